@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"kubevirt.io/client-go/log"
+	exportserver "kubevirt.io/kubevirt/pkg/virt-exportserver"
+)
+
+func main() {
+	listenAddr := pflag.String("listen", ":8443", "Address where to listen on")
+	certFile := pflag.String("cert-file", "/cert/tls.crt", "Certificate of the server")
+	keyFile := pflag.String("key-file", "/cert/tls.key", "Key of the server")
+	tokenFile := pflag.String("token-file", "/token/token", "File holding the token clients have to present")
+	manifestDir := pflag.String("manifest-dir", "/manifests", "Directory holding the exported manifests")
+	scratchDir := pflag.String("scratch-dir", "/scratch", "Directory holding the converted disk images")
+	volumes := pflag.StringArray("volume", nil, "Exported volume as name=path of its disk image, can be repeated")
+	pflag.Parse()
+
+	log.InitializeLogging("virt-exportserver")
+
+	exportedVolumes := make(map[string]string)
+	for _, volume := range *volumes {
+		parts := strings.SplitN(volume, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			log.Log.Errorf("invalid volume %q, expected name=path", volume)
+			os.Exit(1)
+		}
+		exportedVolumes[parts[0]] = parts[1]
+	}
+
+	server := exportserver.NewExportServer(*listenAddr, *certFile, *keyFile, *tokenFile, *manifestDir, *scratchDir, exportedVolumes)
+	if err := server.Run(); err != nil {
+		log.Log.Reason(err).Error("export server failed")
+		os.Exit(1)
+	}
+}
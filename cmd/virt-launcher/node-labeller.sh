@@ -0,0 +1,60 @@
+#!/usr/bin/env bash
+#
+# This file is part of the KubeVirt project
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+# Copyright 2021 Red Hat, Inc.
+#
+
+# Runs as init container of virt-handler. Starts libvirt once and stores the
+# capabilities of the node, which virt-handler turns into node labels.
+
+set -eo pipefail
+
+LABELLER_DIR=/var/lib/kubevirt-node-labeller
+
+ARCH=$(uname -m)
+case "$ARCH" in
+x86_64) MACHINE=q35 ;;
+ppc64le) MACHINE=pseries ;;
+aarch64) MACHINE=virt ;;
+*)
+    echo "unsupported architecture $ARCH"
+    exit 1
+    ;;
+esac
+
+VIRTTYPE=qemu
+if [ -e /dev/kvm ]; then
+    VIRTTYPE=kvm
+fi
+
+mkdir -p "$LABELLER_DIR"
+
+libvirtd -d
+
+VIRSH="virsh -c qemu:///system"
+for i in $(seq 1 10); do
+    if $VIRSH version >/dev/null 2>&1; then
+        break
+    fi
+    sleep 1
+done
+
+$VIRSH domcapabilities --machine "$MACHINE" --arch "$ARCH" --virttype "$VIRTTYPE" >"$LABELLER_DIR/virsh_domcapabilities.xml"
+$VIRSH capabilities >"$LABELLER_DIR/capabilities.xml"
+
+# Expand the host-model CPU into the full list of features it supports
+$VIRSH hypervisor-cpu-baseline --features --machine "$MACHINE" --arch "$ARCH" --virttype "$VIRTTYPE" \
+    "$LABELLER_DIR/virsh_domcapabilities.xml" >"$LABELLER_DIR/supported_features.xml"
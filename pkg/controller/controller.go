@@ -246,6 +246,88 @@ func ApplyVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request
 
 		vmiSpec.Volumes = newVolumesList
 		vmiSpec.Domain.Devices.Disks = newDisksList
+	} else if request.EjectCDRomOptions != nil {
+		// The CD-ROM stays, only the volume of its media is removed
+		newVolumesList := []v1.Volume{}
+		for _, volume := range vmiSpec.Volumes {
+			if volume.Name != request.EjectCDRomOptions.Name {
+				newVolumesList = append(newVolumesList, volume)
+			}
+		}
+		vmiSpec.Volumes = newVolumesList
+	} else if request.InsertCDRomOptions != nil {
+		alreadyInserted := false
+		for _, volume := range vmiSpec.Volumes {
+			if volume.Name == request.InsertCDRomOptions.Name {
+				alreadyInserted = true
+				break
+			}
+		}
+
+		if !alreadyInserted {
+			newVolume := v1.Volume{
+				Name: request.InsertCDRomOptions.Name,
+			}
+
+			if request.InsertCDRomOptions.VolumeSource.PersistentVolumeClaim != nil {
+				newVolume.VolumeSource.PersistentVolumeClaim = request.InsertCDRomOptions.VolumeSource.PersistentVolumeClaim
+			} else if request.InsertCDRomOptions.VolumeSource.DataVolume != nil {
+				newVolume.VolumeSource.DataVolume = request.InsertCDRomOptions.VolumeSource.DataVolume
+			}
+
+			vmiSpec.Volumes = append(vmiSpec.Volumes, newVolume)
+		}
+	}
+
+	return vmiSpec
+}
+
+func ApplyInterfaceRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineInterfaceRequest) *v1.VirtualMachineInstanceSpec {
+	if request.AddInterfaceOptions != nil {
+		alreadyAdded := false
+		for _, network := range vmiSpec.Networks {
+			if network.Name == request.AddInterfaceOptions.Name {
+				alreadyAdded = true
+				break
+			}
+		}
+
+		if !alreadyAdded {
+			vmiSpec.Networks = append(vmiSpec.Networks, v1.Network{
+				Name: request.AddInterfaceOptions.Name,
+				NetworkSource: v1.NetworkSource{
+					Multus: &v1.MultusNetwork{
+						NetworkName: request.AddInterfaceOptions.NetworkAttachmentDefinitionName,
+					},
+				},
+			})
+			vmiSpec.Domain.Devices.Interfaces = append(vmiSpec.Domain.Devices.Interfaces, v1.Interface{
+				Name: request.AddInterfaceOptions.Name,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Bridge: &v1.InterfaceBridge{},
+				},
+			})
+		}
+
+	} else if request.RemoveInterfaceOptions != nil {
+
+		newNetworksList := []v1.Network{}
+		newInterfacesList := []v1.Interface{}
+
+		for _, network := range vmiSpec.Networks {
+			if network.Name != request.RemoveInterfaceOptions.Name {
+				newNetworksList = append(newNetworksList, network)
+			}
+		}
+
+		for _, iface := range vmiSpec.Domain.Devices.Interfaces {
+			if iface.Name != request.RemoveInterfaceOptions.Name {
+				newInterfacesList = append(newInterfacesList, iface)
+			}
+		}
+
+		vmiSpec.Networks = newNetworksList
+		vmiSpec.Domain.Devices.Interfaces = newInterfacesList
 	}
 
 	return vmiSpec
@@ -13,6 +13,9 @@ It has these top-level messages:
 	VirtualMachineOptions
 	VMIRequest
 	MigrationRequest
+	BackupRequest
+	BlockIOTuneRequest
+	FreezeUnfreezeRequest
 	EmptyRequest
 	Response
 	DomainResponse
@@ -182,13 +185,85 @@ func (m *MigrationRequest) GetOptions() []byte {
 	return nil
 }
 
+type BackupRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *BackupRequest) Reset()                    { *m = BackupRequest{} }
+func (m *BackupRequest) String() string            { return proto.CompactTextString(m) }
+func (*BackupRequest) ProtoMessage()               {}
+func (*BackupRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *BackupRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *BackupRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type BlockIOTuneRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *BlockIOTuneRequest) Reset()                    { *m = BlockIOTuneRequest{} }
+func (m *BlockIOTuneRequest) String() string            { return proto.CompactTextString(m) }
+func (*BlockIOTuneRequest) ProtoMessage()               {}
+func (*BlockIOTuneRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *BlockIOTuneRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *BlockIOTuneRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type FreezeUnfreezeRequest struct {
+	Vmi                    *VMI  `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	UnfreezeTimeoutSeconds int32 `protobuf:"varint,2,opt,name=unfreezeTimeoutSeconds" json:"unfreezeTimeoutSeconds,omitempty"`
+}
+
+func (m *FreezeUnfreezeRequest) Reset()                    { *m = FreezeUnfreezeRequest{} }
+func (m *FreezeUnfreezeRequest) String() string            { return proto.CompactTextString(m) }
+func (*FreezeUnfreezeRequest) ProtoMessage()               {}
+func (*FreezeUnfreezeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *FreezeUnfreezeRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *FreezeUnfreezeRequest) GetUnfreezeTimeoutSeconds() int32 {
+	if m != nil {
+		return m.UnfreezeTimeoutSeconds
+	}
+	return 0
+}
+
 type EmptyRequest struct {
 }
 
 func (m *EmptyRequest) Reset()                    { *m = EmptyRequest{} }
 func (m *EmptyRequest) String() string            { return proto.CompactTextString(m) }
 func (*EmptyRequest) ProtoMessage()               {}
-func (*EmptyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*EmptyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 type Response struct {
 	Success bool   `protobuf:"varint,1,opt,name=success" json:"success,omitempty"`
@@ -198,7 +273,7 @@ type Response struct {
 func (m *Response) Reset()                    { *m = Response{} }
 func (m *Response) String() string            { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()               {}
-func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*Response) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
 
 func (m *Response) GetSuccess() bool {
 	if m != nil {
@@ -222,7 +297,7 @@ type DomainResponse struct {
 func (m *DomainResponse) Reset()                    { *m = DomainResponse{} }
 func (m *DomainResponse) String() string            { return proto.CompactTextString(m) }
 func (*DomainResponse) ProtoMessage()               {}
-func (*DomainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*DomainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *DomainResponse) GetResponse() *Response {
 	if m != nil {
@@ -246,7 +321,7 @@ type DomainStatsResponse struct {
 func (m *DomainStatsResponse) Reset()                    { *m = DomainStatsResponse{} }
 func (m *DomainStatsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DomainStatsResponse) ProtoMessage()               {}
-func (*DomainStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*DomainStatsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *DomainStatsResponse) GetResponse() *Response {
 	if m != nil {
@@ -270,7 +345,7 @@ type GuestInfoResponse struct {
 func (m *GuestInfoResponse) Reset()                    { *m = GuestInfoResponse{} }
 func (m *GuestInfoResponse) String() string            { return proto.CompactTextString(m) }
 func (*GuestInfoResponse) ProtoMessage()               {}
-func (*GuestInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*GuestInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *GuestInfoResponse) GetResponse() *Response {
 	if m != nil {
@@ -294,7 +369,7 @@ type GuestUserListResponse struct {
 func (m *GuestUserListResponse) Reset()                    { *m = GuestUserListResponse{} }
 func (m *GuestUserListResponse) String() string            { return proto.CompactTextString(m) }
 func (*GuestUserListResponse) ProtoMessage()               {}
-func (*GuestUserListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*GuestUserListResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
 func (m *GuestUserListResponse) GetResponse() *Response {
 	if m != nil {
@@ -318,7 +393,7 @@ type GuestFilesystemsResponse struct {
 func (m *GuestFilesystemsResponse) Reset()                    { *m = GuestFilesystemsResponse{} }
 func (m *GuestFilesystemsResponse) String() string            { return proto.CompactTextString(m) }
 func (*GuestFilesystemsResponse) ProtoMessage()               {}
-func (*GuestFilesystemsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*GuestFilesystemsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *GuestFilesystemsResponse) GetResponse() *Response {
 	if m != nil {
@@ -340,6 +415,9 @@ func init() {
 	proto.RegisterType((*VirtualMachineOptions)(nil), "kubevirt.cmd.v1.VirtualMachineOptions")
 	proto.RegisterType((*VMIRequest)(nil), "kubevirt.cmd.v1.VMIRequest")
 	proto.RegisterType((*MigrationRequest)(nil), "kubevirt.cmd.v1.MigrationRequest")
+	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
+	proto.RegisterType((*BlockIOTuneRequest)(nil), "kubevirt.cmd.v1.BlockIOTuneRequest")
+	proto.RegisterType((*FreezeUnfreezeRequest)(nil), "kubevirt.cmd.v1.FreezeUnfreezeRequest")
 	proto.RegisterType((*EmptyRequest)(nil), "kubevirt.cmd.v1.EmptyRequest")
 	proto.RegisterType((*Response)(nil), "kubevirt.cmd.v1.Response")
 	proto.RegisterType((*DomainResponse)(nil), "kubevirt.cmd.v1.DomainResponse")
@@ -375,6 +453,12 @@ type CmdClient interface {
 	GetGuestInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestInfoResponse, error)
 	GetUsers(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestUserListResponse, error)
 	GetFilesystems(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestFilesystemsResponse, error)
+	FreezeVirtualMachine(ctx context.Context, in *FreezeUnfreezeRequest, opts ...grpc.CallOption) (*Response, error)
+	UnfreezeVirtualMachine(ctx context.Context, in *FreezeUnfreezeRequest, opts ...grpc.CallOption) (*Response, error)
+	ResizeVirtualMachineVolumes(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error)
+	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
+	AbortVirtualMachineBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
+	SetVirtualMachineBlockIOTune(ctx context.Context, in *BlockIOTuneRequest, opts ...grpc.CallOption) (*Response, error)
 	Ping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
@@ -521,6 +605,60 @@ func (c *cmdClient) GetFilesystems(ctx context.Context, in *EmptyRequest, opts .
 	return out, nil
 }
 
+func (c *cmdClient) FreezeVirtualMachine(ctx context.Context, in *FreezeUnfreezeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/FreezeVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) UnfreezeVirtualMachine(ctx context.Context, in *FreezeUnfreezeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/UnfreezeVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) ResizeVirtualMachineVolumes(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/ResizeVirtualMachineVolumes", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) AbortVirtualMachineBackup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/AbortVirtualMachineBackup", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) SetVirtualMachineBlockIOTune(ctx context.Context, in *BlockIOTuneRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/SetVirtualMachineBlockIOTune", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cmdClient) Ping(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/Ping", in, out, c.cc, opts...)
@@ -548,6 +686,12 @@ type CmdServer interface {
 	GetGuestInfo(context.Context, *EmptyRequest) (*GuestInfoResponse, error)
 	GetUsers(context.Context, *EmptyRequest) (*GuestUserListResponse, error)
 	GetFilesystems(context.Context, *EmptyRequest) (*GuestFilesystemsResponse, error)
+	FreezeVirtualMachine(context.Context, *FreezeUnfreezeRequest) (*Response, error)
+	UnfreezeVirtualMachine(context.Context, *FreezeUnfreezeRequest) (*Response, error)
+	ResizeVirtualMachineVolumes(context.Context, *VMIRequest) (*Response, error)
+	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
+	AbortVirtualMachineBackup(context.Context, *BackupRequest) (*Response, error)
+	SetVirtualMachineBlockIOTune(context.Context, *BlockIOTuneRequest) (*Response, error)
 	Ping(context.Context, *EmptyRequest) (*Response, error)
 }
 
@@ -825,6 +969,114 @@ func _Cmd_GetFilesystems_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_FreezeVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeUnfreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).FreezeVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/FreezeVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).FreezeVirtualMachine(ctx, req.(*FreezeUnfreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_UnfreezeVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeUnfreezeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).UnfreezeVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/UnfreezeVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).UnfreezeVirtualMachine(ctx, req.(*FreezeUnfreezeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_ResizeVirtualMachineVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VMIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).ResizeVirtualMachineVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/ResizeVirtualMachineVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).ResizeVirtualMachineVolumes(ctx, req.(*VMIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_BackupVirtualMachine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/BackupVirtualMachine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).BackupVirtualMachine(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_AbortVirtualMachineBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).AbortVirtualMachineBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/AbortVirtualMachineBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).AbortVirtualMachineBackup(ctx, req.(*BackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_SetVirtualMachineBlockIOTune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockIOTuneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).SetVirtualMachineBlockIOTune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/SetVirtualMachineBlockIOTune",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).SetVirtualMachineBlockIOTune(ctx, req.(*BlockIOTuneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cmd_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
@@ -907,6 +1159,30 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetFilesystems",
 			Handler:    _Cmd_GetFilesystems_Handler,
 		},
+		{
+			MethodName: "FreezeVirtualMachine",
+			Handler:    _Cmd_FreezeVirtualMachine_Handler,
+		},
+		{
+			MethodName: "UnfreezeVirtualMachine",
+			Handler:    _Cmd_UnfreezeVirtualMachine_Handler,
+		},
+		{
+			MethodName: "ResizeVirtualMachineVolumes",
+			Handler:    _Cmd_ResizeVirtualMachineVolumes_Handler,
+		},
+		{
+			MethodName: "BackupVirtualMachine",
+			Handler:    _Cmd_BackupVirtualMachine_Handler,
+		},
+		{
+			MethodName: "AbortVirtualMachineBackup",
+			Handler:    _Cmd_AbortVirtualMachineBackup_Handler,
+		},
+		{
+			MethodName: "SetVirtualMachineBlockIOTune",
+			Handler:    _Cmd_SetVirtualMachineBlockIOTune_Handler,
+		},
 		{
 			MethodName: "Ping",
 			Handler:    _Cmd_Ping_Handler,
@@ -919,52 +1195,61 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 738 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x61, 0x4f, 0xd3, 0x40,
-	0x18, 0xc7, 0x37, 0x86, 0x30, 0x1e, 0x26, 0xc2, 0xc1, 0xb0, 0x62, 0x08, 0x78, 0x31, 0x44, 0x12,
-	0x19, 0x01, 0xf1, 0x8d, 0x2f, 0x8c, 0x19, 0xe8, 0x82, 0x38, 0x98, 0x1d, 0xcc, 0x68, 0x4c, 0xcc,
-	0xd1, 0x1e, 0xdd, 0x85, 0xf6, 0x6e, 0xf6, 0xae, 0xd3, 0xbd, 0xf7, 0x95, 0x89, 0x5f, 0xc0, 0x4f,
-	0xe3, 0x47, 0x33, 0xbd, 0x76, 0x83, 0xad, 0x9d, 0x8b, 0xd9, 0x5e, 0xd1, 0xe7, 0x9e, 0xe7, 0x7e,
-	0xff, 0xe7, 0x9e, 0x6b, 0xff, 0x0c, 0xb6, 0x5b, 0xd7, 0xce, 0x6e, 0x93, 0x70, 0xdb, 0xa5, 0xfe,
-	0x8e, 0x4b, 0x02, 0x6e, 0x35, 0xa9, 0xbf, 0x63, 0x09, 0x6f, 0xd7, 0xf2, 0xec, 0xdd, 0xf6, 0x5e,
-	0xf8, 0xa7, 0xd4, 0xf2, 0x85, 0x12, 0xe8, 0xde, 0x75, 0x70, 0x49, 0xdb, 0xcc, 0x57, 0xa5, 0x70,
-	0xad, 0xbd, 0x87, 0x37, 0x20, 0xd7, 0xa8, 0x1e, 0x23, 0x03, 0x66, 0xdb, 0x1e, 0x7b, 0x2b, 0x05,
-	0x37, 0xb2, 0x9b, 0xd9, 0x27, 0x05, 0xb3, 0x1b, 0xe2, 0x9f, 0x59, 0x98, 0xa9, 0x57, 0xcb, 0x4c,
-	0x48, 0x84, 0xa1, 0xe0, 0x11, 0x1e, 0x5c, 0x11, 0x4b, 0x05, 0x3e, 0xf5, 0x75, 0xe5, 0x9c, 0xd9,
-	0xb7, 0x16, 0x82, 0x5a, 0xbe, 0xb0, 0x03, 0x4b, 0x19, 0x53, 0x3a, 0xdd, 0x0d, 0xb5, 0x04, 0xf5,
-	0x25, 0x13, 0xdc, 0xc8, 0x45, 0x99, 0x38, 0x44, 0x8b, 0x90, 0x93, 0xd7, 0x81, 0x31, 0xad, 0x57,
-	0xc3, 0x47, 0xb4, 0x0a, 0x33, 0x57, 0xc4, 0x63, 0x6e, 0xc7, 0xb8, 0xa3, 0x17, 0xe3, 0x08, 0xff,
-	0xce, 0x42, 0xb1, 0xc1, 0x7c, 0x15, 0x10, 0xb7, 0x4a, 0xac, 0x26, 0xe3, 0xf4, 0xac, 0xa5, 0x98,
-	0xe0, 0x12, 0x9d, 0xc0, 0x4a, 0x7f, 0x22, 0xea, 0x59, 0xf7, 0x38, 0xbf, 0x7f, 0xbf, 0x34, 0x70,
-	0xee, 0x52, 0x94, 0x36, 0x53, 0x37, 0xa1, 0x03, 0x28, 0x56, 0xa9, 0x57, 0x26, 0xae, 0x2b, 0x04,
-	0xaf, 0x2b, 0xa2, 0x64, 0x8d, 0xfa, 0x4c, 0xd8, 0xfa, 0x48, 0x77, 0xcd, 0xf4, 0x24, 0x6e, 0x03,
-	0x34, 0xaa, 0xc7, 0x26, 0xfd, 0x1a, 0x50, 0xa9, 0xd0, 0x16, 0xe4, 0xda, 0x1e, 0x8b, 0xf5, 0x57,
-	0x12, 0xfa, 0x61, 0x65, 0x58, 0x80, 0x5e, 0xc1, 0xac, 0x88, 0xce, 0xa0, 0xe9, 0xf3, 0xfb, 0x5b,
-	0xc9, 0xda, 0xb4, 0x13, 0x9b, 0xdd, 0x6d, 0xf8, 0x1c, 0x16, 0xab, 0xcc, 0xf1, 0x49, 0x18, 0xfd,
-	0xaf, 0xba, 0xd1, 0xaf, 0x5e, 0xb8, 0xa1, 0x2e, 0x40, 0xe1, 0xb5, 0xd7, 0x52, 0x9d, 0x98, 0x88,
-	0x5f, 0x42, 0xde, 0xa4, 0xb2, 0x25, 0xb8, 0xa4, 0xe1, 0x2e, 0x19, 0x58, 0x16, 0x95, 0xd1, 0x7c,
-	0xf3, 0x66, 0x37, 0x0c, 0x33, 0x1e, 0x95, 0x92, 0x38, 0xb4, 0x7b, 0xfd, 0x71, 0x88, 0xbf, 0xc0,
-	0xc2, 0x91, 0xf0, 0x08, 0xe3, 0x3d, 0xca, 0x73, 0xc8, 0xfb, 0xf1, 0x73, 0xdc, 0xe8, 0x83, 0x44,
-	0xa3, 0xdd, 0x62, 0xb3, 0x57, 0x1a, 0xbe, 0x1b, 0xb6, 0x06, 0xc5, 0x0a, 0x71, 0x84, 0x39, 0x2c,
-	0x47, 0x02, 0xfa, 0x4e, 0xc6, 0x55, 0xd9, 0x84, 0x79, 0xfb, 0x86, 0x16, 0x4b, 0xdd, 0x5e, 0xc2,
-	0xdf, 0x61, 0xa9, 0x12, 0x4e, 0xe6, 0x98, 0x5f, 0x89, 0x71, 0xd5, 0x9e, 0xc2, 0x92, 0x33, 0xc8,
-	0x8a, 0x35, 0x93, 0x09, 0xfc, 0x23, 0x0b, 0x45, 0x2d, 0x7d, 0x21, 0xa9, 0xff, 0x8e, 0x49, 0x35,
-	0xae, 0xfc, 0x01, 0x14, 0x9d, 0x34, 0x5e, 0xdc, 0x42, 0x7a, 0x12, 0xff, 0xca, 0x82, 0xa1, 0xdb,
-	0x78, 0xc3, 0x5c, 0x2a, 0x3b, 0x52, 0x51, 0x6f, 0xec, 0xb1, 0xbf, 0x00, 0xc3, 0x19, 0x82, 0x8c,
-	0x9b, 0x19, 0x9a, 0xdf, 0xff, 0x03, 0x90, 0x3b, 0xf4, 0x6c, 0x74, 0x0a, 0xa8, 0xde, 0xe1, 0x56,
-	0xff, 0x57, 0x83, 0x1e, 0xa6, 0x7e, 0x04, 0xd1, 0xcb, 0xbd, 0x36, 0xbc, 0x37, 0x9c, 0x41, 0x67,
-	0xb0, 0x5c, 0x23, 0x81, 0xa4, 0x13, 0x03, 0xbe, 0x87, 0xe2, 0x05, 0x6f, 0x4d, 0x14, 0x69, 0xc2,
-	0x6a, 0xbd, 0x19, 0x28, 0x5b, 0x7c, 0xe3, 0x13, 0x63, 0x9e, 0x02, 0x3a, 0x61, 0xae, 0x3b, 0x31,
-	0x5e, 0x0d, 0x56, 0x8e, 0xa8, 0x4b, 0xd5, 0xe4, 0x4e, 0xfd, 0x01, 0x8a, 0x91, 0xf3, 0x0d, 0x22,
-	0x1f, 0x25, 0x76, 0x0d, 0x3a, 0xe4, 0xc8, 0x2b, 0x0f, 0x5f, 0xa1, 0xde, 0xa6, 0x73, 0xe2, 0x3b,
-	0x54, 0x8d, 0xd1, 0xe9, 0x47, 0x58, 0x3f, 0x24, 0xdc, 0xa2, 0x03, 0xd3, 0xec, 0x09, 0x8c, 0x81,
-	0x6e, 0xc0, 0x5a, 0x9d, 0xaa, 0x7e, 0xae, 0xfe, 0x2c, 0xcf, 0x99, 0x37, 0xce, 0x70, 0xab, 0x30,
-	0x57, 0xa1, 0x2a, 0xb2, 0x54, 0xb4, 0x9e, 0xa8, 0xbc, 0xfd, 0xcf, 0x61, 0x6d, 0x23, 0x91, 0xee,
-	0xf7, 0x7a, 0x7d, 0x57, 0x0b, 0x3d, 0x9c, 0x36, 0xd0, 0x51, 0xcc, 0xc7, 0x43, 0x98, 0x7d, 0xf6,
-	0x8e, 0x33, 0xa8, 0x0e, 0x85, 0x0a, 0x55, 0x3d, 0x2b, 0x1e, 0x85, 0xc5, 0x89, 0x74, 0xc2, 0xc5,
-	0x35, 0x34, 0x5f, 0xa1, 0xda, 0xf2, 0x46, 0xf6, 0xb9, 0x95, 0x0e, 0x4c, 0xd8, 0x65, 0x06, 0x7d,
-	0xd6, 0x23, 0xb8, 0x65, 0x5d, 0xa3, 0xd0, 0xdb, 0xe9, 0xe8, 0x14, 0xf3, 0xc3, 0x19, 0x54, 0x86,
-	0xe9, 0x1a, 0xe3, 0xce, 0x28, 0xe6, 0xbf, 0xee, 0xbc, 0x3c, 0xfd, 0x69, 0xaa, 0xbd, 0x77, 0x39,
-	0xa3, 0x7f, 0x2b, 0x3e, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xa3, 0x1e, 0x96, 0x58, 0x0a,
-	0x00, 0x00,
+	// 885 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0x6d, 0x73, 0xdb, 0x44,
+	0x10, 0xc7, 0xe3, 0x3a, 0x4d, 0xd3, 0x8d, 0x1b, 0xda, 0xab, 0x1d, 0xd4, 0x94, 0xd2, 0x72, 0x30,
+	0x19, 0x3a, 0x43, 0x93, 0x49, 0x28, 0xbc, 0xe0, 0x05, 0x03, 0x6e, 0x69, 0x26, 0x14, 0x37, 0xa9,
+	0x9c, 0xb8, 0x3c, 0x0e, 0x28, 0xd2, 0xc6, 0xb9, 0xb1, 0x74, 0x67, 0xee, 0xc1, 0x25, 0xbc, 0xe6,
+	0x15, 0x33, 0x7c, 0x01, 0x3e, 0x20, 0x9f, 0x83, 0xd1, 0x49, 0x76, 0xa3, 0x07, 0xc7, 0x93, 0x91,
+	0xfb, 0xca, 0xda, 0xdb, 0xbd, 0xdf, 0x7f, 0xef, 0x74, 0xbb, 0x3a, 0xc3, 0xc3, 0xe1, 0xa0, 0xbf,
+	0x75, 0xea, 0xf1, 0x20, 0x44, 0xf9, 0x28, 0xf4, 0x0c, 0xf7, 0x4f, 0x51, 0x3e, 0xf2, 0x45, 0xb4,
+	0xe5, 0x47, 0xc1, 0xd6, 0x68, 0x3b, 0xfe, 0xd9, 0x1c, 0x4a, 0xa1, 0x05, 0x79, 0x67, 0x60, 0x8e,
+	0x71, 0xc4, 0xa4, 0xde, 0x8c, 0xc7, 0x46, 0xdb, 0xf4, 0x3e, 0xd4, 0x7b, 0x9d, 0x3d, 0xe2, 0xc0,
+	0xb5, 0x51, 0xc4, 0xbe, 0x55, 0x82, 0x3b, 0xb5, 0x07, 0xb5, 0x8f, 0x1b, 0xee, 0xd8, 0xa4, 0x7f,
+	0xd7, 0x60, 0xa9, 0xdb, 0x69, 0x33, 0xa1, 0x08, 0x85, 0x46, 0xe4, 0x71, 0x73, 0xe2, 0xf9, 0xda,
+	0x48, 0x94, 0x36, 0xf2, 0xba, 0x9b, 0x19, 0x8b, 0x41, 0x43, 0x29, 0x02, 0xe3, 0x6b, 0xe7, 0x8a,
+	0x75, 0x8f, 0x4d, 0x2b, 0x81, 0x52, 0x31, 0xc1, 0x9d, 0x7a, 0xe2, 0x49, 0x4d, 0x72, 0x13, 0xea,
+	0x6a, 0x60, 0x9c, 0x45, 0x3b, 0x1a, 0x3f, 0x92, 0x35, 0x58, 0x3a, 0xf1, 0x22, 0x16, 0x9e, 0x39,
+	0x57, 0xed, 0x60, 0x6a, 0xd1, 0x7f, 0x6b, 0xd0, 0xea, 0x31, 0xa9, 0x8d, 0x17, 0x76, 0x3c, 0xff,
+	0x94, 0x71, 0xdc, 0x1f, 0x6a, 0x26, 0xb8, 0x22, 0xcf, 0xa1, 0x99, 0x75, 0x24, 0x39, 0xdb, 0x1c,
+	0x57, 0x76, 0xde, 0xdd, 0xcc, 0xad, 0x7b, 0x33, 0x71, 0xbb, 0xa5, 0x93, 0xc8, 0x63, 0x68, 0x75,
+	0x30, 0x6a, 0x7b, 0x61, 0x28, 0x04, 0xef, 0x6a, 0x4f, 0xab, 0x03, 0x94, 0x4c, 0x04, 0x76, 0x49,
+	0x37, 0xdc, 0x72, 0x27, 0x1d, 0x01, 0xf4, 0x3a, 0x7b, 0x2e, 0xfe, 0x6e, 0x50, 0x69, 0xb2, 0x01,
+	0xf5, 0x51, 0xc4, 0x52, 0xfd, 0x66, 0x41, 0x3f, 0x8e, 0x8c, 0x03, 0xc8, 0x57, 0x70, 0x4d, 0x24,
+	0x6b, 0xb0, 0xf4, 0x95, 0x9d, 0x8d, 0x62, 0x6c, 0xd9, 0x8a, 0xdd, 0xf1, 0x34, 0x7a, 0x08, 0x37,
+	0x3b, 0xac, 0x2f, 0xbd, 0xd8, 0xba, 0xac, 0xba, 0x93, 0x55, 0x6f, 0xbc, 0xa1, 0xbe, 0x84, 0x1b,
+	0x6d, 0xcf, 0x1f, 0x98, 0xe1, 0xfc, 0x90, 0x3d, 0x20, 0xed, 0x50, 0xf8, 0x83, 0xbd, 0xfd, 0x43,
+	0xc3, 0x71, 0x7e, 0xdc, 0xd7, 0xd0, 0x7a, 0x26, 0x11, 0xff, 0xc4, 0x23, 0x7e, 0x62, 0x7f, 0x2f,
+	0x8b, 0xfe, 0x1c, 0xd6, 0x4c, 0x3a, 0xf5, 0x90, 0x45, 0x28, 0x8c, 0xee, 0xa2, 0x2f, 0x78, 0x90,
+	0x28, 0x5d, 0x75, 0xa7, 0x78, 0xe9, 0x2a, 0x34, 0xbe, 0x89, 0x86, 0xfa, 0x2c, 0xd5, 0xa3, 0x5f,
+	0xc2, 0xb2, 0x8b, 0x6a, 0x28, 0xb8, 0xc2, 0x38, 0x5d, 0x65, 0x7c, 0x1f, 0x55, 0x72, 0x06, 0x97,
+	0xdd, 0xb1, 0x19, 0x7b, 0x22, 0x54, 0xca, 0xeb, 0xe3, 0xb8, 0x44, 0x52, 0x93, 0xfe, 0x0a, 0xab,
+	0x4f, 0x45, 0xe4, 0x31, 0x3e, 0xa1, 0x7c, 0x06, 0xcb, 0x32, 0x7d, 0x4e, 0x97, 0x71, 0xa7, 0xb0,
+	0x8c, 0x71, 0xb0, 0x3b, 0x09, 0x8d, 0xeb, 0x27, 0xb0, 0xa0, 0x54, 0x21, 0xb5, 0x28, 0x87, 0xdb,
+	0x89, 0x80, 0x3d, 0xb7, 0x55, 0x55, 0x1e, 0xc0, 0x4a, 0xf0, 0x86, 0x96, 0x4a, 0x9d, 0x1f, 0xa2,
+	0x7f, 0xc0, 0xad, 0xdd, 0x78, 0x67, 0xf6, 0xf8, 0x89, 0xa8, 0xaa, 0xf6, 0x09, 0xdc, 0xea, 0xe7,
+	0x59, 0xa9, 0x66, 0xd1, 0x41, 0xff, 0xaa, 0x41, 0xcb, 0x4a, 0x1f, 0x29, 0x94, 0xdf, 0x31, 0xa5,
+	0xab, 0xca, 0x3f, 0x86, 0x56, 0xbf, 0x8c, 0x97, 0xa6, 0x50, 0xee, 0xa4, 0xff, 0xd4, 0xc0, 0xb1,
+	0x69, 0x3c, 0x63, 0x21, 0xaa, 0x33, 0xa5, 0x31, 0xaa, 0xbc, 0xed, 0x5f, 0x80, 0xd3, 0x9f, 0x82,
+	0x4c, 0x93, 0x99, 0xea, 0xdf, 0xf9, 0x6f, 0x15, 0xea, 0x4f, 0xa2, 0x80, 0xbc, 0x00, 0xd2, 0x3d,
+	0xe3, 0x7e, 0xb6, 0xb3, 0x90, 0xbb, 0xa5, 0x25, 0x92, 0x1c, 0xee, 0xf5, 0xe9, 0xb9, 0xd1, 0x05,
+	0xb2, 0x0f, 0xb7, 0x0f, 0x3c, 0xa3, 0x70, 0x6e, 0xc0, 0x97, 0xd0, 0x3a, 0xe2, 0xc3, 0xb9, 0x22,
+	0x5d, 0x58, 0xeb, 0x9e, 0x1a, 0x1d, 0x88, 0xd7, 0x7c, 0x6e, 0xcc, 0x17, 0x40, 0x9e, 0xb3, 0x30,
+	0x9c, 0x1b, 0xef, 0x00, 0x9a, 0x4f, 0x31, 0x44, 0x3d, 0xbf, 0x55, 0xbf, 0x82, 0x56, 0xf2, 0x75,
+	0xc8, 0x23, 0x3f, 0x28, 0xcc, 0xca, 0x7f, 0x45, 0x66, 0xbe, 0xf2, 0xf8, 0x08, 0x4d, 0x26, 0x1d,
+	0x7a, 0xb2, 0x8f, 0xba, 0x42, 0xa6, 0x3f, 0xc0, 0xbd, 0x27, 0x1e, 0xf7, 0x31, 0xb7, 0x9b, 0x13,
+	0x81, 0x0a, 0xe8, 0x1e, 0xac, 0x77, 0x51, 0x67, 0xb9, 0xb6, 0x2c, 0xe3, 0x86, 0x5e, 0x81, 0xdb,
+	0x81, 0xeb, 0xbb, 0xa8, 0x93, 0x96, 0x4a, 0xee, 0x15, 0x22, 0xcf, 0x7f, 0x1c, 0xd6, 0xef, 0x17,
+	0xdc, 0xd9, 0x5e, 0x6f, 0xdf, 0xd5, 0xea, 0x04, 0x67, 0x1b, 0xe8, 0x2c, 0xe6, 0x47, 0x53, 0x98,
+	0x99, 0xf6, 0x4e, 0x17, 0x48, 0x17, 0x1a, 0xbb, 0xa8, 0x27, 0xad, 0x78, 0x16, 0x96, 0x16, 0xdc,
+	0x85, 0x2e, 0x6e, 0xa1, 0xcb, 0xbb, 0x68, 0x5b, 0xde, 0xcc, 0x3c, 0x37, 0xca, 0x81, 0x85, 0x76,
+	0xb9, 0x40, 0x7e, 0xb6, 0x5b, 0x70, 0xae, 0x75, 0xcd, 0x42, 0x3f, 0x2c, 0x47, 0x97, 0x34, 0x3f,
+	0xba, 0x40, 0x7e, 0x82, 0x66, 0x72, 0x53, 0xc8, 0xd5, 0x42, 0x31, 0xbf, 0xd2, 0x0b, 0xc5, 0xc5,
+	0x87, 0xe1, 0x17, 0x58, 0x1b, 0xc7, 0xbf, 0x0d, 0xfc, 0x2b, 0xb8, 0xeb, 0xa2, 0x62, 0x79, 0x78,
+	0x4f, 0x84, 0x26, 0x42, 0x55, 0xe1, 0x10, 0x77, 0xa1, 0x99, 0xdc, 0xf4, 0x72, 0x59, 0xbf, 0x5f,
+	0x98, 0x94, 0xb9, 0x10, 0x5e, 0x0c, 0xfd, 0x1e, 0xee, 0x7c, 0x7d, 0x2c, 0x64, 0xae, 0xe6, 0x12,
+	0x40, 0x35, 0xf2, 0x6f, 0xf0, 0x5e, 0xa1, 0x96, 0xcf, 0x5d, 0x2b, 0xc9, 0x87, 0x45, 0x78, 0xe1,
+	0xd2, 0x79, 0xb1, 0x42, 0x1b, 0x16, 0x0f, 0x18, 0xef, 0xcf, 0x3a, 0x79, 0x17, 0x31, 0xda, 0x8b,
+	0x3f, 0x5e, 0x19, 0x6d, 0x1f, 0x2f, 0xd9, 0x7f, 0x5d, 0x9f, 0xfe, 0x3f, 0x00, 0xb5, 0x21, 0x08,
+	0xf7, 0xa2, 0x0d, 0x00, 0x00,
 }
@@ -20,16 +20,20 @@
 package hostdisk
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"syscall"
 
 	"kubevirt.io/client-go/log"
 	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -107,17 +111,28 @@ func dirBytesAvailable(path string) (uint64, error) {
 }
 
 func createSparseRaw(fullPath string, size int64) (err error) {
-	offset := size - 1
 	f, err := os.Create(fullPath)
 	if err != nil {
 		return err
 	}
 	defer util.CloseIOAndCheckErr(f, &err)
-	_, err = f.WriteAt([]byte{0}, offset)
+	return writeSparseRawEnd(f, size)
+}
+
+func expandSparseRaw(fullPath string, size int64) (err error) {
+	f, err := os.OpenFile(fullPath, os.O_WRONLY, 0)
 	if err != nil {
 		return err
 	}
-	return nil
+	defer util.CloseIOAndCheckErr(f, &err)
+	return writeSparseRawEnd(f, size)
+}
+
+// writeSparseRawEnd writes the last byte of the image, which sets its size without allocating the blocks before it
+func writeSparseRawEnd(f *os.File, size int64) error {
+	offset := size - 1
+	_, err := f.WriteAt([]byte{0}, offset)
+	return err
 }
 
 func getPVCDiskImgPath(volumeName string, diskName string) string {
@@ -165,27 +180,11 @@ func (hdc DiskImgCreator) Create(vmi *v1.VirtualMachineInstance) error {
 					return err
 				}
 				requestedSize, _ := hostDisk.Capacity.AsInt64()
-				diskSize := requestedSize
-				if uint64(requestedSize) > availableSize {
-					// Some storage provisioners provision less space than requested, due to filesystem overhead etc.
-					// We tolerate some difference in requested and available capacity up to some degree.
-					// This can be configured with the "pvc-tolerate-less-space-up-to-percent" parameter in the kubevirt-config ConfigMap.
-					// It is provided as argument to virt-launcher.
-					toleratedSize := requestedSize * (100 - int64(hdc.lessPVCSpaceToleration)) / 100
-					if uint64(toleratedSize) > availableSize {
-						return fmt.Errorf("unable to create %s, not enough space, demanded size %d B is bigger than available space %d B, also after taking %v %% toleration into account",
-							hostDisk.Path, uint64(requestedSize), availableSize, hdc.lessPVCSpaceToleration)
-					}
-					diskSize = int64(availableSize)
-
-					msg := fmt.Sprintf("PV size too small: expected %v B, found %v B. Using it anyway, it is within %v %% toleration", requestedSize, availableSize, hdc.lessPVCSpaceToleration)
-					log.Log.Info(msg)
-					err = hdc.notifier.SendK8sEvent(vmi, EventTypeToleratedSmallPV, EventReasonToleratedSmallPV, msg)
-					if err != nil {
-						log.Log.Reason(err).Warningf("Couldn't send k8s event for tolerated PV size: %v", err)
-					}
+				diskSize, err := hdc.toleratedDiskSize(vmi, hostDisk.Path, requestedSize, availableSize)
+				if err != nil {
+					return err
 				}
-				err = createSparseRaw(diskPath, int64(diskSize))
+				err = createSparseRaw(diskPath, diskSize)
 				if err != nil {
 					log.Log.Reason(err).Errorf("Couldn't create a sparse raw file for disk path: %s, error: %v", diskPath, err)
 					return err
@@ -202,3 +201,138 @@ func (hdc DiskImgCreator) Create(vmi *v1.VirtualMachineInstance) error {
 	}
 	return nil
 }
+
+// Expand grows the sparse raw disk image at diskPath to the given capacity, tolerating a smaller PV
+// the same way as on creation. The image is never shrunk. It returns whether the image was grown.
+func (hdc DiskImgCreator) Expand(vmi *v1.VirtualMachineInstance, diskPath string, capacity resource.Quantity) (bool, error) {
+	info, err := os.Stat(diskPath)
+	if err != nil {
+		return false, err
+	}
+	requestedSize, _ := capacity.AsInt64()
+	if requestedSize <= info.Size() {
+		return false, nil
+	}
+
+	availableSize, err := hdc.dirBytesAvailableFunc(filepath.Dir(diskPath))
+	if err != nil {
+		return false, err
+	}
+	// The blocks already allocated by the image are available to it as well
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok {
+		availableSize += uint64(stat.Blocks) * 512
+	}
+	diskSize, err := hdc.toleratedDiskSize(vmi, diskPath, requestedSize, availableSize)
+	if err != nil {
+		return false, err
+	}
+	if diskSize <= info.Size() {
+		return false, nil
+	}
+
+	if err := expandSparseRaw(diskPath, diskSize); err != nil {
+		log.Log.Reason(err).Errorf("Couldn't expand the sparse raw file for disk path: %s, error: %v", diskPath, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// toleratedDiskSize returns the size of an image for the requested size, or the available size if the
+// PV is smaller than requested but within the toleration.
+func (hdc DiskImgCreator) toleratedDiskSize(vmi *v1.VirtualMachineInstance, diskPath string, requestedSize int64, availableSize uint64) (int64, error) {
+	if uint64(requestedSize) <= availableSize {
+		return requestedSize, nil
+	}
+	// Some storage provisioners provision less space than requested, due to filesystem overhead etc.
+	// We tolerate some difference in requested and available capacity up to some degree.
+	// This can be configured with the "pvc-tolerate-less-space-up-to-percent" parameter in the kubevirt-config ConfigMap.
+	// It is provided as argument to virt-launcher.
+	toleratedSize := requestedSize * (100 - int64(hdc.lessPVCSpaceToleration)) / 100
+	if uint64(toleratedSize) > availableSize {
+		return 0, fmt.Errorf("unable to create %s, not enough space, demanded size %d B is bigger than available space %d B, also after taking %v %% toleration into account",
+			diskPath, uint64(requestedSize), availableSize, hdc.lessPVCSpaceToleration)
+	}
+
+	msg := fmt.Sprintf("PV size too small: expected %v B, found %v B. Using it anyway, it is within %v %% toleration", requestedSize, availableSize, hdc.lessPVCSpaceToleration)
+	log.Log.Info(msg)
+	err := hdc.notifier.SendK8sEvent(vmi, EventTypeToleratedSmallPV, EventReasonToleratedSmallPV, msg)
+	if err != nil {
+		log.Log.Reason(err).Warningf("Couldn't send k8s event for tolerated PV size: %v", err)
+	}
+	return int64(availableSize), nil
+}
+
+var qemuImg = qemuImgFunc
+
+func qemuImgFunc(args ...string) ([]byte, error) {
+	// #nosec No risk for attacker injection. The arguments are paths of disk images and sizes
+	return exec.Command("/usr/bin/qemu-img", args...).CombinedOutput()
+}
+
+func runQemuImg(args ...string) error {
+	if out, err := qemuImg(args...); err != nil {
+		return fmt.Errorf("qemu-img %s failed: %v: %s", args[0], err, string(out))
+	}
+	return nil
+}
+
+func imageVirtualSize(imagePath string) (int64, error) {
+	// the image may be in use by the source of a migration
+	out, err := qemuImg("info", "-U", "--output", "json", imagePath)
+	if err != nil {
+		return 0, fmt.Errorf("qemu-img info failed: %v: %s", err, string(out))
+	}
+	info := struct {
+		VirtualSize int64 `json:"virtual-size"`
+	}{}
+	if err := json.Unmarshal(out, &info); err != nil {
+		return 0, fmt.Errorf("failed to parse the info of %s: %v", imagePath, err)
+	}
+	return info.VirtualSize, nil
+}
+
+// CreateChangedBlockTrackingImage creates the qcow2 image at imagePath, which keeps the persistent dirty
+// bitmaps of the raw image at dataPath while its data stays in the raw image. An existing image is grown
+// if the raw image was expanded in the meantime.
+func CreateChangedBlockTrackingImage(imagePath string, dataPath string) error {
+	data, err := os.Stat(dataPath)
+	if err != nil {
+		return err
+	}
+	size := strconv.FormatInt(data.Size(), 10)
+
+	if _, err := os.Stat(imagePath); os.IsNotExist(err) {
+		// qemu-img create truncates an existing data file, so the image is created with a temporary
+		// data file first, which is then replaced by the raw image.
+		tmpDataPath := imagePath + ".tmp"
+		defer os.Remove(tmpDataPath)
+		if err := runQemuImg("create", "-f", "qcow2", "-o", "data_file="+tmpDataPath+",data_file_raw=on", imagePath, size); err != nil {
+			return err
+		}
+		if err := runQemuImg("amend", "-f", "qcow2", "-o", "data_file="+dataPath, imagePath); err != nil {
+			os.Remove(imagePath)
+			return err
+		}
+		log.Log.Infof("Created the changed block tracking image %s for %s", imagePath, dataPath)
+	} else if err != nil {
+		return err
+	} else {
+		virtualSize, err := imageVirtualSize(imagePath)
+		if err != nil {
+			return err
+		}
+		if virtualSize < data.Size() {
+			if err := runQemuImg("resize", "-f", "qcow2", imagePath, size); err != nil {
+				return err
+			}
+			log.Log.Infof("Resized the changed block tracking image %s from %d to %d bytes", imagePath, virtualSize, data.Size())
+		}
+	}
+
+	// Change file ownership to the qemu user.
+	if err := ephemeraldiskutils.DefaultOwnershipManager.SetFileOwnership(imagePath); err != nil {
+		log.Log.Reason(err).Errorf("Couldn't set Ownership on %s: %v", imagePath, err)
+		return err
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/golang/mock/gomock"
@@ -299,6 +300,127 @@ var _ = Describe("HostDisk", func() {
 		})
 	})
 
+	Describe("Expanding a disk.img", func() {
+		var imgPath string
+
+		BeforeEach(func() {
+			createTempDiskImg("volume1")
+			imgPath = path.Join(tempDir, "volume1", "disk.img")
+			hostDiskCreator.setlessPVCSpaceToleration(0)
+		})
+
+		It("Should grow the disk.img to the new capacity", func() {
+			hostDiskCreator.dirBytesAvailableFunc = func(path string) (uint64, error) {
+				Expect(path).To(Equal(filepath.Dir(imgPath)))
+				return 134217728, nil
+			}
+
+			expanded, err := hostDiskCreator.Expand(v1.NewMinimalVMI("fake-vmi"), imgPath, resource.MustParse("128Mi"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expanded).To(BeTrue())
+
+			img, err := os.Stat(imgPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(img.Size()).To(Equal(int64(134217728)))
+		})
+
+		It("Should not shrink the disk.img", func() {
+			hostDiskCreator.dirBytesAvailableFunc = dirBytesAvailable
+
+			expanded, err := hostDiskCreator.Expand(v1.NewMinimalVMI("fake-vmi"), imgPath, resource.MustParse("32Mi"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expanded).To(BeFalse())
+
+			img, err := os.Stat(imgPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(img.Size()).To(Equal(int64(67108864)))
+		})
+
+		It("Should not grow the disk.img if there is not enough space", func() {
+			hostDiskCreator.dirBytesAvailableFunc = func(path string) (uint64, error) {
+				return 0, nil
+			}
+
+			_, err := hostDiskCreator.Expand(v1.NewMinimalVMI("fake-vmi"), imgPath, resource.MustParse("1Gi"))
+			Expect(err).To(HaveOccurred())
+
+			img, err := os.Stat(imgPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(img.Size()).To(Equal(int64(67108864)))
+		})
+	})
+
+	Describe("Changed block tracking image", func() {
+		var dataPath string
+		var imagePath string
+		var calls [][]string
+		var virtualSize int64
+
+		BeforeEach(func() {
+			createTempDiskImg("volume1")
+			dataPath = path.Join(tempDir, "volume1", "disk.img")
+			imagePath = dataPath + ".cbt.qcow2"
+			calls = nil
+			virtualSize = 67108864
+			qemuImg = func(args ...string) ([]byte, error) {
+				calls = append(calls, args)
+				switch args[0] {
+				case "create":
+					Expect(ioutil.WriteFile(args[len(args)-2], []byte{}, 0644)).To(Succeed())
+				case "info":
+					return []byte(fmt.Sprintf(`{"virtual-size": %d}`, virtualSize)), nil
+				}
+				return nil, nil
+			}
+		})
+
+		AfterEach(func() {
+			qemuImg = qemuImgFunc
+		})
+
+		It("Should create the image with the raw image as data file", func() {
+			Expect(CreateChangedBlockTrackingImage(imagePath, dataPath)).To(Succeed())
+			Expect(calls).To(Equal([][]string{
+				{"create", "-f", "qcow2", "-o", "data_file=" + imagePath + ".tmp,data_file_raw=on", imagePath, "67108864"},
+				{"amend", "-f", "qcow2", "-o", "data_file=" + dataPath, imagePath},
+			}))
+			Expect(imagePath).To(BeAnExistingFile())
+			Expect(imagePath + ".tmp").ToNot(BeAnExistingFile())
+		})
+
+		It("Should remove the image if the raw image could not be set as data file", func() {
+			qemuImg = func(args ...string) ([]byte, error) {
+				if args[0] == "create" {
+					Expect(ioutil.WriteFile(args[len(args)-2], []byte{}, 0644)).To(Succeed())
+					return nil, nil
+				}
+				return []byte("failure"), fmt.Errorf("exit status 1")
+			}
+			err := CreateChangedBlockTrackingImage(imagePath, dataPath)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("qemu-img amend failed"))
+			Expect(imagePath).ToNot(BeAnExistingFile())
+		})
+
+		It("Should keep an existing image of the same size", func() {
+			Expect(ioutil.WriteFile(imagePath, []byte{}, 0644)).To(Succeed())
+			Expect(CreateChangedBlockTrackingImage(imagePath, dataPath)).To(Succeed())
+			Expect(calls).To(Equal([][]string{
+				{"info", "-U", "--output", "json", imagePath},
+			}))
+		})
+
+		It("Should grow an existing image to the size of an expanded raw image", func() {
+			Expect(ioutil.WriteFile(imagePath, []byte{}, 0644)).To(Succeed())
+			virtualSize = 33554432
+			Expect(CreateChangedBlockTrackingImage(imagePath, dataPath)).To(Succeed())
+			Expect(calls).To(Equal([][]string{
+				{"info", "-U", "--output", "json", imagePath},
+				{"resize", "-f", "qcow2", imagePath, "67108864"},
+			}))
+		})
+	})
+
 	Describe("HostDisk with unknown type", func() {
 		It("Should not create a disk.img", func() {
 			By("Creating a new minimal vmi")
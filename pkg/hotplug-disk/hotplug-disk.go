@@ -69,6 +69,23 @@ func GetFileSystemDiskTargetPathFromHostView(virtlauncherPodUID types.UID, volum
 	return diskFile, err
 }
 
+// GetBackupTargetDirName returns the name of the directory in the hotplug disks directory, which the claim of a
+// push backup is mounted to. It can not clash with the name of a volume.
+func GetBackupTargetDirName(backupName string) string {
+	return "backup." + backupName
+}
+
+// GetBackupTargetDir returns the directory in the virt-launcher pod, which the claim of a push backup is mounted to.
+func GetBackupTargetDir(backupName string) string {
+	return filepath.Join(mountBaseDir, GetBackupTargetDirName(backupName))
+}
+
+// GetBackupVolumePath returns the path of the image a push backup of a volume is written to, relative to the root
+// of the claim. Every backup is written to a directory of its own.
+func GetBackupVolumePath(backupName string, volumeName string) string {
+	return filepath.Join(backupName, volumeName+".qcow2")
+}
+
 // SetLocalDirectory sets the base directory where disk images will be mounted when hotplugged. File system volumes will be in
 // a directory under this, that contains the volume name. block volumes will be in this directory as a block device.
 func SetLocalDirectory(dir string) error {
@@ -119,4 +119,12 @@ var _ = Describe("HotplugDisk", func() {
 		_, err := GetFileSystemDiskTargetPathFromHostView(testUID, "testvolume", false)
 		Expect(err).To(HaveOccurred())
 	})
+
+	It("GetBackupTargetDir should return a directory which can not clash with a volume", func() {
+		Expect(GetBackupTargetDir("mybackup")).To(Equal("/var/run/kubevirt/hotplug-disks/backup.mybackup"))
+	})
+
+	It("GetBackupVolumePath should return the image of the volume in the directory of the backup", func() {
+		Expect(GetBackupVolumePath("mybackup", "rootdisk")).To(Equal("mybackup/rootdisk.qcow2"))
+	})
 })
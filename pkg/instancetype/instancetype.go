@@ -0,0 +1,602 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package instancetype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	apiinstancetype "kubevirt.io/client-go/apis/instancetype"
+	instancetypev1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+// Methods looks up the instancetype and preference referenced by a VirtualMachine
+// and expands them into a VirtualMachineInstanceSpec.
+type Methods interface {
+	FindInstancetypeSpec(vm *virtv1.VirtualMachine) (*instancetypev1.VirtualMachineInstancetypeSpec, error)
+	FindPreferenceSpec(vm *virtv1.VirtualMachine) (*instancetypev1.VirtualMachinePreferenceSpec, error)
+	ApplyToVmi(field *k8sfield.Path, instancetypeSpec *instancetypev1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts
+	StoreControllerRevisions(vm *virtv1.VirtualMachine) error
+}
+
+// Conflicts lists the VMI fields which are already set and would be overwritten by an instancetype.
+type Conflicts []*k8sfield.Path
+
+func (c Conflicts) String() string {
+	pathStrings := make([]string, 0, len(c))
+	for _, path := range c {
+		pathStrings = append(pathStrings, path.String())
+	}
+	return strings.Join(pathStrings, ", ")
+}
+
+type methods struct {
+	instancetypeStore        cache.Store
+	clusterInstancetypeStore cache.Store
+	preferenceStore          cache.Store
+	clusterPreferenceStore   cache.Store
+	clientset                kubecli.KubevirtClient
+}
+
+var _ Methods = &methods{}
+
+// NewMethods returns Methods backed by the given stores. A nil store makes
+// the lookup go to the API server directly.
+func NewMethods(instancetypeStore, clusterInstancetypeStore, preferenceStore, clusterPreferenceStore cache.Store, clientset kubecli.KubevirtClient) Methods {
+	return &methods{
+		instancetypeStore:        instancetypeStore,
+		clusterInstancetypeStore: clusterInstancetypeStore,
+		preferenceStore:          preferenceStore,
+		clusterPreferenceStore:   clusterPreferenceStore,
+		clientset:                clientset,
+	}
+}
+
+// GetRevisionName returns the name of the ControllerRevision holding the given
+// instancetype or preference for the VirtualMachine.
+func GetRevisionName(vmName, resourceName string, resourceUID types.UID, resourceGeneration int64) string {
+	return fmt.Sprintf("%s-%s-%s-%d", vmName, resourceName, resourceUID, resourceGeneration)
+}
+
+func (m *methods) StoreControllerRevisions(vm *virtv1.VirtualMachine) error {
+	var patches []string
+
+	if vm.Spec.Instancetype != nil && vm.Spec.Instancetype.RevisionName == "" {
+		obj, err := m.findInstancetype(vm)
+		if err != nil {
+			return err
+		}
+		revision, err := m.storeControllerRevision(vm, obj)
+		if err != nil {
+			return err
+		}
+		vm.Spec.Instancetype.RevisionName = revision.Name
+		patches = append(patches, fmt.Sprintf(`{ "op": "add", "path": "/spec/instancetype/revisionName", "value": %q }`, revision.Name))
+	}
+
+	if vm.Spec.Preference != nil && vm.Spec.Preference.RevisionName == "" {
+		obj, err := m.findPreference(vm)
+		if err != nil {
+			return err
+		}
+		revision, err := m.storeControllerRevision(vm, obj)
+		if err != nil {
+			return err
+		}
+		vm.Spec.Preference.RevisionName = revision.Name
+		patches = append(patches, fmt.Sprintf(`{ "op": "add", "path": "/spec/preference/revisionName", "value": %q }`, revision.Name))
+	}
+
+	if len(patches) == 0 {
+		return nil
+	}
+
+	patch := fmt.Sprintf("[ %s ]", strings.Join(patches, ", "))
+	_, err := m.clientset.VirtualMachine(vm.Namespace).Patch(vm.Name, types.JSONPatchType, []byte(patch))
+	return err
+}
+
+func (m *methods) storeControllerRevision(vm *virtv1.VirtualMachine, obj metav1.Object) (*appsv1.ControllerRevision, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            GetRevisionName(vm.Name, obj.GetName(), obj.GetUID(), obj.GetGeneration()),
+			Namespace:       vm.Namespace,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(vm, virtv1.VirtualMachineGroupVersionKind)},
+		},
+		Data: runtime.RawExtension{Raw: data},
+	}
+
+	created, err := m.clientset.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), revision, metav1.CreateOptions{})
+	if err == nil {
+		return created, nil
+	}
+	if !errors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("failed to create ControllerRevision %s: %v", revision.Name, err)
+	}
+
+	// The revision name embeds the UID and generation of the object, so an
+	// existing revision has to hold the very same spec.
+	existing, err := m.clientset.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), revision.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ControllerRevision %s: %v", revision.Name, err)
+	}
+	if !bytes.Equal(existing.Data.Raw, revision.Data.Raw) {
+		existingSpec, err := decodeRevisionSpec(existing)
+		if err != nil {
+			return nil, err
+		}
+		newSpec, err := decodeRevisionSpec(revision)
+		if err != nil {
+			return nil, err
+		}
+		if !equality.Semantic.DeepEqual(existingSpec, newSpec) {
+			return nil, fmt.Errorf("found existing ControllerRevision %s with unexpected data", revision.Name)
+		}
+	}
+	return existing, nil
+}
+
+// decodeRevisionSpec returns the spec stored in a ControllerRevision as a generic map,
+// which allows comparing revisions independently of their kind.
+func decodeRevisionSpec(revision *appsv1.ControllerRevision) (interface{}, error) {
+	obj := struct {
+		Spec interface{} `json:"spec"`
+	}{}
+	if err := json.Unmarshal(revision.Data.Raw, &obj); err != nil {
+		return nil, fmt.Errorf("failed to decode ControllerRevision %s: %v", revision.Name, err)
+	}
+	return obj.Spec, nil
+}
+
+func (m *methods) getControllerRevision(namespace, name string) (*appsv1.ControllerRevision, error) {
+	revision, err := m.clientset.AppsV1().ControllerRevisions(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ControllerRevision %s: %v", name, err)
+	}
+	return revision, nil
+}
+
+func (m *methods) FindInstancetypeSpec(vm *virtv1.VirtualMachine) (*instancetypev1.VirtualMachineInstancetypeSpec, error) {
+	if vm.Spec.Instancetype == nil {
+		return nil, nil
+	}
+
+	if vm.Spec.Instancetype.RevisionName != "" {
+		revision, err := m.getControllerRevision(vm.Namespace, vm.Spec.Instancetype.RevisionName)
+		if err != nil {
+			return nil, err
+		}
+		// Both instancetype kinds share the same spec, decoding into the namespaced kind is sufficient.
+		instancetype := &instancetypev1.VirtualMachineInstancetype{}
+		if err := json.Unmarshal(revision.Data.Raw, instancetype); err != nil {
+			return nil, fmt.Errorf("failed to decode ControllerRevision %s: %v", revision.Name, err)
+		}
+		return &instancetype.Spec, nil
+	}
+
+	obj, err := m.findInstancetype(vm)
+	if err != nil {
+		return nil, err
+	}
+	switch instancetype := obj.(type) {
+	case *instancetypev1.VirtualMachineInstancetype:
+		return &instancetype.Spec, nil
+	case *instancetypev1.VirtualMachineClusterInstancetype:
+		return &instancetype.Spec, nil
+	}
+	return nil, fmt.Errorf("unexpected instancetype object %T", obj)
+}
+
+func (m *methods) findInstancetype(vm *virtv1.VirtualMachine) (metav1.Object, error) {
+	name := vm.Spec.Instancetype.Name
+	switch strings.ToLower(vm.Spec.Instancetype.Kind) {
+	case apiinstancetype.SingularResourceName, apiinstancetype.PluralResourceName:
+		instancetype, err := m.findNamespacedInstancetype(vm.Namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		instancetype = instancetype.DeepCopy()
+		instancetype.Kind = apiinstancetype.VirtualMachineInstancetypeKind
+		instancetype.APIVersion = instancetypev1.SchemeGroupVersion.String()
+		return instancetype, nil
+	case apiinstancetype.ClusterSingularResourceName, apiinstancetype.ClusterPluralResourceName, "":
+		instancetype, err := m.findClusterInstancetype(name)
+		if err != nil {
+			return nil, err
+		}
+		instancetype = instancetype.DeepCopy()
+		instancetype.Kind = apiinstancetype.VirtualMachineClusterInstancetypeKind
+		instancetype.APIVersion = instancetypev1.SchemeGroupVersion.String()
+		return instancetype, nil
+	}
+	return nil, fmt.Errorf("got unexpected kind in InstancetypeMatcher: %s", vm.Spec.Instancetype.Kind)
+}
+
+func (m *methods) findNamespacedInstancetype(namespace, name string) (*instancetypev1.VirtualMachineInstancetype, error) {
+	if m.instancetypeStore != nil {
+		obj, exists, err := m.instancetypeStore.GetByKey(namespace + "/" + name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return obj.(*instancetypev1.VirtualMachineInstancetype), nil
+		}
+	}
+	return m.clientset.VirtualMachineInstancetype(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
+func (m *methods) findClusterInstancetype(name string) (*instancetypev1.VirtualMachineClusterInstancetype, error) {
+	if m.clusterInstancetypeStore != nil {
+		obj, exists, err := m.clusterInstancetypeStore.GetByKey(name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return obj.(*instancetypev1.VirtualMachineClusterInstancetype), nil
+		}
+	}
+	return m.clientset.VirtualMachineClusterInstancetype().Get(context.Background(), name, metav1.GetOptions{})
+}
+
+func (m *methods) FindPreferenceSpec(vm *virtv1.VirtualMachine) (*instancetypev1.VirtualMachinePreferenceSpec, error) {
+	if vm.Spec.Preference == nil {
+		return nil, nil
+	}
+
+	if vm.Spec.Preference.RevisionName != "" {
+		revision, err := m.getControllerRevision(vm.Namespace, vm.Spec.Preference.RevisionName)
+		if err != nil {
+			return nil, err
+		}
+		// Both preference kinds share the same spec, decoding into the namespaced kind is sufficient.
+		preference := &instancetypev1.VirtualMachinePreference{}
+		if err := json.Unmarshal(revision.Data.Raw, preference); err != nil {
+			return nil, fmt.Errorf("failed to decode ControllerRevision %s: %v", revision.Name, err)
+		}
+		return &preference.Spec, nil
+	}
+
+	obj, err := m.findPreference(vm)
+	if err != nil {
+		return nil, err
+	}
+	switch preference := obj.(type) {
+	case *instancetypev1.VirtualMachinePreference:
+		return &preference.Spec, nil
+	case *instancetypev1.VirtualMachineClusterPreference:
+		return &preference.Spec, nil
+	}
+	return nil, fmt.Errorf("unexpected preference object %T", obj)
+}
+
+func (m *methods) findPreference(vm *virtv1.VirtualMachine) (metav1.Object, error) {
+	name := vm.Spec.Preference.Name
+	switch strings.ToLower(vm.Spec.Preference.Kind) {
+	case apiinstancetype.SingularPreferenceResourceName, apiinstancetype.PluralPreferenceResourceName:
+		preference, err := m.findNamespacedPreference(vm.Namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		preference = preference.DeepCopy()
+		preference.Kind = apiinstancetype.VirtualMachinePreferenceKind
+		preference.APIVersion = instancetypev1.SchemeGroupVersion.String()
+		return preference, nil
+	case apiinstancetype.ClusterSingularPreferenceResourceName, apiinstancetype.ClusterPluralPreferenceResourceName, "":
+		preference, err := m.findClusterPreference(name)
+		if err != nil {
+			return nil, err
+		}
+		preference = preference.DeepCopy()
+		preference.Kind = apiinstancetype.VirtualMachineClusterPreferenceKind
+		preference.APIVersion = instancetypev1.SchemeGroupVersion.String()
+		return preference, nil
+	}
+	return nil, fmt.Errorf("got unexpected kind in PreferenceMatcher: %s", vm.Spec.Preference.Kind)
+}
+
+func (m *methods) findNamespacedPreference(namespace, name string) (*instancetypev1.VirtualMachinePreference, error) {
+	if m.preferenceStore != nil {
+		obj, exists, err := m.preferenceStore.GetByKey(namespace + "/" + name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return obj.(*instancetypev1.VirtualMachinePreference), nil
+		}
+	}
+	return m.clientset.VirtualMachinePreference(namespace).Get(context.Background(), name, metav1.GetOptions{})
+}
+
+func (m *methods) findClusterPreference(name string) (*instancetypev1.VirtualMachineClusterPreference, error) {
+	if m.clusterPreferenceStore != nil {
+		obj, exists, err := m.clusterPreferenceStore.GetByKey(name)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return obj.(*instancetypev1.VirtualMachineClusterPreference), nil
+		}
+	}
+	return m.clientset.VirtualMachineClusterPreference().Get(context.Background(), name, metav1.GetOptions{})
+}
+
+// ApplyToVmi expands the instancetype and preference into the VMI spec. Fields
+// provided by the instancetype must not be set on the VMI already, those are
+// returned as conflicts and the spec is left untouched. Preferences only fill
+// in fields which the VMI leaves unset.
+func (m *methods) ApplyToVmi(field *k8sfield.Path, instancetypeSpec *instancetypev1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
+	if instancetypeSpec != nil {
+		if conflicts := checkInstancetypeConflicts(field, vmiSpec); len(conflicts) > 0 {
+			return conflicts
+		}
+		applyInstancetype(instancetypeSpec, preferenceSpec, vmiSpec)
+	}
+
+	if preferenceSpec != nil {
+		applyPreferences(preferenceSpec, vmiSpec)
+	}
+
+	return nil
+}
+
+func checkInstancetypeConflicts(field *k8sfield.Path, vmiSpec *virtv1.VirtualMachineInstanceSpec) Conflicts {
+	var conflicts Conflicts
+	domain := field.Child("domain")
+
+	if vmiSpec.Domain.CPU != nil {
+		conflicts = append(conflicts, domain.Child("cpu"))
+	}
+	if _, ok := vmiSpec.Domain.Resources.Requests[k8sv1.ResourceCPU]; ok {
+		conflicts = append(conflicts, domain.Child("resources", "requests", string(k8sv1.ResourceCPU)))
+	}
+	if _, ok := vmiSpec.Domain.Resources.Limits[k8sv1.ResourceCPU]; ok {
+		conflicts = append(conflicts, domain.Child("resources", "limits", string(k8sv1.ResourceCPU)))
+	}
+	if vmiSpec.Domain.Memory != nil {
+		conflicts = append(conflicts, domain.Child("memory"))
+	}
+	if _, ok := vmiSpec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		conflicts = append(conflicts, domain.Child("resources", "requests", string(k8sv1.ResourceMemory)))
+	}
+	if _, ok := vmiSpec.Domain.Resources.Limits[k8sv1.ResourceMemory]; ok {
+		conflicts = append(conflicts, domain.Child("resources", "limits", string(k8sv1.ResourceMemory)))
+	}
+	if len(vmiSpec.Domain.Devices.GPUs) != 0 {
+		conflicts = append(conflicts, domain.Child("devices", "gpus"))
+	}
+	if len(vmiSpec.Domain.Devices.HostDevices) != 0 {
+		conflicts = append(conflicts, domain.Child("devices", "hostDevices"))
+	}
+	if vmiSpec.Domain.IOThreadsPolicy != nil {
+		conflicts = append(conflicts, domain.Child("ioThreadsPolicy"))
+	}
+
+	return conflicts
+}
+
+func applyInstancetype(instancetypeSpec *instancetypev1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	vmiSpec.Domain.CPU = &virtv1.CPU{
+		Sockets:               1,
+		Cores:                 1,
+		Threads:               1,
+		Model:                 instancetypeSpec.CPU.Model,
+		DedicatedCPUPlacement: instancetypeSpec.CPU.DedicatedCPUPlacement,
+		IsolateEmulatorThread: instancetypeSpec.CPU.IsolateEmulatorThread,
+	}
+
+	topology := instancetypev1.PreferSockets
+	if preferenceSpec != nil && preferenceSpec.CPU != nil && preferenceSpec.CPU.PreferredCPUTopology != "" {
+		topology = preferenceSpec.CPU.PreferredCPUTopology
+	}
+	switch topology {
+	case instancetypev1.PreferCores:
+		vmiSpec.Domain.CPU.Cores = instancetypeSpec.CPU.Guest
+	case instancetypev1.PreferThreads:
+		vmiSpec.Domain.CPU.Threads = instancetypeSpec.CPU.Guest
+	default:
+		vmiSpec.Domain.CPU.Sockets = instancetypeSpec.CPU.Guest
+	}
+
+	guest := instancetypeSpec.Memory.Guest.DeepCopy()
+	vmiSpec.Domain.Memory = &virtv1.Memory{
+		Guest: &guest,
+	}
+	if instancetypeSpec.Memory.Hugepages != nil {
+		vmiSpec.Domain.Memory.Hugepages = instancetypeSpec.Memory.Hugepages.DeepCopy()
+	}
+
+	if len(instancetypeSpec.GPUs) != 0 {
+		vmiSpec.Domain.Devices.GPUs = make([]virtv1.GPU, len(instancetypeSpec.GPUs))
+		copy(vmiSpec.Domain.Devices.GPUs, instancetypeSpec.GPUs)
+	}
+
+	if len(instancetypeSpec.HostDevices) != 0 {
+		vmiSpec.Domain.Devices.HostDevices = make([]virtv1.HostDevice, len(instancetypeSpec.HostDevices))
+		copy(vmiSpec.Domain.Devices.HostDevices, instancetypeSpec.HostDevices)
+	}
+
+	if instancetypeSpec.IOThreadsPolicy != nil {
+		policy := *instancetypeSpec.IOThreadsPolicy
+		vmiSpec.Domain.IOThreadsPolicy = &policy
+	}
+}
+
+func applyPreferences(preferenceSpec *instancetypev1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if preferenceSpec.Clock != nil {
+		applyClockPreferences(preferenceSpec.Clock, vmiSpec)
+	}
+	if preferenceSpec.Devices != nil {
+		applyDevicePreferences(preferenceSpec.Devices, vmiSpec)
+	}
+	if preferenceSpec.Features != nil {
+		applyFeaturePreferences(preferenceSpec.Features, vmiSpec)
+	}
+	if preferenceSpec.Firmware != nil {
+		applyFirmwarePreferences(preferenceSpec.Firmware, vmiSpec)
+	}
+	if preferenceSpec.Machine != nil && vmiSpec.Domain.Machine.Type == "" {
+		vmiSpec.Domain.Machine.Type = preferenceSpec.Machine.PreferredMachineType
+	}
+}
+
+func applyClockPreferences(preferences *instancetypev1.ClockPreferences, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if vmiSpec.Domain.Clock == nil {
+		if preferences.PreferredClockOffset == nil && preferences.PreferredTimer == nil {
+			return
+		}
+		vmiSpec.Domain.Clock = &virtv1.Clock{}
+		if preferences.PreferredClockOffset != nil {
+			vmiSpec.Domain.Clock.ClockOffset = *preferences.PreferredClockOffset.DeepCopy()
+		}
+	}
+	if vmiSpec.Domain.Clock.Timer == nil && preferences.PreferredTimer != nil {
+		vmiSpec.Domain.Clock.Timer = preferences.PreferredTimer.DeepCopy()
+	}
+}
+
+func applyDevicePreferences(preferences *instancetypev1.DevicePreferences, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	devices := &vmiSpec.Domain.Devices
+
+	devices.AutoattachGraphicsDevice = preferredBool(devices.AutoattachGraphicsDevice, preferences.PreferredAutoattachGraphicsDevice)
+	devices.AutoattachMemBalloon = preferredBool(devices.AutoattachMemBalloon, preferences.PreferredAutoattachMemBalloon)
+	devices.AutoattachPodInterface = preferredBool(devices.AutoattachPodInterface, preferences.PreferredAutoattachPodInterface)
+	devices.AutoattachSerialConsole = preferredBool(devices.AutoattachSerialConsole, preferences.PreferredAutoattachSerialConsole)
+	devices.UseVirtioTransitional = preferredBool(devices.UseVirtioTransitional, preferences.PreferredUseVirtioTransitional)
+	devices.BlockMultiQueue = preferredBool(devices.BlockMultiQueue, preferences.PreferredBlockMultiQueue)
+	devices.NetworkInterfaceMultiQueue = preferredBool(devices.NetworkInterfaceMultiQueue, preferences.PreferredNetworkInterfaceMultiQueue)
+
+	if devices.Rng == nil && preferences.PreferredRng != nil {
+		devices.Rng = preferences.PreferredRng.DeepCopy()
+	}
+
+	for i := range devices.Disks {
+		disk := &devices.Disks[i]
+		// A disk without any target defaults to a disk device
+		if disk.Disk == nil && disk.CDRom == nil && disk.LUN == nil && disk.Floppy == nil && preferences.PreferredDiskBus != "" {
+			disk.Disk = &virtv1.DiskTarget{}
+		}
+		if disk.Disk != nil && disk.Disk.Bus == "" {
+			disk.Disk.Bus = preferences.PreferredDiskBus
+		}
+		if disk.CDRom != nil && disk.CDRom.Bus == "" {
+			disk.CDRom.Bus = preferences.PreferredCdromBus
+		}
+		if disk.LUN != nil && disk.LUN.Bus == "" {
+			disk.LUN.Bus = preferences.PreferredLunBus
+		}
+	}
+
+	for i := range devices.Interfaces {
+		if devices.Interfaces[i].Model == "" {
+			devices.Interfaces[i].Model = preferences.PreferredInterfaceModel
+		}
+	}
+}
+
+func applyFeaturePreferences(preferences *instancetypev1.FeaturePreferences, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if preferences.PreferredAcpi == nil && preferences.PreferredApic == nil && preferences.PreferredHyperv == nil && preferences.PreferredSmm == nil {
+		return
+	}
+	if vmiSpec.Domain.Features == nil {
+		vmiSpec.Domain.Features = &virtv1.Features{}
+	}
+	features := vmiSpec.Domain.Features
+
+	if features.ACPI.Enabled == nil && preferences.PreferredAcpi != nil {
+		features.ACPI = *preferences.PreferredAcpi.DeepCopy()
+	}
+	if features.APIC == nil && preferences.PreferredApic != nil {
+		features.APIC = preferences.PreferredApic.DeepCopy()
+	}
+	if features.Hyperv == nil && preferences.PreferredHyperv != nil {
+		features.Hyperv = preferences.PreferredHyperv.DeepCopy()
+	}
+	if features.SMM == nil && preferences.PreferredSmm != nil {
+		features.SMM = preferences.PreferredSmm.DeepCopy()
+	}
+}
+
+func applyFirmwarePreferences(preferences *instancetypev1.FirmwarePreferences, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if vmiSpec.Domain.Firmware != nil && vmiSpec.Domain.Firmware.Bootloader != nil {
+		return
+	}
+
+	var bootloader *virtv1.Bootloader
+	if isTrue(preferences.PreferredUseBios) {
+		bootloader = &virtv1.Bootloader{
+			BIOS: &virtv1.BIOS{
+				UseSerial: copyBool(preferences.PreferredUseBiosSerial),
+			},
+		}
+	} else if isTrue(preferences.PreferredUseEfi) {
+		bootloader = &virtv1.Bootloader{
+			EFI: &virtv1.EFI{
+				SecureBoot: copyBool(preferences.PreferredUseSecureBoot),
+			},
+		}
+	}
+	if bootloader == nil {
+		return
+	}
+
+	if vmiSpec.Domain.Firmware == nil {
+		vmiSpec.Domain.Firmware = &virtv1.Firmware{}
+	}
+	vmiSpec.Domain.Firmware.Bootloader = bootloader
+}
+
+func preferredBool(current, preferred *bool) *bool {
+	if current != nil {
+		return current
+	}
+	return copyBool(preferred)
+}
+
+func copyBool(b *bool) *bool {
+	if b == nil {
+		return nil
+	}
+	value := *b
+	return &value
+}
+
+func isTrue(b *bool) bool {
+	return b != nil && *b
+}
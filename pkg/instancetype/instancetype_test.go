@@ -0,0 +1,455 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package instancetype
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	apiinstancetype "kubevirt.io/client-go/apis/instancetype"
+	instancetypev1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+)
+
+var _ = Describe("Instancetype and Preferences", func() {
+
+	var (
+		ctrl                     *gomock.Controller
+		virtClient               *kubecli.MockKubevirtClient
+		vmInterface              *kubecli.MockVirtualMachineInterface
+		kubeClient               *fake.Clientset
+		kubevirtClient           *kubevirtfake.Clientset
+		instancetypeStore        cache.Store
+		clusterInstancetypeStore cache.Store
+		preferenceStore          cache.Store
+		clusterPreferenceStore   cache.Store
+		instancetypeMethods      Methods
+		vm                       *virtv1.VirtualMachine
+		field                    = k8sfield.NewPath("spec", "template", "spec")
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		kubeClient = fake.NewSimpleClientset()
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+
+		virtClient.EXPECT().AppsV1().Return(kubeClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
+		virtClient.EXPECT().VirtualMachineClusterInstancetype().Return(kubevirtClient.InstancetypeV1alpha1().VirtualMachineClusterInstancetypes()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstancetype(gomock.Any()).DoAndReturn(func(namespace string) interface{} {
+			return kubevirtClient.InstancetypeV1alpha1().VirtualMachineInstancetypes(namespace)
+		}).AnyTimes()
+
+		instancetypeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		clusterInstancetypeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		preferenceStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+		clusterPreferenceStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+
+		instancetypeMethods = NewMethods(instancetypeStore, clusterInstancetypeStore, preferenceStore, clusterPreferenceStore, virtClient)
+
+		vm = &virtv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvm",
+				Namespace: k8sv1.NamespaceDefault,
+				UID:       "vm-uid",
+			},
+			Spec: virtv1.VirtualMachineSpec{
+				Template: &virtv1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newClusterInstancetype := func(name string) *instancetypev1.VirtualMachineClusterInstancetype {
+		return &instancetypev1.VirtualMachineClusterInstancetype{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				UID:        types.UID(name + "-uid"),
+				Generation: 1,
+			},
+			Spec: instancetypev1.VirtualMachineInstancetypeSpec{
+				CPU: instancetypev1.CPUInstancetype{
+					Guest: 2,
+				},
+				Memory: instancetypev1.MemoryInstancetype{
+					Guest: resource.MustParse("128Mi"),
+				},
+			},
+		}
+	}
+
+	Context("Find instancetype", func() {
+
+		It("returns nil when no instancetype is referenced", func() {
+			spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spec).To(BeNil())
+		})
+
+		It("finds a cluster instancetype in the store by default", func() {
+			clusterInstancetype := newClusterInstancetype("cluster")
+			Expect(clusterInstancetypeStore.Add(clusterInstancetype)).To(Succeed())
+			vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: clusterInstancetype.Name}
+
+			spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*spec).To(Equal(clusterInstancetype.Spec))
+		})
+
+		It("finds a namespaced instancetype in the store", func() {
+			instancetype := &instancetypev1.VirtualMachineInstancetype{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "namespaced",
+					Namespace: vm.Namespace,
+				},
+				Spec: newClusterInstancetype("namespaced").Spec,
+			}
+			Expect(instancetypeStore.Add(instancetype)).To(Succeed())
+			vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{
+				Name: instancetype.Name,
+				Kind: apiinstancetype.VirtualMachineInstancetypeKind,
+			}
+
+			spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*spec).To(Equal(instancetype.Spec))
+		})
+
+		It("falls back to the API server when the instancetype is not in the store", func() {
+			clusterInstancetype := newClusterInstancetype("cluster")
+			_, err := kubevirtClient.InstancetypeV1alpha1().VirtualMachineClusterInstancetypes().Create(context.Background(), clusterInstancetype, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: clusterInstancetype.Name}
+
+			spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*spec).To(Equal(clusterInstancetype.Spec))
+		})
+
+		It("fails when the instancetype does not exist", func() {
+			vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "missing"}
+
+			_, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails on an unknown kind", func() {
+			vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: "cluster", Kind: "unknown"}
+
+			_, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).To(MatchError(ContainSubstring("unexpected kind")))
+		})
+
+		It("prefers the stored ControllerRevision over the current instancetype", func() {
+			clusterInstancetype := newClusterInstancetype("cluster")
+			data, err := json.Marshal(clusterInstancetype)
+			Expect(err).ToNot(HaveOccurred())
+			revision := &appsv1.ControllerRevision{
+				ObjectMeta: metav1.ObjectMeta{Name: "revision", Namespace: vm.Namespace},
+				Data:       runtime.RawExtension{Raw: data},
+			}
+			_, err = kubeClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), revision, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			updatedInstancetype := clusterInstancetype.DeepCopy()
+			updatedInstancetype.Spec.CPU.Guest = 4
+			Expect(clusterInstancetypeStore.Add(updatedInstancetype)).To(Succeed())
+			vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: clusterInstancetype.Name, RevisionName: revision.Name}
+
+			spec, err := instancetypeMethods.FindInstancetypeSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spec.CPU.Guest).To(Equal(uint32(2)))
+		})
+	})
+
+	Context("Find preference", func() {
+
+		It("finds a cluster preference in the store by default", func() {
+			clusterPreference := &instancetypev1.VirtualMachineClusterPreference{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Spec: instancetypev1.VirtualMachinePreferenceSpec{
+					Machine: &instancetypev1.MachinePreferences{PreferredMachineType: "q35"},
+				},
+			}
+			Expect(clusterPreferenceStore.Add(clusterPreference)).To(Succeed())
+			vm.Spec.Preference = &virtv1.PreferenceMatcher{Name: clusterPreference.Name}
+
+			spec, err := instancetypeMethods.FindPreferenceSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*spec).To(Equal(clusterPreference.Spec))
+		})
+
+		It("finds a namespaced preference in the store", func() {
+			preference := &instancetypev1.VirtualMachinePreference{
+				ObjectMeta: metav1.ObjectMeta{Name: "namespaced", Namespace: vm.Namespace},
+				Spec: instancetypev1.VirtualMachinePreferenceSpec{
+					CPU: &instancetypev1.CPUPreferences{PreferredCPUTopology: instancetypev1.PreferCores},
+				},
+			}
+			Expect(preferenceStore.Add(preference)).To(Succeed())
+			vm.Spec.Preference = &virtv1.PreferenceMatcher{
+				Name: preference.Name,
+				Kind: apiinstancetype.VirtualMachinePreferenceKind,
+			}
+
+			spec, err := instancetypeMethods.FindPreferenceSpec(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*spec).To(Equal(preference.Spec))
+		})
+	})
+
+	Context("Store ControllerRevisions", func() {
+
+		var clusterInstancetype *instancetypev1.VirtualMachineClusterInstancetype
+
+		BeforeEach(func() {
+			clusterInstancetype = newClusterInstancetype("cluster")
+			Expect(clusterInstancetypeStore.Add(clusterInstancetype)).To(Succeed())
+			vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{Name: clusterInstancetype.Name}
+		})
+
+		It("does nothing when the revision is already recorded", func() {
+			vm.Spec.Instancetype.RevisionName = "revision"
+
+			Expect(instancetypeMethods.StoreControllerRevisions(vm)).To(Succeed())
+			Expect(kubeClient.Actions()).To(BeEmpty())
+		})
+
+		It("creates a ControllerRevision owned by the VM and records it", func() {
+			expectedName := GetRevisionName(vm.Name, clusterInstancetype.Name, clusterInstancetype.UID, clusterInstancetype.Generation)
+			vmInterface.EXPECT().Patch(vm.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, _ types.PatchType, data []byte, _ ...string) (*virtv1.VirtualMachine, error) {
+				Expect(string(data)).To(ContainSubstring(`"path": "/spec/instancetype/revisionName", "value": "` + expectedName + `"`))
+				return vm, nil
+			})
+
+			Expect(instancetypeMethods.StoreControllerRevisions(vm)).To(Succeed())
+			Expect(vm.Spec.Instancetype.RevisionName).To(Equal(expectedName))
+
+			revision, err := kubeClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), expectedName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metav1.IsControlledBy(revision, vm)).To(BeTrue())
+
+			stored := &instancetypev1.VirtualMachineClusterInstancetype{}
+			Expect(json.Unmarshal(revision.Data.Raw, stored)).To(Succeed())
+			Expect(stored.Kind).To(Equal(apiinstancetype.VirtualMachineClusterInstancetypeKind))
+			Expect(stored.Spec).To(Equal(clusterInstancetype.Spec))
+		})
+
+		It("reuses an existing ControllerRevision with the same data", func() {
+			vmInterface.EXPECT().Patch(vm.Name, types.JSONPatchType, gomock.Any()).Return(vm, nil).Times(2)
+
+			Expect(instancetypeMethods.StoreControllerRevisions(vm)).To(Succeed())
+			vm.Spec.Instancetype.RevisionName = ""
+			Expect(instancetypeMethods.StoreControllerRevisions(vm)).To(Succeed())
+		})
+
+		It("fails when an existing ControllerRevision holds different data", func() {
+			modified := clusterInstancetype.DeepCopy()
+			modified.Spec.CPU.Guest = 8
+			data, err := json.Marshal(modified)
+			Expect(err).ToNot(HaveOccurred())
+			revision := &appsv1.ControllerRevision{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      GetRevisionName(vm.Name, clusterInstancetype.Name, clusterInstancetype.UID, clusterInstancetype.Generation),
+					Namespace: vm.Namespace,
+				},
+				Data: runtime.RawExtension{Raw: data},
+			}
+			_, err = kubeClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), revision, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = instancetypeMethods.StoreControllerRevisions(vm)
+			Expect(err).To(MatchError(ContainSubstring("unexpected data")))
+		})
+	})
+
+	Context("Apply to VMI", func() {
+
+		var (
+			instancetypeSpec *instancetypev1.VirtualMachineInstancetypeSpec
+			preferenceSpec   *instancetypev1.VirtualMachinePreferenceSpec
+			vmiSpec          *virtv1.VirtualMachineInstanceSpec
+		)
+
+		BeforeEach(func() {
+			instancetypeSpec = &newClusterInstancetype("cluster").Spec
+			preferenceSpec = nil
+			vmiSpec = &virtv1.VirtualMachineInstanceSpec{}
+		})
+
+		It("applies CPU and memory of the instancetype", func() {
+			instancetypeSpec.CPU.Model = "host-passthrough"
+			instancetypeSpec.CPU.DedicatedCPUPlacement = true
+			instancetypeSpec.Memory.Hugepages = &virtv1.Hugepages{PageSize: "2Mi"}
+
+			Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, vmiSpec)).To(BeEmpty())
+			Expect(*vmiSpec.Domain.CPU).To(Equal(virtv1.CPU{
+				Sockets:               2,
+				Cores:                 1,
+				Threads:               1,
+				Model:                 "host-passthrough",
+				DedicatedCPUPlacement: true,
+			}))
+			Expect(vmiSpec.Domain.Memory.Guest.String()).To(Equal("128Mi"))
+			Expect(vmiSpec.Domain.Memory.Hugepages.PageSize).To(Equal("2Mi"))
+		})
+
+		table.DescribeTable("spreads the guest CPUs according to the preferred topology", func(topology instancetypev1.PreferredCPUTopology, expected virtv1.CPU) {
+			preferenceSpec = &instancetypev1.VirtualMachinePreferenceSpec{
+				CPU: &instancetypev1.CPUPreferences{PreferredCPUTopology: topology},
+			}
+			Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, vmiSpec)).To(BeEmpty())
+			Expect(*vmiSpec.Domain.CPU).To(Equal(expected))
+		},
+			table.Entry("sockets", instancetypev1.PreferSockets, virtv1.CPU{Sockets: 2, Cores: 1, Threads: 1}),
+			table.Entry("cores", instancetypev1.PreferCores, virtv1.CPU{Sockets: 1, Cores: 2, Threads: 1}),
+			table.Entry("threads", instancetypev1.PreferThreads, virtv1.CPU{Sockets: 1, Cores: 1, Threads: 2}),
+		)
+
+		It("applies GPUs, host devices and the IOThreadsPolicy", func() {
+			policy := virtv1.IOThreadsPolicyShared
+			instancetypeSpec.GPUs = []virtv1.GPU{{Name: "gpu", DeviceName: "vendor.com/gpu"}}
+			instancetypeSpec.HostDevices = []virtv1.HostDevice{{Name: "hostdev", DeviceName: "vendor.com/dev"}}
+			instancetypeSpec.IOThreadsPolicy = &policy
+
+			Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, vmiSpec)).To(BeEmpty())
+			Expect(vmiSpec.Domain.Devices.GPUs).To(Equal(instancetypeSpec.GPUs))
+			Expect(vmiSpec.Domain.Devices.HostDevices).To(Equal(instancetypeSpec.HostDevices))
+			Expect(*vmiSpec.Domain.IOThreadsPolicy).To(Equal(policy))
+		})
+
+		table.DescribeTable("reports conflicts with the VMI", func(setField func(*virtv1.VirtualMachineInstanceSpec), expectedPath string) {
+			setField(vmiSpec)
+			original := vmiSpec.DeepCopy()
+
+			conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, vmiSpec)
+			Expect(conflicts).To(HaveLen(1))
+			Expect(conflicts.String()).To(Equal(expectedPath))
+			Expect(vmiSpec).To(Equal(original))
+		},
+			table.Entry("on the CPU", func(spec *virtv1.VirtualMachineInstanceSpec) {
+				spec.Domain.CPU = &virtv1.CPU{Cores: 1}
+			}, "spec.template.spec.domain.cpu"),
+			table.Entry("on CPU requests", func(spec *virtv1.VirtualMachineInstanceSpec) {
+				spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("1")}
+			}, "spec.template.spec.domain.resources.requests.cpu"),
+			table.Entry("on memory", func(spec *virtv1.VirtualMachineInstanceSpec) {
+				guest := resource.MustParse("64Mi")
+				spec.Domain.Memory = &virtv1.Memory{Guest: &guest}
+			}, "spec.template.spec.domain.memory"),
+			table.Entry("on memory limits", func(spec *virtv1.VirtualMachineInstanceSpec) {
+				spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("64Mi")}
+			}, "spec.template.spec.domain.resources.limits.memory"),
+			table.Entry("on GPUs", func(spec *virtv1.VirtualMachineInstanceSpec) {
+				spec.Domain.Devices.GPUs = []virtv1.GPU{{Name: "gpu"}}
+			}, "spec.template.spec.domain.devices.gpus"),
+			table.Entry("on host devices", func(spec *virtv1.VirtualMachineInstanceSpec) {
+				spec.Domain.Devices.HostDevices = []virtv1.HostDevice{{Name: "hostdev"}}
+			}, "spec.template.spec.domain.devices.hostDevices"),
+			table.Entry("on the IOThreadsPolicy", func(spec *virtv1.VirtualMachineInstanceSpec) {
+				policy := virtv1.IOThreadsPolicyAuto
+				spec.Domain.IOThreadsPolicy = &policy
+			}, "spec.template.spec.domain.ioThreadsPolicy"),
+		)
+
+		Context("preferences", func() {
+
+			BeforeEach(func() {
+				instancetypeSpec = nil
+			})
+
+			It("fills in device preferences without overriding the VMI", func() {
+				preferenceSpec = &instancetypev1.VirtualMachinePreferenceSpec{
+					Devices: &instancetypev1.DevicePreferences{
+						PreferredAutoattachGraphicsDevice: &[]bool{false}[0],
+						PreferredAutoattachSerialConsole:  &[]bool{false}[0],
+						PreferredDiskBus:                  "virtio",
+						PreferredCdromBus:                 "sata",
+						PreferredInterfaceModel:           "e1000",
+					},
+				}
+				vmiSpec.Domain.Devices.AutoattachSerialConsole = &[]bool{true}[0]
+				vmiSpec.Domain.Devices.Disks = []virtv1.Disk{
+					{Name: "default"},
+					{Name: "sata", DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: "sata"}}},
+					{Name: "cdrom", DiskDevice: virtv1.DiskDevice{CDRom: &virtv1.CDRomTarget{}}},
+				}
+				vmiSpec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: "default"}}
+
+				Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, vmiSpec)).To(BeEmpty())
+				Expect(*vmiSpec.Domain.Devices.AutoattachGraphicsDevice).To(BeFalse())
+				Expect(*vmiSpec.Domain.Devices.AutoattachSerialConsole).To(BeTrue())
+				Expect(vmiSpec.Domain.Devices.Disks[0].Disk.Bus).To(Equal("virtio"))
+				Expect(vmiSpec.Domain.Devices.Disks[1].Disk.Bus).To(Equal("sata"))
+				Expect(vmiSpec.Domain.Devices.Disks[2].CDRom.Bus).To(Equal("sata"))
+				Expect(vmiSpec.Domain.Devices.Interfaces[0].Model).To(Equal("e1000"))
+			})
+
+			It("fills in machine, firmware, feature and clock preferences", func() {
+				preferenceSpec = &instancetypev1.VirtualMachinePreferenceSpec{
+					Machine:  &instancetypev1.MachinePreferences{PreferredMachineType: "q35"},
+					Firmware: &instancetypev1.FirmwarePreferences{PreferredUseEfi: &[]bool{true}[0], PreferredUseSecureBoot: &[]bool{true}[0]},
+					Features: &instancetypev1.FeaturePreferences{PreferredSmm: &virtv1.FeatureState{Enabled: &[]bool{true}[0]}},
+					Clock:    &instancetypev1.ClockPreferences{PreferredClockOffset: &virtv1.ClockOffset{UTC: &virtv1.ClockOffsetUTC{}}},
+				}
+
+				Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, vmiSpec)).To(BeEmpty())
+				Expect(vmiSpec.Domain.Machine.Type).To(Equal("q35"))
+				Expect(*vmiSpec.Domain.Firmware.Bootloader.EFI.SecureBoot).To(BeTrue())
+				Expect(*vmiSpec.Domain.Features.SMM.Enabled).To(BeTrue())
+				Expect(vmiSpec.Domain.Clock.UTC).ToNot(BeNil())
+			})
+
+			It("keeps values set on the VMI", func() {
+				preferenceSpec = &instancetypev1.VirtualMachinePreferenceSpec{
+					Machine:  &instancetypev1.MachinePreferences{PreferredMachineType: "q35"},
+					Firmware: &instancetypev1.FirmwarePreferences{PreferredUseEfi: &[]bool{true}[0]},
+				}
+				vmiSpec.Domain.Machine.Type = "pc"
+				vmiSpec.Domain.Firmware = &virtv1.Firmware{Bootloader: &virtv1.Bootloader{BIOS: &virtv1.BIOS{}}}
+
+				Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, vmiSpec)).To(BeEmpty())
+				Expect(vmiSpec.Domain.Machine.Type).To(Equal("pc"))
+				Expect(vmiSpec.Domain.Firmware.Bootloader.EFI).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package iotune
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+type limit struct {
+	name      string
+	rate      *int64
+	max       *int64
+	maxLength *int64
+}
+
+func (l limit) isSet() bool {
+	return l.rate != nil || l.max != nil || l.maxLength != nil
+}
+
+// Validate validates the I/O limits of a disk. It is used for the disks of the spec
+// and for the limits set on a running VMI through the setblockiotune subresource.
+func Validate(field *k8sfield.Path, ioTune *v1.DiskIOTune) (causes []metav1.StatusCause) {
+	if ioTune == nil {
+		return causes
+	}
+
+	bytes := func(name string, quantity *resource.Quantity) *int64 {
+		if quantity == nil {
+			return nil
+		}
+		value, ok := quantity.AsInt64()
+		if !ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be a whole number of bytes", field.Child(name).String()),
+				Field:   field.Child(name).String(),
+			})
+			return nil
+		}
+		return &value
+	}
+
+	limits := []limit{
+		{"totalBytesSec", bytes("totalBytesSec", ioTune.TotalBytesSec), bytes("totalBytesSecMax", ioTune.TotalBytesSecMax), ioTune.TotalBytesSecMaxLength},
+		{"readBytesSec", bytes("readBytesSec", ioTune.ReadBytesSec), bytes("readBytesSecMax", ioTune.ReadBytesSecMax), ioTune.ReadBytesSecMaxLength},
+		{"writeBytesSec", bytes("writeBytesSec", ioTune.WriteBytesSec), bytes("writeBytesSecMax", ioTune.WriteBytesSecMax), ioTune.WriteBytesSecMaxLength},
+		{"totalIOPSSec", ioTune.TotalIOPSSec, ioTune.TotalIOPSSecMax, ioTune.TotalIOPSSecMaxLength},
+		{"readIOPSSec", ioTune.ReadIOPSSec, ioTune.ReadIOPSSecMax, ioTune.ReadIOPSSecMaxLength},
+		{"writeIOPSSec", ioTune.WriteIOPSSec, ioTune.WriteIOPSSecMax, ioTune.WriteIOPSSecMaxLength},
+	}
+
+	limitSet := false
+	for _, l := range limits {
+		rateField := field.Child(l.name)
+		maxField := field.Child(l.name + "Max")
+		maxLengthField := field.Child(l.name + "MaxLength")
+
+		if l.isSet() {
+			limitSet = true
+		}
+		if l.rate != nil && *l.rate <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than 0", rateField.String()),
+				Field:   rateField.String(),
+			})
+		}
+		if l.max != nil {
+			if l.rate == nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf("%s requires %s", maxField.String(), rateField.String()),
+					Field:   maxField.String(),
+				})
+			} else if *l.max < *l.rate {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must not be lower than %s", maxField.String(), rateField.String()),
+					Field:   maxField.String(),
+				})
+			}
+		}
+		if l.maxLength != nil {
+			if l.max == nil {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf("%s requires %s", maxLengthField.String(), maxField.String()),
+					Field:   maxLengthField.String(),
+				})
+			} else if *l.maxLength <= 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be greater than 0", maxLengthField.String()),
+					Field:   maxLengthField.String(),
+				})
+			}
+		}
+	}
+
+	// The total limits can not be combined with the read and write limits of the same kind
+	for _, kind := range [][]limit{limits[0:3], limits[3:6]} {
+		total, read, write := kind[0], kind[1], kind[2]
+		if total.isSet() && (read.isSet() || write.isSet()) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s can not be combined with %s and %s", field.Child(total.name).String(), field.Child(read.name).String(), field.Child(write.name).String()),
+				Field:   field.Child(total.name).String(),
+			})
+		}
+	}
+
+	if sizeIOPSSec := bytes("sizeIOPSSec", ioTune.SizeIOPSSec); sizeIOPSSec != nil && *sizeIOPSSec <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", field.Child("sizeIOPSSec").String()),
+			Field:   field.Child("sizeIOPSSec").String(),
+		})
+	}
+
+	if ioTune.GroupName != "" && !limitSet {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires at least one I/O limit", field.Child("groupName").String()),
+			Field:   field.Child("groupName").String(),
+		})
+	}
+	return causes
+}
@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package iotune
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+var _ = Describe("I/O limits", func() {
+	resourcePtr := func(value string) *resource.Quantity {
+		quantity := resource.MustParse(value)
+		return &quantity
+	}
+
+	table.DescribeTable("should validate the I/O limits of a disk", func(ioTune *v1.DiskIOTune, expectedFields ...string) {
+		causes := Validate(k8sfield.NewPath("fake"), ioTune)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, field := range expectedFields {
+			Expect(causes[i].Field).To(Equal(field))
+		}
+	},
+		table.Entry("accept total limits with bursts",
+			&v1.DiskIOTune{
+				TotalBytesSec:          resourcePtr("100Mi"),
+				TotalBytesSecMax:       resourcePtr("200Mi"),
+				TotalBytesSecMaxLength: pointer.Int64Ptr(10),
+				TotalIOPSSec:           pointer.Int64Ptr(1000),
+				SizeIOPSSec:            resourcePtr("4Ki"),
+				GroupName:              "shared",
+			},
+		),
+		table.Entry("accept read and write limits",
+			&v1.DiskIOTune{ReadBytesSec: resourcePtr("100Mi"), WriteIOPSSec: pointer.Int64Ptr(500), WriteIOPSSecMax: pointer.Int64Ptr(500)},
+		),
+		table.Entry("reject a limit which is not positive",
+			&v1.DiskIOTune{ReadIOPSSec: pointer.Int64Ptr(0), WriteBytesSec: resourcePtr("-1Mi")},
+			"fake.writeBytesSec", "fake.readIOPSSec",
+		),
+		table.Entry("reject a fractional number of bytes",
+			&v1.DiskIOTune{TotalBytesSec: resourcePtr("0.5")},
+			"fake.totalBytesSec",
+		),
+		table.Entry("reject a burst without a limit",
+			&v1.DiskIOTune{TotalIOPSSecMax: pointer.Int64Ptr(1000)},
+			"fake.totalIOPSSecMax",
+		),
+		table.Entry("reject a burst lower than the limit",
+			&v1.DiskIOTune{ReadBytesSec: resourcePtr("100Mi"), ReadBytesSecMax: resourcePtr("10Mi")},
+			"fake.readBytesSecMax",
+		),
+		table.Entry("reject a burst length without a burst",
+			&v1.DiskIOTune{WriteIOPSSec: pointer.Int64Ptr(100), WriteIOPSSecMaxLength: pointer.Int64Ptr(10)},
+			"fake.writeIOPSSecMaxLength",
+		),
+		table.Entry("reject a burst length which is not positive",
+			&v1.DiskIOTune{WriteIOPSSec: pointer.Int64Ptr(100), WriteIOPSSecMax: pointer.Int64Ptr(200), WriteIOPSSecMaxLength: pointer.Int64Ptr(0)},
+			"fake.writeIOPSSecMaxLength",
+		),
+		table.Entry("reject a total limit combined with a read limit",
+			&v1.DiskIOTune{TotalBytesSec: resourcePtr("100Mi"), ReadBytesSec: resourcePtr("50Mi"), TotalIOPSSec: pointer.Int64Ptr(100)},
+			"fake.totalBytesSec",
+		),
+		table.Entry("reject a group without limits",
+			&v1.DiskIOTune{GroupName: "shared"},
+			"fake.groupName",
+		),
+	)
+})
@@ -0,0 +1,116 @@
+package migrations
+
+import (
+	"sort"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	migrationsv1 "kubevirt.io/client-go/apis/migrations/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+// MatchPolicy returns the MigrationPolicy from the given informer which applies to the VMI,
+// or nil if no policy matches. When several policies match, the one with the most specific
+// selectors wins; ties are broken by the policy name.
+func MatchPolicy(policyInformer cache.SharedIndexInformer, namespaceInformer cache.SharedIndexInformer, vmi *v1.VirtualMachineInstance) *migrationsv1.MigrationPolicy {
+	var namespaceLabels map[string]string
+	obj, exists, err := namespaceInformer.GetStore().GetByKey(vmi.Namespace)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("Failed to look up the namespace of the vmi, matching migration policies without namespace labels")
+	} else if exists {
+		namespaceLabels = obj.(*k8sv1.Namespace).Labels
+	}
+
+	var policies []*migrationsv1.MigrationPolicy
+	for _, obj := range policyInformer.GetStore().List() {
+		policies = append(policies, obj.(*migrationsv1.MigrationPolicy))
+	}
+	return MatchPolicyFromList(policies, vmi.Labels, namespaceLabels)
+}
+
+// MatchPolicyFromList returns the most specific policy of the list whose selectors match
+// the given VMI and namespace labels, or nil if none matches.
+func MatchPolicyFromList(policies []*migrationsv1.MigrationPolicy, vmiLabels, namespaceLabels map[string]string) *migrationsv1.MigrationPolicy {
+	var matching []*migrationsv1.MigrationPolicy
+	for _, policy := range policies {
+		if policyMatches(policy, vmiLabels, namespaceLabels) {
+			matching = append(matching, policy)
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		wi, wj := selectorsWeight(matching[i]), selectorsWeight(matching[j])
+		if wi != wj {
+			return wi > wj
+		}
+		return matching[i].Name < matching[j].Name
+	})
+	return matching[0]
+}
+
+// ApplyPolicy returns a copy of the cluster wide migration configuration, with the values
+// set by the policy overriding the cluster wide ones.
+func ApplyPolicy(clusterConfig *v1.MigrationConfiguration, policy *migrationsv1.MigrationPolicy) *v1.MigrationConfiguration {
+	config := clusterConfig.DeepCopy()
+	if policy == nil {
+		return config
+	}
+
+	spec := policy.Spec.DeepCopy()
+	if spec.AllowAutoConverge != nil {
+		config.AllowAutoConverge = spec.AllowAutoConverge
+	}
+	if spec.BandwidthPerMigration != nil {
+		config.BandwidthPerMigration = spec.BandwidthPerMigration
+	}
+	if spec.CompletionTimeoutPerGiB != nil {
+		config.CompletionTimeoutPerGiB = spec.CompletionTimeoutPerGiB
+	}
+	if spec.ProgressTimeout != nil {
+		config.ProgressTimeout = spec.ProgressTimeout
+	}
+	if spec.AllowPostCopy != nil {
+		config.AllowPostCopy = spec.AllowPostCopy
+	}
+	return config
+}
+
+func policyMatches(policy *migrationsv1.MigrationPolicy, vmiLabels, namespaceLabels map[string]string) bool {
+	if policy.Spec.Selectors == nil {
+		return true
+	}
+	return selectorMatches(policy.Spec.Selectors.VirtualMachineInstanceSelector, vmiLabels) &&
+		selectorMatches(policy.Spec.Selectors.NamespaceSelector, namespaceLabels)
+}
+
+func selectorMatches(selector *metav1.LabelSelector, objLabels map[string]string) bool {
+	if selector == nil {
+		return true
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		log.Log.Reason(err).Warning("Ignoring migration policy with an invalid label selector")
+		return false
+	}
+	return s.Matches(labels.Set(objLabels))
+}
+
+func selectorsWeight(policy *migrationsv1.MigrationPolicy) int {
+	if policy.Spec.Selectors == nil {
+		return 0
+	}
+	weight := 0
+	for _, selector := range []*metav1.LabelSelector{policy.Spec.Selectors.VirtualMachineInstanceSelector, policy.Spec.Selectors.NamespaceSelector} {
+		if selector != nil {
+			weight += len(selector.MatchLabels) + len(selector.MatchExpressions)
+		}
+	}
+	return weight
+}
@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package migrations
+
+import (
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	migrationsv1 "kubevirt.io/client-go/apis/migrations/v1alpha1"
+)
+
+var _ = Describe("MigrationPolicy", func() {
+
+	newPolicy := func(name string, vmiLabels, namespaceLabels map[string]string) *migrationsv1.MigrationPolicy {
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       migrationsv1.MigrationPolicySpec{Selectors: &migrationsv1.Selectors{}},
+		}
+		if vmiLabels != nil {
+			policy.Spec.Selectors.VirtualMachineInstanceSelector = &metav1.LabelSelector{MatchLabels: vmiLabels}
+		}
+		if namespaceLabels != nil {
+			policy.Spec.Selectors.NamespaceSelector = &metav1.LabelSelector{MatchLabels: namespaceLabels}
+		}
+		return policy
+	}
+
+	Context("matching", func() {
+		vmiLabels := map[string]string{"app": "db", "tier": "backend"}
+		namespaceLabels := map[string]string{"team": "storage"}
+
+		table.DescribeTable("should select", func(policies []*migrationsv1.MigrationPolicy, expected string) {
+			policy := MatchPolicyFromList(policies, vmiLabels, namespaceLabels)
+			if expected == "" {
+				Expect(policy).To(BeNil())
+			} else {
+				Expect(policy).ToNot(BeNil())
+				Expect(policy.Name).To(Equal(expected))
+			}
+		},
+			table.Entry("nothing without policies", nil, ""),
+			table.Entry("nothing if the vmi selector does not match", []*migrationsv1.MigrationPolicy{
+				newPolicy("a", map[string]string{"app": "web"}, nil),
+			}, ""),
+			table.Entry("nothing if the namespace selector does not match", []*migrationsv1.MigrationPolicy{
+				newPolicy("a", map[string]string{"app": "db"}, map[string]string{"team": "network"}),
+			}, ""),
+			table.Entry("a policy without selectors", []*migrationsv1.MigrationPolicy{
+				{ObjectMeta: metav1.ObjectMeta{Name: "a"}},
+			}, "a"),
+			table.Entry("the policy matching both selectors", []*migrationsv1.MigrationPolicy{
+				newPolicy("a", map[string]string{"app": "web"}, nil),
+				newPolicy("b", map[string]string{"app": "db"}, namespaceLabels),
+			}, "b"),
+			table.Entry("the most specific policy", []*migrationsv1.MigrationPolicy{
+				newPolicy("a", map[string]string{"app": "db"}, nil),
+				newPolicy("b", map[string]string{"app": "db"}, namespaceLabels),
+				newPolicy("c", nil, namespaceLabels),
+			}, "b"),
+			table.Entry("the first policy by name on equal specificity", []*migrationsv1.MigrationPolicy{
+				newPolicy("b", map[string]string{"app": "db"}, nil),
+				newPolicy("a", nil, namespaceLabels),
+			}, "a"),
+		)
+
+		It("should ignore policies with invalid selectors", func() {
+			policy := newPolicy("a", nil, nil)
+			policy.Spec.Selectors.VirtualMachineInstanceSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "bogus"}},
+			}
+			Expect(MatchPolicyFromList([]*migrationsv1.MigrationPolicy{policy}, vmiLabels, namespaceLabels)).To(BeNil())
+		})
+	})
+
+	Context("applying", func() {
+		bandwidth := resource.MustParse("64Mi")
+		completionTimeout := int64(800)
+		progressTimeout := int64(150)
+		clusterConfig := &v1.MigrationConfiguration{
+			BandwidthPerMigration:   &bandwidth,
+			CompletionTimeoutPerGiB: &completionTimeout,
+			ProgressTimeout:         &progressTimeout,
+			AllowAutoConverge:       newBool(false),
+			AllowPostCopy:           newBool(false),
+		}
+
+		It("should return the cluster configuration without a policy", func() {
+			Expect(ApplyPolicy(clusterConfig, nil)).To(Equal(clusterConfig))
+		})
+
+		It("should only override the values set by the policy", func() {
+			policyBandwidth := resource.MustParse("1Gi")
+			policy := newPolicy("a", nil, nil)
+			policy.Spec.BandwidthPerMigration = &policyBandwidth
+			policy.Spec.AllowPostCopy = newBool(true)
+
+			config := ApplyPolicy(clusterConfig, policy)
+			Expect(config.BandwidthPerMigration.String()).To(Equal("1Gi"))
+			Expect(*config.AllowPostCopy).To(BeTrue())
+			Expect(*config.AllowAutoConverge).To(BeFalse())
+			Expect(*config.CompletionTimeoutPerGiB).To(Equal(completionTimeout))
+			Expect(*config.ProgressTimeout).To(Equal(progressTimeout))
+
+			By("leaving the cluster configuration untouched")
+			Expect(clusterConfig.BandwidthPerMigration.String()).To(Equal("64Mi"))
+			Expect(*clusterConfig.AllowPostCopy).To(BeFalse())
+		})
+	})
+})
+
+func newBool(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+// ApplyVolumeMigrations returns a copy of the volumes in which the volumes moved by the
+// volume migrations use their destination claims
+func ApplyVolumeMigrations(volumes []v1.Volume, volumeMigrations []v1.VolumeMigration) []v1.Volume {
+	migrated := make([]v1.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		volume = *volume.DeepCopy()
+		for _, volumeMigration := range volumeMigrations {
+			if volumeMigration.SourceVolume == volume.Name {
+				volume.VolumeSource = migratedVolumeSource(volume.VolumeSource, volumeMigration)
+			}
+		}
+		migrated = append(migrated, volume)
+	}
+	return migrated
+}
+
+func migratedVolumeSource(source v1.VolumeSource, volumeMigration v1.VolumeMigration) v1.VolumeSource {
+	if volumeMigration.DestinationDataVolume != "" {
+		return v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: volumeMigration.DestinationDataVolume}}
+	}
+	claim := k8sv1.PersistentVolumeClaimVolumeSource{}
+	if source.PersistentVolumeClaim != nil {
+		claim.ReadOnly = source.PersistentVolumeClaim.ReadOnly
+	}
+	claim.ClaimName = volumeMigration.DestinationPVC
+	return v1.VolumeSource{PersistentVolumeClaim: &claim}
+}
+
+// GetVolumeMigrations returns the volume migrations of the migration of the VMI in progress
+func GetVolumeMigrations(vmi *v1.VirtualMachineInstance) []v1.VolumeMigration {
+	if vmi.Status.MigrationState == nil || vmi.Status.MigrationState.Completed {
+		return nil
+	}
+	return vmi.Status.MigrationState.VolumeMigrations
+}
+
+// IsMigratedVolume returns true if the volume is moved to a new claim by the migration of the VMI
+func IsMigratedVolume(vmi *v1.VirtualMachineInstance, volumeName string) bool {
+	for _, volumeMigration := range GetVolumeMigrations(vmi) {
+		if volumeMigration.SourceVolume == volumeName {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBlockMigration returns true if the disks of the VMI are copied by its migration, either because
+// some of them are local, or because volumes are moved to new claims
+func IsBlockMigration(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Status.MigrationMethod == v1.BlockMigration || len(GetVolumeMigrations(vmi)) > 0
+}
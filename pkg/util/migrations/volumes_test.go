@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package migrations
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+var _ = Describe("Volume migrations", func() {
+
+	var volumes []v1.Volume
+
+	BeforeEach(func() {
+		volumes = []v1.Volume{
+			{
+				Name: "pvc",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "old-pvc", ReadOnly: true},
+				},
+			},
+			{
+				Name: "dv",
+				VolumeSource: v1.VolumeSource{
+					DataVolume: &v1.DataVolumeSource{Name: "old-dv"},
+				},
+			},
+			{
+				Name: "cloudinit",
+				VolumeSource: v1.VolumeSource{
+					CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+				},
+			},
+		}
+	})
+
+	It("should replace the migrated volumes with their destination claims", func() {
+		migrated := ApplyVolumeMigrations(volumes, []v1.VolumeMigration{
+			{SourceVolume: "pvc", DestinationDataVolume: "new-dv"},
+			{SourceVolume: "dv", DestinationPVC: "new-pvc"},
+		})
+
+		Expect(migrated).To(HaveLen(3))
+		Expect(migrated[0].Name).To(Equal("pvc"))
+		Expect(migrated[0].PersistentVolumeClaim).To(BeNil())
+		Expect(migrated[0].DataVolume.Name).To(Equal("new-dv"))
+		Expect(migrated[1].Name).To(Equal("dv"))
+		Expect(migrated[1].DataVolume).To(BeNil())
+		Expect(migrated[1].PersistentVolumeClaim.ClaimName).To(Equal("new-pvc"))
+		Expect(migrated[2]).To(Equal(volumes[2]))
+	})
+
+	It("should keep the read-only flag of a migrated claim", func() {
+		migrated := ApplyVolumeMigrations(volumes, []v1.VolumeMigration{
+			{SourceVolume: "pvc", DestinationPVC: "new-pvc"},
+		})
+
+		Expect(migrated[0].PersistentVolumeClaim.ClaimName).To(Equal("new-pvc"))
+		Expect(migrated[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+	})
+
+	It("should not modify the original volumes", func() {
+		ApplyVolumeMigrations(volumes, []v1.VolumeMigration{
+			{SourceVolume: "pvc", DestinationPVC: "new-pvc"},
+		})
+
+		Expect(volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("old-pvc"))
+	})
+
+	It("should report the migrated volumes of the VMI", func() {
+		vmi := v1.NewMinimalVMI("testvmi")
+		Expect(IsMigratedVolume(vmi, "pvc")).To(BeFalse())
+
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			VolumeMigrations: []v1.VolumeMigration{{SourceVolume: "pvc", DestinationPVC: "new-pvc"}},
+		}
+		Expect(IsMigratedVolume(vmi, "pvc")).To(BeTrue())
+		Expect(IsMigratedVolume(vmi, "dv")).To(BeFalse())
+
+		vmi.Status.MigrationState.Completed = true
+		Expect(IsMigratedVolume(vmi, "pvc")).To(BeFalse())
+	})
+
+	It("should copy the disks of a VMI with volume migrations", func() {
+		vmi := v1.NewMinimalVMI("testvmi")
+		vmi.Status.MigrationMethod = v1.LiveMigration
+		Expect(IsBlockMigration(vmi)).To(BeFalse())
+
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			VolumeMigrations: []v1.VolumeMigration{{SourceVolume: "pvc", DestinationPVC: "new-pvc"}},
+		}
+		Expect(IsBlockMigration(vmi)).To(BeTrue())
+
+		vmi.Status.MigrationState = nil
+		vmi.Status.MigrationMethod = v1.BlockMigration
+		Expect(IsBlockMigration(vmi)).To(BeTrue())
+	})
+})
@@ -34,6 +34,21 @@ var _ = Describe("Resolveconf", func() {
 		})
 	})
 
+	Context("Function ParseIPv6Nameservers()", func() {
+		It("should only return the IPv6 nameservers", func() {
+			resolvConf := "search example.com\nnameserver 8.8.8.8\nnameserver fd00:10:96::a\nnameserver mynameserver\n"
+			nameservers, err := ParseIPv6Nameservers(resolvConf)
+			Expect(nameservers).To(Equal([]net.IP{net.ParseIP("fd00:10:96::a")}))
+			Expect(err).To(BeNil())
+		})
+
+		It("should not return a default nameserver", func() {
+			nameservers, err := ParseIPv6Nameservers("nameserver 8.8.8.8\n")
+			Expect(nameservers).To(BeEmpty())
+			Expect(err).To(BeNil())
+		})
+	})
+
 	Context("Function ParseSearchDomains()", func() {
 		It("should return a string of search domains", func() {
 			resolvConf := "search cluster.local svc.cluster.local example.com\nnameserver 8.8.8.8\n"
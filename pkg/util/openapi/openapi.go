@@ -17,6 +17,13 @@ import (
 	"k8s.io/kube-openapi/pkg/common"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	instancetypev1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	migrationsv1 "kubevirt.io/client-go/apis/migrations/v1alpha1"
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 )
 
@@ -102,10 +109,20 @@ func createConfig() *common.Config {
 		},
 		GetDefinitions: func(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 			m := v1.GetOpenAPIDefinitions(ref)
-			m2 := snapshotv1.GetOpenAPIDefinitions(ref)
-			for k, v := range m2 {
-				if _, ok := m[k]; !ok {
-					m[k] = v
+			for _, m2 := range []map[string]common.OpenAPIDefinition{
+				snapshotv1.GetOpenAPIDefinitions(ref),
+				poolv1.GetOpenAPIDefinitions(ref),
+				clonev1.GetOpenAPIDefinitions(ref),
+				exportv1.GetOpenAPIDefinitions(ref),
+				migrationsv1.GetOpenAPIDefinitions(ref),
+				instancetypev1.GetOpenAPIDefinitions(ref),
+				networkpolicyv1.GetOpenAPIDefinitions(ref),
+				backupv1.GetOpenAPIDefinitions(ref),
+			} {
+				for k, v := range m2 {
+					if _, ok := m[k]; !ok {
+						m[k] = v
+					}
 				}
 			}
 			return m
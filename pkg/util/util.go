@@ -63,6 +63,21 @@ func IsVFIOVMI(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// Check if an interface announces its IPv6 network with router advertisements
+func IsRouterAdvertisementsInterface(iface *v1.Interface) bool {
+	return iface.DHCPOptions != nil && (iface.DHCPOptions.RouterAdvertisements || iface.DHCPOptions.SLAAC)
+}
+
+// Check if a VMI spec requests IPv6 router advertisements on one of its interfaces
+func IsRouterAdvertisementsVMI(vmi *v1.VirtualMachineInstance) bool {
+	for i := range vmi.Spec.Domain.Devices.Interfaces {
+		if IsRouterAdvertisementsInterface(&vmi.Spec.Domain.Devices.Interfaces[i]) {
+			return true
+		}
+	}
+	return false
+}
+
 func ResourceNameToEnvVar(prefix string, resourceName string) string {
 	varName := strings.ToUpper(resourceName)
 	varName = strings.Replace(varName, "/", "_", -1)
@@ -31,6 +31,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	mime "kubevirt.io/kubevirt/pkg/rest"
 )
@@ -47,6 +50,14 @@ func ComposeAPIDefinitions() []*restful.WebService {
 	vmsGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshots")
 	vmscGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotcontents")
 	vmrGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestores")
+	vmrgGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinerestoregrants")
+	vmssGVR := snapshotv1.SchemeGroupVersion.WithResource("virtualmachinesnapshotschedules")
+
+	vmPoolGVR := poolv1.SchemeGroupVersion.WithResource("virtualmachinepools")
+
+	vmCloneGVR := clonev1.SchemeGroupVersion.WithResource("virtualmachineclones")
+
+	vmExportGVR := exportv1.SchemeGroupVersion.WithResource("virtualmachineexports")
 
 	ws, err := GroupVersionProxyBase(v1.GroupVersion)
 	if err != nil {
@@ -107,12 +118,52 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		panic(err)
 	}
 
+	ws2, err = GenericResourceProxy(ws2, vmrgGVR, &snapshotv1.VirtualMachineRestoreGrant{}, "VirtualMachineRestoreGrant", &snapshotv1.VirtualMachineRestoreGrantList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err = GenericResourceProxy(ws2, vmssGVR, &snapshotv1.VirtualMachineSnapshotSchedule{}, "VirtualMachineSnapshotSchedule", &snapshotv1.VirtualMachineSnapshotScheduleList{})
+	if err != nil {
+		panic(err)
+	}
+
 	ws3, err := ResourceProxyAutodiscovery(vmsGVR)
 	if err != nil {
 		panic(err)
 	}
 
-	return []*restful.WebService{ws, ws1, ws2, ws3}
+	ws4, err := GroupVersionProxyBase(poolv1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws4, err = GenericResourceProxy(ws4, vmPoolGVR, &poolv1.VirtualMachinePool{}, "VirtualMachinePool", &poolv1.VirtualMachinePoolList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws5, err := GroupVersionProxyBase(clonev1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws5, err = GenericResourceProxy(ws5, vmCloneGVR, &clonev1.VirtualMachineClone{}, "VirtualMachineClone", &clonev1.VirtualMachineCloneList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws6, err := GroupVersionProxyBase(exportv1.SchemeGroupVersion)
+	if err != nil {
+		panic(err)
+	}
+
+	ws6, err = GenericResourceProxy(ws6, vmExportGVR, &exportv1.VirtualMachineExport{}, "VirtualMachineExport", &exportv1.VirtualMachineExportList{})
+	if err != nil {
+		panic(err)
+	}
+
+	return []*restful.WebService{ws, ws1, ws2, ws3, ws4, ws5, ws6}
 }
 
 func GroupVersionProxyBase(gv schema.GroupVersion) (*restful.WebService, error) {
@@ -329,6 +380,14 @@ func NamespaceParam(ws *restful.WebService) *restful.Parameter {
 	return ws.PathParameter("namespace", "Object name and auth scope, such as for teams and projects").Required(true)
 }
 
+func PortParam(ws *restful.WebService) *restful.Parameter {
+	return ws.PathParameter("port", "The target port for portforward on the VirtualMachineInstance.").Required(true)
+}
+
+func ProtocolParam(ws *restful.WebService) *restful.Parameter {
+	return ws.PathParameter("protocol", "The protocol for portforward on the VirtualMachineInstance.").Required(true)
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
@@ -397,6 +456,14 @@ func ResourcePath(gvr schema.GroupVersionResource) string {
 	return fmt.Sprintf("/namespaces/{namespace:[a-z0-9][a-z0-9\\-]*}/%s/{name:[a-z0-9][a-z0-9\\-]*}", gvr.Resource)
 }
 
+func PortPath() string {
+	return "/{port:[0-9]+}"
+}
+
+func ProtocolPath() string {
+	return "/{protocol:tcp|udp}"
+}
+
 func SubResourcePath(subResource string) string {
 	if !strings.HasPrefix(subResource, "/") {
 		return "/" + subResource
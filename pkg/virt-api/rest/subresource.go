@@ -20,14 +20,17 @@
 package rest
 
 import (
+	"bytes"
 	"context"
 
 	"crypto/tls"
 	goerror "errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -39,8 +42,10 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/yaml"
 
+	"kubevirt.io/kubevirt/pkg/util/iotune"
 	"kubevirt.io/kubevirt/pkg/util/status"
 
 	v1 "kubevirt.io/client-go/api/v1"
@@ -158,7 +163,7 @@ func (app *SubresourceAPIApp) putRequestHandler(request *restful.Request, respon
 		return
 	}
 
-	err := conn.Put(url, app.handlerTLSConfiguration)
+	err := conn.Put(url, app.handlerTLSConfiguration, request.Request.Body)
 	if err != nil {
 		writeError(errors.NewInternalError(err), response)
 		return
@@ -185,6 +190,35 @@ func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, respon
 	app.streamRequestHandler(request, response, validate, getConsoleURL)
 }
 
+func (app *SubresourceAPIApp) PortForwardRequestHandler(request *restful.Request, response *restful.Response) {
+	port, err := strconv.Atoi(request.PathParameter("port"))
+	if err != nil || port < 1 || port > 65535 {
+		writeError(errors.NewBadRequest(fmt.Sprintf("invalid port %q", request.PathParameter("port"))), response)
+		return
+	}
+
+	protocol := "tcp"
+	if p := request.PathParameter("protocol"); p != "" {
+		protocol = p
+	}
+	if protocol != "tcp" && protocol != "udp" {
+		writeError(errors.NewBadRequest(fmt.Sprintf("unsupported protocol %q", protocol)), response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is paused"))
+		}
+		return nil
+	}
+	getPortForwardURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.PortForwardURI(vmi, port, protocol)
+	}
+	app.streamRequestHandler(request, response, validate, getPortForwardURL)
+}
+
 func (app *SubresourceAPIApp) getVirtHandlerConnForVMI(vmi *v1.VirtualMachineInstance) (kubecli.VirtHandlerConn, error) {
 	if !vmi.IsRunning() {
 		return nil, goerror.New(fmt.Sprintf("Unable to connect to VirtualMachineInstance because phase is %s instead of %s", vmi.Status.Phase, v1.Running))
@@ -753,6 +787,266 @@ func (app *SubresourceAPIApp) UnpauseVMIRequestHandler(request *restful.Request,
 
 }
 
+func (app *SubresourceAPIApp) FreezeVMIRequestHandler(request *restful.Request, response *restful.Response) {
+
+	unfreezeTimeout := &v1.FreezeUnfreezeTimeout{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(unfreezeTimeout)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, an unfreeze timeout is expected as the request body"), response)
+		return
+	}
+
+	if unfreezeTimeout.UnfreezeTimeout == nil {
+		writeError(errors.NewBadRequest("UnfreezeTimeout has to be set"), response)
+		return
+	} else if unfreezeTimeout.UnfreezeTimeout.Duration < 0 {
+		writeError(errors.NewBadRequest("UnfreezeTimeout must not be negative"), response)
+		return
+	}
+
+	// the body was consumed above, pass the validated one on to virt-handler
+	body, err := json.Marshal(unfreezeTimeout)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		condManager := controller.NewVirtualMachineInstanceConditionManager()
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI does not have guest agent connected"))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.FreezeURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL)
+}
+
+func (app *SubresourceAPIApp) UnfreezeVMIRequestHandler(request *restful.Request, response *restful.Response) {
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.UnfreezeURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL)
+}
+
+func (app *SubresourceAPIApp) BackupVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.IncrementalBackupEnabled() {
+		writeError(errors.NewBadRequest("Unable to start a backup because IncrementalBackup feature gate is not enabled."), response)
+		return
+	}
+
+	opts := &v1.VirtualMachineInstanceBackupOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, backup options are expected as the request body"), response)
+		return
+	}
+
+	if opts.BackupName == "" || opts.Checkpoint == "" {
+		writeError(errors.NewBadRequest("VirtualMachineInstanceBackupOptions requires backupName and checkpoint to be set"), response)
+		return
+	}
+	switch opts.Mode {
+	case v1.BackupModePush:
+		if opts.Target == nil || opts.Target.ClaimName == "" || opts.Target.PodUID == "" {
+			writeError(errors.NewBadRequest("A push backup requires a target claim and pod"), response)
+			return
+		}
+	case v1.BackupModePull:
+		if opts.Target != nil {
+			writeError(errors.NewBadRequest("A pull backup does not have a target"), response)
+			return
+		}
+	default:
+		writeError(errors.NewBadRequest(fmt.Sprintf("Unsupported backup mode %q", opts.Mode)), response)
+		return
+	}
+
+	// the body was consumed above, pass the validated one on to virt-handler
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		if migrationState := vmi.Status.MigrationState; migrationState != nil && !migrationState.Completed && !migrationState.Failed {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is migrating"))
+		}
+		if backupState := vmi.Status.BackupState; backupState != nil && !backupState.Completed && backupState.BackupName != opts.BackupName {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("backup %s is still running", backupState.BackupName))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.BackupURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL)
+}
+
+func (app *SubresourceAPIApp) AbortBackupVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	opts := &v1.VirtualMachineInstanceAbortBackupOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, the name of the backup is expected as the request body"), response)
+		return
+	}
+
+	if opts.BackupName == "" {
+		writeError(errors.NewBadRequest("VirtualMachineInstanceAbortBackupOptions requires backupName to be set"), response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		if backupState := vmi.Status.BackupState; backupState == nil || backupState.BackupName != opts.BackupName {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("backup %s is not running", opts.BackupName))
+		}
+		return nil
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.AbortBackupURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL)
+}
+
+func (app *SubresourceAPIApp) NBDRequestHandler(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		backupState := vmi.Status.BackupState
+		if backupState == nil || backupState.Completed {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI does not export a backup"))
+		}
+		return nil
+	}
+	getNBDURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.NBDURI(vmi)
+	}
+	app.streamRequestHandler(request, response, validate, getNBDURL)
+}
+
+func (app *SubresourceAPIApp) SetBlockIOTuneVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	opts := &v1.SetBlockIOTuneOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, the I/O limits are expected as the request body"), response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("SetBlockIOTuneOptions requires name to be set"), response)
+		return
+	}
+	if causes := iotune.Validate(k8sfield.NewPath("ioTune"), &opts.IOTune); len(causes) > 0 {
+		messages := []string{}
+		for _, cause := range causes {
+			messages = append(messages, cause.Message)
+		}
+		writeError(errors.NewBadRequest(fmt.Sprintf("Invalid I/O limits: %s", strings.Join(messages, ", "))), response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	request.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is not running"))
+		}
+		for _, disk := range vmi.Spec.Domain.Devices.Disks {
+			if disk.Name != opts.Name {
+				continue
+			}
+			for _, volumeStatus := range vmi.Status.VolumeStatus {
+				if volumeStatus.Name == opts.Name && volumeStatus.Target != "" {
+					return nil
+				}
+			}
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("disk %s is not attached", opts.Name))
+		}
+		return errors.NewBadRequest(fmt.Sprintf("VMI does not have a disk named %s", opts.Name))
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.SetBlockIOTuneURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validate, getURL)
+}
+
 func (app *SubresourceAPIApp) fetchVirtualMachine(name string, namespace string) (*v1.VirtualMachine, *errors.StatusError) {
 
 	vm, err := app.virtCli.VirtualMachine(namespace).Get(name, &k8smetav1.GetOptions{})
@@ -943,6 +1237,13 @@ func generateVMVolumeRequestPatch(vm *v1.VirtualMachine, volumeRequest *v1.Virtu
 		}
 		volumeRequestsList = append(volumeRequestsList, *volumeRequest)
 		vmCopy.Status.VolumeRequests = volumeRequestsList
+	} else if name := getCDRomRequestName(volumeRequest); name != "" {
+		for _, request := range vm.Status.VolumeRequests {
+			if getCDRomRequestName(&request) == name {
+				return "", fmt.Errorf("A request to change the media of CD-ROM [%s] already exists and is still being processed.", name)
+			}
+		}
+		vmCopy.Status.VolumeRequests = append(vm.Status.VolumeRequests, *volumeRequest)
 	}
 
 	oldJson, err := json.Marshal(vm.Status.VolumeRequests)
@@ -988,6 +1289,10 @@ func generateVMIVolumeRequestPatch(vmi *v1.VirtualMachineInstance, volumeRequest
 		return "", fmt.Errorf("Unable to remove volume [%s] because it does not exist", volumeRequest.RemoveVolumeOptions.Name)
 	}
 
+	if err := verifyCDRomRequest(vmi, volumeRequest); err != nil {
+		return "", err
+	}
+
 	vmiCopy := vmi.DeepCopy()
 	vmiCopy.Spec = *controller.ApplyVolumeRequestOnVMISpec(&vmiCopy.Spec, volumeRequest)
 
@@ -1022,6 +1327,49 @@ func generateVMIVolumeRequestPatch(vmi *v1.VirtualMachineInstance, volumeRequest
 	return patch, nil
 }
 
+// getCDRomRequestName returns the name of the CD-ROM whose media is changed by the request, if any
+func getCDRomRequestName(volumeRequest *v1.VirtualMachineVolumeRequest) string {
+	if volumeRequest.EjectCDRomOptions != nil {
+		return volumeRequest.EjectCDRomOptions.Name
+	} else if volumeRequest.InsertCDRomOptions != nil {
+		return volumeRequest.InsertCDRomOptions.Name
+	}
+	return ""
+}
+
+// verifyCDRomRequest ensures that a media is only ejected from a loaded CD-ROM, and only inserted into an empty one
+func verifyCDRomRequest(vmi *v1.VirtualMachineInstance, volumeRequest *v1.VirtualMachineVolumeRequest) error {
+	name := getCDRomRequestName(volumeRequest)
+	if name == "" {
+		return nil
+	}
+
+	isCDRom := false
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Name == name && disk.CDRom != nil {
+			isCDRom = true
+			break
+		}
+	}
+	if !isCDRom {
+		return fmt.Errorf("Unable to change the media of [%s] because it is not a CD-ROM", name)
+	}
+
+	loaded := false
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == name {
+			loaded = true
+			break
+		}
+	}
+	if volumeRequest.EjectCDRomOptions != nil && !loaded {
+		return fmt.Errorf("Unable to eject CD-ROM [%s] because it is empty", name)
+	} else if volumeRequest.InsertCDRomOptions != nil && loaded {
+		return fmt.Errorf("Unable to insert into CD-ROM [%s] because it is not empty", name)
+	}
+	return nil
+}
+
 func (app *SubresourceAPIApp) addVolumeRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
@@ -1214,3 +1562,473 @@ func (app *SubresourceAPIApp) VMIAddVolumeRequestHandler(request *restful.Reques
 func (app *SubresourceAPIApp) VMIRemoveVolumeRequestHandler(request *restful.Request, response *restful.Response) {
 	app.removeVolumeRequestHandler(request, response, true)
 }
+
+// patchVolumeRequest injects the volume request into the VMI if ephemeral, else sets it as a request
+// on the VM to both make it permanent and apply it to the VMI.
+func (app *SubresourceAPIApp) patchVolumeRequest(name, namespace string, volumeRequest *v1.VirtualMachineVolumeRequest, ephemeral bool, action string, response *restful.Response) bool {
+	if ephemeral {
+		vmi, statErr := app.fetchVirtualMachineInstance(name, namespace)
+		if statErr != nil {
+			writeError(statErr, response)
+			return false
+		}
+
+		if !vmi.IsRunning() {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf("VMI is not running")), response)
+			return false
+		}
+
+		patch, err := generateVMIVolumeRequestPatch(vmi, volumeRequest)
+		if err != nil {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, err), response)
+			return false
+		}
+
+		log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", patch)
+		_, err = app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("unable to patch vmi during %s: %v", action, err)), response)
+			return false
+		}
+		return true
+	}
+
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return false
+	}
+
+	patch, err := generateVMVolumeRequestPatch(vm, volumeRequest)
+	if err != nil {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
+		return false
+	}
+
+	err = app.statusUpdater.PatchStatus(vm, types.JSONPatchType, []byte(patch))
+	if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to patch vm status during %s: %v", action, err)), response)
+		return false
+	}
+	return true
+}
+
+func (app *SubresourceAPIApp) ejectCDRomRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugVolumesEnabled() {
+		writeError(errors.NewBadRequest("Unable to Eject CD-ROM because HotplugVolumes feature gate is not enabled."), response)
+		return
+	}
+
+	opts := &v1.EjectCDRomOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, a CD-ROM name is expected as the request body"), response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("EjectCDRomOptions requires name to be set"), response)
+		return
+	}
+
+	volumeRequest := v1.VirtualMachineVolumeRequest{
+		EjectCDRomOptions: opts,
+	}
+	if !app.patchVolumeRequest(name, namespace, &volumeRequest, ephemeral, "CD-ROM eject", response) {
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) insertCDRomRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugVolumesEnabled() {
+		writeError(errors.NewBadRequest("Unable to Insert CD-ROM because HotplugVolumes feature gate is not enabled."), response)
+		return
+	}
+
+	opts := &v1.InsertCDRomOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, a CD-ROM name and a media are expected as the request body"), response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("InsertCDRomOptions requires name to be set"), response)
+		return
+	} else if opts.VolumeSource == nil {
+		writeError(errors.NewBadRequest("InsertCDRomOptions requires VolumeSource to not be nil"), response)
+		return
+	}
+
+	volumeRequest := v1.VirtualMachineVolumeRequest{
+		InsertCDRomOptions: opts,
+	}
+	if !app.patchVolumeRequest(name, namespace, &volumeRequest, ephemeral, "CD-ROM insert", response) {
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// VMEjectCDRomRequestHandler handles the subresource for ejecting the media of a CD-ROM.
+func (app *SubresourceAPIApp) VMEjectCDRomRequestHandler(request *restful.Request, response *restful.Response) {
+	app.ejectCDRomRequestHandler(request, response, false)
+}
+
+// VMInsertCDRomRequestHandler handles the subresource for inserting a media into a CD-ROM.
+func (app *SubresourceAPIApp) VMInsertCDRomRequestHandler(request *restful.Request, response *restful.Response) {
+	app.insertCDRomRequestHandler(request, response, false)
+}
+
+// VMIEjectCDRomRequestHandler handles the subresource for ejecting the media of a CD-ROM.
+func (app *SubresourceAPIApp) VMIEjectCDRomRequestHandler(request *restful.Request, response *restful.Response) {
+	app.ejectCDRomRequestHandler(request, response, true)
+}
+
+// VMIInsertCDRomRequestHandler handles the subresource for inserting a media into a CD-ROM.
+func (app *SubresourceAPIApp) VMIInsertCDRomRequestHandler(request *restful.Request, response *restful.Response) {
+	app.insertCDRomRequestHandler(request, response, true)
+}
+
+func generateVMInterfaceRequestPatch(vm *v1.VirtualMachine, interfaceRequest *v1.VirtualMachineInterfaceRequest) (string, error) {
+	verb := "add"
+	if len(vm.Status.InterfaceRequests) > 0 {
+		verb = "replace"
+	}
+
+	vmCopy := vm.DeepCopy()
+
+	// We only validate the list against other items in the list at this point.
+	// The VM validation webhook will validate the list against the VMI spec
+	// during the Patch command
+	if interfaceRequest.AddInterfaceOptions != nil {
+		name := interfaceRequest.AddInterfaceOptions.Name
+		for _, request := range vm.Status.InterfaceRequests {
+			if request.AddInterfaceOptions != nil && request.AddInterfaceOptions.Name == name {
+				return "", fmt.Errorf("Add interface request for interface [%s] already exists", name)
+			} else if request.RemoveInterfaceOptions != nil && request.RemoveInterfaceOptions.Name == name {
+				return "", fmt.Errorf("Unable to add interface. A remove interface request for interface [%s] already exists and is still being processed.", name)
+			}
+		}
+		vmCopy.Status.InterfaceRequests = append(vm.Status.InterfaceRequests, *interfaceRequest)
+	} else if interfaceRequest.RemoveInterfaceOptions != nil {
+		name := interfaceRequest.RemoveInterfaceOptions.Name
+		interfaceRequestsList := []v1.VirtualMachineInterfaceRequest{}
+		for _, request := range vm.Status.InterfaceRequests {
+			if request.AddInterfaceOptions != nil && request.AddInterfaceOptions.Name == name {
+				// Filter matching AddInterface requests from the new list.
+				continue
+			} else if request.RemoveInterfaceOptions != nil && request.RemoveInterfaceOptions.Name == name {
+				return "", fmt.Errorf("A remove interface request for interface [%s] already exists and is still being processed.", name)
+			}
+
+			interfaceRequestsList = append(interfaceRequestsList, request)
+		}
+		interfaceRequestsList = append(interfaceRequestsList, *interfaceRequest)
+		vmCopy.Status.InterfaceRequests = interfaceRequestsList
+	}
+
+	oldJson, err := json.Marshal(vm.Status.InterfaceRequests)
+	if err != nil {
+		return "", err
+	}
+
+	newJson, err := json.Marshal(vmCopy.Status.InterfaceRequests)
+	if err != nil {
+		return "", err
+	}
+
+	test := fmt.Sprintf(`{ "op": "test", "path": "/status/interfaceRequests", "value": %s}`, string(oldJson))
+	update := fmt.Sprintf(`{ "op": "%s", "path": "/status/interfaceRequests", "value": %s}`, verb, string(newJson))
+	patch := fmt.Sprintf("[%s, %s]", test, update)
+
+	return patch, nil
+}
+
+func generateVMIInterfaceRequestPatch(vmi *v1.VirtualMachineInstance, interfaceRequest *v1.VirtualMachineInterfaceRequest) (string, error) {
+
+	networkVerb := "add"
+	interfaceVerb := "add"
+
+	if len(vmi.Spec.Networks) > 0 {
+		networkVerb = "replace"
+	}
+
+	if len(vmi.Spec.Domain.Devices.Interfaces) > 0 {
+		interfaceVerb = "replace"
+	}
+
+	foundRemoveIface := false
+	for _, network := range vmi.Spec.Networks {
+		if interfaceRequest.AddInterfaceOptions != nil && network.Name == interfaceRequest.AddInterfaceOptions.Name {
+			return "", fmt.Errorf("Unable to add interface [%s] because it already exists", network.Name)
+		} else if interfaceRequest.RemoveInterfaceOptions != nil && network.Name == interfaceRequest.RemoveInterfaceOptions.Name {
+			foundRemoveIface = true
+		}
+	}
+
+	if interfaceRequest.RemoveInterfaceOptions != nil {
+		name := interfaceRequest.RemoveInterfaceOptions.Name
+		if !foundRemoveIface {
+			return "", fmt.Errorf("Unable to remove interface [%s] because it does not exist", name)
+		}
+		hotplugged := false
+		for _, status := range vmi.Status.HotplugInterfaces {
+			if status.Name == name {
+				hotplugged = true
+				break
+			}
+		}
+		if !hotplugged {
+			return "", fmt.Errorf("Unable to remove interface [%s] because it was not hotplugged", name)
+		}
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	vmiCopy.Spec = *controller.ApplyInterfaceRequestOnVMISpec(&vmiCopy.Spec, interfaceRequest)
+
+	oldNetworksJson, err := json.Marshal(vmi.Spec.Networks)
+	if err != nil {
+		return "", err
+	}
+
+	newNetworksJson, err := json.Marshal(vmiCopy.Spec.Networks)
+	if err != nil {
+		return "", err
+	}
+
+	oldInterfacesJson, err := json.Marshal(vmi.Spec.Domain.Devices.Interfaces)
+	if err != nil {
+		return "", err
+	}
+
+	newInterfacesJson, err := json.Marshal(vmiCopy.Spec.Domain.Devices.Interfaces)
+	if err != nil {
+		return "", err
+	}
+
+	testNetworks := fmt.Sprintf(`{ "op": "test", "path": "/spec/networks", "value": %s}`, string(oldNetworksJson))
+	updateNetworks := fmt.Sprintf(`{ "op": "%s", "path": "/spec/networks", "value": %s}`, networkVerb, string(newNetworksJson))
+
+	testInterfaces := fmt.Sprintf(`{ "op": "test", "path": "/spec/domain/devices/interfaces", "value": %s}`, string(oldInterfacesJson))
+	updateInterfaces := fmt.Sprintf(`{ "op": "%s", "path": "/spec/domain/devices/interfaces", "value": %s}`, interfaceVerb, string(newInterfacesJson))
+
+	patch := fmt.Sprintf("[%s, %s, %s, %s]", testNetworks, testInterfaces, updateNetworks, updateInterfaces)
+
+	return patch, nil
+}
+
+func (app *SubresourceAPIApp) addInterfaceRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugNICsEnabled() {
+		writeError(errors.NewBadRequest("Unable to Add Interface because HotplugNICs feature gate is not enabled."), response)
+		return
+	}
+
+	opts := &v1.AddInterfaceOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s", err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, a new name is expected as the request body"), response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("AddInterfaceOptions requires name to be set"), response)
+		return
+	} else if opts.NetworkAttachmentDefinitionName == "" {
+		writeError(errors.NewBadRequest("AddInterfaceOptions requires networkAttachmentDefinitionName to be set"), response)
+		return
+	}
+
+	interfaceRequest := v1.VirtualMachineInterfaceRequest{
+		AddInterfaceOptions: opts,
+	}
+
+	// inject into VMI if ephemeral, else set as a request on the VM to both make permanent and hotplug.
+	if ephemeral {
+		vmi, statErr := app.fetchVirtualMachineInstance(name, namespace)
+		if statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+
+		if !vmi.IsRunning() {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf("VMI is not running")), response)
+			return
+		}
+
+		patch, err := generateVMIInterfaceRequestPatch(vmi, &interfaceRequest)
+		if err != nil {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, err), response)
+			return
+		}
+
+		log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", patch)
+		_, err = app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("unable to patch vmi during interface add: %v", err)), response)
+			return
+		}
+
+	} else {
+		vm, statErr := app.fetchVirtualMachine(name, namespace)
+		if statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+
+		patch, err := generateVMInterfaceRequestPatch(vm, &interfaceRequest)
+		if err != nil {
+			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
+			return
+		}
+
+		err = app.statusUpdater.PatchStatus(vm, types.JSONPatchType, []byte(patch))
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("unable to patch vm status during interface add: %v", err)), response)
+			return
+		}
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) removeInterfaceRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugNICsEnabled() {
+		writeError(errors.NewBadRequest("Unable to Remove Interface because HotplugNICs feature gate is not enabled."), response)
+		return
+	}
+
+	opts := &v1.RemoveInterfaceOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf("Can not unmarshal Request body to struct, error: %s",
+				err)), response)
+			return
+		}
+	} else {
+		writeError(errors.NewBadRequest("Request with no body, a new name is expected as the request body"),
+			response)
+		return
+	}
+
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("RemoveInterfaceOptions requires name to be set"), response)
+		return
+	}
+	interfaceRequest := v1.VirtualMachineInterfaceRequest{
+		RemoveInterfaceOptions: opts,
+	}
+
+	// remove from VMI if ephemeral, else set as a request on the VM to both make permanent and hot unplug.
+	if ephemeral {
+		vmi, statErr := app.fetchVirtualMachineInstance(name, namespace)
+		if statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+
+		if !vmi.IsRunning() {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf("VMI is not running")), response)
+			return
+		}
+
+		patch, err := generateVMIInterfaceRequestPatch(vmi, &interfaceRequest)
+		if err != nil {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, err), response)
+			return
+		}
+
+		log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", patch)
+		_, err = app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(patch))
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("unable to patch vmi during interface remove: %v", err)), response)
+			return
+		}
+	} else {
+		vm, statErr := app.fetchVirtualMachine(name, namespace)
+		if statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+
+		patch, err := generateVMInterfaceRequestPatch(vm, &interfaceRequest)
+		if err != nil {
+			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
+			return
+		}
+
+		err = app.statusUpdater.PatchStatus(vm, types.JSONPatchType, []byte(patch))
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("unable to patch vm status during interface remove: %v", err)), response)
+			return
+		}
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// VMAddInterfaceRequestHandler handles the subresource for hot plugging a network interface.
+func (app *SubresourceAPIApp) VMAddInterfaceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.addInterfaceRequestHandler(request, response, false)
+}
+
+// VMRemoveInterfaceRequestHandler handles the subresource for hot unplugging a network interface.
+func (app *SubresourceAPIApp) VMRemoveInterfaceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.removeInterfaceRequestHandler(request, response, false)
+}
+
+// VMIAddInterfaceRequestHandler handles the subresource for hot plugging a network interface.
+func (app *SubresourceAPIApp) VMIAddInterfaceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.addInterfaceRequestHandler(request, response, true)
+}
+
+// VMIRemoveInterfaceRequestHandler handles the subresource for hot unplugging a network interface.
+func (app *SubresourceAPIApp) VMIRemoveInterfaceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.removeInterfaceRequestHandler(request, response, true)
+}
@@ -32,6 +32,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/emicklei/go-restful"
 	. "github.com/onsi/ginkgo"
@@ -43,6 +44,7 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/uuid"
 
@@ -357,6 +359,31 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			close(done)
 		}, 5)
 
+		table.DescribeTable("should fail to port-forward with invalid parameters", func(port, protocol string) {
+			request.PathParameters()["name"] = "testvmi"
+			request.PathParameters()["namespace"] = "default"
+			request.PathParameters()["port"] = port
+			request.PathParameters()["protocol"] = protocol
+
+			app.PortForwardRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			table.Entry("with a port out of range", "65536", "tcp"),
+			table.Entry("with port 0", "0", ""),
+			table.Entry("with a non numeric port", "ssh", ""),
+			table.Entry("with an unsupported protocol", "22", "sctp"),
+		)
+
+		It("should fail to port-forward if the VMI is paused", func(done Done) {
+			request.PathParameters()["port"] = "22"
+
+			expectVMI(true, true)
+
+			app.PortForwardRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			close(done)
+		}, 5)
+
 		It("should fail if VirtualMachine not exists", func(done Done) {
 			request.PathParameters()["name"] = "testvm"
 			request.PathParameters()["namespace"] = "default"
@@ -1245,6 +1272,446 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		)
 	})
 
+	Context("Eject/Insert CD-ROM Subresource api", func() {
+
+		newBody := func(opts interface{}) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+
+		newRunningVMIWithCDRoms := func(name string) *v1.VirtualMachineInstance {
+			vmi := v1.NewMinimalVMI(name)
+			vmi.Namespace = "default"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+				{Name: "loaded", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}},
+				{Name: "empty", DiskDevice: v1.DiskDevice{CDRom: &v1.CDRomTarget{}}},
+				{Name: "existingvol"},
+			}
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "loaded",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "iso"},
+					},
+				},
+				{
+					Name: "existingvol",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "testpvcdiskclaim"},
+					},
+				},
+			}
+			return vmi
+		}
+
+		isoSource := &v1.HotplugVolumeSource{
+			DataVolume: &v1.DataVolumeSource{Name: "iso2"},
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = "testvm"
+			request.PathParameters()["namespace"] = "default"
+		})
+
+		table.DescribeTable("Should handle CD-ROM media request", func(ejectOpts *v1.EjectCDRomOptions, insertOpts *v1.InsertCDRomOptions, isVM bool, code int, enableGate bool) {
+			if enableGate {
+				enableFeatureGate(virtconfig.HotplugVolumesGate)
+			}
+			if ejectOpts != nil {
+				request.Request.Body = newBody(ejectOpts)
+			} else {
+				request.Request.Body = newBody(insertOpts)
+			}
+
+			if isVM {
+				vm := newMinimalVM(request.PathParameter("name"))
+				vm.Namespace = "default"
+
+				patchedVM := vm.DeepCopy()
+				patchedVM.Status.VolumeRequests = append(patchedVM.Status.VolumeRequests, v1.VirtualMachineVolumeRequest{EjectCDRomOptions: ejectOpts, InsertCDRomOptions: insertOpts})
+				if code == http.StatusAccepted {
+					server.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachines/testvm"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, vm),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PATCH", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachines/testvm/status"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, patchedVM),
+						),
+					)
+				}
+				if ejectOpts != nil {
+					app.VMEjectCDRomRequestHandler(request, response)
+				} else {
+					app.VMInsertCDRomRequestHandler(request, response)
+				}
+			} else {
+				vmi := newRunningVMIWithCDRoms(request.PathParameter("name"))
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvm"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvm"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+					),
+				)
+
+				if ejectOpts != nil {
+					app.VMIEjectCDRomRequestHandler(request, response)
+				} else {
+					app.VMIInsertCDRomRequestHandler(request, response)
+				}
+			}
+
+			Expect(response.StatusCode()).To(Equal(code))
+		},
+			table.Entry("VM with a valid eject request", &v1.EjectCDRomOptions{Name: "loaded"}, nil, true, http.StatusAccepted, true),
+			table.Entry("VMI with a valid eject request", &v1.EjectCDRomOptions{Name: "loaded"}, nil, false, http.StatusAccepted, true),
+			table.Entry("VMI with an eject request missing a name", &v1.EjectCDRomOptions{}, nil, false, http.StatusBadRequest, true),
+			table.Entry("VMI with an eject request for an empty CD-ROM", &v1.EjectCDRomOptions{Name: "empty"}, nil, false, http.StatusConflict, true),
+			table.Entry("VMI with an eject request for a disk", &v1.EjectCDRomOptions{Name: "existingvol"}, nil, false, http.StatusConflict, true),
+			table.Entry("VM with a valid insert request", nil, &v1.InsertCDRomOptions{Name: "empty", VolumeSource: isoSource}, true, http.StatusAccepted, true),
+			table.Entry("VMI with a valid insert request", nil, &v1.InsertCDRomOptions{Name: "empty", VolumeSource: isoSource}, false, http.StatusAccepted, true),
+			table.Entry("VMI with an insert request missing a media", nil, &v1.InsertCDRomOptions{Name: "empty"}, false, http.StatusBadRequest, true),
+			table.Entry("VMI with an insert request for a loaded CD-ROM", nil, &v1.InsertCDRomOptions{Name: "loaded", VolumeSource: isoSource}, false, http.StatusConflict, true),
+			table.Entry("VM with a valid eject request but no feature gate", &v1.EjectCDRomOptions{Name: "loaded"}, nil, true, http.StatusBadRequest, false),
+			table.Entry("VMI with a valid insert request but no feature gate", nil, &v1.InsertCDRomOptions{Name: "empty", VolumeSource: isoSource}, false, http.StatusBadRequest, false),
+		)
+
+		table.DescribeTable("Should generate expected vmi patch", func(volumeRequest *v1.VirtualMachineVolumeRequest, expectedPatch string, expectError bool) {
+			vmi := newRunningVMIWithCDRoms(request.PathParameter("name"))
+			vmi.Spec.Domain.Devices.Disks = vmi.Spec.Domain.Devices.Disks[:2]
+			vmi.Spec.Volumes = vmi.Spec.Volumes[:1]
+
+			patch, err := generateVMIVolumeRequestPatch(vmi, volumeRequest)
+			if expectError {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+
+			Expect(patch).To(Equal(expectedPatch))
+		},
+			table.Entry("eject request",
+				&v1.VirtualMachineVolumeRequest{
+					EjectCDRomOptions: &v1.EjectCDRomOptions{Name: "loaded"},
+				},
+				"[{ \"op\": \"test\", \"path\": \"/spec/volumes\", \"value\": [{\"name\":\"loaded\",\"persistentVolumeClaim\":{\"claimName\":\"iso\"}}]}, { \"op\": \"test\", \"path\": \"/spec/domain/devices/disks\", \"value\": [{\"name\":\"loaded\",\"cdrom\":{}},{\"name\":\"empty\",\"cdrom\":{}}]}, { \"op\": \"replace\", \"path\": \"/spec/volumes\", \"value\": []}, { \"op\": \"replace\", \"path\": \"/spec/domain/devices/disks\", \"value\": [{\"name\":\"loaded\",\"cdrom\":{}},{\"name\":\"empty\",\"cdrom\":{}}]}]",
+				false),
+			table.Entry("insert request",
+				&v1.VirtualMachineVolumeRequest{
+					InsertCDRomOptions: &v1.InsertCDRomOptions{Name: "empty", VolumeSource: isoSource},
+				},
+				"[{ \"op\": \"test\", \"path\": \"/spec/volumes\", \"value\": [{\"name\":\"loaded\",\"persistentVolumeClaim\":{\"claimName\":\"iso\"}}]}, { \"op\": \"test\", \"path\": \"/spec/domain/devices/disks\", \"value\": [{\"name\":\"loaded\",\"cdrom\":{}},{\"name\":\"empty\",\"cdrom\":{}}]}, { \"op\": \"replace\", \"path\": \"/spec/volumes\", \"value\": [{\"name\":\"loaded\",\"persistentVolumeClaim\":{\"claimName\":\"iso\"}},{\"name\":\"empty\",\"dataVolume\":{\"name\":\"iso2\"}}]}, { \"op\": \"replace\", \"path\": \"/spec/domain/devices/disks\", \"value\": [{\"name\":\"loaded\",\"cdrom\":{}},{\"name\":\"empty\",\"cdrom\":{}}]}]",
+				false),
+			table.Entry("eject request for an empty CD-ROM",
+				&v1.VirtualMachineVolumeRequest{
+					EjectCDRomOptions: &v1.EjectCDRomOptions{Name: "empty"},
+				},
+				"",
+				true),
+			table.Entry("insert request for a CD-ROM that does not exist",
+				&v1.VirtualMachineVolumeRequest{
+					InsertCDRomOptions: &v1.InsertCDRomOptions{Name: "non-existent", VolumeSource: isoSource},
+				},
+				"",
+				true),
+		)
+
+		table.DescribeTable("Should generate expected vm patch", func(volumeRequest *v1.VirtualMachineVolumeRequest, existingVolumeRequests []v1.VirtualMachineVolumeRequest, expectedPatch string, expectError bool) {
+			vm := newMinimalVM(request.PathParameter("name"))
+			vm.Namespace = "default"
+			vm.Status.VolumeRequests = existingVolumeRequests
+
+			patch, err := generateVMVolumeRequestPatch(vm, volumeRequest)
+			if expectError {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+
+			Expect(patch).To(Equal(expectedPatch))
+		},
+			table.Entry("eject request with no existing volume requests",
+				&v1.VirtualMachineVolumeRequest{
+					EjectCDRomOptions: &v1.EjectCDRomOptions{Name: "cdrom"},
+				},
+				nil,
+				"[{ \"op\": \"test\", \"path\": \"/status/volumeRequests\", \"value\": null}, { \"op\": \"add\", \"path\": \"/status/volumeRequests\", \"value\": [{\"ejectCDRomOptions\":{\"name\":\"cdrom\"}}]}]",
+				false),
+			table.Entry("insert request while the media is still being ejected should fail",
+				&v1.VirtualMachineVolumeRequest{
+					InsertCDRomOptions: &v1.InsertCDRomOptions{Name: "cdrom", VolumeSource: isoSource},
+				},
+				[]v1.VirtualMachineVolumeRequest{
+					{
+						EjectCDRomOptions: &v1.EjectCDRomOptions{Name: "cdrom"},
+					},
+				},
+				"",
+				true),
+		)
+	})
+
+	Context("Add/Remove Interface Subresource api", func() {
+
+		newAddInterfaceBody := func(opts *v1.AddInterfaceOptions) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+		newRemoveInterfaceBody := func(opts *v1.RemoveInterfaceOptions) io.ReadCloser {
+			optsJson, _ := json.Marshal(opts)
+			return &readCloserWrapper{bytes.NewReader(optsJson)}
+		}
+
+		newRunningVMIWithHotpluggedInterface := func(name string) *v1.VirtualMachineInstance {
+			vmi := v1.NewMinimalVMI(name)
+			vmi.Namespace = "default"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Networks = []v1.Network{
+				*v1.DefaultPodNetwork(),
+				{Name: "existingnet", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad1"}}},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				*v1.DefaultBridgeNetworkInterface(),
+				{Name: "existingnet", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			}
+			vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: "existingnet", PodInterfaceName: "net1", Phase: v1.InterfaceHotplugReady},
+			}
+			return vmi
+		}
+
+		BeforeEach(func() {
+			request.PathParameters()["name"] = "testvm"
+			request.PathParameters()["namespace"] = "default"
+		})
+
+		table.DescribeTable("Should handle interface request", func(addOpts *v1.AddInterfaceOptions, removeOpts *v1.RemoveInterfaceOptions, isVM bool, code int, enableGate bool) {
+
+			if enableGate {
+				enableFeatureGate(virtconfig.HotplugNICsGate)
+			}
+			if addOpts != nil {
+				request.Request.Body = newAddInterfaceBody(addOpts)
+			} else {
+				request.Request.Body = newRemoveInterfaceBody(removeOpts)
+			}
+
+			if isVM {
+				vm := newMinimalVM(request.PathParameter("name"))
+				vm.Namespace = "default"
+
+				patchedVM := vm.DeepCopy()
+				patchedVM.Status.InterfaceRequests = append(patchedVM.Status.InterfaceRequests, v1.VirtualMachineInterfaceRequest{AddInterfaceOptions: addOpts, RemoveInterfaceOptions: removeOpts})
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachines/testvm"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, vm),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachines/testvm/status"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, patchedVM),
+					),
+				)
+				if addOpts != nil {
+					app.VMAddInterfaceRequestHandler(request, response)
+				} else {
+					app.VMRemoveInterfaceRequestHandler(request, response)
+				}
+			} else {
+				vmi := newRunningVMIWithHotpluggedInterface(request.PathParameter("name"))
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvm"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PATCH", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvm"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+					),
+				)
+
+				if addOpts != nil {
+					app.VMIAddInterfaceRequestHandler(request, response)
+				} else {
+					app.VMIRemoveInterfaceRequestHandler(request, response)
+				}
+			}
+
+			Expect(response.StatusCode()).To(Equal(code))
+		},
+			table.Entry("VM with a valid add interface request", &v1.AddInterfaceOptions{
+				Name:                            "net2",
+				NetworkAttachmentDefinitionName: "nad2",
+			}, nil, true, http.StatusAccepted, true),
+			table.Entry("VMI with a valid add interface request", &v1.AddInterfaceOptions{
+				Name:                            "net2",
+				NetworkAttachmentDefinitionName: "nad2",
+			}, nil, false, http.StatusAccepted, true),
+			table.Entry("VMI with an invalid add interface request that's missing a name", &v1.AddInterfaceOptions{
+				NetworkAttachmentDefinitionName: "nad2",
+			}, nil, false, http.StatusBadRequest, true),
+			table.Entry("VMI with an invalid add interface request that's missing a network attachment definition", &v1.AddInterfaceOptions{
+				Name: "net2",
+			}, nil, false, http.StatusBadRequest, true),
+			table.Entry("VMI with an add interface request for an existing interface", &v1.AddInterfaceOptions{
+				Name:                            "existingnet",
+				NetworkAttachmentDefinitionName: "nad2",
+			}, nil, false, http.StatusConflict, true),
+			table.Entry("VM with a valid remove interface request", nil, &v1.RemoveInterfaceOptions{
+				Name: "net2",
+			}, true, http.StatusAccepted, true),
+			table.Entry("VMI with a valid remove interface request", nil, &v1.RemoveInterfaceOptions{
+				Name: "existingnet",
+			}, false, http.StatusAccepted, true),
+			table.Entry("VMI with a remove interface request for an interface which was not hotplugged", nil, &v1.RemoveInterfaceOptions{
+				Name: "default",
+			}, false, http.StatusConflict, true),
+			table.Entry("VMI with a invalid remove interface request missing a name", nil, &v1.RemoveInterfaceOptions{}, false, http.StatusBadRequest, true),
+			table.Entry("VMI with a valid remove interface request but no feature gate", nil, &v1.RemoveInterfaceOptions{
+				Name: "existingnet",
+			}, false, http.StatusBadRequest, false),
+			table.Entry("VM with a valid add interface request but no feature gate", &v1.AddInterfaceOptions{
+				Name:                            "net2",
+				NetworkAttachmentDefinitionName: "nad2",
+			}, nil, true, http.StatusBadRequest, false),
+		)
+
+		table.DescribeTable("Should generate expected vmi patch", func(interfaceRequest *v1.VirtualMachineInterfaceRequest, expectedPatch string, expectError bool) {
+
+			vmi := newRunningVMIWithHotpluggedInterface(request.PathParameter("name"))
+			vmi.Spec.Networks = vmi.Spec.Networks[1:]
+			vmi.Spec.Domain.Devices.Interfaces = vmi.Spec.Domain.Devices.Interfaces[1:]
+
+			patch, err := generateVMIInterfaceRequestPatch(vmi, interfaceRequest)
+			if expectError {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+
+			Expect(patch).To(Equal(expectedPatch))
+		},
+			table.Entry("add interface request",
+				&v1.VirtualMachineInterfaceRequest{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{
+						Name:                            "net2",
+						NetworkAttachmentDefinitionName: "nad2",
+					},
+				},
+				"[{ \"op\": \"test\", \"path\": \"/spec/networks\", \"value\": [{\"name\":\"existingnet\",\"multus\":{\"networkName\":\"nad1\"}}]}, { \"op\": \"test\", \"path\": \"/spec/domain/devices/interfaces\", \"value\": [{\"name\":\"existingnet\",\"bridge\":{}}]}, { \"op\": \"replace\", \"path\": \"/spec/networks\", \"value\": [{\"name\":\"existingnet\",\"multus\":{\"networkName\":\"nad1\"}},{\"name\":\"net2\",\"multus\":{\"networkName\":\"nad2\"}}]}, { \"op\": \"replace\", \"path\": \"/spec/domain/devices/interfaces\", \"value\": [{\"name\":\"existingnet\",\"bridge\":{}},{\"name\":\"net2\",\"bridge\":{}}]}]",
+				false),
+			table.Entry("remove interface request",
+				&v1.VirtualMachineInterfaceRequest{
+					RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+						Name: "existingnet",
+					},
+				},
+				"[{ \"op\": \"test\", \"path\": \"/spec/networks\", \"value\": [{\"name\":\"existingnet\",\"multus\":{\"networkName\":\"nad1\"}}]}, { \"op\": \"test\", \"path\": \"/spec/domain/devices/interfaces\", \"value\": [{\"name\":\"existingnet\",\"bridge\":{}}]}, { \"op\": \"replace\", \"path\": \"/spec/networks\", \"value\": []}, { \"op\": \"replace\", \"path\": \"/spec/domain/devices/interfaces\", \"value\": []}]",
+				false),
+			table.Entry("remove interface request that does not exist should fail",
+				&v1.VirtualMachineInterfaceRequest{
+					RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+						Name: "net2",
+					},
+				},
+				"",
+				true),
+			table.Entry("add interface request that already exists should fail",
+				&v1.VirtualMachineInterfaceRequest{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{
+						Name:                            "existingnet",
+						NetworkAttachmentDefinitionName: "nad1",
+					},
+				},
+				"",
+				true),
+		)
+
+		table.DescribeTable("Should generate expected vm patch", func(interfaceRequest *v1.VirtualMachineInterfaceRequest, existingInterfaceRequests []v1.VirtualMachineInterfaceRequest, expectedPatch string, expectError bool) {
+
+			vm := newMinimalVM(request.PathParameter("name"))
+			vm.Namespace = "default"
+
+			if len(existingInterfaceRequests) > 0 {
+				vm.Status.InterfaceRequests = existingInterfaceRequests
+			}
+
+			patch, err := generateVMInterfaceRequestPatch(vm, interfaceRequest)
+			if expectError {
+				Expect(err).ToNot(BeNil())
+			} else {
+				Expect(err).To(BeNil())
+			}
+
+			Expect(patch).To(Equal(expectedPatch))
+		},
+			table.Entry("add interface request with no existing interface requests",
+				&v1.VirtualMachineInterfaceRequest{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{
+						Name:                            "net1",
+						NetworkAttachmentDefinitionName: "nad1",
+					},
+				},
+				nil,
+				"[{ \"op\": \"test\", \"path\": \"/status/interfaceRequests\", \"value\": null}, { \"op\": \"add\", \"path\": \"/status/interfaceRequests\", \"value\": [{\"addInterfaceOptions\":{\"networkAttachmentDefinitionName\":\"nad1\",\"name\":\"net1\"}}]}]",
+				false),
+			table.Entry("add interface request that already exists should fail",
+				&v1.VirtualMachineInterfaceRequest{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{
+						Name:                            "net1",
+						NetworkAttachmentDefinitionName: "nad1",
+					},
+				},
+				[]v1.VirtualMachineInterfaceRequest{
+					{
+						AddInterfaceOptions: &v1.AddInterfaceOptions{
+							Name:                            "net1",
+							NetworkAttachmentDefinitionName: "nad1",
+						},
+					},
+				},
+				"",
+				true),
+			table.Entry("remove interface request should replace add interface request",
+				&v1.VirtualMachineInterfaceRequest{
+					RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+						Name: "net1",
+					},
+				},
+				[]v1.VirtualMachineInterfaceRequest{
+					{
+						AddInterfaceOptions: &v1.AddInterfaceOptions{
+							Name:                            "net1",
+							NetworkAttachmentDefinitionName: "nad1",
+						},
+					},
+				},
+				"[{ \"op\": \"test\", \"path\": \"/status/interfaceRequests\", \"value\": [{\"addInterfaceOptions\":{\"networkAttachmentDefinitionName\":\"nad1\",\"name\":\"net1\"}}]}, { \"op\": \"replace\", \"path\": \"/status/interfaceRequests\", \"value\": [{\"removeInterfaceOptions\":{\"name\":\"net1\"}}]}]",
+				false),
+			table.Entry("remove interface request that already exists should fail",
+				&v1.VirtualMachineInterfaceRequest{
+					RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+						Name: "net1",
+					},
+				},
+				[]v1.VirtualMachineInterfaceRequest{
+					{
+						RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+							Name: "net1",
+						},
+					},
+				},
+				"",
+				true),
+		)
+	})
+
 	Context("Subresource api - error handling for StartVMRequestHandler", func() {
 		BeforeEach(func() {
 			request.PathParameters()["name"] = "testvm"
@@ -1849,6 +2316,367 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Freezing", func() {
+		expectVMIWithGuestAgent := func(running, agentConnected bool) {
+			request.PathParameters()["name"] = "testvmi"
+			request.PathParameters()["namespace"] = "default"
+
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Status.Phase = v1.Running
+			if !running {
+				vmi.Status.Phase = v1.Failed
+			}
+			if agentConnected {
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+					{
+						Type:   v1.VirtualMachineInstanceAgentConnected,
+						Status: k8sv1.ConditionTrue,
+					},
+				}
+			}
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+				),
+			)
+
+			expectHandlerPod()
+		}
+
+		newFreezeBody := func(unfreezeTimeout *k8smetav1.Duration) io.ReadCloser {
+			body, _ := json.Marshal(&v1.FreezeUnfreezeTimeout{UnfreezeTimeout: unfreezeTimeout})
+			return ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		It("Should freeze a running VMI with guest agent", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/freeze"),
+					ghttp.VerifyBody([]byte(`{"unfreezeTimeout":"1m0s"}`)),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMIWithGuestAgent(true, true)
+
+			request.Request.Body = newFreezeBody(&k8smetav1.Duration{Duration: time.Minute})
+			app.FreezeVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail freezing a VMI without guest agent", func() {
+			expectVMIWithGuestAgent(true, false)
+
+			request.Request.Body = newFreezeBody(&k8smetav1.Duration{Duration: time.Minute})
+			app.FreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail freezing a not running VMI", func() {
+			expectVMIWithGuestAgent(false, true)
+
+			request.Request.Body = newFreezeBody(&k8smetav1.Duration{Duration: time.Minute})
+			app.FreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail freezing without an unfreeze timeout", func() {
+			request.Request.Body = newFreezeBody(nil)
+			app.FreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("Should fail freezing with a negative unfreeze timeout", func() {
+			request.Request.Body = newFreezeBody(&k8smetav1.Duration{Duration: -time.Minute})
+			app.FreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("Should unfreeze a running VMI", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/unfreeze"),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMIWithGuestAgent(true, true)
+
+			app.UnfreezeVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail unfreezing a not running VMI", func() {
+			expectVMIWithGuestAgent(false, true)
+
+			app.UnfreezeVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
+	Context("Backup", func() {
+		expectVMIWithBackupState := func(running bool, backupState *v1.VirtualMachineInstanceBackupState) {
+			request.PathParameters()["name"] = "testvmi"
+			request.PathParameters()["namespace"] = "default"
+
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Status.Phase = v1.Running
+			if !running {
+				vmi.Status.Phase = v1.Failed
+			}
+			vmi.Status.BackupState = backupState
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+				),
+			)
+
+			expectHandlerPod()
+		}
+
+		newBody := func(opts interface{}) io.ReadCloser {
+			body, _ := json.Marshal(opts)
+			return ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		pullBackupOptions := func() *v1.VirtualMachineInstanceBackupOptions {
+			return &v1.VirtualMachineInstanceBackupOptions{
+				BackupName: "mybackup",
+				Mode:       v1.BackupModePull,
+				Checkpoint: "mybackup-checkpoint",
+			}
+		}
+
+		BeforeEach(func() {
+			enableFeatureGate(virtconfig.IncrementalBackupGate)
+		})
+
+		It("Should start a backup of a running VMI", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/backup"),
+					ghttp.VerifyBody([]byte(`{"backupName":"mybackup","mode":"Pull","checkpoint":"mybackup-checkpoint"}`)),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMIWithBackupState(true, &v1.VirtualMachineInstanceBackupState{
+				BackupName: "oldbackup",
+				Completed:  true,
+			})
+
+			request.Request.Body = newBody(pullBackupOptions())
+			app.BackupVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail starting a backup while another one is running", func() {
+			expectVMIWithBackupState(true, &v1.VirtualMachineInstanceBackupState{
+				BackupName: "oldbackup",
+			})
+
+			request.Request.Body = newBody(pullBackupOptions())
+			app.BackupVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail starting a backup of a not running VMI", func() {
+			expectVMIWithBackupState(false, nil)
+
+			request.Request.Body = newBody(pullBackupOptions())
+			app.BackupVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		table.DescribeTable("Should reject invalid backup options", func(mutate func(opts *v1.VirtualMachineInstanceBackupOptions)) {
+			opts := pullBackupOptions()
+			mutate(opts)
+
+			request.Request.Body = newBody(opts)
+			app.BackupVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			table.Entry("without a backup name", func(opts *v1.VirtualMachineInstanceBackupOptions) {
+				opts.BackupName = ""
+			}),
+			table.Entry("without a checkpoint", func(opts *v1.VirtualMachineInstanceBackupOptions) {
+				opts.Checkpoint = ""
+			}),
+			table.Entry("with an unknown mode", func(opts *v1.VirtualMachineInstanceBackupOptions) {
+				opts.Mode = "Sideways"
+			}),
+			table.Entry("with a push backup without a target", func(opts *v1.VirtualMachineInstanceBackupOptions) {
+				opts.Mode = v1.BackupModePush
+			}),
+			table.Entry("with a pull backup with a target", func(opts *v1.VirtualMachineInstanceBackupOptions) {
+				opts.Target = &v1.BackupTarget{ClaimName: "target", PodUID: "1234"}
+			}),
+		)
+
+		It("Should fail starting a backup without the feature gate", func() {
+			disableFeatureGates()
+
+			request.Request.Body = newBody(pullBackupOptions())
+			app.BackupVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("Should abort the running backup", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/abortbackup"),
+					ghttp.VerifyBody([]byte(`{"backupName":"mybackup"}`)),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMIWithBackupState(true, &v1.VirtualMachineInstanceBackupState{
+				BackupName: "mybackup",
+			})
+
+			request.Request.Body = newBody(&v1.VirtualMachineInstanceAbortBackupOptions{BackupName: "mybackup"})
+			app.AbortBackupVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail aborting a backup which is not running", func() {
+			expectVMIWithBackupState(true, &v1.VirtualMachineInstanceBackupState{
+				BackupName: "otherbackup",
+			})
+
+			request.Request.Body = newBody(&v1.VirtualMachineInstanceAbortBackupOptions{BackupName: "mybackup"})
+			app.AbortBackupVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail connecting to the NBD export of a VMI without a running backup", func() {
+			expectVMIWithBackupState(true, &v1.VirtualMachineInstanceBackupState{
+				BackupName: "mybackup",
+				Completed:  true,
+			})
+
+			app.NBDRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+	})
+
+	Context("I/O limits", func() {
+		expectVMIWithDisk := func(running bool, target string) {
+			request.PathParameters()["name"] = "testvmi"
+			request.PathParameters()["namespace"] = "default"
+
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Status.Phase = v1.Running
+			if !running {
+				vmi.Status.Phase = v1.Failed
+			}
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "rootdisk"}}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "rootdisk", Target: target}}
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/apis/kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, vmi),
+				),
+			)
+
+			expectHandlerPod()
+		}
+
+		newBody := func(opts *v1.SetBlockIOTuneOptions) io.ReadCloser {
+			body, _ := json.Marshal(opts)
+			return ioutil.NopCloser(bytes.NewReader(body))
+		}
+
+		newOptions := func(name string) *v1.SetBlockIOTuneOptions {
+			totalBytesSec := resource.MustParse("100Mi")
+			return &v1.SetBlockIOTuneOptions{
+				Name:   name,
+				IOTune: v1.DiskIOTune{TotalBytesSec: &totalBytesSec},
+			}
+		}
+
+		It("Should set the I/O limits of a disk of a running VMI", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/setblockiotune"),
+					ghttp.VerifyBody([]byte(`{"name":"rootdisk","ioTune":{"totalBytesSec":"100Mi"}}`)),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMIWithDisk(true, "vda")
+
+			request.Request.Body = newBody(newOptions("rootdisk"))
+			app.SetBlockIOTuneVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should fail setting the I/O limits of a not running VMI", func() {
+			expectVMIWithDisk(false, "vda")
+
+			request.Request.Body = newBody(newOptions("rootdisk"))
+			app.SetBlockIOTuneVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail setting the I/O limits of a disk which is not attached", func() {
+			expectVMIWithDisk(true, "")
+
+			request.Request.Body = newBody(newOptions("rootdisk"))
+			app.SetBlockIOTuneVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("Should fail setting the I/O limits of an unknown disk", func() {
+			expectVMIWithDisk(true, "vda")
+
+			request.Request.Body = newBody(newOptions("datadisk"))
+			app.SetBlockIOTuneVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		table.DescribeTable("Should reject invalid I/O limits", func(mutate func(opts *v1.SetBlockIOTuneOptions)) {
+			opts := newOptions("rootdisk")
+			mutate(opts)
+
+			request.Request.Body = newBody(opts)
+			app.SetBlockIOTuneVMIRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			table.Entry("without a disk name", func(opts *v1.SetBlockIOTuneOptions) {
+				opts.Name = ""
+			}),
+			table.Entry("with a total limit combined with a read limit", func(opts *v1.SetBlockIOTuneOptions) {
+				readBytesSec := resource.MustParse("10Mi")
+				opts.IOTune.ReadBytesSec = &readBytesSec
+			}),
+			table.Entry("with a burst lower than the limit", func(opts *v1.SetBlockIOTuneOptions) {
+				totalBytesSecMax := resource.MustParse("10Mi")
+				opts.IOTune.TotalBytesSecMax = &totalBytesSecMax
+			}),
+		)
+	})
+
 	AfterEach(func() {
 		server.Close()
 		backend.Close()
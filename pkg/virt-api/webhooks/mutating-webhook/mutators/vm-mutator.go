@@ -24,14 +24,17 @@ import (
 	"k8s.io/api/admission/v1beta1"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	apiinstancetype "kubevirt.io/client-go/apis/instancetype"
 	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 type VMsMutator struct {
-	ClusterConfig *virtconfig.ClusterConfig
+	ClusterConfig       *virtconfig.ClusterConfig
+	InstancetypeMethods instancetype.Methods
 }
 
 // until the minimum supported version is kubernetes 1.15 (see https://github.com/kubernetes/kubernetes/commit/c2fcdc818be1441dd788cae22648c04b1650d3af#diff-e057ec5b2ec27b4ba1e1a3915f715262)
@@ -64,6 +67,8 @@ func (mutator *VMsMutator) Mutate(ar *v1beta1.AdmissionReview) *v1beta1.Admissio
 
 	// Set VM defaults
 	log.Log.Object(&vm).V(4).Info("Apply defaults")
+	mutator.setDefaultInstancetypeKind(&vm)
+	mutator.setDefaultPreferenceKind(&vm)
 	mutator.setDefaultMachineType(&vm)
 
 	var patch []patchOperation
@@ -101,7 +106,38 @@ func (mutator *VMsMutator) setDefaultMachineType(vm *v1.VirtualMachine) {
 		// nothing to do, let's the validating webhook fail later
 		return
 	}
+	if vm.Spec.Template.Spec.Domain.Machine.Type == "" {
+		vm.Spec.Template.Spec.Domain.Machine.Type = mutator.getPreferredMachineType(vm)
+	}
 	if vm.Spec.Template.Spec.Domain.Machine.Type == "" {
 		vm.Spec.Template.Spec.Domain.Machine.Type = mutator.ClusterConfig.GetMachineType()
 	}
 }
+
+func (mutator *VMsMutator) getPreferredMachineType(vm *v1.VirtualMachine) string {
+	if vm.Spec.Preference == nil || mutator.InstancetypeMethods == nil {
+		return ""
+	}
+	preferenceSpec, err := mutator.InstancetypeMethods.FindPreferenceSpec(vm)
+	if err != nil {
+		// the validating webhook rejects VMs referencing unknown preferences
+		log.Log.Object(vm).V(4).Reason(err).Info("Failed to find the preference of the VM")
+		return ""
+	}
+	if preferenceSpec == nil || preferenceSpec.Machine == nil {
+		return ""
+	}
+	return preferenceSpec.Machine.PreferredMachineType
+}
+
+func (mutator *VMsMutator) setDefaultInstancetypeKind(vm *v1.VirtualMachine) {
+	if vm.Spec.Instancetype != nil && vm.Spec.Instancetype.Kind == "" {
+		vm.Spec.Instancetype.Kind = apiinstancetype.VirtualMachineClusterInstancetypeKind
+	}
+}
+
+func (mutator *VMsMutator) setDefaultPreferenceKind(vm *v1.VirtualMachine) {
+	if vm.Spec.Preference != nil && vm.Spec.Preference.Kind == "" {
+		vm.Spec.Preference.Kind = apiinstancetype.VirtualMachineClusterPreferenceKind
+	}
+}
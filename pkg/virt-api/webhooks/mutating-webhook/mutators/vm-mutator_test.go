@@ -31,6 +31,9 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	apiinstancetype "kubevirt.io/client-go/apis/instancetype"
+	instancetypev1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -115,4 +118,55 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		vmSpec, _ := getVMSpecMetaFromResponse()
 		Expect(vmSpec.Template.Spec.Domain.Machine.Type).To(Equal(vm.Spec.Template.Spec.Domain.Machine.Type))
 	})
+
+	Context("with instancetypes and preferences", func() {
+
+		var clusterPreferenceStore cache.Store
+
+		BeforeEach(func() {
+			clusterPreferenceStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+			mutator.InstancetypeMethods = instancetype.NewMethods(nil, nil, nil, clusterPreferenceStore, nil)
+
+			Expect(clusterPreferenceStore.Add(&instancetypev1.VirtualMachineClusterPreference{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "preference"},
+				Spec: instancetypev1.VirtualMachinePreferenceSpec{
+					Machine: &instancetypev1.MachinePreferences{PreferredMachineType: "pc-i440fx-2.10"},
+				},
+			})).To(Succeed())
+		})
+
+		It("should default the kinds to the cluster wide resources", func() {
+			vm.Spec.Instancetype = &v1.InstancetypeMatcher{Name: "instancetype"}
+			vm.Spec.Preference = &v1.PreferenceMatcher{Name: "preference"}
+
+			vmSpec, _ := getVMSpecMetaFromResponse()
+			Expect(vmSpec.Instancetype.Kind).To(Equal(apiinstancetype.VirtualMachineClusterInstancetypeKind))
+			Expect(vmSpec.Preference.Kind).To(Equal(apiinstancetype.VirtualMachineClusterPreferenceKind))
+		})
+
+		It("should not override specified kinds", func() {
+			vm.Spec.Instancetype = &v1.InstancetypeMatcher{Name: "instancetype", Kind: apiinstancetype.VirtualMachineInstancetypeKind}
+			vm.Spec.Preference = &v1.PreferenceMatcher{Name: "preference", Kind: apiinstancetype.VirtualMachinePreferenceKind}
+			vm.Spec.Template.Spec.Domain.Machine.Type = "q35"
+
+			vmSpec, _ := getVMSpecMetaFromResponse()
+			Expect(vmSpec.Instancetype.Kind).To(Equal(apiinstancetype.VirtualMachineInstancetypeKind))
+			Expect(vmSpec.Preference.Kind).To(Equal(apiinstancetype.VirtualMachinePreferenceKind))
+		})
+
+		It("should use the preferred machine type of the preference", func() {
+			vm.Spec.Preference = &v1.PreferenceMatcher{Name: "preference"}
+
+			vmSpec, _ := getVMSpecMetaFromResponse()
+			Expect(vmSpec.Template.Spec.Domain.Machine.Type).To(Equal("pc-i440fx-2.10"))
+		})
+
+		It("should prefer the machine type of the template over the preference", func() {
+			vm.Spec.Preference = &v1.PreferenceMatcher{Name: "preference"}
+			vm.Spec.Template.Spec.Domain.Machine.Type = "q35"
+
+			vmSpec, _ := getVMSpecMetaFromResponse()
+			Expect(vmSpec.Template.Spec.Domain.Machine.Type).To(Equal("q35"))
+		})
+	})
 })
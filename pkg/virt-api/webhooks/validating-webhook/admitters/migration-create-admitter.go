@@ -52,6 +52,10 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1b
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("LiveMigration feature gate is not enabled in kubevirt-config"))
 	}
 
+	if len(migration.Spec.VolumeMigrations) > 0 && !admitter.ClusterConfig.VolumeMigrationEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("VolumeMigration feature gate is not enabled in kubevirt-config"))
+	}
+
 	causes := ValidateVirtualMachineInstanceMigrationSpec(k8sfield.NewPath("spec"), &migration.Spec)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
@@ -75,10 +79,18 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1b
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("Cannot migrated VMI in finalized state."))
 	}
 
-	// Reject migration jobs for non-migratable VMIs
+	causes = validateVolumeMigrationSources(k8sfield.NewPath("spec").Child("volumeMigrations"), migration.Spec.VolumeMigrations, vmi)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	// Reject migration jobs for non-migratable VMIs. VMIs with non-shared disks
+	// can still move them to new claims with volume migrations, virt-handler
+	// checks the remaining volumes once the migration is scheduled.
 	for _, c := range vmi.Status.Conditions {
 		if c.Type == v1.VirtualMachineInstanceIsMigratable &&
-			c.Status == k8sv1.ConditionFalse {
+			c.Status == k8sv1.ConditionFalse &&
+			!(c.Reason == v1.VirtualMachineInstanceReasonDisksNotMigratable && len(migration.Spec.VolumeMigrations) > 0) {
 			errMsg := fmt.Errorf("Cannot migrate VMI, Reason: %s, Message: %s",
 				c.Reason, c.Message)
 			return webhookutils.ToAdmissionResponseError(errMsg)
@@ -95,6 +107,11 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1b
 		return webhookutils.ToAdmissionResponseError(fmt.Errorf("in-flight migration detected. Active migration job (%s) is currently already in progress for VMI %s.", string(vmi.Status.MigrationState.MigrationUID), vmi.Name))
 	}
 
+	// The backup job runs in the source domain, it can't follow the VMI
+	if vmi.Status.BackupState != nil && !vmi.Status.BackupState.Completed {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("backup %s is in progress for VMI %s", vmi.Status.BackupState.BackupName, vmi.Name))
+	}
+
 	reviewResponse := v1beta1.AdmissionResponse{}
 	reviewResponse.Allowed = true
 	return &reviewResponse
@@ -138,5 +155,105 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	causes = append(causes, validateVolumeMigrations(field.Child("volumeMigrations"), spec.VolumeMigrations)...)
+
+	return causes
+}
+
+func validateVolumeMigrations(field *k8sfield.Path, volumeMigrations []v1.VolumeMigration) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	sourceVolumes := make(map[string]struct{})
+	destinations := make(map[string]struct{})
+
+	for idx, volumeMigration := range volumeMigrations {
+		if volumeMigration.SourceVolume == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s is missing", field.Index(idx).Child("sourceVolume").String()),
+				Field:   field.Index(idx).Child("sourceVolume").String(),
+			})
+			continue
+		}
+		if _, exists := sourceVolumes[volumeMigration.SourceVolume]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("volume %s is migrated more than once", volumeMigration.SourceVolume),
+				Field:   field.Index(idx).Child("sourceVolume").String(),
+			})
+		}
+		sourceVolumes[volumeMigration.SourceVolume] = struct{}{}
+
+		if (volumeMigration.DestinationPVC == "") == (volumeMigration.DestinationDataVolume == "") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s must have exactly one of destinationPVC or destinationDataVolume", volumeMigration.SourceVolume),
+				Field:   field.Index(idx).String(),
+			})
+			continue
+		}
+		destination := volumeMigration.DestinationPVC + volumeMigration.DestinationDataVolume
+		if _, exists := destinations[destination]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("claim %s is the destination of more than one volume", destination),
+				Field:   field.Index(idx).String(),
+			})
+		}
+		destinations[destination] = struct{}{}
+	}
+
+	return causes
+}
+
+func validateVolumeMigrationSources(field *k8sfield.Path, volumeMigrations []v1.VolumeMigration, vmi *v1.VirtualMachineInstance) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	volumes := make(map[string]v1.Volume)
+	for _, volume := range vmi.Spec.Volumes {
+		volumes[volume.Name] = volume
+	}
+	hotplugVolumes := make(map[string]struct{})
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.HotplugVolume != nil {
+			hotplugVolumes[volumeStatus.Name] = struct{}{}
+		}
+	}
+
+	for idx, volumeMigration := range volumeMigrations {
+		volume, exists := volumes[volumeMigration.SourceVolume]
+		if !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: fmt.Sprintf("volume %s does not exist in VMI %s", volumeMigration.SourceVolume, vmi.Name),
+				Field:   field.Index(idx).Child("sourceVolume").String(),
+			})
+			continue
+		}
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s is not a PersistentVolumeClaim or DataVolume and can't be migrated", volumeMigration.SourceVolume),
+				Field:   field.Index(idx).Child("sourceVolume").String(),
+			})
+			continue
+		}
+		if _, isHotplug := hotplugVolumes[volumeMigration.SourceVolume]; isHotplug {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s is hotplugged and can't be migrated", volumeMigration.SourceVolume),
+				Field:   field.Index(idx).Child("sourceVolume").String(),
+			})
+			continue
+		}
+		destination := volumeMigration.DestinationPVC + volumeMigration.DestinationDataVolume
+		if (volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == destination) ||
+			(volume.DataVolume != nil && volume.DataVolume.Name == destination) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("volume %s already uses claim %s", volumeMigration.SourceVolume, destination),
+				Field:   field.Index(idx).String(),
+			})
+		}
+	}
+
 	return causes
 }
@@ -179,6 +179,41 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 		Expect(resp.Allowed).To(BeFalse())
 	})
 
+	It("should reject Migration spec on create when a backup of the VMI is in progress", func() {
+		vmi := v1.NewMinimalVMI("testmigratevmibackup")
+		vmi.Status.BackupState = &v1.VirtualMachineInstanceBackupState{
+			BackupName: "mybackup",
+		}
+
+		informers := webhooks.GetInformers()
+		informers.VMIInformer.GetIndexer().Add(vmi)
+
+		migration := v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: vmi.Namespace,
+			},
+			Spec: v1.VirtualMachineInstanceMigrationSpec{
+				VMIName: vmi.Name,
+			},
+		}
+		migrationBytes, _ := json.Marshal(&migration)
+
+		enableFeatureGate(virtconfig.LiveMigrationGate)
+
+		ar := &v1beta1.AdmissionReview{
+			Request: &v1beta1.AdmissionRequest{
+				Resource: webhooks.MigrationGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: migrationBytes,
+				},
+			},
+		}
+
+		resp := migrationCreateAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("backup mybackup is in progress"))
+	})
+
 	It("should accept Migration spec on create when previous VMI migration completed", func() {
 		vmi := v1.NewMinimalVMI("testmigratevmi4")
 		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
@@ -292,6 +327,124 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 		Expect(resp.Result.Message).To(ContainSubstring("DisksNotLiveMigratable"))
 	})
 
+	Context("with volume migrations", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = v1.NewMinimalVMI("testmigratevolumes")
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "pvc",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "old-pvc"},
+					},
+				},
+				{
+					Name: "dv",
+					VolumeSource: v1.VolumeSource{
+						DataVolume: &v1.DataVolumeSource{Name: "old-dv"},
+					},
+				},
+				{
+					Name: "hotplug",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "hotplug-pvc"},
+					},
+				},
+				{
+					Name: "cloudinit",
+					VolumeSource: v1.VolumeSource{
+						CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{Name: "hotplug", HotplugVolume: &v1.HotplugVolumeStatus{}},
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:    v1.VirtualMachineInstanceIsMigratable,
+					Status:  k8sv1.ConditionFalse,
+					Reason:  v1.VirtualMachineInstanceReasonDisksNotMigratable,
+					Message: "cannot migrate VMI: PVC old-pvc is not shared",
+				},
+			}
+			webhooks.GetInformers().VMIInformer.GetIndexer().Add(vmi)
+		})
+
+		admit := func(volumeMigrations ...v1.VolumeMigration) *v1beta1.AdmissionResponse {
+			migration := v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:          vmi.Name,
+					VolumeMigrations: volumeMigrations,
+				},
+			}
+			migrationBytes, _ := json.Marshal(&migration)
+
+			ar := &v1beta1.AdmissionReview{
+				Request: &v1beta1.AdmissionRequest{
+					Resource: webhooks.MigrationGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: migrationBytes,
+					},
+				},
+			}
+			return migrationCreateAdmitter.Admit(ar)
+		}
+
+		It("should reject volume migrations when the feature gate isn't enabled", func() {
+			enableFeatureGate(virtconfig.LiveMigrationGate)
+
+			resp := admit(v1.VolumeMigration{SourceVolume: "pvc", DestinationPVC: "new-pvc"})
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(ContainSubstring("VolumeMigration feature gate is not enabled"))
+		})
+
+		It("should accept volume migrations of a VMI with non-shared disks", func() {
+			enableFeatureGate(virtconfig.LiveMigrationGate + "," + virtconfig.VolumeMigrationGate)
+
+			resp := admit(
+				v1.VolumeMigration{SourceVolume: "pvc", DestinationPVC: "new-pvc"},
+				v1.VolumeMigration{SourceVolume: "dv", DestinationDataVolume: "new-dv"},
+			)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		table.DescribeTable("should reject invalid volume migrations", func(volumeMigration v1.VolumeMigration, field string) {
+			enableFeatureGate(virtconfig.LiveMigrationGate + "," + virtconfig.VolumeMigrationGate)
+
+			resp := admit(volumeMigration)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			table.Entry("without source volume", v1.VolumeMigration{DestinationPVC: "new-pvc"}, "spec.volumeMigrations[0].sourceVolume"),
+			table.Entry("without destination", v1.VolumeMigration{SourceVolume: "pvc"}, "spec.volumeMigrations[0]"),
+			table.Entry("with two destinations", v1.VolumeMigration{SourceVolume: "pvc", DestinationPVC: "new-pvc", DestinationDataVolume: "new-dv"}, "spec.volumeMigrations[0]"),
+			table.Entry("with an unknown source volume", v1.VolumeMigration{SourceVolume: "unknown", DestinationPVC: "new-pvc"}, "spec.volumeMigrations[0].sourceVolume"),
+			table.Entry("with a source volume that is not a claim", v1.VolumeMigration{SourceVolume: "cloudinit", DestinationPVC: "new-pvc"}, "spec.volumeMigrations[0].sourceVolume"),
+			table.Entry("with a hotplugged source volume", v1.VolumeMigration{SourceVolume: "hotplug", DestinationPVC: "new-pvc"}, "spec.volumeMigrations[0].sourceVolume"),
+			table.Entry("with the claim the volume already uses", v1.VolumeMigration{SourceVolume: "pvc", DestinationPVC: "old-pvc"}, "spec.volumeMigrations[0]"),
+		)
+
+		It("should reject duplicate source volumes and destinations", func() {
+			enableFeatureGate(virtconfig.LiveMigrationGate + "," + virtconfig.VolumeMigrationGate)
+
+			resp := admit(
+				v1.VolumeMigration{SourceVolume: "pvc", DestinationPVC: "new-pvc"},
+				v1.VolumeMigration{SourceVolume: "pvc", DestinationPVC: "other-pvc"},
+				v1.VolumeMigration{SourceVolume: "dv", DestinationPVC: "new-pvc"},
+			)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(2))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.volumeMigrations[1].sourceVolume"))
+			Expect(resp.Result.Details.Causes[1].Field).To(Equal("spec.volumeMigrations[2]"))
+		})
+	})
+
 	table.DescribeTable("should reject documents containing unknown or missing fields for", func(data string, validationResult string, gvr metav1.GroupVersionResource, review func(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse) {
 		input := map[string]interface{}{}
 		json.Unmarshal([]byte(data), &input)
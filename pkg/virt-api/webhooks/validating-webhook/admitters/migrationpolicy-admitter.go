@@ -0,0 +1,108 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	migrationsv1 "kubevirt.io/client-go/apis/migrations/v1alpha1"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+)
+
+// MigrationPolicyAdmitter validates MigrationPolicies
+type MigrationPolicyAdmitter struct{}
+
+// Admit validates an AdmissionReview
+func (admitter *MigrationPolicyAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	if ar.Request.Resource.Group != migrationsv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "migrationpolicies" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	policy := &migrationsv1.MigrationPolicy{}
+	err := json.Unmarshal(ar.Request.Object.Raw, policy)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	causes := ValidateMigrationPolicySpec(k8sfield.NewPath("spec"), &policy.Spec)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := v1beta1.AdmissionResponse{}
+	reviewResponse.Allowed = true
+	return &reviewResponse
+}
+
+func ValidateMigrationPolicySpec(field *k8sfield.Path, spec *migrationsv1.MigrationPolicySpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Selectors != nil {
+		selectorsField := field.Child("selectors")
+		causes = append(causes, validateLabelSelector(selectorsField.Child("namespaceSelector"), spec.Selectors.NamespaceSelector)...)
+		causes = append(causes, validateLabelSelector(selectorsField.Child("virtualMachineInstanceSelector"), spec.Selectors.VirtualMachineInstanceSelector)...)
+	}
+
+	if spec.BandwidthPerMigration != nil && spec.BandwidthPerMigration.Sign() < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("bandwidthPerMigration must not be negative, got %s", spec.BandwidthPerMigration.String()),
+			Field:   field.Child("bandwidthPerMigration").String(),
+		})
+	}
+
+	if spec.CompletionTimeoutPerGiB != nil && *spec.CompletionTimeoutPerGiB < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("completionTimeoutPerGiB must not be negative, got %d", *spec.CompletionTimeoutPerGiB),
+			Field:   field.Child("completionTimeoutPerGiB").String(),
+		})
+	}
+
+	if spec.ProgressTimeout != nil && *spec.ProgressTimeout < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("progressTimeout must not be negative, got %d", *spec.ProgressTimeout),
+			Field:   field.Child("progressTimeout").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateLabelSelector(field *k8sfield.Path, selector *metav1.LabelSelector) []metav1.StatusCause {
+	if selector == nil {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid label selector: %v", err),
+			Field:   field.String(),
+		}}
+	}
+	return nil
+}
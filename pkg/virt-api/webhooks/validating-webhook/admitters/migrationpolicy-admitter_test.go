@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admission/v1beta1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	migrationsv1 "kubevirt.io/client-go/apis/migrations/v1alpha1"
+)
+
+var _ = Describe("Validating MigrationPolicy Admitter", func() {
+	policyAdmitter := &MigrationPolicyAdmitter{}
+
+	policyResource := metav1.GroupVersionResource{
+		Group:    migrationsv1.SchemeGroupVersion.Group,
+		Version:  migrationsv1.SchemeGroupVersion.Version,
+		Resource: "migrationpolicies",
+	}
+
+	admit := func(resource metav1.GroupVersionResource, policy *migrationsv1.MigrationPolicy) *v1beta1.AdmissionResponse {
+		policyBytes, _ := json.Marshal(policy)
+		ar := &v1beta1.AdmissionReview{
+			Request: &v1beta1.AdmissionRequest{
+				Resource: resource,
+				Object: runtime.RawExtension{
+					Raw: policyBytes,
+				},
+			},
+		}
+		return policyAdmitter.Admit(ar)
+	}
+
+	It("should reject unexpected resources", func() {
+		response := admit(metav1.GroupVersionResource{Group: "kubevirt.io", Version: "v1", Resource: "virtualmachines"}, &migrationsv1.MigrationPolicy{})
+		Expect(response.Allowed).To(BeFalse())
+	})
+
+	It("should accept a valid policy", func() {
+		bandwidth := resource.MustParse("128Mi")
+		completionTimeout := int64(400)
+		policy := &migrationsv1.MigrationPolicy{
+			Spec: migrationsv1.MigrationPolicySpec{
+				Selectors: &migrationsv1.Selectors{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "storage"}},
+					VirtualMachineInstanceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"db"}}},
+					},
+				},
+				BandwidthPerMigration:   &bandwidth,
+				CompletionTimeoutPerGiB: &completionTimeout,
+			},
+		}
+		Expect(admit(policyResource, policy).Allowed).To(BeTrue())
+	})
+
+	table.DescribeTable("should reject", func(spec migrationsv1.MigrationPolicySpec, field string) {
+		response := admit(policyResource, &migrationsv1.MigrationPolicy{Spec: spec})
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		table.Entry("an invalid namespace selector", migrationsv1.MigrationPolicySpec{
+			Selectors: &migrationsv1.Selectors{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "bogus"}},
+				},
+			},
+		}, "spec.selectors.namespaceSelector"),
+		table.Entry("an invalid vmi selector", migrationsv1.MigrationPolicySpec{
+			Selectors: &migrationsv1.Selectors{
+				VirtualMachineInstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "not a value"}},
+			},
+		}, "spec.selectors.virtualMachineInstanceSelector"),
+		table.Entry("a negative bandwidth", migrationsv1.MigrationPolicySpec{
+			BandwidthPerMigration: resource.NewQuantity(-1, resource.BinarySI),
+		}, "spec.bandwidthPerMigration"),
+		table.Entry("a negative completion timeout", migrationsv1.MigrationPolicySpec{
+			CompletionTimeoutPerGiB: newInt64(-1),
+		}, "spec.completionTimeoutPerGiB"),
+		table.Entry("a negative progress timeout", migrationsv1.MigrationPolicySpec{
+			ProgressTimeout: newInt64(-1),
+		}, "spec.progressTimeout"),
+	)
+})
+
+func newInt64(i int64) *int64 {
+	return &i
+}
@@ -0,0 +1,385 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMBackupAdmitter validates VirtualMachineBackups
+type VMBackupAdmitter struct {
+	Config *virtconfig.ClusterConfig
+	Client kubecli.KubevirtClient
+}
+
+// NewVMBackupAdmitter creates a VMBackupAdmitter
+func NewVMBackupAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient) *VMBackupAdmitter {
+	return &VMBackupAdmitter{
+		Config: config,
+		Client: client,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMBackupAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	if ar.Request.Resource.Group != backupv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackups" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == v1beta1.Create && !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("IncrementalBackup feature gate not enabled"))
+	}
+
+	vmBackup := &backupv1.VirtualMachineBackup{}
+	// TODO ideally use UniversalDeserializer here
+	err := json.Unmarshal(ar.Request.Object.Raw, vmBackup)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case v1beta1.Create:
+		sourceField := k8sfield.NewPath("spec", "source")
+		causes, err = admitter.validateSource(sourceField, ar.Request.Namespace, vmBackup.Spec.Source)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		causes = append(causes, validateBackupMode(k8sfield.NewPath("spec"), &vmBackup.Spec)...)
+
+		if vmBackup.Spec.IncrementalFrom != nil {
+			incrementalCauses, err := admitter.validateIncrementalFrom(k8sfield.NewPath("spec", "incrementalFrom"), ar.Request.Namespace, vmBackup)
+			if err != nil {
+				return webhookutils.ToAdmissionResponseError(err)
+			}
+			causes = append(causes, incrementalCauses...)
+		}
+
+	case v1beta1.Update:
+		prevObj := &backupv1.VirtualMachineBackup{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !reflect.DeepEqual(prevObj.Spec, vmBackup.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := v1beta1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func (admitter *VMBackupAdmitter) validateSource(field *k8sfield.Path, namespace string, source corev1.TypedLocalObjectReference) ([]metav1.StatusCause, error) {
+	if source.APIGroup == nil || *source.APIGroup != v1.GroupName || source.Kind != "VirtualMachine" {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid source %s, only VirtualMachines of apiGroup %q can be backed up", source.Kind, v1.GroupName),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	_, err := admitter.Client.VirtualMachine(namespace).Get(source.Name, &metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachine %q does not exist", source.Name),
+				Field:   field.Child("name").String(),
+			},
+		}, nil
+	}
+
+	return nil, err
+}
+
+func validateBackupMode(field *k8sfield.Path, spec *backupv1.VirtualMachineBackupSpec) []metav1.StatusCause {
+	switch spec.Mode {
+	case "", v1.BackupModePush:
+		if spec.PVCName == nil || *spec.PVCName == "" {
+			return []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: "a push backup requires a pvcName",
+					Field:   field.Child("pvcName").String(),
+				},
+			}
+		}
+		if spec.TTLDuration != nil {
+			return []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: "only the export of a pull backup has a ttlDuration",
+					Field:   field.Child("ttlDuration").String(),
+				},
+			}
+		}
+	case v1.BackupModePull:
+		if spec.PVCName != nil {
+			return []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: "a pull backup is not written to a pvcName",
+					Field:   field.Child("pvcName").String(),
+				},
+			}
+		}
+		if spec.TTLDuration != nil && spec.TTLDuration.Duration <= 0 {
+			return []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "ttlDuration must be positive",
+					Field:   field.Child("ttlDuration").String(),
+				},
+			}
+		}
+	default:
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("mode must be either %s or %s", v1.BackupModePush, v1.BackupModePull),
+				Field:   field.Child("mode").String(),
+			},
+		}
+	}
+
+	return nil
+}
+
+func (admitter *VMBackupAdmitter) validateIncrementalFrom(field *k8sfield.Path, namespace string, vmBackup *backupv1.VirtualMachineBackup) ([]metav1.StatusCause, error) {
+	previous, err := admitter.Client.VirtualMachineBackup(namespace).Get(context.Background(), *vmBackup.Spec.IncrementalFrom, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachineBackup %q does not exist", *vmBackup.Spec.IncrementalFrom),
+				Field:   field.String(),
+			},
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if previous.Spec.Source.Name != vmBackup.Spec.Source.Name {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachineBackup %q is a backup of another source", previous.Name),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// VMBackupRestoreAdmitter validates VirtualMachineBackupRestores
+type VMBackupRestoreAdmitter struct {
+	Config *virtconfig.ClusterConfig
+	Client kubecli.KubevirtClient
+}
+
+// NewVMBackupRestoreAdmitter creates a VMBackupRestoreAdmitter
+func NewVMBackupRestoreAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient) *VMBackupRestoreAdmitter {
+	return &VMBackupRestoreAdmitter{
+		Config: config,
+		Client: client,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMBackupRestoreAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	if ar.Request.Resource.Group != backupv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackuprestores" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == v1beta1.Create && !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("IncrementalBackup feature gate not enabled"))
+	}
+
+	vmBackupRestore := &backupv1.VirtualMachineBackupRestore{}
+	// TODO ideally use UniversalDeserializer here
+	err := json.Unmarshal(ar.Request.Object.Raw, vmBackupRestore)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case v1beta1.Create:
+		causes, err = admitter.validateBackup(k8sfield.NewPath("spec", "backupName"), ar.Request.Namespace, vmBackupRestore.Spec.BackupName)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		causes = append(causes, validateBackupRestoreVolumes(k8sfield.NewPath("spec", "volumes"), vmBackupRestore.Spec.Volumes)...)
+
+	case v1beta1.Update:
+		prevObj := &backupv1.VirtualMachineBackupRestore{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !reflect.DeepEqual(prevObj.Spec, vmBackupRestore.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := v1beta1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func (admitter *VMBackupRestoreAdmitter) validateBackup(field *k8sfield.Path, namespace, backupName string) ([]metav1.StatusCause, error) {
+	if backupName == "" {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "missing backupName",
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	vmBackup, err := admitter.Client.VirtualMachineBackup(namespace).Get(context.Background(), backupName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachineBackup %q does not exist", backupName),
+				Field:   field.String(),
+			},
+		}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if vmBackup.Spec.Mode == v1.BackupModePull {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("VirtualMachineBackup %q is a pull backup, only push backups can be restored", backupName),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func validateBackupRestoreVolumes(field *k8sfield.Path, volumes []backupv1.VirtualMachineBackupRestoreVolume) []metav1.StatusCause {
+	if len(volumes) == 0 {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "at least one volume has to be restored",
+				Field:   field.String(),
+			},
+		}
+	}
+
+	var causes []metav1.StatusCause
+	volumeNames := map[string]struct{}{}
+	pvcNames := map[string]struct{}{}
+	for i, volume := range volumes {
+		if volume.VolumeName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "missing volumeName",
+				Field:   field.Index(i).Child("volumeName").String(),
+			})
+		} else if _, exists := volumeNames[volume.VolumeName]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("volume %s is restored more than once", volume.VolumeName),
+				Field:   field.Index(i).Child("volumeName").String(),
+			})
+		}
+		volumeNames[volume.VolumeName] = struct{}{}
+
+		if volume.PVCName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "missing pvcName",
+				Field:   field.Index(i).Child("pvcName").String(),
+			})
+		} else if _, exists := pvcNames[volume.PVCName]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("more than one volume is restored to claim %s", volume.PVCName),
+				Field:   field.Index(i).Child("pvcName").String(),
+			})
+		}
+		pvcNames[volume.PVCName] = struct{}{}
+	}
+
+	return causes
+}
@@ -0,0 +1,393 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineBackup Admitter", func() {
+	const vmName = "vm"
+
+	apiGroup := v1.GroupName
+	pvcName := "backups"
+
+	vm := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vmName,
+			Namespace: "default",
+		},
+	}
+
+	newBackup := func(name string) *backupv1.VirtualMachineBackup {
+		return &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				PVCName: &pvcName,
+			},
+		}
+	}
+
+	newRestore := func() *backupv1.VirtualMachineBackupRestore {
+		return &backupv1.VirtualMachineBackupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "restore",
+				Namespace: "default",
+			},
+			Spec: backupv1.VirtualMachineBackupRestoreSpec{
+				BackupName: "backup",
+				Volumes: []backupv1.VirtualMachineBackupRestoreVolume{
+					{VolumeName: "rootdisk", PVCName: "restored-rootdisk"},
+				},
+			},
+		}
+	}
+
+	config, configMapInformer, _, _ := testutils.NewFakeClusterConfig(&corev1.ConfigMap{})
+
+	Context("Without feature gate enabled", func() {
+		It("should reject a backup", func() {
+			ar := createBackupAdmissionReview("virtualmachinebackups", newBackup("backup"))
+			resp := createTestVMBackupAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("IncrementalBackup feature gate not enabled"))
+		})
+
+		It("should reject a restore", func() {
+			ar := createBackupAdmissionReview("virtualmachinebackuprestores", newRestore())
+			resp := createTestVMBackupRestoreAdmitter(config, newBackup("backup")).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("IncrementalBackup feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{
+				Data: map[string]string{virtconfig.FeatureGatesKey: virtconfig.IncrementalBackupGate},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &v1beta1.AdmissionReview{
+				Request: &v1beta1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should accept a push backup", func() {
+			ar := createBackupAdmissionReview("virtualmachinebackups", newBackup("backup"))
+			resp := createTestVMBackupAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a pull backup", func() {
+			vmBackup := newBackup("backup")
+			vmBackup.Spec.Mode = v1.BackupModePull
+			vmBackup.Spec.PVCName = nil
+			vmBackup.Spec.TTLDuration = &metav1.Duration{Duration: time.Hour}
+
+			ar := createBackupAdmissionReview("virtualmachinebackups", vmBackup)
+			resp := createTestVMBackupAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a missing source", func() {
+			ar := createBackupAdmissionReview("virtualmachinebackups", newBackup("backup"))
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.name"))
+		})
+
+		table.DescribeTable("should reject an unsupported source", func(source corev1.TypedLocalObjectReference) {
+			vmBackup := newBackup("backup")
+			vmBackup.Spec.Source = source
+
+			ar := createBackupAdmissionReview("virtualmachinebackups", vmBackup)
+			resp := createTestVMBackupAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source"))
+		},
+			table.Entry("kind", corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachineInstance", Name: vmName}),
+			table.Entry("VirtualMachine without apiGroup", corev1.TypedLocalObjectReference{Kind: "VirtualMachine", Name: vmName}),
+		)
+
+		table.DescribeTable("should reject an invalid mode", func(mutate func(spec *backupv1.VirtualMachineBackupSpec), field string) {
+			vmBackup := newBackup("backup")
+			mutate(&vmBackup.Spec)
+
+			ar := createBackupAdmissionReview("virtualmachinebackups", vmBackup)
+			resp := createTestVMBackupAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			table.Entry("push without a pvcName", func(spec *backupv1.VirtualMachineBackupSpec) {
+				spec.PVCName = nil
+			}, "spec.pvcName"),
+			table.Entry("push with a ttlDuration", func(spec *backupv1.VirtualMachineBackupSpec) {
+				spec.TTLDuration = &metav1.Duration{Duration: time.Hour}
+			}, "spec.ttlDuration"),
+			table.Entry("pull with a pvcName", func(spec *backupv1.VirtualMachineBackupSpec) {
+				spec.Mode = v1.BackupModePull
+			}, "spec.pvcName"),
+			table.Entry("pull with a negative ttlDuration", func(spec *backupv1.VirtualMachineBackupSpec) {
+				spec.Mode = v1.BackupModePull
+				spec.PVCName = nil
+				spec.TTLDuration = &metav1.Duration{Duration: -time.Hour}
+			}, "spec.ttlDuration"),
+			table.Entry("unknown", func(spec *backupv1.VirtualMachineBackupSpec) {
+				spec.Mode = "Sideways"
+			}, "spec.mode"),
+		)
+
+		It("should accept an incremental backup of the same source", func() {
+			previous := newBackup("previous")
+			vmBackup := newBackup("backup")
+			vmBackup.Spec.IncrementalFrom = &previous.Name
+
+			ar := createBackupAdmissionReview("virtualmachinebackups", vmBackup)
+			resp := createTestVMBackupAdmitter(config, vm, previous).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject an incremental backup of a missing backup", func() {
+			previous := "previous"
+			vmBackup := newBackup("backup")
+			vmBackup.Spec.IncrementalFrom = &previous
+
+			ar := createBackupAdmissionReview("virtualmachinebackups", vmBackup)
+			resp := createTestVMBackupAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.incrementalFrom"))
+		})
+
+		It("should reject an incremental backup of a backup of another source", func() {
+			previous := newBackup("previous")
+			previous.Spec.Source.Name = "other-vm"
+			vmBackup := newBackup("backup")
+			vmBackup.Spec.IncrementalFrom = &previous.Name
+
+			ar := createBackupAdmissionReview("virtualmachinebackups", vmBackup)
+			resp := createTestVMBackupAdmitter(config, vm, previous).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("is a backup of another source"))
+		})
+
+		It("should reject backup spec update", func() {
+			oldBackup := newBackup("backup")
+			vmBackup := newBackup("backup")
+			vmBackup.Spec.Mode = v1.BackupModePull
+
+			ar := createBackupUpdateAdmissionReview("virtualmachinebackups", oldBackup, vmBackup)
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should allow backup metadata update", func() {
+			oldBackup := newBackup("backup")
+			vmBackup := newBackup("backup")
+			vmBackup.Labels = map[string]string{"app": "web"}
+
+			ar := createBackupUpdateAdmissionReview("virtualmachinebackups", oldBackup, vmBackup)
+			resp := createTestVMBackupAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a restore of a push backup", func() {
+			ar := createBackupAdmissionReview("virtualmachinebackuprestores", newRestore())
+			resp := createTestVMBackupRestoreAdmitter(config, newBackup("backup")).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a restore of a missing backup", func() {
+			ar := createBackupAdmissionReview("virtualmachinebackuprestores", newRestore())
+			resp := createTestVMBackupRestoreAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.backupName"))
+		})
+
+		It("should reject a restore of a pull backup", func() {
+			vmBackup := newBackup("backup")
+			vmBackup.Spec.Mode = v1.BackupModePull
+			vmBackup.Spec.PVCName = nil
+
+			ar := createBackupAdmissionReview("virtualmachinebackuprestores", newRestore())
+			resp := createTestVMBackupRestoreAdmitter(config, vmBackup).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring("only push backups can be restored"))
+		})
+
+		table.DescribeTable("should reject invalid restore volumes", func(volumes []backupv1.VirtualMachineBackupRestoreVolume, field string) {
+			vmBackupRestore := newRestore()
+			vmBackupRestore.Spec.Volumes = volumes
+
+			ar := createBackupAdmissionReview("virtualmachinebackuprestores", vmBackupRestore)
+			resp := createTestVMBackupRestoreAdmitter(config, newBackup("backup")).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			table.Entry("which are missing", nil, "spec.volumes"),
+			table.Entry("without a volumeName", []backupv1.VirtualMachineBackupRestoreVolume{
+				{PVCName: "restored-rootdisk"},
+			}, "spec.volumes[0].volumeName"),
+			table.Entry("without a pvcName", []backupv1.VirtualMachineBackupRestoreVolume{
+				{VolumeName: "rootdisk"},
+			}, "spec.volumes[0].pvcName"),
+			table.Entry("restoring a volume twice", []backupv1.VirtualMachineBackupRestoreVolume{
+				{VolumeName: "rootdisk", PVCName: "restored-rootdisk"},
+				{VolumeName: "rootdisk", PVCName: "other-rootdisk"},
+			}, "spec.volumes[1].volumeName"),
+			table.Entry("restoring to the same claim", []backupv1.VirtualMachineBackupRestoreVolume{
+				{VolumeName: "rootdisk", PVCName: "restored-rootdisk"},
+				{VolumeName: "datadisk", PVCName: "restored-rootdisk"},
+			}, "spec.volumes[1].pvcName"),
+		)
+
+		It("should reject restore spec update", func() {
+			oldRestore := newRestore()
+			vmBackupRestore := newRestore()
+			vmBackupRestore.Spec.Volumes[0].PVCName = "other-rootdisk"
+
+			ar := createBackupUpdateAdmissionReview("virtualmachinebackuprestores", oldRestore, vmBackupRestore)
+			resp := createTestVMBackupRestoreAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+	})
+})
+
+func createBackupAdmissionReview(resource string, obj interface{}) *v1beta1.AdmissionReview {
+	bytes, _ := json.Marshal(obj)
+
+	ar := &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: v1beta1.Create,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    "backup.kubevirt.io",
+				Resource: resource,
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	return ar
+}
+
+func createBackupUpdateAdmissionReview(resource string, old, current interface{}) *v1beta1.AdmissionReview {
+	oldBytes, _ := json.Marshal(old)
+	currentBytes, _ := json.Marshal(current)
+
+	ar := &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: v1beta1.Update,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    "backup.kubevirt.io",
+				Resource: resource,
+			},
+			Object: runtime.RawExtension{
+				Raw: currentBytes,
+			},
+			OldObject: runtime.RawExtension{
+				Raw: oldBytes,
+			},
+		},
+	}
+
+	return ar
+}
+
+func newBackupTestClient(vm *v1.VirtualMachine, objs ...runtime.Object) kubecli.KubevirtClient {
+	ctrl := gomock.NewController(GinkgoT())
+	virtClient := kubecli.NewMockKubevirtClient(ctrl)
+	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
+	kubevirtClient := kubevirtfake.NewSimpleClientset(objs...)
+
+	virtClient.EXPECT().VirtualMachineBackup("default").
+		Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackups("default")).AnyTimes()
+	virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
+
+	if vm == nil {
+		err := errors.NewNotFound(schema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachines"}, "foo")
+		vmInterface.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, err).AnyTimes()
+	} else {
+		vmInterface.EXPECT().Get(vm.Name, gomock.Any()).Return(vm, nil).AnyTimes()
+	}
+	return virtClient
+}
+
+func createTestVMBackupAdmitter(config *virtconfig.ClusterConfig, vm *v1.VirtualMachine, objs ...runtime.Object) *VMBackupAdmitter {
+	return &VMBackupAdmitter{Config: config, Client: newBackupTestClient(vm, objs...)}
+}
+
+func createTestVMBackupRestoreAdmitter(config *virtconfig.ClusterConfig, objs ...runtime.Object) *VMBackupRestoreAdmitter {
+	return &VMBackupRestoreAdmitter{Config: config, Client: newBackupTestClient(nil, objs...)}
+}
@@ -0,0 +1,254 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMCloneAdmitter validates VirtualMachineClones
+type VMCloneAdmitter struct {
+	Config *virtconfig.ClusterConfig
+	Client kubecli.KubevirtClient
+}
+
+// NewVMCloneAdmitter creates a VMCloneAdmitter
+func NewVMCloneAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient) *VMCloneAdmitter {
+	return &VMCloneAdmitter{
+		Config: config,
+		Client: client,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMCloneAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	if ar.Request.Resource.Group != clonev1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachineclones" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == v1beta1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	vmClone := &clonev1.VirtualMachineClone{}
+	// TODO ideally use UniversalDeserializer here
+	err := json.Unmarshal(ar.Request.Object.Raw, vmClone)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case v1beta1.Create:
+		causes, err = admitter.validateSource(k8sfield.NewPath("spec", "source"), ar.Request.Namespace, vmClone.Spec.Source)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		causes = append(causes, validateCloneSpec(k8sfield.NewPath("spec"), &vmClone.Spec)...)
+
+	case v1beta1.Update:
+		prevObj := &clonev1.VirtualMachineClone{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !reflect.DeepEqual(prevObj.Spec, vmClone.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := v1beta1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func (admitter *VMCloneAdmitter) validateSource(field *k8sfield.Path, namespace string, source corev1.TypedLocalObjectReference) ([]metav1.StatusCause, error) {
+	if source.APIGroup == nil {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: "missing apiGroup",
+				Field:   field.Child("apiGroup").String(),
+			},
+		}, nil
+	}
+
+	var err error
+	switch {
+	case *source.APIGroup == v1.GroupName && source.Kind == "VirtualMachine":
+		_, err = admitter.Client.VirtualMachine(namespace).Get(source.Name, &metav1.GetOptions{})
+	case *source.APIGroup == snapshotv1.SchemeGroupVersion.Group && source.Kind == "VirtualMachineSnapshot":
+		_, err = admitter.Client.VirtualMachineSnapshot(namespace).Get(context.Background(), source.Name, metav1.GetOptions{})
+	default:
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid source %s of apiGroup %s", source.Kind, *source.APIGroup),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %q does not exist", source.Kind, source.Name),
+				Field:   field.Child("name").String(),
+			},
+		}, nil
+	}
+
+	return nil, err
+}
+
+func validateCloneSpec(field *k8sfield.Path, spec *clonev1.VirtualMachineCloneSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if spec.Target != nil {
+		targetField := field.Child("target")
+		if (spec.Target.APIGroup != nil && *spec.Target.APIGroup != v1.GroupName) || spec.Target.Kind != "VirtualMachine" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "target must be a VirtualMachine",
+				Field:   targetField.String(),
+			})
+		}
+
+		if errs := validation.IsDNS1123Subdomain(spec.Target.Name); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid target name: %s", strings.Join(errs, ", ")),
+				Field:   targetField.Child("name").String(),
+			})
+		}
+	}
+
+	if spec.NameTemplate != nil {
+		if err := validateCloneNameTemplate(*spec.NameTemplate); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Child("nameTemplate").String(),
+			})
+		}
+	}
+
+	causes = append(causes, validateCloneFilters(field.Child("labelFilters"), spec.LabelFilters)...)
+	causes = append(causes, validateCloneFilters(field.Child("annotationFilters"), spec.AnnotationFilters)...)
+	causes = append(causes, validateCloneFilters(field.Child("template", "labelFilters"), spec.Template.LabelFilters)...)
+	causes = append(causes, validateCloneFilters(field.Child("template", "annotationFilters"), spec.Template.AnnotationFilters)...)
+
+	for name, mac := range spec.NewMacAddresses {
+		if _, err := net.ParseMAC(mac); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid MAC address %q", mac),
+				Field:   field.Child("newMacAddresses").Key(name).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validateCloneNameTemplate makes sure the template generates a valid name for a
+// typical source, the generated name is checked again when the clone is processed
+func validateCloneNameTemplate(nameTemplate string) error {
+	tmpl, err := template.New("name").Parse(nameTemplate)
+	if err != nil {
+		return fmt.Errorf("invalid name template: %v", err)
+	}
+
+	data := struct {
+		SourceName   string
+		CloneName    string
+		UniqueSuffix string
+	}{
+		SourceName:   "source",
+		CloneName:    "clone",
+		UniqueSuffix: "abcde",
+	}
+
+	var b bytes.Buffer
+	if err = tmpl.Execute(&b, data); err != nil {
+		return fmt.Errorf("invalid name template: %v", err)
+	}
+
+	if errs := validation.IsDNS1123Subdomain(b.String()); len(errs) > 0 {
+		return fmt.Errorf("name template generates invalid name %q: %s", b.String(), strings.Join(errs, ", "))
+	}
+
+	return nil
+}
+
+func validateCloneFilters(field *k8sfield.Path, filters []string) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for i, filter := range filters {
+		if strings.TrimPrefix(filter, "!") == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid filter %q", filter),
+				Field:   field.Index(i).String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -0,0 +1,324 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineClone Admitter", func() {
+	const (
+		vmName         = "vm"
+		vmSnapshotName = "snapshot"
+	)
+
+	apiGroup := v1.GroupName
+	snapshotAPIGroup := snapshotv1.SchemeGroupVersion.Group
+
+	vm := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vmName,
+			Namespace: "default",
+		},
+	}
+
+	snapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vmSnapshotName,
+			Namespace: "default",
+		},
+	}
+
+	newClone := func() *clonev1.VirtualMachineClone {
+		return &clonev1.VirtualMachineClone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "clone",
+				Namespace: "default",
+			},
+			Spec: clonev1.VirtualMachineCloneSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+		}
+	}
+
+	config, configMapInformer, _, _ := testutils.NewFakeClusterConfig(&corev1.ConfigMap{})
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createCloneAdmissionReview(newClone())
+			resp := createTestVMCloneAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{
+				Data: map[string]string{virtconfig.FeatureGatesKey: "Snapshot"},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &v1beta1.AdmissionReview{
+				Request: &v1beta1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := createTestVMCloneAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should accept a source VirtualMachine", func() {
+			ar := createCloneAdmissionReview(newClone())
+			resp := createTestVMCloneAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a source VirtualMachineSnapshot", func() {
+			vmClone := newClone()
+			vmClone.Spec.Source = corev1.TypedLocalObjectReference{
+				APIGroup: &snapshotAPIGroup,
+				Kind:     "VirtualMachineSnapshot",
+				Name:     vmSnapshotName,
+			}
+
+			ar := createCloneAdmissionReview(vmClone)
+			resp := createTestVMCloneAdmitter(config, nil, snapshot).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a missing source VirtualMachine", func() {
+			ar := createCloneAdmissionReview(newClone())
+			resp := createTestVMCloneAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.name"))
+		})
+
+		It("should reject a missing source VirtualMachineSnapshot", func() {
+			vmClone := newClone()
+			vmClone.Spec.Source = corev1.TypedLocalObjectReference{
+				APIGroup: &snapshotAPIGroup,
+				Kind:     "VirtualMachineSnapshot",
+				Name:     vmSnapshotName,
+			}
+
+			ar := createCloneAdmissionReview(vmClone)
+			resp := createTestVMCloneAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.name"))
+		})
+
+		It("should reject a missing source apiGroup", func() {
+			vmClone := newClone()
+			vmClone.Spec.Source.APIGroup = nil
+
+			ar := createCloneAdmissionReview(vmClone)
+			resp := createTestVMCloneAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.apiGroup"))
+		})
+
+		It("should reject an unsupported source kind", func() {
+			vmClone := newClone()
+			vmClone.Spec.Source.Kind = "VirtualMachineInstance"
+
+			ar := createCloneAdmissionReview(vmClone)
+			resp := createTestVMCloneAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source"))
+		})
+
+		table.DescribeTable("should reject an invalid spec", func(update func(*clonev1.VirtualMachineClone), field string) {
+			vmClone := newClone()
+			update(vmClone)
+
+			ar := createCloneAdmissionReview(vmClone)
+			resp := createTestVMCloneAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			table.Entry("with a target of another kind", func(vmClone *clonev1.VirtualMachineClone) {
+				vmClone.Spec.Target = &corev1.TypedLocalObjectReference{Kind: "VirtualMachineInstance", Name: "target"}
+			}, "spec.target"),
+			table.Entry("with an invalid target name", func(vmClone *clonev1.VirtualMachineClone) {
+				vmClone.Spec.Target = &corev1.TypedLocalObjectReference{Kind: "VirtualMachine", Name: "Target_VM"}
+			}, "spec.target.name"),
+			table.Entry("with an unparsable name template", func(vmClone *clonev1.VirtualMachineClone) {
+				nameTemplate := "{{.SourceName"
+				vmClone.Spec.NameTemplate = &nameTemplate
+			}, "spec.nameTemplate"),
+			table.Entry("with a name template referencing unknown fields", func(vmClone *clonev1.VirtualMachineClone) {
+				nameTemplate := "{{.Namespace}}-clone"
+				vmClone.Spec.NameTemplate = &nameTemplate
+			}, "spec.nameTemplate"),
+			table.Entry("with a name template generating invalid names", func(vmClone *clonev1.VirtualMachineClone) {
+				nameTemplate := "{{.SourceName}}_clone"
+				vmClone.Spec.NameTemplate = &nameTemplate
+			}, "spec.nameTemplate"),
+			table.Entry("with an empty label filter", func(vmClone *clonev1.VirtualMachineClone) {
+				vmClone.Spec.LabelFilters = []string{"app", "!"}
+			}, "spec.labelFilters[1]"),
+			table.Entry("with an empty template annotation filter", func(vmClone *clonev1.VirtualMachineClone) {
+				vmClone.Spec.Template.AnnotationFilters = []string{""}
+			}, "spec.template.annotationFilters[0]"),
+			table.Entry("with an invalid MAC address", func(vmClone *clonev1.VirtualMachineClone) {
+				vmClone.Spec.NewMacAddresses = map[string]string{"default": "02:00:00:00:00"}
+			}, "spec.newMacAddresses[default]"),
+		)
+
+		It("should accept a valid target, template, filters and MAC addresses", func() {
+			vmClone := newClone()
+			nameTemplate := "{{.CloneName}}-{{.SourceName}}"
+			vmClone.Spec.Target = &corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachine", Name: "target"}
+			vmClone.Spec.NameTemplate = &nameTemplate
+			vmClone.Spec.LabelFilters = []string{"*", "!kubevirt.io/*"}
+			vmClone.Spec.NewMacAddresses = map[string]string{"default": "02:00:00:00:00:42"}
+
+			ar := createCloneAdmissionReview(vmClone)
+			resp := createTestVMCloneAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject spec update", func() {
+			oldClone := newClone()
+			vmClone := newClone()
+			vmClone.Spec.LabelFilters = []string{"app"}
+
+			ar := createCloneUpdateAdmissionReview(oldClone, vmClone)
+			resp := createTestVMCloneAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should allow metadata update", func() {
+			oldClone := newClone()
+			vmClone := newClone()
+			vmClone.Labels = map[string]string{"app": "web"}
+
+			ar := createCloneUpdateAdmissionReview(oldClone, vmClone)
+			resp := createTestVMCloneAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+func createCloneAdmissionReview(vmClone *clonev1.VirtualMachineClone) *v1beta1.AdmissionReview {
+	bytes, _ := json.Marshal(vmClone)
+
+	ar := &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: v1beta1.Create,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    "clone.kubevirt.io",
+				Resource: "virtualmachineclones",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	return ar
+}
+
+func createCloneUpdateAdmissionReview(old, current *clonev1.VirtualMachineClone) *v1beta1.AdmissionReview {
+	oldBytes, _ := json.Marshal(old)
+	currentBytes, _ := json.Marshal(current)
+
+	ar := &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: v1beta1.Update,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    "clone.kubevirt.io",
+				Resource: "virtualmachineclones",
+			},
+			Object: runtime.RawExtension{
+				Raw: currentBytes,
+			},
+			OldObject: runtime.RawExtension{
+				Raw: oldBytes,
+			},
+		},
+	}
+
+	return ar
+}
+
+func createTestVMCloneAdmitter(
+	config *virtconfig.ClusterConfig,
+	vm *v1.VirtualMachine,
+	objs ...runtime.Object,
+) *VMCloneAdmitter {
+	ctrl := gomock.NewController(GinkgoT())
+	virtClient := kubecli.NewMockKubevirtClient(ctrl)
+	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
+	kubevirtClient := kubevirtfake.NewSimpleClientset(objs...)
+
+	virtClient.EXPECT().VirtualMachineSnapshot("default").
+		Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots("default")).AnyTimes()
+	virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
+
+	if vm == nil {
+		err := errors.NewNotFound(schema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachines"}, "foo")
+		vmInterface.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, err).AnyTimes()
+	} else {
+		vmInterface.EXPECT().Get(vm.Name, gomock.Any()).Return(vm, nil).AnyTimes()
+	}
+	return &VMCloneAdmitter{Config: config, Client: virtClient}
+}
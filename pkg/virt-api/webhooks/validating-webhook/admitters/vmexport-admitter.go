@@ -0,0 +1,176 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMExportAdmitter validates VirtualMachineExports
+type VMExportAdmitter struct {
+	Config *virtconfig.ClusterConfig
+	Client kubecli.KubevirtClient
+}
+
+// NewVMExportAdmitter creates a VMExportAdmitter
+func NewVMExportAdmitter(config *virtconfig.ClusterConfig, client kubecli.KubevirtClient) *VMExportAdmitter {
+	return &VMExportAdmitter{
+		Config: config,
+		Client: client,
+	}
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMExportAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	if ar.Request.Resource.Group != exportv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachineexports" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == v1beta1.Create && !admitter.Config.VMExportEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("VMExport feature gate not enabled"))
+	}
+
+	vmExport := &exportv1.VirtualMachineExport{}
+	// TODO ideally use UniversalDeserializer here
+	err := json.Unmarshal(ar.Request.Object.Raw, vmExport)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+
+	switch ar.Request.Operation {
+	case v1beta1.Create:
+		causes, err = admitter.validateSource(k8sfield.NewPath("spec", "source"), ar.Request.Namespace, vmExport.Spec.Source)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		causes = append(causes, validateTokenSecretRef(k8sfield.NewPath("spec", "tokenSecretRef"), vmExport.Spec.TokenSecretRef)...)
+
+	case v1beta1.Update:
+		prevObj := &exportv1.VirtualMachineExport{}
+		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+
+		if !reflect.DeepEqual(prevObj.Spec, vmExport.Spec) {
+			causes = []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "spec in immutable after creation",
+					Field:   k8sfield.NewPath("spec").String(),
+				},
+			}
+		}
+	default:
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected operation %s", ar.Request.Operation))
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := v1beta1.AdmissionResponse{
+		Allowed: true,
+	}
+	return &reviewResponse
+}
+
+func (admitter *VMExportAdmitter) validateSource(field *k8sfield.Path, namespace string, source corev1.TypedLocalObjectReference) ([]metav1.StatusCause, error) {
+	apiGroup := ""
+	if source.APIGroup != nil {
+		apiGroup = *source.APIGroup
+	}
+
+	var err error
+	switch {
+	case apiGroup == v1.GroupName && source.Kind == "VirtualMachine":
+		_, err = admitter.Client.VirtualMachine(namespace).Get(source.Name, &metav1.GetOptions{})
+	case apiGroup == snapshotv1.SchemeGroupVersion.Group && source.Kind == "VirtualMachineSnapshot":
+		_, err = admitter.Client.VirtualMachineSnapshot(namespace).Get(context.Background(), source.Name, metav1.GetOptions{})
+	case apiGroup == "" && source.Kind == "PersistentVolumeClaim":
+		_, err = admitter.Client.CoreV1().PersistentVolumeClaims(namespace).Get(context.Background(), source.Name, metav1.GetOptions{})
+	default:
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid source %s of apiGroup %q", source.Kind, apiGroup),
+				Field:   field.String(),
+			},
+		}, nil
+	}
+
+	if errors.IsNotFound(err) {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s %q does not exist", source.Kind, source.Name),
+				Field:   field.Child("name").String(),
+			},
+		}, nil
+	}
+
+	return nil, err
+}
+
+func validateTokenSecretRef(field *k8sfield.Path, tokenSecretRef string) []metav1.StatusCause {
+	if tokenSecretRef == "" {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueNotFound,
+				Message: "missing tokenSecretRef",
+				Field:   field.String(),
+			},
+		}
+	}
+
+	if errs := validation.IsDNS1123Subdomain(tokenSecretRef); len(errs) > 0 {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("invalid tokenSecretRef: %s", strings.Join(errs, ", ")),
+				Field:   field.String(),
+			},
+		}
+	}
+
+	return nil
+}
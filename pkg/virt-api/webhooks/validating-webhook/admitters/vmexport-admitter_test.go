@@ -0,0 +1,304 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineExport Admitter", func() {
+	const (
+		vmName         = "vm"
+		vmSnapshotName = "snapshot"
+		pvcName        = "pvc"
+	)
+
+	apiGroup := v1.GroupName
+	snapshotAPIGroup := snapshotv1.SchemeGroupVersion.Group
+
+	vm := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vmName,
+			Namespace: "default",
+		},
+	}
+
+	snapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vmSnapshotName,
+			Namespace: "default",
+		},
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcName,
+			Namespace: "default",
+		},
+	}
+
+	newExport := func() *exportv1.VirtualMachineExport {
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "export",
+				Namespace: "default",
+			},
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				TokenSecretRef: "token",
+			},
+		}
+	}
+
+	config, configMapInformer, _, _ := testutils.NewFakeClusterConfig(&corev1.ConfigMap{})
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createExportAdmissionReview(newExport())
+			resp := createTestVMExportAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("VMExport feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{
+				Data: map[string]string{virtconfig.FeatureGatesKey: "VMExport"},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := &v1beta1.AdmissionReview{
+				Request: &v1beta1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+				},
+			}
+
+			resp := createTestVMExportAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		It("should accept a source VirtualMachine", func() {
+			ar := createExportAdmissionReview(newExport())
+			resp := createTestVMExportAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a source VirtualMachineSnapshot", func() {
+			vmExport := newExport()
+			vmExport.Spec.Source = corev1.TypedLocalObjectReference{
+				APIGroup: &snapshotAPIGroup,
+				Kind:     "VirtualMachineSnapshot",
+				Name:     vmSnapshotName,
+			}
+
+			ar := createExportAdmissionReview(vmExport)
+			resp := createTestVMExportAdmitter(config, nil, snapshot).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should accept a source PersistentVolumeClaim", func() {
+			vmExport := newExport()
+			vmExport.Spec.Source = corev1.TypedLocalObjectReference{
+				Kind: "PersistentVolumeClaim",
+				Name: pvcName,
+			}
+
+			ar := createExportAdmissionReview(vmExport)
+			resp := createTestVMExportAdmitter(config, nil, pvc).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		table.DescribeTable("should reject a missing source", func(source corev1.TypedLocalObjectReference) {
+			vmExport := newExport()
+			vmExport.Spec.Source = source
+
+			ar := createExportAdmissionReview(vmExport)
+			resp := createTestVMExportAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source.name"))
+		},
+			table.Entry("VirtualMachine", corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachine", Name: vmName}),
+			table.Entry("VirtualMachineSnapshot", corev1.TypedLocalObjectReference{APIGroup: &snapshotAPIGroup, Kind: "VirtualMachineSnapshot", Name: vmSnapshotName}),
+			table.Entry("PersistentVolumeClaim", corev1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: pvcName}),
+		)
+
+		table.DescribeTable("should reject an unsupported source", func(source corev1.TypedLocalObjectReference) {
+			vmExport := newExport()
+			vmExport.Spec.Source = source
+
+			ar := createExportAdmissionReview(vmExport)
+			resp := createTestVMExportAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.source"))
+		},
+			table.Entry("kind", corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachineInstance", Name: vmName}),
+			table.Entry("VirtualMachine without apiGroup", corev1.TypedLocalObjectReference{Kind: "VirtualMachine", Name: vmName}),
+			table.Entry("PersistentVolumeClaim of another apiGroup", corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "PersistentVolumeClaim", Name: pvcName}),
+		)
+
+		table.DescribeTable("should reject an invalid tokenSecretRef", func(tokenSecretRef string) {
+			vmExport := newExport()
+			vmExport.Spec.TokenSecretRef = tokenSecretRef
+
+			ar := createExportAdmissionReview(vmExport)
+			resp := createTestVMExportAdmitter(config, vm).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.tokenSecretRef"))
+		},
+			table.Entry("which is empty", ""),
+			table.Entry("which is no valid name", "Token_Secret"),
+		)
+
+		It("should reject spec update", func() {
+			oldExport := newExport()
+			vmExport := newExport()
+			vmExport.Spec.TokenSecretRef = "other-token"
+
+			ar := createExportUpdateAdmissionReview(oldExport, vmExport)
+			resp := createTestVMExportAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+		})
+
+		It("should allow metadata update", func() {
+			oldExport := newExport()
+			vmExport := newExport()
+			vmExport.Labels = map[string]string{"app": "web"}
+
+			ar := createExportUpdateAdmissionReview(oldExport, vmExport)
+			resp := createTestVMExportAdmitter(config, nil).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+	})
+})
+
+func createExportAdmissionReview(vmExport *exportv1.VirtualMachineExport) *v1beta1.AdmissionReview {
+	bytes, _ := json.Marshal(vmExport)
+
+	ar := &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: v1beta1.Create,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    "export.kubevirt.io",
+				Resource: "virtualmachineexports",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	return ar
+}
+
+func createExportUpdateAdmissionReview(old, current *exportv1.VirtualMachineExport) *v1beta1.AdmissionReview {
+	oldBytes, _ := json.Marshal(old)
+	currentBytes, _ := json.Marshal(current)
+
+	ar := &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: v1beta1.Update,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    "export.kubevirt.io",
+				Resource: "virtualmachineexports",
+			},
+			Object: runtime.RawExtension{
+				Raw: currentBytes,
+			},
+			OldObject: runtime.RawExtension{
+				Raw: oldBytes,
+			},
+		},
+	}
+
+	return ar
+}
+
+func createTestVMExportAdmitter(
+	config *virtconfig.ClusterConfig,
+	vm *v1.VirtualMachine,
+	objs ...runtime.Object,
+) *VMExportAdmitter {
+	ctrl := gomock.NewController(GinkgoT())
+	virtClient := kubecli.NewMockKubevirtClient(ctrl)
+	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
+
+	var kubevirtObjs, k8sObjs []runtime.Object
+	for _, obj := range objs {
+		if _, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+			k8sObjs = append(k8sObjs, obj)
+		} else {
+			kubevirtObjs = append(kubevirtObjs, obj)
+		}
+	}
+	kubevirtClient := kubevirtfake.NewSimpleClientset(kubevirtObjs...)
+	k8sClient := k8sfake.NewSimpleClientset(k8sObjs...)
+
+	virtClient.EXPECT().VirtualMachineSnapshot("default").
+		Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots("default")).AnyTimes()
+	virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+	virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
+
+	if vm == nil {
+		err := errors.NewNotFound(schema.GroupResource{Group: "kubevirt.io", Resource: "virtualmachines"}, "foo")
+		vmInterface.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, err).AnyTimes()
+	} else {
+		vmInterface.EXPECT().Get(vm.Name, gomock.Any()).Return(vm, nil).AnyTimes()
+	}
+	return &VMExportAdmitter{Config: config, Client: virtClient}
+}
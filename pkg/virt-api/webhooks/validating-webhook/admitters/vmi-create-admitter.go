@@ -26,8 +26,10 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/blang/semver"
 	"k8s.io/api/admission/v1beta1"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -36,6 +38,7 @@ import (
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/util/iotune"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -56,6 +59,10 @@ const (
 	maxDNSNameservers     = 3
 	maxDNSSearchPaths     = 6
 	maxDNSSearchListChars = 256
+
+	// Every disk hotplugged on the virtio bus needs its own PCIe root port,
+	// which are placed on the root complex next to the other devices
+	maxHotpluggedDisks = 32
 )
 
 var validInterfaceModels = map[string]*struct{}{"e1000": nil, "e1000e": nil, "ne2k_pci": nil, "pcnet": nil, "rtl8139": nil, "virtio": nil}
@@ -195,6 +202,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validatePermittedHostDevices(field, spec, config)...)
+	causes = append(causes, validateChangedBlockTracking(field, spec, config)...)
 	return causes
 }
 
@@ -224,10 +232,13 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		networkInterfaceMap[iface.Name] = struct{}{}
 
 		causes = append(causes, validatePortConfiguration(field, networkExists, networkData, iface, idx, portForwardMap)...)
+		causes = append(causes, validateMasqueradeConfiguration(field, networkExists, networkData, iface, idx, portForwardMap)...)
 		causes = append(causes, validateInterfaceModel(field, iface, idx)...)
 		causes = append(causes, validateMacAddress(field, iface, idx)...)
 		causes = append(causes, validateInterfaceBootOrder(field, iface, idx, bootOrderMap)...)
 		causes = append(causes, validateInterfacePciAddress(field, iface, idx)...)
+		causes = append(causes, validateInterfaceBandwidth(field, iface, idx)...)
+		causes = append(causes, validateSRIOVStandbyInterface(field, spec, iface, idx)...)
 
 		newCauses, newDone := validateDHCPExtraOptions(field, iface)
 		causes = append(causes, newCauses...)
@@ -241,6 +252,7 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		}
 
 		causes = append(causes, validateDHCPNTPServersAreValidIPv4Addresses(field, iface, idx)...)
+		causes = append(causes, validateDHCPOptions(field, iface, idx, networkData)...)
 	}
 	return networkInterfaceMap, vifMQ, isVirtioNicRequested, causes, done
 }
@@ -260,6 +272,32 @@ func validateInterfaceNetworkBasics(field *k8sfield.Path, networkExists bool, id
 		causes = appendStatusCauseForMacvtapFeatureGateNotEnabled(field, causes, idx)
 	} else if iface.InterfaceBindingMethod.Macvtap != nil && networkData.NetworkSource.Multus == nil {
 		causes = appendStatusCauseForMacvtapOnlyAllowedWithMultus(field, causes, idx)
+	} else if iface.Binding != nil {
+		causes = append(causes, validateInterfaceBindingPlugin(field, idx, iface, config)...)
+	}
+	return causes
+}
+
+func validateInterfaceBindingPlugin(field *k8sfield.Path, idx int, iface v1.Interface, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	bindingField := field.Child("domain", "devices", "interfaces").Index(idx).Child("binding")
+	if !config.NetworkBindingPluginsEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled", virtconfig.NetworkBindingPluginsGate),
+			Field:   bindingField.String(),
+		})
+	} else if iface.InterfaceBindingMethod != (v1.InterfaceBindingMethod{}) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "binding plugins can not be combined with another interface binding method",
+			Field:   bindingField.String(),
+		})
+	} else if _, exists := config.GetNetworkBindings()[iface.Binding.Name]; !exists {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("binding plugin %q is not registered in the KubeVirt configuration", iface.Binding.Name),
+			Field:   bindingField.Child("name").String(),
+		})
 	}
 	return causes
 }
@@ -295,6 +333,113 @@ func validateDHCPNTPServersAreValidIPv4Addresses(field *k8sfield.Path, iface v1.
 	return causes
 }
 
+func validateDHCPOptions(field *k8sfield.Path, iface v1.Interface, idx int, networkData *v1.Network) (causes []metav1.StatusCause) {
+	if iface.DHCPOptions == nil {
+		return causes
+	}
+	dhcpField := field.Child("domain", "devices", "interfaces").Index(idx).Child("dhcpOptions")
+
+	for index, domain := range iface.DHCPOptions.SearchDomains {
+		if errs := validation.IsDNS1123Subdomain(strings.ToLower(domain)); len(errs) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Search domain %s is not a valid DNS name: %s", domain, strings.Join(errs, ", ")),
+				Field:   dhcpField.Child("searchDomains").Index(index).String(),
+			})
+		}
+	}
+	for index, route := range iface.DHCPOptions.Routes {
+		causes = append(causes, validateDHCPRoute(dhcpField.Child("routes").Index(index), route)...)
+	}
+	if iface.DHCPOptions.StaticLease != nil {
+		causes = append(causes, validateDHCPStaticLease(dhcpField.Child("staticLease"), iface, iface.DHCPOptions.StaticLease)...)
+	}
+	if iface.DHCPOptions.SLAAC {
+		causes = append(causes, validateDHCPSLAAC(dhcpField.Child("slaac"), iface, networkData)...)
+	}
+	return causes
+}
+
+func validateDHCPRoute(field *k8sfield.Path, route v1.DHCPRoute) (causes []metav1.StatusCause) {
+	ip, _, err := net.ParseCIDR(route.Destination)
+	if err != nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Route destination %s is not a valid CIDR", route.Destination),
+			Field:   field.Child("destination").String(),
+		})
+	}
+	if route.Gateway == "" {
+		return causes
+	}
+	if ip.To4() == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "IPv6 routes are advertised through the router of the interface and can not have a gateway",
+			Field:   field.Child("gateway").String(),
+		})
+	} else if net.ParseIP(route.Gateway).To4() == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Route gateway %s is not a valid IPv4 address", route.Gateway),
+			Field:   field.Child("gateway").String(),
+		})
+	}
+	return causes
+}
+
+func validateDHCPStaticLease(field *k8sfield.Path, iface v1.Interface, lease *v1.DHCPStaticLease) (causes []metav1.StatusCause) {
+	if iface.Bridge == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Static DHCP leases are only supported on bridge interfaces",
+			Field:   field.String(),
+		})
+	}
+	ip, subnet, err := net.ParseCIDR(lease.Address)
+	if err != nil || ip.To4() == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Static lease address %s is not a valid IPv4 CIDR", lease.Address),
+			Field:   field.Child("address").String(),
+		})
+	}
+	if lease.Gateway == "" {
+		return causes
+	}
+	if gateway := net.ParseIP(lease.Gateway); gateway.To4() == nil || !subnet.Contains(gateway) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Static lease gateway %s is not an IPv4 address of %s", lease.Gateway, subnet.String()),
+			Field:   field.Child("gateway").String(),
+		})
+	}
+	return causes
+}
+
+func validateDHCPSLAAC(field *k8sfield.Path, iface v1.Interface, networkData *v1.Network) (causes []metav1.StatusCause) {
+	if iface.Masquerade == nil || networkData == nil || networkData.Pod == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "SLAAC is only supported on masquerade interfaces of the pod network",
+			Field:   field.String(),
+		})
+	}
+	if _, subnet, err := net.ParseCIDR(networkData.Pod.VMIPv6NetworkCIDR); err != nil || !isIPv6Prefix64(subnet) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "SLAAC requires a /64 vmIPv6NetworkCIDR on the pod network",
+			Field:   field.String(),
+		})
+	}
+	return causes
+}
+
+func isIPv6Prefix64(subnet *net.IPNet) bool {
+	ones, bits := subnet.Mask.Size()
+	return bits == net.IPv6len*8 && ones == 64
+}
+
 func validateDHCPPrivateOptionsWithinRange(field *k8sfield.Path, DHCPPrivateOption v1.DHCPPrivateOptions) (causes []metav1.StatusCause) {
 	if !(DHCPPrivateOption.Option >= 224 && DHCPPrivateOption.Option <= 254) {
 		causes = append(causes, metav1.StatusCause{
@@ -329,6 +474,92 @@ func validateInterfacePciAddress(field *k8sfield.Path, iface v1.Interface, idx i
 	return causes
 }
 
+func validateInterfaceBandwidth(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.Bandwidth == nil {
+		return causes
+	}
+
+	bandwidthField := field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+	if iface.Bridge == nil && iface.Masquerade == nil && iface.Macvtap == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Bandwidth limits are only supported on bridge, masquerade and macvtap interfaces",
+			Field:   bandwidthField.String(),
+		})
+		return causes
+	}
+
+	causes = append(causes, validateBandwidthLimits(bandwidthField.Child("inbound"), iface.Bandwidth.Inbound)...)
+	causes = append(causes, validateBandwidthLimits(bandwidthField.Child("outbound"), iface.Bandwidth.Outbound)...)
+	return causes
+}
+
+func validateBandwidthLimits(field *k8sfield.Path, limits *v1.BandwidthLimits) (causes []metav1.StatusCause) {
+	if limits == nil {
+		return causes
+	}
+
+	if limits.Average.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Average rate must be greater than 0",
+			Field:   field.Child("average").String(),
+		})
+	}
+	if limits.Peak != nil && limits.Peak.Cmp(limits.Average) < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Peak rate %s must not be lower than the average rate %s", limits.Peak.String(), limits.Average.String()),
+			Field:   field.Child("peak").String(),
+		})
+	}
+	if limits.Burst != nil && limits.Burst.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Burst must be greater than 0",
+			Field:   field.Child("burst").String(),
+		})
+	}
+	return causes
+}
+
+func validateSRIOVStandbyInterface(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.SRIOV == nil || iface.SRIOV.StandbyInterface == "" {
+		return nil
+	}
+	standbyField := field.Child("domain", "devices", "interfaces").Index(idx).Child("sriov", "standbyInterface")
+
+	var standby *v1.Interface
+	for i := range spec.Domain.Devices.Interfaces {
+		if spec.Domain.Devices.Interfaces[i].Name == iface.SRIOV.StandbyInterface {
+			standby = &spec.Domain.Devices.Interfaces[i]
+		}
+	}
+	if standby == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s refers to the interface %s which does not exist.", standbyField.String(), iface.SRIOV.StandbyInterface),
+			Field:   standbyField.String(),
+		})
+	}
+	if standby.Bridge == nil || (standby.Model != "" && standby.Model != "virtio") {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must refer to a virtio interface with the bridge binding.", standbyField.String()),
+			Field:   standbyField.String(),
+		})
+	}
+	// the guest teams the interfaces by their MAC address
+	if iface.MacAddress == "" || !strings.EqualFold(iface.MacAddress, standby.MacAddress) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have the same MAC address as its standby interface %s.", field.Child("domain", "devices", "interfaces").Index(idx).Child("name").String(), standby.Name),
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("macAddress").String(),
+		})
+	}
+	return causes
+}
+
 func validateInterfaceBootOrder(field *k8sfield.Path, iface v1.Interface, idx int, bootOrderMap map[uint]bool) (causes []metav1.StatusCause) {
 	if iface.BootOrder != nil {
 		order := *iface.BootOrder
@@ -402,6 +633,99 @@ func validatePortConfiguration(field *k8sfield.Path, networkExists bool, network
 	return causes
 }
 
+func validateMasqueradeConfiguration(field *k8sfield.Path, networkExists bool, networkData *v1.Network, iface v1.Interface, idx int, portForwardMap map[string]struct{}) (causes []metav1.StatusCause) {
+
+	// Masquerade on networks other than the pod network is reported by validateInterfaceNetworkBasics
+	if !networkExists || networkData.Pod == nil || iface.Masquerade == nil {
+		return causes
+	}
+
+	for mappingIdx, mapping := range iface.Masquerade.PortMappings {
+		causes = append(causes, validatePortMapping(field, mapping, portForwardMap, idx, mappingIdx)...)
+	}
+
+	for destinationIdx, destination := range iface.Masquerade.BlockedDestinations {
+		if _, _, err := net.ParseCIDR(destination); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Blocked destination %s is not a valid CIDR", destination),
+				Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("masquerade", "blockedDestinations").Index(destinationIdx).String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validatePortMapping(field *k8sfield.Path, mapping v1.MasqueradePortMapping, portForwardMap map[string]struct{}, idx int, mappingIdx int) (causes []metav1.StatusCause) {
+	mappingField := field.Child("domain", "devices", "interfaces").Index(idx).Child("masquerade", "portMappings").Index(mappingIdx)
+
+	if mapping.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: "Port mapping name is mandatory.",
+			Field:   mappingField.Child("name").String(),
+		})
+	} else {
+		if _, ok := portForwardMap[mapping.Name]; ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("Duplicate name of the port: %s", mapping.Name),
+				Field:   mappingField.Child("name").String(),
+			})
+		}
+		if msgs := validation.IsValidPortName(mapping.Name); len(msgs) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("Invalid name of the port: %s", mapping.Name),
+				Field:   mappingField.Child("name").String(),
+			})
+		}
+		portForwardMap[mapping.Name] = struct{}{}
+	}
+
+	if mapping.Protocol != "" && mapping.Protocol != "TCP" && mapping.Protocol != "UDP" && mapping.Protocol != "SCTP" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Unknown protocol, only TCP, UDP or SCTP allowed",
+			Field:   mappingField.Child("protocol").String(),
+		})
+	}
+
+	if mapping.Port < 1 || mapping.Port > 65535 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "Port field must be in range 0 < x < 65536.",
+			Field:   mappingField.Child("port").String(),
+		})
+	}
+
+	if mapping.EndPort != 0 && (mapping.EndPort < mapping.Port || mapping.EndPort > 65535) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "EndPort field must be in range port <= x < 65536.",
+			Field:   mappingField.Child("endPort").String(),
+		})
+	}
+
+	if mapping.GuestPort != 0 {
+		if mapping.GuestPort < 1 || mapping.GuestPort > 65535 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "GuestPort field must be in range 0 < x < 65536.",
+				Field:   mappingField.Child("guestPort").String(),
+			})
+		}
+		if mapping.EndPort != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "GuestPort can not be combined with a port range",
+				Field:   mappingField.Child("guestPort").String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateForwardPortName(field *k8sfield.Path, forwardPort v1.Port, portForwardMap map[string]struct{}, idx int, portIdx int) (causes []metav1.StatusCause) {
 	if forwardPort.Name != "" {
 		if _, ok := portForwardMap[forwardPort.Name]; ok {
@@ -745,6 +1069,64 @@ func validateHostDevicesWithPassthroughEnabled(field *k8sfield.Path, spec *v1.Vi
 	return causes
 }
 
+var (
+	// changedBlockTrackingLibvirtVersion is the first libvirt which attaches the raw image of a disk
+	// as the external data file of the qcow2 image keeping its bitmaps
+	changedBlockTrackingLibvirtVersion = semver.MustParse("10.10.0")
+	// launcherLibvirtVersion is the libvirt of the virt-launcher image
+	launcherLibvirtVersion = semver.MustParse("6.6.0")
+)
+
+func validateChangedBlockTracking(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	volumes := make(map[string]v1.Volume)
+	for _, volume := range spec.Volumes {
+		volumes[volume.Name] = volume
+	}
+
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if !disk.ChangedBlockTracking {
+			continue
+		}
+		diskField := field.Child("domain", "devices", "disks").Index(idx).Child("changedBlockTracking")
+		if !config.IncrementalBackupEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("IncrementalBackup feature gate is not enabled in kubevirt-config"),
+				Field:   diskField.String(),
+			})
+			continue
+		}
+		if disk.CDRom != nil || disk.LUN != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("changed block tracking is only supported on disks, %s is not", disk.Name),
+				Field:   diskField.String(),
+			})
+			continue
+		}
+		volume, exists := volumes[disk.Name]
+		if !exists {
+			continue
+		}
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil && volume.HostDisk == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("changed block tracking is only supported on persistentVolumeClaim, dataVolume and hostDisk volumes, %s is not", disk.Name),
+				Field:   diskField.String(),
+			})
+			continue
+		}
+		if launcherLibvirtVersion.LT(changedBlockTrackingLibvirtVersion) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("changed block tracking needs libvirt %s, the virt-launcher image ships libvirt %s", changedBlockTrackingLibvirtVersion, launcherLibvirtVersion),
+				Field:   diskField.String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validatePermittedHostDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
 	if hostDevs := config.GetPermittedHostDevices(); hostDevs != nil {
 		// build a map of all permitted host devices
@@ -807,6 +1189,7 @@ func validateNetworks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec,
 		if network.Pod != nil {
 			cniTypesCount++
 			podExists = true
+			causes = append(causes, validatePodNetworkCIDRs(field, network.Pod, idx)...)
 		}
 
 		if network.NetworkSource.Multus != nil {
@@ -828,6 +1211,20 @@ func validateNetworks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec,
 	return podExists, multusDefaultCount, causes
 }
 
+func validatePodNetworkCIDRs(field *k8sfield.Path, pod *v1.PodNetwork, idx int) (causes []metav1.StatusCause) {
+	if pod.VMIPv6NetworkCIDR == "" {
+		return causes
+	}
+	if ip, _, err := net.ParseCIDR(pod.VMIPv6NetworkCIDR); err != nil || ip.To4() != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VM IPv6 network CIDR %s is not a valid IPv6 CIDR", pod.VMIPv6NetworkCIDR),
+			Field:   field.Child("networks").Index(idx).Child("pod", "vmIPv6NetworkCIDR").String(),
+		})
+	}
+	return causes
+}
+
 func appendStatusCauseForCNIPluginHasNoNetworkName(field *k8sfield.Path, incomingCauses []metav1.StatusCause, idx int) (causes []metav1.StatusCause) {
 	causes = append(incomingCauses, metav1.StatusCause{
 		Type:    metav1.CauseTypeFieldValueRequired,
@@ -868,7 +1265,9 @@ func validateBootOrder(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec
 
 		matchingVolume, volumeExists := volumeNameMap[disk.Name]
 
-		if !volumeExists {
+		// A CD-ROM without a volume has an empty tray
+		isCDRom := disk.CDRom != nil && disk.Disk == nil && disk.LUN == nil && disk.Floppy == nil
+		if !volumeExists && !isCDRom {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
 				Message: fmt.Sprintf(nameOfTypeNotFoundMessagePattern, field.Child("domain", "devices", "disks").Index(idx).Child("Name").String(), disk.Name),
@@ -1917,6 +2316,26 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 func validateDevices(field *k8sfield.Path, devices *v1.Devices) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	causes = append(causes, validateDisks(field.Child("disks"), devices.Disks)...)
+	causes = append(causes, validateMaxHotpluggedDisks(field.Child("maxHotpluggedDisks"), devices)...)
+	return causes
+}
+
+func validateMaxHotpluggedDisks(field *k8sfield.Path, devices *v1.Devices) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if devices.MaxHotpluggedDisks > maxHotpluggedDisks {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be greater than %d", field.String(), maxHotpluggedDisks),
+			Field:   field.String(),
+		})
+	}
+	if devices.MaxHotpluggedDisks > 0 && devices.DisableHotplug {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s can not be set when hotplug is disabled", field.String()),
+			Field:   field.String(),
+		})
+	}
 	return causes
 }
 
@@ -2117,6 +2536,8 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 			})
 		}
 
+		causes = append(causes, iotune.Validate(field.Index(idx).Child("ioTune"), disk.IOTune)...)
+
 		// Verify disk and volume name can be a valid container name since disk
 		// name can become a container name which will fail to schedule if invalid
 		errs := validation.IsDNS1123Label(disk.Name)
@@ -2130,5 +2551,31 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		}
 	}
 
+	causes = append(causes, validateDiskIOTuneGroups(field, disks)...)
+
+	return causes
+}
+
+// validateDiskIOTuneGroups verifies that the disks which share their I/O limits have the same limits,
+// QEMU applies the limits of the disk added last to the whole group otherwise
+func validateDiskIOTuneGroups(field *k8sfield.Path, disks []v1.Disk) (causes []metav1.StatusCause) {
+	groups := make(map[string]int)
+	for idx, disk := range disks {
+		if disk.IOTune == nil || disk.IOTune.GroupName == "" {
+			continue
+		}
+		otherIdx, exists := groups[disk.IOTune.GroupName]
+		if !exists {
+			groups[disk.IOTune.GroupName] = idx
+			continue
+		}
+		if !equality.Semantic.DeepEqual(disk.IOTune, disks[otherIdx].IOTune) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s and %s are in the I/O limits group %s and must have the same limits", field.Index(idx).String(), field.Index(otherIdx).String(), disk.IOTune.GroupName),
+				Field:   field.Index(idx).Child("ioTune").String(),
+			})
+		}
+	}
 	return causes
 }
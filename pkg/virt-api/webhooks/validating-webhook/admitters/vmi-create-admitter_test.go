@@ -589,6 +589,37 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(len(causes)).To(Equal(1))
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[1].name"))
 		})
+		It("should accept a CD-ROM without a volume", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "cdrom",
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{Bus: "sata"},
+				},
+			})
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		table.DescribeTable("should validate maxHotpluggedDisks", func(maxHotpluggedDisks uint32, disableHotplug bool, expectedMessage string) {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.MaxHotpluggedDisks = maxHotpluggedDisks
+			vmi.Spec.Domain.Devices.DisableHotplug = disableHotplug
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedMessage == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.maxHotpluggedDisks"))
+				Expect(causes[0].Message).To(Equal(expectedMessage))
+			}
+		},
+			table.Entry("with reserved root ports", uint32(4), false, ""),
+			table.Entry("with too many reserved root ports", uint32(33), false, "fake.domain.devices.maxHotpluggedDisks must not be greater than 32"),
+			table.Entry("with reserved root ports while hotplug is disabled", uint32(4), true, "fake.domain.devices.maxHotpluggedDisks can not be set when hotplug is disabled"),
+		)
 		It("should generate multiple causes", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
 
@@ -1485,6 +1516,180 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(len(causes)).To(Equal(1), "unexpected number of errors")
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].ports[0].name"))
 		})
+		table.DescribeTable("should validate masquerade options", func(masquerade *v1.InterfaceMasquerade, podNetwork *v1.PodNetwork, expectedFields ...string) {
+			vm := v1.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name: "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Masquerade: masquerade,
+				},
+				Ports: []v1.Port{{Name: "http", Port: 80}}}}
+
+			vm.Spec.Networks = []v1.Network{{
+				Name:          "default",
+				NetworkSource: v1.NetworkSource{Pod: podNetwork},
+			},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			table.Entry("accept port mappings, blocked destinations and an IPv6 CIDR",
+				&v1.InterfaceMasquerade{
+					PortMappings: []v1.MasqueradePortMapping{
+						{Name: "ssh", Port: 2222, GuestPort: 22},
+						{Name: "media", Protocol: "UDP", Port: 5000, EndPort: 5010},
+						{Name: "signaling", Protocol: "SCTP", Port: 9000},
+					},
+					BlockedDestinations: []string{"10.96.0.0/12", "fd00:10:96::/112"},
+				},
+				&v1.PodNetwork{VMIPv6NetworkCIDR: "fd10:1:2::/120"},
+			),
+			table.Entry("reject a port mapping without a name",
+				&v1.InterfaceMasquerade{PortMappings: []v1.MasqueradePortMapping{{Port: 2222}}},
+				&v1.PodNetwork{},
+				"fake.domain.devices.interfaces[0].masquerade.portMappings[0].name",
+			),
+			table.Entry("reject a port mapping with the name of a port",
+				&v1.InterfaceMasquerade{PortMappings: []v1.MasqueradePortMapping{{Name: "http", Port: 8080}}},
+				&v1.PodNetwork{},
+				"fake.domain.devices.interfaces[0].masquerade.portMappings[0].name",
+			),
+			table.Entry("reject a port mapping with an unknown protocol",
+				&v1.InterfaceMasquerade{PortMappings: []v1.MasqueradePortMapping{{Name: "ssh", Protocol: "ICMP", Port: 2222}}},
+				&v1.PodNetwork{},
+				"fake.domain.devices.interfaces[0].masquerade.portMappings[0].protocol",
+			),
+			table.Entry("reject a port mapping without a port",
+				&v1.InterfaceMasquerade{PortMappings: []v1.MasqueradePortMapping{{Name: "ssh"}}},
+				&v1.PodNetwork{},
+				"fake.domain.devices.interfaces[0].masquerade.portMappings[0].port",
+			),
+			table.Entry("reject a port mapping with an end port lower than the port",
+				&v1.InterfaceMasquerade{PortMappings: []v1.MasqueradePortMapping{{Name: "media", Port: 5000, EndPort: 4000}}},
+				&v1.PodNetwork{},
+				"fake.domain.devices.interfaces[0].masquerade.portMappings[0].endPort",
+			),
+			table.Entry("reject a port mapping with a guest port out of range",
+				&v1.InterfaceMasquerade{PortMappings: []v1.MasqueradePortMapping{{Name: "ssh", Port: 2222, GuestPort: 70000}}},
+				&v1.PodNetwork{},
+				"fake.domain.devices.interfaces[0].masquerade.portMappings[0].guestPort",
+			),
+			table.Entry("reject a port range with a guest port",
+				&v1.InterfaceMasquerade{PortMappings: []v1.MasqueradePortMapping{{Name: "media", Port: 5000, EndPort: 5010, GuestPort: 6000}}},
+				&v1.PodNetwork{},
+				"fake.domain.devices.interfaces[0].masquerade.portMappings[0].guestPort",
+			),
+			table.Entry("reject a blocked destination which is not a CIDR",
+				&v1.InterfaceMasquerade{BlockedDestinations: []string{"10.96.0.1"}},
+				&v1.PodNetwork{},
+				"fake.domain.devices.interfaces[0].masquerade.blockedDestinations[0]",
+			),
+			table.Entry("reject an IPv4 VM IPv6 network CIDR",
+				&v1.InterfaceMasquerade{},
+				&v1.PodNetwork{VMIPv6NetworkCIDR: "10.0.2.0/24"},
+				"fake.networks[0].pod.vmIPv6NetworkCIDR",
+			),
+		)
+		table.DescribeTable("should validate interface bandwidth", func(bindingMethod v1.InterfaceBindingMethod, bandwidth *v1.InterfaceBandwidth, expectedFields ...string) {
+			enableSlirpInterface()
+			vm := v1.NewMinimalVMI("testvm")
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name:                   "default",
+				InterfaceBindingMethod: bindingMethod,
+				Bandwidth:              bandwidth,
+			}}
+			vm.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			table.Entry("accept limits on a masquerade interface",
+				v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				&v1.InterfaceBandwidth{
+					Inbound:  &v1.BandwidthLimits{Average: resource.MustParse("10M"), Peak: resourcePtr("20M"), Burst: resourcePtr("1Mi")},
+					Outbound: &v1.BandwidthLimits{Average: resource.MustParse("10M")},
+				},
+			),
+			table.Entry("reject limits on a slirp interface",
+				v1.InterfaceBindingMethod{Slirp: &v1.InterfaceSlirp{}},
+				&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{Average: resource.MustParse("10M")}},
+				"fake.domain.devices.interfaces[0].bandwidth",
+			),
+			table.Entry("reject limits without an average",
+				v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				&v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimits{}},
+				"fake.domain.devices.interfaces[0].bandwidth.outbound.average",
+			),
+			table.Entry("reject a peak lower than the average",
+				v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{Average: resource.MustParse("10M"), Peak: resourcePtr("1M")}},
+				"fake.domain.devices.interfaces[0].bandwidth.inbound.peak",
+			),
+			table.Entry("reject a negative burst",
+				v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				&v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{Average: resource.MustParse("10M"), Burst: resourcePtr("-1")}},
+				"fake.domain.devices.interfaces[0].bandwidth.inbound.burst",
+			),
+		)
+		table.DescribeTable("should validate the standby interface of an SR-IOV interface", func(standbyInterface string, standby v1.Interface, sriovMAC string, expectedFields ...string) {
+			vm := v1.NewMinimalVMI("testvm")
+			standby.Name = "standby"
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{
+					Name:                   "sriov",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{StandbyInterface: standbyInterface}},
+					MacAddress:             sriovMAC,
+				},
+				standby,
+			}
+			vm.Spec.Networks = []v1.Network{
+				{Name: "sriov", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "sriov-net"}}},
+				{Name: "standby", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "bridge-net"}}},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, field := range expectedFields {
+				Expect(causes[i].Field).To(Equal(field))
+			}
+		},
+			table.Entry("accept a virtio bridge interface with the same MAC",
+				"standby",
+				v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, MacAddress: "de:ad:00:00:be:af"},
+				"DE:AD:00:00:BE:AF",
+			),
+			table.Entry("reject a missing standby interface",
+				"missing",
+				v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, MacAddress: "de:ad:00:00:be:af"},
+				"de:ad:00:00:be:af",
+				"fake.domain.devices.interfaces[0].sriov.standbyInterface",
+			),
+			table.Entry("reject a standby interface which is not a virtio bridge",
+				"standby",
+				v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, Model: "e1000", MacAddress: "de:ad:00:00:be:af"},
+				"de:ad:00:00:be:af",
+				"fake.domain.devices.interfaces[0].sriov.standbyInterface",
+			),
+			table.Entry("reject interfaces with different MAC addresses",
+				"standby",
+				v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}, MacAddress: "de:ad:00:00:be:af"},
+				"de:ad:00:00:be:00",
+				"fake.domain.devices.interfaces[0].macAddress",
+			),
+			table.Entry("reject an SR-IOV interface without MAC address",
+				"standby",
+				v1.Interface{InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+				"",
+				"fake.domain.devices.interfaces[0].macAddress",
+			),
+		)
 		It("should reject networks with a pod network source and slirp interface with bad protocol type", func() {
 			enableSlirpInterface()
 			vm := v1.NewMinimalVMI("testvm")
@@ -1589,6 +1794,55 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
 			Expect(causes).To(HaveLen(0))
 		})
+		Context("with a binding plugin", func() {
+			enableBindingPlugin := func(name string) {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{virtconfig.NetworkBindingPluginsGate}
+				kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+					Binding: map[string]v1.InterfaceBindingPlugin{
+						name: {SidecarImage: "binding:latest"},
+					},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+			}
+
+			table.DescribeTable("should validate the interface binding", func(setup func(), iface v1.Interface, expectedField, expectedMessage string) {
+				setup()
+				vmi := v1.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+				vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				if expectedMessage == "" {
+					Expect(causes).To(BeEmpty())
+					return
+				}
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+				Expect(causes[0].Message).To(Equal(expectedMessage))
+			},
+				table.Entry("accept a registered plugin",
+					func() { enableBindingPlugin("mybinding") },
+					v1.Interface{Name: "default", Binding: &v1.PluginBinding{Name: "mybinding"}},
+					"", ""),
+				table.Entry("reject a plugin when the feature gate is disabled",
+					func() {},
+					v1.Interface{Name: "default", Binding: &v1.PluginBinding{Name: "mybinding"}},
+					"fake.domain.devices.interfaces[0].binding", "NetworkBindingPlugins feature gate is not enabled"),
+				table.Entry("reject a plugin which is not registered",
+					func() { enableBindingPlugin("otherbinding") },
+					v1.Interface{Name: "default", Binding: &v1.PluginBinding{Name: "mybinding"}},
+					"fake.domain.devices.interfaces[0].binding.name", `binding plugin "mybinding" is not registered in the KubeVirt configuration`),
+				table.Entry("reject a plugin combined with another binding method",
+					func() { enableBindingPlugin("mybinding") },
+					v1.Interface{
+						Name:                   "default",
+						Binding:                &v1.PluginBinding{Name: "mybinding"},
+						InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+					},
+					"fake.domain.devices.interfaces[0].binding", "binding plugins can not be combined with another interface binding method"),
+			)
+		})
 		It("should reject port out of range", func() {
 			enableSlirpInterface()
 			vm := v1.NewMinimalVMI("testvm")
@@ -1768,6 +2022,86 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(len(causes)).To(Equal(2))
 		})
 
+		It("should accept valid search domains and routes", func() {
+			vmi := v1.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				SearchDomains: []string{"example.com", "Corp.Example.com"},
+				Routes: []v1.DHCPRoute{
+					{Destination: "192.168.0.0/16", Gateway: "10.0.0.254"},
+					{Destination: "fd20::/64"},
+				},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		It("should reject invalid search domains and routes", func() {
+			vmi := v1.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				SearchDomains: []string{"not_a_domain"},
+				Routes: []v1.DHCPRoute{
+					{Destination: "192.168.0.0"},
+					{Destination: "192.168.0.0/16", Gateway: "fd00::1"},
+					{Destination: "fd20::/64", Gateway: "fd00::1"},
+				},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(4))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.searchDomains[0]"))
+			Expect(causes[1].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.routes[0].destination"))
+			Expect(causes[2].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.routes[1].gateway"))
+			Expect(causes[3].Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.routes[2].gateway"))
+		})
+
+		table.DescribeTable("should validate the static lease", func(iface *v1.Interface, lease v1.DHCPStaticLease, expectedField string) {
+			vmi := v1.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{StaticLease: &lease}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			table.Entry("accept a lease with a gateway", v1.DefaultBridgeNetworkInterface(),
+				v1.DHCPStaticLease{Address: "192.168.10.5/24", Gateway: "192.168.10.1"}, ""),
+			table.Entry("accept a lease without a gateway", v1.DefaultBridgeNetworkInterface(),
+				v1.DHCPStaticLease{Address: "192.168.10.5/24"}, ""),
+			table.Entry("reject a lease on masquerade", v1.DefaultMasqueradeNetworkInterface(),
+				v1.DHCPStaticLease{Address: "192.168.10.5/24"}, "fake.domain.devices.interfaces[0].dhcpOptions.staticLease"),
+			table.Entry("reject an IPv6 address", v1.DefaultBridgeNetworkInterface(),
+				v1.DHCPStaticLease{Address: "fd10::5/64"}, "fake.domain.devices.interfaces[0].dhcpOptions.staticLease.address"),
+			table.Entry("reject an address without prefix", v1.DefaultBridgeNetworkInterface(),
+				v1.DHCPStaticLease{Address: "192.168.10.5"}, "fake.domain.devices.interfaces[0].dhcpOptions.staticLease.address"),
+			table.Entry("reject a gateway outside of the subnet", v1.DefaultBridgeNetworkInterface(),
+				v1.DHCPStaticLease{Address: "192.168.10.5/24", Gateway: "192.168.11.1"}, "fake.domain.devices.interfaces[0].dhcpOptions.staticLease.gateway"),
+		)
+
+		table.DescribeTable("should validate SLAAC", func(iface *v1.Interface, vmIPv6NetworkCIDR string, expectedCauses int) {
+			vmi := v1.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Networks[0].Pod.VMIPv6NetworkCIDR = vmIPv6NetworkCIDR
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{SLAAC: true}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+			for _, cause := range causes {
+				Expect(cause.Field).To(Equal("fake.domain.devices.interfaces[0].dhcpOptions.slaac"))
+			}
+		},
+			table.Entry("accept masquerade with a /64 network", v1.DefaultMasqueradeNetworkInterface(), "fd10:0:2::/64", 0),
+			table.Entry("reject masquerade with the default network", v1.DefaultMasqueradeNetworkInterface(), "", 1),
+			table.Entry("reject masquerade with a /120 network", v1.DefaultMasqueradeNetworkInterface(), "fd10:0:2::/120", 1),
+			table.Entry("reject bridge", v1.DefaultBridgeNetworkInterface(), "fd10:0:2::/64", 1),
+		)
+
 		It("should accept valid DHCPPrivateOptions", func() {
 			vmi := v1.NewMinimalVMI("testvm")
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*v1.DefaultBridgeNetworkInterface()}
@@ -2616,6 +2950,35 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Message).To(Equal("Bus type virtio is invalid for CD-ROM device"))
 		})
 
+		It("should reject invalid I/O limits of a disk", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:   "testdisk",
+				IOTune: &v1.DiskIOTune{TotalIOPSSecMax: pointer.Int64Ptr(1000)},
+			})
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake[0].ioTune.totalIOPSSecMax"))
+		})
+
+		It("should reject disks of the same I/O limits group with different limits", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			for i, iops := range []int64{100, 100, 200} {
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name: fmt.Sprintf("testdisk%d", i),
+					IOTune: &v1.DiskIOTune{
+						TotalIOPSSec: pointer.Int64Ptr(iops),
+						GroupName:    "shared",
+					},
+				})
+			}
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake[2].ioTune"))
+		})
+
 		It("should accept a boot order greater than '0'", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
 			order := uint(1)
@@ -2853,6 +3216,79 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 
 	})
 
+	Context("with changed block tracking", func() {
+		newVMIWithChangedBlockTracking := func(volumeSource v1.VolumeSource) *v1.VirtualMachineInstance {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name:                 "rootdisk",
+				ChangedBlockTracking: true,
+			})
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name:         "rootdisk",
+				VolumeSource: volumeSource,
+			})
+			return vmi
+		}
+
+		pvcVolumeSource := v1.VolumeSource{
+			PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "rootdisk"},
+		}
+
+		It("should reject changed block tracking if the feature gate is not enabled", func() {
+			vmi := newVMIWithChangedBlockTracking(pvcVolumeSource)
+
+			causes := validateChangedBlockTracking(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].changedBlockTracking"))
+			Expect(causes[0].Message).To(ContainSubstring("IncrementalBackup feature gate is not enabled"))
+		})
+
+		It("should reject changed block tracking while the virt-launcher image ships an older libvirt", func() {
+			enableFeatureGate(virtconfig.IncrementalBackupGate)
+			vmi := newVMIWithChangedBlockTracking(pvcVolumeSource)
+
+			causes := validateChangedBlockTracking(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].changedBlockTracking"))
+			Expect(causes[0].Message).To(Equal("changed block tracking needs libvirt 10.10.0, the virt-launcher image ships libvirt 6.6.0"))
+		})
+
+		table.DescribeTable("should accept changed block tracking with a recent libvirt on", func(volumeSource v1.VolumeSource) {
+			enableFeatureGate(virtconfig.IncrementalBackupGate)
+			origLibvirtVersion := launcherLibvirtVersion
+			launcherLibvirtVersion = changedBlockTrackingLibvirtVersion
+			defer func() { launcherLibvirtVersion = origLibvirtVersion }()
+			vmi := newVMIWithChangedBlockTracking(volumeSource)
+
+			causes := validateChangedBlockTracking(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			table.Entry("a persistentVolumeClaim", pvcVolumeSource),
+			table.Entry("a dataVolume", v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "rootdisk"}}),
+			table.Entry("a hostDisk", v1.VolumeSource{HostDisk: &v1.HostDisk{Path: "/disk.img", Type: v1.HostDiskExists}}),
+		)
+
+		It("should reject changed block tracking on a containerDisk", func() {
+			enableFeatureGate(virtconfig.IncrementalBackupGate)
+			vmi := newVMIWithChangedBlockTracking(v1.VolumeSource{ContainerDisk: &v1.ContainerDiskSource{Image: "fedora"}})
+
+			causes := validateChangedBlockTracking(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].changedBlockTracking"))
+		})
+
+		It("should reject changed block tracking on a CD-ROM", func() {
+			enableFeatureGate(virtconfig.IncrementalBackupGate)
+			vmi := newVMIWithChangedBlockTracking(pvcVolumeSource)
+			vmi.Spec.Domain.Devices.Disks[0].CDRom = &v1.CDRomTarget{}
+
+			causes := validateChangedBlockTracking(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring("only supported on disks"))
+		})
+	})
+
 	Context("with volume", func() {
 		It("should reject hostDisk volumes if the feature gate is not enabled", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
@@ -3388,3 +3824,8 @@ var _ = Describe("Function getNumberOfPodInterfaces()", func() {
 	})
 
 })
+
+func resourcePtr(value string) *resource.Quantity {
+	quantity := resource.MustParse(value)
+	return &quantity
+}
@@ -31,6 +31,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
@@ -53,10 +54,14 @@ func (admitter *VMIUpdateAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.A
 	if !reflect.DeepEqual(newVMI.Spec, oldVMI.Spec) {
 		// Only allow the KubeVirt SA to modify the VMI spec, since that means it went through the sub resource.
 		if webhooks.IsKubeVirtServiceAccount(ar.Request.UserInfo.Username) {
-			hotplugResponse := admitHotplug(newVMI.Spec.Volumes, oldVMI.Spec.Volumes, newVMI.Spec.Domain.Devices.Disks, oldVMI.Spec.Domain.Devices.Disks, oldVMI.Status.VolumeStatus, newVMI, admitter.ClusterConfig)
+			hotplugResponse := admitHotplug(newVMI.Spec.Volumes, oldVMI.Spec.Volumes, newVMI.Spec.Domain.Devices.Disks, oldVMI.Spec.Domain.Devices.Disks, oldVMI.Status.VolumeStatus, getCompletedVolumeMigrations(oldVMI), newVMI, admitter.ClusterConfig)
 			if hotplugResponse != nil {
 				return hotplugResponse
 			}
+			interfaceHotplugResponse := admitInterfaceHotplug(newVMI.Spec.Networks, oldVMI.Spec.Networks, newVMI.Spec.Domain.Devices.Interfaces, oldVMI.Spec.Domain.Devices.Interfaces, oldVMI.Status.HotplugInterfaces)
+			if interfaceHotplugResponse != nil {
+				return interfaceHotplugResponse
+			}
 		} else {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
@@ -76,9 +81,33 @@ func (admitter *VMIUpdateAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.A
 	return &reviewResponse
 }
 
+// getCompletedVolumeMigrations returns the volume migrations of the last migration of the VMI,
+// if it succeeded
+func getCompletedVolumeMigrations(vmi *v1.VirtualMachineInstance) []v1.VolumeMigration {
+	migrationState := vmi.Status.MigrationState
+	if migrationState == nil || !migrationState.Completed || migrationState.Failed {
+		return nil
+	}
+	return migrationState.VolumeMigrations
+}
+
 // admitHotplug compares the old and new volumes and disks, and ensures that they match and are valid.
-func admitHotplug(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, volumeStatuses []v1.VolumeStatus, newVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *v1beta1.AdmissionResponse {
-	if len(newVolumes) != len(newDisks) {
+// Permanent volumes may only change to the claims they were moved to by the completed volume migrations.
+// The media of CD-ROMs are verified on their own, since they can be ejected and inserted.
+func admitHotplug(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Disk, volumeStatuses []v1.VolumeStatus, volumeMigrations []v1.VolumeMigration, newVMI *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) *v1beta1.AdmissionResponse {
+	newDiskMap := getDiskMap(newDisks)
+	oldDiskMap := getDiskMap(oldDisks)
+	migratedVolumes := migrations.ApplyVolumeMigrations(oldVolumes, volumeMigrations)
+
+	cdromAr := verifyCDRomMedia(getVolumeMap(newVolumes), getVolumeMap(oldVolumes), getVolumeMap(migratedVolumes), newDiskMap, oldDiskMap, volumeStatuses)
+	if cdromAr != nil {
+		return cdromAr
+	}
+	newVolumes = filterCDRomMediaVolumes(newVolumes, newDiskMap)
+	oldVolumes = filterCDRomMediaVolumes(oldVolumes, oldDiskMap)
+	migratedVolumes = filterCDRomMediaVolumes(migratedVolumes, oldDiskMap)
+
+	if len(newVolumes) != len(newDisks)-countCDRoms(newDisks) {
 		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 			{
 				Type:    metav1.CauseTypeFieldValueInvalid,
@@ -90,16 +119,14 @@ func admitHotplug(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Di
 	newPermanentVolumeMap := getPermanentVolumes(newVolumes, volumeStatuses)
 	oldHotplugVolumeMap := getHotplugVolumes(oldVolumes, volumeStatuses)
 	oldPermanentVolumeMap := getPermanentVolumes(oldVolumes, volumeStatuses)
+	migratedPermanentVolumeMap := getPermanentVolumes(migratedVolumes, volumeStatuses)
 
-	newDiskMap := getDiskMap(newDisks)
-	oldDiskMap := getDiskMap(oldDisks)
-
-	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap)
+	permanentAr := verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, migratedPermanentVolumeMap, newDiskMap, oldDiskMap)
 	if permanentAr != nil {
 		return permanentAr
 	}
 
-	hotplugAr := verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap, newDiskMap, oldDiskMap)
+	hotplugAr := verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap, newDiskMap, oldDiskMap, newVMI.Spec.Domain.Devices.MaxHotpluggedDisks)
 	if hotplugAr != nil {
 		return hotplugAr
 	}
@@ -112,8 +139,215 @@ func admitHotplug(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks []v1.Di
 	return nil
 }
 
-func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk) *v1beta1.AdmissionResponse {
+// admitInterfaceHotplug compares the old and new networks and interfaces, and ensures that only
+// hotplugged interfaces are removed and that new interfaces can be hotplugged.
+func admitInterfaceHotplug(newNetworks, oldNetworks []v1.Network, newInterfaces, oldInterfaces []v1.Interface, interfaceStatuses []v1.HotplugInterfaceStatus) *v1beta1.AdmissionResponse {
+	if len(newNetworks) != len(newInterfaces) {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "number of interfaces does not equal the number of networks",
+			},
+		})
+	}
+
+	hotplugInterfaces := make(map[string]bool)
+	for _, status := range interfaceStatuses {
+		hotplugInterfaces[status.Name] = true
+	}
+
+	newNetworkMap := getNetworkMap(newNetworks)
+	oldNetworkMap := getNetworkMap(oldNetworks)
+	newInterfaceMap := getInterfaceMap(newInterfaces)
+	oldInterfaceMap := getInterfaceMap(oldInterfaces)
+
+	for name, oldNetwork := range oldNetworkMap {
+		newNetwork, exists := newNetworkMap[name]
+		if !exists {
+			if !hotplugInterfaces[name] {
+				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+					{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Message: fmt.Sprintf("permanent network %s, removed", name),
+					},
+				})
+			}
+			continue
+		}
+		if !reflect.DeepEqual(newNetwork, oldNetwork) {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("network %s, changed", name),
+				},
+			})
+		}
+		if !reflect.DeepEqual(newInterfaceMap[name], oldInterfaceMap[name]) {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("interface %s, changed", name),
+				},
+			})
+		}
+	}
+
+	for name, newNetwork := range newNetworkMap {
+		if _, exists := oldNetworkMap[name]; exists {
+			continue
+		}
+		// This is a new interface, ensure that it is a bridged secondary multus network
+		if newNetwork.Multus == nil || newNetwork.Multus.Default {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("hotplugged network %s is not a secondary multus network", name),
+				},
+			})
+		}
+		iface, exists := newInterfaceMap[name]
+		if !exists {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("Interface %s does not exist", name),
+				},
+			})
+		}
+		if iface.Bridge == nil {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("hotplugged Interface %s does not use a bridge binding", name),
+				},
+			})
+		}
+	}
+	return nil
+}
+
+func getNetworkMap(networks []v1.Network) map[string]v1.Network {
+	networkMap := make(map[string]v1.Network, 0)
+	for _, network := range networks {
+		networkMap[network.Name] = network
+	}
+	return networkMap
+}
+
+func getInterfaceMap(interfaces []v1.Interface) map[string]v1.Interface {
+	interfaceMap := make(map[string]v1.Interface, 0)
+	for _, iface := range interfaces {
+		interfaceMap[iface.Name] = iface
+	}
+	return interfaceMap
+}
+
+// verifyCDRomMedia ensures that the CD-ROMs stay in place, and that only their media change. A media can
+// be ejected, and a PVC or DataVolume can be inserted into an empty CD-ROM, once the ejected one is gone.
+func verifyCDRomMedia(newVolumes, oldVolumes, migratedVolumes map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk, volumeStatuses []v1.VolumeStatus) *v1beta1.AdmissionResponse {
+	for k, oldDisk := range oldDisks {
+		if oldDisk.CDRom == nil {
+			continue
+		}
+		if newDisk, ok := newDisks[k]; !ok || !reflect.DeepEqual(newDisk, oldDisk) {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("CD-ROM %s, changed", k),
+				},
+			})
+		}
+	}
+
+	volumeStatusMap := make(map[string]v1.VolumeStatus)
+	for _, volumeStatus := range volumeStatuses {
+		volumeStatusMap[volumeStatus.Name] = volumeStatus
+	}
+	for k, newDisk := range newDisks {
+		if newDisk.CDRom == nil {
+			continue
+		}
+		if _, ok := oldDisks[k]; !ok {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("CD-ROM %s can not be hotplugged", k),
+				},
+			})
+		}
+		newVolume, inserted := newVolumes[k]
+		oldVolume, wasInserted := oldVolumes[k]
+		if !inserted {
+			// Ejected, or still empty
+			continue
+		}
+		if wasInserted {
+			if !reflect.DeepEqual(newVolume, oldVolume) && !reflect.DeepEqual(newVolume, migratedVolumes[k]) {
+				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+					{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Message: fmt.Sprintf("media of CD-ROM %s, changed, it has to be ejected first", k),
+					},
+				})
+			}
+			continue
+		}
+		if newVolume.DataVolume == nil && newVolume.PersistentVolumeClaim == nil {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("volume %s is not a PVC or DataVolume", k),
+				},
+			})
+		}
+		if _, ok := volumeStatusMap[k]; ok {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("media of CD-ROM %s is still being ejected", k),
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// filterCDRomMediaVolumes returns the volumes which are not the media of a CD-ROM
+func filterCDRomMediaVolumes(volumes []v1.Volume, disks map[string]v1.Disk) []v1.Volume {
+	filtered := make([]v1.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		if disk, ok := disks[volume.Name]; ok && disk.CDRom != nil {
+			continue
+		}
+		filtered = append(filtered, volume)
+	}
+	return filtered
+}
+
+func countCDRoms(disks []v1.Disk) int {
+	count := 0
+	for _, disk := range disks {
+		if disk.CDRom != nil {
+			count++
+		}
+	}
+	return count
+}
+
+func getVolumeMap(volumes []v1.Volume) map[string]v1.Volume {
+	volumeMap := make(map[string]v1.Volume, 0)
+	for _, volume := range volumes {
+		volumeMap[volume.Name] = volume
+	}
+	return volumeMap
+}
+
+func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk, maxHotpluggedDisks uint32) *v1beta1.AdmissionResponse {
+	virtioDisks := uint32(0)
 	for k, v := range newHotplugVolumeMap {
+		if disk, ok := newDisks[k]; ok && disk.Disk != nil && disk.Disk.Bus == "virtio" {
+			virtioDisks++
+		}
 		if _, ok := oldHotplugVolumeMap[k]; ok {
 			// New and old have same volume, ensure they are the same
 			if !reflect.DeepEqual(v, oldHotplugVolumeMap[k]) {
@@ -150,7 +384,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 					},
 				})
 			}
-			// Also ensure the matching new disk exists and is of type scsi
+			// Also ensure the matching new disk exists and is of type scsi or virtio
 			if _, ok := newDisks[k]; !ok {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
@@ -160,21 +394,30 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 				})
 			}
 			disk := newDisks[k]
-			if disk.Disk == nil || disk.Disk.Bus != "scsi" {
+			if disk.Disk == nil || (disk.Disk.Bus != "scsi" && disk.Disk.Bus != "virtio") {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
 						Type:    metav1.CauseTypeFieldValueInvalid,
-						Message: fmt.Sprintf("hotplugged Disk %s does not use a scsi bus", k),
+						Message: fmt.Sprintf("hotplugged Disk %s does not use a scsi or virtio bus", k),
 					},
 				})
 
 			}
 		}
 	}
+	// Every disk hotplugged on the virtio bus takes one of the reserved PCIe root ports
+	if virtioDisks > maxHotpluggedDisks {
+		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%d disks are hotplugged on the virtio bus, but maxHotpluggedDisks only allows %d", virtioDisks, maxHotpluggedDisks),
+			},
+		})
+	}
 	return nil
 }
 
-func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk) *v1beta1.AdmissionResponse {
+func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap, migratedPermanentVolumeMap map[string]v1.Volume, newDisks, oldDisks map[string]v1.Disk) *v1beta1.AdmissionResponse {
 	if len(newPermanentVolumeMap) != len(oldPermanentVolumeMap) {
 		// Removed one of the permanent volumes, reject admission.
 		return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
@@ -196,7 +439,7 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 				},
 			})
 		}
-		if !reflect.DeepEqual(v, oldPermanentVolumeMap[k]) {
+		if !reflect.DeepEqual(v, oldPermanentVolumeMap[k]) && !reflect.DeepEqual(v, migratedPermanentVolumeMap[k]) {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
 					Type:    metav1.CauseTypeFieldValueInvalid,
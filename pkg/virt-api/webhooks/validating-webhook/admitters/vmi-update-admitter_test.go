@@ -411,7 +411,7 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		newVMI.Spec.Volumes = newVolumes
 		newVMI.Spec.Domain.Devices.Disks = newDisks
 
-		result := admitHotplug(newVolumes, oldVolumes, newDisks, oldDisks, volumeStatuses, newVMI, vmiUpdateAdmitter.ClusterConfig)
+		result := admitHotplug(newVolumes, oldVolumes, newDisks, oldDisks, volumeStatuses, nil, newVMI, vmiUpdateAdmitter.ClusterConfig)
 		Expect(reflect.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
 	},
 		table.Entry("Should accept if no volumes are there or added",
@@ -476,7 +476,7 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeDisksInvalidBusLastDisk(0, 1),
 			makeDisks(0),
 			makeStatus(1, 0),
-			makeExpected("hotplugged Disk volume-name-1 does not use a scsi bus", "")),
+			makeExpected("hotplugged Disk volume-name-1 does not use a scsi or virtio bus", "")),
 		table.Entry("Should reject if we add disk with invalid boot order",
 			makeVolumes(0, 1),
 			makeVolumes(0),
@@ -486,6 +486,208 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeExpected("spec.domain.devices.disks[1] must have a boot order > 0, if supplied", "spec.domain.devices.disks[1].bootOrder")),
 	)
 
+	table.DescribeTable("Should return proper admission response for migrated volumes", func(newVolumes []v1.Volume, volumeMigrations []v1.VolumeMigration, expected *v1beta1.AdmissionResponse) {
+		newVMI := v1.NewMinimalVMI("testvmi")
+		newVMI.Spec.Volumes = newVolumes
+		newVMI.Spec.Domain.Devices.Disks = makeDisks(0, 1)
+
+		result := admitHotplug(newVolumes, makeVolumes(0, 1), makeDisks(0, 1), makeDisks(0, 1), makeStatus(2, 0), volumeMigrations, newVMI, vmiUpdateAdmitter.ClusterConfig)
+		Expect(reflect.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
+	},
+		table.Entry("Should accept if a volume is moved to its destination claim",
+			[]v1.Volume{makeVolumes(0)[0], {Name: "volume-name-1", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "new-dv"}}}},
+			[]v1.VolumeMigration{{SourceVolume: "volume-name-1", DestinationDataVolume: "new-dv"}},
+			nil),
+		table.Entry("Should reject if a volume is moved to another claim",
+			[]v1.Volume{makeVolumes(0)[0], {Name: "volume-name-1", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "other-dv"}}}},
+			[]v1.VolumeMigration{{SourceVolume: "volume-name-1", DestinationDataVolume: "new-dv"}},
+			makeExpected("permanent volume volume-name-1, changed", "")),
+		table.Entry("Should reject if a volume is moved without volume migrations",
+			[]v1.Volume{makeVolumes(0)[0], {Name: "volume-name-1", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "new-dv"}}}},
+			nil,
+			makeExpected("permanent volume volume-name-1, changed", "")),
+	)
+
+	makeVirtioDisks := func(indexes ...int) []v1.Disk {
+		res := makeDisks(indexes...)
+		for i := range res {
+			res[i].Disk.Bus = "virtio"
+		}
+		return res
+	}
+
+	table.DescribeTable("Should return proper admission response for disks hotplugged on the virtio bus", func(maxHotpluggedDisks uint32, expected *v1beta1.AdmissionResponse) {
+		newVMI := v1.NewMinimalVMI("testvmi")
+		newVMI.Spec.Volumes = makeVolumes(0, 1, 2)
+		newVMI.Spec.Domain.Devices.Disks = makeVirtioDisks(0, 1, 2)
+		newVMI.Spec.Domain.Devices.MaxHotpluggedDisks = maxHotpluggedDisks
+
+		result := admitHotplug(newVMI.Spec.Volumes, makeVolumes(0, 1), newVMI.Spec.Domain.Devices.Disks, makeVirtioDisks(0, 1), makeStatus(2, 1), nil, newVMI, vmiUpdateAdmitter.ClusterConfig)
+		Expect(reflect.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
+	},
+		table.Entry("Should accept if there are enough reserved root ports", uint32(2), nil),
+		table.Entry("Should reject if there are not enough reserved root ports", uint32(1),
+			makeExpected("2 disks are hotplugged on the virtio bus, but maxHotpluggedDisks only allows 1", "")),
+		table.Entry("Should reject if no root ports are reserved", uint32(0),
+			makeExpected("2 disks are hotplugged on the virtio bus, but maxHotpluggedDisks only allows 0", "")),
+	)
+
+	makeCDRoms := func(indexes ...int) []v1.Disk {
+		res := make([]v1.Disk, 0)
+		for _, index := range indexes {
+			res = append(res, v1.Disk{
+				Name: fmt.Sprintf("volume-name-%d", index),
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{
+						Bus: "sata",
+					},
+				},
+			})
+		}
+		return res
+	}
+
+	table.DescribeTable("Should return proper admission response for CD-ROM media", func(newVolumes, oldVolumes []v1.Volume, newDisks []v1.Disk, volumeStatuses []v1.VolumeStatus, expected *v1beta1.AdmissionResponse) {
+		oldDisks := append(makeDisks(0), makeCDRoms(1)...)
+		newVMI := v1.NewMinimalVMI("testvmi")
+		newVMI.Spec.Volumes = newVolumes
+		newVMI.Spec.Domain.Devices.Disks = newDisks
+
+		result := admitHotplug(newVolumes, oldVolumes, newDisks, oldDisks, volumeStatuses, nil, newVMI, vmiUpdateAdmitter.ClusterConfig)
+		Expect(reflect.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
+	},
+		table.Entry("Should accept if the media is ejected",
+			makeVolumes(0),
+			makeVolumes(0, 1),
+			append(makeDisks(0), makeCDRoms(1)...),
+			makeStatus(2, 0),
+			nil),
+		table.Entry("Should accept if a media is inserted into an empty CD-ROM",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			append(makeDisks(0), makeCDRoms(1)...),
+			makeStatus(1, 0),
+			nil),
+		table.Entry("Should reject if a media is inserted while the ejected one is still detaching",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			append(makeDisks(0), makeCDRoms(1)...),
+			makeStatus(2, 1),
+			makeExpected("media of CD-ROM volume-name-1 is still being ejected", "")),
+		table.Entry("Should reject if the media is swapped without ejecting it",
+			[]v1.Volume{makeVolumes(0)[0], {Name: "volume-name-1", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "other-dv"}}}},
+			makeVolumes(0, 1),
+			append(makeDisks(0), makeCDRoms(1)...),
+			makeStatus(2, 0),
+			makeExpected("media of CD-ROM volume-name-1, changed, it has to be ejected first", "")),
+		table.Entry("Should reject if the inserted media is not a PVC or DataVolume",
+			makeInvalidVolumes(2, 1),
+			makeVolumes(0),
+			append(makeDisks(0), makeCDRoms(1)...),
+			makeStatus(1, 0),
+			makeExpected("volume volume-name-1 is not a PVC or DataVolume", "")),
+		table.Entry("Should reject if the CD-ROM is removed",
+			makeVolumes(0),
+			makeVolumes(0, 1),
+			makeDisks(0),
+			makeStatus(2, 0),
+			makeExpected("CD-ROM volume-name-1, changed", "")),
+		table.Entry("Should reject if a CD-ROM is hotplugged",
+			makeVolumes(0, 1, 2),
+			makeVolumes(0, 1),
+			append(makeDisks(0), makeCDRoms(1, 2)...),
+			makeStatus(2, 0),
+			makeExpected("CD-ROM volume-name-2 can not be hotplugged", "")),
+	)
+
+	It("should only consider the volume migrations of a successful migration", func() {
+		vmi := v1.NewMinimalVMI("testvmi")
+		volumeMigrations := []v1.VolumeMigration{{SourceVolume: "volume-name-1", DestinationDataVolume: "new-dv"}}
+		Expect(getCompletedVolumeMigrations(vmi)).To(BeEmpty())
+
+		vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{VolumeMigrations: volumeMigrations}
+		Expect(getCompletedVolumeMigrations(vmi)).To(BeEmpty())
+
+		vmi.Status.MigrationState.Completed = true
+		vmi.Status.MigrationState.Failed = true
+		Expect(getCompletedVolumeMigrations(vmi)).To(BeEmpty())
+
+		vmi.Status.MigrationState.Failed = false
+		Expect(getCompletedVolumeMigrations(vmi)).To(Equal(volumeMigrations))
+	})
+
+	multusNetwork := func(name string) v1.Network {
+		return v1.Network{Name: name, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: name + "-nad"}}}
+	}
+	bridgeInterface := func(name string) v1.Interface {
+		return v1.Interface{Name: name, InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}}
+	}
+	masqueradeInterface := func(name string) v1.Interface {
+		return v1.Interface{Name: name, InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}}
+	}
+
+	table.DescribeTable("Should return proper admission response for interface hotplug", func(newNetworks, oldNetworks []v1.Network, newInterfaces, oldInterfaces []v1.Interface, interfaceStatuses []v1.HotplugInterfaceStatus, expected *v1beta1.AdmissionResponse) {
+		result := admitInterfaceHotplug(newNetworks, oldNetworks, newInterfaces, oldInterfaces, interfaceStatuses)
+		Expect(reflect.DeepEqual(result, expected)).To(BeTrue(), "result: %v and expected: %v do not match", result, expected)
+	},
+		table.Entry("Should accept if no interfaces are changed",
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			nil,
+			nil),
+		table.Entry("Should accept if a bridged multus interface is added",
+			[]v1.Network{*v1.DefaultPodNetwork(), multusNetwork("blue")},
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface(), bridgeInterface("blue")},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			nil,
+			nil),
+		table.Entry("Should accept if a hotplugged interface is removed",
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Network{*v1.DefaultPodNetwork(), multusNetwork("blue")},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface(), bridgeInterface("blue")},
+			[]v1.HotplugInterfaceStatus{{Name: "blue", PodInterfaceName: "net1", Phase: v1.InterfaceHotplugReady}},
+			nil),
+		table.Entry("Should reject if #networks != #interfaces",
+			[]v1.Network{*v1.DefaultPodNetwork(), multusNetwork("blue")},
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			nil,
+			makeExpected("number of interfaces does not equal the number of networks", "")),
+		table.Entry("Should reject if a permanent interface is removed",
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Network{*v1.DefaultPodNetwork(), multusNetwork("blue")},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface(), bridgeInterface("blue")},
+			nil,
+			makeExpected("permanent network blue, removed", "")),
+		table.Entry("Should reject if an existing interface is modified",
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{masqueradeInterface("default")},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			nil,
+			makeExpected("interface default, changed", "")),
+		table.Entry("Should reject if the added network is not a multus network",
+			[]v1.Network{multusNetwork("blue"), *v1.DefaultPodNetwork()},
+			[]v1.Network{multusNetwork("blue")},
+			[]v1.Interface{bridgeInterface("blue"), *v1.DefaultBridgeNetworkInterface()},
+			[]v1.Interface{bridgeInterface("blue")},
+			nil,
+			makeExpected("hotplugged network default is not a secondary multus network", "")),
+		table.Entry("Should reject if the added interface does not use a bridge binding",
+			[]v1.Network{*v1.DefaultPodNetwork(), multusNetwork("blue")},
+			[]v1.Network{*v1.DefaultPodNetwork()},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface(), masqueradeInterface("blue")},
+			[]v1.Interface{*v1.DefaultBridgeNetworkInterface()},
+			nil,
+			makeExpected("hotplugged Interface blue does not use a bridge binding", "")),
+	)
+
 	table.DescribeTable("Admit or deny based on user", func(user string, expected types.GomegaMatcher) {
 		vmi := v1.NewMinimalVMI("testvmi")
 		vmi.Spec.Volumes = makeVolumes(1)
@@ -0,0 +1,213 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+
+	"k8s.io/api/admission/v1beta1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	netutils "k8s.io/utils/net"
+
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMNetworkPolicyAdmitter validates VirtualMachineNetworkPolicies
+type VMNetworkPolicyAdmitter struct {
+	ClusterConfig *virtconfig.ClusterConfig
+}
+
+// Admit validates an AdmissionReview
+func (admitter *VMNetworkPolicyAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
+	if ar.Request.Resource.Group != networkpolicyv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinenetworkpolicies" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if !admitter.ClusterConfig.VirtualMachineNetworkPolicyEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("%s feature gate is not enabled in kubevirt-config", virtconfig.VirtualMachineNetworkPolicyGate))
+	}
+
+	policy := &networkpolicyv1.VirtualMachineNetworkPolicy{}
+	err := json.Unmarshal(ar.Request.Object.Raw, policy)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	causes := ValidateVMNetworkPolicySpec(k8sfield.NewPath("spec"), &policy.Spec)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	reviewResponse := v1beta1.AdmissionResponse{}
+	reviewResponse.Allowed = true
+	return &reviewResponse
+}
+
+func ValidateVMNetworkPolicySpec(field *k8sfield.Path, spec *networkpolicyv1.VirtualMachineNetworkPolicySpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	causes = append(causes, validateLabelSelector(field.Child("virtualMachineInstanceSelector"), &spec.VirtualMachineInstanceSelector)...)
+
+	for i, network := range spec.Networks {
+		if network == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "network name must not be empty",
+				Field:   field.Child("networks").Index(i).String(),
+			})
+		}
+	}
+
+	policyTypes := map[networkpolicyv1.PolicyType]bool{}
+	for i, policyType := range spec.PolicyTypes {
+		policyTypeField := field.Child("policyTypes").Index(i)
+		if policyType != networkpolicyv1.PolicyTypeIngress && policyType != networkpolicyv1.PolicyTypeEgress {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("policy type %s is not supported, only %s and %s are", policyType, networkpolicyv1.PolicyTypeIngress, networkpolicyv1.PolicyTypeEgress),
+				Field:   policyTypeField.String(),
+			})
+		} else if policyTypes[policyType] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("policy type %s is listed more than once", policyType),
+				Field:   policyTypeField.String(),
+			})
+		}
+		policyTypes[policyType] = true
+	}
+
+	for i, rule := range spec.Ingress {
+		ruleField := field.Child("ingress").Index(i)
+		causes = append(causes, validateVMNetworkPolicyPorts(ruleField.Child("ports"), rule.Ports)...)
+		causes = append(causes, validateVMNetworkPolicyPeers(ruleField.Child("from"), rule.From)...)
+	}
+	for i, rule := range spec.Egress {
+		ruleField := field.Child("egress").Index(i)
+		causes = append(causes, validateVMNetworkPolicyPorts(ruleField.Child("ports"), rule.Ports)...)
+		causes = append(causes, validateVMNetworkPolicyPeers(ruleField.Child("to"), rule.To)...)
+	}
+
+	return causes
+}
+
+func validateVMNetworkPolicyPorts(field *k8sfield.Path, ports []networkpolicyv1.VirtualMachineNetworkPolicyPort) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for i, port := range ports {
+		portField := field.Index(i)
+		if port.Protocol != nil && *port.Protocol != k8sv1.ProtocolTCP && *port.Protocol != k8sv1.ProtocolUDP && *port.Protocol != k8sv1.ProtocolSCTP {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("protocol %s is not supported, only TCP, UDP and SCTP are", *port.Protocol),
+				Field:   portField.Child("protocol").String(),
+			})
+		}
+		if port.Port != nil && (*port.Port < 1 || *port.Port > 65535) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("port %d is out of range 1-65535", *port.Port),
+				Field:   portField.Child("port").String(),
+			})
+		}
+		if port.EndPort == nil {
+			continue
+		}
+		if port.Port == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: "endPort requires port to be set",
+				Field:   portField.Child("port").String(),
+			})
+		} else if *port.EndPort < *port.Port || *port.EndPort > 65535 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("endPort %d must be in the range %d-65535", *port.EndPort, *port.Port),
+				Field:   portField.Child("endPort").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+func validateVMNetworkPolicyPeers(field *k8sfield.Path, peers []networkpolicyv1.VirtualMachineNetworkPolicyPeer) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	for i, peer := range peers {
+		peerField := field.Index(i)
+		if (peer.IPBlock == nil) == (peer.VirtualMachineInstanceSelector == nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "exactly one of ipBlock and virtualMachineInstanceSelector must be set",
+				Field:   peerField.String(),
+			})
+			continue
+		}
+		if peer.VirtualMachineInstanceSelector != nil {
+			causes = append(causes, validateLabelSelector(peerField.Child("virtualMachineInstanceSelector"), peer.VirtualMachineInstanceSelector)...)
+			continue
+		}
+		causes = append(causes, validateIPBlock(peerField.Child("ipBlock"), peer.IPBlock)...)
+	}
+
+	return causes
+}
+
+func validateIPBlock(field *k8sfield.Path, block *networkpolicyv1.IPBlock) []metav1.StatusCause {
+	_, cidr, err := net.ParseCIDR(block.CIDR)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is not a valid CIDR", block.CIDR),
+			Field:   field.Child("cidr").String(),
+		}}
+	}
+
+	var causes []metav1.StatusCause
+	for i, except := range block.Except {
+		_, exceptCIDR, err := net.ParseCIDR(except)
+		if err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid CIDR", except),
+				Field:   field.Child("except").Index(i).String(),
+			})
+			continue
+		}
+		cidrOnes, _ := cidr.Mask.Size()
+		exceptOnes, _ := exceptCIDR.Mask.Size()
+		if netutils.IsIPv6CIDR(cidr) != netutils.IsIPv6CIDR(exceptCIDR) || !cidr.Contains(exceptCIDR.IP) || exceptOnes < cidrOnes {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not within %s", except, block.CIDR),
+				Field:   field.Child("except").Index(i).String(),
+			})
+		}
+	}
+	return causes
+}
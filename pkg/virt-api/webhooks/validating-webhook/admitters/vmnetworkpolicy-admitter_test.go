@@ -0,0 +1,187 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admission/v1beta1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineNetworkPolicy Admitter", func() {
+	config, configMapInformer, _, _ := testutils.NewFakeClusterConfig(&k8sv1.ConfigMap{})
+	policyAdmitter := &VMNetworkPolicyAdmitter{ClusterConfig: config}
+
+	BeforeEach(func() {
+		testutils.UpdateFakeClusterConfig(configMapInformer, &k8sv1.ConfigMap{
+			Data: map[string]string{virtconfig.FeatureGatesKey: virtconfig.VirtualMachineNetworkPolicyGate},
+		})
+	})
+
+	policyResource := metav1.GroupVersionResource{
+		Group:    networkpolicyv1.SchemeGroupVersion.Group,
+		Version:  networkpolicyv1.SchemeGroupVersion.Version,
+		Resource: "virtualmachinenetworkpolicies",
+	}
+
+	admit := func(resource metav1.GroupVersionResource, policy *networkpolicyv1.VirtualMachineNetworkPolicy) *v1beta1.AdmissionResponse {
+		policyBytes, _ := json.Marshal(policy)
+		ar := &v1beta1.AdmissionReview{
+			Request: &v1beta1.AdmissionRequest{
+				Resource: resource,
+				Object: runtime.RawExtension{
+					Raw: policyBytes,
+				},
+			},
+		}
+		return policyAdmitter.Admit(ar)
+	}
+
+	udp := k8sv1.ProtocolUDP
+
+	It("should reject unexpected resources", func() {
+		response := admit(metav1.GroupVersionResource{Group: "kubevirt.io", Version: "v1", Resource: "virtualmachines"}, &networkpolicyv1.VirtualMachineNetworkPolicy{})
+		Expect(response.Allowed).To(BeFalse())
+	})
+
+	It("should reject policies when the feature gate is not enabled", func() {
+		testutils.UpdateFakeClusterConfig(configMapInformer, &k8sv1.ConfigMap{})
+		response := admit(policyResource, &networkpolicyv1.VirtualMachineNetworkPolicy{})
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Message).To(ContainSubstring("VirtualMachineNetworkPolicy feature gate is not enabled"))
+	})
+
+	It("should accept a valid policy", func() {
+		policy := &networkpolicyv1.VirtualMachineNetworkPolicy{
+			Spec: networkpolicyv1.VirtualMachineNetworkPolicySpec{
+				VirtualMachineInstanceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				Networks:                       []string{"blue"},
+				PolicyTypes:                    []networkpolicyv1.PolicyType{networkpolicyv1.PolicyTypeIngress, networkpolicyv1.PolicyTypeEgress},
+				Ingress: []networkpolicyv1.VirtualMachineNetworkPolicyIngressRule{{
+					Ports: []networkpolicyv1.VirtualMachineNetworkPolicyPort{{Port: newInt32(5432)}},
+					From: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{
+						{VirtualMachineInstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}},
+						{IPBlock: &networkpolicyv1.IPBlock{CIDR: "10.10.0.0/16", Except: []string{"10.10.1.0/24"}}},
+					},
+				}},
+				Egress: []networkpolicyv1.VirtualMachineNetworkPolicyEgressRule{{
+					Ports: []networkpolicyv1.VirtualMachineNetworkPolicyPort{{Protocol: &udp, Port: newInt32(5000), EndPort: newInt32(5010)}},
+					To:    []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{IPBlock: &networkpolicyv1.IPBlock{CIDR: "fd00:10::/64"}}},
+				}},
+			},
+		}
+		Expect(admit(policyResource, policy).Allowed).To(BeTrue())
+	})
+
+	table.DescribeTable("should reject", func(spec networkpolicyv1.VirtualMachineNetworkPolicySpec, field string) {
+		response := admit(policyResource, &networkpolicyv1.VirtualMachineNetworkPolicy{Spec: spec})
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		table.Entry("an invalid vmi selector", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			VirtualMachineInstanceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "not a value"}},
+		}, "spec.virtualMachineInstanceSelector"),
+		table.Entry("an empty network name", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Networks: []string{""},
+		}, "spec.networks[0]"),
+		table.Entry("an unknown policy type", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			PolicyTypes: []networkpolicyv1.PolicyType{"Forward"},
+		}, "spec.policyTypes[0]"),
+		table.Entry("a duplicate policy type", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			PolicyTypes: []networkpolicyv1.PolicyType{networkpolicyv1.PolicyTypeEgress, networkpolicyv1.PolicyTypeEgress},
+		}, "spec.policyTypes[1]"),
+		table.Entry("an unknown protocol", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Ingress: []networkpolicyv1.VirtualMachineNetworkPolicyIngressRule{{
+				Ports: []networkpolicyv1.VirtualMachineNetworkPolicyPort{{Protocol: protocolPtr("ICMP")}},
+			}},
+		}, "spec.ingress[0].ports[0].protocol"),
+		table.Entry("a port out of range", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Egress: []networkpolicyv1.VirtualMachineNetworkPolicyEgressRule{{
+				Ports: []networkpolicyv1.VirtualMachineNetworkPolicyPort{{Port: newInt32(70000)}},
+			}},
+		}, "spec.egress[0].ports[0].port"),
+		table.Entry("an end port without port", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Ingress: []networkpolicyv1.VirtualMachineNetworkPolicyIngressRule{{
+				Ports: []networkpolicyv1.VirtualMachineNetworkPolicyPort{{EndPort: newInt32(80)}},
+			}},
+		}, "spec.ingress[0].ports[0].port"),
+		table.Entry("an end port lower than port", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Ingress: []networkpolicyv1.VirtualMachineNetworkPolicyIngressRule{{
+				Ports: []networkpolicyv1.VirtualMachineNetworkPolicyPort{{Port: newInt32(80), EndPort: newInt32(79)}},
+			}},
+		}, "spec.ingress[0].ports[0].endPort"),
+		table.Entry("a peer without selector and ip block", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Ingress: []networkpolicyv1.VirtualMachineNetworkPolicyIngressRule{{
+				From: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{}},
+			}},
+		}, "spec.ingress[0].from[0]"),
+		table.Entry("a peer with both selector and ip block", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Egress: []networkpolicyv1.VirtualMachineNetworkPolicyEgressRule{{
+				To: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{
+					VirtualMachineInstanceSelector: &metav1.LabelSelector{},
+					IPBlock:                        &networkpolicyv1.IPBlock{CIDR: "10.0.0.0/8"},
+				}},
+			}},
+		}, "spec.egress[0].to[0]"),
+		table.Entry("an invalid peer selector", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Ingress: []networkpolicyv1.VirtualMachineNetworkPolicyIngressRule{{
+				From: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{
+					VirtualMachineInstanceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "bogus"}},
+					},
+				}},
+			}},
+		}, "spec.ingress[0].from[0].virtualMachineInstanceSelector"),
+		table.Entry("an invalid cidr", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Egress: []networkpolicyv1.VirtualMachineNetworkPolicyEgressRule{{
+				To: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{IPBlock: &networkpolicyv1.IPBlock{CIDR: "10.0.0.0"}}},
+			}},
+		}, "spec.egress[0].to[0].ipBlock.cidr"),
+		table.Entry("an except outside of the cidr", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Egress: []networkpolicyv1.VirtualMachineNetworkPolicyEgressRule{{
+				To: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{IPBlock: &networkpolicyv1.IPBlock{CIDR: "10.0.0.0/16", Except: []string{"10.1.0.0/24"}}}},
+			}},
+		}, "spec.egress[0].to[0].ipBlock.except[0]"),
+		table.Entry("an except of another family", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Egress: []networkpolicyv1.VirtualMachineNetworkPolicyEgressRule{{
+				To: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{IPBlock: &networkpolicyv1.IPBlock{CIDR: "::/0", Except: []string{"10.1.0.0/24"}}}},
+			}},
+		}, "spec.egress[0].to[0].ipBlock.except[0]"),
+	)
+})
+
+func protocolPtr(protocol k8sv1.Protocol) *k8sv1.Protocol {
+	return &protocol
+}
+
+func newInt32(i int32) *int32 {
+	return &i
+}
@@ -0,0 +1,160 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/admission/v1beta1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Validating VMPool Admitter", func() {
+	config, _, _, _ := testutils.NewFakeClusterConfig(&k8sv1.ConfigMap{})
+	poolAdmitter := &VMPoolAdmitter{ClusterConfig: config}
+
+	poolResource := metav1.GroupVersionResource{
+		Group:    poolv1.SchemeGroupVersion.Group,
+		Version:  poolv1.SchemeGroupVersion.Version,
+		Resource: "virtualmachinepools",
+	}
+
+	newPool := func(selector map[string]string, labels map[string]string, template *v1.VirtualMachineInstanceTemplateSpec) *poolv1.VirtualMachinePool {
+		running := true
+		return &poolv1.VirtualMachinePool{
+			Spec: poolv1.VirtualMachinePoolSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: selector,
+				},
+				VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: labels,
+					},
+					Spec: v1.VirtualMachineSpec{
+						Running:  &running,
+						Template: template,
+					},
+				},
+			},
+		}
+	}
+
+	admit := func(pool *poolv1.VirtualMachinePool) *v1beta1.AdmissionResponse {
+		poolBytes, _ := json.Marshal(pool)
+		ar := &v1beta1.AdmissionReview{
+			Request: &v1beta1.AdmissionRequest{
+				Resource: poolResource,
+				Object: runtime.RawExtension{
+					Raw: poolBytes,
+				},
+			},
+		}
+		return poolAdmitter.Admit(ar)
+	}
+
+	validTemplate := func() *v1.VirtualMachineInstanceTemplateSpec {
+		return newVirtualMachineBuilder().
+			WithDisk(v1.Disk{
+				Name: "testdisk",
+			}).
+			WithVolume(v1.Volume{
+				Name: "testdisk",
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: &v1.ContainerDiskSource{},
+				},
+			}).
+			BuildTemplate()
+	}
+
+	It("should reject unexpected resources", func() {
+		ar := &v1beta1.AdmissionReview{
+			Request: &v1beta1.AdmissionRequest{
+				Resource: metav1.GroupVersionResource{Group: poolv1.SchemeGroupVersion.Group, Resource: "foos"},
+			},
+		}
+		resp := poolAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	table.DescribeTable("reject invalid VirtualMachinePool spec", func(pool *poolv1.VirtualMachinePool, causes []string) {
+		resp := admit(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(len(causes)))
+		for i, cause := range causes {
+			Expect(resp.Result.Details.Causes[i].Field).To(Equal(cause))
+		}
+	},
+		table.Entry("with missing volume", newPool(
+			map[string]string{"match": "this"},
+			map[string]string{"match": "this"},
+			newVirtualMachineBuilder().WithDisk(v1.Disk{Name: "testdisk"}).BuildTemplate(),
+		), []string{
+			"spec.virtualMachineTemplate.spec.template.spec.domain.devices.disks[0].name",
+		}),
+		table.Entry("with mismatching label selectors", newPool(
+			map[string]string{"match": "not"},
+			map[string]string{"match": "this"},
+			validTemplate(),
+		), []string{
+			"spec.selector",
+		}),
+		table.Entry("with an empty label selector", newPool(
+			map[string]string{},
+			map[string]string{"match": "this"},
+			validTemplate(),
+		), []string{
+			"spec.selector",
+		}),
+	)
+
+	It("should reject negative replicas and unknown policies", func() {
+		pool := newPool(map[string]string{"match": "me"}, map[string]string{"match": "me"}, validTemplate())
+		replicas := int32(-1)
+		policy := poolv1.VirtualMachinePoolVolumeClaimPolicy("Unknown")
+		pool.Spec.Replicas = &replicas
+		pool.Spec.ScaleDownVolumeClaimPolicy = &policy
+		pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{Type: "Unknown"}
+
+		resp := admit(pool)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(3))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.replicas"))
+		Expect(resp.Result.Details.Causes[1].Field).To(Equal("spec.updateStrategy.type"))
+		Expect(resp.Result.Details.Causes[2].Field).To(Equal("spec.scaleDownVolumeClaimPolicy"))
+	})
+
+	It("should accept a valid pool spec", func() {
+		pool := newPool(map[string]string{"match": "me"}, map[string]string{"match": "me"}, validTemplate())
+		retain := poolv1.VirtualMachinePoolVolumeClaimRetain
+		pool.Spec.ScaleDownVolumeClaimPolicy = &retain
+
+		resp := admit(pool)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
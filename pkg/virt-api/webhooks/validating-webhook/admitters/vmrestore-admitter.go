@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/cache"
 
@@ -116,14 +117,36 @@ func (admitter *VMRestoreAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.A
 			}
 		}
 
-		snapshotCauses, err := admitter.validateSnapshot(
-			k8sfield.NewPath("spec", "virtualMachineSnapshotName"),
-			ar.Request.Namespace,
-			vmRestore.Spec.VirtualMachineSnapshotName,
-			targetUID,
-		)
-		if err != nil {
-			return webhookutils.ToAdmissionResponseError(err)
+		snapshotNamespace := ar.Request.Namespace
+		if vmRestore.Spec.VirtualMachineSnapshotNamespace != nil && *vmRestore.Spec.VirtualMachineSnapshotNamespace != "" {
+			snapshotNamespace = *vmRestore.Spec.VirtualMachineSnapshotNamespace
+		}
+
+		// The grant is checked first, so that nothing about the snapshots of
+		// another namespace is revealed to those who may not restore them
+		var snapshotCauses []metav1.StatusCause
+		if snapshotNamespace != ar.Request.Namespace {
+			snapshotCauses, err = admitter.validateGrant(
+				k8sfield.NewPath("spec", "virtualMachineSnapshotNamespace"),
+				snapshotNamespace,
+				ar.Request.Namespace,
+				vmRestore.Spec.VirtualMachineSnapshotName,
+			)
+			if err != nil {
+				return webhookutils.ToAdmissionResponseError(err)
+			}
+		}
+
+		if len(snapshotCauses) == 0 {
+			snapshotCauses, err = admitter.validateSnapshot(
+				k8sfield.NewPath("spec", "virtualMachineSnapshotName"),
+				snapshotNamespace,
+				vmRestore.Spec.VirtualMachineSnapshotName,
+				targetUID,
+			)
+			if err != nil {
+				return webhookutils.ToAdmissionResponseError(err)
+			}
 		}
 
 		informers := webhooks.GetInformers()
@@ -180,13 +203,16 @@ func (admitter *VMRestoreAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.A
 func (admitter *VMRestoreAdmitter) validateCreateVM(field *k8sfield.Path, namespace, name string) ([]metav1.StatusCause, *types.UID, error) {
 	vm, err := admitter.Client.VirtualMachine(namespace).Get(name, &metav1.GetOptions{})
 	if errors.IsNotFound(err) {
-		return []metav1.StatusCause{
-			{
+		// the VirtualMachine is created by the restore
+		var causes []metav1.StatusCause
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: fmt.Sprintf("VirtualMachine %q does not exist", name),
+				Message: fmt.Sprintf("VirtualMachine name %q is invalid: %s", name, msg),
 				Field:   field.String(),
-			},
-		}, nil, nil
+			})
+		}
+		return causes, nil, nil
 	}
 
 	if err != nil {
@@ -250,3 +276,24 @@ func (admitter *VMRestoreAdmitter) validateSnapshot(field *k8sfield.Path, namesp
 
 	return causes, nil
 }
+
+func (admitter *VMRestoreAdmitter) validateGrant(field *k8sfield.Path, snapshotNamespace, namespace, snapshotName string) ([]metav1.StatusCause, error) {
+	grants, err := admitter.Client.VirtualMachineRestoreGrant(snapshotNamespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, grant := range grants.Items {
+		if grant.Allows(namespace, snapshotName) {
+			return nil, nil
+		}
+	}
+
+	return []metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("no VirtualMachineRestoreGrant in namespace %q allows restoring VirtualMachineSnapshot %q", snapshotNamespace, snapshotName),
+			Field:   field.String(),
+		},
+	}, nil
+}
@@ -37,6 +37,7 @@ import (
 	v1 "kubevirt.io/client-go/api/v1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	snapshotv1client "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -138,7 +139,7 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.apiGroup"))
 		})
 
-		It("should reject when VM does not exist", func() {
+		It("should accept when VM does not exist", func() {
 			restore := &snapshotv1.VirtualMachineRestore{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "restore",
@@ -148,7 +149,28 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 					Target: corev1.TypedLocalObjectReference{
 						APIGroup: &apiGroup,
 						Kind:     "VirtualMachine",
-						Name:     vmName,
+						Name:     "newvm",
+					},
+					VirtualMachineSnapshotName: vmSnapshotName,
+				},
+			}
+
+			ar := createRestoreAdmissionReview(restore)
+			resp := createTestVMRestoreAdmitter(config, nil, snapshot).Admit(ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject invalid name when VM does not exist", func() {
+			restore := &snapshotv1.VirtualMachineRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "restore",
+					Namespace: "default",
+				},
+				Spec: snapshotv1.VirtualMachineRestoreSpec{
+					Target: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     "VirtualMachine",
+						Name:     "New_VM",
 					},
 					VirtualMachineSnapshotName: vmSnapshotName,
 				},
@@ -161,6 +183,87 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.name"))
 		})
 
+		Context("with snapshot in another namespace", func() {
+			snapshotNamespace := "source"
+
+			var crossNamespaceSnapshot *snapshotv1.VirtualMachineSnapshot
+			var restore *snapshotv1.VirtualMachineRestore
+
+			BeforeEach(func() {
+				crossNamespaceSnapshot = snapshot.DeepCopy()
+				crossNamespaceSnapshot.Namespace = snapshotNamespace
+
+				restore = &snapshotv1.VirtualMachineRestore{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "restore",
+						Namespace: "default",
+					},
+					Spec: snapshotv1.VirtualMachineRestoreSpec{
+						Target: corev1.TypedLocalObjectReference{
+							APIGroup: &apiGroup,
+							Kind:     "VirtualMachine",
+							Name:     "newvm",
+						},
+						VirtualMachineSnapshotName:      vmSnapshotName,
+						VirtualMachineSnapshotNamespace: &snapshotNamespace,
+					},
+				}
+			})
+
+			It("should reject without grant", func() {
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, nil, crossNamespaceSnapshot).Admit(ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(len(resp.Result.Details.Causes)).To(Equal(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotNamespace"))
+			})
+
+			It("should not reveal whether the snapshot exists without grant", func() {
+				restore.Spec.VirtualMachineSnapshotName = "missing"
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, nil, crossNamespaceSnapshot).Admit(ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(len(resp.Result.Details.Causes)).To(Equal(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotNamespace"))
+			})
+
+			It("should reject when grant does not include the snapshot", func() {
+				grant := &snapshotv1.VirtualMachineRestoreGrant{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "grant",
+						Namespace: snapshotNamespace,
+					},
+					Spec: snapshotv1.VirtualMachineRestoreGrantSpec{
+						Namespaces:                  []string{"default"},
+						VirtualMachineSnapshotNames: []string{"other"},
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, nil, crossNamespaceSnapshot, grant).Admit(ar)
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(len(resp.Result.Details.Causes)).To(Equal(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotNamespace"))
+			})
+
+			It("should accept with grant", func() {
+				grant := &snapshotv1.VirtualMachineRestoreGrant{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "grant",
+						Namespace: snapshotNamespace,
+					},
+					Spec: snapshotv1.VirtualMachineRestoreGrantSpec{
+						Namespaces:                  []string{"default"},
+						VirtualMachineSnapshotNames: []string{vmSnapshotName},
+					},
+				}
+
+				ar := createRestoreAdmissionReview(restore)
+				resp := createTestVMRestoreAdmitter(config, nil, crossNamespaceSnapshot, grant).Admit(ar)
+				Expect(resp.Allowed).To(BeTrue())
+			})
+		})
+
 		It("should reject when VM and snapshot do not exist", func() {
 			restore := &snapshotv1.VirtualMachineRestore{
 				ObjectMeta: metav1.ObjectMeta{
@@ -180,9 +283,8 @@ var _ = Describe("Validating VirtualMachineRestore Admitter", func() {
 			ar := createRestoreAdmissionReview(restore)
 			resp := createTestVMRestoreAdmitter(config, nil).Admit(ar)
 			Expect(resp.Allowed).To(BeFalse())
-			Expect(len(resp.Result.Details.Causes)).To(Equal(2))
-			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.target.name"))
-			Expect(resp.Result.Details.Causes[1].Field).To(Equal("spec.virtualMachineSnapshotName"))
+			Expect(len(resp.Result.Details.Causes)).To(Equal(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotName"))
 		})
 
 		It("should reject spec update", func() {
@@ -541,8 +643,14 @@ func createTestVMRestoreAdmitter(
 	vmInterface := kubecli.NewMockVirtualMachineInterface(ctrl)
 	kubevirtClient := kubevirtfake.NewSimpleClientset(objs...)
 
-	virtClient.EXPECT().VirtualMachineSnapshot("default").
-		Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots("default"))
+	virtClient.EXPECT().VirtualMachineSnapshot(gomock.Any()).
+		DoAndReturn(func(namespace string) snapshotv1client.VirtualMachineSnapshotInterface {
+			return kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(namespace)
+		}).AnyTimes()
+	virtClient.EXPECT().VirtualMachineRestoreGrant(gomock.Any()).
+		DoAndReturn(func(namespace string) snapshotv1client.VirtualMachineRestoreGrantInterface {
+			return kubevirtClient.SnapshotV1alpha1().VirtualMachineRestoreGrants(namespace)
+		}).AnyTimes()
 	virtClient.EXPECT().VirtualMachine(gomock.Any()).Return(vmInterface).AnyTimes()
 
 	restoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
@@ -38,6 +38,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 	cdiclone "kubevirt.io/containerized-data-importer/pkg/clone"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -48,9 +49,10 @@ var validRunStrategies = []v1.VirtualMachineRunStrategy{v1.RunStrategyHalted, v1
 type CloneAuthFunc func(pvcNamespace, pvcName, saNamespace, saName string) (bool, string, error)
 
 type VMsAdmitter struct {
-	ClusterConfig *virtconfig.ClusterConfig
-	cloneAuthFunc CloneAuthFunc
-	virtClient    kubecli.KubevirtClient
+	ClusterConfig       *virtconfig.ClusterConfig
+	InstancetypeMethods instancetype.Methods
+	cloneAuthFunc       CloneAuthFunc
+	virtClient          kubecli.KubevirtClient
 }
 
 type sarProxy struct {
@@ -65,8 +67,9 @@ func NewVMsAdmitter(clusterConfig *virtconfig.ClusterConfig, client kubecli.Kube
 	proxy := &sarProxy{client: client}
 
 	return &VMsAdmitter{
-		ClusterConfig: clusterConfig,
-		virtClient:    client,
+		ClusterConfig:       clusterConfig,
+		InstancetypeMethods: instancetype.NewMethods(nil, nil, nil, nil, client),
+		virtClient:          client,
 		cloneAuthFunc: func(pvcNamespace, pvcName, saNamespace, saName string) (bool, string, error) {
 			return cdiclone.CanServiceAccountClonePVC(proxy, pvcNamespace, pvcName, saNamespace, saName)
 		},
@@ -92,7 +95,15 @@ func (admitter *VMsAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.Admissi
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
-	causes := ValidateVirtualMachineSpec(k8sfield.NewPath("spec"), &vm.Spec, admitter.ClusterConfig, accountName)
+	// Validate the template the way it will be expanded into the
+	// VirtualMachineInstance by the referenced instancetype and preference.
+	vmCopy := vm.DeepCopy()
+	causes := admitter.applyInstancetypeToVm(vmCopy)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	causes = ValidateVirtualMachineSpec(k8sfield.NewPath("spec"), &vmCopy.Spec, admitter.ClusterConfig, accountName)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -117,6 +128,11 @@ func (admitter *VMsAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.Admissi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes = admitter.validateInterfaceRequests(&vm)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	causes = validateSnapshotStatus(ar.Request, &vm)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
@@ -127,6 +143,45 @@ func (admitter *VMsAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.Admissi
 	return &reviewResponse
 }
 
+func (admitter *VMsAdmitter) applyInstancetypeToVm(vm *v1.VirtualMachine) []metav1.StatusCause {
+	if vm.Spec.Instancetype == nil && vm.Spec.Preference == nil {
+		return nil
+	}
+
+	instancetypeSpec, err := admitter.InstancetypeMethods.FindInstancetypeSpec(vm)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("Failure to find instancetype: %v", err),
+			Field:   k8sfield.NewPath("spec", "instancetype").String(),
+		}}
+	}
+
+	preferenceSpec, err := admitter.InstancetypeMethods.FindPreferenceSpec(vm)
+	if err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueNotFound,
+			Message: fmt.Sprintf("Failure to find preference: %v", err),
+			Field:   k8sfield.NewPath("spec", "preference").String(),
+		}}
+	}
+
+	if vm.Spec.Template == nil {
+		return nil
+	}
+
+	conflicts := admitter.InstancetypeMethods.ApplyToVmi(k8sfield.NewPath("spec", "template", "spec"), instancetypeSpec, preferenceSpec, &vm.Spec.Template.Spec)
+	var causes []metav1.StatusCause
+	for _, conflict := range conflicts {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("VM field %s conflicts with selected instancetype", conflict.String()),
+			Field:   conflict.String(),
+		})
+	}
+	return causes
+}
+
 func (admitter *VMsAdmitter) AdmitStatus(ar *v1beta1.AdmissionReview) *v1beta1.AdmissionResponse {
 	vm, _, err := webhookutils.GetVMFromAdmissionReview(ar)
 	if err != nil {
@@ -145,6 +200,11 @@ func (admitter *VMsAdmitter) AdmitStatus(ar *v1beta1.AdmissionReview) *v1beta1.A
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes = admitter.validateInterfaceRequests(vm)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	causes = validateSnapshotStatus(ar.Request, vm)
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
@@ -292,6 +352,32 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	return causes
 }
 
+func countVolumeRequestOptions(volumeRequest *v1.VirtualMachineVolumeRequest) int {
+	count := 0
+	if volumeRequest.AddVolumeOptions != nil {
+		count++
+	}
+	if volumeRequest.RemoveVolumeOptions != nil {
+		count++
+	}
+	if volumeRequest.EjectCDRomOptions != nil {
+		count++
+	}
+	if volumeRequest.InsertCDRomOptions != nil {
+		count++
+	}
+	return count
+}
+
+func hasCDRom(spec *v1.VirtualMachineInstanceSpec, name string) bool {
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.Name == name && disk.CDRom != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (admitter *VMsAdmitter) validateVolumeRequests(ar *v1beta1.AdmissionRequest, vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
 	if len(vm.Status.VolumeRequests) == 0 {
 		return nil, nil
@@ -299,6 +385,7 @@ func (admitter *VMsAdmitter) validateVolumeRequests(ar *v1beta1.AdmissionRequest
 
 	curVMAddRequestsMap := make(map[string]*v1.VirtualMachineVolumeRequest)
 	curVMRemoveRequestsMap := make(map[string]*v1.VirtualMachineVolumeRequest)
+	curVMCDRomRequestsMap := make(map[string]*v1.VirtualMachineVolumeRequest)
 
 	vmVolumeMap := make(map[string]v1.Volume)
 	vmiVolumeMap := make(map[string]v1.Volume)
@@ -333,10 +420,10 @@ func (admitter *VMsAdmitter) validateVolumeRequests(ar *v1beta1.AdmissionRequest
 	for _, volumeRequest := range vm.Status.VolumeRequests {
 		volumeRequest := volumeRequest
 		name := ""
-		if volumeRequest.AddVolumeOptions != nil && volumeRequest.RemoveVolumeOptions != nil {
+		if countVolumeRequestOptions(&volumeRequest) > 1 {
 			return []metav1.StatusCause{{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "VolumeRequests require either addVolumeOptions or removeVolumeOptions to be set, not both",
+				Message: "VolumeRequests require either addVolumeOptions, removeVolumeOptions, ejectCDRomOptions or insertCDRomOptions to be set, not several",
 				Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
 			}}, nil
 		} else if volumeRequest.AddVolumeOptions != nil {
@@ -364,10 +451,16 @@ func (admitter *VMsAdmitter) validateVolumeRequests(ar *v1beta1.AdmissionRequest
 					Message: fmt.Sprintf("AddVolume request for [%s] requires diskDevice of type 'disk' to be used.", name),
 					Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
 				}}, nil
-			} else if volumeRequest.AddVolumeOptions.Disk.DiskDevice.Disk.Bus != "scsi" {
+			} else if bus := volumeRequest.AddVolumeOptions.Disk.DiskDevice.Disk.Bus; bus != "scsi" && bus != "virtio" {
 				return []metav1.StatusCause{{
 					Type:    metav1.CauseTypeFieldValueInvalid,
-					Message: fmt.Sprintf("AddVolume request for [%s] requires disk bus to be 'scsi'. [%s] is not permitted", name, volumeRequest.AddVolumeOptions.Disk.DiskDevice.Disk.Bus),
+					Message: fmt.Sprintf("AddVolume request for [%s] requires disk bus to be 'scsi' or 'virtio'. [%s] is not permitted", name, bus),
+					Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
+				}}, nil
+			} else if bus == "virtio" && vm.Spec.Template.Spec.Domain.Devices.MaxHotpluggedDisks == 0 {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("AddVolume request for [%s] requires maxHotpluggedDisks to be set to use the 'virtio' bus", name),
 					Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
 				}}, nil
 			}
@@ -413,10 +506,35 @@ func (admitter *VMsAdmitter) validateVolumeRequests(ar *v1beta1.AdmissionRequest
 			}
 
 			curVMRemoveRequestsMap[name] = &volumeRequest
+		} else if volumeRequest.EjectCDRomOptions != nil || volumeRequest.InsertCDRomOptions != nil {
+			if volumeRequest.EjectCDRomOptions != nil {
+				name = volumeRequest.EjectCDRomOptions.Name
+			} else {
+				name = volumeRequest.InsertCDRomOptions.Name
+			}
+
+			if !hasCDRom(&vm.Spec.Template.Spec, name) {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("CD-ROM [%s] does not exist on the vmi template", name),
+					Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
+				}}, nil
+			}
+
+			// Each eject or insert request replaces the previous one of the CD-ROM, they have
+			// to be applied one after another
+			if _, ok := curVMCDRomRequestsMap[name]; ok {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("A request to change the media of CD-ROM [%s] already exists", name),
+					Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
+				}}, nil
+			}
+			curVMCDRomRequestsMap[name] = &volumeRequest
 		} else {
 			return []metav1.StatusCause{{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "VolumeRequests require one of either addVolumeOptions or removeVolumeOptions to be set",
+				Message: "VolumeRequests require one of either addVolumeOptions, removeVolumeOptions, ejectCDRomOptions or insertCDRomOptions to be set",
 				Field:   k8sfield.NewPath("Status", "volumeRequests").String(),
 			}}, nil
 		}
@@ -445,6 +563,79 @@ func (admitter *VMsAdmitter) validateVolumeRequests(ar *v1beta1.AdmissionRequest
 
 }
 
+func (admitter *VMsAdmitter) validateInterfaceRequests(vm *v1.VirtualMachine) []metav1.StatusCause {
+	if len(vm.Status.InterfaceRequests) == 0 {
+		return nil
+	}
+
+	curVMAddRequestsMap := make(map[string]bool)
+	curVMRemoveRequestsMap := make(map[string]bool)
+
+	newSpec := vm.Spec.Template.Spec.DeepCopy()
+	for _, interfaceRequest := range vm.Status.InterfaceRequests {
+		interfaceRequest := interfaceRequest
+		if interfaceRequest.AddInterfaceOptions != nil && interfaceRequest.RemoveInterfaceOptions != nil {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "InterfaceRequests require either addInterfaceOptions or removeInterfaceOptions to be set, not both",
+				Field:   k8sfield.NewPath("Status", "interfaceRequests").String(),
+			}}
+		} else if interfaceRequest.AddInterfaceOptions != nil {
+			name := interfaceRequest.AddInterfaceOptions.Name
+
+			if curVMAddRequestsMap[name] {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("AddInterface request for [%s] aleady exists", name),
+					Field:   k8sfield.NewPath("Status", "interfaceRequests").String(),
+				}}
+			}
+
+			if interfaceRequest.AddInterfaceOptions.NetworkAttachmentDefinitionName == "" {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("AddInterface request for [%s] requires the networkAttachmentDefinitionName field to be set.", name),
+					Field:   k8sfield.NewPath("Status", "interfaceRequests").String(),
+				}}
+			}
+
+			for _, network := range newSpec.Networks {
+				if network.Name == name && (network.Multus == nil || network.Multus.NetworkName != interfaceRequest.AddInterfaceOptions.NetworkAttachmentDefinitionName) {
+					return []metav1.StatusCause{{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Message: fmt.Sprintf("AddInterface request for [%s] conflicts with an existing network of the same name on the vmi template.", name),
+						Field:   k8sfield.NewPath("Status", "interfaceRequests").String(),
+					}}
+				}
+			}
+
+			curVMAddRequestsMap[name] = true
+		} else if interfaceRequest.RemoveInterfaceOptions != nil {
+			name := interfaceRequest.RemoveInterfaceOptions.Name
+
+			if curVMRemoveRequestsMap[name] {
+				return []metav1.StatusCause{{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("RemoveInterface request for [%s] aleady exists", name),
+					Field:   k8sfield.NewPath("Status", "interfaceRequests").String(),
+				}}
+			}
+
+			curVMRemoveRequestsMap[name] = true
+		} else {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "InterfaceRequests require one of either addInterfaceOptions or removeInterfaceOptions to be set",
+				Field:   k8sfield.NewPath("Status", "interfaceRequests").String(),
+			}}
+		}
+		newSpec = controller.ApplyInterfaceRequestOnVMISpec(newSpec, &interfaceRequest)
+	}
+
+	// this simulates injecting the changes into the VMI template and validates it will work.
+	return ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("spec", "template", "spec"), newSpec, admitter.ClusterConfig)
+}
+
 func validateStateChangeRequests(ar *v1beta1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
 	// Only rename request is validated
 	renameRequest := getRenameRequest(vm)
@@ -38,12 +38,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	authv1 "k8s.io/api/authentication/v1"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	instancetypev1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -151,6 +155,95 @@ var _ = Describe("Validating VM Admitter", func() {
 		Expect(resp.Allowed).To(BeTrue())
 	})
 
+	Context("with instancetypes and preferences", func() {
+
+		var clusterInstancetypeStore cache.Store
+		var clusterPreferenceStore cache.Store
+		var vm *v1.VirtualMachine
+
+		admitVM := func(vm *v1.VirtualMachine) *v1beta1.AdmissionResponse {
+			vmBytes, _ := json.Marshal(vm)
+			ar := &v1beta1.AdmissionReview{
+				Request: &v1beta1.AdmissionRequest{
+					Resource: webhooks.VirtualMachineGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: vmBytes,
+					},
+				},
+			}
+			return vmsAdmitter.Admit(ar)
+		}
+
+		BeforeEach(func() {
+			clusterInstancetypeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+			clusterPreferenceStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+			vmsAdmitter.InstancetypeMethods = instancetype.NewMethods(nil, clusterInstancetypeStore, nil, clusterPreferenceStore, virtClient)
+
+			Expect(clusterInstancetypeStore.Add(&instancetypev1.VirtualMachineClusterInstancetype{
+				ObjectMeta: metav1.ObjectMeta{Name: "instancetype"},
+				Spec: instancetypev1.VirtualMachineInstancetypeSpec{
+					CPU:    instancetypev1.CPUInstancetype{Guest: 2},
+					Memory: instancetypev1.MemoryInstancetype{Guest: resource.MustParse("128Mi")},
+				},
+			})).To(Succeed())
+			Expect(clusterPreferenceStore.Add(&instancetypev1.VirtualMachineClusterPreference{
+				ObjectMeta: metav1.ObjectMeta{Name: "preference"},
+				Spec: instancetypev1.VirtualMachinePreferenceSpec{
+					Devices: &instancetypev1.DevicePreferences{PreferredDiskBus: "virtio"},
+				},
+			})).To(Succeed())
+
+			vm = &v1.VirtualMachine{
+				Spec: v1.VirtualMachineSpec{
+					Running:      &notRunning,
+					Instancetype: &v1.InstancetypeMatcher{Name: "instancetype"},
+					Preference:   &v1.PreferenceMatcher{Name: "preference"},
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: v1.VirtualMachineInstanceSpec{},
+					},
+				},
+			}
+		})
+
+		It("should accept a VM which gets its resources from the instancetype", func() {
+			resp := admitVM(vm)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("should reject a VM which conflicts with the instancetype", func() {
+			vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 1}
+			vm.Spec.Template.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("64Mi"),
+			}
+
+			resp := admitVM(vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(2))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.cpu"))
+			Expect(resp.Result.Details.Causes[1].Field).To(Equal("spec.template.spec.domain.resources.requests.memory"))
+		})
+
+		It("should reject a VM referencing an unknown instancetype kind", func() {
+			vm.Spec.Instancetype.Kind = "unknown"
+
+			resp := admitVM(vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.instancetype"))
+		})
+
+		It("should reject a VM referencing a missing preference", func() {
+			kubevirtClient := kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineClusterPreference().Return(kubevirtClient.InstancetypeV1alpha1().VirtualMachineClusterPreferences())
+			vm.Spec.Preference.Name = "missing"
+
+			resp := admitVM(vm)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.preference"))
+		})
+	})
+
 	table.DescribeTable("should validate VolumeRequest on running vm", func(requests []v1.VirtualMachineVolumeRequest, isValid bool) {
 		vmi := v1.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -186,6 +279,21 @@ var _ = Describe("Validating VM Admitter", func() {
 			},
 		})
 
+		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+			Name: "cdrom",
+			DiskDevice: v1.DiskDevice{
+				CDRom: &v1.CDRomTarget{Bus: "sata"},
+			},
+		})
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "cdrom",
+			VolumeSource: v1.VolumeSource{
+				DataVolume: &v1.DataVolumeSource{
+					Name: "iso-dv",
+				},
+			},
+		})
+
 		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
 			Name: "t-pvcdisk",
 		})
@@ -374,6 +482,154 @@ var _ = Describe("Validating VM Admitter", func() {
 			},
 		},
 			true),
+		table.Entry("with invalid request to add volume on the virtio bus without reserved root ports", []v1.VirtualMachineVolumeRequest{
+			{
+				AddVolumeOptions: &v1.AddVolumeOptions{
+					Name: "testdisk2",
+					Disk: &v1.Disk{
+						Name: "testdisk2",
+						DiskDevice: v1.DiskDevice{
+							Disk: &v1.DiskTarget{
+								Bus: "virtio",
+							},
+						},
+					},
+					VolumeSource: &v1.HotplugVolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "madeup",
+						},
+					},
+				},
+			},
+		},
+			false),
+		table.Entry("with valid request to eject a CD-ROM", []v1.VirtualMachineVolumeRequest{
+			{
+				EjectCDRomOptions: &v1.EjectCDRomOptions{
+					Name: "cdrom",
+				},
+			},
+		},
+			true),
+		table.Entry("with invalid request to eject a disk which is not a CD-ROM", []v1.VirtualMachineVolumeRequest{
+			{
+				EjectCDRomOptions: &v1.EjectCDRomOptions{
+					Name: "testpvcdisk",
+				},
+			},
+		},
+			false),
+		table.Entry("with invalid request to eject and insert the media of a CD-ROM at once", []v1.VirtualMachineVolumeRequest{
+			{
+				EjectCDRomOptions: &v1.EjectCDRomOptions{
+					Name: "cdrom",
+				},
+			},
+			{
+				InsertCDRomOptions: &v1.InsertCDRomOptions{
+					Name: "cdrom",
+					VolumeSource: &v1.HotplugVolumeSource{
+						DataVolume: &v1.DataVolumeSource{
+							Name: "other-iso-dv",
+						},
+					},
+				},
+			},
+		},
+			false),
+	)
+
+	table.DescribeTable("should validate InterfaceRequest", func(requests []v1.VirtualMachineInterfaceRequest, isValid bool) {
+		vmi := v1.NewMinimalVMI("testvmi")
+		vmi.Spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-nad"}}},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			*v1.DefaultBridgeNetworkInterface(),
+			{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+		}
+
+		vm := &v1.VirtualMachine{
+			Spec: v1.VirtualMachineSpec{
+				Running: &notRunning,
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: *vmi.Spec.DeepCopy(),
+				},
+			},
+			Status: v1.VirtualMachineStatus{
+				InterfaceRequests: requests,
+			},
+		}
+		vmBytes, _ := json.Marshal(&vm)
+
+		ar := &v1beta1.AdmissionReview{
+			Request: &v1beta1.AdmissionRequest{
+				Resource: webhooks.VirtualMachineGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: vmBytes,
+				},
+			},
+		}
+
+		resp := vmsAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(Equal(isValid))
+	},
+		table.Entry("with valid request to add interface", []v1.VirtualMachineInterfaceRequest{
+			{
+				AddInterfaceOptions: &v1.AddInterfaceOptions{
+					Name:                            "red",
+					NetworkAttachmentDefinitionName: "red-nad",
+				},
+			},
+		}, true),
+		table.Entry("with valid request to add interface that is identical to one in the template", []v1.VirtualMachineInterfaceRequest{
+			{
+				AddInterfaceOptions: &v1.AddInterfaceOptions{
+					Name:                            "blue",
+					NetworkAttachmentDefinitionName: "blue-nad",
+				},
+			},
+		}, true),
+		table.Entry("with valid request to remove interface", []v1.VirtualMachineInterfaceRequest{
+			{
+				RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+					Name: "blue",
+				},
+			},
+		}, true),
+		table.Entry("with invalid request to add interface that conflicts with the template", []v1.VirtualMachineInterfaceRequest{
+			{
+				AddInterfaceOptions: &v1.AddInterfaceOptions{
+					Name:                            "blue",
+					NetworkAttachmentDefinitionName: "other-nad",
+				},
+			},
+		}, false),
+		table.Entry("with invalid request to add interface without a network attachment definition", []v1.VirtualMachineInterfaceRequest{
+			{
+				AddInterfaceOptions: &v1.AddInterfaceOptions{
+					Name: "red",
+				},
+			},
+		}, false),
+		table.Entry("with invalid request to add the same interface twice", []v1.VirtualMachineInterfaceRequest{
+			{
+				AddInterfaceOptions: &v1.AddInterfaceOptions{
+					Name:                            "red",
+					NetworkAttachmentDefinitionName: "red-nad",
+				},
+			},
+			{
+				AddInterfaceOptions: &v1.AddInterfaceOptions{
+					Name:                            "red",
+					NetworkAttachmentDefinitionName: "red-nad",
+				},
+			},
+		}, false),
+		table.Entry("with invalid request with no options", []v1.VirtualMachineInterfaceRequest{
+			{},
+		}, false),
 	)
 
 	table.DescribeTable("should validate VolumeRequest on offline vm", func(requests []v1.VirtualMachineVolumeRequest, isValid bool) {
@@ -112,6 +112,14 @@ func (admitter *VMSnapshotAdmitter) Admit(ar *v1beta1.AdmissionReview) *v1beta1.
 			}
 		}
 
+		if timeout := vmSnapshot.Spec.FreezeTimeout; timeout != nil && timeout.Duration <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "freezeTimeout must be greater than 0",
+				Field:   k8sfield.NewPath("spec", "freezeTimeout").String(),
+			})
+		}
+
 	case v1beta1.Update:
 		prevObj := &snapshotv1.VirtualMachineSnapshot{}
 		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
@@ -158,21 +166,10 @@ func (admitter *VMSnapshotAdmitter) validateCreateVM(field *k8sfield.Path, names
 		return nil, err
 	}
 
-	var causes []metav1.StatusCause
-
-	rs, err := vm.RunStrategy()
-	if err != nil {
+	// running VMs are snapshotted online, only make sure the run strategy is valid
+	if _, err := vm.RunStrategy(); err != nil {
 		return nil, err
 	}
 
-	if rs != v1.RunStrategyHalted {
-		cause := metav1.StatusCause{
-			Type:    metav1.CauseTypeFieldValueInvalid,
-			Message: fmt.Sprintf("VirtualMachine %q is running", name),
-			Field:   field.String(),
-		}
-		causes = append(causes, cause)
-	}
-
-	return causes, nil
+	return nil, nil
 }
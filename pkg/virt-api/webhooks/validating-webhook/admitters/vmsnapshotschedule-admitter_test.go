@@ -0,0 +1,153 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package admitters
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	config, configMapInformer, _, _ := testutils.NewFakeClusterConfig(&corev1.ConfigMap{})
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "schedule",
+				Namespace: "foo",
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"backup": "nightly"},
+				},
+			},
+		}
+	}
+
+	Context("Without feature gate enabled", func() {
+		It("should reject anything", func() {
+			ar := createSnapshotScheduleAdmissionReview(v1beta1.Create, createSchedule())
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+		})
+	})
+
+	Context("With feature gate enabled", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{
+				Data: map[string]string{virtconfig.FeatureGatesKey: "Snapshot"},
+			})
+		})
+
+		AfterEach(func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &corev1.ConfigMap{})
+		})
+
+		It("should reject invalid request resource", func() {
+			ar := createSnapshotScheduleAdmissionReview(v1beta1.Create, createSchedule())
+			ar.Request.Resource.Resource = "virtualmachinesnapshots"
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+		})
+
+		table.DescribeTable("should allow valid schedules", func(operation v1beta1.Operation) {
+			schedule := createSchedule()
+			schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+				Last:    &[]int32{3}[0],
+				Daily:   &[]int32{7}[0],
+				Weekly:  &[]int32{4}[0],
+				Expires: &metav1.Duration{Duration: 30 * 24 * time.Hour},
+			}
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(createSnapshotScheduleAdmissionReview(operation, schedule))
+			Expect(resp.Allowed).To(BeTrue())
+		},
+			table.Entry("on create", v1beta1.Create),
+			table.Entry("on update", v1beta1.Update),
+		)
+
+		table.DescribeTable("should reject", func(update func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+			schedule := createSchedule()
+			update(schedule)
+
+			resp := NewVMSnapshotScheduleAdmitter(config).Admit(createSnapshotScheduleAdmissionReview(v1beta1.Create, schedule))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+		},
+			table.Entry("an invalid schedule", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Schedule = "every day"
+			}, "spec.schedule"),
+			table.Entry("an invalid selector", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+					{Key: "backup", Operator: "Bogus"},
+				}
+			}, "spec.selector"),
+			table.Entry("an invalid deletionPolicy", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				policy := snapshotv1.DeletionPolicy("Keep")
+				s.Spec.DeletionPolicy = &policy
+			}, "spec.deletionPolicy"),
+			table.Entry("a zero last count", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{Last: &[]int32{0}[0]}
+			}, "spec.retention.last"),
+			table.Entry("a negative weekly count", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{Weekly: &[]int32{-1}[0]}
+			}, "spec.retention.weekly"),
+			table.Entry("a non positive expires", func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+				s.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{Expires: &metav1.Duration{}}
+			}, "spec.retention.expires"),
+		)
+	})
+})
+
+func createSnapshotScheduleAdmissionReview(operation v1beta1.Operation, schedule *snapshotv1.VirtualMachineSnapshotSchedule) *v1beta1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	return &v1beta1.AdmissionReview{
+		Request: &v1beta1.AdmissionRequest{
+			Operation: operation,
+			Namespace: "foo",
+			Resource: metav1.GroupVersionResource{
+				Group:    "snapshot.kubevirt.io",
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+}
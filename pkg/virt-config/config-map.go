@@ -61,6 +61,9 @@ const (
 	MemBalloonStatsPeriod             = "memBalloonStatsPeriod"
 	CPUAllocationRatio                = "cpu-allocation-ratio"
 	PermittedHostDevicesKey           = "permittedHostDevices"
+	ObsoleteCPUModelsKey              = "obsolete-cpu-models"
+	NetworkBindingKey                 = "networkBinding"
+	SecondaryNetworkDNSKey            = "secondaryNetworkDNS"
 )
 
 type ConfigModifiedFn func()
@@ -179,6 +182,7 @@ func defaultClusterConfig() *v1.KubeVirtConfiguration {
 		Product:      SmbiosConfigDefaultProduct,
 	}
 	supportedQEMUGuestAgentVersions := strings.Split(strings.TrimRight(SupportedGuestAgentVersions, ","), ",")
+	obsoleteCPUModelsDefault := cpuModelsToMap(DefaultObsoleteCPUModels)
 
 	return &v1.KubeVirtConfiguration{
 		ImagePullPolicy: DefaultImagePullPolicy,
@@ -220,6 +224,7 @@ func defaultClusterConfig() *v1.KubeVirtConfiguration {
 		SupportedGuestAgentVersions: supportedQEMUGuestAgentVersions,
 		OVMFPath:                    DefaultOVMFPath,
 		MemBalloonStatsPeriod:       &defaultMemBalloonStatsPeriod,
+		ObsoleteCPUModels:           obsoleteCPUModelsDefault,
 	}
 }
 
@@ -402,6 +407,28 @@ func setConfigFromConfigMap(config *v1.KubeVirtConfiguration, configMap *k8sv1.C
 		return fmt.Errorf("invalid value for permitBridgeInterfaceOnPodNetwork in config: %v", permitBridge)
 	}
 
+	// set network binding plugins
+	rawConfig = strings.TrimSpace(configMap.Data[NetworkBindingKey])
+	if rawConfig != "" {
+		bindings := map[string]v1.InterfaceBindingPlugin{}
+		err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(rawConfig), 1024).Decode(&bindings)
+		if err != nil {
+			return fmt.Errorf("failed to parse network binding config: %v", err)
+		}
+		config.NetworkConfiguration.Binding = bindings
+	}
+
+	// set the DNS records of secondary networks
+	rawConfig = strings.TrimSpace(configMap.Data[SecondaryNetworkDNSKey])
+	if rawConfig != "" {
+		dns := &v1.SecondaryNetworkDNS{}
+		err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(rawConfig), 1024).Decode(dns)
+		if err != nil {
+			return fmt.Errorf("failed to parse secondary network DNS config: %v", err)
+		}
+		config.NetworkConfiguration.SecondaryNetworkDNS = dns
+	}
+
 	// set default network interface
 	iface := strings.TrimSpace(configMap.Data[NetworkInterfaceKey])
 	switch iface {
@@ -442,6 +469,10 @@ func setConfigFromConfigMap(config *v1.KubeVirtConfiguration, configMap *k8sv1.C
 		}
 	}
 
+	if obsoleteCPUModels := strings.TrimSpace(configMap.Data[ObsoleteCPUModelsKey]); obsoleteCPUModels != "" {
+		config.ObsoleteCPUModels = cpuModelsToMap(obsoleteCPUModels)
+	}
+
 	return nil
 }
 
@@ -578,3 +609,13 @@ func stringToStringArray(str string) []string {
 	}
 	return vals
 }
+
+func cpuModelsToMap(str string) map[string]bool {
+	models := make(map[string]bool)
+	for _, model := range stringToStringArray(str) {
+		if model != "" {
+			models[model] = true
+		}
+	}
+	return models
+}
@@ -329,6 +329,45 @@ var _ = Describe("ConfigMap", func() {
 		table.Entry("when unset, GetOVMFPath should return the default", "", virtconfig.DefaultOVMFPath),
 	)
 
+	table.DescribeTable(" when obsoleteCPUModels", func(value string, result []string) {
+		clusterConfig, _, _, _ := testutils.NewFakeClusterConfig(&kubev1.ConfigMap{
+			Data: map[string]string{virtconfig.ObsoleteCPUModelsKey: value},
+		})
+		obsoleteCPUModels := clusterConfig.GetObsoleteCPUModels()
+		Expect(obsoleteCPUModels).To(HaveLen(len(result)))
+		for _, model := range result {
+			Expect(obsoleteCPUModels).To(HaveKeyWithValue(model, true))
+		}
+	},
+		table.Entry("when set, GetObsoleteCPUModels should return the value", "Penryn, Nehalem", []string{"Penryn", "Nehalem"}),
+		table.Entry("when unset, GetObsoleteCPUModels should return the defaults", "", strings.Split(virtconfig.DefaultObsoleteCPUModels, ",")),
+	)
+
+	It("should let the KubeVirt CR add and remove obsolete CPU models", func() {
+		clusterConfig, _, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				ResourceVersion: rand.String(10),
+				Name:            "kubevirt",
+				Namespace:       "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					ObsoleteCPUModels: map[string]bool{
+						"qemu64": false,
+						"Penryn": true,
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		})
+		obsoleteCPUModels := clusterConfig.GetObsoleteCPUModels()
+		Expect(obsoleteCPUModels).ToNot(HaveKey("qemu64"))
+		Expect(obsoleteCPUModels).To(HaveKeyWithValue("Penryn", true))
+		Expect(obsoleteCPUModels).To(HaveKeyWithValue("Conroe", true))
+	})
+
 	It("verifies that SetConfigModifiedCallback works as expected ", func() {
 		var callbackSet1, callbackSet2 bool
 		callback1 := func() {
@@ -371,6 +410,28 @@ var _ = Describe("ConfigMap", func() {
 		}).Should(BeTrue())
 	})
 
+	It("Should get the network binding plugins from the config map", func() {
+		clusterConfig, _, _, _ := testutils.NewFakeClusterConfig(&kubev1.ConfigMap{
+			Data: map[string]string{virtconfig.NetworkBindingKey: `{"mybinding":{"sidecarImage":"registry:5000/binding:latest","networkAttachmentDefinition":"default/binding-nad"}}`},
+		})
+		Expect(clusterConfig.GetNetworkBindings()).To(Equal(map[string]v1.InterfaceBindingPlugin{
+			"mybinding": {
+				SidecarImage:                "registry:5000/binding:latest",
+				NetworkAttachmentDefinition: "default/binding-nad",
+			},
+		}))
+	})
+
+	table.DescribeTable("Should get the name pattern of the secondary network DNS records", func(value string, expected string) {
+		clusterConfig, _, _, _ := testutils.NewFakeClusterConfig(&kubev1.ConfigMap{
+			Data: map[string]string{virtconfig.SecondaryNetworkDNSKey: value},
+		})
+		Expect(clusterConfig.GetSecondaryNetworkDNSNamePattern()).To(Equal(expected))
+	},
+		table.Entry("when it is not set", "", virtconfig.DefaultSecondaryNetworkDNSNamePattern),
+		table.Entry("when it is set", `{"namePattern":"{vmi}.{network}.vms.example"}`, "{vmi}.{network}.vms.example"),
+	)
+
 	It("Should still get GetPermittedHostDevices after invalid update", func() {
 		expectedDevices := `{"pciHostDevices":[{"pciVendorSelector":"10DE:1EB8","resourceName":"nvidia.com/TU104GL_Tesla_T4"}],"mediatedDevices":[{"mdevNameSelector":"GRID T4-1Q","resourceName":"nvidia.com/GRID_T4-1Q"}]}`
 		invalidPermittedHostDevicesConfig := "something wrong"
@@ -28,17 +28,26 @@ const (
 	IgnitionGate      = "ExperimentalIgnitionSupport"
 	LiveMigrationGate = "LiveMigration"
 	// SRIOVLiveMigrationGate enable's Live Migration for VM's with SRIOV interfaces.
-	SRIOVLiveMigrationGate = "SRIOVLiveMigration"
-	CPUNodeDiscoveryGate   = "CPUNodeDiscovery"
-	HypervStrictCheckGate  = "HypervStrictCheck"
-	SidecarGate            = "Sidecar"
-	GPUGate                = "GPU"
-	HostDevicesGate        = "HostDevices"
-	SnapshotGate           = "Snapshot"
-	HotplugVolumesGate     = "HotplugVolumes"
-	HostDiskGate           = "HostDisk"
-	VirtIOFSGate           = "ExperimentalVirtiofsSupport"
-	MacvtapGate            = "Macvtap"
+	SRIOVLiveMigrationGate    = "SRIOVLiveMigration"
+	CPUNodeDiscoveryGate      = "CPUNodeDiscovery"
+	HypervStrictCheckGate     = "HypervStrictCheck"
+	SidecarGate               = "Sidecar"
+	GPUGate                   = "GPU"
+	HostDevicesGate           = "HostDevices"
+	SnapshotGate              = "Snapshot"
+	HotplugVolumesGate        = "HotplugVolumes"
+	HotplugNICsGate           = "HotplugNICs"
+	HostDiskGate              = "HostDisk"
+	VirtIOFSGate              = "ExperimentalVirtiofsSupport"
+	MacvtapGate               = "Macvtap"
+	VMExportGate              = "VMExport"
+	NetworkBindingPluginsGate = "NetworkBindingPlugins"
+	SecondaryNetworkDNSGate   = "SecondaryNetworkDNS"
+	VolumeMigrationGate       = "VolumeMigration"
+	IncrementalBackupGate     = "IncrementalBackup"
+	// VirtualMachineNetworkPolicyGate enables the VirtualMachineNetworkPolicies, virt-handler
+	// only watches them and gets the permission to do so while it is enabled.
+	VirtualMachineNetworkPolicyGate = "VirtualMachineNetworkPolicy"
 )
 
 func (c *ClusterConfig) isFeatureGateEnabled(featureGate string) bool {
@@ -91,6 +100,10 @@ func (config *ClusterConfig) HotplugVolumesEnabled() bool {
 	return config.isFeatureGateEnabled(HotplugVolumesGate)
 }
 
+func (config *ClusterConfig) HotplugNICsEnabled() bool {
+	return config.isFeatureGateEnabled(HotplugNICsGate)
+}
+
 func (config *ClusterConfig) HostDiskEnabled() bool {
 	return config.isFeatureGateEnabled(HostDiskGate)
 }
@@ -106,3 +119,27 @@ func (config *ClusterConfig) MacvtapEnabled() bool {
 func (config *ClusterConfig) HostDevicesPassthroughEnabled() bool {
 	return config.isFeatureGateEnabled(HostDevicesGate)
 }
+
+func (config *ClusterConfig) VMExportEnabled() bool {
+	return config.isFeatureGateEnabled(VMExportGate)
+}
+
+func (config *ClusterConfig) NetworkBindingPluginsEnabled() bool {
+	return config.isFeatureGateEnabled(NetworkBindingPluginsGate)
+}
+
+func (config *ClusterConfig) SecondaryNetworkDNSEnabled() bool {
+	return config.isFeatureGateEnabled(SecondaryNetworkDNSGate)
+}
+
+func (config *ClusterConfig) VolumeMigrationEnabled() bool {
+	return config.isFeatureGateEnabled(VolumeMigrationGate)
+}
+
+func (config *ClusterConfig) IncrementalBackupEnabled() bool {
+	return config.isFeatureGateEnabled(IncrementalBackupGate)
+}
+
+func (config *ClusterConfig) VirtualMachineNetworkPolicyEnabled() bool {
+	return config.isFeatureGateEnabled(VirtualMachineNetworkPolicyGate)
+}
@@ -57,6 +57,7 @@ const (
 	SmbiosConfigDefaultManufacturer                 = "KubeVirt"
 	SmbiosConfigDefaultProduct                      = "None"
 	DefaultPermitBridgeInterfaceOnPodNetwork        = true
+	DefaultSecondaryNetworkDNSNamePattern           = "{interface}.{vmi}.{namespace}.{network}"
 	DefaultSELinuxLauncherType                      = "virt_launcher.process"
 	SupportedGuestAgentVersions                     = "2.*,3.*,4.*"
 	DefaultOVMFPath                                 = "/usr/share/OVMF"
@@ -67,6 +68,7 @@ const (
 	DefaultVirtHandlerLogVerbosity                  = 2
 	DefaultVirtLauncherLogVerbosity                 = 2
 	DefaultVirtOperatorLogVerbosity                 = 2
+	DefaultObsoleteCPUModels                        = "486,pentium,pentium2,pentium3,pentiumpro,coreduo,n270,core2duo,Conroe,athlon,phenom,qemu64,qemu32,kvm64,kvm32"
 )
 
 // Set default machine type and supported emulated machines based on architecture
@@ -137,6 +139,20 @@ func (c *ClusterConfig) IsSlirpInterfaceEnabled() bool {
 	return *c.GetConfig().NetworkConfiguration.PermitSlirpInterface
 }
 
+// GetNetworkBindings returns the network binding plugins registered in the configuration
+func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugin {
+	return c.GetConfig().NetworkConfiguration.Binding
+}
+
+// GetSecondaryNetworkDNSNamePattern returns the name pattern of the DNS records of secondary networks
+func (c *ClusterConfig) GetSecondaryNetworkDNSNamePattern() string {
+	dns := c.GetConfig().NetworkConfiguration.SecondaryNetworkDNS
+	if dns == nil || dns.NamePattern == "" {
+		return DefaultSecondaryNetworkDNSNamePattern
+	}
+	return dns.NamePattern
+}
+
 func (c *ClusterConfig) GetSMBIOS() *v1.SMBiosConfiguration {
 	return c.GetConfig().SMBIOSConfig
 }
@@ -169,6 +185,17 @@ func (c *ClusterConfig) GetPermittedHostDevices() *v1.PermittedHostDevices {
 	return c.GetConfig().PermittedHostDevices
 }
 
+// GetObsoleteCPUModels returns the CPU models which are treated as obsolete
+func (c *ClusterConfig) GetObsoleteCPUModels() map[string]bool {
+	obsoleteCPUModels := make(map[string]bool)
+	for model, obsolete := range c.GetConfig().ObsoleteCPUModels {
+		if obsolete {
+			obsoleteCPUModels[model] = true
+		}
+	}
+	return obsoleteCPUModels
+}
+
 func (c *ClusterConfig) GetVirtHandlerVerbosity(nodeName string) uint {
 	logConf := c.GetConfig().DeveloperConfiguration.LogVerbosity
 	if level := logConf.NodeVerbosity[nodeName]; level != 0 {
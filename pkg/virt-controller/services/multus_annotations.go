@@ -26,11 +26,15 @@ import (
 	v1 "kubevirt.io/client-go/api/v1"
 )
 
+// MultusNetworkStatusAnnotation is set by multus on the pod and reports the networks attached to it
+const MultusNetworkStatusAnnotation = "k8s.v1.cni.cncf.io/network-status"
+
 type multusNetworkAnnotation struct {
-	InterfaceName string `json:"interface"`
-	Mac           string `json:"mac,omitempty"`
-	NetworkName   string `json:"name"`
-	Namespace     string `json:"namespace"`
+	InterfaceName string                  `json:"interface,omitempty"`
+	Mac           string                  `json:"mac,omitempty"`
+	NetworkName   string                  `json:"name"`
+	Namespace     string                  `json:"namespace"`
+	CNIArgs       *map[string]interface{} `json:"cni-args,omitempty"`
 }
 
 type multusNetworkAnnotationPool struct {
@@ -53,7 +57,94 @@ func (mnap multusNetworkAnnotationPool) toString() (string, error) {
 	return string(multusNetworksAnnotation), nil
 }
 
-func generateMultusCNIAnnotation(vmi *v1.VirtualMachineInstance) (string, error) {
+type multusNetworkStatus struct {
+	Name      string `json:"name"`
+	Interface string `json:"interface,omitempty"`
+}
+
+func newMultusNetworkAnnotationPool(networksAnnotation string) (multusNetworkAnnotationPool, error) {
+	mnap := multusNetworkAnnotationPool{}
+	if networksAnnotation == "" {
+		return mnap, nil
+	}
+	if err := json.Unmarshal([]byte(networksAnnotation), &mnap.pool); err != nil {
+		return mnap, fmt.Errorf("failed to parse multus networks annotation %s: %v", networksAnnotation, err)
+	}
+	return mnap, nil
+}
+
+// MultusAnnotationPodInterfaceNames returns the names of the pod interfaces requested by
+// the multus networks annotation of the virt-launcher pod.
+func MultusAnnotationPodInterfaceNames(networksAnnotation string) (map[string]bool, error) {
+	mnap, err := newMultusNetworkAnnotationPool(networksAnnotation)
+	if err != nil {
+		return nil, err
+	}
+	podInterfaceNames := map[string]bool{}
+	for _, network := range mnap.pool {
+		// networks of binding plugins are not backing an interface of the VMI
+		if network.InterfaceName != "" {
+			podInterfaceNames[network.InterfaceName] = true
+		}
+	}
+	return podInterfaceNames, nil
+}
+
+// MultusNetworkStatusPodInterfaceNames returns the names of the pod interfaces multus
+// reported as attached to the virt-launcher pod.
+func MultusNetworkStatusPodInterfaceNames(networkStatusAnnotation string) (map[string]bool, error) {
+	podInterfaceNames := map[string]bool{}
+	if networkStatusAnnotation == "" {
+		return podInterfaceNames, nil
+	}
+	var networkStatuses []multusNetworkStatus
+	if err := json.Unmarshal([]byte(networkStatusAnnotation), &networkStatuses); err != nil {
+		return nil, fmt.Errorf("failed to parse multus network-status annotation %s: %v", networkStatusAnnotation, err)
+	}
+	for _, status := range networkStatuses {
+		if status.Interface != "" {
+			podInterfaceNames[status.Interface] = true
+		}
+	}
+	return podInterfaceNames, nil
+}
+
+// AddMultusNetworkToAnnotation returns the multus networks annotation extended with the
+// network of a hotplugged interface, attached to the pod as podInterfaceName.
+func AddMultusNetworkToAnnotation(vmi *v1.VirtualMachineInstance, networksAnnotation string, network v1.Network, podInterfaceName string) (string, error) {
+	mnap, err := newMultusNetworkAnnotationPool(networksAnnotation)
+	if err != nil {
+		return "", err
+	}
+	for _, existing := range mnap.pool {
+		if existing.InterfaceName == podInterfaceName {
+			return networksAnnotation, nil
+		}
+	}
+	mnap.add(newMultusAnnotationData(vmi, network, podInterfaceName))
+	return mnap.toString()
+}
+
+// RemoveMultusNetworkFromAnnotation returns the multus networks annotation without the
+// network attached to the pod as podInterfaceName.
+func RemoveMultusNetworkFromAnnotation(networksAnnotation string, podInterfaceName string) (string, error) {
+	mnap, err := newMultusNetworkAnnotationPool(networksAnnotation)
+	if err != nil {
+		return "", err
+	}
+	newPool := multusNetworkAnnotationPool{pool: []multusNetworkAnnotation{}}
+	for _, existing := range mnap.pool {
+		if existing.InterfaceName != podInterfaceName {
+			newPool.add(existing)
+		}
+	}
+	if len(newPool.pool) == len(mnap.pool) {
+		return networksAnnotation, nil
+	}
+	return newPool.toString()
+}
+
+func generateMultusCNIAnnotation(vmi *v1.VirtualMachineInstance, bindings map[string]v1.InterfaceBindingPlugin) (string, error) {
 	multusNetworkAnnotationPool := multusNetworkAnnotationPool{}
 
 	multusNonDefaultNetworks := filterMultusNonDefaultNetworks(vmi.Spec.Networks)
@@ -62,6 +153,15 @@ func generateMultusCNIAnnotation(vmi *v1.VirtualMachineInstance) (string, error)
 			newMultusAnnotationData(vmi, network, fmt.Sprintf("net%d", i+1)))
 	}
 
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Binding == nil {
+			continue
+		}
+		if bindingAnnotation := newBindingPluginMultusAnnotationData(vmi, bindings, iface); bindingAnnotation != nil {
+			multusNetworkAnnotationPool.add(*bindingAnnotation)
+		}
+	}
+
 	if !multusNetworkAnnotationPool.isEmpty() {
 		return multusNetworkAnnotationPool.toString()
 	}
@@ -96,6 +196,22 @@ func newMultusAnnotationData(vmi *v1.VirtualMachineInstance, network v1.Network,
 	}
 }
 
+// newBindingPluginMultusAnnotationData returns the network which sets up the pod network for the
+// binding plugin of the interface, or nil if the plugin does not define one. Multus names the pod
+// interface of the network, the CNI plugin learns the network it serves from the CNI arguments.
+func newBindingPluginMultusAnnotationData(vmi *v1.VirtualMachineInstance, bindings map[string]v1.InterfaceBindingPlugin, iface v1.Interface) *multusNetworkAnnotation {
+	plugin, exists := bindings[iface.Binding.Name]
+	if !exists || plugin.NetworkAttachmentDefinition == "" {
+		return nil
+	}
+	namespace, networkName := getNamespaceAndNetworkName(vmi, plugin.NetworkAttachmentDefinition)
+	return &multusNetworkAnnotation{
+		NetworkName: networkName,
+		Namespace:   namespace,
+		CNIArgs:     &map[string]interface{}{"logicNetworkName": iface.Name},
+	}
+}
+
 func getIfaceByName(vmi *v1.VirtualMachineInstance, name string) *v1.Interface {
 	for i, iface := range vmi.Spec.Domain.Devices.Interfaces {
 		if iface.Name == name {
@@ -84,4 +84,74 @@ var _ = Describe("Multus annotations", func() {
 			Expect(multusAnnotationPool.toString()).To(BeIdenticalTo(expectedString))
 		})
 	})
+
+	Context("hotplugged interfaces", func() {
+		const networksAnnotation = `[{"interface":"net1","name":"test1","namespace":"namespace1"}]`
+
+		It("adds the network of the interface to the annotation", func() {
+			network.Name = "blue"
+			network.Multus.NetworkName = "blue-nad"
+			annotation, err := AddMultusNetworkToAnnotation(&vmi, networksAnnotation, network, "net2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(annotation).To(Equal(`[{"interface":"net1","name":"test1","namespace":"namespace1"},{"interface":"net2","name":"blue-nad","namespace":"namespace1"}]`))
+		})
+
+		It("adds the network to an empty annotation", func() {
+			annotation, err := AddMultusNetworkToAnnotation(&vmi, "", network, "net1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(annotation).To(Equal(networksAnnotation))
+		})
+
+		It("does not add a network which is already requested", func() {
+			annotation, err := AddMultusNetworkToAnnotation(&vmi, networksAnnotation, network, "net1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(annotation).To(Equal(networksAnnotation))
+		})
+
+		It("removes the network of the interface from the annotation", func() {
+			annotation, err := RemoveMultusNetworkFromAnnotation(networksAnnotation, "net1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(annotation).To(Equal("[]"))
+		})
+
+		It("fails on a malformed annotation", func() {
+			_, err := RemoveMultusNetworkFromAnnotation("net1,net2", "net1")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("lists the requested pod interfaces", func() {
+			Expect(MultusAnnotationPodInterfaceNames(networksAnnotation)).To(Equal(map[string]bool{"net1": true}))
+		})
+
+		It("lists the pod interfaces reported by multus", func() {
+			networkStatus := `[{"name":"k8s-pod-network","ips":["10.244.0.5"],"default":true},{"name":"namespace1/test1","interface":"net1","mac":"02:00:00:00:00:01"}]`
+			Expect(MultusNetworkStatusPodInterfaceNames(networkStatus)).To(Equal(map[string]bool{"net1": true}))
+		})
+	})
+
+	Context("binding plugins", func() {
+		BeforeEach(func() {
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "default", Binding: &v1.PluginBinding{Name: "mybinding"}},
+			}
+		})
+
+		It("adds the network of the binding plugin with the logical network name", func() {
+			bindings := map[string]v1.InterfaceBindingPlugin{
+				"mybinding": {NetworkAttachmentDefinition: "default/binding-nad"},
+			}
+			annotation, err := generateMultusCNIAnnotation(&vmi, bindings)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(annotation).To(Equal(`[{"name":"binding-nad","namespace":"default","cni-args":{"logicNetworkName":"default"}}]`))
+			Expect(MultusAnnotationPodInterfaceNames(annotation)).To(BeEmpty())
+		})
+
+		It("does not add a network for a binding plugin without one", func() {
+			bindings := map[string]v1.InterfaceBindingPlugin{
+				"mybinding": {SidecarImage: "binding:latest"},
+			}
+			Expect(generateMultusCNIAnnotation(&vmi, bindings)).To(BeEmpty())
+		})
+	})
 })
@@ -72,12 +72,6 @@ const CAP_SYS_RESOURCE = "SYS_RESOURCE"
 // Libvirt needs roughly 10 seconds to start.
 const LibvirtStartupDelay = 10
 
-//These perfixes for node feature discovery, are used in a NodeSelector on the pod
-//to match a VirtualMachineInstance CPU model(Family) and/or features to nodes that support them.
-const NFD_CPU_MODEL_PREFIX = "feature.node.kubernetes.io/cpu-model-"
-const NFD_CPU_FEATURE_PREFIX = "feature.node.kubernetes.io/cpu-feature-"
-const NFD_KVM_INFO_PREFIX = "feature.node.kubernetes.io/kvm-info-cap-hyperv-"
-
 const MULTUS_RESOURCE_NAME_ANNOTATION = "k8s.v1.cni.cncf.io/resourceName"
 const MULTUS_DEFAULT_NETWORK_CNI_ANNOTATION = "v1.multus-cni.io/default-network"
 
@@ -191,7 +185,7 @@ func getHypervNodeSelectors(vmi *v1.VirtualMachineInstance) map[string]string {
 	hvFeatureLabels := makeHVFeatureLabelTable(vmi)
 	for _, hv := range hvFeatureLabels {
 		if isFeatureStateEnabled(hv.Feature) {
-			nodeSelectors[NFD_KVM_INFO_PREFIX+hv.Label] = "true"
+			nodeSelectors[v1.HypervLabel+hv.Label] = "true"
 		}
 	}
 	return nodeSelectors
@@ -202,7 +196,7 @@ func CPUModelLabelFromCPUModel(vmi *v1.VirtualMachineInstance) (label string, er
 		err = fmt.Errorf("Cannot create CPU Model label, vmi spec is mising CPU model")
 		return
 	}
-	label = NFD_CPU_MODEL_PREFIX + vmi.Spec.Domain.CPU.Model
+	label = v1.CPUModelLabel + vmi.Spec.Domain.CPU.Model
 	return
 }
 
@@ -211,13 +205,19 @@ func CPUFeatureLabelsFromCPUFeatures(vmi *v1.VirtualMachineInstance) []string {
 	if vmi.Spec.Domain.CPU != nil && vmi.Spec.Domain.CPU.Features != nil {
 		for _, feature := range vmi.Spec.Domain.CPU.Features {
 			if feature.Policy == "" || feature.Policy == "require" {
-				labels = append(labels, NFD_CPU_FEATURE_PREFIX+feature.Name)
+				labels = append(labels, v1.CPUFeatureLabel+feature.Name)
 			}
 		}
 	}
 	return labels
 }
 
+// IsHostModelCPU returns true if the VMI uses the host-model CPU of the node,
+// either explicitly or because it does not request any CPU model
+func IsHostModelCPU(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.CPU == nil || vmi.Spec.Domain.CPU.Model == "" || vmi.Spec.Domain.CPU.Model == v1.CPUModeHostModel
+}
+
 func SetNodeAffinityForForbiddenFeaturePolicy(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
 
 	if vmi.Spec.Domain.CPU == nil || vmi.Spec.Domain.CPU.Features == nil {
@@ -226,44 +226,58 @@ func SetNodeAffinityForForbiddenFeaturePolicy(vmi *v1.VirtualMachineInstance, po
 
 	for _, feature := range vmi.Spec.Domain.CPU.Features {
 		if feature.Policy == "forbid" {
-
-			requirement := k8sv1.NodeSelectorRequirement{
-				Key:      NFD_CPU_FEATURE_PREFIX + feature.Name,
+			addNodeAffinityRequirement(pod, k8sv1.NodeSelectorRequirement{
+				Key:      v1.CPUFeatureLabel + feature.Name,
 				Operator: k8sv1.NodeSelectorOpDoesNotExist,
-			}
-			term := k8sv1.NodeSelectorTerm{
-				MatchExpressions: []k8sv1.NodeSelectorRequirement{requirement}}
+			})
+		}
+	}
+}
 
-			nodeAffinity := &k8sv1.NodeAffinity{
-				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
-					NodeSelectorTerms: []k8sv1.NodeSelectorTerm{term},
-				},
-			}
+// SetNodeAffinityForHostModelCPU keeps host-model VMIs away from nodes whose
+// host-model CPU is obsolete
+func SetNodeAffinityForHostModelCPU(vmi *v1.VirtualMachineInstance, pod *k8sv1.Pod) {
+	if !IsHostModelCPU(vmi) {
+		return
+	}
+	addNodeAffinityRequirement(pod, k8sv1.NodeSelectorRequirement{
+		Key:      v1.NodeHostModelIsObsoleteLabel,
+		Operator: k8sv1.NodeSelectorOpDoesNotExist,
+	})
+}
 
-			if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil {
-				if pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
-					terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
-					// Since NodeSelectorTerms are ORed , the anti affinity requirement will be added to each term.
-					for i, selectorTerm := range terms {
-						pod.Spec.Affinity.NodeAffinity.
-							RequiredDuringSchedulingIgnoredDuringExecution.
-							NodeSelectorTerms[i].MatchExpressions = append(selectorTerm.MatchExpressions, requirement)
-					}
-				} else {
-					pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8sv1.NodeSelector{
-						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{term},
-					}
-				}
+func addNodeAffinityRequirement(pod *k8sv1.Pod, requirement k8sv1.NodeSelectorRequirement) {
+	term := k8sv1.NodeSelectorTerm{
+		MatchExpressions: []k8sv1.NodeSelectorRequirement{requirement}}
 
-			} else if pod.Spec.Affinity != nil {
-				pod.Spec.Affinity.NodeAffinity = nodeAffinity
-			} else {
-				pod.Spec.Affinity = &k8sv1.Affinity{
-					NodeAffinity: nodeAffinity,
-				}
+	nodeAffinity := &k8sv1.NodeAffinity{
+		RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+			NodeSelectorTerms: []k8sv1.NodeSelectorTerm{term},
+		},
+	}
 
+	if pod.Spec.Affinity != nil && pod.Spec.Affinity.NodeAffinity != nil {
+		if pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+			terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+			// Since NodeSelectorTerms are ORed , the anti affinity requirement will be added to each term.
+			for i, selectorTerm := range terms {
+				pod.Spec.Affinity.NodeAffinity.
+					RequiredDuringSchedulingIgnoredDuringExecution.
+					NodeSelectorTerms[i].MatchExpressions = append(selectorTerm.MatchExpressions, requirement)
+			}
+		} else {
+			pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &k8sv1.NodeSelector{
+				NodeSelectorTerms: []k8sv1.NodeSelectorTerm{term},
 			}
 		}
+
+	} else if pod.Spec.Affinity != nil {
+		pod.Spec.Affinity.NodeAffinity = nodeAffinity
+	} else {
+		pod.Spec.Affinity = &k8sv1.Affinity{
+			NodeAffinity: nodeAffinity,
+		}
+
 	}
 }
 
@@ -837,6 +851,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 	if err != nil {
 		return nil, err
 	}
+	requestedHookSidecarList = append(requestedHookSidecarList, t.bindingPluginSidecars(vmi)...)
 
 	if len(requestedHookSidecarList) != 0 {
 		volumes = append(volumes, k8sv1.Volume{
@@ -966,7 +981,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 			Privileged: &privileged,
 			Capabilities: &k8sv1.Capabilities{
 				Add:  capabilities,
-				Drop: []k8sv1.Capability{CAP_NET_RAW},
+				Drop: getDroppedCapabilities(vmi),
 			},
 		},
 		Command:       command,
@@ -1063,6 +1078,9 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 		for _, cpuFeatureLable := range CPUFeatureLabelsFromCPUFeatures(vmi) {
 			nodeSelector[cpuFeatureLable] = "true"
 		}
+		if vmi.Spec.Domain.Machine.Type != "" {
+			nodeSelector[v1.SupportedMachineTypeLabel+vmi.Spec.Domain.Machine.Type] = "true"
+		}
 	}
 
 	if t.clusterConfig.HypervStrictCheckEnabled() {
@@ -1114,7 +1132,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 
 	hostName := dns.SanitizeHostname(vmi)
 
-	podAnnotations, err := generatePodAnnotations(vmi)
+	podAnnotations, err := generatePodAnnotations(vmi, t.clusterConfig.GetNetworkBindings())
 	if err != nil {
 		return nil, err
 	}
@@ -1213,6 +1231,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, t
 
 	if t.clusterConfig.CPUNodeDiscoveryEnabled() {
 		SetNodeAffinityForForbiddenFeaturePolicy(vmi, &pod)
+		SetNodeAffinityForHostModelCPU(vmi, &pod)
 	}
 
 	pod.Spec.Tolerations = vmi.Spec.Tolerations
@@ -1361,6 +1380,11 @@ func getRequiredCapabilities(vmi *v1.VirtualMachineInstance, config *virtconfig.
 		capabilities = append(capabilities, CAP_SYS_ADMIN)
 	}
 
+	// add CAP_NET_RAW capability to send IPv6 router advertisements
+	if util.IsRouterAdvertisementsVMI(vmi) {
+		capabilities = append(capabilities, CAP_NET_RAW)
+	}
+
 	// add SYS_RESOURCE capability to enable Live Migration for VM with SRIOV interfaces
 	// until https://bugzilla.redhat.com/show_bug.cgi?id=1916346 is resolved.
 	if config.SRIOVLiveMigrationEnabled() && util.IsSRIOVVmi(vmi) {
@@ -1370,6 +1394,14 @@ func getRequiredCapabilities(vmi *v1.VirtualMachineInstance, config *virtconfig.
 	return capabilities
 }
 
+func getDroppedCapabilities(vmi *v1.VirtualMachineInstance) []k8sv1.Capability {
+	// router advertisements are sent through a raw ICMPv6 socket
+	if util.IsRouterAdvertisementsVMI(vmi) {
+		return []k8sv1.Capability{}
+	}
+	return []k8sv1.Capability{CAP_NET_RAW}
+}
+
 func getRequiredResources(vmi *v1.VirtualMachineInstance, useEmulation bool) k8sv1.ResourceList {
 	res := k8sv1.ResourceList{}
 	if (len(vmi.Spec.Domain.Devices.Interfaces) > 0) ||
@@ -1479,6 +1511,20 @@ func getPortsFromVMI(vmi *v1.VirtualMachineInstance) []k8sv1.ContainerPort {
 				ports = append(ports, k8sv1.ContainerPort{Protocol: k8sv1.Protocol(port.Protocol), Name: port.Name, ContainerPort: port.Port})
 			}
 		}
+
+		// Port ranges can not be expressed as container ports, only single ports are exposed
+		if iface.Masquerade != nil {
+			for _, mapping := range iface.Masquerade.PortMappings {
+				if mapping.EndPort > mapping.Port {
+					continue
+				}
+				if mapping.Protocol == "" {
+					mapping.Protocol = "TCP"
+				}
+
+				ports = append(ports, k8sv1.ContainerPort{Protocol: k8sv1.Protocol(mapping.Protocol), Name: mapping.Name, ContainerPort: mapping.Port})
+			}
+		}
 	}
 
 	if len(ports) == 0 {
@@ -1610,7 +1656,28 @@ func generateContainerSecurityContext(selinuxType string) *k8sv1.SecurityContext
 	}
 }
 
-func generatePodAnnotations(vmi *v1.VirtualMachineInstance) (map[string]string, error) {
+// bindingPluginSidecars returns the sidecars of the binding plugins used by the interfaces of the VMI.
+// Each plugin sidecar is added once, it handles all the interfaces using the binding.
+func (t *templateService) bindingPluginSidecars(vmi *v1.VirtualMachineInstance) hooks.HookSidecarList {
+	var sidecars hooks.HookSidecarList
+	bindings := t.clusterConfig.GetNetworkBindings()
+	added := map[string]bool{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Binding == nil || added[iface.Binding.Name] {
+			continue
+		}
+		added[iface.Binding.Name] = true
+		if plugin, exists := bindings[iface.Binding.Name]; exists && plugin.SidecarImage != "" {
+			sidecars = append(sidecars, hooks.HookSidecar{
+				Image:           plugin.SidecarImage,
+				ImagePullPolicy: t.clusterConfig.GetImagePullPolicy(),
+			})
+		}
+	}
+	return sidecars
+}
+
+func generatePodAnnotations(vmi *v1.VirtualMachineInstance, bindings map[string]v1.InterfaceBindingPlugin) (map[string]string, error) {
 	annotationsSet := map[string]string{
 		v1.DomainAnnotation: vmi.GetObjectMeta().GetName(),
 	}
@@ -1618,7 +1685,7 @@ func generatePodAnnotations(vmi *v1.VirtualMachineInstance) (map[string]string,
 		annotationsSet[k] = v
 	}
 
-	multusAnnotation, err := generateMultusCNIAnnotation(vmi)
+	multusAnnotation, err := generateMultusCNIAnnotation(vmi, bindings)
 	if err != nil {
 		return nil, err
 	}
@@ -712,6 +712,47 @@ var _ = Describe("Template", func() {
 			})
 
 		})
+		Context("with network binding plugins", func() {
+			AfterEach(func() {
+				disableFeatureGates()
+			})
+
+			It("should add the sidecar and the network of the plugin", func() {
+				testutils.UpdateFakeClusterConfig(configMapInformer, &kubev1.ConfigMap{
+					Data: map[string]string{
+						virtconfig.FeatureGatesKey:   virtconfig.NetworkBindingPluginsGate,
+						virtconfig.NetworkBindingKey: `{"mybinding": {"sidecarImage": "binding:v1", "networkAttachmentDefinition": "binding-nad"}}`,
+					},
+				})
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								DisableHotplug: true,
+								Interfaces: []v1.Interface{
+									{Name: "default", Binding: &v1.PluginBinding{Name: "mybinding"}},
+								},
+							},
+						},
+						Networks: []v1.Network{*v1.DefaultPodNetwork()},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers).To(HaveLen(2))
+				Expect(pod.Spec.Containers[1].Name).To(Equal("hook-sidecar-0"))
+				Expect(pod.Spec.Containers[1].Image).To(Equal("binding:v1"))
+				Expect(pod.Annotations).To(HaveKeyWithValue("k8s.v1.cni.cncf.io/networks",
+					`[{"name":"binding-nad","namespace":"default","cni-args":{"logicNetworkName":"default"}}]`))
+			})
+		})
+
 		Context("with multus annotation", func() {
 			It("should add multus networks in the pod annotation", func() {
 				vmi := v1.VirtualMachineInstance{
@@ -967,6 +1008,72 @@ var _ = Describe("Template", func() {
 				}
 			})
 
+			It("should add node selector for the machine type with node discovery feature", func() {
+				enableFeatureGate(virtconfig.CPUNodeDiscoveryGate)
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								DisableHotplug: true,
+							},
+							Machine: v1.Machine{
+								Type: "pc-q35-rhel8.3.0",
+							},
+						},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.SupportedMachineTypeLabel+"pc-q35-rhel8.3.0", "true"))
+			})
+
+			table.DescribeTable("should keep host-model VMIs away from nodes with an obsolete host-model CPU", func(cpu *v1.CPU, expectAffinity bool) {
+				enableFeatureGate(virtconfig.CPUNodeDiscoveryGate)
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								DisableHotplug: true,
+							},
+							CPU: cpu,
+						},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				requirement := kubev1.NodeSelectorRequirement{
+					Key:      v1.NodeHostModelIsObsoleteLabel,
+					Operator: kubev1.NodeSelectorOpDoesNotExist,
+				}
+				if !expectAffinity {
+					Expect(pod.Spec.Affinity).To(BeNil())
+					return
+				}
+				Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(HaveLen(1))
+				Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions).To(ContainElement(requirement))
+			},
+				table.Entry("without a CPU", nil, true),
+				table.Entry("without a CPU model", &v1.CPU{Cores: 2}, true),
+				table.Entry("with host-model", &v1.CPU{Model: v1.CPUModeHostModel}, true),
+				table.Entry("with host-passthrough", &v1.CPU{Model: v1.CPUModeHostPassthrough}, false),
+				table.Entry("with a named CPU model", &v1.CPU{Model: "Conroe"}, false),
+			)
+
 			It("should add node selectors from kubevirt-config configMap", func() {
 				testutils.UpdateFakeClusterConfig(configMapInformer, &kubev1.ConfigMap{
 					Data: map[string]string{virtconfig.NodeSelectorsKey: "kubernetes.io/hostname=node02\nnode-role.kubernetes.io/compute=true\n"},
@@ -1011,7 +1118,7 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 
-				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(NFD_KVM_INFO_PREFIX))))
+				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(v1.HypervLabel))))
 			})
 
 			It("should not add node selector for hyperv nodes if VMI requests hyperv features, but feature gate is disabled", func() {
@@ -1044,7 +1151,7 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 
-				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(NFD_KVM_INFO_PREFIX))))
+				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(v1.HypervLabel))))
 			})
 
 			It("should add node selector for hyperv nodes if VMI requests hyperv features which depend on host kernel", func() {
@@ -1085,10 +1192,10 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 
-				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(NFD_KVM_INFO_PREFIX+"synic", "true"))
-				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(NFD_KVM_INFO_PREFIX+"synictimer", "true"))
-				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(NFD_KVM_INFO_PREFIX+"frequencies", "true"))
-				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(NFD_KVM_INFO_PREFIX+"ipi", "true"))
+				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"synic", "true"))
+				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"synictimer", "true"))
+				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"frequencies", "true"))
+				Expect(pod.Spec.NodeSelector).Should(HaveKeyWithValue(v1.HypervLabel+"ipi", "true"))
 			})
 
 			It("should not add node selector for hyperv nodes if VMI requests hyperv features which do not depend on host kernel", func() {
@@ -1128,7 +1235,7 @@ var _ = Describe("Template", func() {
 				pod, err := svc.RenderLaunchManifest(&vmi)
 				Expect(err).ToNot(HaveOccurred())
 
-				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(NFD_KVM_INFO_PREFIX))))
+				Expect(pod.Spec.NodeSelector).To(Not(HaveKey(ContainSubstring(v1.HypervLabel))))
 			})
 
 			It("should add default cpu/memory resources to the sidecar container if cpu pinning was requested", func() {
@@ -2121,6 +2228,37 @@ var _ = Describe("Template", func() {
 				Expect(pod.Spec.Containers[0].Ports[1].ContainerPort).To(Equal(int32(80)))
 				Expect(pod.Spec.Containers[0].Ports[1].Protocol).To(Equal(kubev1.Protocol("TCP")))
 			})
+			It("Should add the single port mappings of a masquerade interface", func() {
+				domain := v1.DomainSpec{
+					Devices: v1.Devices{
+						DisableHotplug: true,
+					},
+				}
+				domain.Devices.Interfaces = []v1.Interface{
+					{Name: "testnet",
+						InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{
+							PortMappings: []v1.MasqueradePortMapping{
+								{Name: "ssh", Port: 2222, GuestPort: 22},
+								{Name: "media", Protocol: "UDP", Port: 5000, EndPort: 5010},
+								{Name: "dns", Protocol: "UDP", Port: 53},
+							},
+						}}}}
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Domain: domain},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Containers[0].Ports).To(Equal([]kubev1.ContainerPort{
+					{Name: "ssh", ContainerPort: 2222, Protocol: kubev1.ProtocolTCP},
+					{Name: "dns", ContainerPort: 53, Protocol: kubev1.ProtocolUDP},
+				}))
+			})
 		})
 
 		Context("with pod networking", func() {
@@ -2191,6 +2329,29 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with IPv6 router advertisements", func() {
+			It("Should grant the NET_RAW capability", func() {
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{}},
+				}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:                   "default",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+					DHCPOptions:            &v1.DHCPOptions{RouterAdvertisements: true},
+				}}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				caps := pod.Spec.Containers[0].SecurityContext.Capabilities
+
+				Expect(caps.Add).To(ContainElement(kubev1.Capability(CAP_NET_RAW)), "Expected compute container to be granted NET_RAW capability")
+				Expect(caps.Drop).ToNot(ContainElement(kubev1.Capability(CAP_NET_RAW)), "Expected compute container not to drop NET_RAW capability")
+			})
+		})
+
 		Context("with a configMap volume source", func() {
 			It("Should add the ConfigMap to template", func() {
 				volumes := []v1.Volume{
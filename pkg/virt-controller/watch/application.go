@@ -43,6 +43,10 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/healthz"
 
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -50,14 +54,19 @@ import (
 	"kubevirt.io/kubevirt/pkg/certificates/bootstrap"
 	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/backup"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/export"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/snapshot"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 )
@@ -136,18 +145,42 @@ type VirtControllerApp struct {
 
 	dataVolumeInformer cache.SharedIndexInformer
 
-	migrationController *MigrationController
-	migrationInformer   cache.SharedIndexInformer
+	migrationController     *MigrationController
+	migrationInformer       cache.SharedIndexInformer
+	migrationPolicyInformer cache.SharedIndexInformer
+	namespaceInformer       cache.SharedIndexInformer
+
+	instancetypeInformer        cache.SharedIndexInformer
+	clusterInstancetypeInformer cache.SharedIndexInformer
+	preferenceInformer          cache.SharedIndexInformer
+	clusterPreferenceInformer   cache.SharedIndexInformer
+
+	poolController *pool.PoolController
+	poolInformer   cache.SharedIndexInformer
 
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
-	snapshotController        *snapshot.VMSnapshotController
-	restoreController         *snapshot.VMRestoreController
-	vmSnapshotInformer        cache.SharedIndexInformer
-	vmSnapshotContentInformer cache.SharedIndexInformer
-	vmRestoreInformer         cache.SharedIndexInformer
-	storageClassInformer      cache.SharedIndexInformer
-	allPodInformer            cache.SharedIndexInformer
+	secondaryNetworkDNSController *SecondaryNetworkDNSController
+
+	vmNetworkPolicyController *VMNetworkPolicyController
+	vmNetworkPolicyInformer   cache.SharedIndexInformer
+
+	snapshotController         *snapshot.VMSnapshotController
+	restoreController          *snapshot.VMRestoreController
+	vmSnapshotInformer         cache.SharedIndexInformer
+	vmSnapshotContentInformer  cache.SharedIndexInformer
+	vmRestoreInformer          cache.SharedIndexInformer
+	vmRestoreGrantInformer     cache.SharedIndexInformer
+	vmSnapshotScheduleInformer cache.SharedIndexInformer
+	cloneController            *clone.VMCloneController
+	vmCloneInformer            cache.SharedIndexInformer
+	exportController           *export.VMExportController
+	vmExportInformer           cache.SharedIndexInformer
+	backupController           *backup.VMBackupController
+	vmBackupInformer           cache.SharedIndexInformer
+	vmBackupRestoreInformer    cache.SharedIndexInformer
+	storageClassInformer       cache.SharedIndexInformer
+	allPodInformer             cache.SharedIndexInformer
 
 	crdInformer cache.SharedIndexInformer
 
@@ -184,6 +217,12 @@ type VirtControllerApp struct {
 	launcherSubGid                    int64
 	snapshotControllerThreads         int
 	restoreControllerThreads          int
+	poolControllerThreads             int
+	cloneControllerThreads            int
+	exportControllerThreads           int
+	backupControllerThreads           int
+	secondaryNetworkDNSThreads        int
+	vmNetworkPolicyThreads            int
 	snapshotControllerResyncPeriod    time.Duration
 
 	caConfigMapName  string
@@ -196,6 +235,10 @@ var _ service.Service = &VirtControllerApp{}
 func init() {
 	vsv1beta1.AddToScheme(scheme.Scheme)
 	snapshotv1.AddToScheme(scheme.Scheme)
+	poolv1.AddToScheme(scheme.Scheme)
+	clonev1.AddToScheme(scheme.Scheme)
+	exportv1.AddToScheme(scheme.Scheme)
+	backupv1.AddToScheme(scheme.Scheme)
 
 	prometheus.MustRegister(leaderGauge)
 	prometheus.MustRegister(readyGauge)
@@ -275,10 +318,25 @@ func Execute() {
 	app.vmInformer = app.informerFactory.VirtualMachine()
 
 	app.migrationInformer = app.informerFactory.VirtualMachineInstanceMigration()
+	app.migrationPolicyInformer = app.informerFactory.MigrationPolicy()
+
+	app.instancetypeInformer = app.informerFactory.VirtualMachineInstancetype()
+	app.clusterInstancetypeInformer = app.informerFactory.VirtualMachineClusterInstancetype()
+	app.preferenceInformer = app.informerFactory.VirtualMachinePreference()
+	app.clusterPreferenceInformer = app.informerFactory.VirtualMachineClusterPreference()
+	app.namespaceInformer = app.informerFactory.Namespace()
 
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmRestoreGrantInformer = app.informerFactory.VirtualMachineRestoreGrant()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.poolInformer = app.informerFactory.VirtualMachinePool()
+	app.vmCloneInformer = app.informerFactory.VirtualMachineClone()
+	app.vmExportInformer = app.informerFactory.VirtualMachineExport()
+	app.vmBackupInformer = app.informerFactory.VirtualMachineBackup()
+	app.vmBackupRestoreInformer = app.informerFactory.VirtualMachineBackupRestore()
+	app.vmNetworkPolicyInformer = app.informerFactory.VirtualMachineNetworkPolicy()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.allPodInformer = app.informerFactory.Pod()
 
@@ -300,6 +358,12 @@ func Execute() {
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initPoolController()
+	app.initCloneController()
+	app.initExportController()
+	app.initBackupController()
+	app.initSecondaryNetworkDNSController()
+	app.initVMNetworkPolicyController()
 	app.initWorkloadUpdaterController()
 	go app.Run()
 
@@ -409,6 +473,12 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
 		go vca.snapshotController.Run(vca.snapshotControllerThreads, stop)
 		go vca.restoreController.Run(vca.restoreControllerThreads, stop)
+		go vca.poolController.Run(vca.poolControllerThreads, stop)
+		go vca.cloneController.Run(vca.cloneControllerThreads, stop)
+		go vca.exportController.Run(vca.exportControllerThreads, stop)
+		go vca.backupController.Run(vca.backupControllerThreads, stop)
+		go vca.secondaryNetworkDNSController.Run(vca.secondaryNetworkDNSThreads, stop)
+		go vca.vmNetworkPolicyController.Run(vca.vmNetworkPolicyThreads, stop)
 		go vca.workloadUpdateController.Run(stop)
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced)
 		close(vca.readyChan)
@@ -447,7 +517,7 @@ func (vca *VirtControllerApp) initCommon() {
 	vca.vmiController = NewVMIController(vca.templateService, vca.vmiInformer, vca.kvPodInformer, vca.persistentVolumeClaimInformer, vca.vmiRecorder, vca.clientSet, vca.dataVolumeInformer)
 	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "node-controller")
 	vca.nodeController = NewNodeController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, recorder)
-	vca.migrationController = NewMigrationController(vca.templateService, vca.vmiInformer, vca.kvPodInformer, vca.migrationInformer, vca.vmiRecorder, vca.clientSet, vca.clusterConfig)
+	vca.migrationController = NewMigrationController(vca.templateService, vca.vmiInformer, vca.kvPodInformer, vca.migrationInformer, vca.migrationPolicyInformer, vca.namespaceInformer, vca.nodeInformer, vca.vmiRecorder, vca.clientSet, vca.clusterConfig)
 }
 
 func (vca *VirtControllerApp) initReplicaSet() {
@@ -455,6 +525,11 @@ func (vca *VirtControllerApp) initReplicaSet() {
 	vca.rsController = NewVMIReplicaSet(vca.vmiInformer, vca.rsInformer, recorder, vca.clientSet, controller.BurstReplicas)
 }
 
+func (vca *VirtControllerApp) initPoolController() {
+	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "pool-controller")
+	vca.poolController = pool.NewPoolController(vca.clientSet, vca.poolInformer, vca.vmInformer, vca.vmiInformer, recorder, controller.BurstReplicas)
+}
+
 func (vca *VirtControllerApp) initVirtualMachines() {
 	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "virtualmachine-controller")
 
@@ -463,6 +538,13 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.vmInformer,
 		vca.dataVolumeInformer,
 		vca.persistentVolumeClaimInformer,
+		instancetype.NewMethods(
+			vca.instancetypeInformer.GetStore(),
+			vca.clusterInstancetypeInformer.GetStore(),
+			vca.preferenceInformer.GetStore(),
+			vca.clusterPreferenceInformer.GetStore(),
+			vca.clientSet,
+		),
 		recorder,
 		vca.clientSet)
 }
@@ -506,18 +588,19 @@ func (vca *VirtControllerApp) initEvacuationController() {
 func (vca *VirtControllerApp) initSnapshotController() {
 	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "snapshot-controller")
 	vca.snapshotController = &snapshot.VMSnapshotController{
-		Client:                    vca.clientSet,
-		VMSnapshotInformer:        vca.vmSnapshotInformer,
-		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
-		VMInformer:                vca.vmInformer,
-		VMIInformer:               vca.vmiInformer,
-		StorageClassInformer:      vca.storageClassInformer,
-		PVCInformer:               vca.persistentVolumeClaimInformer,
-		CRDInformer:               vca.crdInformer,
-		PodInformer:               vca.allPodInformer,
-		DVInformer:                vca.dataVolumeInformer,
-		Recorder:                  recorder,
-		ResyncPeriod:              vca.snapshotControllerResyncPeriod,
+		Client:                     vca.clientSet,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMSnapshotContentInformer:  vca.vmSnapshotContentInformer,
+		VMInformer:                 vca.vmInformer,
+		VMIInformer:                vca.vmiInformer,
+		StorageClassInformer:       vca.storageClassInformer,
+		PVCInformer:                vca.persistentVolumeClaimInformer,
+		CRDInformer:                vca.crdInformer,
+		PodInformer:                vca.allPodInformer,
+		DVInformer:                 vca.dataVolumeInformer,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		Recorder:                   recorder,
+		ResyncPeriod:               vca.snapshotControllerResyncPeriod,
 	}
 	vca.snapshotController.Init()
 }
@@ -527,6 +610,7 @@ func (vca *VirtControllerApp) initRestoreController() {
 	vca.restoreController = &snapshot.VMRestoreController{
 		Client:                    vca.clientSet,
 		VMRestoreInformer:         vca.vmRestoreInformer,
+		VMRestoreGrantInformer:    vca.vmRestoreGrantInformer,
 		VMSnapshotInformer:        vca.vmSnapshotInformer,
 		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
 		VMInformer:                vca.vmInformer,
@@ -539,6 +623,62 @@ func (vca *VirtControllerApp) initRestoreController() {
 	vca.restoreController.Init()
 }
 
+func (vca *VirtControllerApp) initCloneController() {
+	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "clone-controller")
+	vca.cloneController = &clone.VMCloneController{
+		Client:                    vca.clientSet,
+		VMCloneInformer:           vca.vmCloneInformer,
+		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		VMInformer:                vca.vmInformer,
+		PVCInformer:               vca.persistentVolumeClaimInformer,
+		Recorder:                  recorder,
+	}
+	vca.cloneController.Init()
+}
+
+func (vca *VirtControllerApp) initExportController() {
+	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "export-controller")
+	vca.exportController = &export.VMExportController{
+		Client:                    vca.clientSet,
+		VMExportInformer:          vca.vmExportInformer,
+		PodInformer:               vca.allPodInformer,
+		PVCInformer:               vca.persistentVolumeClaimInformer,
+		VMInformer:                vca.vmInformer,
+		VMIInformer:               vca.vmiInformer,
+		VMSnapshotInformer:        vca.vmSnapshotInformer,
+		VMSnapshotContentInformer: vca.vmSnapshotContentInformer,
+		Recorder:                  recorder,
+		ClusterConfig:             vca.clusterConfig,
+		ExportServerImage:         vca.launcherImage,
+	}
+	vca.exportController.Init()
+}
+
+func (vca *VirtControllerApp) initBackupController() {
+	recorder := vca.getNewRecorder(k8sv1.NamespaceAll, "backup-controller")
+	vca.backupController = &backup.VMBackupController{
+		Client:                  vca.clientSet,
+		VMBackupInformer:        vca.vmBackupInformer,
+		VMBackupRestoreInformer: vca.vmBackupRestoreInformer,
+		PodInformer:             vca.allPodInformer,
+		PVCInformer:             vca.persistentVolumeClaimInformer,
+		VMIInformer:             vca.vmiInformer,
+		Recorder:                recorder,
+		ClusterConfig:           vca.clusterConfig,
+		LauncherImage:           vca.launcherImage,
+	}
+	vca.backupController.Init()
+}
+
+func (vca *VirtControllerApp) initSecondaryNetworkDNSController() {
+	vca.secondaryNetworkDNSController = NewSecondaryNetworkDNSController(vca.clientSet, vca.vmiInformer, vca.clusterConfig, vca.kubevirtNamespace)
+}
+
+func (vca *VirtControllerApp) initVMNetworkPolicyController() {
+	vca.vmNetworkPolicyController = NewVMNetworkPolicyController(vca.clientSet, vca.vmiInformer, vca.vmNetworkPolicyInformer, vca.clusterConfig)
+}
+
 func (vca *VirtControllerApp) leaderProbe(_ *restful.Request, response *restful.Response) {
 	res := map[string]interface{}{}
 
@@ -617,6 +757,24 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.restoreControllerThreads, "restore-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for restore controller")
 
+	flag.IntVar(&vca.poolControllerThreads, "pool-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for pool controller")
+
+	flag.IntVar(&vca.cloneControllerThreads, "clone-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for clone controller")
+
+	flag.IntVar(&vca.exportControllerThreads, "export-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for export controller")
+
+	flag.IntVar(&vca.backupControllerThreads, "backup-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for backup controller")
+
+	flag.IntVar(&vca.secondaryNetworkDNSThreads, "secondary-network-dns-controller-threads", 1,
+		"Number of goroutines to run for secondary network DNS controller")
+
+	flag.IntVar(&vca.vmNetworkPolicyThreads, "vm-network-policy-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for network policy controller")
+
 	flag.DurationVar(&vca.snapshotControllerResyncPeriod, "snapshot-controller-resync-period", defaultSnapshotControllerResyncPeriod,
 		"Number of goroutines to run for snapshot controller")
 
@@ -41,15 +41,26 @@ import (
 	io_prometheus_client "github.com/prometheus/client_model/go"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	migrationsv1 "kubevirt.io/client-go/apis/migrations/v1alpha1"
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/rest"
 	testutils "kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/backup"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/export"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/snapshot"
 
 	storagev1 "k8s.io/api/storage/v1"
@@ -87,7 +98,17 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1beta1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmRestoreGrantInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestoreGrant{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
 		dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		poolInformer, _ := testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+		vmCloneInformer, _ := testutils.NewFakeInformerFor(&clonev1.VirtualMachineClone{})
+		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
+		vmBackupInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
+		vmBackupRestoreInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupRestore{})
+		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		vmNetworkPolicyInformer, _ := testutils.NewFakeInformerFor(&networkpolicyv1.VirtualMachineNetworkPolicy{})
 
 		var qemuGid int64 = 107
 
@@ -104,33 +125,38 @@ var _ = Describe("Application", func() {
 			dataVolumeInformer,
 		)
 		app.rsController = NewVMIReplicaSet(vmiInformer, rsInformer, recorder, virtClient, uint(10))
-		app.vmController = NewVMController(vmiInformer, vmInformer, dataVolumeInformer, pvcInformer, recorder, virtClient)
+		app.vmController = NewVMController(vmiInformer, vmInformer, dataVolumeInformer, pvcInformer, instancetype.NewMethods(nil, nil, nil, nil, virtClient), recorder, virtClient)
 		app.migrationController = NewMigrationController(services.NewTemplateService("a", "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid),
 			vmiInformer,
 			podInformer,
 			migrationInformer,
+			migrationPolicyInformer,
+			namespaceInformer,
+			nodeInformer,
 			recorder,
 			virtClient,
 			config,
 		)
 		app.snapshotController = &snapshot.VMSnapshotController{
-			Client:                    virtClient,
-			VMSnapshotInformer:        vmSnapshotInformer,
-			VMSnapshotContentInformer: vmSnapshotContentInformer,
-			VMInformer:                vmInformer,
-			VMIInformer:               vmiInformer,
-			PodInformer:               podInformer,
-			StorageClassInformer:      storageClassInformer,
-			PVCInformer:               pvcInformer,
-			CRDInformer:               crdInformer,
-			DVInformer:                dvInformer,
-			Recorder:                  recorder,
-			ResyncPeriod:              60 * time.Second,
+			Client:                     virtClient,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMSnapshotContentInformer:  vmSnapshotContentInformer,
+			VMInformer:                 vmInformer,
+			VMIInformer:                vmiInformer,
+			PodInformer:                podInformer,
+			StorageClassInformer:       storageClassInformer,
+			PVCInformer:                pvcInformer,
+			CRDInformer:                crdInformer,
+			DVInformer:                 dvInformer,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			Recorder:                   recorder,
+			ResyncPeriod:               60 * time.Second,
 		}
 		app.snapshotController.Init()
 		app.restoreController = &snapshot.VMRestoreController{
 			Client:                    virtClient,
 			VMRestoreInformer:         vmRestoreInformer,
+			VMRestoreGrantInformer:    vmRestoreGrantInformer,
 			VMSnapshotInformer:        vmSnapshotInformer,
 			VMSnapshotContentInformer: vmSnapshotContentInformer,
 			VMInformer:                vmInformer,
@@ -141,6 +167,43 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		app.restoreController.Init()
+		app.poolController = pool.NewPoolController(virtClient, poolInformer, vmInformer, vmiInformer, recorder, uint(10))
+		app.cloneController = &clone.VMCloneController{
+			Client:                    virtClient,
+			VMCloneInformer:           vmCloneInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			VMInformer:                vmInformer,
+			PVCInformer:               pvcInformer,
+			Recorder:                  recorder,
+		}
+		app.cloneController.Init()
+		app.exportController = &export.VMExportController{
+			Client:                    virtClient,
+			VMExportInformer:          vmExportInformer,
+			PodInformer:               podInformer,
+			PVCInformer:               pvcInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			Recorder:                  recorder,
+			ClusterConfig:             config,
+		}
+		app.exportController.Init()
+		app.backupController = &backup.VMBackupController{
+			Client:                  virtClient,
+			VMBackupInformer:        vmBackupInformer,
+			VMBackupRestoreInformer: vmBackupRestoreInformer,
+			PodInformer:             podInformer,
+			PVCInformer:             pvcInformer,
+			VMIInformer:             vmiInformer,
+			Recorder:                recorder,
+			ClusterConfig:           config,
+		}
+		app.backupController.Init()
+		app.secondaryNetworkDNSController = NewSecondaryNetworkDNSController(virtClient, vmiInformer, config, "kubevirt")
+		app.vmNetworkPolicyController = NewVMNetworkPolicyController(virtClient, vmiInformer, vmNetworkPolicyInformer, config)
 		app.persistentVolumeClaimInformer = pvcInformer
 
 		app.readyChan = make(chan bool)
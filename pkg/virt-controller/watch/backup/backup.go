@@ -0,0 +1,695 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/controller"
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+)
+
+const (
+	// BackupNameLabel selects the backup target pod of a VirtualMachineBackup
+	BackupNameLabel = "backup.kubevirt.io/backup-name"
+
+	backupPrefix = "virt-backup"
+
+	vmBackupFinalizer = "backup.kubevirt.io/vmbackup-protection"
+
+	backupStartedEvent = "BackupStarted"
+
+	backupTargetCreatedEvent = "BackupTargetCreated"
+
+	backupErrorEvent = "VirtualMachineBackupError"
+
+	virtualMachineBackupKind = "VirtualMachineBackup"
+
+	targetDir = "/target"
+)
+
+// variable so can be overridden in tests
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+func cacheKeyFunc(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+// backupResourceName is the name of the backup target pod of vmBackup
+func backupResourceName(vmBackup *backupv1.VirtualMachineBackup) string {
+	return fmt.Sprintf("%s-%s", backupPrefix, vmBackup.Name)
+}
+
+// checkpointName is the name of the checkpoint created by vmBackup. The uid keeps it unique in the
+// chain even if a backup of the same name is created again.
+func checkpointName(vmBackup *backupv1.VirtualMachineBackup) string {
+	return fmt.Sprintf("%s-%s", vmBackup.Name, vmBackup.UID)
+}
+
+func backupMode(vmBackup *backupv1.VirtualMachineBackup) kubevirtv1.BackupMode {
+	if vmBackup.Spec.Mode == "" {
+		return kubevirtv1.BackupModePush
+	}
+	return vmBackup.Spec.Mode
+}
+
+func pullBackupTTL(vmBackup *backupv1.VirtualMachineBackup) time.Duration {
+	if vmBackup.Spec.TTLDuration != nil {
+		return vmBackup.Spec.TTLDuration.Duration
+	}
+	ttl, _ := time.ParseDuration(backupv1.DefaultPullBackupTTL)
+	return ttl
+}
+
+func (ctrl *VMBackupController) updateVMBackup(vmBackupIn *backupv1.VirtualMachineBackup) error {
+	logger := log.Log.Object(vmBackupIn)
+
+	logger.V(1).Infof("Updating VirtualMachineBackup")
+
+	if vmBackupIn.DeletionTimestamp != nil {
+		return ctrl.cleanupVMBackup(vmBackupIn)
+	}
+
+	vmBackupOut := vmBackupIn.DeepCopy()
+	// the finalizer aborts a backup which is still running when it is deleted
+	controller.AddFinalizer(vmBackupOut, vmBackupFinalizer)
+	if vmBackupOut.Status == nil {
+		vmBackupOut.Status = &backupv1.VirtualMachineBackupStatus{
+			Phase: backupv1.Pending,
+		}
+	}
+
+	if err := ctrl.reconcileVMBackup(vmBackupOut); err != nil {
+		logger.Reason(err).Error("Error backing up VirtualMachine")
+		return ctrl.doUpdateError(vmBackupIn, vmBackupOut, err)
+	}
+
+	return ctrl.doUpdate(vmBackupIn, vmBackupOut)
+}
+
+func (ctrl *VMBackupController) reconcileVMBackup(vmBackup *backupv1.VirtualMachineBackup) error {
+	if isTerminal(backupPhase(vmBackup.Status)) {
+		return ctrl.deleteTargetPod(vmBackup)
+	}
+
+	vmi, err := ctrl.getVMI(vmBackup.Namespace, vmBackup.Spec.Source.Name)
+	if err != nil {
+		return err
+	}
+
+	if vmBackup.Status.Phase == backupv1.Running {
+		return ctrl.reconcileRunningVMBackup(vmBackup, vmi)
+	}
+
+	return ctrl.reconcilePendingVMBackup(vmBackup, vmi)
+}
+
+// reconcilePendingVMBackup starts the backup once the VirtualMachineInstance and the target are ready
+func (ctrl *VMBackupController) reconcilePendingVMBackup(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) error {
+	sourceName := vmBackup.Spec.Source.Name
+
+	if vmi == nil || !vmi.IsRunning() {
+		setPending(vmBackup, fmt.Sprintf("Waiting for VirtualMachineInstance %s to run", sourceName))
+		return nil
+	}
+
+	volumes := changedBlockTrackingVolumes(vmi)
+	if len(volumes) == 0 {
+		setFailed(vmBackup, fmt.Sprintf("VirtualMachineInstance %s does not have disks with changed block tracking", sourceName))
+		return nil
+	}
+
+	if running := ctrl.runningVMBackup(vmBackup); running != "" {
+		setPending(vmBackup, fmt.Sprintf("Waiting for backup %s", running))
+		return nil
+	}
+
+	if state := vmi.Status.BackupState; state != nil && !state.Completed && state.BackupName != vmBackup.Name {
+		setPending(vmBackup, fmt.Sprintf("Waiting for backup %s", state.BackupName))
+		return nil
+	}
+
+	if migrationState := vmi.Status.MigrationState; migrationState != nil && !migrationState.Completed && !migrationState.Failed {
+		setPending(vmBackup, fmt.Sprintf("Waiting for the migration of VirtualMachineInstance %s", sourceName))
+		return nil
+	}
+
+	var chain []backupv1.VirtualMachineBackupCheckpoint
+	if vmBackup.Spec.IncrementalFrom != nil {
+		var ok bool
+		var err error
+		if chain, ok, err = ctrl.resolveCheckpointChain(vmBackup, volumes); err != nil || !ok {
+			return err
+		}
+	}
+
+	var target *kubevirtv1.BackupTarget
+	if backupMode(vmBackup) == kubevirtv1.BackupModePush {
+		pod, err := ctrl.ensureTargetPod(vmBackup, vmi)
+		if err != nil {
+			return err
+		}
+
+		if pod == nil || pod.Status.Phase != corev1.PodRunning {
+			setPending(vmBackup, "Waiting for backup target pod")
+			return nil
+		}
+
+		target = &kubevirtv1.BackupTarget{
+			ClaimName: *vmBackup.Spec.PVCName,
+			PodUID:    pod.UID,
+		}
+	}
+
+	checkpoint := checkpointName(vmBackup)
+	options := &kubevirtv1.VirtualMachineInstanceBackupOptions{
+		BackupName:  vmBackup.Name,
+		Mode:        backupMode(vmBackup),
+		Checkpoint:  checkpoint,
+		Checkpoints: vmiCheckpoints(chain),
+		Target:      target,
+	}
+	if err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Backup(vmi.Name, options); err != nil {
+		return err
+	}
+
+	ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeNormal, backupStartedEvent, "Started backup of VirtualMachineInstance %s", vmi.Name)
+
+	now := currentTime()
+	vmBackup.Status.Phase = backupv1.Running
+	vmBackup.Status.Type = backupv1.Full
+	if len(chain) > 0 {
+		vmBackup.Status.Type = backupv1.Incremental
+	}
+	vmBackup.Status.StartTime = now
+	vmBackup.Status.Checkpoint = &checkpoint
+	vmBackup.Status.Volumes = backupVolumes(vmBackup, vmi, volumes)
+	vmBackup.Status.CheckpointChain = append(append([]backupv1.VirtualMachineBackupCheckpoint{}, chain...), backupv1.VirtualMachineBackupCheckpoint{
+		BackupName:        vmBackup.Name,
+		Name:              checkpoint,
+		CreationTimestamp: *now,
+		PVCName:           vmBackup.Spec.PVCName,
+		Volumes:           volumes,
+	})
+	updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionTrue, "Backup is running"))
+	updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionFalse, "Backup is running"))
+
+	return nil
+}
+
+// resolveCheckpointChain returns the chain of checkpoints an incremental backup continues.
+// It returns false, and sets the phase of vmBackup, if the chain can not be continued yet or at all.
+func (ctrl *VMBackupController) resolveCheckpointChain(vmBackup *backupv1.VirtualMachineBackup, volumes []string) ([]backupv1.VirtualMachineBackupCheckpoint, bool, error) {
+	previousName := *vmBackup.Spec.IncrementalFrom
+
+	previous, err := ctrl.getVMBackup(vmBackup.Namespace, previousName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if previous == nil {
+		setFailed(vmBackup, fmt.Sprintf("VirtualMachineBackup %s does not exist", previousName))
+		return nil, false, nil
+	}
+
+	switch backupPhase(previous.Status) {
+	case backupv1.Succeeded:
+	case backupv1.Failed:
+		setFailed(vmBackup, fmt.Sprintf("VirtualMachineBackup %s failed", previousName))
+		return nil, false, nil
+	default:
+		setPending(vmBackup, fmt.Sprintf("Waiting for backup %s to succeed", previousName))
+		return nil, false, nil
+	}
+
+	chain := previous.Status.CheckpointChain
+	if len(chain) == 0 {
+		setFailed(vmBackup, fmt.Sprintf("VirtualMachineBackup %s does not have a checkpoint", previousName))
+		return nil, false, nil
+	}
+
+	// every full backup removes the checkpoints of the backups before it
+	if full := ctrl.laterFullVMBackup(vmBackup, chain[0]); full != "" {
+		setFailed(vmBackup, fmt.Sprintf("the checkpoint of VirtualMachineBackup %s was removed by the full backup %s, a full backup is needed", previousName, full))
+		return nil, false, nil
+	}
+
+	tracked := map[string]bool{}
+	for _, volume := range chain[len(chain)-1].Volumes {
+		tracked[volume] = true
+	}
+	for _, volume := range volumes {
+		if !tracked[volume] {
+			setFailed(vmBackup, fmt.Sprintf("the changed blocks of volume %s are not tracked since VirtualMachineBackup %s, a full backup is needed", volume, previousName))
+			return nil, false, nil
+		}
+	}
+
+	return chain, true, nil
+}
+
+// laterFullVMBackup returns the name of a full backup of the source of vmBackup, which started after
+// the chain of checkpoints did
+func (ctrl *VMBackupController) laterFullVMBackup(vmBackup *backupv1.VirtualMachineBackup, root backupv1.VirtualMachineBackupCheckpoint) string {
+	for _, other := range ctrl.sourceVMBackups(vmBackup) {
+		if other.Name == root.BackupName || other.Status == nil || other.Status.Type != backupv1.Full || other.Status.StartTime == nil {
+			continue
+		}
+		if other.Status.StartTime.After(root.CreationTimestamp.Time) {
+			return other.Name
+		}
+	}
+
+	return ""
+}
+
+// runningVMBackup returns the name of another running backup of the source of vmBackup
+func (ctrl *VMBackupController) runningVMBackup(vmBackup *backupv1.VirtualMachineBackup) string {
+	for _, other := range ctrl.sourceVMBackups(vmBackup) {
+		if other.Name != vmBackup.Name && backupPhase(other.Status) == backupv1.Running {
+			return other.Name
+		}
+	}
+
+	return ""
+}
+
+func (ctrl *VMBackupController) sourceVMBackups(vmBackup *backupv1.VirtualMachineBackup) []*backupv1.VirtualMachineBackup {
+	objs, err := ctrl.VMBackupInformer.GetIndexer().ByIndex(cache.NamespaceIndex, vmBackup.Namespace)
+	if err != nil {
+		log.Log.Object(vmBackup).Reason(err).Error("Failed to list VirtualMachineBackups")
+		return nil
+	}
+
+	var vmBackups []*backupv1.VirtualMachineBackup
+	for _, obj := range objs {
+		other := obj.(*backupv1.VirtualMachineBackup)
+		if other.Spec.Source.Name == vmBackup.Spec.Source.Name {
+			vmBackups = append(vmBackups, other)
+		}
+	}
+
+	return vmBackups
+}
+
+// reconcileRunningVMBackup records the result of the backup once it ended, and ends the export of
+// the disks of a pull backup after its ttl
+func (ctrl *VMBackupController) reconcileRunningVMBackup(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) error {
+	startTime := vmBackup.Status.StartTime
+	if vmi == nil || vmi.IsFinal() || (startTime != nil && vmi.CreationTimestamp.After(startTime.Time)) {
+		setFailed(vmBackup, fmt.Sprintf("VirtualMachineInstance %s stopped during the backup", vmBackup.Spec.Source.Name))
+		return ctrl.deleteTargetPod(vmBackup)
+	}
+
+	state := vmi.Status.BackupState
+	if state == nil || state.BackupName != vmBackup.Name {
+		// virt-handler did not report the backup yet, unless a later one replaced it
+		if state != nil && state.StartTimestamp != nil && startTime != nil && state.StartTimestamp.After(startTime.Time) {
+			setFailed(vmBackup, fmt.Sprintf("the result of the backup was replaced by backup %s", state.BackupName))
+			return ctrl.deleteTargetPod(vmBackup)
+		}
+		return nil
+	}
+
+	if !state.Completed {
+		if backupMode(vmBackup) == kubevirtv1.BackupModePull {
+			updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionTrue, "Disks are exported"))
+			return ctrl.expirePullVMBackup(vmBackup, vmi)
+		}
+		return nil
+	}
+
+	vmBackup.Status.CompletionTime = state.EndTimestamp
+	if state.Failed {
+		setFailed(vmBackup, state.Message)
+	} else {
+		vmBackup.Status.Phase = backupv1.Succeeded
+		if vmBackup.Status.CompletionTime == nil {
+			vmBackup.Status.CompletionTime = currentTime()
+		}
+		updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionFalse, "Backup completed"))
+		updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionTrue, "Backup completed"))
+	}
+
+	return ctrl.deleteTargetPod(vmBackup)
+}
+
+// expirePullVMBackup aborts a pull backup once its ttl passed, which ends the export of its disks
+func (ctrl *VMBackupController) expirePullVMBackup(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) error {
+	if vmBackup.Status.StartTime == nil {
+		return nil
+	}
+
+	remaining := vmBackup.Status.StartTime.Add(pullBackupTTL(vmBackup)).Sub(currentTime().Time)
+	if remaining > 0 {
+		ctrl.vmBackupQueue.AddAfter(cacheKeyFunc(vmBackup.Namespace, vmBackup.Name), remaining)
+		return nil
+	}
+
+	log.Log.Object(vmBackup).Infof("Ending the export of the disks after %s", pullBackupTTL(vmBackup))
+	return ctrl.Client.VirtualMachineInstance(vmi.Namespace).AbortBackup(vmi.Name, &kubevirtv1.VirtualMachineInstanceAbortBackupOptions{
+		BackupName: vmBackup.Name,
+	})
+}
+
+// cleanupVMBackup aborts the backup if it is still running, and releases the VirtualMachineBackup
+func (ctrl *VMBackupController) cleanupVMBackup(vmBackup *backupv1.VirtualMachineBackup) error {
+	if !controller.HasFinalizer(vmBackup, vmBackupFinalizer) {
+		return nil
+	}
+
+	if backupPhase(vmBackup.Status) == backupv1.Running {
+		vmi, err := ctrl.getVMI(vmBackup.Namespace, vmBackup.Spec.Source.Name)
+		if err != nil {
+			return err
+		}
+
+		if vmi != nil && vmi.IsRunning() {
+			if state := vmi.Status.BackupState; state != nil && state.BackupName == vmBackup.Name && !state.Completed {
+				err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).AbortBackup(vmi.Name, &kubevirtv1.VirtualMachineInstanceAbortBackupOptions{
+					BackupName: vmBackup.Name,
+				})
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	vmBackupCopy := vmBackup.DeepCopy()
+	controller.RemoveFinalizer(vmBackupCopy, vmBackupFinalizer)
+
+	return ctrl.doUpdate(vmBackup, vmBackupCopy)
+}
+
+// ensureTargetPod creates the pod which mounts the claim of a push backup on the node of the VirtualMachineInstance.
+// It returns the pod once it exists on the node.
+func (ctrl *VMBackupController) ensureTargetPod(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) (*corev1.Pod, error) {
+	pod, err := ctrl.getPod(vmBackup.Namespace, backupResourceName(vmBackup))
+	if err != nil {
+		return nil, err
+	}
+
+	if pod != nil {
+		if pod.DeletionTimestamp != nil {
+			return nil, nil
+		}
+
+		// the VirtualMachineInstance migrated while the backup waited
+		if pod.Spec.NodeName != vmi.Status.NodeName {
+			return nil, ctrl.deleteTargetPod(vmBackup)
+		}
+
+		return pod, nil
+	}
+
+	pod = ctrl.newBackupTargetPod(vmBackup, vmi)
+	if _, err = ctrl.Client.CoreV1().Pods(vmBackup.Namespace).Create(context.Background(), pod, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	ctrl.Recorder.Eventf(vmBackup, corev1.EventTypeNormal, backupTargetCreatedEvent, "Created backup target pod %s", pod.Name)
+
+	return nil, nil
+}
+
+func (ctrl *VMBackupController) deleteTargetPod(vmBackup *backupv1.VirtualMachineBackup) error {
+	pod, err := ctrl.getPod(vmBackup.Namespace, backupResourceName(vmBackup))
+	if err != nil || pod == nil || pod.DeletionTimestamp != nil {
+		return err
+	}
+
+	err = ctrl.Client.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// newBackupTargetPod returns the pod which mounts the claim of a push backup on the node of the VirtualMachineInstance.
+// Its init container creates the directory of the backup in the root of the claim, which is how virt-handler finds
+// the claim in the volumes of the pod, before it mounts the claim into the virt-launcher pod.
+func (ctrl *VMBackupController) newBackupTargetPod(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance) *corev1.Pod {
+	qemuID := int64(107)
+	nonRoot := true
+	automount := false
+
+	volumeMounts := []corev1.VolumeMount{
+		{Name: "target", MountPath: targetDir},
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            backupResourceName(vmBackup),
+			Namespace:       vmBackup.Namespace,
+			Labels:          map[string]string{BackupNameLabel: vmBackup.Name},
+			OwnerReferences: []metav1.OwnerReference{ownerRef(vmBackup.Name, vmBackup.UID, virtualMachineBackupKind)},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:                     vmi.Status.NodeName,
+			RestartPolicy:                corev1.RestartPolicyAlways,
+			AutomountServiceAccountToken: &automount,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsUser:    &qemuID,
+				RunAsGroup:   &qemuID,
+				FSGroup:      &qemuID,
+				RunAsNonRoot: &nonRoot,
+			},
+			InitContainers: []corev1.Container{
+				{
+					Name:            "prepare",
+					Image:           ctrl.LauncherImage,
+					ImagePullPolicy: ctrl.ClusterConfig.GetImagePullPolicy(),
+					Command:         []string{"mkdir", "-p", fmt.Sprintf("%s/%s", targetDir, vmBackup.Name)},
+					VolumeMounts:    volumeMounts,
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:            "target",
+					Image:           ctrl.LauncherImage,
+					ImagePullPolicy: ctrl.ClusterConfig.GetImagePullPolicy(),
+					Command:         []string{"sleep", "infinity"},
+					VolumeMounts:    volumeMounts,
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: "target",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: *vmBackup.Spec.PVCName,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ctrl *VMBackupController) doUpdateError(original, updated *backupv1.VirtualMachineBackup, err error) error {
+	ctrl.Recorder.Eventf(
+		original,
+		corev1.EventTypeWarning,
+		backupErrorEvent,
+		"VirtualMachineBackup encountered error %s",
+		err.Error(),
+	)
+
+	updateCondition(&updated.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionFalse, err.Error()))
+	if err2 := ctrl.doUpdate(original, updated); err2 != nil {
+		return err2
+	}
+
+	return err
+}
+
+func (ctrl *VMBackupController) doUpdate(original, updated *backupv1.VirtualMachineBackup) error {
+	if !reflect.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineBackup(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMBackupController) getVMBackup(namespace, name string) (*backupv1.VirtualMachineBackup, error) {
+	obj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*backupv1.VirtualMachineBackup).DeepCopy(), nil
+}
+
+func (ctrl *VMBackupController) getVMI(namespace, name string) (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*kubevirtv1.VirtualMachineInstance), nil
+}
+
+func (ctrl *VMBackupController) getPod(namespace, name string) (*corev1.Pod, error) {
+	obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*corev1.Pod).DeepCopy(), nil
+}
+
+func (ctrl *VMBackupController) getPVC(namespace, name string) (*corev1.PersistentVolumeClaim, error) {
+	obj, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*corev1.PersistentVolumeClaim), nil
+}
+
+// changedBlockTrackingVolumes returns the volumes of the disks of vmi with changed block tracking
+func changedBlockTrackingVolumes(vmi *kubevirtv1.VirtualMachineInstance) []string {
+	var volumes []string
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.ChangedBlockTracking {
+			volumes = append(volumes, disk.Name)
+		}
+	}
+
+	return volumes
+}
+
+func backupVolumes(vmBackup *backupv1.VirtualMachineBackup, vmi *kubevirtv1.VirtualMachineInstance, volumeNames []string) []backupv1.VirtualMachineBackupVolume {
+	targets := map[string]string{}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		targets[volumeStatus.Name] = volumeStatus.Target
+	}
+
+	var volumes []backupv1.VirtualMachineBackupVolume
+	for _, name := range volumeNames {
+		volume := backupv1.VirtualMachineBackupVolume{
+			VolumeName: name,
+			DiskTarget: targets[name],
+		}
+		if backupMode(vmBackup) == kubevirtv1.BackupModePull {
+			volume.ExportName = name
+		} else {
+			volume.Path = hotplugdisk.GetBackupVolumePath(vmBackup.Name, name)
+		}
+		volumes = append(volumes, volume)
+	}
+
+	return volumes
+}
+
+func vmiCheckpoints(chain []backupv1.VirtualMachineBackupCheckpoint) []kubevirtv1.BackupCheckpoint {
+	var checkpoints []kubevirtv1.BackupCheckpoint
+	for _, checkpoint := range chain {
+		checkpoints = append(checkpoints, kubevirtv1.BackupCheckpoint{
+			Name:              checkpoint.Name,
+			CreationTimestamp: checkpoint.CreationTimestamp,
+			Volumes:           checkpoint.Volumes,
+		})
+	}
+
+	return checkpoints
+}
+
+func ownerRef(name string, uid types.UID, kind string) metav1.OwnerReference {
+	t := true
+	return metav1.OwnerReference{
+		APIVersion:         backupv1.SchemeGroupVersion.String(),
+		Kind:               kind,
+		Name:               name,
+		UID:                uid,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+}
+
+func backupPhase(status *backupv1.VirtualMachineBackupStatus) backupv1.BackupPhase {
+	if status == nil {
+		return ""
+	}
+	return status.Phase
+}
+
+func isTerminal(phase backupv1.BackupPhase) bool {
+	return phase == backupv1.Succeeded || phase == backupv1.Failed
+}
+
+func setPending(vmBackup *backupv1.VirtualMachineBackup, reason string) {
+	vmBackup.Status.Phase = backupv1.Pending
+	updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionFalse, reason))
+}
+
+// setFailed fails vmBackup. Libvirt deletes the checkpoint of a failed backup, so later backups can not be incremental to it.
+func setFailed(vmBackup *backupv1.VirtualMachineBackup, reason string) {
+	vmBackup.Status.Phase = backupv1.Failed
+	if vmBackup.Status.CompletionTime == nil {
+		vmBackup.Status.CompletionTime = currentTime()
+	}
+	vmBackup.Status.Checkpoint = nil
+	vmBackup.Status.CheckpointChain = nil
+	updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionFalse, reason))
+	updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionFalse, reason))
+	updateCondition(&vmBackup.Status.Conditions, newCondition(backupv1.ConditionFailure, corev1.ConditionTrue, reason))
+}
+
+func newCondition(conditionType backupv1.ConditionType, status corev1.ConditionStatus, reason string) backupv1.Condition {
+	return backupv1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateCondition(conditions *[]backupv1.Condition, c backupv1.Condition) {
+	for i := range *conditions {
+		if (*conditions)[i].Type == c.Type {
+			if (*conditions)[i].Status != c.Status || (*conditions)[i].Reason != c.Reason {
+				(*conditions)[i] = c
+			}
+			return
+		}
+	}
+
+	*conditions = append(*conditions, c)
+}
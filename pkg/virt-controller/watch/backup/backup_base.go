@@ -0,0 +1,323 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package backup
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMBackupController is responsible for backing up VMs and restoring their backups
+type VMBackupController struct {
+	Client kubecli.KubevirtClient
+
+	VMBackupInformer        cache.SharedIndexInformer
+	VMBackupRestoreInformer cache.SharedIndexInformer
+	PodInformer             cache.SharedIndexInformer
+	PVCInformer             cache.SharedIndexInformer
+	VMIInformer             cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	ClusterConfig *virtconfig.ClusterConfig
+
+	// LauncherImage is the image of the backup target and restore pods, it provides qemu-img
+	LauncherImage string
+
+	vmBackupQueue        workqueue.RateLimitingInterface
+	vmBackupRestoreQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the backup controller
+func (ctrl *VMBackupController) Init() {
+	ctrl.vmBackupQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "backup-controller-vmbackup")
+	ctrl.vmBackupRestoreQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "backup-controller-vmbackuprestore")
+
+	ctrl.VMBackupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackup(newObj) },
+			DeleteFunc: ctrl.handleVMBackup,
+		},
+	)
+
+	ctrl.VMBackupRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackupRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackupRestore(newObj) },
+		},
+	)
+
+	ctrl.PodInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleOwnedObject,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleOwnedObject(newObj) },
+			DeleteFunc: ctrl.handleOwnedObject,
+		},
+	)
+
+	ctrl.PVCInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handlePVC,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handlePVC(newObj) },
+		},
+	)
+
+	ctrl.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMI(newObj) },
+			DeleteFunc: ctrl.handleVMI,
+		},
+	)
+}
+
+// Run the controller
+func (ctrl *VMBackupController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmBackupQueue.ShutDown()
+	defer ctrl.vmBackupRestoreQueue.ShutDown()
+
+	log.Log.Info("Starting backup controller.")
+	defer log.Log.Info("Shutting down backup controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMBackupInformer.HasSynced,
+		ctrl.VMBackupRestoreInformer.HasSynced,
+		ctrl.PodInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmBackupWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmBackupRestoreWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMBackupController) vmBackupWorker() {
+	for ctrl.processWorkItem(ctrl.vmBackupQueue, ctrl.executeVMBackup) {
+	}
+}
+
+func (ctrl *VMBackupController) vmBackupRestoreWorker() {
+	for ctrl.processWorkItem(ctrl.vmBackupRestoreQueue, ctrl.executeVMBackupRestore) {
+	}
+}
+
+func (ctrl *VMBackupController) processWorkItem(queue workqueue.RateLimitingInterface, execute func(string) error) bool {
+	obj, shutdown := queue.Get()
+	if shutdown {
+		return false
+	}
+	defer queue.Done(obj)
+
+	key, ok := obj.(string)
+	if !ok {
+		queue.Forget(obj)
+		utilruntime.HandleError(fmt.Errorf("expected string in workqueue but got %#v", obj))
+		return true
+	}
+
+	log.Log.V(3).Infof("backup worker processing key [%s]", key)
+
+	if err := execute(key); err != nil {
+		utilruntime.HandleError(err)
+		queue.AddRateLimited(key)
+		return true
+	}
+
+	queue.Forget(obj)
+	return true
+}
+
+func (ctrl *VMBackupController) executeVMBackup(key string) error {
+	storeObj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(key)
+	if !exists || err != nil {
+		return err
+	}
+
+	vmBackup, ok := storeObj.(*backupv1.VirtualMachineBackup)
+	if !ok {
+		return fmt.Errorf("unexpected resource %+v", storeObj)
+	}
+
+	return ctrl.updateVMBackup(vmBackup.DeepCopy())
+}
+
+func (ctrl *VMBackupController) executeVMBackupRestore(key string) error {
+	storeObj, exists, err := ctrl.VMBackupRestoreInformer.GetStore().GetByKey(key)
+	if !exists || err != nil {
+		return err
+	}
+
+	vmBackupRestore, ok := storeObj.(*backupv1.VirtualMachineBackupRestore)
+	if !ok {
+		return fmt.Errorf("unexpected resource %+v", storeObj)
+	}
+
+	return ctrl.updateVMBackupRestore(vmBackupRestore.DeepCopy())
+}
+
+// handleVMBackup enqueues the backup, the pending backups of the same source which may wait for it,
+// and the restores of it
+func (ctrl *VMBackupController) handleVMBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmBackup, ok := obj.(*backupv1.VirtualMachineBackup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(vmBackup)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, vmBackup)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmBackupQueue.Add(objName)
+
+		ctrl.enqueueBackups(vmBackup.Namespace, func(other *backupv1.VirtualMachineBackup) bool {
+			return other.Name != vmBackup.Name && other.Spec.Source.Name == vmBackup.Spec.Source.Name && !isTerminal(backupPhase(other.Status))
+		})
+		ctrl.enqueueRestores(vmBackup.Namespace, func(vmBackupRestore *backupv1.VirtualMachineBackupRestore) bool {
+			return vmBackupRestore.Spec.BackupName == vmBackup.Name
+		})
+	}
+}
+
+func (ctrl *VMBackupController) handleVMBackupRestore(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmBackupRestore, ok := obj.(*backupv1.VirtualMachineBackupRestore); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(vmBackupRestore)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, vmBackupRestore)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmBackupRestoreQueue.Add(objName)
+	}
+}
+
+// handleOwnedObject enqueues the VirtualMachineBackup or VirtualMachineBackupRestore controlling obj
+func (ctrl *VMBackupController) handleOwnedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if o, ok := obj.(metav1.Object); ok {
+		ownerRef := metav1.GetControllerOf(o)
+		if ownerRef == nil || ownerRef.APIVersion != backupv1.SchemeGroupVersion.String() {
+			return
+		}
+
+		objName := cacheKeyFunc(o.GetNamespace(), ownerRef.Name)
+
+		switch ownerRef.Kind {
+		case virtualMachineBackupKind:
+			log.Log.V(3).Infof("Handling %s/%s, Backup %s", o.GetNamespace(), o.GetName(), objName)
+			ctrl.vmBackupQueue.Add(objName)
+		case virtualMachineBackupRestoreKind:
+			log.Log.V(3).Infof("Handling %s/%s, Restore %s", o.GetNamespace(), o.GetName(), objName)
+			ctrl.vmBackupRestoreQueue.Add(objName)
+		}
+	}
+}
+
+func (ctrl *VMBackupController) handlePVC(obj interface{}) {
+	if pvc, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+		ctrl.enqueueRestores(pvc.Namespace, func(vmBackupRestore *backupv1.VirtualMachineBackupRestore) bool {
+			for _, volume := range vmBackupRestore.Spec.Volumes {
+				if volume.PVCName == pvc.Name {
+					return true
+				}
+			}
+			return false
+		})
+	}
+}
+
+func (ctrl *VMBackupController) handleVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmi, ok := obj.(*kubevirtv1.VirtualMachineInstance); ok {
+		ctrl.enqueueBackups(vmi.Namespace, func(vmBackup *backupv1.VirtualMachineBackup) bool {
+			return vmBackup.Spec.Source.Name == vmi.Name && !isTerminal(backupPhase(vmBackup.Status))
+		})
+	}
+}
+
+// enqueueBackups enqueues the VirtualMachineBackups in namespace which match
+func (ctrl *VMBackupController) enqueueBackups(namespace string, match func(*backupv1.VirtualMachineBackup) bool) {
+	objs, err := ctrl.VMBackupInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	for _, obj := range objs {
+		vmBackup := obj.(*backupv1.VirtualMachineBackup)
+		if match(vmBackup) {
+			ctrl.vmBackupQueue.Add(cacheKeyFunc(vmBackup.Namespace, vmBackup.Name))
+		}
+	}
+}
+
+// enqueueRestores enqueues the VirtualMachineBackupRestores in namespace which match
+func (ctrl *VMBackupController) enqueueRestores(namespace string, match func(*backupv1.VirtualMachineBackupRestore) bool) {
+	objs, err := ctrl.VMBackupRestoreInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	for _, obj := range objs {
+		vmBackupRestore := obj.(*backupv1.VirtualMachineBackupRestore)
+		if match(vmBackupRestore) {
+			ctrl.vmBackupRestoreQueue.Add(cacheKeyFunc(vmBackupRestore.Namespace, vmBackupRestore.Name))
+		}
+	}
+}
@@ -0,0 +1,724 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Backup", func() {
+	const (
+		testNamespace = metav1.NamespaceDefault
+		backupName    = "backup"
+		backupUID     = "abcdef-1234"
+		sourceVMName  = "source"
+		nodeName      = "node01"
+		pvcName       = "backups"
+		launcherImage = "kubevirt/virt-launcher:latest"
+	)
+
+	var timeStamp = metav1.Now()
+	var earlier = metav1.NewTime(timeStamp.Add(-time.Hour))
+
+	var ctrl *gomock.Controller
+	var virtClient *kubecli.MockKubevirtClient
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var kubevirtClient *kubevirtfake.Clientset
+	var k8sClient *k8sfake.Clientset
+	var vmBackupInformer cache.SharedIndexInformer
+	var vmBackupRestoreInformer cache.SharedIndexInformer
+	var podInformer cache.SharedIndexInformer
+	var pvcInformer cache.SharedIndexInformer
+	var vmiInformer cache.SharedIndexInformer
+	var recorder *record.FakeRecorder
+	var controller *VMBackupController
+
+	apiGroup := v1.GroupName
+
+	createBackup := func(name string, mode v1.BackupMode) *backupv1.VirtualMachineBackup {
+		vmBackup := &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				UID:       backupUID,
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     sourceVMName,
+				},
+				Mode: mode,
+			},
+		}
+		if mode != v1.BackupModePull {
+			claimName := pvcName
+			vmBackup.Spec.PVCName = &claimName
+		}
+		return vmBackup
+	}
+
+	// createSucceededBackup returns a push backup whose checkpoint chain starts with full
+	createSucceededBackup := func(name string, backupType backupv1.BackupType, chain ...string) *backupv1.VirtualMachineBackup {
+		vmBackup := createBackup(name, v1.BackupModePush)
+		claimName := pvcName
+		vmBackup.Status = &backupv1.VirtualMachineBackupStatus{
+			Phase:          backupv1.Succeeded,
+			Type:           backupType,
+			StartTime:      &earlier,
+			CompletionTime: &earlier,
+		}
+		for _, backup := range append(chain, name) {
+			vmBackup.Status.CheckpointChain = append(vmBackup.Status.CheckpointChain, backupv1.VirtualMachineBackupCheckpoint{
+				BackupName:        backup,
+				Name:              backup + "-uid",
+				CreationTimestamp: earlier,
+				PVCName:           &claimName,
+				Volumes:           []string{"rootdisk"},
+			})
+		}
+		return vmBackup
+	}
+
+	createVMI := func() *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              sourceVMName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(earlier.Add(-time.Hour)),
+			},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{
+					Devices: v1.Devices{
+						Disks: []v1.Disk{
+							{Name: "rootdisk", ChangedBlockTracking: true},
+							{Name: "cloudinit"},
+						},
+					},
+				},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: nodeName,
+				VolumeStatus: []v1.VolumeStatus{
+					{Name: "rootdisk", Target: "vda"},
+					{Name: "cloudinit", Target: "vdb"},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
+		vmBackupRestoreInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupRestore{})
+		podInformer, _ = testutils.NewFakeInformerFor(&corev1.Pod{})
+		pvcInformer, _ = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		recorder = record.NewFakeRecorder(100)
+		config, _, _, _ := testutils.NewFakeClusterConfig(&corev1.ConfigMap{})
+
+		controller = &VMBackupController{
+			Client:                  virtClient,
+			VMBackupInformer:        vmBackupInformer,
+			VMBackupRestoreInformer: vmBackupRestoreInformer,
+			PodInformer:             podInformer,
+			PVCInformer:             pvcInformer,
+			VMIInformer:             vmiInformer,
+			Recorder:                recorder,
+			ClusterConfig:           config,
+			LauncherImage:           launcherImage,
+		}
+		controller.Init()
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+
+		virtClient.EXPECT().VirtualMachineBackup(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineBackupRestore(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupRestores(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		currentTime = func() *metav1.Time {
+			return &timeStamp
+		}
+	})
+
+	addBackup := func(vmBackup *backupv1.VirtualMachineBackup) {
+		Expect(vmBackupInformer.GetIndexer().Add(vmBackup)).To(Succeed())
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Create(context.Background(), vmBackup, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	execute := func(vmBackup *backupv1.VirtualMachineBackup) *backupv1.VirtualMachineBackup {
+		Expect(controller.executeVMBackup(cacheKeyFunc(vmBackup.Namespace, vmBackup.Name))).To(Succeed())
+		updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(vmBackup.Namespace).Get(context.Background(), vmBackup.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updated
+	}
+
+	addRunningTargetPod := func(vmBackup *backupv1.VirtualMachineBackup) {
+		pod := controller.newBackupTargetPod(vmBackup, createVMI())
+		pod.UID = "target-pod-uid"
+		pod.Status.Phase = corev1.PodRunning
+		Expect(podInformer.GetIndexer().Add(pod)).To(Succeed())
+		_, err := k8sClient.CoreV1().Pods(testNamespace).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	expectCondition := func(conditions []backupv1.Condition, conditionType backupv1.ConditionType, status corev1.ConditionStatus, reason string) {
+		for _, condition := range conditions {
+			if condition.Type == conditionType {
+				Expect(condition.Status).To(Equal(status))
+				Expect(condition.Reason).To(ContainSubstring(reason))
+				return
+			}
+		}
+		Fail("condition " + string(conditionType) + " not found")
+	}
+
+	Context("VirtualMachineBackup", func() {
+		It("should wait for the VirtualMachineInstance to run", func() {
+			vmBackup := createBackup(backupName, v1.BackupModePush)
+			addBackup(vmBackup)
+
+			updated := execute(vmBackup)
+
+			Expect(updated.Finalizers).To(ContainElement(vmBackupFinalizer))
+			Expect(updated.Status.Phase).To(Equal(backupv1.Pending))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionFalse, "Waiting for VirtualMachineInstance source to run")
+		})
+
+		It("should fail without disks with changed block tracking", func() {
+			vmBackup := createBackup(backupName, v1.BackupModePush)
+			addBackup(vmBackup)
+			vmi := createVMI()
+			vmi.Spec.Domain.Devices.Disks[0].ChangedBlockTracking = false
+			Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+			updated := execute(vmBackup)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "does not have disks with changed block tracking")
+		})
+
+		It("should create the target pod of a push backup on the node of the VirtualMachineInstance", func() {
+			vmBackup := createBackup(backupName, v1.BackupModePush)
+			addBackup(vmBackup)
+			Expect(vmiInformer.GetIndexer().Add(createVMI())).To(Succeed())
+
+			updated := execute(vmBackup)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Pending))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionFalse, "Waiting for backup target pod")
+
+			pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), "virt-backup-backup", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Spec.NodeName).To(Equal(nodeName))
+			Expect(pod.Labels).To(HaveKeyWithValue(BackupNameLabel, backupName))
+			Expect(pod.OwnerReferences[0].Kind).To(Equal(virtualMachineBackupKind))
+			Expect(pod.Spec.Volumes).To(HaveLen(1))
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(pvcName))
+			Expect(pod.Spec.InitContainers[0].Command).To(Equal([]string{"mkdir", "-p", "/target/backup"}))
+			Expect(pod.Spec.InitContainers[0].Image).To(Equal(launcherImage))
+			Expect(*pod.Spec.AutomountServiceAccountToken).To(BeFalse())
+			testutils.ExpectEvent(recorder, backupTargetCreatedEvent)
+		})
+
+		It("should replace a target pod on another node", func() {
+			vmBackup := createBackup(backupName, v1.BackupModePush)
+			addBackup(vmBackup)
+			addRunningTargetPod(vmBackup)
+			vmi := createVMI()
+			vmi.Status.NodeName = "node02"
+			Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+			updated := execute(vmBackup)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Pending))
+			_, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), "virt-backup-backup", metav1.GetOptions{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should start a full push backup once the target pod runs", func() {
+			vmBackup := createBackup(backupName, v1.BackupModePush)
+			addBackup(vmBackup)
+			addRunningTargetPod(vmBackup)
+			Expect(vmiInformer.GetIndexer().Add(createVMI())).To(Succeed())
+
+			vmiInterface.EXPECT().Backup(sourceVMName, gomock.Any()).DoAndReturn(func(name string, options *v1.VirtualMachineInstanceBackupOptions) error {
+				Expect(options.BackupName).To(Equal(backupName))
+				Expect(options.Mode).To(Equal(v1.BackupModePush))
+				Expect(options.Checkpoint).To(Equal("backup-" + backupUID))
+				Expect(options.Checkpoints).To(BeEmpty())
+				Expect(options.Target).To(Equal(&v1.BackupTarget{ClaimName: pvcName, PodUID: "target-pod-uid"}))
+				return nil
+			})
+
+			updated := execute(vmBackup)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Running))
+			Expect(updated.Status.Type).To(Equal(backupv1.Full))
+			Expect(updated.Status.StartTime).ToNot(BeNil())
+			Expect(*updated.Status.Checkpoint).To(Equal("backup-" + backupUID))
+			Expect(updated.Status.Volumes).To(Equal([]backupv1.VirtualMachineBackupVolume{
+				{VolumeName: "rootdisk", DiskTarget: "vda", Path: "backup/rootdisk.qcow2"},
+			}))
+			Expect(updated.Status.CheckpointChain).To(HaveLen(1))
+			Expect(updated.Status.CheckpointChain[0].BackupName).To(Equal(backupName))
+			Expect(*updated.Status.CheckpointChain[0].PVCName).To(Equal(pvcName))
+			Expect(updated.Status.CheckpointChain[0].Volumes).To(Equal([]string{"rootdisk"}))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionProgressing, corev1.ConditionTrue, "Backup is running")
+			testutils.ExpectEvent(recorder, backupStartedEvent)
+		})
+
+		It("should start a pull backup without a target", func() {
+			vmBackup := createBackup(backupName, v1.BackupModePull)
+			addBackup(vmBackup)
+			Expect(vmiInformer.GetIndexer().Add(createVMI())).To(Succeed())
+
+			vmiInterface.EXPECT().Backup(sourceVMName, gomock.Any()).DoAndReturn(func(name string, options *v1.VirtualMachineInstanceBackupOptions) error {
+				Expect(options.Mode).To(Equal(v1.BackupModePull))
+				Expect(options.Target).To(BeNil())
+				return nil
+			})
+
+			updated := execute(vmBackup)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Running))
+			Expect(updated.Status.Volumes).To(Equal([]backupv1.VirtualMachineBackupVolume{
+				{VolumeName: "rootdisk", DiskTarget: "vda", ExportName: "rootdisk"},
+			}))
+			Expect(updated.Status.CheckpointChain[0].PVCName).To(BeNil())
+		})
+
+		It("should wait for another running backup of the source", func() {
+			running := createBackup("running", v1.BackupModePull)
+			running.Status = &backupv1.VirtualMachineBackupStatus{Phase: backupv1.Running}
+			addBackup(running)
+			vmBackup := createBackup(backupName, v1.BackupModePull)
+			addBackup(vmBackup)
+			Expect(vmiInformer.GetIndexer().Add(createVMI())).To(Succeed())
+
+			updated := execute(vmBackup)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Pending))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionFalse, "Waiting for backup running")
+		})
+
+		Context("incremental", func() {
+			var vmBackup *backupv1.VirtualMachineBackup
+
+			BeforeEach(func() {
+				vmBackup = createBackup(backupName, v1.BackupModePull)
+				previous := "previous"
+				vmBackup.Spec.IncrementalFrom = &previous
+				Expect(vmiInformer.GetIndexer().Add(createVMI())).To(Succeed())
+			})
+
+			It("should continue the checkpoint chain of the previous backup", func() {
+				addBackup(createSucceededBackup("previous", backupv1.Incremental, "full"))
+				addBackup(vmBackup)
+
+				vmiInterface.EXPECT().Backup(sourceVMName, gomock.Any()).DoAndReturn(func(name string, options *v1.VirtualMachineInstanceBackupOptions) error {
+					Expect(options.Checkpoints).To(HaveLen(2))
+					Expect(options.Checkpoints[0].Name).To(Equal("full-uid"))
+					Expect(options.Checkpoints[1].Name).To(Equal("previous-uid"))
+					Expect(options.Checkpoints[1].Volumes).To(Equal([]string{"rootdisk"}))
+					return nil
+				})
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Running))
+				Expect(updated.Status.Type).To(Equal(backupv1.Incremental))
+				Expect(updated.Status.CheckpointChain).To(HaveLen(3))
+				Expect(updated.Status.CheckpointChain[2].BackupName).To(Equal(backupName))
+			})
+
+			It("should wait for the previous backup to succeed", func() {
+				previous := createBackup("previous", v1.BackupModePush)
+				previous.Status = &backupv1.VirtualMachineBackupStatus{Phase: backupv1.Running}
+				addBackup(previous)
+				addBackup(vmBackup)
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Pending))
+				expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionFalse, "Waiting for backup previous")
+			})
+
+			It("should fail if the previous backup does not exist", func() {
+				addBackup(vmBackup)
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+				expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "VirtualMachineBackup previous does not exist")
+			})
+
+			It("should fail if a later full backup removed the checkpoints", func() {
+				addBackup(createSucceededBackup("previous", backupv1.Incremental, "full"))
+				later := createSucceededBackup("later", backupv1.Full)
+				later.Status.StartTime = &timeStamp
+				addBackup(later)
+				addBackup(vmBackup)
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+				expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "removed by the full backup later")
+			})
+
+			It("should fail if the changed blocks of a volume are not tracked since the previous backup", func() {
+				addBackup(createSucceededBackup("previous", backupv1.Full))
+				addBackup(vmBackup)
+				vmi := createVMI()
+				vmi.Spec.Domain.Devices.Disks[1].ChangedBlockTracking = true
+				Expect(vmiInformer.GetIndexer().Update(vmi)).To(Succeed())
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+				expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "volume cloudinit are not tracked")
+			})
+		})
+
+		Context("running", func() {
+			var vmBackup *backupv1.VirtualMachineBackup
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				vmBackup = createBackup(backupName, v1.BackupModePush)
+				checkpoint := "backup-" + backupUID
+				vmBackup.Finalizers = []string{vmBackupFinalizer}
+				vmBackup.Status = &backupv1.VirtualMachineBackupStatus{
+					Phase:      backupv1.Running,
+					Type:       backupv1.Full,
+					StartTime:  &earlier,
+					Checkpoint: &checkpoint,
+					CheckpointChain: []backupv1.VirtualMachineBackupCheckpoint{
+						{BackupName: backupName, Name: checkpoint, CreationTimestamp: earlier, Volumes: []string{"rootdisk"}},
+					},
+				}
+				vmi = createVMI()
+				vmi.Status.BackupState = &v1.VirtualMachineInstanceBackupState{
+					BackupName:     backupName,
+					Checkpoint:     checkpoint,
+					StartTimestamp: &earlier,
+				}
+			})
+
+			It("should wait for the backup to end", func() {
+				addBackup(vmBackup)
+				Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Running))
+			})
+
+			It("should succeed once the backup ended and delete the target pod", func() {
+				addBackup(vmBackup)
+				addRunningTargetPod(vmBackup)
+				vmi.Status.BackupState.Completed = true
+				vmi.Status.BackupState.EndTimestamp = &timeStamp
+				Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Succeeded))
+				Expect(updated.Status.CompletionTime).ToNot(BeNil())
+				Expect(updated.Status.CheckpointChain).To(HaveLen(1))
+				expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionTrue, "Backup completed")
+				_, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), "virt-backup-backup", metav1.GetOptions{})
+				Expect(errors.IsNotFound(err)).To(BeTrue())
+			})
+
+			It("should fail if the backup failed", func() {
+				addBackup(vmBackup)
+				vmi.Status.BackupState.Completed = true
+				vmi.Status.BackupState.Failed = true
+				vmi.Status.BackupState.Message = "no space left on device"
+				Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+				Expect(updated.Status.Checkpoint).To(BeNil())
+				Expect(updated.Status.CheckpointChain).To(BeEmpty())
+				expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "no space left on device")
+			})
+
+			It("should fail if the VirtualMachineInstance stopped", func() {
+				addBackup(vmBackup)
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+				expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "stopped during the backup")
+			})
+
+			It("should fail if the VirtualMachineInstance restarted", func() {
+				addBackup(vmBackup)
+				vmi.CreationTimestamp = timeStamp
+				vmi.Status.BackupState = nil
+				Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+			})
+
+			It("should export the disks of a pull backup until the ttl passed", func() {
+				vmBackup.Spec.Mode = v1.BackupModePull
+				vmBackup.Spec.PVCName = nil
+				vmBackup.Spec.TTLDuration = &metav1.Duration{Duration: 2 * time.Hour}
+				addBackup(vmBackup)
+				Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Running))
+				expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionTrue, "Disks are exported")
+			})
+
+			It("should abort a pull backup once the ttl passed", func() {
+				vmBackup.Spec.Mode = v1.BackupModePull
+				vmBackup.Spec.PVCName = nil
+				vmBackup.Spec.TTLDuration = &metav1.Duration{Duration: 30 * time.Minute}
+				addBackup(vmBackup)
+				Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+				vmiInterface.EXPECT().AbortBackup(sourceVMName, &v1.VirtualMachineInstanceAbortBackupOptions{BackupName: backupName}).Return(nil)
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Status.Phase).To(Equal(backupv1.Running))
+			})
+
+			It("should abort the backup and remove the finalizer when deleted", func() {
+				vmBackup.DeletionTimestamp = &timeStamp
+				addBackup(vmBackup)
+				Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+
+				vmiInterface.EXPECT().AbortBackup(sourceVMName, &v1.VirtualMachineInstanceAbortBackupOptions{BackupName: backupName}).Return(nil)
+
+				updated := execute(vmBackup)
+
+				Expect(updated.Finalizers).To(BeEmpty())
+			})
+		})
+	})
+
+	Context("VirtualMachineBackupRestore", func() {
+		createRestore := func() *backupv1.VirtualMachineBackupRestore {
+			return &backupv1.VirtualMachineBackupRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "restore",
+					Namespace: testNamespace,
+					UID:       "restore-uid",
+				},
+				Spec: backupv1.VirtualMachineBackupRestoreSpec{
+					BackupName: backupName,
+					Volumes: []backupv1.VirtualMachineBackupRestoreVolume{
+						{VolumeName: "rootdisk", PVCName: "restored"},
+					},
+				},
+			}
+		}
+
+		createPVC := func(name string, volumeMode corev1.PersistentVolumeMode) *corev1.PersistentVolumeClaim {
+			return &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: testNamespace,
+				},
+				Spec: corev1.PersistentVolumeClaimSpec{
+					VolumeMode: &volumeMode,
+				},
+			}
+		}
+
+		addRestore := func(vmBackupRestore *backupv1.VirtualMachineBackupRestore) {
+			Expect(vmBackupRestoreInformer.GetIndexer().Add(vmBackupRestore)).To(Succeed())
+			_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupRestores(testNamespace).Create(context.Background(), vmBackupRestore, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		executeRestore := func(vmBackupRestore *backupv1.VirtualMachineBackupRestore) *backupv1.VirtualMachineBackupRestore {
+			Expect(controller.executeVMBackupRestore(cacheKeyFunc(vmBackupRestore.Namespace, vmBackupRestore.Name))).To(Succeed())
+			updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupRestores(vmBackupRestore.Namespace).Get(context.Background(), vmBackupRestore.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return updated
+		}
+
+		addRestorePod := func(vmBackupRestore *backupv1.VirtualMachineBackupRestore, phase corev1.PodPhase, statuses ...corev1.ContainerStatus) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      restoreResourceName(vmBackupRestore),
+					Namespace: testNamespace,
+				},
+				Status: corev1.PodStatus{
+					Phase:             phase,
+					ContainerStatuses: statuses,
+				},
+			}
+			Expect(podInformer.GetIndexer().Add(pod)).To(Succeed())
+		}
+
+		It("should wait for the backup to succeed", func() {
+			vmBackup := createBackup(backupName, v1.BackupModePush)
+			vmBackup.Status = &backupv1.VirtualMachineBackupStatus{Phase: backupv1.Running}
+			addBackup(vmBackup)
+			vmBackupRestore := createRestore()
+			addRestore(vmBackupRestore)
+
+			updated := executeRestore(vmBackupRestore)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Pending))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionFalse, "Waiting for backup backup to succeed")
+		})
+
+		It("should wait for the target claim", func() {
+			addBackup(createSucceededBackup(backupName, backupv1.Full))
+			vmBackupRestore := createRestore()
+			addRestore(vmBackupRestore)
+
+			updated := executeRestore(vmBackupRestore)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Pending))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionFalse, "Waiting for PersistentVolumeClaim restored")
+		})
+
+		It("should fail if the checkpoint chain contains a pull backup", func() {
+			vmBackup := createSucceededBackup(backupName, backupv1.Incremental, "full")
+			vmBackup.Status.CheckpointChain[0].PVCName = nil
+			addBackup(vmBackup)
+			vmBackupRestore := createRestore()
+			addRestore(vmBackupRestore)
+
+			updated := executeRestore(vmBackupRestore)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "backup full of the checkpoint chain is a pull backup")
+		})
+
+		It("should fail if a volume is not part of the backup", func() {
+			addBackup(createSucceededBackup(backupName, backupv1.Full))
+			vmBackupRestore := createRestore()
+			vmBackupRestore.Spec.Volumes[0].VolumeName = "datadisk"
+			addRestore(vmBackupRestore)
+
+			updated := executeRestore(vmBackupRestore)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "volume datadisk is not part of backup")
+		})
+
+		It("should create the restore pod, layering the incremental backups over the full backup", func() {
+			addBackup(createSucceededBackup(backupName, backupv1.Incremental, "full"))
+			vmBackupRestore := createRestore()
+			vmBackupRestore.Spec.Volumes = append(vmBackupRestore.Spec.Volumes, backupv1.VirtualMachineBackupRestoreVolume{
+				VolumeName: "rootdisk", PVCName: "restored-block",
+			})
+			addRestore(vmBackupRestore)
+			Expect(pvcInformer.GetIndexer().Add(createPVC("restored", corev1.PersistentVolumeFilesystem))).To(Succeed())
+			Expect(pvcInformer.GetIndexer().Add(createPVC("restored-block", corev1.PersistentVolumeBlock))).To(Succeed())
+
+			updated := executeRestore(vmBackupRestore)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Running))
+			Expect(updated.Status.StartTime).ToNot(BeNil())
+			testutils.ExpectEvent(recorder, restorePodCreatedEvent)
+
+			pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), "virt-backup-restore-restore", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pod.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+			Expect(pod.Spec.Volumes).To(HaveLen(3))
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(pvcName))
+			Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+
+			imageSpec := `json:{"backing":{"backing":null,"driver":"qcow2","file":{"driver":"file","filename":"/backups/backups/full/rootdisk.qcow2"}},` +
+				`"driver":"qcow2","file":{"driver":"file","filename":"/backups/backups/backup/rootdisk.qcow2"}}`
+			Expect(pod.Spec.Containers).To(HaveLen(2))
+			Expect(pod.Spec.Containers[0].Command).To(Equal([]string{
+				"qemu-img", "convert", "-O", "raw", imageSpec, "/restore-volumes/rootdisk/disk.img",
+			}))
+			Expect(pod.Spec.Containers[0].VolumeMounts).To(HaveLen(2))
+			Expect(pod.Spec.Containers[1].Command).To(Equal([]string{
+				"qemu-img", "convert", "-O", "raw", "-n", imageSpec, "/dev/restore-volumes/rootdisk",
+			}))
+			Expect(pod.Spec.Containers[1].VolumeDevices[0].DevicePath).To(Equal("/dev/restore-volumes/rootdisk"))
+		})
+
+		It("should succeed once the restore pod succeeded", func() {
+			vmBackupRestore := createRestore()
+			vmBackupRestore.Status = &backupv1.VirtualMachineBackupRestoreStatus{Phase: backupv1.Running}
+			addRestore(vmBackupRestore)
+			addRestorePod(vmBackupRestore, corev1.PodSucceeded)
+
+			updated := executeRestore(vmBackupRestore)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Succeeded))
+			Expect(updated.Status.CompletionTime).ToNot(BeNil())
+			expectCondition(updated.Status.Conditions, backupv1.ConditionReady, corev1.ConditionTrue, "Restore completed")
+		})
+
+		It("should fail once the restore pod failed", func() {
+			vmBackupRestore := createRestore()
+			vmBackupRestore.Status = &backupv1.VirtualMachineBackupRestoreStatus{Phase: backupv1.Running}
+			addRestore(vmBackupRestore)
+			addRestorePod(vmBackupRestore, corev1.PodFailed, corev1.ContainerStatus{
+				Name: "restore-0",
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 1},
+				},
+			})
+
+			updated := executeRestore(vmBackupRestore)
+
+			Expect(updated.Status.Phase).To(Equal(backupv1.Failed))
+			expectCondition(updated.Status.Conditions, backupv1.ConditionFailure, corev1.ConditionTrue, "restore-0: exit code 1")
+		})
+	})
+})
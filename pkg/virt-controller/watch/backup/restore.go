@@ -0,0 +1,412 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	"kubevirt.io/client-go/log"
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+)
+
+const (
+	// BackupRestoreNameLabel selects the restore pod of a VirtualMachineBackupRestore
+	BackupRestoreNameLabel = "backup.kubevirt.io/backup-restore-name"
+
+	restorePrefix = "virt-backup-restore"
+
+	restorePodCreatedEvent = "BackupRestorePodCreated"
+
+	restoreErrorEvent = "VirtualMachineBackupRestoreError"
+
+	virtualMachineBackupRestoreKind = "VirtualMachineBackupRestore"
+
+	backupsDir = "/backups"
+
+	restoreVolumesDir = "/restore-volumes"
+
+	blockRestoreVolumesDir = "/dev/restore-volumes"
+
+	diskImageName = "disk.img"
+)
+
+// restoreVolume is a backed up volume and the claim it is restored to
+type restoreVolume struct {
+	name string
+	pvc  *corev1.PersistentVolumeClaim
+	// images are the backup images of the volume, relative to the backups dir, the full backup first
+	images []string
+}
+
+// restoreResourceName is the name of the restore pod of vmBackupRestore
+func restoreResourceName(vmBackupRestore *backupv1.VirtualMachineBackupRestore) string {
+	return fmt.Sprintf("%s-%s", restorePrefix, vmBackupRestore.Name)
+}
+
+func (ctrl *VMBackupController) updateVMBackupRestore(vmBackupRestoreIn *backupv1.VirtualMachineBackupRestore) error {
+	logger := log.Log.Object(vmBackupRestoreIn)
+
+	logger.V(1).Infof("Updating VirtualMachineBackupRestore")
+
+	if vmBackupRestoreIn.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmBackupRestoreOut := vmBackupRestoreIn.DeepCopy()
+	if vmBackupRestoreOut.Status == nil {
+		vmBackupRestoreOut.Status = &backupv1.VirtualMachineBackupRestoreStatus{
+			Phase: backupv1.Pending,
+		}
+	}
+
+	if err := ctrl.reconcileVMBackupRestore(vmBackupRestoreOut); err != nil {
+		logger.Reason(err).Error("Error restoring VirtualMachineBackup")
+		return ctrl.doUpdateRestoreError(vmBackupRestoreIn, vmBackupRestoreOut, err)
+	}
+
+	return ctrl.doUpdateRestore(vmBackupRestoreIn, vmBackupRestoreOut)
+}
+
+func (ctrl *VMBackupController) reconcileVMBackupRestore(vmBackupRestore *backupv1.VirtualMachineBackupRestore) error {
+	if isTerminal(vmBackupRestore.Status.Phase) {
+		return nil
+	}
+
+	pod, err := ctrl.getPod(vmBackupRestore.Namespace, restoreResourceName(vmBackupRestore))
+	if err != nil {
+		return err
+	}
+
+	if pod == nil {
+		volumes, ok, err := ctrl.resolveRestoreVolumes(vmBackupRestore)
+		if err != nil || !ok {
+			return err
+		}
+
+		pod, err = ctrl.newRestorePod(vmBackupRestore, volumes)
+		if err != nil {
+			return err
+		}
+		if _, err = ctrl.Client.CoreV1().Pods(vmBackupRestore.Namespace).Create(context.Background(), pod, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+
+		ctrl.Recorder.Eventf(vmBackupRestore, corev1.EventTypeNormal, restorePodCreatedEvent, "Created restore pod %s", pod.Name)
+
+		vmBackupRestore.Status.Phase = backupv1.Running
+		vmBackupRestore.Status.StartTime = currentTime()
+		updateCondition(&vmBackupRestore.Status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionTrue, "Restore is running"))
+		updateCondition(&vmBackupRestore.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionFalse, "Restore is running"))
+		return nil
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		vmBackupRestore.Status.Phase = backupv1.Succeeded
+		vmBackupRestore.Status.CompletionTime = currentTime()
+		updateCondition(&vmBackupRestore.Status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionFalse, "Restore completed"))
+		updateCondition(&vmBackupRestore.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionTrue, "Restore completed"))
+	case corev1.PodFailed:
+		setRestoreFailed(vmBackupRestore, fmt.Sprintf("restore pod %s failed%s", pod.Name, terminationMessages(pod)))
+	default:
+		vmBackupRestore.Status.Phase = backupv1.Running
+	}
+
+	return nil
+}
+
+// resolveRestoreVolumes returns the volumes restored by vmBackupRestore. It returns false, and sets the phase
+// of vmBackupRestore, if they can not be restored yet or at all.
+func (ctrl *VMBackupController) resolveRestoreVolumes(vmBackupRestore *backupv1.VirtualMachineBackupRestore) ([]restoreVolume, bool, error) {
+	backupName := vmBackupRestore.Spec.BackupName
+
+	vmBackup, err := ctrl.getVMBackup(vmBackupRestore.Namespace, backupName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if vmBackup == nil {
+		setRestoreFailed(vmBackupRestore, fmt.Sprintf("VirtualMachineBackup %s does not exist", backupName))
+		return nil, false, nil
+	}
+
+	switch backupPhase(vmBackup.Status) {
+	case backupv1.Succeeded:
+	case backupv1.Failed:
+		setRestoreFailed(vmBackupRestore, fmt.Sprintf("VirtualMachineBackup %s failed", backupName))
+		return nil, false, nil
+	default:
+		setRestorePending(vmBackupRestore, fmt.Sprintf("Waiting for backup %s to succeed", backupName))
+		return nil, false, nil
+	}
+
+	chain := vmBackup.Status.CheckpointChain
+	for _, checkpoint := range chain {
+		if checkpoint.PVCName == nil {
+			setRestoreFailed(vmBackupRestore, fmt.Sprintf("backup %s of the checkpoint chain is a pull backup, which can not be restored", checkpoint.BackupName))
+			return nil, false, nil
+		}
+	}
+
+	var volumes []restoreVolume
+	for _, volume := range vmBackupRestore.Spec.Volumes {
+		var images []string
+		for _, checkpoint := range chain {
+			if !containsString(checkpoint.Volumes, volume.VolumeName) {
+				setRestoreFailed(vmBackupRestore, fmt.Sprintf("volume %s is not part of backup %s", volume.VolumeName, checkpoint.BackupName))
+				return nil, false, nil
+			}
+			images = append(images, fmt.Sprintf("%s/%s", *checkpoint.PVCName, hotplugdisk.GetBackupVolumePath(checkpoint.BackupName, volume.VolumeName)))
+		}
+
+		pvc, err := ctrl.getPVC(vmBackupRestore.Namespace, volume.PVCName)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if pvc == nil {
+			setRestorePending(vmBackupRestore, fmt.Sprintf("Waiting for PersistentVolumeClaim %s", volume.PVCName))
+			return nil, false, nil
+		}
+
+		volumes = append(volumes, restoreVolume{
+			name:   volume.VolumeName,
+			pvc:    pvc,
+			images: images,
+		})
+	}
+
+	return volumes, true, nil
+}
+
+// newRestorePod returns the pod which restores the volumes. Each of its containers writes one volume to its claim
+// as a raw disk image, converted from the chain of its backup images.
+func (ctrl *VMBackupController) newRestorePod(vmBackupRestore *backupv1.VirtualMachineBackupRestore, volumes []restoreVolume) (*corev1.Pod, error) {
+	qemuID := int64(107)
+	nonRoot := true
+	automount := false
+
+	var containers []corev1.Container
+	var podVolumes []corev1.Volume
+	backupVolumeNames := map[string]string{}
+
+	for i, volume := range volumes {
+		container := corev1.Container{
+			Name:            fmt.Sprintf("restore-%d", i),
+			Image:           ctrl.LauncherImage,
+			ImagePullPolicy: ctrl.ClusterConfig.GetImagePullPolicy(),
+		}
+
+		var images []string
+		mounted := map[string]bool{}
+		for _, image := range volume.images {
+			claimName := strings.SplitN(image, "/", 2)[0]
+			// claim names may contain dots which are not allowed in pod volume names
+			volumeName, ok := backupVolumeNames[claimName]
+			if !ok {
+				volumeName = fmt.Sprintf("backup-%d", len(backupVolumeNames))
+				backupVolumeNames[claimName] = volumeName
+				podVolumes = append(podVolumes, corev1.Volume{
+					Name: volumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: claimName,
+							ReadOnly:  true,
+						},
+					},
+				})
+			}
+			if !mounted[claimName] {
+				mounted[claimName] = true
+				container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+					Name:      volumeName,
+					MountPath: fmt.Sprintf("%s/%s", backupsDir, claimName),
+					ReadOnly:  true,
+				})
+			}
+			images = append(images, fmt.Sprintf("%s/%s", backupsDir, image))
+		}
+
+		imageSpec, err := restoreImageSpec(images)
+		if err != nil {
+			return nil, err
+		}
+
+		targetVolumeName := fmt.Sprintf("target-%d", i)
+		container.Command = []string{"qemu-img", "convert", "-O", "raw"}
+		if isBlock(volume.pvc) {
+			devicePath := fmt.Sprintf("%s/%s", blockRestoreVolumesDir, volume.name)
+			container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{
+				Name:       targetVolumeName,
+				DevicePath: devicePath,
+			})
+			// the block device exists already
+			container.Command = append(container.Command, "-n", imageSpec, devicePath)
+		} else {
+			mountPath := fmt.Sprintf("%s/%s", restoreVolumesDir, volume.name)
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      targetVolumeName,
+				MountPath: mountPath,
+			})
+			container.Command = append(container.Command, imageSpec, fmt.Sprintf("%s/%s", mountPath, diskImageName))
+		}
+
+		podVolumes = append(podVolumes, corev1.Volume{
+			Name: targetVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: volume.pvc.Name,
+				},
+			},
+		})
+		containers = append(containers, container)
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            restoreResourceName(vmBackupRestore),
+			Namespace:       vmBackupRestore.Namespace,
+			Labels:          map[string]string{BackupRestoreNameLabel: vmBackupRestore.Name},
+			OwnerReferences: []metav1.OwnerReference{ownerRef(vmBackupRestore.Name, vmBackupRestore.UID, virtualMachineBackupRestoreKind)},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:                corev1.RestartPolicyNever,
+			AutomountServiceAccountToken: &automount,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsUser:    &qemuID,
+				RunAsGroup:   &qemuID,
+				FSGroup:      &qemuID,
+				RunAsNonRoot: &nonRoot,
+			},
+			Containers: containers,
+			Volumes:    podVolumes,
+		},
+	}, nil
+}
+
+// restoreImageSpec describes the backup images of a volume to qemu-img. The image of every incremental backup
+// only holds the blocks which changed, so it is layered over the image of the backup before it.
+func restoreImageSpec(images []string) (string, error) {
+	var spec map[string]interface{}
+	for _, image := range images {
+		layer := map[string]interface{}{
+			"driver": "qcow2",
+			"file": map[string]interface{}{
+				"driver":   "file",
+				"filename": image,
+			},
+			"backing": nil,
+		}
+		if spec != nil {
+			layer["backing"] = spec
+		}
+		spec = layer
+	}
+
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+
+	return "json:" + string(b), nil
+}
+
+func (ctrl *VMBackupController) doUpdateRestoreError(original, updated *backupv1.VirtualMachineBackupRestore, err error) error {
+	ctrl.Recorder.Eventf(
+		original,
+		corev1.EventTypeWarning,
+		restoreErrorEvent,
+		"VirtualMachineBackupRestore encountered error %s",
+		err.Error(),
+	)
+
+	updateCondition(&updated.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionFalse, err.Error()))
+	if err2 := ctrl.doUpdateRestore(original, updated); err2 != nil {
+		return err2
+	}
+
+	return err
+}
+
+func (ctrl *VMBackupController) doUpdateRestore(original, updated *backupv1.VirtualMachineBackupRestore) error {
+	if !reflect.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineBackupRestore(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// terminationMessages returns why the containers of pod failed
+func terminationMessages(pod *corev1.Pod) string {
+	var messages []string
+	for _, status := range pod.Status.ContainerStatuses {
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			message := strings.TrimSpace(terminated.Message)
+			if message == "" {
+				message = fmt.Sprintf("exit code %d", terminated.ExitCode)
+			}
+			messages = append(messages, fmt.Sprintf("%s: %s", status.Name, message))
+		}
+	}
+
+	if len(messages) == 0 {
+		return ""
+	}
+
+	return ": " + strings.Join(messages, ", ")
+}
+
+func isBlock(pvc *corev1.PersistentVolumeClaim) bool {
+	return pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func setRestorePending(vmBackupRestore *backupv1.VirtualMachineBackupRestore, reason string) {
+	vmBackupRestore.Status.Phase = backupv1.Pending
+	updateCondition(&vmBackupRestore.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionFalse, reason))
+}
+
+func setRestoreFailed(vmBackupRestore *backupv1.VirtualMachineBackupRestore, reason string) {
+	vmBackupRestore.Status.Phase = backupv1.Failed
+	vmBackupRestore.Status.CompletionTime = currentTime()
+	updateCondition(&vmBackupRestore.Status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionFalse, reason))
+	updateCondition(&vmBackupRestore.Status.Conditions, newCondition(backupv1.ConditionReady, corev1.ConditionFalse, reason))
+	updateCondition(&vmBackupRestore.Status.Conditions, newCondition(backupv1.ConditionFailure, corev1.ConditionTrue, reason))
+}
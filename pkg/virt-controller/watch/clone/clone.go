@@ -0,0 +1,699 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package clone
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/snapshot"
+)
+
+const (
+	cloneNameAnnotation = "clone.kubevirt.io/name"
+
+	cloneUIDAnnotation = "clone.kubevirt.io/uid"
+
+	defaultNameTemplate = "{{.SourceName}}-clone-{{.UniqueSuffix}}"
+
+	snapshotCreatedEvent = "SnapshotCreated"
+
+	targetVMCreatedEvent = "TargetVMCreated"
+
+	cloneSucceededEvent = "CloneSucceeded"
+
+	cloneFailedEvent = "CloneFailed"
+
+	cloneErrorEvent = "CloneError"
+
+	virtualMachineKind = "VirtualMachine"
+
+	virtualMachineSnapshotKind = "VirtualMachineSnapshot"
+)
+
+// nameTemplateData holds the values which can be referenced in the name template of a clone
+type nameTemplateData struct {
+	SourceName   string
+	CloneName    string
+	UniqueSuffix string
+}
+
+// variable so can be overridden in tests
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+func cacheKeyFunc(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+func tmpSnapshotName(vmClone *clonev1.VirtualMachineClone) string {
+	return fmt.Sprintf("tmp-clone-%s", vmClone.UID)
+}
+
+func restorePVCName(vmClone *clonev1.VirtualMachineClone, volumeName string) string {
+	return fmt.Sprintf("clone-%s-%s", vmClone.UID, volumeName)
+}
+
+func (ctrl *VMCloneController) updateVMClone(vmCloneIn *clonev1.VirtualMachineClone) error {
+	logger := log.Log.Object(vmCloneIn)
+
+	logger.V(1).Infof("Updating VirtualMachineClone")
+
+	vmCloneOut := vmCloneIn.DeepCopy()
+
+	var err error
+	switch vmCloneOut.Status.Phase {
+	case clonev1.PhaseUnset:
+		err = ctrl.initialize(vmCloneOut)
+	case clonev1.SnapshotInProgress:
+		err = ctrl.waitForSnapshot(vmCloneOut)
+	case clonev1.CreatingTargetVM:
+		err = ctrl.createTargetVM(vmCloneOut)
+	case clonev1.RestoreInProgress:
+		err = ctrl.restoreVolumes(vmCloneOut)
+	case clonev1.Succeeded:
+		err = ctrl.cleanup(vmCloneOut)
+	}
+
+	if err != nil {
+		logger.Reason(err).Error("Error cloning VirtualMachine")
+		return ctrl.doUpdateError(vmCloneIn, err)
+	}
+
+	return ctrl.doUpdate(vmCloneIn, vmCloneOut)
+}
+
+func (ctrl *VMCloneController) initialize(vmClone *clonev1.VirtualMachineClone) error {
+	var snapshotName string
+
+	switch vmClone.Spec.Source.Kind {
+	case virtualMachineKind:
+		snapshotName = tmpSnapshotName(vmClone)
+		if err := ctrl.createSnapshot(vmClone, snapshotName); err != nil {
+			return err
+		}
+	case virtualMachineSnapshotKind:
+		snapshotName = vmClone.Spec.Source.Name
+	default:
+		ctrl.fail(vmClone, fmt.Sprintf("unsupported source kind %q", vmClone.Spec.Source.Kind))
+		return nil
+	}
+
+	vmClone.Status.CreationTime = currentTime()
+	vmClone.Status.SnapshotName = &snapshotName
+	vmClone.Status.Phase = clonev1.SnapshotInProgress
+	updateProgress(vmClone, "Waiting for VirtualMachineSnapshot")
+
+	return nil
+}
+
+func (ctrl *VMCloneController) createSnapshot(vmClone *clonev1.VirtualMachineClone, name string) error {
+	_, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(vmClone.Namespace, name))
+	if err != nil || exists {
+		return err
+	}
+
+	apiGroup := kubevirtv1.GroupName
+	vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       vmClone.Namespace,
+			OwnerReferences: []metav1.OwnerReference{ownerRef(vmClone)},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     virtualMachineKind,
+				Name:     vmClone.Spec.Source.Name,
+			},
+		},
+	}
+
+	_, err = ctrl.Client.VirtualMachineSnapshot(vmClone.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	ctrl.Recorder.Eventf(vmClone, corev1.EventTypeNormal, snapshotCreatedEvent, "Created VirtualMachineSnapshot %s", name)
+
+	return nil
+}
+
+func (ctrl *VMCloneController) waitForSnapshot(vmClone *clonev1.VirtualMachineClone) error {
+	vmSnapshot, err := ctrl.getVMSnapshot(vmClone)
+	if err != nil {
+		return err
+	}
+
+	if vmSnapshot.Status == nil || vmSnapshot.Status.ReadyToUse == nil || !*vmSnapshot.Status.ReadyToUse {
+		updateProgress(vmClone, "Waiting for VirtualMachineSnapshot to be ready")
+		return nil
+	}
+
+	vmClone.Status.Phase = clonev1.CreatingTargetVM
+	updateProgress(vmClone, "Creating target VirtualMachine")
+
+	return nil
+}
+
+func (ctrl *VMCloneController) createTargetVM(vmClone *clonev1.VirtualMachineClone) error {
+	content, err := ctrl.getSnapshotContent(vmClone)
+	if err != nil {
+		return err
+	}
+
+	sourceVM := content.Spec.Source.VirtualMachine
+	if sourceVM == nil {
+		ctrl.fail(vmClone, "VirtualMachineSnapshot has no VirtualMachine source")
+		return nil
+	}
+
+	// the name is stored before the target is created so that it stays
+	// the same if the creation has to be retried
+	if vmClone.Status.TargetName == nil {
+		name, err := targetName(vmClone, sourceVM.Name)
+		if err != nil {
+			ctrl.fail(vmClone, err.Error())
+			return nil
+		}
+
+		vmClone.Status.TargetName = &name
+		return nil
+	}
+
+	vm, err := ctrl.getVM(vmClone.Namespace, *vmClone.Status.TargetName)
+	if err != nil {
+		return err
+	}
+
+	if vm != nil {
+		if vm.Annotations[cloneUIDAnnotation] != string(vmClone.UID) {
+			ctrl.fail(vmClone, fmt.Sprintf("target VirtualMachine %s already exists", vm.Name))
+			return nil
+		}
+	} else {
+		vm = newTargetVM(vmClone, sourceVM, content.Spec.VolumeBackups)
+		if _, err = ctrl.Client.VirtualMachine(vmClone.Namespace).Create(vm); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+
+		ctrl.Recorder.Eventf(vmClone, corev1.EventTypeNormal, targetVMCreatedEvent, "Created target VirtualMachine %s", vm.Name)
+	}
+
+	vmClone.Status.Phase = clonev1.RestoreInProgress
+	updateProgress(vmClone, "Restoring volumes")
+
+	return nil
+}
+
+func (ctrl *VMCloneController) restoreVolumes(vmClone *clonev1.VirtualMachineClone) error {
+	content, err := ctrl.getSnapshotContent(vmClone)
+	if err != nil {
+		return err
+	}
+
+	vm, err := ctrl.getVM(vmClone.Namespace, *vmClone.Status.TargetName)
+	if err != nil {
+		return err
+	}
+
+	if vm == nil {
+		updateProgress(vmClone, "Waiting for target VirtualMachine")
+		return nil
+	}
+
+	createdPVC := false
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		pvcName := restorePVCName(vmClone, volumeBackup.VolumeName)
+		pvc, err := ctrl.getPVC(vmClone.Namespace, pvcName)
+		if err != nil {
+			return err
+		}
+
+		if pvc == nil {
+			if err = ctrl.createRestorePVC(vmClone, vm, volumeBackup, pvcName); err != nil {
+				return err
+			}
+			createdPVC = true
+		} else if pvc.Status.Phase == corev1.ClaimLost {
+			return fmt.Errorf("PVC %s/%s in status %q", pvc.Namespace, pvc.Name, pvc.Status.Phase)
+		}
+	}
+
+	if createdPVC {
+		updateProgress(vmClone, "Waiting for restored volumes")
+		return nil
+	}
+
+	// the target was created halted, now that its volumes exist it
+	// gets the run strategy of the source
+	sourceVM := content.Spec.Source.VirtualMachine
+	if !equality.Semantic.DeepEqual(vm.Spec.Running, sourceVM.Spec.Running) ||
+		!equality.Semantic.DeepEqual(vm.Spec.RunStrategy, sourceVM.Spec.RunStrategy) {
+		vm.Spec.Running = sourceVM.Spec.Running
+		vm.Spec.RunStrategy = sourceVM.Spec.RunStrategy
+		if _, err = ctrl.Client.VirtualMachine(vm.Namespace).Update(vm); err != nil {
+			return err
+		}
+	}
+
+	ctrl.Recorder.Eventf(vmClone, corev1.EventTypeNormal, cloneSucceededEvent, "Successfully cloned to VirtualMachine %s", vm.Name)
+
+	vmClone.Status.Phase = clonev1.Succeeded
+	updateCondition(vmClone, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	updateCondition(vmClone, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+
+	return nil
+}
+
+func (ctrl *VMCloneController) createRestorePVC(vmClone *clonev1.VirtualMachineClone, vm *kubevirtv1.VirtualMachine, volumeBackup snapshotv1.VolumeBackup, name string) error {
+	pvc, err := snapshot.CreateRestorePVCDef(name, volumeBackup)
+	if err != nil {
+		return err
+	}
+
+	pvc.Annotations[cloneNameAnnotation] = vmClone.Name
+	// the restored volumes are tied to the life-cycle of the target
+	t := true
+	pvc.OwnerReferences = []metav1.OwnerReference{
+		{
+			APIVersion:         kubevirtv1.GroupVersion.String(),
+			Kind:               virtualMachineKind,
+			Name:               vm.Name,
+			UID:                vm.UID,
+			Controller:         &t,
+			BlockOwnerDeletion: &t,
+		},
+	}
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmClone.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// cleanup removes the temporary snapshot of a source VirtualMachine once
+// all restored volumes are bound and no longer need it
+func (ctrl *VMCloneController) cleanup(vmClone *clonev1.VirtualMachineClone) error {
+	if vmClone.Spec.Source.Kind != virtualMachineKind || vmClone.Status.SnapshotName == nil {
+		return nil
+	}
+
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(vmClone.Namespace, *vmClone.Status.SnapshotName))
+	if err != nil || !exists {
+		return err
+	}
+
+	vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if vmSnapshot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	content, err := ctrl.getSnapshotContent(vmClone)
+	if err != nil {
+		return err
+	}
+
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		pvc, err := ctrl.getPVC(vmClone.Namespace, restorePVCName(vmClone, volumeBackup.VolumeName))
+		if err != nil {
+			return err
+		}
+
+		if pvc == nil || pvc.Status.Phase != corev1.ClaimBound {
+			return nil
+		}
+	}
+
+	err = ctrl.Client.VirtualMachineSnapshot(vmClone.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (ctrl *VMCloneController) fail(vmClone *clonev1.VirtualMachineClone, reason string) {
+	ctrl.Recorder.Eventf(vmClone, corev1.EventTypeWarning, cloneFailedEvent, "VirtualMachineClone failed: %s", reason)
+
+	vmClone.Status.Phase = clonev1.Failed
+	updateCondition(vmClone, newProgressingCondition(corev1.ConditionFalse, reason))
+	updateCondition(vmClone, newReadyCondition(corev1.ConditionFalse, reason))
+}
+
+func (ctrl *VMCloneController) doUpdateError(vmClone *clonev1.VirtualMachineClone, err error) error {
+	ctrl.Recorder.Eventf(
+		vmClone,
+		corev1.EventTypeWarning,
+		cloneErrorEvent,
+		"VirtualMachineClone encountered error %s",
+		err.Error(),
+	)
+
+	updated := vmClone.DeepCopy()
+
+	updateCondition(updated, newProgressingCondition(corev1.ConditionFalse, err.Error()))
+	updateCondition(updated, newReadyCondition(corev1.ConditionFalse, err.Error()))
+	if err2 := ctrl.doUpdate(vmClone, updated); err2 != nil {
+		return err2
+	}
+
+	return err
+}
+
+func (ctrl *VMCloneController) doUpdate(original, updated *clonev1.VirtualMachineClone) error {
+	if !reflect.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineClone(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMCloneController) getVMSnapshot(vmClone *clonev1.VirtualMachineClone) (*snapshotv1.VirtualMachineSnapshot, error) {
+	objKey := cacheKeyFunc(vmClone.Namespace, *vmClone.Status.SnapshotName)
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(objKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("VMSnapshot %s does not exist", objKey)
+	}
+
+	return obj.(*snapshotv1.VirtualMachineSnapshot).DeepCopy(), nil
+}
+
+func (ctrl *VMCloneController) getSnapshotContent(vmClone *clonev1.VirtualMachineClone) (*snapshotv1.VirtualMachineSnapshotContent, error) {
+	vmSnapshot, err := ctrl.getVMSnapshot(vmClone)
+	if err != nil {
+		return nil, err
+	}
+
+	if vmSnapshot.Status == nil || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return nil, fmt.Errorf("no snapshot content name in %s/%s", vmSnapshot.Namespace, vmSnapshot.Name)
+	}
+
+	objKey := cacheKeyFunc(vmClone.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName)
+	obj, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(objKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("VMSnapshotContent %s does not exist", objKey)
+	}
+
+	return obj.(*snapshotv1.VirtualMachineSnapshotContent).DeepCopy(), nil
+}
+
+func (ctrl *VMCloneController) getVM(namespace, name string) (*kubevirtv1.VirtualMachine, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
+}
+
+func (ctrl *VMCloneController) getPVC(namespace, name string) (*corev1.PersistentVolumeClaim, error) {
+	obj, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*corev1.PersistentVolumeClaim).DeepCopy(), nil
+}
+
+// newTargetVM returns the VirtualMachine created by vmClone from the snapshotted sourceVM.
+// Volumes with a backup are replaced by the PVCs restored from it, the interfaces get new
+// MAC addresses and the firmware UUID is dropped so that it is derived from the new name.
+func newTargetVM(vmClone *clonev1.VirtualMachineClone, sourceVM *kubevirtv1.VirtualMachine, volumeBackups []snapshotv1.VolumeBackup) *kubevirtv1.VirtualMachine {
+	vm := &kubevirtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        *vmClone.Status.TargetName,
+			Namespace:   vmClone.Namespace,
+			Labels:      filterMap(sourceVM.Labels, vmClone.Spec.LabelFilters),
+			Annotations: filterMap(sourceVM.Annotations, vmClone.Spec.AnnotationFilters),
+		},
+		Spec: *sourceVM.Spec.DeepCopy(),
+	}
+
+	if vm.Annotations == nil {
+		vm.Annotations = make(map[string]string)
+	}
+	vm.Annotations[cloneNameAnnotation] = vmClone.Name
+	vm.Annotations[cloneUIDAnnotation] = string(vmClone.UID)
+
+	vmiTemplate := vm.Spec.Template
+	vmiTemplate.ObjectMeta.Labels = filterMap(sourceVM.Spec.Template.ObjectMeta.Labels, vmClone.Spec.Template.LabelFilters)
+	vmiTemplate.ObjectMeta.Annotations = filterMap(sourceVM.Spec.Template.ObjectMeta.Annotations, vmClone.Spec.Template.AnnotationFilters)
+
+	// the target is started once all of its volumes are restored
+	halted := kubevirtv1.RunStrategyHalted
+	vm.Spec.Running = nil
+	vm.Spec.RunStrategy = &halted
+
+	domain := &vmiTemplate.Spec.Domain
+	for i := range domain.Devices.Interfaces {
+		iface := &domain.Devices.Interfaces[i]
+		iface.MacAddress = vmClone.Spec.NewMacAddresses[iface.Name]
+	}
+
+	if domain.Firmware != nil {
+		domain.Firmware.UUID = ""
+		domain.Firmware.Serial = ""
+	}
+
+	if vmClone.Spec.NewSMBiosSerial != nil {
+		if domain.Firmware == nil {
+			domain.Firmware = &kubevirtv1.Firmware{}
+		}
+		domain.Firmware.Serial = *vmClone.Spec.NewSMBiosSerial
+	}
+
+	restoredVolumes := make(map[string]bool)
+	for _, volumeBackup := range volumeBackups {
+		restoredVolumes[volumeBackup.VolumeName] = true
+	}
+
+	replacedDataVolumes := make(map[string]bool)
+	for i, volume := range vmiTemplate.Spec.Volumes {
+		if !restoredVolumes[volume.Name] {
+			continue
+		}
+
+		if volume.DataVolume != nil {
+			replacedDataVolumes[volume.DataVolume.Name] = true
+		}
+
+		vmiTemplate.Spec.Volumes[i].VolumeSource = kubevirtv1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: restorePVCName(vmClone, volume.Name),
+			},
+		}
+	}
+
+	var dataVolumeTemplates []kubevirtv1.DataVolumeTemplateSpec
+	for _, dataVolumeTemplate := range vm.Spec.DataVolumeTemplates {
+		if !replacedDataVolumes[dataVolumeTemplate.Name] {
+			dataVolumeTemplates = append(dataVolumeTemplates, dataVolumeTemplate)
+		}
+	}
+	vm.Spec.DataVolumeTemplates = dataVolumeTemplates
+
+	return vm
+}
+
+// targetName returns the name of the VirtualMachine created by vmClone
+func targetName(vmClone *clonev1.VirtualMachineClone, sourceName string) (string, error) {
+	if vmClone.Spec.Target != nil && vmClone.Spec.Target.Name != "" {
+		return vmClone.Spec.Target.Name, nil
+	}
+
+	nameTemplate := defaultNameTemplate
+	if vmClone.Spec.NameTemplate != nil && *vmClone.Spec.NameTemplate != "" {
+		nameTemplate = *vmClone.Spec.NameTemplate
+	}
+
+	uniqueSuffix := strings.Replace(string(vmClone.UID), "-", "", -1)
+	if len(uniqueSuffix) > 5 {
+		uniqueSuffix = uniqueSuffix[:5]
+	}
+
+	return executeNameTemplate(nameTemplate, nameTemplateData{
+		SourceName:   sourceName,
+		CloneName:    vmClone.Name,
+		UniqueSuffix: uniqueSuffix,
+	})
+}
+
+// executeNameTemplate generates a name from nameTemplate and makes sure it is a valid object name
+func executeNameTemplate(nameTemplate string, data nameTemplateData) (string, error) {
+	tmpl, err := template.New("name").Parse(nameTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid name template: %v", err)
+	}
+
+	var b bytes.Buffer
+	if err = tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("invalid name template: %v", err)
+	}
+
+	name := b.String()
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", fmt.Errorf("invalid target name %q: %s", name, strings.Join(errs, ", "))
+	}
+
+	return name, nil
+}
+
+// filterMap returns the entries of m whose keys pass filters. A key passes if it
+// matches any of the including filters, or there are none, and none of the excluding
+// filters, which are prefixed with "!". A "*" in a filter matches any sequence of characters.
+func filterMap(m map[string]string, filters []string) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	var includes, excludes []string
+	for _, filter := range filters {
+		if strings.HasPrefix(filter, "!") {
+			excludes = append(excludes, filter[1:])
+		} else {
+			includes = append(includes, filter)
+		}
+	}
+
+	filtered := make(map[string]string)
+	for k, v := range m {
+		if len(includes) > 0 && !matchesAny(k, includes) {
+			continue
+		}
+
+		if matchesAny(k, excludes) {
+			continue
+		}
+
+		filtered[k] = v
+	}
+
+	return filtered
+}
+
+func matchesAny(key string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matchPattern(key, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPattern(key, pattern string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return key == pattern
+	}
+
+	if !strings.HasPrefix(key, parts[0]) {
+		return false
+	}
+	key = key[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(key, part)
+		if i < 0 {
+			return false
+		}
+		key = key[i+len(part):]
+	}
+
+	return strings.HasSuffix(key, last)
+}
+
+func ownerRef(vmClone *clonev1.VirtualMachineClone) metav1.OwnerReference {
+	t := true
+	return metav1.OwnerReference{
+		APIVersion:         clonev1.SchemeGroupVersion.String(),
+		Kind:               "VirtualMachineClone",
+		Name:               vmClone.Name,
+		UID:                vmClone.UID,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+}
+
+func newReadyCondition(status corev1.ConditionStatus, reason string) clonev1.Condition {
+	return clonev1.Condition{
+		Type:               clonev1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func newProgressingCondition(status corev1.ConditionStatus, reason string) clonev1.Condition {
+	return clonev1.Condition{
+		Type:               clonev1.ConditionProgressing,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateProgress(vmClone *clonev1.VirtualMachineClone, reason string) {
+	updateCondition(vmClone, newProgressingCondition(corev1.ConditionTrue, reason))
+	updateCondition(vmClone, newReadyCondition(corev1.ConditionFalse, reason))
+}
+
+func updateCondition(vmClone *clonev1.VirtualMachineClone, c clonev1.Condition) {
+	for i := range vmClone.Status.Conditions {
+		if vmClone.Status.Conditions[i].Type == c.Type {
+			if vmClone.Status.Conditions[i].Status != c.Status || vmClone.Status.Conditions[i].Reason != c.Reason {
+				vmClone.Status.Conditions[i] = c
+			}
+			return
+		}
+	}
+
+	vmClone.Status.Conditions = append(vmClone.Status.Conditions, c)
+}
@@ -0,0 +1,231 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package clone
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+)
+
+// VMCloneController is responsible for cloning VMs
+type VMCloneController struct {
+	Client kubecli.KubevirtClient
+
+	VMCloneInformer           cache.SharedIndexInformer
+	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotContentInformer cache.SharedIndexInformer
+	VMInformer                cache.SharedIndexInformer
+	PVCInformer               cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	vmCloneQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the clone controller
+func (ctrl *VMCloneController) Init() {
+	ctrl.vmCloneQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "clone-controller-vmclone")
+
+	ctrl.VMCloneInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMClone,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMClone(newObj) },
+		},
+	)
+
+	ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+
+	ctrl.PVCInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handlePVC,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handlePVC(newObj) },
+		},
+	)
+
+	ctrl.VMInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVM,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVM(newObj) },
+		},
+	)
+}
+
+// Run the controller
+func (ctrl *VMCloneController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmCloneQueue.ShutDown()
+
+	log.Log.Info("Starting clone controller.")
+	defer log.Log.Info("Shutting down clone controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMCloneInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmCloneWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMCloneController) vmCloneWorker() {
+	for ctrl.processVMCloneWorkItem() {
+	}
+}
+
+func (ctrl *VMCloneController) processVMCloneWorkItem() bool {
+	obj, shutdown := ctrl.vmCloneQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer ctrl.vmCloneQueue.Done(obj)
+
+	key, ok := obj.(string)
+	if !ok {
+		ctrl.vmCloneQueue.Forget(obj)
+		utilruntime.HandleError(fmt.Errorf("expected string in workqueue but got %#v", obj))
+		return true
+	}
+
+	log.Log.V(3).Infof("vmClone worker processing key [%s]", key)
+
+	if err := ctrl.execute(key); err != nil {
+		utilruntime.HandleError(err)
+		ctrl.vmCloneQueue.AddRateLimited(key)
+		return true
+	}
+
+	ctrl.vmCloneQueue.Forget(obj)
+	return true
+}
+
+func (ctrl *VMCloneController) execute(key string) error {
+	storeObj, exists, err := ctrl.VMCloneInformer.GetStore().GetByKey(key)
+	if !exists || err != nil {
+		return err
+	}
+
+	vmClone, ok := storeObj.(*clonev1.VirtualMachineClone)
+	if !ok {
+		return fmt.Errorf("unexpected resource %+v", storeObj)
+	}
+
+	return ctrl.updateVMClone(vmClone.DeepCopy())
+}
+
+func (ctrl *VMCloneController) handleVMClone(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmClone, ok := obj.(*clonev1.VirtualMachineClone); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(vmClone)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, vmClone)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmCloneQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMCloneController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		keys, err := ctrl.VMCloneInformer.GetIndexer().IndexKeys("snapshot", cacheKeyFunc(vmSnapshot.Namespace, vmSnapshot.Name))
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+
+		for _, k := range keys {
+			ctrl.vmCloneQueue.Add(k)
+		}
+	}
+}
+
+func (ctrl *VMCloneController) handlePVC(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if pvc, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+		cloneName, ok := pvc.Annotations[cloneNameAnnotation]
+		if !ok {
+			return
+		}
+
+		objName := cacheKeyFunc(pvc.Namespace, cloneName)
+
+		log.Log.V(3).Infof("Handling PVC %s/%s, Clone %s", pvc.Namespace, pvc.Name, objName)
+		ctrl.vmCloneQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMCloneController) handleVM(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vm, ok := obj.(*kubevirtv1.VirtualMachine); ok {
+		cloneName, ok := vm.Annotations[cloneNameAnnotation]
+		if !ok {
+			return
+		}
+
+		objName := cacheKeyFunc(vm.Namespace, cloneName)
+
+		log.Log.V(3).Infof("Handling VM %s/%s, Clone %s", vm.Namespace, vm.Name, objName)
+		ctrl.vmCloneQueue.Add(objName)
+	}
+}
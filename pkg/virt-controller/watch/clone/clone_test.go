@@ -0,0 +1,618 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Clone", func() {
+	const (
+		testNamespace  = metav1.NamespaceDefault
+		cloneName      = "clone"
+		cloneUID       = "abcdef-1234"
+		sourceVMName   = "source"
+		vmSnapshotName = "snapshot"
+		contentName    = "content"
+	)
+
+	var timeStamp = metav1.Now()
+
+	table.DescribeTable("name templates", func(nameTemplate string, expected string, expectErr bool) {
+		name, err := executeNameTemplate(nameTemplate, nameTemplateData{
+			SourceName:   "vm",
+			CloneName:    "clone",
+			UniqueSuffix: "abcde",
+		})
+		if expectErr {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal(expected))
+	},
+		table.Entry("should generate the default name", defaultNameTemplate, "vm-clone-abcde", false),
+		table.Entry("should reference the clone name", "{{.CloneName}}-{{.SourceName}}", "clone-vm", false),
+		table.Entry("should reject unparsable templates", "{{.SourceName", "", true),
+		table.Entry("should reject unknown fields", "{{.Unknown}}", "", true),
+		table.Entry("should reject invalid names", "{{.SourceName}}_Clone", "", true),
+	)
+
+	table.DescribeTable("filters", func(filters []string, expected map[string]string) {
+		m := map[string]string{
+			"app":                    "web",
+			"kubevirt.io/os":         "fedora",
+			"kubevirt.io/size":       "small",
+			"example.com/team":       "a",
+			"example.com/team-owner": "b",
+		}
+		Expect(filterMap(m, filters)).To(Equal(expected))
+	},
+		table.Entry("should copy everything without filters", nil, map[string]string{
+			"app":                    "web",
+			"kubevirt.io/os":         "fedora",
+			"kubevirt.io/size":       "small",
+			"example.com/team":       "a",
+			"example.com/team-owner": "b",
+		}),
+		table.Entry("should copy matching keys", []string{"kubevirt.io/*"}, map[string]string{
+			"kubevirt.io/os":   "fedora",
+			"kubevirt.io/size": "small",
+		}),
+		table.Entry("should drop excluded keys", []string{"!kubevirt.io/*", "!app"}, map[string]string{
+			"example.com/team":       "a",
+			"example.com/team-owner": "b",
+		}),
+		table.Entry("should combine including and excluding filters", []string{"*", "!*/team*", "!kubevirt.io/size"}, map[string]string{
+			"app":            "web",
+			"kubevirt.io/os": "fedora",
+		}),
+		table.Entry("should support wildcards in the middle", []string{"example.com/*-owner"}, map[string]string{
+			"example.com/team-owner": "b",
+		}),
+	)
+
+	Context("with a VirtualMachineClone", func() {
+		var ctrl *gomock.Controller
+		var virtClient *kubecli.MockKubevirtClient
+		var vmInterface *kubecli.MockVirtualMachineInterface
+		var kubevirtClient *kubevirtfake.Clientset
+		var k8sClient *k8sfake.Clientset
+		var vmCloneInformer cache.SharedIndexInformer
+		var vmSnapshotInformer cache.SharedIndexInformer
+		var vmSnapshotContentInformer cache.SharedIndexInformer
+		var vmInformer cache.SharedIndexInformer
+		var pvcInformer cache.SharedIndexInformer
+		var recorder *record.FakeRecorder
+		var controller *VMCloneController
+
+		apiGroup := v1.GroupName
+		snapshotAPIGroup := snapshotv1.SchemeGroupVersion.Group
+
+		createClone := func(kind string) *clonev1.VirtualMachineClone {
+			source := corev1.TypedLocalObjectReference{
+				APIGroup: &apiGroup,
+				Kind:     kind,
+				Name:     sourceVMName,
+			}
+			if kind == virtualMachineSnapshotKind {
+				source.APIGroup = &snapshotAPIGroup
+				source.Name = vmSnapshotName
+			}
+
+			return &clonev1.VirtualMachineClone{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cloneName,
+					Namespace: testNamespace,
+					UID:       cloneUID,
+				},
+				Spec: clonev1.VirtualMachineCloneSpec{
+					Source: source,
+				},
+			}
+		}
+
+		createSourceVM := func() *v1.VirtualMachine {
+			runStrategy := v1.RunStrategyAlways
+			return &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      sourceVMName,
+					Namespace: testNamespace,
+					UID:       "source-uid",
+					Labels: map[string]string{
+						"app":           "web",
+						"kubevirt.io/x": "y",
+					},
+					Annotations: map[string]string{
+						"note": "golden",
+					},
+				},
+				Spec: v1.VirtualMachineSpec{
+					RunStrategy: &runStrategy,
+					DataVolumeTemplates: []v1.DataVolumeTemplateSpec{
+						{ObjectMeta: metav1.ObjectMeta{Name: "rootdisk"}},
+						{ObjectMeta: metav1.ObjectMeta{Name: "scratch"}},
+					},
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{
+								"kubevirt.io/domain": sourceVMName,
+							},
+						},
+						Spec: v1.VirtualMachineInstanceSpec{
+							Domain: v1.DomainSpec{
+								Firmware: &v1.Firmware{
+									UUID:   "a9b8c7d6-0000-1111-2222-333344445555",
+									Serial: "source-serial",
+								},
+								Devices: v1.Devices{
+									Interfaces: []v1.Interface{
+										{Name: "default", MacAddress: "02:00:00:00:00:01"},
+										{Name: "secondary", MacAddress: "02:00:00:00:00:02"},
+									},
+								},
+							},
+							Volumes: []v1.Volume{
+								{
+									Name: "disk0",
+									VolumeSource: v1.VolumeSource{
+										DataVolume: &v1.DataVolumeSource{Name: "rootdisk"},
+									},
+								},
+								{
+									Name: "disk1",
+									VolumeSource: v1.VolumeSource{
+										DataVolume: &v1.DataVolumeSource{Name: "scratch"},
+									},
+								},
+								{
+									Name: "cloudinit",
+									VolumeSource: v1.VolumeSource{
+										CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		createSnapshot := func(name string, ready bool) *snapshotv1.VirtualMachineSnapshot {
+			content := contentName
+			return &snapshotv1.VirtualMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: testNamespace,
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     virtualMachineKind,
+						Name:     sourceVMName,
+					},
+				},
+				Status: &snapshotv1.VirtualMachineSnapshotStatus{
+					ReadyToUse:                        &ready,
+					VirtualMachineSnapshotContentName: &content,
+				},
+			}
+		}
+
+		createContent := func() *snapshotv1.VirtualMachineSnapshotContent {
+			volumeSnapshotName := "vs-disk0"
+			return &snapshotv1.VirtualMachineSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      contentName,
+					Namespace: testNamespace,
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+					Source: snapshotv1.SourceSpec{
+						VirtualMachine: createSourceVM(),
+					},
+					VolumeBackups: []snapshotv1.VolumeBackup{
+						{
+							VolumeName: "disk0",
+							PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+								ObjectMeta: metav1.ObjectMeta{
+									Name: "rootdisk",
+									Annotations: map[string]string{
+										"cdi.kubevirt.io/storage.import.endpoint": "http://example.com",
+									},
+								},
+							},
+							VolumeSnapshotName: &volumeSnapshotName,
+						},
+					},
+				},
+			}
+		}
+
+		createPVC := func(vmClone *clonev1.VirtualMachineClone, volumeName string, phase corev1.PersistentVolumeClaimPhase) *corev1.PersistentVolumeClaim {
+			return &corev1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      restorePVCName(vmClone, volumeName),
+					Namespace: testNamespace,
+					Annotations: map[string]string{
+						cloneNameAnnotation: vmClone.Name,
+					},
+				},
+				Status: corev1.PersistentVolumeClaimStatus{
+					Phase: phase,
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			virtClient = kubecli.NewMockKubevirtClient(ctrl)
+			vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+
+			vmCloneInformer, _ = testutils.NewFakeInformerWithIndexersFor(&clonev1.VirtualMachineClone{}, cache.Indexers{
+				"snapshot": func(obj interface{}) ([]string, error) {
+					vmClone := obj.(*clonev1.VirtualMachineClone)
+					if vmClone.Status.SnapshotName == nil {
+						return nil, nil
+					}
+					return []string{cacheKeyFunc(vmClone.Namespace, *vmClone.Status.SnapshotName)}, nil
+				},
+			})
+			vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+			vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+			vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+			pvcInformer, _ = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+			recorder = record.NewFakeRecorder(100)
+
+			controller = &VMCloneController{
+				Client:                    virtClient,
+				VMCloneInformer:           vmCloneInformer,
+				VMSnapshotInformer:        vmSnapshotInformer,
+				VMSnapshotContentInformer: vmSnapshotContentInformer,
+				VMInformer:                vmInformer,
+				PVCInformer:               pvcInformer,
+				Recorder:                  recorder,
+			}
+			controller.Init()
+
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			k8sClient = k8sfake.NewSimpleClientset()
+
+			virtClient.EXPECT().VirtualMachine(testNamespace).Return(vmInterface).AnyTimes()
+			virtClient.EXPECT().VirtualMachineClone(testNamespace).
+				Return(kubevirtClient.CloneV1alpha1().VirtualMachineClones(testNamespace)).AnyTimes()
+			virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+				Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+			currentTime = func() *metav1.Time {
+				return &timeStamp
+			}
+		})
+
+		addClone := func(vmClone *clonev1.VirtualMachineClone) {
+			Expect(vmCloneInformer.GetIndexer().Add(vmClone)).To(Succeed())
+			_, err := kubevirtClient.CloneV1alpha1().VirtualMachineClones(testNamespace).Create(context.Background(), vmClone, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		addSnapshot := func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+			Expect(vmSnapshotInformer.GetIndexer().Add(vmSnapshot)).To(Succeed())
+			_, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		addContent := func(content *snapshotv1.VirtualMachineSnapshotContent) {
+			Expect(vmSnapshotContentInformer.GetIndexer().Add(content)).To(Succeed())
+		}
+
+		addVM := func(vm *v1.VirtualMachine) {
+			Expect(vmInformer.GetIndexer().Add(vm)).To(Succeed())
+		}
+
+		addPVC := func(pvc *corev1.PersistentVolumeClaim) {
+			Expect(pvcInformer.GetIndexer().Add(pvc)).To(Succeed())
+		}
+
+		execute := func(vmClone *clonev1.VirtualMachineClone) *clonev1.VirtualMachineClone {
+			Expect(controller.execute(cacheKeyFunc(vmClone.Namespace, vmClone.Name))).To(Succeed())
+			updated, err := kubevirtClient.CloneV1alpha1().VirtualMachineClones(vmClone.Namespace).Get(context.Background(), vmClone.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return updated
+		}
+
+		withStatus := func(vmClone *clonev1.VirtualMachineClone, phase clonev1.VirtualMachineClonePhase, snapshotName string, targetName *string) *clonev1.VirtualMachineClone {
+			vmClone.Status.Phase = phase
+			vmClone.Status.SnapshotName = &snapshotName
+			vmClone.Status.TargetName = targetName
+			return vmClone
+		}
+
+		It("should snapshot a source VirtualMachine", func() {
+			vmClone := createClone(virtualMachineKind)
+			addClone(vmClone)
+
+			updated := execute(vmClone)
+
+			Expect(updated.Status.Phase).To(Equal(clonev1.SnapshotInProgress))
+			Expect(*updated.Status.SnapshotName).To(Equal(tmpSnapshotName(vmClone)))
+			Expect(updated.Status.CreationTime).ToNot(BeNil())
+
+			vmSnapshot, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), tmpSnapshotName(vmClone), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmSnapshot.Spec.Source.Name).To(Equal(sourceVMName))
+			Expect(metav1.IsControlledBy(vmSnapshot, vmClone)).To(BeTrue())
+			testutils.ExpectEvent(recorder, snapshotCreatedEvent)
+		})
+
+		It("should use a source VirtualMachineSnapshot", func() {
+			vmClone := createClone(virtualMachineSnapshotKind)
+			addClone(vmClone)
+
+			updated := execute(vmClone)
+
+			Expect(updated.Status.Phase).To(Equal(clonev1.SnapshotInProgress))
+			Expect(*updated.Status.SnapshotName).To(Equal(vmSnapshotName))
+
+			snapshots, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshots.Items).To(BeEmpty())
+		})
+
+		It("should wait for the snapshot to be ready", func() {
+			vmClone := withStatus(createClone(virtualMachineSnapshotKind), clonev1.SnapshotInProgress, vmSnapshotName, nil)
+			addClone(vmClone)
+			addSnapshot(createSnapshot(vmSnapshotName, false))
+
+			updated := execute(vmClone)
+			Expect(updated.Status.Phase).To(Equal(clonev1.SnapshotInProgress))
+
+			Expect(vmSnapshotInformer.GetIndexer().Update(createSnapshot(vmSnapshotName, true))).To(Succeed())
+			updated = execute(vmClone)
+			Expect(updated.Status.Phase).To(Equal(clonev1.CreatingTargetVM))
+		})
+
+		Context("creating the target", func() {
+			var vmClone *clonev1.VirtualMachineClone
+
+			BeforeEach(func() {
+				vmClone = withStatus(createClone(virtualMachineKind), clonev1.CreatingTargetVM, tmpSnapshotName(createClone(virtualMachineKind)), nil)
+				addSnapshot(createSnapshot(tmpSnapshotName(vmClone), true))
+				addContent(createContent())
+			})
+
+			It("should store the generated target name first", func() {
+				addClone(vmClone)
+
+				updated := execute(vmClone)
+
+				Expect(updated.Status.Phase).To(Equal(clonev1.CreatingTargetVM))
+				Expect(*updated.Status.TargetName).To(Equal("source-clone-abcde"))
+			})
+
+			It("should use the name of an explicit target", func() {
+				vmClone.Spec.Target = &corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     virtualMachineKind,
+					Name:     "target",
+				}
+				addClone(vmClone)
+
+				updated := execute(vmClone)
+
+				Expect(*updated.Status.TargetName).To(Equal("target"))
+			})
+
+			It("should fail with an invalid name template", func() {
+				nameTemplate := "{{.SourceName}}_{{.CloneName}}"
+				vmClone.Spec.NameTemplate = &nameTemplate
+				addClone(vmClone)
+
+				updated := execute(vmClone)
+
+				Expect(updated.Status.Phase).To(Equal(clonev1.Failed))
+				Expect(updated.Status.TargetName).To(BeNil())
+				testutils.ExpectEvent(recorder, cloneFailedEvent)
+			})
+
+			It("should create the target VirtualMachine", func() {
+				targetName := "target"
+				serial := "new-serial"
+				vmClone.Status.TargetName = &targetName
+				vmClone.Spec.LabelFilters = []string{"!kubevirt.io/*"}
+				vmClone.Spec.Template.LabelFilters = []string{"!kubevirt.io/domain"}
+				vmClone.Spec.NewMacAddresses = map[string]string{"secondary": "02:00:00:00:00:42"}
+				vmClone.Spec.NewSMBiosSerial = &serial
+				addClone(vmClone)
+
+				var created *v1.VirtualMachine
+				vmInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+					created = vm
+					return vm, nil
+				})
+
+				updated := execute(vmClone)
+
+				Expect(updated.Status.Phase).To(Equal(clonev1.RestoreInProgress))
+				testutils.ExpectEvent(recorder, targetVMCreatedEvent)
+
+				Expect(created.Name).To(Equal(targetName))
+				Expect(created.Labels).To(Equal(map[string]string{"app": "web"}))
+				Expect(created.Annotations).To(HaveKeyWithValue("note", "golden"))
+				Expect(created.Annotations).To(HaveKeyWithValue(cloneNameAnnotation, cloneName))
+				Expect(created.Annotations).To(HaveKeyWithValue(cloneUIDAnnotation, cloneUID))
+				Expect(created.Spec.Template.ObjectMeta.Labels).To(BeEmpty())
+				Expect(created.Spec.Running).To(BeNil())
+				Expect(*created.Spec.RunStrategy).To(Equal(v1.RunStrategyHalted))
+
+				domain := created.Spec.Template.Spec.Domain
+				Expect(domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+				Expect(domain.Devices.Interfaces[1].MacAddress).To(Equal("02:00:00:00:00:42"))
+				Expect(domain.Firmware.UUID).To(BeEmpty())
+				Expect(domain.Firmware.Serial).To(Equal(serial))
+
+				volumes := created.Spec.Template.Spec.Volumes
+				Expect(volumes[0].DataVolume).To(BeNil())
+				Expect(volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(restorePVCName(vmClone, "disk0")))
+				Expect(volumes[1].DataVolume.Name).To(Equal("scratch"))
+				Expect(volumes[2].CloudInitNoCloud).ToNot(BeNil())
+				Expect(created.Spec.DataVolumeTemplates).To(HaveLen(1))
+				Expect(created.Spec.DataVolumeTemplates[0].Name).To(Equal("scratch"))
+			})
+
+			It("should fail if a foreign VirtualMachine has the target name", func() {
+				targetName := "target"
+				vmClone.Status.TargetName = &targetName
+				addClone(vmClone)
+				addVM(&v1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      targetName,
+						Namespace: testNamespace,
+					},
+				})
+
+				updated := execute(vmClone)
+
+				Expect(updated.Status.Phase).To(Equal(clonev1.Failed))
+				testutils.ExpectEvent(recorder, cloneFailedEvent)
+			})
+		})
+
+		Context("restoring the volumes", func() {
+			var vmClone *clonev1.VirtualMachineClone
+			var targetVM *v1.VirtualMachine
+
+			BeforeEach(func() {
+				targetName := "target"
+				vmClone = withStatus(createClone(virtualMachineKind), clonev1.RestoreInProgress, tmpSnapshotName(createClone(virtualMachineKind)), &targetName)
+				addClone(vmClone)
+				addSnapshot(createSnapshot(tmpSnapshotName(vmClone), true))
+				addContent(createContent())
+
+				targetVM = newTargetVM(vmClone, createSourceVM(), createContent().Spec.VolumeBackups)
+				targetVM.UID = types.UID("target-uid")
+				addVM(targetVM)
+			})
+
+			It("should create the restored PVCs", func() {
+				updated := execute(vmClone)
+
+				Expect(updated.Status.Phase).To(Equal(clonev1.RestoreInProgress))
+
+				pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), restorePVCName(vmClone, "disk0"), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvc.Annotations).To(Equal(map[string]string{cloneNameAnnotation: cloneName}))
+				Expect(pvc.Spec.DataSource.Kind).To(Equal("VolumeSnapshot"))
+				Expect(pvc.Spec.DataSource.Name).To(Equal("vs-disk0"))
+				Expect(pvc.OwnerReferences).To(HaveLen(1))
+				Expect(pvc.OwnerReferences[0].UID).To(Equal(targetVM.UID))
+			})
+
+			It("should start the target once the PVCs exist", func() {
+				addPVC(createPVC(vmClone, "disk0", corev1.ClaimPending))
+
+				vmInterface.EXPECT().Update(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+					Expect(*vm.Spec.RunStrategy).To(Equal(v1.RunStrategyAlways))
+					return vm, nil
+				})
+
+				updated := execute(vmClone)
+
+				Expect(updated.Status.Phase).To(Equal(clonev1.Succeeded))
+				Expect(updated.Status.Conditions).To(ContainElement(newReadyCondition(corev1.ConditionTrue, "Operation complete")))
+				testutils.ExpectEvent(recorder, cloneSucceededEvent)
+			})
+
+			It("should report lost PVCs", func() {
+				addPVC(createPVC(vmClone, "disk0", corev1.ClaimLost))
+
+				Expect(controller.execute(cacheKeyFunc(testNamespace, cloneName))).ToNot(Succeed())
+				testutils.ExpectEvent(recorder, cloneErrorEvent)
+			})
+		})
+
+		Context("after the clone succeeded", func() {
+			var vmClone *clonev1.VirtualMachineClone
+
+			BeforeEach(func() {
+				targetName := "target"
+				vmClone = withStatus(createClone(virtualMachineKind), clonev1.Succeeded, tmpSnapshotName(createClone(virtualMachineKind)), &targetName)
+				addClone(vmClone)
+				addSnapshot(createSnapshot(tmpSnapshotName(vmClone), true))
+				addContent(createContent())
+			})
+
+			It("should keep the snapshot while PVCs are not bound", func() {
+				addPVC(createPVC(vmClone, "disk0", corev1.ClaimPending))
+
+				execute(vmClone)
+
+				_, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), tmpSnapshotName(vmClone), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should delete the temporary snapshot once the PVCs are bound", func() {
+				addPVC(createPVC(vmClone, "disk0", corev1.ClaimBound))
+
+				execute(vmClone)
+
+				snapshots, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(snapshots.Items).To(BeEmpty())
+			})
+		})
+
+		It("should enqueue clones of a snapshot", func() {
+			vmClone := withStatus(createClone(virtualMachineSnapshotKind), clonev1.SnapshotInProgress, vmSnapshotName, nil)
+			Expect(vmCloneInformer.GetIndexer().Add(vmClone)).To(Succeed())
+
+			controller.handleVMSnapshot(createSnapshot(vmSnapshotName, true))
+
+			Expect(controller.vmCloneQueue.Len()).To(Equal(1))
+		})
+
+		It("should ignore VirtualMachines not created by a clone", func() {
+			controller.handleVM(createSourceVM())
+			Expect(controller.vmCloneQueue.Len()).To(Equal(0))
+
+			targetVM := createSourceVM()
+			targetVM.Annotations[cloneNameAnnotation] = cloneName
+			controller.handleVM(targetVM)
+			Expect(controller.vmCloneQueue.Len()).To(Equal(1))
+		})
+	})
+})
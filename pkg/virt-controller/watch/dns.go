@@ -0,0 +1,271 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// SecondaryNetworkDNSConfigMapName is the ConfigMap in the KubeVirt namespace which holds the records
+	SecondaryNetworkDNSConfigMapName = "kubevirt-secondary-network-dns"
+	// SecondaryNetworkDNSHostsKey is the key of the records in the hosts file format of the CoreDNS hosts plugin
+	SecondaryNetworkDNSHostsKey = "hosts"
+
+	// all the records are rendered together, every change enqueues the same key
+	secondaryNetworkDNSKey = "secondary-network-dns"
+
+	// secondaryNetworkDNSMaxHostsSize keeps the records below the 1MiB size limit
+	// of a ConfigMap, leaving room for its metadata
+	secondaryNetworkDNSMaxHostsSize = 1000 * 1024
+)
+
+var invalidDNSCharacters = regexp.MustCompile("[^a-z0-9.-]")
+
+// SecondaryNetworkDNSController publishes the addresses the VMIs report on secondary networks
+// as DNS records in a ConfigMap which can be served by CoreDNS.
+type SecondaryNetworkDNSController struct {
+	clientset      kubecli.KubevirtClient
+	Queue          workqueue.RateLimitingInterface
+	vmiInformer    cache.SharedIndexInformer
+	clusterConfig  *virtconfig.ClusterConfig
+	namespace      string
+	resyncInterval time.Duration
+}
+
+// NewSecondaryNetworkDNSController creates a new instance of the SecondaryNetworkDNSController struct.
+func NewSecondaryNetworkDNSController(clientset kubecli.KubevirtClient, vmiInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig, namespace string) *SecondaryNetworkDNSController {
+	c := &SecondaryNetworkDNSController{
+		clientset:      clientset,
+		Queue:          workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		vmiInformer:    vmiInformer,
+		clusterConfig:  clusterConfig,
+		namespace:      namespace,
+		resyncInterval: 1 * time.Minute,
+	}
+
+	c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updateVirtualMachineInstance,
+	})
+
+	return c
+}
+
+func (c *SecondaryNetworkDNSController) enqueue() {
+	c.Queue.Add(secondaryNetworkDNSKey)
+}
+
+func (c *SecondaryNetworkDNSController) updateVirtualMachineInstance(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+	if !equality.Semantic.DeepEqual(oldVMI.Status.Interfaces, currVMI.Status.Interfaces) {
+		c.enqueue()
+	}
+}
+
+// Run runs the passed in SecondaryNetworkDNSController.
+func (c *SecondaryNetworkDNSController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting secondary network DNS controller.")
+
+	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced)
+
+	// Changes of the configuration are picked up on the next resync
+	go wait.Until(c.enqueue, c.resyncInterval, stopCh)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping secondary network DNS controller.")
+}
+
+func (c *SecondaryNetworkDNSController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute renders the records if they are enqueued, if there is
+// an error it requeues them. Returns false if the queue is shut down.
+func (c *SecondaryNetworkDNSController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(); err != nil {
+		log.Log.Reason(err).Info("reenqueuing secondary network DNS records")
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Info("processed secondary network DNS records")
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *SecondaryNetworkDNSController) execute() error {
+	if !c.clusterConfig.SecondaryNetworkDNSEnabled() {
+		return nil
+	}
+
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range c.vmiInformer.GetStore().List() {
+		vmis = append(vmis, obj.(*virtv1.VirtualMachineInstance))
+	}
+	hosts := renderSecondaryNetworkHosts(vmis, c.clusterConfig.GetSecondaryNetworkDNSNamePattern())
+	hosts, dropped := truncateHosts(hosts, secondaryNetworkDNSMaxHostsSize)
+	if dropped > 0 {
+		log.Log.Errorf("%d secondary network DNS records exceed the size limit of the ConfigMap %s and are not published", dropped, SecondaryNetworkDNSConfigMapName)
+	}
+
+	configMaps := c.clientset.CoreV1().ConfigMaps(c.namespace)
+	configMap, err := configMaps.Get(context.Background(), SecondaryNetworkDNSConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		configMap = &k8sv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      SecondaryNetworkDNSConfigMapName,
+				Namespace: c.namespace,
+				Labels: map[string]string{
+					virtv1.AppLabel: "virt-controller",
+				},
+			},
+			Data: map[string]string{SecondaryNetworkDNSHostsKey: hosts},
+		}
+		_, err = configMaps.Create(context.Background(), configMap, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if configMap.Data[SecondaryNetworkDNSHostsKey] == hosts {
+		return nil
+	}
+	configMap = configMap.DeepCopy()
+	if configMap.Data == nil {
+		configMap.Data = map[string]string{}
+	}
+	configMap.Data[SecondaryNetworkDNSHostsKey] = hosts
+	_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+	return err
+}
+
+// renderSecondaryNetworkHosts renders a line in the hosts file format for every address
+// of an interface connected to a Multus network, sorted to keep the output stable.
+func renderSecondaryNetworkHosts(vmis []*virtv1.VirtualMachineInstance, namePattern string) string {
+	var lines []string
+	for _, vmi := range vmis {
+		if vmi.IsFinal() || vmi.DeletionTimestamp != nil {
+			continue
+		}
+
+		networksByName := map[string]virtv1.Network{}
+		for _, network := range vmi.Spec.Networks {
+			networksByName[network.Name] = network
+		}
+
+		for _, iface := range vmi.Status.Interfaces {
+			network, exists := networksByName[iface.Name]
+			if !exists || network.Multus == nil || network.Multus.Default {
+				continue
+			}
+
+			name := secondaryNetworkDNSName(namePattern, iface.Name, vmi, network.Multus.NetworkName)
+			for _, ip := range getInterfaceIPs(iface) {
+				lines = append(lines, fmt.Sprintf("%s %s", ip, name))
+			}
+		}
+	}
+
+	sort.Strings(lines)
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// truncateHosts drops the records which do not fit into maxSize bytes and
+// returns how many were dropped
+func truncateHosts(hosts string, maxSize int) (string, int) {
+	if len(hosts) <= maxSize {
+		return hosts, 0
+	}
+
+	end := strings.LastIndex(hosts[:maxSize], "\n") + 1
+	return hosts[:end], strings.Count(hosts[end:], "\n")
+}
+
+func getInterfaceIPs(iface virtv1.VirtualMachineInstanceNetworkInterface) []string {
+	ips := iface.IPs
+	if len(ips) == 0 && iface.IP != "" {
+		ips = []string{iface.IP}
+	}
+
+	var routable []string
+	for _, ip := range ips {
+		parsed := net.ParseIP(ip)
+		if parsed == nil || parsed.IsLinkLocalUnicast() {
+			continue
+		}
+		routable = append(routable, parsed.String())
+	}
+	return routable
+}
+
+// secondaryNetworkDNSName fills the placeholders of the pattern, the network attachment
+// definition is referenced without its namespace.
+func secondaryNetworkDNSName(namePattern string, ifaceName string, vmi *virtv1.VirtualMachineInstance, networkName string) string {
+	if idx := strings.LastIndex(networkName, "/"); idx >= 0 {
+		networkName = networkName[idx+1:]
+	}
+
+	name := strings.NewReplacer(
+		"{interface}", ifaceName,
+		"{vmi}", vmi.Name,
+		"{namespace}", vmi.Namespace,
+		"{network}", networkName,
+	).Replace(namePattern)
+
+	return invalidDNSCharacters.ReplaceAllString(strings.ToLower(name), "-")
+}
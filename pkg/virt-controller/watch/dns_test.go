@@ -0,0 +1,148 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Secondary network DNS controller", func() {
+
+	newVMIWithSecondaryNetwork := func(name string, ips ...string) *virtv1.VirtualMachineInstance {
+		vmi := virtv1.NewMinimalVMIWithNS("default", name)
+		vmi.Status.Phase = virtv1.Running
+		vmi.Spec.Networks = []virtv1.Network{
+			*virtv1.DefaultPodNetwork(),
+			{Name: "blue", NetworkSource: virtv1.NetworkSource{Multus: &virtv1.MultusNetwork{NetworkName: "vlans/blue-nad"}}},
+		}
+		vmi.Status.Interfaces = []virtv1.VirtualMachineInstanceNetworkInterface{
+			{Name: "default", IP: "10.244.0.5", IPs: []string{"10.244.0.5"}},
+			{Name: "blue", IPs: ips},
+		}
+		return vmi
+	}
+
+	table.DescribeTable("should render the records of", func(namePattern string, vmi *virtv1.VirtualMachineInstance, expectedHosts string) {
+		Expect(renderSecondaryNetworkHosts([]*virtv1.VirtualMachineInstance{vmi}, namePattern)).To(Equal(expectedHosts))
+	},
+		table.Entry("IPv4 and IPv6 addresses of secondary networks only",
+			virtconfig.DefaultSecondaryNetworkDNSNamePattern,
+			newVMIWithSecondaryNetwork("testvmi", "192.168.1.10", "fd00::10"),
+			"192.168.1.10 blue.testvmi.default.blue-nad\nfd00::10 blue.testvmi.default.blue-nad\n",
+		),
+		table.Entry("routable addresses only",
+			virtconfig.DefaultSecondaryNetworkDNSNamePattern,
+			newVMIWithSecondaryNetwork("testvmi", "fe80::1", "192.168.1.10"),
+			"192.168.1.10 blue.testvmi.default.blue-nad\n",
+		),
+		table.Entry("a custom pattern with invalid characters",
+			"{vmi}.{network}.VMs.example",
+			newVMIWithSecondaryNetwork("test_vmi", "192.168.1.10"),
+			"192.168.1.10 test-vmi.blue-nad.vms.example\n",
+		),
+		table.Entry("no records for finalized VMIs",
+			virtconfig.DefaultSecondaryNetworkDNSNamePattern,
+			func() *virtv1.VirtualMachineInstance {
+				vmi := newVMIWithSecondaryNetwork("testvmi", "192.168.1.10")
+				vmi.Status.Phase = virtv1.Succeeded
+				return vmi
+			}(),
+			"",
+		),
+	)
+
+	table.DescribeTable("should truncate the records", func(maxSize int, expectedHosts string, expectedDropped int) {
+		hosts, dropped := truncateHosts("192.168.1.10 a.example\n192.168.1.11 b.example\n", maxSize)
+		Expect(hosts).To(Equal(expectedHosts))
+		Expect(dropped).To(Equal(expectedDropped))
+	},
+		table.Entry("not when they fit", 46, "192.168.1.10 a.example\n192.168.1.11 b.example\n", 0),
+		table.Entry("to whole lines", 45, "192.168.1.10 a.example\n", 1),
+		table.Entry("to nothing when no line fits", 10, "", 2),
+	)
+
+	Context("publishing the records", func() {
+		var ctrl *gomock.Controller
+		var kubeClient *fake.Clientset
+		var vmiInformer cache.SharedIndexInformer
+		var configMapInformer cache.SharedIndexInformer
+		var controller *SecondaryNetworkDNSController
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			kubeClient = fake.NewSimpleClientset()
+			virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+			var config *virtconfig.ClusterConfig
+			config, configMapInformer, _, _ = testutils.NewFakeClusterConfig(&k8sv1.ConfigMap{
+				Data: map[string]string{virtconfig.FeatureGatesKey: virtconfig.SecondaryNetworkDNSGate},
+			})
+			vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+			controller = NewSecondaryNetworkDNSController(virtClient, vmiInformer, config, "kubevirt")
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		execute := func() {
+			controller.Queue.Add(secondaryNetworkDNSKey)
+			controller.Execute()
+		}
+
+		getHosts := func() string {
+			configMap, err := kubeClient.CoreV1().ConfigMaps("kubevirt").Get(context.Background(), SecondaryNetworkDNSConfigMapName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return configMap.Data[SecondaryNetworkDNSHostsKey]
+		}
+
+		It("should create and update the ConfigMap", func() {
+			Expect(vmiInformer.GetStore().Add(newVMIWithSecondaryNetwork("testvmi", "192.168.1.10"))).To(Succeed())
+			execute()
+			Expect(getHosts()).To(Equal("192.168.1.10 blue.testvmi.default.blue-nad\n"))
+
+			Expect(vmiInformer.GetStore().Update(newVMIWithSecondaryNetwork("testvmi", "192.168.1.11"))).To(Succeed())
+			execute()
+			Expect(getHosts()).To(Equal("192.168.1.11 blue.testvmi.default.blue-nad\n"))
+		})
+
+		It("should not publish records when the feature gate is disabled", func() {
+			testutils.UpdateFakeClusterConfig(configMapInformer, &k8sv1.ConfigMap{})
+			Expect(vmiInformer.GetStore().Add(newVMIWithSecondaryNetwork("testvmi", "192.168.1.10"))).To(Succeed())
+			execute()
+			Expect(kubeClient.Actions()).To(BeEmpty())
+		})
+	})
+})
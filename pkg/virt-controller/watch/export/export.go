@@ -0,0 +1,821 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/certificates/triple"
+	certutil "kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/snapshot"
+	exportserver "kubevirt.io/kubevirt/pkg/virt-exportserver"
+)
+
+const (
+	// ExportNameLabel selects the export server pod of a VirtualMachineExport
+	ExportNameLabel = "export.kubevirt.io/export-name"
+
+	exportPrefix = "virt-export"
+
+	exportServerPort = 8443
+
+	exportServicePort = 443
+
+	caKey = "ca.crt"
+
+	certDuration = 30 * 24 * time.Hour
+
+	exportServerCreatedEvent = "ExportServerCreated"
+
+	exportPVCCreatedEvent = "ExportPVCCreated"
+
+	exportErrorEvent = "VirtualMachineExportError"
+
+	virtualMachineExportKind = "VirtualMachineExport"
+
+	virtualMachineKind = "VirtualMachine"
+
+	virtualMachineSnapshotKind = "VirtualMachineSnapshot"
+
+	persistentVolumeClaimKind = "PersistentVolumeClaim"
+
+	volumesDir = "/export-volumes"
+
+	blockVolumesDir = "/dev/export-volumes"
+
+	diskImageName = "disk.img"
+)
+
+// variable so can be overridden in tests
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+// exportVolume is a PersistentVolumeClaim served by the export server
+type exportVolume struct {
+	// name is the name the volume is exported as, the name of the source claim
+	name string
+	pvc  *corev1.PersistentVolumeClaim
+}
+
+// exportSource is what a VirtualMachineExport serves
+type exportSource struct {
+	vm      *kubevirtv1.VirtualMachine
+	volumes []exportVolume
+}
+
+func cacheKeyFunc(namespace, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
+
+// exportResourceName is the name of the pod, service, secret and config map of vmExport
+func exportResourceName(vmExport *exportv1.VirtualMachineExport) string {
+	return fmt.Sprintf("%s-%s", exportPrefix, vmExport.Name)
+}
+
+func exportPVCName(vmExport *exportv1.VirtualMachineExport, volumeName string) string {
+	return fmt.Sprintf("%s-%s-%s", exportPrefix, vmExport.Name, volumeName)
+}
+
+func (ctrl *VMExportController) updateVMExport(vmExportIn *exportv1.VirtualMachineExport) error {
+	logger := log.Log.Object(vmExportIn)
+
+	logger.V(1).Infof("Updating VirtualMachineExport")
+
+	if vmExportIn.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmExportOut := vmExportIn.DeepCopy()
+	if vmExportOut.Status == nil {
+		vmExportOut.Status = &exportv1.VirtualMachineExportStatus{
+			Phase: exportv1.Pending,
+		}
+	}
+
+	if err := ctrl.reconcile(vmExportOut); err != nil {
+		logger.Reason(err).Error("Error exporting VirtualMachine")
+		return ctrl.doUpdateError(vmExportIn, err)
+	}
+
+	return ctrl.doUpdate(vmExportIn, vmExportOut)
+}
+
+func (ctrl *VMExportController) reconcile(vmExport *exportv1.VirtualMachineExport) error {
+	pod, err := ctrl.getPod(vmExport.Namespace, exportResourceName(vmExport))
+	if err != nil {
+		return err
+	}
+
+	// once the export server runs it holds the volumes, until then
+	// they must not be used by anything else
+	source, reason, err := ctrl.resolveSource(vmExport, pod == nil)
+	if err != nil {
+		return err
+	}
+
+	if reason != "" {
+		setPending(vmExport, reason)
+		return nil
+	}
+
+	caCert, err := ctrl.ensureCertSecret(vmExport)
+	if err != nil {
+		return err
+	}
+
+	if err = ctrl.ensureManifests(vmExport, source); err != nil {
+		return err
+	}
+
+	if err = ctrl.ensureService(vmExport); err != nil {
+		return err
+	}
+
+	if pod == nil {
+		pod = ctrl.newExportServerPod(vmExport, source.volumes)
+		if _, err = ctrl.Client.CoreV1().Pods(vmExport.Namespace).Create(context.Background(), pod, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+
+		ctrl.Recorder.Eventf(vmExport, corev1.EventTypeNormal, exportServerCreatedEvent, "Created export server pod %s", pod.Name)
+	}
+
+	vmExport.Status.ServiceName = exportResourceName(vmExport)
+	vmExport.Status.Links = &exportv1.VirtualMachineExportLinks{
+		Internal: exportLinks(vmExport, caCert, source),
+	}
+
+	if !isPodReady(pod) {
+		setPending(vmExport, "Waiting for export server")
+		return nil
+	}
+
+	vmExport.Status.Phase = exportv1.Ready
+	updateCondition(vmExport, newReadyCondition(corev1.ConditionTrue, "Export server is ready"))
+
+	return nil
+}
+
+// resolveSource returns the volumes and the VirtualMachine of the source of vmExport,
+// or the reason why the source can not be exported yet
+func (ctrl *VMExportController) resolveSource(vmExport *exportv1.VirtualMachineExport, checkInUse bool) (*exportSource, string, error) {
+	source := vmExport.Spec.Source
+
+	switch source.Kind {
+	case virtualMachineKind:
+		return ctrl.resolveVMSource(vmExport.Namespace, source.Name, checkInUse)
+	case virtualMachineSnapshotKind:
+		return ctrl.resolveVMSnapshotSource(vmExport)
+	case persistentVolumeClaimKind:
+		return ctrl.resolvePVCSource(vmExport.Namespace, source.Name, checkInUse)
+	}
+
+	return nil, fmt.Sprintf("unsupported source kind %q", source.Kind), nil
+}
+
+func (ctrl *VMExportController) resolveVMSource(namespace, name string, checkInUse bool) (*exportSource, string, error) {
+	obj, exists, err := ctrl.VMInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !exists {
+		return nil, fmt.Sprintf("VirtualMachine %s does not exist", name), nil
+	}
+
+	if checkInUse {
+		_, running, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+		if err != nil {
+			return nil, "", err
+		}
+
+		if running {
+			return nil, fmt.Sprintf("VirtualMachine %s is running", name), nil
+		}
+	}
+
+	vm := obj.(*kubevirtv1.VirtualMachine)
+	source := &exportSource{vm: vm}
+	for _, claimName := range claimNames(vm.Spec.Template.Spec.Volumes) {
+		pvc, err := ctrl.getPVC(namespace, claimName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if pvc == nil {
+			return nil, fmt.Sprintf("PersistentVolumeClaim %s does not exist", claimName), nil
+		}
+
+		source.volumes = append(source.volumes, exportVolume{name: claimName, pvc: pvc})
+	}
+
+	return source, "", nil
+}
+
+func (ctrl *VMExportController) resolvePVCSource(namespace, name string, checkInUse bool) (*exportSource, string, error) {
+	pvc, err := ctrl.getPVC(namespace, name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if pvc == nil {
+		return nil, fmt.Sprintf("PersistentVolumeClaim %s does not exist", name), nil
+	}
+
+	if checkInUse {
+		objs, err := ctrl.VMIInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+		if err != nil {
+			return nil, "", err
+		}
+
+		for _, obj := range objs {
+			vmi := obj.(*kubevirtv1.VirtualMachineInstance)
+			for _, claimName := range claimNames(vmi.Spec.Volumes) {
+				if claimName == name {
+					return nil, fmt.Sprintf("PersistentVolumeClaim %s is in use by VirtualMachineInstance %s", name, vmi.Name), nil
+				}
+			}
+		}
+	}
+
+	return &exportSource{volumes: []exportVolume{{name: name, pvc: pvc}}}, "", nil
+}
+
+// resolveVMSnapshotSource restores the volumes of a VirtualMachineSnapshot to
+// PersistentVolumeClaims owned by vmExport
+func (ctrl *VMExportController) resolveVMSnapshotSource(vmExport *exportv1.VirtualMachineExport) (*exportSource, string, error) {
+	name := vmExport.Spec.Source.Name
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(vmExport.Namespace, name))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !exists {
+		return nil, fmt.Sprintf("VirtualMachineSnapshot %s does not exist", name), nil
+	}
+
+	vmSnapshot := obj.(*snapshotv1.VirtualMachineSnapshot)
+	if vmSnapshot.Status == nil || vmSnapshot.Status.ReadyToUse == nil || !*vmSnapshot.Status.ReadyToUse ||
+		vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return nil, fmt.Sprintf("VirtualMachineSnapshot %s is not ready", name), nil
+	}
+
+	objKey := cacheKeyFunc(vmExport.Namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName)
+	obj, exists, err = ctrl.VMSnapshotContentInformer.GetStore().GetByKey(objKey)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !exists {
+		return nil, "", fmt.Errorf("VMSnapshotContent %s does not exist", objKey)
+	}
+
+	content := obj.(*snapshotv1.VirtualMachineSnapshotContent)
+	source := &exportSource{vm: content.Spec.Source.VirtualMachine}
+	for _, volumeBackup := range content.Spec.VolumeBackups {
+		pvcName := exportPVCName(vmExport, volumeBackup.VolumeName)
+		pvc, err := ctrl.getPVC(vmExport.Namespace, pvcName)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if pvc == nil {
+			if pvc, err = ctrl.createExportPVC(vmExport, volumeBackup, pvcName); err != nil {
+				return nil, "", err
+			}
+		}
+
+		source.volumes = append(source.volumes, exportVolume{name: volumeBackup.PersistentVolumeClaim.Name, pvc: pvc})
+	}
+
+	return source, "", nil
+}
+
+func (ctrl *VMExportController) createExportPVC(vmExport *exportv1.VirtualMachineExport, volumeBackup snapshotv1.VolumeBackup, name string) (*corev1.PersistentVolumeClaim, error) {
+	pvc, err := snapshot.CreateRestorePVCDef(name, volumeBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	pvc.Namespace = vmExport.Namespace
+	pvc.OwnerReferences = []metav1.OwnerReference{ownerRef(vmExport)}
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmExport.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return nil, err
+	}
+
+	ctrl.Recorder.Eventf(vmExport, corev1.EventTypeNormal, exportPVCCreatedEvent, "Created PersistentVolumeClaim %s from VolumeSnapshot", name)
+
+	return pvc, nil
+}
+
+// ensureCertSecret creates the serving certificate of the export server
+// and returns the PEM encoded CA certificate which signed it
+func (ctrl *VMExportController) ensureCertSecret(vmExport *exportv1.VirtualMachineExport) (string, error) {
+	name := exportResourceName(vmExport)
+	secrets := ctrl.Client.CoreV1().Secrets(vmExport.Namespace)
+
+	secret, err := secrets.Get(context.Background(), name, metav1.GetOptions{})
+	if err == nil {
+		return string(secret.Data[caKey]), nil
+	}
+
+	if !errors.IsNotFound(err) {
+		return "", err
+	}
+
+	ca, err := triple.NewCA("export.kubevirt.io", certDuration)
+	if err != nil {
+		return "", err
+	}
+
+	keyPair, err := triple.NewServerKeyPair(ca, fmt.Sprintf("%s.%s.svc", name, vmExport.Namespace), name, vmExport.Namespace, "cluster.local", nil, nil, certDuration)
+	if err != nil {
+		return "", err
+	}
+
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       vmExport.Namespace,
+			OwnerReferences: []metav1.OwnerReference{ownerRef(vmExport)},
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certutil.EncodeCertPEM(keyPair.Cert),
+			corev1.TLSPrivateKeyKey: certutil.EncodePrivateKeyPEM(keyPair.Key),
+			caKey:                   certutil.EncodeCertPEM(ca.Cert),
+		},
+	}
+
+	if _, err = secrets.Create(context.Background(), secret, metav1.CreateOptions{}); err != nil {
+		return "", err
+	}
+
+	return string(secret.Data[caKey]), nil
+}
+
+// ensureManifests stores the sanitized manifests of the source in the config map
+// served by the export server
+func (ctrl *VMExportController) ensureManifests(vmExport *exportv1.VirtualMachineExport, source *exportSource) error {
+	name := exportResourceName(vmExport)
+	configMaps := ctrl.Client.CoreV1().ConfigMaps(vmExport.Namespace)
+
+	_, err := configMaps.Get(context.Background(), name, metav1.GetOptions{})
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+
+	manifests, err := exportManifests(source)
+	if err != nil {
+		return err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       vmExport.Namespace,
+			OwnerReferences: []metav1.OwnerReference{ownerRef(vmExport)},
+		},
+		Data: manifests,
+	}
+
+	_, err = configMaps.Create(context.Background(), configMap, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (ctrl *VMExportController) ensureService(vmExport *exportv1.VirtualMachineExport) error {
+	name := exportResourceName(vmExport)
+	services := ctrl.Client.CoreV1().Services(vmExport.Namespace)
+
+	_, err := services.Get(context.Background(), name, metav1.GetOptions{})
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       vmExport.Namespace,
+			OwnerReferences: []metav1.OwnerReference{ownerRef(vmExport)},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				ExportNameLabel: vmExport.Name,
+			},
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "export",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       exportServicePort,
+					TargetPort: intstr.FromInt(exportServerPort),
+				},
+			},
+		},
+	}
+
+	_, err = services.Create(context.Background(), service, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// newExportServerPod returns the pod serving volumes. File system volumes are expected
+// to hold their disk image in disk.img, block volumes are served as they are.
+func (ctrl *VMExportController) newExportServerPod(vmExport *exportv1.VirtualMachineExport, volumes []exportVolume) *corev1.Pod {
+	name := exportResourceName(vmExport)
+	qemuID := int64(107)
+	nonRoot := true
+
+	container := corev1.Container{
+		Name:            "exportserver",
+		Image:           ctrl.ExportServerImage,
+		ImagePullPolicy: ctrl.ClusterConfig.GetImagePullPolicy(),
+		Command:         []string{"/usr/bin/virt-exportserver"},
+		Ports: []corev1.ContainerPort{
+			{Name: "export", ContainerPort: exportServerPort, Protocol: corev1.ProtocolTCP},
+		},
+		ReadinessProbe: &corev1.Probe{
+			Handler: corev1.Handler{
+				HTTPGet: &corev1.HTTPGetAction{
+					Path:   exportserver.HealthzPath,
+					Port:   intstr.FromInt(exportServerPort),
+					Scheme: corev1.URISchemeHTTPS,
+				},
+			},
+			InitialDelaySeconds: 2,
+			PeriodSeconds:       5,
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "token", MountPath: "/token", ReadOnly: true},
+			{Name: "cert", MountPath: "/cert", ReadOnly: true},
+			{Name: "manifests", MountPath: "/manifests", ReadOnly: true},
+			{Name: "scratch", MountPath: "/scratch"},
+		},
+	}
+
+	podVolumes := []corev1.Volume{
+		{
+			Name: "token",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: vmExport.Spec.TokenSecretRef,
+					Items:      []corev1.KeyToPath{{Key: exportv1.TokenKey, Path: exportv1.TokenKey}},
+				},
+			},
+		},
+		{
+			Name: "cert",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: name},
+			},
+		},
+		{
+			Name: "manifests",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+				},
+			},
+		},
+		{
+			Name: "scratch",
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+
+	for i, volume := range volumes {
+		// claim names may contain dots which are not allowed in pod volume names
+		volumeName := fmt.Sprintf("volume-%d", i)
+		var imagePath string
+		if isBlock(volume.pvc) {
+			imagePath = fmt.Sprintf("%s/%s", blockVolumesDir, volume.name)
+			container.VolumeDevices = append(container.VolumeDevices, corev1.VolumeDevice{
+				Name:       volumeName,
+				DevicePath: imagePath,
+			})
+		} else {
+			mountPath := fmt.Sprintf("%s/%s", volumesDir, volume.name)
+			imagePath = fmt.Sprintf("%s/%s", mountPath, diskImageName)
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: mountPath,
+				ReadOnly:  true,
+			})
+		}
+
+		container.Args = append(container.Args, "--volume", fmt.Sprintf("%s=%s", volume.name, imagePath))
+		podVolumes = append(podVolumes, corev1.Volume{
+			Name: volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: volume.pvc.Name,
+					ReadOnly:  true,
+				},
+			},
+		})
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       vmExport.Namespace,
+			Labels:          map[string]string{ExportNameLabel: vmExport.Name},
+			OwnerReferences: []metav1.OwnerReference{ownerRef(vmExport)},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyAlways,
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsUser:    &qemuID,
+				RunAsGroup:   &qemuID,
+				FSGroup:      &qemuID,
+				RunAsNonRoot: &nonRoot,
+			},
+			Containers: []corev1.Container{container},
+			Volumes:    podVolumes,
+		},
+	}
+}
+
+func (ctrl *VMExportController) doUpdateError(vmExport *exportv1.VirtualMachineExport, err error) error {
+	ctrl.Recorder.Eventf(
+		vmExport,
+		corev1.EventTypeWarning,
+		exportErrorEvent,
+		"VirtualMachineExport encountered error %s",
+		err.Error(),
+	)
+
+	updated := vmExport.DeepCopy()
+	if updated.Status == nil {
+		updated.Status = &exportv1.VirtualMachineExportStatus{}
+	}
+
+	setPending(updated, err.Error())
+	if err2 := ctrl.doUpdate(vmExport, updated); err2 != nil {
+		return err2
+	}
+
+	return err
+}
+
+func (ctrl *VMExportController) doUpdate(original, updated *exportv1.VirtualMachineExport) error {
+	if !reflect.DeepEqual(original, updated) {
+		if _, err := ctrl.Client.VirtualMachineExport(updated.Namespace).Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *VMExportController) getPod(namespace, name string) (*corev1.Pod, error) {
+	obj, exists, err := ctrl.PodInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*corev1.Pod).DeepCopy(), nil
+}
+
+func (ctrl *VMExportController) getPVC(namespace, name string) (*corev1.PersistentVolumeClaim, error) {
+	obj, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+
+	return obj.(*corev1.PersistentVolumeClaim).DeepCopy(), nil
+}
+
+// claimNames returns the names of the PersistentVolumeClaims backing volumes,
+// a DataVolume is backed by the claim of the same name
+func claimNames(volumes []kubevirtv1.Volume) []string {
+	var names []string
+	for _, volume := range volumes {
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			names = append(names, volume.PersistentVolumeClaim.ClaimName)
+		case volume.DataVolume != nil:
+			names = append(names, volume.DataVolume.Name)
+		}
+	}
+
+	return names
+}
+
+// exportManifests returns the manifests of the source keyed by their file names
+func exportManifests(source *exportSource) (map[string]string, error) {
+	manifests := make(map[string]string)
+
+	if source.vm != nil {
+		b, err := yaml.Marshal(exportedVM(source.vm))
+		if err != nil {
+			return nil, err
+		}
+		manifests["vm.yaml"] = string(b)
+	}
+
+	for _, volume := range source.volumes {
+		b, err := yaml.Marshal(exportedPVC(volume.name, volume.pvc))
+		if err != nil {
+			return nil, err
+		}
+		manifests[fmt.Sprintf("pvc-%s.yaml", volume.name)] = string(b)
+	}
+
+	return manifests, nil
+}
+
+// exportedVM strips the cluster specific fields from vm. DataVolumes are
+// replaced by the claims they are backed by, which are exported as well.
+func exportedVM(vm *kubevirtv1.VirtualMachine) *kubevirtv1.VirtualMachine {
+	exported := &kubevirtv1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kubevirtv1.GroupVersion.String(),
+			Kind:       virtualMachineKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        vm.Name,
+			Labels:      vm.Labels,
+			Annotations: exportedAnnotations(vm.Annotations),
+		},
+		Spec: *vm.Spec.DeepCopy(),
+	}
+
+	volumes := exported.Spec.Template.Spec.Volumes
+	for i := range volumes {
+		if volumes[i].DataVolume != nil {
+			volumes[i].VolumeSource = kubevirtv1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: volumes[i].DataVolume.Name,
+				},
+			}
+		}
+	}
+	exported.Spec.DataVolumeTemplates = nil
+
+	return exported
+}
+
+func exportedPVC(name string, pvc *corev1.PersistentVolumeClaim) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       persistentVolumeClaimKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: pvc.Labels,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      pvc.Spec.AccessModes,
+			Resources:        pvc.Spec.Resources,
+			VolumeMode:       pvc.Spec.VolumeMode,
+			StorageClassName: pvc.Spec.StorageClassName,
+		},
+	}
+}
+
+// exportedAnnotations drops the annotations kubectl and KubeVirt maintain
+func exportedAnnotations(annotations map[string]string) map[string]string {
+	var exported map[string]string
+	for k, v := range annotations {
+		if k == corev1.LastAppliedConfigAnnotation || strings.HasPrefix(k, "kubevirt.io/") {
+			continue
+		}
+
+		if exported == nil {
+			exported = make(map[string]string)
+		}
+		exported[k] = v
+	}
+
+	return exported
+}
+
+func exportLinks(vmExport *exportv1.VirtualMachineExport, caCert string, source *exportSource) *exportv1.VirtualMachineExportLink {
+	base := fmt.Sprintf("https://%s.%s.svc", exportResourceName(vmExport), vmExport.Namespace)
+	link := &exportv1.VirtualMachineExportLink{
+		Cert: caCert,
+	}
+
+	for _, volume := range source.volumes {
+		exportedVolume := exportv1.VirtualMachineExportVolume{Name: volume.name}
+		for _, format := range []exportv1.ExportVolumeFormat{exportv1.FormatRaw, exportv1.FormatGzip, exportv1.FormatQcow2} {
+			exportedVolume.Formats = append(exportedVolume.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: format,
+				URL:    base + exportserver.VolumePath(volume.name, format),
+			})
+		}
+		link.Volumes = append(link.Volumes, exportedVolume)
+	}
+
+	if source.vm != nil {
+		link.Manifests = append(link.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.VirtualMachineManifest,
+			URL:  base + exportserver.ManifestPath(exportv1.VirtualMachineManifest),
+		})
+	}
+	link.Manifests = append(link.Manifests, exportv1.VirtualMachineExportManifest{
+		Type: exportv1.AllManifests,
+		URL:  base + exportserver.ManifestPath(exportv1.AllManifests),
+	})
+
+	return link
+}
+
+func isBlock(pvc *corev1.PersistentVolumeClaim) bool {
+	return pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+func ownerRef(vmExport *exportv1.VirtualMachineExport) metav1.OwnerReference {
+	t := true
+	return metav1.OwnerReference{
+		APIVersion:         exportv1.SchemeGroupVersion.String(),
+		Kind:               virtualMachineExportKind,
+		Name:               vmExport.Name,
+		UID:                vmExport.UID,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+}
+
+func setPending(vmExport *exportv1.VirtualMachineExport, reason string) {
+	vmExport.Status.Phase = exportv1.Pending
+	updateCondition(vmExport, newReadyCondition(corev1.ConditionFalse, reason))
+}
+
+func newReadyCondition(status corev1.ConditionStatus, reason string) exportv1.Condition {
+	return exportv1.Condition{
+		Type:               exportv1.ConditionReady,
+		Status:             status,
+		Reason:             reason,
+		LastTransitionTime: *currentTime(),
+	}
+}
+
+func updateCondition(vmExport *exportv1.VirtualMachineExport, c exportv1.Condition) {
+	for i := range vmExport.Status.Conditions {
+		if vmExport.Status.Conditions[i].Type == c.Type {
+			if vmExport.Status.Conditions[i].Status != c.Status || vmExport.Status.Conditions[i].Reason != c.Reason {
+				vmExport.Status.Conditions[i] = c
+			}
+			return
+		}
+	}
+
+	vmExport.Status.Conditions = append(vmExport.Status.Conditions, c)
+}
@@ -0,0 +1,292 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package export
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMExportController is responsible for exporting VMs
+type VMExportController struct {
+	Client kubecli.KubevirtClient
+
+	VMExportInformer          cache.SharedIndexInformer
+	PodInformer               cache.SharedIndexInformer
+	PVCInformer               cache.SharedIndexInformer
+	VMInformer                cache.SharedIndexInformer
+	VMIInformer               cache.SharedIndexInformer
+	VMSnapshotInformer        cache.SharedIndexInformer
+	VMSnapshotContentInformer cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	ClusterConfig *virtconfig.ClusterConfig
+
+	// ExportServerImage is the image running the export server
+	ExportServerImage string
+
+	vmExportQueue workqueue.RateLimitingInterface
+}
+
+// Init initializes the export controller
+func (ctrl *VMExportController) Init() {
+	ctrl.vmExportQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "export-controller-vmexport")
+
+	ctrl.VMExportInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMExport,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMExport(newObj) },
+		},
+	)
+
+	ctrl.PodInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleOwnedObject,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleOwnedObject(newObj) },
+			DeleteFunc: ctrl.handleOwnedObject,
+		},
+	)
+
+	ctrl.PVCInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handlePVC,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handlePVC(newObj) },
+			DeleteFunc: ctrl.handlePVC,
+		},
+	)
+
+	ctrl.VMInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVM,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVM(newObj) },
+			DeleteFunc: ctrl.handleVM,
+		},
+	)
+
+	ctrl.VMIInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMI,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMI(newObj) },
+			DeleteFunc: ctrl.handleVMI,
+		},
+	)
+
+	ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshot,
+		},
+	)
+}
+
+// Run the controller
+func (ctrl *VMExportController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.vmExportQueue.ShutDown()
+
+	log.Log.Info("Starting export controller.")
+	defer log.Log.Info("Shutting down export controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMExportInformer.HasSynced,
+		ctrl.PodInformer.HasSynced,
+		ctrl.PVCInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotContentInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmExportWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMExportController) vmExportWorker() {
+	for ctrl.processVMExportWorkItem() {
+	}
+}
+
+func (ctrl *VMExportController) processVMExportWorkItem() bool {
+	obj, shutdown := ctrl.vmExportQueue.Get()
+	if shutdown {
+		return false
+	}
+	defer ctrl.vmExportQueue.Done(obj)
+
+	key, ok := obj.(string)
+	if !ok {
+		ctrl.vmExportQueue.Forget(obj)
+		utilruntime.HandleError(fmt.Errorf("expected string in workqueue but got %#v", obj))
+		return true
+	}
+
+	log.Log.V(3).Infof("vmExport worker processing key [%s]", key)
+
+	if err := ctrl.execute(key); err != nil {
+		utilruntime.HandleError(err)
+		ctrl.vmExportQueue.AddRateLimited(key)
+		return true
+	}
+
+	ctrl.vmExportQueue.Forget(obj)
+	return true
+}
+
+func (ctrl *VMExportController) execute(key string) error {
+	storeObj, exists, err := ctrl.VMExportInformer.GetStore().GetByKey(key)
+	if !exists || err != nil {
+		return err
+	}
+
+	vmExport, ok := storeObj.(*exportv1.VirtualMachineExport)
+	if !ok {
+		return fmt.Errorf("unexpected resource %+v", storeObj)
+	}
+
+	return ctrl.updateVMExport(vmExport.DeepCopy())
+}
+
+func (ctrl *VMExportController) handleVMExport(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmExport, ok := obj.(*exportv1.VirtualMachineExport); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(vmExport)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, vmExport)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmExportQueue.Add(objName)
+	}
+}
+
+// handleOwnedObject enqueues the VirtualMachineExport controlling obj
+func (ctrl *VMExportController) handleOwnedObject(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if o, ok := obj.(metav1.Object); ok {
+		ownerRef := metav1.GetControllerOf(o)
+		if ownerRef == nil || ownerRef.Kind != virtualMachineExportKind || ownerRef.APIVersion != exportv1.SchemeGroupVersion.String() {
+			return
+		}
+
+		objName := cacheKeyFunc(o.GetNamespace(), ownerRef.Name)
+
+		log.Log.V(3).Infof("Handling %s/%s, Export %s", o.GetNamespace(), o.GetName(), objName)
+		ctrl.vmExportQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMExportController) handlePVC(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if pvc, ok := obj.(*corev1.PersistentVolumeClaim); ok {
+		ctrl.handleOwnedObject(pvc)
+		ctrl.enqueueExports(pvc.Namespace, func(vmExport *exportv1.VirtualMachineExport) bool {
+			source := vmExport.Spec.Source
+			return source.Kind == virtualMachineKind || (source.Kind == persistentVolumeClaimKind && source.Name == pvc.Name)
+		})
+	}
+}
+
+func (ctrl *VMExportController) handleVM(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vm, ok := obj.(*kubevirtv1.VirtualMachine); ok {
+		ctrl.enqueueExports(vm.Namespace, func(vmExport *exportv1.VirtualMachineExport) bool {
+			return vmExport.Spec.Source.Kind == virtualMachineKind && vmExport.Spec.Source.Name == vm.Name
+		})
+	}
+}
+
+func (ctrl *VMExportController) handleVMI(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmi, ok := obj.(*kubevirtv1.VirtualMachineInstance); ok {
+		ctrl.enqueueExports(vmi.Namespace, func(vmExport *exportv1.VirtualMachineExport) bool {
+			source := vmExport.Spec.Source
+			return source.Kind == persistentVolumeClaimKind || (source.Kind == virtualMachineKind && source.Name == vmi.Name)
+		})
+	}
+}
+
+func (ctrl *VMExportController) handleVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		ctrl.enqueueExports(vmSnapshot.Namespace, func(vmExport *exportv1.VirtualMachineExport) bool {
+			return vmExport.Spec.Source.Kind == virtualMachineSnapshotKind && vmExport.Spec.Source.Name == vmSnapshot.Name
+		})
+	}
+}
+
+// enqueueExports enqueues the VirtualMachineExports in namespace which match
+func (ctrl *VMExportController) enqueueExports(namespace string, match func(*exportv1.VirtualMachineExport) bool) {
+	objs, err := ctrl.VMExportInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		utilruntime.HandleError(err)
+		return
+	}
+
+	for _, obj := range objs {
+		vmExport := obj.(*exportv1.VirtualMachineExport)
+		if match(vmExport) {
+			ctrl.vmExportQueue.Add(cacheKeyFunc(vmExport.Namespace, vmExport.Name))
+		}
+	}
+}
@@ -0,0 +1,542 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package export
+
+import (
+	"context"
+
+	"github.com/ghodss/yaml"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Export", func() {
+	const (
+		testNamespace  = metav1.NamespaceDefault
+		exportName     = "export"
+		exportUID      = "abcdef-1234"
+		sourceVMName   = "source"
+		vmSnapshotName = "snapshot"
+		contentName    = "content"
+		tokenSecret    = "token"
+		exportImage    = "kubevirt/virt-launcher:latest"
+	)
+
+	var timeStamp = metav1.Now()
+
+	var ctrl *gomock.Controller
+	var virtClient *kubecli.MockKubevirtClient
+	var kubevirtClient *kubevirtfake.Clientset
+	var k8sClient *k8sfake.Clientset
+	var vmExportInformer cache.SharedIndexInformer
+	var podInformer cache.SharedIndexInformer
+	var pvcInformer cache.SharedIndexInformer
+	var vmInformer cache.SharedIndexInformer
+	var vmiInformer cache.SharedIndexInformer
+	var vmSnapshotInformer cache.SharedIndexInformer
+	var vmSnapshotContentInformer cache.SharedIndexInformer
+	var recorder *record.FakeRecorder
+	var controller *VMExportController
+
+	apiGroup := v1.GroupName
+	snapshotAPIGroup := snapshotv1.SchemeGroupVersion.Group
+
+	createExport := func(kind, name string) *exportv1.VirtualMachineExport {
+		source := corev1.TypedLocalObjectReference{
+			Kind: kind,
+			Name: name,
+		}
+		switch kind {
+		case virtualMachineKind:
+			source.APIGroup = &apiGroup
+		case virtualMachineSnapshotKind:
+			source.APIGroup = &snapshotAPIGroup
+		}
+
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      exportName,
+				Namespace: testNamespace,
+				UID:       exportUID,
+			},
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source:         source,
+				TokenSecretRef: tokenSecret,
+			},
+		}
+	}
+
+	createPVC := func(name string, volumeMode corev1.PersistentVolumeMode) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				UID:       "pvc-uid",
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: resource.MustParse("1Gi"),
+					},
+				},
+				VolumeMode: &volumeMode,
+				VolumeName: "pv-" + name,
+			},
+		}
+	}
+
+	createVM := func() *v1.VirtualMachine {
+		return &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sourceVMName,
+				Namespace: testNamespace,
+				UID:       "vm-uid",
+				Annotations: map[string]string{
+					corev1.LastAppliedConfigAnnotation: "{}",
+					"note":                             "golden",
+				},
+			},
+			Spec: v1.VirtualMachineSpec{
+				DataVolumeTemplates: []v1.DataVolumeTemplateSpec{
+					{ObjectMeta: metav1.ObjectMeta{Name: "rootdisk"}},
+				},
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{
+							{
+								Name: "disk0",
+								VolumeSource: v1.VolumeSource{
+									DataVolume: &v1.DataVolumeSource{Name: "rootdisk"},
+								},
+							},
+							{
+								Name: "disk1",
+								VolumeSource: v1.VolumeSource{
+									PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+								},
+							},
+							{
+								Name: "cloudinit",
+								VolumeSource: v1.VolumeSource{
+									CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	createVMI := func(name string, claimName string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Volumes: []v1.Volume{
+					{
+						Name: "disk0",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	createSnapshot := func(ready bool) *snapshotv1.VirtualMachineSnapshot {
+		content := contentName
+		return &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmSnapshotName,
+				Namespace: testNamespace,
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				ReadyToUse:                        &ready,
+				VirtualMachineSnapshotContentName: &content,
+			},
+		}
+	}
+
+	createContent := func() *snapshotv1.VirtualMachineSnapshotContent {
+		volumeSnapshotName := "vs-disk0"
+		volumeMode := corev1.PersistentVolumeFilesystem
+		return &snapshotv1.VirtualMachineSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      contentName,
+				Namespace: testNamespace,
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+				Source: snapshotv1.SourceSpec{
+					VirtualMachine: createVM(),
+				},
+				VolumeBackups: []snapshotv1.VolumeBackup{
+					{
+						VolumeName: "disk0",
+						PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "rootdisk",
+							},
+							Spec: corev1.PersistentVolumeClaimSpec{
+								AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceStorage: resource.MustParse("1Gi"),
+									},
+								},
+								VolumeMode: &volumeMode,
+							},
+						},
+						VolumeSnapshotName: &volumeSnapshotName,
+					},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+
+		vmExportInformer, _ = testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
+		podInformer, _ = testutils.NewFakeInformerFor(&corev1.Pod{})
+		pvcInformer, _ = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		recorder = record.NewFakeRecorder(100)
+		config, _, _, _ := testutils.NewFakeClusterConfig(&corev1.ConfigMap{})
+
+		controller = &VMExportController{
+			Client:                    virtClient,
+			VMExportInformer:          vmExportInformer,
+			PodInformer:               podInformer,
+			PVCInformer:               pvcInformer,
+			VMInformer:                vmInformer,
+			VMIInformer:               vmiInformer,
+			VMSnapshotInformer:        vmSnapshotInformer,
+			VMSnapshotContentInformer: vmSnapshotContentInformer,
+			Recorder:                  recorder,
+			ClusterConfig:             config,
+			ExportServerImage:         exportImage,
+		}
+		controller.Init()
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset()
+
+		virtClient.EXPECT().VirtualMachineExport(testNamespace).
+			Return(kubevirtClient.ExportV1alpha1().VirtualMachineExports(testNamespace)).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		currentTime = func() *metav1.Time {
+			return &timeStamp
+		}
+	})
+
+	addExport := func(vmExport *exportv1.VirtualMachineExport) {
+		Expect(vmExportInformer.GetIndexer().Add(vmExport)).To(Succeed())
+		_, err := kubevirtClient.ExportV1alpha1().VirtualMachineExports(testNamespace).Create(context.Background(), vmExport, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	execute := func(vmExport *exportv1.VirtualMachineExport) *exportv1.VirtualMachineExport {
+		Expect(controller.execute(cacheKeyFunc(vmExport.Namespace, vmExport.Name))).To(Succeed())
+		updated, err := kubevirtClient.ExportV1alpha1().VirtualMachineExports(vmExport.Namespace).Get(context.Background(), vmExport.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return updated
+	}
+
+	getPod := func(vmExport *exportv1.VirtualMachineExport) *corev1.Pod {
+		pod, err := k8sClient.CoreV1().Pods(testNamespace).Get(context.Background(), exportResourceName(vmExport), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pod
+	}
+
+	markPodReady := func(pod *corev1.Pod) {
+		pod.Status.Conditions = []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+		}
+		Expect(podInformer.GetIndexer().Add(pod)).To(Succeed())
+	}
+
+	expectReadyCondition := func(vmExport *exportv1.VirtualMachineExport, status corev1.ConditionStatus, reason string) {
+		Expect(vmExport.Status.Conditions).To(HaveLen(1))
+		Expect(vmExport.Status.Conditions[0].Type).To(Equal(exportv1.ConditionReady))
+		Expect(vmExport.Status.Conditions[0].Status).To(Equal(status))
+		Expect(vmExport.Status.Conditions[0].Reason).To(ContainSubstring(reason))
+	}
+
+	Context("with a PersistentVolumeClaim source", func() {
+		It("should wait for the claim to exist", func() {
+			vmExport := createExport(persistentVolumeClaimKind, "data")
+			addExport(vmExport)
+
+			updated := execute(vmExport)
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+			expectReadyCondition(updated, corev1.ConditionFalse, "PersistentVolumeClaim data does not exist")
+		})
+
+		It("should not export a claim in use", func() {
+			vmExport := createExport(persistentVolumeClaimKind, "data")
+			addExport(vmExport)
+			Expect(pvcInformer.GetIndexer().Add(createPVC("data", corev1.PersistentVolumeFilesystem))).To(Succeed())
+			Expect(vmiInformer.GetIndexer().Add(createVMI("vmi", "data"))).To(Succeed())
+
+			updated := execute(vmExport)
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+			expectReadyCondition(updated, corev1.ConditionFalse, "in use by VirtualMachineInstance vmi")
+		})
+
+		It("should create the export server", func() {
+			vmExport := createExport(persistentVolumeClaimKind, "data")
+			addExport(vmExport)
+			Expect(pvcInformer.GetIndexer().Add(createPVC("data", corev1.PersistentVolumeFilesystem))).To(Succeed())
+
+			updated := execute(vmExport)
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+			Expect(updated.Status.ServiceName).To(Equal("virt-export-export"))
+			expectReadyCondition(updated, corev1.ConditionFalse, "Waiting for export server")
+			testutils.ExpectEvent(recorder, exportServerCreatedEvent)
+
+			link := updated.Status.Links.Internal
+			Expect(link.Cert).To(ContainSubstring("BEGIN CERTIFICATE"))
+			Expect(link.Volumes).To(HaveLen(1))
+			Expect(link.Volumes[0].Name).To(Equal("data"))
+			Expect(link.Volumes[0].Formats).To(ConsistOf(
+				exportv1.VirtualMachineExportVolumeFormat{Format: exportv1.FormatRaw, URL: "https://virt-export-export.default.svc/volumes/data/disk.img"},
+				exportv1.VirtualMachineExportVolumeFormat{Format: exportv1.FormatGzip, URL: "https://virt-export-export.default.svc/volumes/data/disk.img.gz"},
+				exportv1.VirtualMachineExportVolumeFormat{Format: exportv1.FormatQcow2, URL: "https://virt-export-export.default.svc/volumes/data/disk.qcow2"},
+			))
+			Expect(link.Manifests).To(HaveLen(1))
+			Expect(link.Manifests[0].Type).To(Equal(exportv1.AllManifests))
+
+			pod := getPod(vmExport)
+			Expect(metav1.IsControlledBy(pod, vmExport)).To(BeTrue())
+			Expect(pod.Labels).To(HaveKeyWithValue(ExportNameLabel, exportName))
+			Expect(pod.Spec.Containers).To(HaveLen(1))
+			Expect(pod.Spec.Containers[0].Image).To(Equal(exportImage))
+			Expect(pod.Spec.Containers[0].Args).To(Equal([]string{"--volume", "data=/export-volumes/data/disk.img"}))
+			Expect(pod.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "volume-0",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data", ReadOnly: true},
+				},
+			}))
+
+			secret, err := k8sClient.CoreV1().Secrets(testNamespace).Get(context.Background(), "virt-export-export", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Data).To(HaveKey(corev1.TLSCertKey))
+			Expect(secret.Data).To(HaveKey(corev1.TLSPrivateKeyKey))
+			Expect(string(secret.Data[caKey])).To(Equal(link.Cert))
+
+			service, err := k8sClient.CoreV1().Services(testNamespace).Get(context.Background(), "virt-export-export", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(service.Spec.Selector).To(Equal(map[string]string{ExportNameLabel: exportName}))
+
+			configMap, err := k8sClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), "virt-export-export", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configMap.Data).To(HaveKey("pvc-data.yaml"))
+			Expect(configMap.Data).ToNot(HaveKey("vm.yaml"))
+		})
+
+		It("should serve block volumes as devices", func() {
+			vmExport := createExport(persistentVolumeClaimKind, "data")
+			addExport(vmExport)
+			Expect(pvcInformer.GetIndexer().Add(createPVC("data", corev1.PersistentVolumeBlock))).To(Succeed())
+
+			execute(vmExport)
+
+			pod := getPod(vmExport)
+			Expect(pod.Spec.Containers[0].Args).To(Equal([]string{"--volume", "data=/dev/export-volumes/data"}))
+			Expect(pod.Spec.Containers[0].VolumeDevices).To(Equal([]corev1.VolumeDevice{
+				{Name: "volume-0", DevicePath: "/dev/export-volumes/data"},
+			}))
+		})
+
+		It("should become ready with the export server", func() {
+			vmExport := createExport(persistentVolumeClaimKind, "data")
+			addExport(vmExport)
+			Expect(pvcInformer.GetIndexer().Add(createPVC("data", corev1.PersistentVolumeFilesystem))).To(Succeed())
+
+			execute(vmExport)
+			markPodReady(getPod(vmExport))
+			// the export server holds the claim now
+			Expect(vmiInformer.GetIndexer().Add(createVMI("vmi", "data"))).To(Succeed())
+
+			updated := execute(vmExport)
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Ready))
+			expectReadyCondition(updated, corev1.ConditionTrue, "Export server is ready")
+		})
+	})
+
+	Context("with a VirtualMachine source", func() {
+		BeforeEach(func() {
+			Expect(vmInformer.GetIndexer().Add(createVM())).To(Succeed())
+			Expect(pvcInformer.GetIndexer().Add(createPVC("rootdisk", corev1.PersistentVolumeFilesystem))).To(Succeed())
+			Expect(pvcInformer.GetIndexer().Add(createPVC("data", corev1.PersistentVolumeBlock))).To(Succeed())
+		})
+
+		It("should not export a running VirtualMachine", func() {
+			vmExport := createExport(virtualMachineKind, sourceVMName)
+			addExport(vmExport)
+			Expect(vmiInformer.GetIndexer().Add(createVMI(sourceVMName, "rootdisk"))).To(Succeed())
+
+			updated := execute(vmExport)
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+			expectReadyCondition(updated, corev1.ConditionFalse, "VirtualMachine source is running")
+		})
+
+		It("should export all claims of the VirtualMachine", func() {
+			vmExport := createExport(virtualMachineKind, sourceVMName)
+			addExport(vmExport)
+
+			updated := execute(vmExport)
+
+			link := updated.Status.Links.Internal
+			Expect(link.Volumes).To(HaveLen(2))
+			Expect(link.Volumes[0].Name).To(Equal("rootdisk"))
+			Expect(link.Volumes[1].Name).To(Equal("data"))
+			Expect(link.Manifests).To(ConsistOf(
+				exportv1.VirtualMachineExportManifest{Type: exportv1.VirtualMachineManifest, URL: "https://virt-export-export.default.svc/manifests/vm.yaml"},
+				exportv1.VirtualMachineExportManifest{Type: exportv1.AllManifests, URL: "https://virt-export-export.default.svc/manifests/all.tar.gz"},
+			))
+
+			pod := getPod(vmExport)
+			Expect(pod.Spec.Containers[0].Args).To(Equal([]string{
+				"--volume", "rootdisk=/export-volumes/rootdisk/disk.img",
+				"--volume", "data=/dev/export-volumes/data",
+			}))
+		})
+
+		It("should store the sanitized VirtualMachine", func() {
+			vmExport := createExport(virtualMachineKind, sourceVMName)
+			addExport(vmExport)
+
+			execute(vmExport)
+
+			configMap, err := k8sClient.CoreV1().ConfigMaps(testNamespace).Get(context.Background(), "virt-export-export", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configMap.Data).To(HaveKey("pvc-rootdisk.yaml"))
+			Expect(configMap.Data).To(HaveKey("pvc-data.yaml"))
+
+			vm := &v1.VirtualMachine{}
+			Expect(yaml.Unmarshal([]byte(configMap.Data["vm.yaml"]), vm)).To(Succeed())
+			Expect(vm.Kind).To(Equal("VirtualMachine"))
+			Expect(vm.Name).To(Equal(sourceVMName))
+			Expect(vm.Namespace).To(BeEmpty())
+			Expect(vm.UID).To(BeEmpty())
+			Expect(vm.Annotations).To(Equal(map[string]string{"note": "golden"}))
+			Expect(vm.Spec.DataVolumeTemplates).To(BeEmpty())
+			Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume).To(BeNil())
+			Expect(vm.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("rootdisk"))
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Expect(yaml.Unmarshal([]byte(configMap.Data["pvc-rootdisk.yaml"]), pvc)).To(Succeed())
+			Expect(pvc.Name).To(Equal("rootdisk"))
+			Expect(pvc.Spec.VolumeName).To(BeEmpty())
+			Expect(pvc.Spec.Resources.Requests.Storage().String()).To(Equal("1Gi"))
+		})
+	})
+
+	Context("with a VirtualMachineSnapshot source", func() {
+		It("should wait for the snapshot to be ready", func() {
+			vmExport := createExport(virtualMachineSnapshotKind, vmSnapshotName)
+			addExport(vmExport)
+			Expect(vmSnapshotInformer.GetIndexer().Add(createSnapshot(false))).To(Succeed())
+
+			updated := execute(vmExport)
+
+			Expect(updated.Status.Phase).To(Equal(exportv1.Pending))
+			expectReadyCondition(updated, corev1.ConditionFalse, "VirtualMachineSnapshot snapshot is not ready")
+		})
+
+		It("should restore the volumes of the snapshot", func() {
+			vmExport := createExport(virtualMachineSnapshotKind, vmSnapshotName)
+			addExport(vmExport)
+			Expect(vmSnapshotInformer.GetIndexer().Add(createSnapshot(true))).To(Succeed())
+			Expect(vmSnapshotContentInformer.GetIndexer().Add(createContent())).To(Succeed())
+
+			updated := execute(vmExport)
+			testutils.ExpectEvents(recorder, exportPVCCreatedEvent, exportServerCreatedEvent)
+
+			pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), "virt-export-export-disk0", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(metav1.IsControlledBy(pvc, vmExport)).To(BeTrue())
+			Expect(pvc.Spec.DataSource.Name).To(Equal("vs-disk0"))
+
+			link := updated.Status.Links.Internal
+			Expect(link.Volumes).To(HaveLen(1))
+			Expect(link.Volumes[0].Name).To(Equal("rootdisk"))
+			Expect(link.Manifests).To(HaveLen(2))
+
+			pod := getPod(vmExport)
+			Expect(pod.Spec.Containers[0].Args).To(Equal([]string{"--volume", "rootdisk=/export-volumes/rootdisk/disk.img"}))
+			Expect(pod.Spec.Volumes[len(pod.Spec.Volumes)-1].PersistentVolumeClaim.ClaimName).To(Equal("virt-export-export-disk0"))
+		})
+	})
+
+	Context("handlers", func() {
+		It("should enqueue the export owning a pod", func() {
+			vmExport := createExport(persistentVolumeClaimKind, "data")
+			Expect(vmExportInformer.GetIndexer().Add(vmExport)).To(Succeed())
+			pod := controller.newExportServerPod(vmExport, nil)
+
+			controller.handleOwnedObject(pod)
+
+			Expect(controller.vmExportQueue.Len()).To(Equal(1))
+		})
+
+		It("should enqueue exports of a claim", func() {
+			vmExport := createExport(persistentVolumeClaimKind, "data")
+			Expect(vmExportInformer.GetIndexer().Add(vmExport)).To(Succeed())
+
+			controller.handlePVC(createPVC("other", corev1.PersistentVolumeFilesystem))
+			Expect(controller.vmExportQueue.Len()).To(Equal(0))
+
+			controller.handlePVC(createPVC("data", corev1.PersistentVolumeFilesystem))
+			Expect(controller.vmExportQueue.Len()).To(Equal(1))
+		})
+	})
+})
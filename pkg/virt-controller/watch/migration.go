@@ -24,10 +24,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -41,6 +43,7 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 
 	"kubevirt.io/kubevirt/pkg/util/migrations"
+	pvcutils "kubevirt.io/kubevirt/pkg/util/types"
 
 	virtv1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/kubecli"
@@ -58,6 +61,9 @@ type MigrationController struct {
 	vmiInformer        cache.SharedIndexInformer
 	podInformer        cache.SharedIndexInformer
 	migrationInformer  cache.SharedIndexInformer
+	policyInformer     cache.SharedIndexInformer
+	namespaceInformer  cache.SharedIndexInformer
+	nodeInformer       cache.SharedIndexInformer
 	recorder           record.EventRecorder
 	podExpectations    *controller.UIDTrackingControllerExpectations
 	migrationStartLock *sync.Mutex
@@ -69,6 +75,9 @@ func NewMigrationController(templateService services.TemplateService,
 	vmiInformer cache.SharedIndexInformer,
 	podInformer cache.SharedIndexInformer,
 	migrationInformer cache.SharedIndexInformer,
+	policyInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -80,6 +89,9 @@ func NewMigrationController(templateService services.TemplateService,
 		vmiInformer:        vmiInformer,
 		podInformer:        podInformer,
 		migrationInformer:  migrationInformer,
+		policyInformer:     policyInformer,
+		namespaceInformer:  namespaceInformer,
+		nodeInformer:       nodeInformer,
 		recorder:           recorder,
 		clientset:          clientset,
 		podExpectations:    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -115,7 +127,7 @@ func (c *MigrationController) Run(threadiness int, stopCh <-chan struct{}) {
 	log.Log.Info("Starting migration controller.")
 
 	// Wait for cache sync before we start the pod controller
-	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.podInformer.HasSynced, c.migrationInformer.HasSynced)
+	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.podInformer.HasSynced, c.migrationInformer.HasSynced, c.policyInformer.HasSynced, c.namespaceInformer.HasSynced, c.nodeInformer.HasSynced)
 
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
@@ -355,7 +367,8 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 				migrationCopy.Status.Phase = virtv1.MigrationRunning
 			}
 		case virtv1.MigrationRunning:
-			if vmi.Status.MigrationState.Completed {
+			// The migration only succeeds once the VMI uses the claims its volumes were moved to
+			if vmi.Status.MigrationState.Completed && syncErr == nil {
 				migrationCopy.Status.Phase = virtv1.MigrationSucceeded
 				c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulMigrationReason, "Source node reported migration succeeded")
 				log.Log.Object(migration).Infof("VMI reported migration succeeded.")
@@ -380,6 +393,16 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 
 func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
 
+	if len(migration.Spec.VolumeMigrations) > 0 {
+		if err := c.verifyVolumeMigrations(migration, vmi); err != nil {
+			c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedVolumeMigrationReason, "Invalid volume migration: %v", err)
+			return err
+		}
+		// The target pod mounts the destination claims of the migrated volumes
+		vmi = vmi.DeepCopy()
+		vmi.Spec.Volumes = migrations.ApplyVolumeMigrations(vmi.Spec.Volumes, migration.Spec.VolumeMigrations)
+	}
+
 	templatePod, err := c.templateService.RenderLaunchManifest(vmi)
 	if err != nil {
 		return fmt.Errorf("failed to render launch manifest: %v", err)
@@ -407,6 +430,13 @@ func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineIn
 		templatePod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution = append(templatePod.Spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution, antiAffinityTerm)
 	}
 
+	if c.clusterConfig.CPUNodeDiscoveryEnabled() && services.IsHostModelCPU(vmi) {
+		if err := c.prepareNodeSelectorForHostModelCPU(vmi, templatePod); err != nil {
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreatePodReason, "Error creating pod: %v", err)
+			return fmt.Errorf("failed to create vmi migration target pod: %v", err)
+		}
+	}
+
 	templatePod.ObjectMeta.Labels[virtv1.MigrationJobLabel] = string(migration.UID)
 	templatePod.ObjectMeta.Annotations[virtv1.MigrationJobNameAnnotation] = string(migration.Name)
 
@@ -425,6 +455,163 @@ func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineIn
 	return nil
 }
 
+// verifyVolumeMigrations makes sure that the destination claims of the volume migrations
+// exist and have the volume mode of the claims they replace, since the migrated disks keep
+// their paths in the domain
+func (c *MigrationController) verifyVolumeMigrations(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	for _, volume := range vmi.Spec.Volumes {
+		for _, volumeMigration := range migration.Spec.VolumeMigrations {
+			if volumeMigration.SourceVolume != volume.Name {
+				continue
+			}
+			var sourceClaim string
+			if volume.PersistentVolumeClaim != nil {
+				sourceClaim = volume.PersistentVolumeClaim.ClaimName
+			} else if volume.DataVolume != nil {
+				sourceClaim = volume.DataVolume.Name
+			} else {
+				return fmt.Errorf("volume %s is not a PersistentVolumeClaim or DataVolume", volume.Name)
+			}
+			destinationClaim := volumeMigration.DestinationPVC + volumeMigration.DestinationDataVolume
+
+			sourcePVC, exists, sourceIsBlock, err := pvcutils.IsPVCBlockFromClient(c.clientset, vmi.Namespace, sourceClaim)
+			if err != nil {
+				return err
+			} else if !exists {
+				return fmt.Errorf("persistentvolumeclaim %s of volume %s not found", sourceClaim, volume.Name)
+			}
+			destinationPVC, exists, destinationIsBlock, err := pvcutils.IsPVCBlockFromClient(c.clientset, vmi.Namespace, destinationClaim)
+			if err != nil {
+				return err
+			} else if !exists {
+				return fmt.Errorf("destination persistentvolumeclaim %s of volume %s not found", destinationClaim, volume.Name)
+			}
+			if sourceIsBlock != destinationIsBlock {
+				return fmt.Errorf("destination persistentvolumeclaim %s of volume %s has a different volume mode", destinationClaim, volume.Name)
+			}
+			sourceCapacity := getClaimCapacity(sourcePVC)
+			destinationCapacity := getClaimCapacity(destinationPVC)
+			if destinationCapacity.Cmp(sourceCapacity) < 0 {
+				return fmt.Errorf("destination persistentvolumeclaim %s of volume %s is smaller than the source, %s < %s",
+					destinationClaim, volume.Name, destinationCapacity.String(), sourceCapacity.String())
+			}
+		}
+	}
+	return nil
+}
+
+// getClaimCapacity returns the capacity of the claim, or the requested storage while it is not bound
+func getClaimCapacity(pvc *k8sv1.PersistentVolumeClaim) resource.Quantity {
+	if capacity, ok := pvc.Status.Capacity[k8sv1.ResourceStorage]; ok {
+		return capacity
+	}
+	return pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]
+}
+
+// updateMigratedVolumes makes the VMI, and the VM owning it, use the destination claims of
+// the volumes moved by a completed migration
+func (c *MigrationController) updateMigratedVolumes(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	volumeMigrations := vmi.Status.MigrationState.VolumeMigrations
+
+	if controllerRef := v1.GetControllerOf(vmi); controllerRef != nil && controllerRef.Kind == virtv1.VirtualMachineGroupVersionKind.Kind {
+		vm, err := c.clientset.VirtualMachine(vmi.Namespace).Get(controllerRef.Name, &v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if vm.UID == controllerRef.UID && vm.Spec.Template != nil {
+			vmCopy := vm.DeepCopy()
+			vmCopy.Spec.Template.Spec.Volumes = migrations.ApplyVolumeMigrations(vm.Spec.Template.Spec.Volumes, volumeMigrations)
+			vmCopy.Spec.DataVolumeTemplates = removeMigratedDataVolumeTemplates(vm, volumeMigrations)
+			if !reflect.DeepEqual(vm.Spec, vmCopy.Spec) {
+				if _, err := c.clientset.VirtualMachine(vm.Namespace).Update(vmCopy); err != nil {
+					c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedVolumeMigrationReason, "Failed to update the volumes of VM %s: %v", vm.Name, err)
+					return err
+				}
+			}
+		}
+	}
+
+	volumes := migrations.ApplyVolumeMigrations(vmi.Spec.Volumes, volumeMigrations)
+	if reflect.DeepEqual(vmi.Spec.Volumes, volumes) {
+		return nil
+	}
+	oldVolumes, err := json.Marshal(vmi.Spec.Volumes)
+	if err != nil {
+		return err
+	}
+	newVolumes, err := json.Marshal(volumes)
+	if err != nil {
+		return err
+	}
+	test := fmt.Sprintf(`{ "op": "test", "path": "/spec/volumes", "value": %s }`, string(oldVolumes))
+	patch := fmt.Sprintf(`{ "op": "replace", "path": "/spec/volumes", "value": %s }`, string(newVolumes))
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(vmi.Name, types.JSONPatchType, []byte(fmt.Sprintf("[ %s, %s ]", test, patch)))
+	if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, FailedVolumeMigrationReason, "Failed to update the volumes of VMI %s: %v", vmi.Name, err)
+		return err
+	}
+	c.recorder.Eventf(migration, k8sv1.EventTypeNormal, SuccessfulVolumeMigrationReason, "VMI %s uses the destination claims of the migrated volumes", vmi.Name)
+	return nil
+}
+
+// removeMigratedDataVolumeTemplates returns the DataVolume templates of the VM without the
+// ones of the DataVolumes that were replaced by volume migrations
+func removeMigratedDataVolumeTemplates(vm *virtv1.VirtualMachine, volumeMigrations []virtv1.VolumeMigration) []virtv1.DataVolumeTemplateSpec {
+	migratedDataVolumes := map[string]struct{}{}
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.DataVolume == nil {
+			continue
+		}
+		for _, volumeMigration := range volumeMigrations {
+			if volumeMigration.SourceVolume == volume.Name {
+				migratedDataVolumes[volume.DataVolume.Name] = struct{}{}
+			}
+		}
+	}
+	if len(migratedDataVolumes) == 0 {
+		return vm.Spec.DataVolumeTemplates
+	}
+
+	var dataVolumeTemplates []virtv1.DataVolumeTemplateSpec
+	for _, dataVolumeTemplate := range vm.Spec.DataVolumeTemplates {
+		if _, migrated := migratedDataVolumes[dataVolumeTemplate.Name]; !migrated {
+			dataVolumeTemplates = append(dataVolumeTemplates, dataVolumeTemplate)
+		}
+	}
+	return dataVolumeTemplates
+}
+
+// prepareNodeSelectorForHostModelCPU makes sure that the target node can run
+// the host-model CPU the VMI got on its source node
+func (c *MigrationController) prepareNodeSelectorForHostModelCPU(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
+	obj, exists, err := c.nodeInformer.GetStore().GetByKey(vmi.Status.NodeName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("source node %s of vmi %s/%s does not exist", vmi.Status.NodeName, vmi.Namespace, vmi.Name)
+	}
+	node := obj.(*k8sv1.Node)
+
+	hostModelFound := false
+	for key, value := range node.Labels {
+		if strings.HasPrefix(key, virtv1.HostModelCPULabel) {
+			hostModelFound = true
+			model := strings.TrimPrefix(key, virtv1.HostModelCPULabel)
+			pod.Spec.NodeSelector[virtv1.SupportedHostModelMigrationCPU+model] = value
+		}
+		if strings.HasPrefix(key, virtv1.HostModelRequiredFeaturesLabel) {
+			feature := strings.TrimPrefix(key, virtv1.HostModelRequiredFeaturesLabel)
+			pod.Spec.NodeSelector[virtv1.CPUFeatureLabel+feature] = value
+		}
+	}
+
+	if !hostModelFound {
+		return fmt.Errorf("source node %s of vmi %s/%s is not labelled with its host-model CPU", vmi.Status.NodeName, vmi.Namespace, vmi.Name)
+	}
+	return nil
+}
+
 func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, pods []*k8sv1.Pod) error {
 
 	var pod *k8sv1.Pod = nil
@@ -465,6 +652,10 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 	migrationDone := vmi.Status.MigrationState != nil && vmi.Status.MigrationState.MigrationUID == migration.UID && vmi.Status.MigrationState.EndTimestamp != nil
 
 	if vmiDeleted || migrationDone {
+		if migrationDone && vmi.Status.MigrationState.Completed && !vmi.Status.MigrationState.Failed &&
+			len(vmi.Status.MigrationState.VolumeMigrations) > 0 {
+			return c.updateMigratedVolumes(migration, vmi)
+		}
 		return nil
 	}
 
@@ -537,6 +728,8 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 				SourceNode:   vmi.Status.NodeName,
 				TargetPod:    pod.Name,
 			}
+			vmiCopy.Status.MigrationState.VolumeMigrations = migration.Spec.VolumeMigrations
+			c.setMigrationConfiguration(vmi, vmiCopy.Status.MigrationState)
 
 			// By setting this label, virt-handler on the target node will receive
 			// the vmi and prepare the local environment for the migration
@@ -556,6 +749,23 @@ func (c *MigrationController) sync(key string, migration *virtv1.VirtualMachineI
 	return nil
 }
 
+// setMigrationConfiguration records the migration configuration, and the MigrationPolicy it
+// was derived from, on the migration state. A migration which is already handed over keeps
+// its configuration, so that policy changes do not alter it.
+func (c *MigrationController) setMigrationConfiguration(vmi *virtv1.VirtualMachineInstance, state *virtv1.VirtualMachineInstanceMigrationState) {
+	if current := vmi.Status.MigrationState; current != nil && current.MigrationUID == state.MigrationUID {
+		state.MigrationPolicyName = current.MigrationPolicyName
+		state.MigrationConfiguration = current.MigrationConfiguration
+		return
+	}
+
+	policy := migrations.MatchPolicy(c.policyInformer, c.namespaceInformer, vmi)
+	if policy != nil {
+		state.MigrationPolicyName = &policy.Name
+	}
+	state.MigrationConfiguration = migrations.ApplyPolicy(c.clusterConfig.GetMigrationConfiguration(), policy)
+}
+
 func (c *MigrationController) listMatchingTargetPods(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) ([]*k8sv1.Pod, error) {
 
 	selector, err := v1.LabelSelectorAsSelector(&v1.LabelSelector{
@@ -27,6 +27,8 @@ import (
 	"github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -38,10 +40,12 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/client-go/api/v1"
+	migrationsv1 "kubevirt.io/client-go/apis/migrations/v1alpha1"
 	fakenetworkclient "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 )
 
@@ -57,6 +61,10 @@ var _ = Describe("Migration watcher", func() {
 	var vmiInformer cache.SharedIndexInformer
 	var podInformer cache.SharedIndexInformer
 	var migrationInformer cache.SharedIndexInformer
+	var policyInformer cache.SharedIndexInformer
+	var namespaceInformer cache.SharedIndexInformer
+	var nodeInformer cache.SharedIndexInformer
+	var configMapInformer cache.SharedIndexInformer
 	var stop chan struct{}
 	var controller *MigrationController
 	var recorder *record.FakeRecorder
@@ -166,16 +174,23 @@ var _ = Describe("Migration watcher", func() {
 		vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 		migrationInformer, migrationSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
 		podInformer, podSource = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		policyInformer, _ = testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		namespaceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
 		recorder = record.NewFakeRecorder(100)
 
 		pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
-		config, _, _, _ := testutils.NewFakeClusterConfig(&k8sv1.ConfigMap{})
+		var config *virtconfig.ClusterConfig
+		config, configMapInformer, _, _ = testutils.NewFakeClusterConfig(&k8sv1.ConfigMap{})
 
 		controller = NewMigrationController(
 			services.NewTemplateService("a", "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid),
 			vmiInformer,
 			podInformer,
 			migrationInformer,
+			policyInformer,
+			namespaceInformer,
+			nodeInformer,
 			recorder,
 			virtClient,
 			config,
@@ -234,6 +249,74 @@ var _ = Describe("Migration watcher", func() {
 			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
 		})
 
+		Context("with CPU node discovery enabled", func() {
+			BeforeEach(func() {
+				testutils.UpdateFakeClusterConfig(configMapInformer, &k8sv1.ConfigMap{
+					Data: map[string]string{virtconfig.FeatureGatesKey: virtconfig.CPUNodeDiscoveryGate},
+				})
+			})
+
+			It("should target nodes which support the host-model CPU of the source node", func() {
+				vmi := newVirtualMachine("testvmi", v1.Running)
+				migration := newMigration("testmigration", vmi.Name, v1.MigrationPending)
+				Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: vmi.Status.NodeName,
+						Labels: map[string]string{
+							v1.HostModelCPULabel + "Skylake-Client-IBRS": "true",
+							v1.HostModelRequiredFeaturesLabel + "vmx":    "true",
+							v1.CPUFeatureLabel + "avx2":                  "true",
+						},
+					},
+				})).To(Succeed())
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					pod := action.(testing.CreateAction).GetObject().(*k8sv1.Pod)
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.SupportedHostModelMigrationCPU+"Skylake-Client-IBRS", "true"))
+					Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue(v1.CPUFeatureLabel+"vmx", "true"))
+					Expect(pod.Spec.NodeSelector).ToNot(HaveKey(v1.CPUFeatureLabel + "avx2"))
+					return true, pod, nil
+				})
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+			})
+
+			It("should not create the target pod if the host-model CPU of the source node is unknown", func() {
+				vmi := newVirtualMachine("testvmi", v1.Running)
+				migration := newMigration("testmigration", vmi.Name, v1.MigrationPending)
+				Expect(nodeInformer.GetStore().Add(&k8sv1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: vmi.Status.NodeName,
+					},
+				})).To(Succeed())
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, FailedCreatePodReason)
+			})
+
+			It("should not add host-model node selectors for a named CPU model", func() {
+				vmi := newVirtualMachine("testvmi", v1.Running)
+				vmi.Spec.Domain.CPU = &v1.CPU{Model: "Conroe"}
+				migration := newMigration("testmigration", vmi.Name, v1.MigrationPending)
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				shouldExpectPodCreation(vmi.UID, migration.UID, 1, 0, 0)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+			})
+		})
+
 		It("should create another target pods if only 4 migrations are in progress", func() {
 			// It should create a pod for this one
 			vmi := newVirtualMachine("testvmi", v1.Running)
@@ -555,6 +638,85 @@ var _ = Describe("Migration watcher", func() {
 			testutils.ExpectEvent(recorder, SuccessfulHandOverPodReason)
 		})
 
+		It("should hand pod over to target virt-handler with the matching migration policy", func() {
+			vmi := newVirtualMachine("testvmi", v1.Running)
+			vmi.Status.NodeName = "node02"
+			vmi.Labels["app"] = "db"
+			migration := newMigration("testmigration", vmi.Name, v1.MigrationScheduled)
+
+			pod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodPending)
+			pod.Spec.NodeName = "node01"
+
+			bandwidth := resource.MustParse("1Gi")
+			allowPostCopy := true
+			policy := &migrationsv1.MigrationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "db-policy"},
+				Spec: migrationsv1.MigrationPolicySpec{
+					Selectors: &migrationsv1.Selectors{
+						VirtualMachineInstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+						NamespaceSelector:              &metav1.LabelSelector{MatchLabels: map[string]string{"team": "storage"}},
+					},
+					BandwidthPerMigration: &bandwidth,
+					AllowPostCopy:         &allowPostCopy,
+				},
+			}
+			Expect(policyInformer.GetStore().Add(policy)).To(Succeed())
+			Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: k8sv1.NamespaceDefault, Labels: map[string]string{"team": "storage"}},
+			})).To(Succeed())
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			podFeeder.Add(pod)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
+				state := arg.(*v1.VirtualMachineInstance).Status.MigrationState
+				Expect(state).ToNot(BeNil())
+				Expect(state.MigrationPolicyName).To(Equal(&policy.Name))
+				Expect(state.MigrationConfiguration).ToNot(BeNil())
+				Expect(state.MigrationConfiguration.BandwidthPerMigration.String()).To(Equal("1Gi"))
+				Expect(*state.MigrationConfiguration.AllowPostCopy).To(BeTrue())
+				Expect(*state.MigrationConfiguration.CompletionTimeoutPerGiB).To(Equal(*controller.clusterConfig.GetMigrationConfiguration().CompletionTimeoutPerGiB))
+				return arg, nil
+			})
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulHandOverPodReason)
+		})
+
+		It("should hand pod over to target virt-handler with the cluster wide configuration if no policy matches", func() {
+			vmi := newVirtualMachine("testvmi", v1.Running)
+			vmi.Status.NodeName = "node02"
+			migration := newMigration("testmigration", vmi.Name, v1.MigrationScheduled)
+
+			pod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodPending)
+			pod.Spec.NodeName = "node01"
+
+			Expect(policyInformer.GetStore().Add(&migrationsv1.MigrationPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "db-policy"},
+				Spec: migrationsv1.MigrationPolicySpec{
+					Selectors: &migrationsv1.Selectors{
+						VirtualMachineInstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					},
+				},
+			})).To(Succeed())
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			podFeeder.Add(pod)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
+				state := arg.(*v1.VirtualMachineInstance).Status.MigrationState
+				Expect(state).ToNot(BeNil())
+				Expect(state.MigrationPolicyName).To(BeNil())
+				Expect(state.MigrationConfiguration).To(Equal(controller.clusterConfig.GetMigrationConfiguration()))
+				return arg, nil
+			})
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulHandOverPodReason)
+		})
+
 		It("should hand pod over to target virt-handler overriding previous state", func() {
 			vmi := newVirtualMachine("testvmi", v1.Running)
 			vmi.Status.NodeName = "node02"
@@ -703,6 +865,211 @@ var _ = Describe("Migration watcher", func() {
 			testutils.ExpectEvent(recorder, SuccessfulAbortMigrationReason)
 		})
 	})
+
+	Context("Migration object with volume migrations", func() {
+		var vmi *v1.VirtualMachineInstance
+		var migration *v1.VirtualMachineInstanceMigration
+
+		newPVC := func(name string, volumeMode k8sv1.PersistentVolumeMode) *k8sv1.PersistentVolumeClaim {
+			return &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: k8sv1.NamespaceDefault},
+				Spec:       k8sv1.PersistentVolumeClaimSpec{VolumeMode: &volumeMode},
+			}
+		}
+
+		shouldExpectPVCGets := func(pvcs ...*k8sv1.PersistentVolumeClaim) {
+			for _, pvc := range pvcs {
+				Expect(pvcInformer.GetStore().Add(pvc)).To(Succeed())
+			}
+			kubeClient.Fake.PrependReactor("get", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				name := action.(testing.GetAction).GetName()
+				for _, pvc := range pvcs {
+					if pvc.Name == name {
+						return true, pvc, nil
+					}
+				}
+				return true, nil, errors.NewNotFound(k8sv1.Resource("persistentvolumeclaims"), name)
+			})
+		}
+
+		BeforeEach(func() {
+			vmi = newVirtualMachine("testvmi", v1.Running)
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "disk0",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "old-pvc"},
+					},
+				},
+			}
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}}
+			migration = newMigration("testmigration", vmi.Name, v1.MigrationPending)
+			migration.Spec.VolumeMigrations = []v1.VolumeMigration{{SourceVolume: "disk0", DestinationPVC: "new-pvc"}}
+		})
+
+		It("should create the target pod with the destination claims", func() {
+			shouldExpectPVCGets(newPVC("old-pvc", k8sv1.PersistentVolumeFilesystem), newPVC("new-pvc", k8sv1.PersistentVolumeFilesystem))
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				pod := action.(testing.CreateAction).GetObject().(*k8sv1.Pod)
+				var claims []string
+				for _, volume := range pod.Spec.Volumes {
+					if volume.PersistentVolumeClaim != nil {
+						claims = append(claims, volume.PersistentVolumeClaim.ClaimName)
+					}
+				}
+				Expect(claims).To(ConsistOf("new-pvc"))
+				return true, pod, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		})
+
+		It("should not create the target pod if the destination claim has another volume mode", func() {
+			shouldExpectPVCGets(newPVC("old-pvc", k8sv1.PersistentVolumeFilesystem), newPVC("new-pvc", k8sv1.PersistentVolumeBlock))
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, FailedVolumeMigrationReason)
+		})
+
+		It("should not create the target pod if the destination claim is smaller than the source", func() {
+			source := newPVC("old-pvc", k8sv1.PersistentVolumeFilesystem)
+			source.Status.Capacity = k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("2Gi")}
+			destination := newPVC("new-pvc", k8sv1.PersistentVolumeFilesystem)
+			destination.Status.Capacity = k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("1Gi")}
+			shouldExpectPVCGets(source, destination)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, FailedVolumeMigrationReason)
+		})
+
+		It("should compare the requested storage of a destination claim which is not bound yet", func() {
+			source := newPVC("old-pvc", k8sv1.PersistentVolumeFilesystem)
+			source.Status.Capacity = k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("2Gi")}
+			destination := newPVC("new-pvc", k8sv1.PersistentVolumeFilesystem)
+			destination.Spec.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("1Gi")}
+			shouldExpectPVCGets(source, destination)
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, FailedVolumeMigrationReason)
+		})
+
+		It("should not create the target pod if the destination claim does not exist", func() {
+			shouldExpectPVCGets(newPVC("old-pvc", k8sv1.PersistentVolumeFilesystem))
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, FailedVolumeMigrationReason)
+		})
+
+		It("should hand the volume migrations over to virt-handler", func() {
+			vmi.Status.NodeName = "node02"
+			migration.Status.Phase = v1.MigrationScheduled
+			pod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodPending)
+			pod.Spec.NodeName = "node01"
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+			podFeeder.Add(pod)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
+				Expect(arg.(*v1.VirtualMachineInstance).Status.MigrationState.VolumeMigrations).To(Equal(migration.Spec.VolumeMigrations))
+				return arg, nil
+			})
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, SuccessfulHandOverPodReason)
+		})
+
+		Context("when the migration completed", func() {
+			var vmInterface *kubecli.MockVirtualMachineInterface
+
+			BeforeEach(func() {
+				vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+				virtClient.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).Return(vmInterface).AnyTimes()
+
+				vmi.Status.NodeName = "node01"
+				migration.Status.Phase = v1.MigrationRunning
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      migration.UID,
+					TargetNode:        "node01",
+					SourceNode:        "node02",
+					TargetNodeAddress: "10.10.10.10:1234",
+					StartTimestamp:    now(),
+					EndTimestamp:      now(),
+					Completed:         true,
+					VolumeMigrations:  migration.Spec.VolumeMigrations,
+				}
+			})
+
+			It("should update the volumes of the VMI and its VM", func() {
+				vm := &v1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: vmi.Name, Namespace: vmi.Namespace, UID: "vm-uid"},
+					Spec: v1.VirtualMachineSpec{
+						Template: &v1.VirtualMachineInstanceTemplateSpec{Spec: vmi.Spec},
+					},
+				}
+				t := true
+				vmi.OwnerReferences = []metav1.OwnerReference{{
+					APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+					Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+					Name:       vm.Name,
+					UID:        vm.UID,
+					Controller: &t,
+				}}
+				pod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+				pod.Spec.NodeName = "node01"
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				podFeeder.Add(pod)
+
+				vmInterface.EXPECT().Get(vm.Name, gomock.Any()).Return(vm, nil)
+				vmInterface.EXPECT().Update(gomock.Any()).DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
+					Expect(arg.(*v1.VirtualMachine).Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("new-pvc"))
+					return arg, nil
+				})
+				vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(name string, patchType types.PatchType, body []byte) (interface{}, interface{}) {
+					Expect(string(body)).To(ContainSubstring(`"claimName":"new-pvc"`))
+					return vmi, nil
+				})
+				shouldExpectMigrationCompletedState(migration)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulVolumeMigrationReason)
+				testutils.ExpectEvent(recorder, SuccessfulMigrationReason)
+			})
+
+			It("should not succeed before the volumes of the VMI are updated", func() {
+				pod := newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+				pod.Spec.NodeName = "node01"
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+				podFeeder.Add(pod)
+
+				vmiInterface.EXPECT().Patch(vmi.Name, types.JSONPatchType, gomock.Any()).Return(nil, fmt.Errorf("conflict"))
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, FailedVolumeMigrationReason)
+			})
+		})
+	})
 })
 
 func newMigration(name string, vmiName string, phase v1.VirtualMachineInstanceMigrationPhase) *v1.VirtualMachineInstanceMigration {
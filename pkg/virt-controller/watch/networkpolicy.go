@@ -0,0 +1,278 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// VMNetworkPolicyController keeps the addresses of the VMIs selected by the peers of the
+// VirtualMachineNetworkPolicies in their status, so that virt-handler only has to watch
+// the policies instead of all the VMIs of the cluster.
+type VMNetworkPolicyController struct {
+	clientset      kubecli.KubevirtClient
+	Queue          workqueue.RateLimitingInterface
+	vmiInformer    cache.SharedIndexInformer
+	policyInformer cache.SharedIndexInformer
+	clusterConfig  *virtconfig.ClusterConfig
+	resyncInterval time.Duration
+}
+
+// NewVMNetworkPolicyController creates a new instance of the VMNetworkPolicyController struct.
+func NewVMNetworkPolicyController(clientset kubecli.KubevirtClient, vmiInformer cache.SharedIndexInformer, policyInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig) *VMNetworkPolicyController {
+	c := &VMNetworkPolicyController{
+		clientset:      clientset,
+		Queue:          workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		vmiInformer:    vmiInformer,
+		policyInformer: policyInformer,
+		clusterConfig:  clusterConfig,
+		resyncInterval: 1 * time.Minute,
+	}
+
+	c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNamespaceOf,
+		DeleteFunc: c.enqueueNamespaceOf,
+		UpdateFunc: c.updateVirtualMachineInstance,
+	})
+
+	c.policyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueuePolicy,
+		DeleteFunc: c.enqueuePolicy,
+		UpdateFunc: func(_, curr interface{}) { c.enqueuePolicy(curr) },
+	})
+
+	return c
+}
+
+func (c *VMNetworkPolicyController) enqueuePolicy(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to get the key of a network policy")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+// enqueueNamespaceOf enqueues the policies in the namespace of the object
+func (c *VMNetworkPolicyController) enqueueNamespaceOf(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	meta, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	policies, err := c.policyInformer.GetIndexer().ByIndex(cache.NamespaceIndex, meta.GetNamespace())
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the network policies of namespace %s", meta.GetNamespace())
+		return
+	}
+	for _, policy := range policies {
+		c.enqueuePolicy(policy)
+	}
+}
+
+func (c *VMNetworkPolicyController) enqueueAll() {
+	for _, policy := range c.policyInformer.GetStore().List() {
+		c.enqueuePolicy(policy)
+	}
+}
+
+// updateVirtualMachineInstance enqueues the policies of the namespace if the vmi can be
+// selected differently as a peer
+func (c *VMNetworkPolicyController) updateVirtualMachineInstance(old, curr interface{}) {
+	oldVMI := old.(*virtv1.VirtualMachineInstance)
+	currVMI := curr.(*virtv1.VirtualMachineInstance)
+	if reflect.DeepEqual(oldVMI.Labels, currVMI.Labels) &&
+		reflect.DeepEqual(getPeerAddresses(oldVMI), getPeerAddresses(currVMI)) {
+		return
+	}
+	c.enqueueNamespaceOf(curr)
+}
+
+// Run runs the passed in VMNetworkPolicyController.
+func (c *VMNetworkPolicyController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting network policy controller.")
+
+	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.policyInformer.HasSynced)
+
+	// Changes of the configuration are picked up on the next resync
+	go wait.Until(c.enqueueAll, c.resyncInterval, stopCh)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping network policy controller.")
+}
+
+func (c *VMNetworkPolicyController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute updates the status of the next enqueued policy, if there is
+// an error it requeues it. Returns false if the queue is shut down.
+func (c *VMNetworkPolicyController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key.(string)); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing network policy %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed network policy %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *VMNetworkPolicyController) execute(key string) error {
+	if !c.clusterConfig.VirtualMachineNetworkPolicyEnabled() {
+		return nil
+	}
+
+	obj, exists, err := c.policyInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	policy := obj.(*networkpolicyv1.VirtualMachineNetworkPolicy)
+
+	vmis, err := c.vmiInformer.GetIndexer().ByIndex(cache.NamespaceIndex, policy.Namespace)
+	if err != nil {
+		return err
+	}
+
+	status := networkpolicyv1.VirtualMachineNetworkPolicyStatus{}
+	for _, selector := range getPeerSelectors(&policy.Spec) {
+		status.Peers = append(status.Peers, networkpolicyv1.VirtualMachineNetworkPolicyPeerStatus{
+			VirtualMachineInstanceSelector: selector,
+			Addresses:                      getSelectedPeerAddresses(policy, selector, vmis),
+		})
+	}
+
+	if equality.Semantic.DeepEqual(policy.Status, status) {
+		return nil
+	}
+	policy = policy.DeepCopy()
+	policy.Status = status
+	_, err = c.clientset.VirtualMachineNetworkPolicy(policy.Namespace).UpdateStatus(context.Background(), policy, metav1.UpdateOptions{})
+	return err
+}
+
+// getPeerSelectors returns the distinct VMI selectors of the peers of the rules
+func getPeerSelectors(spec *networkpolicyv1.VirtualMachineNetworkPolicySpec) []metav1.LabelSelector {
+	var peers []networkpolicyv1.VirtualMachineNetworkPolicyPeer
+	for _, rule := range spec.Ingress {
+		peers = append(peers, rule.From...)
+	}
+	for _, rule := range spec.Egress {
+		peers = append(peers, rule.To...)
+	}
+
+	var selectors []metav1.LabelSelector
+	for _, peer := range peers {
+		if peer.VirtualMachineInstanceSelector == nil {
+			continue
+		}
+		known := false
+		for _, selector := range selectors {
+			if equality.Semantic.DeepEqual(selector, *peer.VirtualMachineInstanceSelector) {
+				known = true
+				break
+			}
+		}
+		if !known {
+			selectors = append(selectors, *peer.VirtualMachineInstanceSelector)
+		}
+	}
+	return selectors
+}
+
+// getSelectedPeerAddresses returns the addresses of the vmis matching the selector as
+// host CIDRs, sorted to keep the status stable
+func getSelectedPeerAddresses(policy *networkpolicyv1.VirtualMachineNetworkPolicy, labelSelector metav1.LabelSelector, vmis []interface{}) []string {
+	selector, err := metav1.LabelSelectorAsSelector(&labelSelector)
+	if err != nil {
+		log.Log.Object(policy).Reason(err).Error("Invalid vmi selector")
+		return nil
+	}
+
+	var addresses []string
+	for _, obj := range vmis {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if !selector.Matches(labels.Set(vmi.Labels)) {
+			continue
+		}
+		addresses = append(addresses, getPeerAddresses(vmi)...)
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// getPeerAddresses returns the addresses the vmi reports as host CIDRs, a finalized
+// vmi has no addresses
+func getPeerAddresses(vmi *virtv1.VirtualMachineInstance) []string {
+	if vmi.IsFinal() {
+		return nil
+	}
+
+	var addresses []string
+	for _, iface := range vmi.Status.Interfaces {
+		ips := iface.IPs
+		if len(ips) == 0 && iface.IP != "" {
+			ips = []string{iface.IP}
+		}
+		for _, ip := range ips {
+			if parsed := net.ParseIP(ip); parsed == nil {
+				continue
+			} else if parsed.To4() != nil {
+				addresses = append(addresses, ip+"/32")
+			} else {
+				addresses = append(addresses, ip+"/128")
+			}
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,161 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Network policy controller", func() {
+	var ctrl *gomock.Controller
+	var kubevirtClient *kubevirtfake.Clientset
+	var vmiInformer cache.SharedIndexInformer
+	var policyInformer cache.SharedIndexInformer
+	var configMapInformer cache.SharedIndexInformer
+	var controller *VMNetworkPolicyController
+
+	webSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	newPolicy := func() *networkpolicyv1.VirtualMachineNetworkPolicy {
+		return &networkpolicyv1.VirtualMachineNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+			Spec: networkpolicyv1.VirtualMachineNetworkPolicySpec{
+				VirtualMachineInstanceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+				Ingress: []networkpolicyv1.VirtualMachineNetworkPolicyIngressRule{
+					{From: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{VirtualMachineInstanceSelector: &webSelector}}},
+					{From: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{
+						{VirtualMachineInstanceSelector: &webSelector},
+						{IPBlock: &networkpolicyv1.IPBlock{CIDR: "10.10.0.0/16"}},
+					}},
+				},
+			},
+		}
+	}
+
+	newPeerVMI := func(name string, ips ...string) *virtv1.VirtualMachineInstance {
+		vmi := virtv1.NewMinimalVMIWithNS("default", name)
+		vmi.Labels = map[string]string{"app": "web"}
+		vmi.Status.Phase = virtv1.Running
+		vmi.Status.Interfaces = []virtv1.VirtualMachineInstanceNetworkInterface{{Name: "blue", IPs: ips}}
+		return vmi
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineNetworkPolicy("default").Return(kubevirtClient.NetworkpolicyV1alpha1().VirtualMachineNetworkPolicies("default")).AnyTimes()
+
+		var config *virtconfig.ClusterConfig
+		config, configMapInformer, _, _ = testutils.NewFakeClusterConfig(&k8sv1.ConfigMap{
+			Data: map[string]string{virtconfig.FeatureGatesKey: virtconfig.VirtualMachineNetworkPolicyGate},
+		})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		policyInformer, _ = testutils.NewFakeInformerFor(&networkpolicyv1.VirtualMachineNetworkPolicy{})
+		controller = NewVMNetworkPolicyController(virtClient, vmiInformer, policyInformer, config)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	addPolicy := func(policy *networkpolicyv1.VirtualMachineNetworkPolicy) {
+		_, err := kubevirtClient.NetworkpolicyV1alpha1().VirtualMachineNetworkPolicies(policy.Namespace).Create(context.Background(), policy, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policyInformer.GetStore().Add(policy)).To(Succeed())
+		kubevirtClient.ClearActions()
+	}
+
+	execute := func() {
+		controller.Queue.Add("default/db")
+		controller.Execute()
+	}
+
+	getStatus := func() networkpolicyv1.VirtualMachineNetworkPolicyStatus {
+		policy, err := kubevirtClient.NetworkpolicyV1alpha1().VirtualMachineNetworkPolicies("default").Get(context.Background(), "db", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return policy.Status
+	}
+
+	It("should publish the addresses of the selected VMIs once for every peer selector", func() {
+		addPolicy(newPolicy())
+		Expect(vmiInformer.GetStore().Add(newPeerVMI("web1", "192.168.1.11", "fd00::11"))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newPeerVMI("web0", "192.168.1.10"))).To(Succeed())
+		other := newPeerVMI("other", "192.168.1.20")
+		other.Labels = map[string]string{"app": "other"}
+		Expect(vmiInformer.GetStore().Add(other)).To(Succeed())
+		finalized := newPeerVMI("finalized", "192.168.1.30")
+		finalized.Status.Phase = virtv1.Succeeded
+		Expect(vmiInformer.GetStore().Add(finalized)).To(Succeed())
+
+		execute()
+		Expect(getStatus().Peers).To(Equal([]networkpolicyv1.VirtualMachineNetworkPolicyPeerStatus{{
+			VirtualMachineInstanceSelector: webSelector,
+			Addresses:                      []string{"192.168.1.10/32", "192.168.1.11/32", "fd00::11/128"},
+		}}))
+	})
+
+	It("should not update an unchanged status", func() {
+		policy := newPolicy()
+		policy.Status.Peers = []networkpolicyv1.VirtualMachineNetworkPolicyPeerStatus{{
+			VirtualMachineInstanceSelector: webSelector,
+			Addresses:                      []string{"192.168.1.10/32"},
+		}}
+		addPolicy(policy)
+		Expect(vmiInformer.GetStore().Add(newPeerVMI("web0", "192.168.1.10"))).To(Succeed())
+
+		execute()
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+
+	It("should enqueue the policies of the namespace when the addresses of a VMI change", func() {
+		addPolicy(newPolicy())
+		old := newPeerVMI("web0", "192.168.1.10")
+
+		controller.updateVirtualMachineInstance(old, old.DeepCopy())
+		Expect(controller.Queue.Len()).To(Equal(0))
+
+		controller.updateVirtualMachineInstance(old, newPeerVMI("web0", "192.168.1.11"))
+		Expect(controller.Queue.Len()).To(Equal(1))
+	})
+
+	It("should not update the status when the feature gate is disabled", func() {
+		testutils.UpdateFakeClusterConfig(configMapInformer, &k8sv1.ConfigMap{})
+		addPolicy(newPolicy())
+		Expect(vmiInformer.GetStore().Add(newPeerVMI("web0", "192.168.1.10"))).To(Succeed())
+
+		execute()
+		Expect(kubevirtClient.Actions()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,914 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	k8score "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/client-go/api/v1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+// Reasons for pool events
+const (
+	// FailedCreateVirtualMachineReason is added in an event and in a pool condition
+	// when a virtual machine for a pool is failed to be created.
+	FailedCreateVirtualMachineReason = "FailedCreate"
+	// SuccessfulCreateVirtualMachineReason is added in an event when a virtual machine for a pool
+	// is successfully created.
+	SuccessfulCreateVirtualMachineReason = "SuccessfulCreate"
+	// FailedDeleteVirtualMachineReason is added in an event and in a pool condition
+	// when a virtual machine for a pool is failed to be deleted.
+	FailedDeleteVirtualMachineReason = "FailedDelete"
+	// SuccessfulDeleteVirtualMachineReason is added in an event when a virtual machine for a pool
+	// is successfully deleted.
+	SuccessfulDeleteVirtualMachineReason = "SuccessfulDelete"
+	// FailedUpdateVirtualMachineReason is added in an event and in a pool condition
+	// when a virtual machine for a pool is failed to be updated to the latest template.
+	FailedUpdateVirtualMachineReason = "FailedUpdate"
+	// SuccessfulUpdateVirtualMachineReason is added in an event when a virtual machine for a pool
+	// is successfully updated to the latest template.
+	SuccessfulUpdateVirtualMachineReason = "SuccessfulUpdate"
+	// SuccessfulRestartVirtualMachineReason is added in an event when the virtual machine instance
+	// of an updated virtual machine got deleted to pick up the latest template.
+	SuccessfulRestartVirtualMachineReason = "SuccessfulRestart"
+	// SuccessfulPausedPoolReason is added in an event when the pool discovered that it
+	// should be paused. The event is triggered after it successfully managed to add the Paused Condition
+	// to itself.
+	SuccessfulPausedPoolReason = "SuccessfulPaused"
+	// SuccessfulResumedPoolReason is added in an event when the pool discovered that it
+	// should be resumed. The event is triggered after it successfully managed to remove the Paused Condition
+	// from itself.
+	SuccessfulResumedPoolReason = "SuccessfulResumed"
+)
+
+// RevisionAnnotation is set on every VirtualMachine of a pool and on its VirtualMachineInstance template.
+// It holds a hash of the pool template the VirtualMachine was created or last updated from.
+const RevisionAnnotation = "pool.kubevirt.io/revision"
+
+func NewPoolController(clientset kubecli.KubevirtClient,
+	poolInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	burstReplicas uint) *PoolController {
+
+	c := &PoolController{
+		Queue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-pool"),
+		poolInformer:  poolInformer,
+		vmInformer:    vmInformer,
+		vmiInformer:   vmiInformer,
+		recorder:      recorder,
+		clientset:     clientset,
+		expectations:  controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
+		burstReplicas: burstReplicas,
+	}
+
+	c.poolInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addPool,
+		DeleteFunc: c.deletePool,
+		UpdateFunc: c.updatePool,
+	})
+
+	c.vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.addVirtualMachine,
+		DeleteFunc: c.deleteVirtualMachine,
+		UpdateFunc: c.updateVirtualMachine,
+	})
+
+	c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.handleVirtualMachineInstance,
+		DeleteFunc: c.handleVirtualMachineInstance,
+		UpdateFunc: func(old, cur interface{}) { c.handleVirtualMachineInstance(cur) },
+	})
+
+	return c
+}
+
+// PoolController creates, updates and deletes the VirtualMachines of VirtualMachinePools
+type PoolController struct {
+	clientset     kubecli.KubevirtClient
+	Queue         workqueue.RateLimitingInterface
+	poolInformer  cache.SharedIndexInformer
+	vmInformer    cache.SharedIndexInformer
+	vmiInformer   cache.SharedIndexInformer
+	recorder      record.EventRecorder
+	expectations  *controller.UIDTrackingControllerExpectations
+	burstReplicas uint
+}
+
+func (c *PoolController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting VirtualMachinePool controller.")
+
+	// Wait for cache sync before we start the controller
+	cache.WaitForCacheSync(stopCh, c.poolInformer.HasSynced, c.vmInformer.HasSynced, c.vmiInformer.HasSynced)
+
+	// Start the actual work
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping VirtualMachinePool controller.")
+}
+
+func (c *PoolController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *PoolController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+	if err := c.execute(key.(string)); err != nil {
+		log.Log.Reason(err).Infof("re-enqueuing VirtualMachinePool %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachinePool %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *PoolController) execute(key string) error {
+
+	obj, exists, err := c.poolInformer.GetStore().GetByKey(key)
+	if err != nil {
+		return nil
+	}
+	if !exists {
+		// nothing we need to do. It should always be possible to re-create this type of controller
+		c.expectations.DeleteExpectations(key)
+		return nil
+	}
+	pool := obj.(*poolv1.VirtualMachinePool)
+
+	logger := log.Log.Object(pool)
+
+	if pool.Spec.VirtualMachineTemplate == nil || pool.Spec.Selector == nil || len(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels) == 0 {
+		logger.Error("Invalid controller spec, will not re-enqueue.")
+		return nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(pool.Spec.Selector)
+	if err != nil {
+		logger.Reason(err).Error("Invalid selector on pool, will not re-enqueue.")
+		return nil
+	}
+
+	if !selector.Matches(labels.Set(pool.Spec.VirtualMachineTemplate.ObjectMeta.Labels)) {
+		logger.Error("Selector does not match template labels, will not re-enqueue.")
+		return nil
+	}
+
+	needsSync := c.expectations.SatisfiedExpectations(key)
+
+	vms, err := c.listOwnedVMs(pool)
+	if err != nil {
+		logger.Reason(err).Error("Failed to fetch vms for namespace from cache.")
+		return err
+	}
+
+	activeVMs := filterActiveVMs(vms)
+
+	var syncErr error
+
+	// Scale and update, if all expected creates and deletes were report by the listener
+	if needsSync && !pool.Spec.Paused && pool.ObjectMeta.DeletionTimestamp == nil {
+		syncErr = c.scale(pool, vms, activeVMs)
+		if syncErr == nil {
+			syncErr = c.update(pool, activeVMs)
+		}
+	}
+
+	if syncErr != nil {
+		logger.Reason(syncErr).Error("Scaling or updating the pool failed.")
+	}
+
+	err = c.updateStatus(pool.DeepCopy(), activeVMs, syncErr)
+	if err != nil {
+		logger.Reason(err).Error("Updating the pool status failed.")
+	}
+
+	return syncErr
+}
+
+// listOwnedVMs returns all VirtualMachines from the cache which are controlled by the pool
+func (c *PoolController) listOwnedVMs(pool *poolv1.VirtualMachinePool) ([]*virtv1.VirtualMachine, error) {
+	objs, err := c.vmInformer.GetIndexer().ByIndex(cache.NamespaceIndex, pool.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	vms := []*virtv1.VirtualMachine{}
+	for _, obj := range objs {
+		vm := obj.(*virtv1.VirtualMachine)
+		if controllerRef := metav1.GetControllerOf(vm); controllerRef != nil && controllerRef.UID == pool.UID {
+			vms = append(vms, vm)
+		}
+	}
+	return vms, nil
+}
+
+// filterActiveVMs returns all VirtualMachines which are not being deleted
+func filterActiveVMs(vms []*virtv1.VirtualMachine) []*virtv1.VirtualMachine {
+	active := []*virtv1.VirtualMachine{}
+	for _, vm := range vms {
+		if vm.DeletionTimestamp == nil {
+			active = append(active, vm)
+		}
+	}
+	return active
+}
+
+func (c *PoolController) scale(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, activeVMs []*virtv1.VirtualMachine) error {
+	log.Log.V(4).Object(pool).Info("Scale")
+	diff := calcDiff(pool, activeVMs)
+
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		log.Log.Object(pool).Reason(err).Error("Failed to extract poolKey from pool.")
+		return nil
+	}
+
+	if diff == 0 {
+		return nil
+	}
+
+	// Make sure that we don't overload the cluster
+	diff = limit(diff, c.burstReplicas)
+
+	// Every request can fail, give the channel enough room, to not block the go routines
+	errChan := make(chan error, abs(diff))
+
+	var wg sync.WaitGroup
+	wg.Add(abs(diff))
+
+	if diff > 0 {
+		log.Log.V(4).Object(pool).Info("Delete excess VM's")
+		// Always remove the VirtualMachines with the highest indexes, to keep the names compact
+		deleteCandidates := make([]*virtv1.VirtualMachine, len(activeVMs))
+		copy(deleteCandidates, activeVMs)
+		sort.Slice(deleteCandidates, func(i, j int) bool {
+			return indexOf(pool, deleteCandidates[i]) > indexOf(pool, deleteCandidates[j])
+		})
+		deleteCandidates = deleteCandidates[0:diff]
+
+		c.expectations.ExpectDeletions(poolKey, vmKeys(deleteCandidates))
+		for _, vm := range deleteCandidates {
+			go func(vm *virtv1.VirtualMachine) {
+				defer wg.Done()
+				err := c.deleteVM(pool, vm)
+				if err != nil {
+					// We can't observe a delete if it was not accepted by the server
+					c.expectations.DeletionObserved(poolKey, vmKey(vm))
+					c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedDeleteVirtualMachineReason, "Error deleting virtual machine %s: %v", vm.Name, err)
+					errChan <- err
+					return
+				}
+				c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulDeleteVirtualMachineReason, "Deleted virtual machine %s", vm.Name)
+			}(vm)
+		}
+
+	} else if diff < 0 {
+		log.Log.V(4).Object(pool).Info("Add missing VM's")
+		// VirtualMachines which are still terminating keep their index until they are gone
+		indexes := freeIndexes(pool, vms, abs(diff))
+		c.expectations.ExpectCreations(poolKey, abs(diff))
+		for _, index := range indexes {
+			go func(index int) {
+				defer wg.Done()
+				vm := newVirtualMachine(pool, index)
+				vm, err := c.clientset.VirtualMachine(pool.Namespace).Create(vm)
+				if err != nil {
+					c.expectations.CreationObserved(poolKey)
+					c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedCreateVirtualMachineReason, "Error creating virtual machine: %v", err)
+					errChan <- err
+					return
+				}
+				c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulCreateVirtualMachineReason, "Created virtual machine %s", vm.Name)
+			}(index)
+		}
+	}
+	wg.Wait()
+
+	select {
+	case err := <-errChan:
+		// Only return the first error which occurred, the others will most likely be equal errors
+		return err
+	default:
+	}
+	return nil
+}
+
+// deleteVM removes a VirtualMachine of the pool. If the DataVolumes have to be retained, the
+// VirtualMachine is deleted with the orphan propagation policy and its VirtualMachineInstance is
+// removed explicitly. A VirtualMachine which is created later with the same index adopts the
+// orphaned DataVolumes again.
+func (c *PoolController) deleteVM(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) error {
+	if volumeClaimPolicy(pool) != poolv1.VirtualMachinePoolVolumeClaimRetain {
+		return c.clientset.VirtualMachine(vm.Namespace).Delete(vm.Name, &metav1.DeleteOptions{})
+	}
+
+	orphan := metav1.DeletePropagationOrphan
+	err := c.clientset.VirtualMachine(vm.Namespace).Delete(vm.Name, &metav1.DeleteOptions{PropagationPolicy: &orphan})
+	if err != nil {
+		return err
+	}
+	err = c.clientset.VirtualMachineInstance(vm.Namespace).Delete(vm.Name, &metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// update brings all VirtualMachines of the pool to the latest template revision. With the
+// RollingUpdate strategy running VirtualMachineInstances are restarted afterwards, respecting maxUnavailable.
+func (c *PoolController) update(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) error {
+	if updateStrategyType(pool) != poolv1.RollingUpdateVirtualMachinePoolStrategyType {
+		return nil
+	}
+
+	revision := templateRevision(pool)
+
+	for _, vm := range vms {
+		if vm.Annotations[RevisionAnnotation] == revision {
+			continue
+		}
+		vmCopy := vm.DeepCopy()
+		updated := newVirtualMachine(pool, indexOf(pool, vm))
+		vmCopy.Labels = updated.Labels
+		vmCopy.Annotations = updated.Annotations
+		vmCopy.Spec = updated.Spec
+		_, err := c.clientset.VirtualMachine(vm.Namespace).Update(vmCopy)
+		if err != nil {
+			c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedUpdateVirtualMachineReason, "Error updating virtual machine %s: %v", vm.Name, err)
+			return err
+		}
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulUpdateVirtualMachineReason, "Updated virtual machine %s", vm.Name)
+	}
+
+	unavailable := 0
+	var outdated []*virtv1.VirtualMachineInstance
+	for _, vm := range vms {
+		runStrategy, err := vm.RunStrategy()
+		if err != nil || runStrategy != virtv1.RunStrategyAlways {
+			// only VirtualMachines which are always running get their VirtualMachineInstance recreated,
+			// all others pick up the latest template on their next start
+			continue
+		}
+		vmi, err := c.getVMI(vm)
+		if err != nil {
+			return err
+		}
+		if vmi == nil || vmi.DeletionTimestamp != nil || !isReady(vmi) {
+			unavailable++
+			continue
+		}
+		if vmi.Annotations[RevisionAnnotation] != revision {
+			outdated = append(outdated, vmi)
+		}
+	}
+
+	budget := maxUnavailable(pool) - unavailable
+	for i := 0; i < len(outdated) && i < budget; i++ {
+		vmi := outdated[i]
+		err := c.clientset.VirtualMachineInstance(vmi.Namespace).Delete(vmi.Name, &metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			c.recorder.Eventf(pool, k8score.EventTypeWarning, FailedUpdateVirtualMachineReason, "Error restarting virtual machine %s: %v", vmi.Name, err)
+			return err
+		}
+		c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulRestartVirtualMachineReason, "Restarted virtual machine %s to apply the latest template", vmi.Name)
+	}
+	return nil
+}
+
+func (c *PoolController) getVMI(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachineInstance, error) {
+	obj, exists, err := c.vmiInformer.GetStore().GetByKey(vmKey(vm))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*virtv1.VirtualMachineInstance), nil
+}
+
+func isReady(vmi *virtv1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceConditionType(k8score.PodReady), k8score.ConditionTrue)
+}
+
+// isUpdated returns true if the VirtualMachine and, if it exists, its VirtualMachineInstance run the given revision
+func (c *PoolController) isUpdated(vm *virtv1.VirtualMachine, revision string) bool {
+	if vm.Annotations[RevisionAnnotation] != revision {
+		return false
+	}
+	vmi, err := c.getVMI(vm)
+	if err != nil {
+		return false
+	}
+	return vmi == nil || vmi.Annotations[RevisionAnnotation] == revision
+}
+
+// newVirtualMachine renders the VirtualMachine with the given index from the pool template.
+// DataVolumeTemplates and the volumes referencing them get the VirtualMachine name as suffix,
+// which makes the DataVolumes unique for every VirtualMachine of the pool.
+func newVirtualMachine(pool *poolv1.VirtualMachinePool, index int) *virtv1.VirtualMachine {
+	name := vmName(pool, index)
+	revision := templateRevision(pool)
+	template := pool.Spec.VirtualMachineTemplate.DeepCopy()
+
+	vm := &virtv1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: virtv1.GroupVersion.String(),
+			Kind:       virtv1.VirtualMachineGroupVersionKind.Kind,
+		},
+		ObjectMeta: template.ObjectMeta,
+	}
+	vm.ObjectMeta.Name = name
+	vm.ObjectMeta.GenerateName = ""
+	vm.ObjectMeta.Namespace = pool.Namespace
+	vm.ObjectMeta.OwnerReferences = []metav1.OwnerReference{OwnerRef(pool)}
+	if vm.ObjectMeta.Annotations == nil {
+		vm.ObjectMeta.Annotations = map[string]string{}
+	}
+	vm.ObjectMeta.Annotations[RevisionAnnotation] = revision
+	vm.Spec = template.Spec
+
+	dvNames := map[string]string{}
+	for i := range vm.Spec.DataVolumeTemplates {
+		dvName := fmt.Sprintf("%s-%s", vm.Spec.DataVolumeTemplates[i].Name, name)
+		dvNames[vm.Spec.DataVolumeTemplates[i].Name] = dvName
+		vm.Spec.DataVolumeTemplates[i].Name = dvName
+	}
+
+	if vm.Spec.Template != nil {
+		if vm.Spec.Template.ObjectMeta.Annotations == nil {
+			vm.Spec.Template.ObjectMeta.Annotations = map[string]string{}
+		}
+		vm.Spec.Template.ObjectMeta.Annotations[RevisionAnnotation] = revision
+
+		for i, volume := range vm.Spec.Template.Spec.Volumes {
+			if volume.DataVolume != nil {
+				if dvName, ok := dvNames[volume.DataVolume.Name]; ok {
+					vm.Spec.Template.Spec.Volumes[i].DataVolume.Name = dvName
+				}
+			} else if volume.PersistentVolumeClaim != nil {
+				if dvName, ok := dvNames[volume.PersistentVolumeClaim.ClaimName]; ok {
+					vm.Spec.Template.Spec.Volumes[i].PersistentVolumeClaim.ClaimName = dvName
+				}
+			}
+		}
+	}
+	return vm
+}
+
+// templateRevision returns a hash of the pool template
+func templateRevision(pool *poolv1.VirtualMachinePool) string {
+	hasher := fnv.New32a()
+	// errors can't happen, the template was decoded from json before
+	b, _ := json.Marshal(pool.Spec.VirtualMachineTemplate)
+	hasher.Write(b)
+	return fmt.Sprintf("%x", hasher.Sum32())
+}
+
+func vmName(pool *poolv1.VirtualMachinePool, index int) string {
+	return fmt.Sprintf("%s-%d", pool.Name, index)
+}
+
+// indexOf extracts the index from the name of a VirtualMachine of the pool, -1 is returned
+// for VirtualMachines which don't follow the naming scheme
+func indexOf(pool *poolv1.VirtualMachinePool, vm *virtv1.VirtualMachine) int {
+	prefix := pool.Name + "-"
+	if !strings.HasPrefix(vm.Name, prefix) {
+		return -1
+	}
+	index, err := strconv.Atoi(strings.TrimPrefix(vm.Name, prefix))
+	if err != nil || index < 0 {
+		return -1
+	}
+	return index
+}
+
+// freeIndexes returns the count lowest indexes which are not used by any VirtualMachine of the pool
+func freeIndexes(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, count int) []int {
+	used := map[int]bool{}
+	for _, vm := range vms {
+		used[indexOf(pool, vm)] = true
+	}
+	var indexes []int
+	for i := 0; len(indexes) < count; i++ {
+		if !used[i] {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func calcDiff(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine) int {
+	return len(vms) - int(wantedReplicas(pool))
+}
+
+func wantedReplicas(pool *poolv1.VirtualMachinePool) int32 {
+	if pool.Spec.Replicas != nil {
+		return *pool.Spec.Replicas
+	}
+	return 1
+}
+
+func updateStrategyType(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolUpdateStrategyType {
+	if pool.Spec.UpdateStrategy != nil && pool.Spec.UpdateStrategy.Type != "" {
+		return pool.Spec.UpdateStrategy.Type
+	}
+	return poolv1.RollingUpdateVirtualMachinePoolStrategyType
+}
+
+func volumeClaimPolicy(pool *poolv1.VirtualMachinePool) poolv1.VirtualMachinePoolVolumeClaimPolicy {
+	if pool.Spec.ScaleDownVolumeClaimPolicy != nil {
+		return *pool.Spec.ScaleDownVolumeClaimPolicy
+	}
+	return poolv1.VirtualMachinePoolVolumeClaimDelete
+}
+
+// maxUnavailable returns the number of VirtualMachineInstances which may be down at the same time during an update
+func maxUnavailable(pool *poolv1.VirtualMachinePool) int {
+	value := intstr.FromInt(1)
+	if pool.Spec.UpdateStrategy != nil && pool.Spec.UpdateStrategy.RollingUpdate != nil && pool.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable != nil {
+		value = *pool.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable
+	}
+	unavailable, err := intstr.GetValueFromIntOrPercent(&value, int(wantedReplicas(pool)), false)
+	if err != nil || unavailable < 1 {
+		return 1
+	}
+	return unavailable
+}
+
+func vmKey(vm *virtv1.VirtualMachine) string {
+	return fmt.Sprintf("%v/%v", vm.Namespace, vm.Name)
+}
+
+func vmKeys(vms []*virtv1.VirtualMachine) []string {
+	keys := []string{}
+	for _, vm := range vms {
+		keys = append(keys, vmKey(vm))
+	}
+	return keys
+}
+
+// When a vm is created, enqueue the pool that manages it and update its expectations.
+func (c *PoolController) addVirtualMachine(obj interface{}) {
+	vm := obj.(*virtv1.VirtualMachine)
+
+	if vm.DeletionTimestamp != nil {
+		// on a restart of the controller manager, it's possible a new vm shows up in a state that
+		// is already pending deletion. Prevent the vm from being a creation observation.
+		c.deleteVirtualMachine(vm)
+		return
+	}
+
+	controllerRef := metav1.GetControllerOf(vm)
+	if controllerRef == nil {
+		return
+	}
+	pool := c.resolveControllerRef(vm.Namespace, controllerRef)
+	if pool == nil {
+		return
+	}
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return
+	}
+	log.Log.V(4).Object(vm).Infof("VirtualMachine created")
+	c.expectations.CreationObserved(poolKey)
+	c.enqueuePool(pool)
+}
+
+// When a vm is updated, wake up the pool which manages it.
+func (c *PoolController) updateVirtualMachine(old, cur interface{}) {
+	curVM := cur.(*virtv1.VirtualMachine)
+	oldVM := old.(*virtv1.VirtualMachine)
+	if curVM.ResourceVersion == oldVM.ResourceVersion {
+		// Periodic resync will send update events for all known vms.
+		// Two different versions of the same vm will always have different RVs.
+		return
+	}
+
+	if curVM.DeletionTimestamp != nil {
+		// the pool can create a replacement as soon as the vm is marked for deletion
+		c.deleteVirtualMachine(curVM)
+		return
+	}
+
+	if controllerRef := metav1.GetControllerOf(curVM); controllerRef != nil {
+		if pool := c.resolveControllerRef(curVM.Namespace, controllerRef); pool != nil {
+			c.enqueuePool(pool)
+		}
+	}
+}
+
+// When a vm is deleted, enqueue the pool that manages the vm and update its expectations.
+// obj could be an *virtv1.VirtualMachine, or a DeletionFinalStateUnknown marker item.
+func (c *PoolController) deleteVirtualMachine(obj interface{}) {
+	vm, ok := obj.(*virtv1.VirtualMachine)
+
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("couldn't get object from tombstone %+v", obj)).Error("Failed to process delete notification")
+			return
+		}
+		vm, ok = tombstone.Obj.(*virtv1.VirtualMachine)
+		if !ok {
+			log.Log.Reason(fmt.Errorf("tombstone contained object that is not a vm %#v", obj)).Error("Failed to process delete notification")
+			return
+		}
+	}
+
+	controllerRef := metav1.GetControllerOf(vm)
+	if controllerRef == nil {
+		return
+	}
+	pool := c.resolveControllerRef(vm.Namespace, controllerRef)
+	if pool == nil {
+		return
+	}
+	poolKey, err := controller.KeyFunc(pool)
+	if err != nil {
+		return
+	}
+	c.expectations.DeletionObserved(poolKey, vmKey(vm))
+	c.enqueuePool(pool)
+}
+
+// handleVirtualMachineInstance wakes up the pool of the VirtualMachine which owns the vmi,
+// the readiness and the revision of the vmi are reflected in the pool status.
+func (c *PoolController) handleVirtualMachineInstance(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance)
+		if !ok {
+			return
+		}
+	}
+
+	vmRef := metav1.GetControllerOf(vmi)
+	if vmRef == nil || vmRef.Kind != virtv1.VirtualMachineGroupVersionKind.Kind {
+		return
+	}
+	vmObj, exists, err := c.vmInformer.GetStore().GetByKey(vmi.Namespace + "/" + vmRef.Name)
+	if err != nil || !exists {
+		return
+	}
+	vm := vmObj.(*virtv1.VirtualMachine)
+	if controllerRef := metav1.GetControllerOf(vm); controllerRef != nil {
+		if pool := c.resolveControllerRef(vm.Namespace, controllerRef); pool != nil {
+			c.enqueuePool(pool)
+		}
+	}
+}
+
+func (c *PoolController) addPool(obj interface{}) {
+	c.enqueuePool(obj)
+}
+
+func (c *PoolController) deletePool(obj interface{}) {
+	c.enqueuePool(obj)
+}
+
+func (c *PoolController) updatePool(old, curr interface{}) {
+	c.enqueuePool(curr)
+}
+
+func (c *PoolController) enqueuePool(obj interface{}) {
+	logger := log.Log
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		logger.Reason(err).Error("Failed to extract poolKey from pool.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func limit(x int, burstReplicas uint) int {
+	replicas := int(burstReplicas)
+	if x <= 0 {
+		if x < -replicas {
+			return -replicas
+		}
+		return x
+	}
+	if x > replicas {
+		return replicas
+	}
+	return x
+}
+
+func hasCondition(pool *poolv1.VirtualMachinePool, cond poolv1.VirtualMachinePoolConditionType) bool {
+	for _, c := range pool.Status.Conditions {
+		if c.Type == cond {
+			return true
+		}
+	}
+	return false
+}
+
+func removeCondition(pool *poolv1.VirtualMachinePool, cond poolv1.VirtualMachinePoolConditionType) {
+	var conds []poolv1.VirtualMachinePoolCondition
+	for _, c := range pool.Status.Conditions {
+		if c.Type == cond {
+			continue
+		}
+		conds = append(conds, c)
+	}
+	pool.Status.Conditions = conds
+}
+
+func (c *PoolController) updateStatus(pool *poolv1.VirtualMachinePool, vms []*virtv1.VirtualMachine, syncErr error) error {
+	diff := calcDiff(pool, vms)
+	labelSelector, err := metav1.LabelSelectorAsSelector(pool.Spec.Selector)
+	if err != nil {
+		return err
+	}
+
+	revision := templateRevision(pool)
+	readyReplicas := int32(0)
+	updatedReplicas := int32(0)
+	for _, vm := range vms {
+		if vm.Status.Ready {
+			readyReplicas++
+		}
+		if c.isUpdated(vm, revision) {
+			updatedReplicas++
+		}
+	}
+
+	// check if we have reached the equilibrium
+	statesMatch := int32(len(vms)) == pool.Status.Replicas &&
+		readyReplicas == pool.Status.ReadyReplicas &&
+		updatedReplicas == pool.Status.UpdatedReplicas
+
+	// check if we need to update because of appeared or disappeared errors
+	errorsMatch := (syncErr != nil) == hasCondition(pool, poolv1.VirtualMachinePoolReplicaFailure)
+
+	// check if we need to update because pause was modified
+	pausedMatch := pool.Spec.Paused == hasCondition(pool, poolv1.VirtualMachinePoolReplicaPaused)
+
+	// check if the label selector changed
+	labelSelectorMatch := labelSelector.String() == pool.Status.LabelSelector
+
+	if statesMatch && errorsMatch && pausedMatch && labelSelectorMatch {
+		return nil
+	}
+
+	pool.Status.LabelSelector = labelSelector.String()
+	pool.Status.Replicas = int32(len(vms))
+	pool.Status.ReadyReplicas = readyReplicas
+	pool.Status.UpdatedReplicas = updatedReplicas
+
+	// Add/Remove Paused condition
+	checkPaused(pool)
+
+	// Add/Remove Failure condition if necessary
+	checkFailure(pool, diff, syncErr)
+
+	_, err = c.clientset.VirtualMachinePool(pool.Namespace).UpdateStatus(context.Background(), pool, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	// Finally trigger resumed or paused events
+	if !pausedMatch {
+		if pool.Spec.Paused {
+			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulPausedPoolReason, "Paused")
+		} else {
+			c.recorder.Eventf(pool, k8score.EventTypeNormal, SuccessfulResumedPoolReason, "Resumed")
+		}
+	}
+
+	return nil
+}
+
+func checkPaused(pool *poolv1.VirtualMachinePool) {
+	if pool.Spec.Paused && !hasCondition(pool, poolv1.VirtualMachinePoolReplicaPaused) {
+		pool.Status.Conditions = append(pool.Status.Conditions, poolv1.VirtualMachinePoolCondition{
+			Type:               poolv1.VirtualMachinePoolReplicaPaused,
+			Reason:             "Paused",
+			Message:            "Controller got paused",
+			LastTransitionTime: metav1.Now(),
+			Status:             k8score.ConditionTrue,
+		})
+	} else if !pool.Spec.Paused && hasCondition(pool, poolv1.VirtualMachinePoolReplicaPaused) {
+		removeCondition(pool, poolv1.VirtualMachinePoolReplicaPaused)
+	}
+}
+
+func checkFailure(pool *poolv1.VirtualMachinePool, diff int, syncErr error) {
+	if syncErr != nil && !hasCondition(pool, poolv1.VirtualMachinePoolReplicaFailure) {
+		var reason string
+		switch {
+		case diff < 0:
+			reason = FailedCreateVirtualMachineReason
+		case diff > 0:
+			reason = FailedDeleteVirtualMachineReason
+		default:
+			reason = FailedUpdateVirtualMachineReason
+		}
+
+		pool.Status.Conditions = append(pool.Status.Conditions, poolv1.VirtualMachinePoolCondition{
+			Type:               poolv1.VirtualMachinePoolReplicaFailure,
+			Reason:             reason,
+			Message:            syncErr.Error(),
+			LastTransitionTime: metav1.Now(),
+			Status:             k8score.ConditionTrue,
+		})
+
+	} else if syncErr == nil && hasCondition(pool, poolv1.VirtualMachinePoolReplicaFailure) {
+		removeCondition(pool, poolv1.VirtualMachinePoolReplicaFailure)
+	}
+}
+
+func OwnerRef(pool *poolv1.VirtualMachinePool) metav1.OwnerReference {
+	t := true
+	gvk := poolv1.SchemeGroupVersion.WithKind("VirtualMachinePool")
+	return metav1.OwnerReference{
+		APIVersion:         gvk.GroupVersion().String(),
+		Kind:               gvk.Kind,
+		Name:               pool.ObjectMeta.Name,
+		UID:                pool.ObjectMeta.UID,
+		Controller:         &t,
+		BlockOwnerDeletion: &t,
+	}
+}
+
+// resolveControllerRef returns the controller referenced by a ControllerRef,
+// or nil if the ControllerRef could not be resolved to a matching controller
+// of the correct Kind.
+func (c *PoolController) resolveControllerRef(namespace string, controllerRef *metav1.OwnerReference) *poolv1.VirtualMachinePool {
+	// We can't look up by UID, so look up by Name and then verify UID.
+	// Don't even try to look up by Name if it's the wrong Kind.
+	if controllerRef.Kind != "VirtualMachinePool" {
+		return nil
+	}
+	obj, exists, err := c.poolInformer.GetStore().GetByKey(namespace + "/" + controllerRef.Name)
+	if err != nil || !exists {
+		return nil
+	}
+
+	pool := obj.(*poolv1.VirtualMachinePool)
+	if pool.UID != controllerRef.UID {
+		// The controller we found with this Name is not the same one that the
+		// ControllerRef points to.
+		return nil
+	}
+	return pool
+}
@@ -0,0 +1,438 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package pool
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Pool", func() {
+
+	table.DescribeTable("Different replica diffs given", func(diff int, burstReplicas int, result int) {
+		Expect(limit(diff, uint(burstReplicas))).To(Equal(result))
+	},
+		table.Entry("should limit negative diff to negative burst maximum", -10, 5, -5),
+		table.Entry("should return negative diff if bigger than negative burst maximum", -4, 5, -4),
+		table.Entry("should limit positive diff to positive burst maximum", 10, 5, 5),
+		table.Entry("should return positive diff if less than positive burst maximum", 4, 5, 4),
+		table.Entry("should return 0 for zero diff", 0, 5, 0),
+	)
+
+	Context("One valid VirtualMachinePool given", func() {
+
+		var ctrl *gomock.Controller
+		var vmInterface *kubecli.MockVirtualMachineInterface
+		var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		var poolClient *kubevirtfake.Clientset
+		var poolInformer cache.SharedIndexInformer
+		var vmInformer cache.SharedIndexInformer
+		var vmiInformer cache.SharedIndexInformer
+		var controller *PoolController
+		var recorder *record.FakeRecorder
+		var virtClient *kubecli.MockKubevirtClient
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			virtClient = kubecli.NewMockKubevirtClient(ctrl)
+			vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+			vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+			poolInformer, _ = testutils.NewFakeInformerFor(&poolv1.VirtualMachinePool{})
+			vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+			vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+			recorder = record.NewFakeRecorder(100)
+
+			controller = NewPoolController(virtClient, poolInformer, vmInformer, vmiInformer, recorder, uint(10))
+
+			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+			virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		})
+
+		addPool := func(pool *poolv1.VirtualMachinePool) {
+			Expect(poolInformer.GetIndexer().Add(pool)).To(Succeed())
+			poolClient = kubevirtfake.NewSimpleClientset(pool)
+			virtClient.EXPECT().VirtualMachinePool(metav1.NamespaceDefault).Return(poolClient.PoolV1alpha1().VirtualMachinePools(metav1.NamespaceDefault)).AnyTimes()
+			key, err := cache.MetaNamespaceKeyFunc(pool)
+			Expect(err).ToNot(HaveOccurred())
+			controller.Queue.Add(key)
+		}
+
+		addVM := func(vm *v1.VirtualMachine) {
+			Expect(vmInformer.GetIndexer().Add(vm)).To(Succeed())
+		}
+
+		addVMI := func(vmi *v1.VirtualMachineInstance) {
+			Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+		}
+
+		getPool := func(pool *poolv1.VirtualMachinePool) *poolv1.VirtualMachinePool {
+			updated, err := poolClient.PoolV1alpha1().VirtualMachinePools(pool.Namespace).Get(context.Background(), pool.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return updated
+		}
+
+		It("should create missing VMs with per index names and DataVolumes", func() {
+			pool := DefaultPool(2)
+			addPool(pool)
+
+			created := map[string]*v1.VirtualMachine{}
+			vmInterface.EXPECT().Create(gomock.Any()).Times(2).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				created[vm.Name] = vm
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, SuccessfulCreateVirtualMachineReason, SuccessfulCreateVirtualMachineReason)
+			Expect(created).To(HaveKey("testpool-0"))
+			Expect(created).To(HaveKey("testpool-1"))
+
+			vm := created["testpool-1"]
+			Expect(metav1.IsControlledBy(vm, pool)).To(BeTrue())
+			Expect(vm.Labels).To(HaveKeyWithValue("pool", "testpool"))
+			Expect(vm.Annotations).To(HaveKeyWithValue(RevisionAnnotation, templateRevision(pool)))
+			Expect(vm.Spec.Template.ObjectMeta.Annotations).To(HaveKeyWithValue(RevisionAnnotation, templateRevision(pool)))
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("rootdisk-testpool-1"))
+			Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("rootdisk-testpool-1"))
+		})
+
+		It("should fill the lowest free indexes on scale up", func() {
+			pool := DefaultPool(3)
+			addPool(pool)
+			addVM(newPoolVM(pool, 1))
+
+			var names []string
+			vmInterface.EXPECT().Create(gomock.Any()).Times(2).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				names = append(names, vm.Name)
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, SuccessfulCreateVirtualMachineReason, SuccessfulCreateVirtualMachineReason)
+			Expect(names).To(ConsistOf("testpool-0", "testpool-2"))
+		})
+
+		It("should not reuse the index of a terminating VM", func() {
+			pool := DefaultPool(1)
+			addPool(pool)
+			vm := newPoolVM(pool, 0)
+			now := metav1.Now()
+			vm.DeletionTimestamp = &now
+			addVM(vm)
+
+			vmInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				Expect(vm.Name).To(Equal("testpool-1"))
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+		})
+
+		It("should delete the VMs with the highest indexes on scale down", func() {
+			pool := DefaultPool(1)
+			addPool(pool)
+			addVM(newPoolVM(pool, 0))
+			addVM(newPoolVM(pool, 1))
+			addVM(newPoolVM(pool, 2))
+
+			var names []string
+			vmInterface.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(name string, options *metav1.DeleteOptions) error {
+				Expect(options.PropagationPolicy).To(BeNil())
+				names = append(names, name)
+				return nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvents(recorder, SuccessfulDeleteVirtualMachineReason, SuccessfulDeleteVirtualMachineReason)
+			Expect(names).To(ConsistOf("testpool-1", "testpool-2"))
+		})
+
+		It("should orphan the DataVolumes on scale down with the Retain policy", func() {
+			pool := DefaultPool(0)
+			retain := poolv1.VirtualMachinePoolVolumeClaimRetain
+			pool.Spec.ScaleDownVolumeClaimPolicy = &retain
+			addPool(pool)
+			addVM(newPoolVM(pool, 0))
+
+			vmInterface.EXPECT().Delete("testpool-0", gomock.Any()).DoAndReturn(func(name string, options *metav1.DeleteOptions) error {
+				Expect(*options.PropagationPolicy).To(Equal(metav1.DeletePropagationOrphan))
+				return nil
+			})
+			vmiInterface.EXPECT().Delete("testpool-0", gomock.Any()).Return(nil)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+		})
+
+		It("should not scale when paused and add the paused condition", func() {
+			pool := DefaultPool(3)
+			pool.Spec.Paused = true
+			addPool(pool)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulPausedPoolReason)
+			updated := getPool(pool)
+			Expect(updated.Status.Conditions).To(HaveLen(1))
+			Expect(updated.Status.Conditions[0].Type).To(Equal(poolv1.VirtualMachinePoolReplicaPaused))
+		})
+
+		It("should add the failure condition if creating a VM fails", func() {
+			pool := DefaultPool(1)
+			addPool(pool)
+
+			vmInterface.EXPECT().Create(gomock.Any()).Return(nil, errFailure)
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, FailedCreateVirtualMachineReason)
+			updated := getPool(pool)
+			Expect(updated.Status.Conditions).To(HaveLen(1))
+			Expect(updated.Status.Conditions[0].Type).To(Equal(poolv1.VirtualMachinePoolReplicaFailure))
+			Expect(updated.Status.Conditions[0].Reason).To(Equal(FailedCreateVirtualMachineReason))
+		})
+
+		It("should report ready and updated replicas and the label selector", func() {
+			pool := DefaultPool(2)
+			addPool(pool)
+			vm0 := newPoolVM(pool, 0)
+			vm0.Status.Ready = true
+			addVM(vm0)
+			addVMI(newPoolVMI(vm0, true))
+			vm1 := newPoolVM(pool, 1)
+			vm1.Annotations[RevisionAnnotation] = "outdated"
+			addVM(vm1)
+
+			vmInterface.EXPECT().Update(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				Expect(vm.Name).To(Equal("testpool-1"))
+				return vm, nil
+			})
+
+			controller.Execute()
+
+			testutils.ExpectEvent(recorder, SuccessfulUpdateVirtualMachineReason)
+			updated := getPool(pool)
+			Expect(updated.Status.Replicas).To(Equal(int32(2)))
+			Expect(updated.Status.ReadyReplicas).To(Equal(int32(1)))
+			// the cache still contains the outdated VM
+			Expect(updated.Status.UpdatedReplicas).To(Equal(int32(1)))
+			Expect(updated.Status.LabelSelector).To(Equal("pool=testpool"))
+		})
+
+		Context("with a changed template", func() {
+
+			var pool *poolv1.VirtualMachinePool
+			var oldPool *poolv1.VirtualMachinePool
+
+			BeforeEach(func() {
+				oldPool = DefaultPool(3)
+				pool = oldPool.DeepCopy()
+				pool.Spec.VirtualMachineTemplate.Spec.Template.Spec.Domain.CPU = &v1.CPU{Cores: 2}
+			})
+
+			It("should update the VMs and restart not more VMIs than maxUnavailable", func() {
+				maxUnavailable := intstr.FromInt(2)
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					RollingUpdate: &poolv1.RollingUpdateVirtualMachinePool{MaxUnavailable: &maxUnavailable},
+				}
+				addPool(pool)
+				for i := 0; i < 3; i++ {
+					vm := newPoolVM(oldPool, i)
+					vm.Annotations[RevisionAnnotation] = templateRevision(pool)
+					addVM(vm)
+					addVMI(newPoolVMI(newPoolVM(oldPool, i), true))
+				}
+
+				var restarted []string
+				vmiInterface.EXPECT().Delete(gomock.Any(), gomock.Any()).Times(2).DoAndReturn(func(name string, options *metav1.DeleteOptions) error {
+					restarted = append(restarted, name)
+					return nil
+				})
+
+				controller.Execute()
+
+				testutils.ExpectEvents(recorder, SuccessfulRestartVirtualMachineReason, SuccessfulRestartVirtualMachineReason)
+				Expect(restarted).To(HaveLen(2))
+			})
+
+			It("should not restart VMIs while other VMIs are unavailable", func() {
+				addPool(pool)
+				for i := 0; i < 3; i++ {
+					vm := newPoolVM(oldPool, i)
+					vm.Annotations[RevisionAnnotation] = templateRevision(pool)
+					addVM(vm)
+					addVMI(newPoolVMI(newPoolVM(oldPool, i), i != 0))
+				}
+
+				controller.Execute()
+
+				Expect(recorder.Events).To(BeEmpty())
+			})
+
+			It("should update the VM spec to the latest template", func() {
+				addPool(pool)
+				addVM(newPoolVM(oldPool, 0))
+				addVM(newPoolVM(pool, 1))
+				addVM(newPoolVM(pool, 2))
+
+				vmInterface.EXPECT().Update(gomock.Any()).DoAndReturn(func(vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+					Expect(vm.Name).To(Equal("testpool-0"))
+					Expect(vm.Annotations).To(HaveKeyWithValue(RevisionAnnotation, templateRevision(pool)))
+					Expect(vm.Spec.Template.Spec.Domain.CPU.Cores).To(Equal(uint32(2)))
+					Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("rootdisk-testpool-0"))
+					return vm, nil
+				})
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulUpdateVirtualMachineReason)
+			})
+
+			It("should not touch the VMs with the OnDelete strategy", func() {
+				pool.Spec.UpdateStrategy = &poolv1.VirtualMachinePoolUpdateStrategy{
+					Type: poolv1.OnDeleteVirtualMachinePoolStrategyType,
+				}
+				addPool(pool)
+				for i := 0; i < 3; i++ {
+					vm := newPoolVM(oldPool, i)
+					addVM(vm)
+					addVMI(newPoolVMI(vm, true))
+				}
+
+				controller.Execute()
+
+				Expect(recorder.Events).To(BeEmpty())
+				Expect(getPool(pool).Status.UpdatedReplicas).To(BeZero())
+			})
+		})
+
+		AfterEach(func() {
+			// Ensure that we add checks for expected events to every test
+			Expect(recorder.Events).To(BeEmpty())
+			ctrl.Finish()
+		})
+	})
+})
+
+var errFailure = &failure{}
+
+type failure struct{}
+
+func (f *failure) Error() string {
+	return "failure"
+}
+
+func DefaultPool(replicas int32) *poolv1.VirtualMachinePool {
+	running := true
+	return &poolv1.VirtualMachinePool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testpool",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "pool-uid",
+		},
+		Spec: poolv1.VirtualMachinePoolSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"pool": "testpool"},
+			},
+			VirtualMachineTemplate: &poolv1.VirtualMachineTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"pool": "testpool"},
+				},
+				Spec: v1.VirtualMachineSpec{
+					Running: &running,
+					DataVolumeTemplates: []v1.DataVolumeTemplateSpec{
+						{ObjectMeta: metav1.ObjectMeta{Name: "rootdisk"}},
+					},
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: v1.VirtualMachineInstanceSpec{
+							Volumes: []v1.Volume{
+								{
+									Name: "rootdisk",
+									VolumeSource: v1.VolumeSource{
+										DataVolume: &v1.DataVolumeSource{Name: "rootdisk"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newPoolVM(pool *poolv1.VirtualMachinePool, index int) *v1.VirtualMachine {
+	vm := newVirtualMachine(pool, index)
+	vm.UID = "vm-uid"
+	return vm
+}
+
+func newPoolVMI(vm *v1.VirtualMachine, ready bool) *v1.VirtualMachineInstance {
+	t := true
+	status := k8sv1.ConditionFalse
+	if ready {
+		status = k8sv1.ConditionTrue
+	}
+	return &v1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        vm.Name,
+			Namespace:   vm.Namespace,
+			Annotations: vm.Spec.Template.ObjectMeta.Annotations,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+				Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+				Name:       vm.Name,
+				UID:        vm.UID,
+				Controller: &t,
+			}},
+		},
+		Status: v1.VirtualMachineInstanceStatus{
+			Phase: v1.Running,
+			Conditions: []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceConditionType(k8sv1.PodReady),
+					Status: status,
+				},
+			},
+		},
+	}
+}
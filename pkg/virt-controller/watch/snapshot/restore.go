@@ -29,8 +29,9 @@ import (
 	vsv1beta1 "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 
 	kubevirtv1 "kubevirt.io/client-go/api/v1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
@@ -45,13 +46,15 @@ const (
 
 	lastRestoreAnnotation = "restore.kubevirt.io/lastRestoreUID"
 
+	volumeSnapshotCopiesDeletedAnnotation = "restore.kubevirt.io/volumeSnapshotCopiesDeleted"
+
 	restoreCompleteEvent = "VirtualMachineRestoreComplete"
 
 	restoreErrorEvent = "VirtualMachineRestoreError"
 )
 
 type restoreTarget interface {
-	UID() types.UID
+	Exists() bool
 	Ready() (bool, error)
 	Reconcile() (bool, error)
 	Cleanup() error
@@ -62,6 +65,9 @@ type vmRestoreTarget struct {
 	controller *VMRestoreController
 	vmRestore  *snapshotv1.VirtualMachineRestore
 	vm         *kubevirtv1.VirtualMachine
+	// newVM is true if the target is not the source of the snapshot
+	// and gets created from the snapshot
+	newVM bool
 }
 
 var restoreAnnotationsToDelete = []string{
@@ -78,6 +84,26 @@ func restoreDVName(vmRestore *snapshotv1.VirtualMachineRestore, name string) str
 	return restorePVCName(vmRestore, name)
 }
 
+func restoreVolumeSnapshotName(vmRestore *snapshotv1.VirtualMachineRestore, name string) string {
+	return restorePVCName(vmRestore, name)
+}
+
+func restoreID(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	return fmt.Sprintf("%s-%s", vmRestore.Name, vmRestore.UID)
+}
+
+func snapshotNamespace(vmRestore *snapshotv1.VirtualMachineRestore) string {
+	if vmRestore.Spec.VirtualMachineSnapshotNamespace != nil && *vmRestore.Spec.VirtualMachineSnapshotNamespace != "" {
+		return *vmRestore.Spec.VirtualMachineSnapshotNamespace
+	}
+
+	return vmRestore.Namespace
+}
+
+func crossNamespaceRestore(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return snapshotNamespace(vmRestore) != vmRestore.Namespace
+}
+
 func vmRestoreProgressing(vmRestore *snapshotv1.VirtualMachineRestore) bool {
 	return vmRestore.Status == nil || vmRestore.Status.Complete == nil || !*vmRestore.Status.Complete
 }
@@ -88,7 +114,8 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 	logger.V(1).Infof("Updating VirtualMachineRestore")
 
 	if !vmRestoreProgressing(vmRestoreIn) {
-		return 0, nil
+		// restored PVCs may bind after completion
+		return 0, ctrl.finishVolumeSnapshotCopiesCleanup(vmRestoreIn)
 	}
 
 	vmRestoreOut := vmRestoreIn.DeepCopy()
@@ -106,7 +133,8 @@ func (ctrl *VMRestoreController) updateVMRestore(vmRestoreIn *snapshotv1.Virtual
 		return 0, ctrl.doUpdateError(vmRestoreOut, err)
 	}
 
-	if len(vmRestoreOut.OwnerReferences) == 0 {
+	// a target VM created by the restore owns it once it exists
+	if len(vmRestoreOut.OwnerReferences) == 0 && (target.Exists() || len(vmRestoreOut.Status.Conditions) == 0) {
 		target.Own(vmRestoreOut)
 		updateRestoreCondition(vmRestoreOut, newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"))
 		updateRestoreCondition(vmRestoreOut, newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"))
@@ -208,7 +236,7 @@ func (ctrl *VMRestoreController) doUpdate(original, updated *snapshotv1.VirtualM
 }
 
 func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.VirtualMachineRestore, target restoreTarget) (bool, error) {
-	content, err := ctrl.getSnapshotContent(vmRestore, target.UID())
+	content, err := ctrl.getSnapshotContent(vmRestore)
 	if err != nil {
 		return false, err
 	}
@@ -234,6 +262,10 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 				PersistentVolumeClaimName: restorePVCName(vmRestore, vb.VolumeName),
 				VolumeSnapshotName:        *vb.VolumeSnapshotName,
 			}
+			if crossNamespaceRestore(vmRestore) {
+				// VolumeSnapshots can only be restored in their namespace
+				vr.VolumeSnapshotName = restoreVolumeSnapshotName(vmRestore, vb.VolumeName)
+			}
 			restores = append(restores, vr)
 		}
 	}
@@ -257,6 +289,11 @@ func (ctrl *VMRestoreController) reconcileVolumeRestores(vmRestore *snapshotv1.V
 
 		if pvc == nil {
 			backup := content.Spec.VolumeBackups[i]
+			if crossNamespaceRestore(vmRestore) {
+				if err = ctrl.createVolumeSnapshotCopy(vmRestore, backup, restore); err != nil {
+					return false, err
+				}
+			}
 			if err = ctrl.createRestorePVC(vmRestore, target, backup, restore); err != nil {
 				return false, err
 			}
@@ -297,13 +334,18 @@ func (ctrl *VMRestoreController) getBindingMode(pvc *corev1.PersistentVolumeClai
 	return sc.VolumeBindingMode, nil
 }
 
-func (t *vmRestoreTarget) UID() types.UID {
-	return t.vm.UID
+func (t *vmRestoreTarget) Exists() bool {
+	return t.vm != nil
 }
 
 func (t *vmRestoreTarget) Ready() (bool, error) {
 	log.Log.Object(t.vmRestore).V(3).Info("Checking VM ready")
 
+	if t.newVM {
+		// the VM is created by the restore
+		return true, nil
+	}
+
 	rs, err := t.vm.RunStrategy()
 	if err != nil {
 		return false, err
@@ -329,13 +371,15 @@ func (t *vmRestoreTarget) Ready() (bool, error) {
 func (t *vmRestoreTarget) Reconcile() (bool, error) {
 	log.Log.Object(t.vmRestore).V(3).Info("Reconciling VM")
 
-	restoreID := fmt.Sprintf("%s-%s", t.vmRestore.Name, t.vmRestore.UID)
+	restoreID := restoreID(t.vmRestore)
 
-	if lastRestoreID, ok := t.vm.Annotations[lastRestoreAnnotation]; ok && lastRestoreID == restoreID {
-		return false, nil
+	if t.vm != nil {
+		if lastRestoreID, ok := t.vm.Annotations[lastRestoreAnnotation]; ok && lastRestoreID == restoreID {
+			return false, nil
+		}
 	}
 
-	content, err := t.controller.getSnapshotContent(t.vmRestore, t.UID())
+	content, err := t.controller.getSnapshotContent(t.vmRestore)
 	if err != nil {
 		return false, err
 	}
@@ -435,6 +479,10 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 	}
 
 	if updatedStatus {
+		if t.newVM {
+			return true, nil
+		}
+
 		// find DataVolumes that will no longer exist
 		for _, cdv := range t.vm.Spec.DataVolumeTemplates {
 			found := false
@@ -453,6 +501,10 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 		return true, nil
 	}
 
+	if t.vm == nil {
+		return true, t.createVM(snapshotVM, newTemplates, newVolumes)
+	}
+
 	newVM := t.vm.DeepCopy()
 	newVM.Spec = snapshotVM.Spec
 	newVM.Spec.DataVolumeTemplates = newTemplates
@@ -470,7 +522,46 @@ func (t *vmRestoreTarget) Reconcile() (bool, error) {
 	return true, nil
 }
 
+func (t *vmRestoreTarget) createVM(snapshotVM *kubevirtv1.VirtualMachine, templates []kubevirtv1.DataVolumeTemplateSpec, volumes []kubevirtv1.Volume) error {
+	newVM := &kubevirtv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        t.vmRestore.Spec.Target.Name,
+			Namespace:   t.vmRestore.Namespace,
+			Labels:      snapshotVM.Labels,
+			Annotations: snapshotVM.Annotations,
+		},
+		Spec: *snapshotVM.Spec.DeepCopy(),
+	}
+	newVM.Spec.DataVolumeTemplates = templates
+	newVM.Spec.Template.Spec.Volumes = volumes
+	if newVM.Annotations == nil {
+		newVM.Annotations = make(map[string]string)
+	}
+	newVM.Annotations[lastRestoreAnnotation] = restoreID(t.vmRestore)
+
+	// the source VM may still exist, identifiers have to be regenerated
+	for i := range newVM.Spec.Template.Spec.Domain.Devices.Interfaces {
+		newVM.Spec.Template.Spec.Domain.Devices.Interfaces[i].MacAddress = ""
+	}
+	if firmware := newVM.Spec.Template.Spec.Domain.Firmware; firmware != nil {
+		firmware.UUID = ""
+		firmware.Serial = ""
+	}
+
+	_, err := t.controller.Client.VirtualMachine(newVM.Namespace).Create(newVM)
+	if errors.IsAlreadyExists(err) {
+		// wait for the VM to show up in the cache
+		return nil
+	}
+
+	return err
+}
+
 func (t *vmRestoreTarget) Own(obj metav1.Object) {
+	if t.vm == nil {
+		return
+	}
+
 	b := true
 	obj.SetOwnerReferences([]metav1.OwnerReference{
 		{
@@ -485,6 +576,34 @@ func (t *vmRestoreTarget) Own(obj metav1.Object) {
 }
 
 func (t *vmRestoreTarget) Cleanup() error {
+	if t.newVM {
+		// PVCs were created before the VM
+		for _, vr := range t.vmRestore.Status.Restores {
+			if vr.DataVolumeName != nil {
+				continue
+			}
+
+			pvc, err := t.controller.getPVC(t.vmRestore.Namespace, vr.PersistentVolumeClaimName)
+			if err != nil {
+				return err
+			}
+
+			if pvc == nil || len(pvc.OwnerReferences) > 0 {
+				continue
+			}
+
+			t.Own(pvc)
+			_, err = t.controller.Client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Update(context.Background(), pvc, metav1.UpdateOptions{})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := t.controller.cleanupVolumeSnapshotCopies(t.vmRestore); err != nil {
+		return err
+	}
+
 	for _, dvName := range t.vmRestore.Status.DeletedDataVolumes {
 		objKey := cacheKeyFunc(t.vmRestore.Namespace, dvName)
 		_, exists, err := t.controller.DataVolumeInformer.GetStore().GetByKey(objKey)
@@ -504,8 +623,8 @@ func (t *vmRestoreTarget) Cleanup() error {
 	return nil
 }
 
-func (ctrl *VMRestoreController) getSnapshotContent(vmRestore *snapshotv1.VirtualMachineRestore, targetUID types.UID) (*snapshotv1.VirtualMachineSnapshotContent, error) {
-	objKey := cacheKeyFunc(vmRestore.Namespace, vmRestore.Spec.VirtualMachineSnapshotName)
+func (ctrl *VMRestoreController) getVMSnapshot(vmRestore *snapshotv1.VirtualMachineRestore) (*snapshotv1.VirtualMachineSnapshot, error) {
+	objKey := cacheKeyFunc(snapshotNamespace(vmRestore), vmRestore.Spec.VirtualMachineSnapshotName)
 	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(objKey)
 	if err != nil {
 		return nil, err
@@ -515,21 +634,53 @@ func (ctrl *VMRestoreController) getSnapshotContent(vmRestore *snapshotv1.Virtua
 		return nil, fmt.Errorf("VMSnapshot %s does not exist", objKey)
 	}
 
+	if crossNamespaceRestore(vmRestore) {
+		granted, err := ctrl.restoreGranted(vmRestore)
+		if err != nil {
+			return nil, err
+		}
+
+		if !granted {
+			return nil, fmt.Errorf("no VirtualMachineRestoreGrant allows restoring VMSnapshot %s in namespace %s", objKey, vmRestore.Namespace)
+		}
+	}
+
 	vms := obj.(*snapshotv1.VirtualMachineSnapshot).DeepCopy()
 	if !vmSnapshotReady(vms) {
 		return nil, fmt.Errorf("VMSnapshot %s not ready", objKey)
 	}
 
-	if vms.Status.SourceUID == nil || *vms.Status.SourceUID != targetUID {
-		return nil, fmt.Errorf("VMSnapshot source and restore target differ")
+	return vms, nil
+}
+
+func (ctrl *VMRestoreController) restoreGranted(vmRestore *snapshotv1.VirtualMachineRestore) (bool, error) {
+	objs, err := ctrl.VMRestoreGrantInformer.GetIndexer().ByIndex(cache.NamespaceIndex, snapshotNamespace(vmRestore))
+	if err != nil {
+		return false, err
+	}
+
+	for _, obj := range objs {
+		grant := obj.(*snapshotv1.VirtualMachineRestoreGrant)
+		if grant.Allows(vmRestore.Namespace, vmRestore.Spec.VirtualMachineSnapshotName) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (ctrl *VMRestoreController) getSnapshotContent(vmRestore *snapshotv1.VirtualMachineRestore) (*snapshotv1.VirtualMachineSnapshotContent, error) {
+	vms, err := ctrl.getVMSnapshot(vmRestore)
+	if err != nil {
+		return nil, err
 	}
 
 	if vms.Status.VirtualMachineSnapshotContentName == nil {
-		return nil, fmt.Errorf("no snapshot content name in %s", objKey)
+		return nil, fmt.Errorf("no snapshot content name in %s/%s", vms.Namespace, vms.Name)
 	}
 
-	objKey = cacheKeyFunc(vmRestore.Namespace, *vms.Status.VirtualMachineSnapshotContentName)
-	obj, exists, err = ctrl.VMSnapshotContentInformer.GetStore().GetByKey(objKey)
+	objKey := cacheKeyFunc(vms.Namespace, *vms.Status.VirtualMachineSnapshotContentName)
+	obj, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(objKey)
 	if err != nil {
 		return nil, err
 	}
@@ -554,7 +705,7 @@ func (ctrl *VMRestoreController) getVM(namespace, name string) (*kubevirtv1.Virt
 	}
 
 	if !exists {
-		return nil, fmt.Errorf("VirtualMachine %s/%s does not exist", namespace, name)
+		return nil, nil
 	}
 
 	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
@@ -583,11 +734,33 @@ func (ctrl *VMRestoreController) getTarget(vmRestore *snapshotv1.VirtualMachineR
 			return nil, err
 		}
 
-		return &vmRestoreTarget{
+		target := &vmRestoreTarget{
 			controller: ctrl,
 			vmRestore:  vmRestore,
 			vm:         vm,
-		}, nil
+			newVM:      vm == nil,
+		}
+
+		if vm == nil {
+			return target, nil
+		}
+
+		vms, err := ctrl.getVMSnapshot(vmRestore)
+		if err != nil {
+			return nil, err
+		}
+
+		if vms.Status.SourceUID != nil && *vms.Status.SourceUID == vm.UID {
+			return target, nil
+		}
+
+		if vm.Annotations[lastRestoreAnnotation] == restoreID(vmRestore) {
+			// created by this restore
+			target.newVM = true
+			return target, nil
+		}
+
+		return nil, fmt.Errorf("VMSnapshot source and restore target differ")
 	}
 
 	return nil, fmt.Errorf("unknown source %+v", vmRestore.Spec.Target)
@@ -599,10 +772,187 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	volumeBackup snapshotv1.VolumeBackup,
 	volumeRestore snapshotv1.VolumeRestore,
 ) error {
+	pvc, err := CreateRestorePVCDef(volumeRestore.PersistentVolumeClaimName, volumeBackup)
+	if err != nil {
+		return err
+	}
+
+	pvc.Annotations[pvcRestoreAnnotation] = vmRestore.Name
+	pvc.Spec.DataSource.Name = volumeRestore.VolumeSnapshotName
+	target.Own(pvc)
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createVolumeSnapshotCopy binds a new VolumeSnapshot in the namespace of the restore
+// to the storage snapshot of the VolumeSnapshot of volumeBackup
+func (ctrl *VMRestoreController) createVolumeSnapshotCopy(
+	vmRestore *snapshotv1.VirtualMachineRestore,
+	volumeBackup snapshotv1.VolumeBackup,
+	volumeRestore snapshotv1.VolumeRestore,
+) error {
+	client := ctrl.Client.KubernetesSnapshotClient().SnapshotV1beta1()
+
+	_, err := client.VolumeSnapshots(vmRestore.Namespace).Get(context.Background(), volumeRestore.VolumeSnapshotName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	if volumeBackup.VolumeSnapshotName == nil {
+		return fmt.Errorf("missing VolumeSnapshot name")
+	}
+
+	sourceNamespace := snapshotNamespace(vmRestore)
+	source, err := client.VolumeSnapshots(sourceNamespace).Get(context.Background(), *volumeBackup.VolumeSnapshotName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if source.Status == nil || source.Status.BoundVolumeSnapshotContentName == nil {
+		return fmt.Errorf("VolumeSnapshot %s/%s is not bound", sourceNamespace, source.Name)
+	}
+
+	sourceContent, err := client.VolumeSnapshotContents().Get(context.Background(), *source.Status.BoundVolumeSnapshotContentName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if sourceContent.Status == nil || sourceContent.Status.SnapshotHandle == nil {
+		return fmt.Errorf("VolumeSnapshotContent %s has no snapshot handle", sourceContent.Name)
+	}
+
+	// the source VolumeSnapshotContent owns the storage snapshot
+	content := &vsv1beta1.VolumeSnapshotContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: volumeRestore.VolumeSnapshotName,
+		},
+		Spec: vsv1beta1.VolumeSnapshotContentSpec{
+			VolumeSnapshotRef: corev1.ObjectReference{
+				Namespace: vmRestore.Namespace,
+				Name:      volumeRestore.VolumeSnapshotName,
+			},
+			DeletionPolicy:          vsv1beta1.VolumeSnapshotContentRetain,
+			Driver:                  sourceContent.Spec.Driver,
+			VolumeSnapshotClassName: sourceContent.Spec.VolumeSnapshotClassName,
+			Source: vsv1beta1.VolumeSnapshotContentSource{
+				SnapshotHandle: sourceContent.Status.SnapshotHandle,
+			},
+		},
+	}
+
+	_, err = client.VolumeSnapshotContents().Create(context.Background(), content, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	t := true
+	volumeSnapshot := &vsv1beta1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: volumeRestore.VolumeSnapshotName,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         snapshotv1.SchemeGroupVersion.String(),
+					Kind:               "VirtualMachineRestore",
+					Name:               vmRestore.Name,
+					UID:                vmRestore.UID,
+					Controller:         &t,
+					BlockOwnerDeletion: &t,
+				},
+			},
+		},
+		Spec: vsv1beta1.VolumeSnapshotSpec{
+			Source: vsv1beta1.VolumeSnapshotSource{
+				VolumeSnapshotContentName: &content.Name,
+			},
+			VolumeSnapshotClassName: sourceContent.Spec.VolumeSnapshotClassName,
+		},
+	}
+
+	_, err = client.VolumeSnapshots(vmRestore.Namespace).Create(context.Background(), volumeSnapshot, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// finishVolumeSnapshotCopiesCleanup deletes the remaining VolumeSnapshot copies of a
+// completed restore and records once all of them are gone, so that later resyncs skip it
+func (ctrl *VMRestoreController) finishVolumeSnapshotCopiesCleanup(vmRestore *snapshotv1.VirtualMachineRestore) error {
+	if !crossNamespaceRestore(vmRestore) {
+		return nil
+	}
+
+	if _, ok := vmRestore.Annotations[volumeSnapshotCopiesDeletedAnnotation]; ok {
+		return nil
+	}
+
+	deleted, err := ctrl.cleanupVolumeSnapshotCopies(vmRestore)
+	if err != nil || !deleted {
+		return err
+	}
+
+	vmRestoreOut := vmRestore.DeepCopy()
+	if vmRestoreOut.Annotations == nil {
+		vmRestoreOut.Annotations = make(map[string]string)
+	}
+	vmRestoreOut.Annotations[volumeSnapshotCopiesDeletedAnnotation] = "true"
+
+	return ctrl.doUpdate(vmRestore, vmRestoreOut)
+}
+
+// cleanupVolumeSnapshotCopies deletes the VolumeSnapshot copies of a cross namespace
+// restore once the restored PVCs are bound, it returns whether all copies are deleted
+func (ctrl *VMRestoreController) cleanupVolumeSnapshotCopies(vmRestore *snapshotv1.VirtualMachineRestore) (bool, error) {
+	if !crossNamespaceRestore(vmRestore) || vmRestore.Status == nil {
+		return true, nil
+	}
+
+	client := ctrl.Client.KubernetesSnapshotClient().SnapshotV1beta1()
+
+	deleted := true
+	for _, vr := range vmRestore.Status.Restores {
+		pvc, err := ctrl.getPVC(vmRestore.Namespace, vr.PersistentVolumeClaimName)
+		if err != nil {
+			return false, err
+		}
+
+		if pvc != nil && pvc.Status.Phase != corev1.ClaimBound {
+			deleted = false
+			continue
+		}
+
+		name := restoreVolumeSnapshotName(vmRestore, vr.VolumeName)
+		err = client.VolumeSnapshots(vmRestore.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+
+		err = client.VolumeSnapshotContents().Delete(context.Background(), name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+
+	return deleted, nil
+}
+
+// CreateRestorePVCDef returns a PVC named restorePVCName which is populated from the
+// VolumeSnapshot of volumeBackup and has the spec of the backed up PVC
+func CreateRestorePVCDef(restorePVCName string, volumeBackup snapshotv1.VolumeBackup) (*corev1.PersistentVolumeClaim, error) {
 	sourcePVC := volumeBackup.PersistentVolumeClaim.DeepCopy()
 	pvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        volumeRestore.PersistentVolumeClaimName,
+			Name:        restorePVCName,
 			Labels:      sourcePVC.Labels,
 			Annotations: sourcePVC.Annotations,
 		},
@@ -611,7 +961,7 @@ func (ctrl *VMRestoreController) createRestorePVC(
 
 	if volumeBackup.VolumeSnapshotName == nil {
 		log.Log.Errorf("VolumeSnapshot name missing %+v", volumeBackup)
-		return fmt.Errorf("missing VolumeSnapshot name")
+		return nil, fmt.Errorf("missing VolumeSnapshot name")
 	}
 
 	if pvc.Annotations == nil {
@@ -625,7 +975,6 @@ func (ctrl *VMRestoreController) createRestorePVC(
 			}
 		}
 	}
-	pvc.Annotations[pvcRestoreAnnotation] = vmRestore.Name
 
 	apiGroup := vsv1beta1.GroupName
 	pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
@@ -635,14 +984,7 @@ func (ctrl *VMRestoreController) createRestorePVC(
 	}
 	pvc.Spec.VolumeName = ""
 
-	target.Own(pvc)
-
-	_, err := ctrl.Client.CoreV1().PersistentVolumeClaims(vmRestore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return pvc, nil
 }
 
 func updateRestoreCondition(r *snapshotv1.VirtualMachineRestore, c snapshotv1.Condition) {
@@ -41,6 +41,7 @@ type VMRestoreController struct {
 	Client kubecli.KubevirtClient
 
 	VMRestoreInformer         cache.SharedIndexInformer
+	VMRestoreGrantInformer    cache.SharedIndexInformer
 	VMSnapshotInformer        cache.SharedIndexInformer
 	VMSnapshotContentInformer cache.SharedIndexInformer
 	VMInformer                cache.SharedIndexInformer
@@ -91,6 +92,7 @@ func (ctrl *VMRestoreController) Run(threadiness int, stopCh <-chan struct{}) er
 	if !cache.WaitForCacheSync(
 		stopCh,
 		ctrl.VMRestoreInformer.HasSynced,
+		ctrl.VMRestoreGrantInformer.HasSynced,
 		ctrl.VMSnapshotInformer.HasSynced,
 		ctrl.VMSnapshotContentInformer.HasSynced,
 		ctrl.VMInformer.HasSynced,
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/golang/mock/gomock"
+	vsv1beta1 "github.com/kubernetes-csi/external-snapshotter/v2/pkg/apis/volumesnapshot/v1beta1"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -23,6 +24,7 @@ import (
 	v1 "kubevirt.io/client-go/api/v1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
+	k8ssnapshotfake "kubevirt.io/client-go/generated/external-snapshotter/clientset/versioned/fake"
 	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
@@ -168,6 +170,9 @@ var _ = Describe("Restore controlleer", func() {
 		var vmRestoreSource *framework.FakeControllerSource
 		var vmRestoreInformer cache.SharedIndexInformer
 
+		var vmRestoreGrantSource *framework.FakeControllerSource
+		var vmRestoreGrantInformer cache.SharedIndexInformer
+
 		var vmSnapshotSource *framework.FakeControllerSource
 		var vmSnapshotInformer cache.SharedIndexInformer
 
@@ -197,9 +202,11 @@ var _ = Describe("Restore controlleer", func() {
 		var kubevirtClient *kubevirtfake.Clientset
 		var k8sClient *k8sfake.Clientset
 		var cdiClient *cdifake.Clientset
+		var k8sSnapshotClient *k8ssnapshotfake.Clientset
 
 		syncCaches := func(stop chan struct{}) {
 			go vmRestoreInformer.Run(stop)
+			go vmRestoreGrantInformer.Run(stop)
 			go vmSnapshotInformer.Run(stop)
 			go vmSnapshotContentInformer.Run(stop)
 			go vmInformer.Run(stop)
@@ -210,6 +217,7 @@ var _ = Describe("Restore controlleer", func() {
 			Expect(cache.WaitForCacheSync(
 				stop,
 				vmRestoreInformer.HasSynced,
+				vmRestoreGrantInformer.HasSynced,
 				vmSnapshotInformer.HasSynced,
 				vmSnapshotContentInformer.HasSynced,
 				vmInformer.HasSynced,
@@ -237,6 +245,7 @@ var _ = Describe("Restore controlleer", func() {
 					return nil, nil
 				},
 			})
+			vmRestoreGrantInformer, vmRestoreGrantSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestoreGrant{})
 			vmSnapshotInformer, vmSnapshotSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 			vmSnapshotContentInformer, vmSnapshotContentSource = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
 			vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
@@ -250,6 +259,7 @@ var _ = Describe("Restore controlleer", func() {
 			controller = &VMRestoreController{
 				Client:                    virtClient,
 				VMRestoreInformer:         vmRestoreInformer,
+				VMRestoreGrantInformer:    vmRestoreGrantInformer,
 				VMSnapshotInformer:        vmSnapshotInformer,
 				VMSnapshotContentInformer: vmSnapshotContentInformer,
 				VMInformer:                vmInformer,
@@ -283,6 +293,9 @@ var _ = Describe("Restore controlleer", func() {
 			cdiClient = cdifake.NewSimpleClientset()
 			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 
+			k8sSnapshotClient = k8ssnapshotfake.NewSimpleClientset()
+			virtClient.EXPECT().KubernetesSnapshotClient().Return(k8sSnapshotClient).AnyTimes()
+
 			k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
 				Expect(action).To(BeNil())
 				return true, nil, nil
@@ -608,6 +621,398 @@ var _ = Describe("Restore controlleer", func() {
 				Expect(len(l.Items)).To(BeZero())
 				testutils.ExpectEvent(recorder, "VirtualMachineRestoreComplete")
 			})
+
+			Context("with a new target VM", func() {
+				const newVMName = "newvm"
+
+				createNewVMRestore := func() *snapshotv1.VirtualMachineRestore {
+					r := createRestore()
+					r.Spec.Target.Name = newVMName
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: &f,
+					}
+					return r
+				}
+
+				It("should initialize conditions without owner", func() {
+					r := createRestore()
+					r.Spec.Target.Name = newVMName
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: &f,
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Initializing VirtualMachineRestore"),
+							newReadyCondition(corev1.ConditionFalse, "Initializing VirtualMachineRestore"),
+						},
+					}
+					expectVMRestoreUpdate(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should create restore PVCs without owner", func() {
+					r := createNewVMRestore()
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+					}
+					addVolumeRestores(r)
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						create, ok := action.(testing.CreateAction)
+						Expect(ok).To(BeTrue())
+
+						createObj := create.GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(createObj.Name).To(Equal("restore-uid-disk1"))
+						Expect(createObj.OwnerReferences).To(BeEmpty())
+
+						return true, create.GetObject(), nil
+					})
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should create the VM with new identifiers", func() {
+					s := createSnapshot()
+					vm := createSnapshotVM()
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{
+						{
+							Name:       "default",
+							MacAddress: "de:ad:00:00:be:af",
+						},
+					}
+					vm.Spec.Template.Spec.Domain.Firmware = &v1.Firmware{
+						UUID:   "5d307ca9-b3ef-428c-8861-06e72d69f223",
+						Serial: "e4686d2c-6e8d-4335-b8fd-81bee22f4814",
+					}
+					sc := createVirtualMachineSnapshotContent(s, vm)
+					sc.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						CreationTime: timeFunc(),
+						ReadyToUse:   &t,
+					}
+					vmSnapshotContentSource.Modify(sc)
+
+					r := createNewVMRestore()
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, "Waiting for target to be ready"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target to be ready"),
+					}
+					addVolumeRestores(r)
+					for i := range r.Status.Restores {
+						r.Status.Restores[i].DataVolumeName = &r.Status.Restores[i].PersistentVolumeClaimName
+					}
+					ur := r.DeepCopy()
+					ur.ResourceVersion = "1"
+					ur.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					}
+
+					vmInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(newVM *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+						Expect(newVM.Name).To(Equal(newVMName))
+						Expect(newVM.Namespace).To(Equal(testNamespace))
+						Expect(newVM.Annotations["restore.kubevirt.io/lastRestoreUID"]).To(Equal("restore-uid"))
+						Expect(newVM.Spec.DataVolumeTemplates[0].Name).To(Equal("restore-uid-disk1"))
+						Expect(newVM.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("restore-uid-disk1"))
+						Expect(newVM.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+						Expect(newVM.Spec.Template.Spec.Domain.Firmware.UUID).To(BeEmpty())
+						Expect(newVM.Spec.Template.Spec.Domain.Firmware.Serial).To(BeEmpty())
+						return newVM, nil
+					})
+					expectVMRestoreUpdate(kubevirtClient, ur)
+					for _, pvc := range getRestorePVCs(r) {
+						pvc.Annotations["cdi.kubevirt.io/storage.populatedFor"] = pvc.Name
+						pvc.Status.Phase = corev1.ClaimBound
+						pvcSource.Add(&pvc)
+					}
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should own PVCs and complete once the VM exists", func() {
+					r := createNewVMRestore()
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					}
+					addVolumeRestores(r)
+
+					vm := &v1.VirtualMachine{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      newVMName,
+							Namespace: testNamespace,
+							UID:       "new-vm-uid",
+							Annotations: map[string]string{
+								"restore.kubevirt.io/lastRestoreUID": "restore-uid",
+							},
+						},
+					}
+
+					r.OwnerReferences = []metav1.OwnerReference{
+						{
+							APIVersion:         kubevirtv1.GroupVersion.String(),
+							Kind:               "VirtualMachine",
+							Name:               newVMName,
+							UID:                vm.UID,
+							Controller:         &t,
+							BlockOwnerDeletion: &t,
+						},
+					}
+
+					ur := r.DeepCopy()
+					ur.ResourceVersion = "1"
+					ur.Status.Complete = &t
+					ur.Status.RestoreTime = timeFunc()
+					ur.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+						newReadyCondition(corev1.ConditionTrue, "Operation complete"),
+					}
+
+					k8sClient.Fake.PrependReactor("update", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						update, ok := action.(testing.UpdateAction)
+						Expect(ok).To(BeTrue())
+
+						updateObj := update.GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(updateObj.OwnerReferences).To(Equal(r.OwnerReferences))
+
+						return true, update.GetObject(), nil
+					})
+					expectVMRestoreUpdate(kubevirtClient, ur)
+
+					for _, pvc := range getRestorePVCs(r) {
+						pvc.Status.Phase = corev1.ClaimBound
+						pvcSource.Add(&pvc)
+					}
+
+					vmRestoreSource.Add(r)
+					addVM(vm)
+					controller.processVMRestoreWorkItem()
+					testutils.ExpectEvent(recorder, "VirtualMachineRestoreComplete")
+				})
+
+				It("should error if the target VM was not created by the restore", func() {
+					r := createNewVMRestore()
+					r.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+					}
+					vm := createModifiedVM()
+					vm.Name = newVMName
+					vm.UID = types.UID("foobar")
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, "VMSnapshot source and restore target differ"),
+						newReadyCondition(corev1.ConditionFalse, "VMSnapshot source and restore target differ"),
+					}
+					vmSource.Add(vm)
+					expectVMRestoreUpdate(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					testutils.ExpectEvent(recorder, "VirtualMachineRestoreError")
+				})
+			})
+
+			Context("with a snapshot in another namespace", func() {
+				const (
+					snapshotNamespace     = "source"
+					volumeSnapshotName    = "vmsnapshot-snapshot-uid-volume-disk1"
+					volumeSnapshotContent = "snapcontent-disk1"
+				)
+
+				createCrossNamespaceRestore := func() *snapshotv1.VirtualMachineRestore {
+					r := createRestore()
+					ns := snapshotNamespace
+					r.Spec.Target.Name = "newvm"
+					r.Spec.VirtualMachineSnapshotNamespace = &ns
+					r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+						Complete: &f,
+						Conditions: []snapshotv1.Condition{
+							newProgressingCondition(corev1.ConditionTrue, "Creating new PVCs"),
+							newReadyCondition(corev1.ConditionFalse, "Waiting for new PVCs"),
+						},
+						Restores: []snapshotv1.VolumeRestore{
+							{
+								VolumeName:                "disk1",
+								PersistentVolumeClaimName: "restore-uid-disk1",
+								VolumeSnapshotName:        "restore-uid-disk1",
+							},
+						},
+					}
+					return r
+				}
+
+				BeforeEach(func() {
+					s := createSnapshot()
+					s.Namespace = snapshotNamespace
+					sc := createVirtualMachineSnapshotContent(s, createSnapshotVM())
+					sc.Namespace = snapshotNamespace
+					s.Status.VirtualMachineSnapshotContentName = &sc.Name
+					sc.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+						CreationTime: timeFunc(),
+						ReadyToUse:   &t,
+					}
+					vmSnapshotSource.Add(s)
+					vmSnapshotContentSource.Add(sc)
+				})
+
+				It("should error if the restore is not granted", func() {
+					r := createCrossNamespaceRestore()
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					msg := "no VirtualMachineRestoreGrant allows restoring VMSnapshot source/snapshot in namespace default"
+					rc.Status.Conditions = []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionFalse, msg),
+						newReadyCondition(corev1.ConditionFalse, msg),
+					}
+					vmRestoreGrantSource.Add(&snapshotv1.VirtualMachineRestoreGrant{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "grant",
+							Namespace: snapshotNamespace,
+						},
+						Spec: snapshotv1.VirtualMachineRestoreGrantSpec{
+							Namespaces:                  []string{testNamespace},
+							VirtualMachineSnapshotNames: []string{"other"},
+						},
+					})
+					expectVMRestoreUpdate(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+					testutils.ExpectEvent(recorder, "VirtualMachineRestoreError")
+				})
+
+				It("should copy VolumeSnapshots into the restore namespace", func() {
+					r := createCrossNamespaceRestore()
+					vmRestoreGrantSource.Add(&snapshotv1.VirtualMachineRestoreGrant{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "grant",
+							Namespace: snapshotNamespace,
+						},
+						Spec: snapshotv1.VirtualMachineRestoreGrantSpec{
+							Namespaces: []string{testNamespace},
+						},
+					})
+
+					contentName := volumeSnapshotContent
+					handle := "snapshot-handle"
+					className := "vsc"
+					err := k8sSnapshotClient.Tracker().Add(&vsv1beta1.VolumeSnapshot{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      volumeSnapshotName,
+							Namespace: snapshotNamespace,
+						},
+						Status: &vsv1beta1.VolumeSnapshotStatus{
+							BoundVolumeSnapshotContentName: &contentName,
+						},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					err = k8sSnapshotClient.Tracker().Add(&vsv1beta1.VolumeSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: contentName,
+						},
+						Spec: vsv1beta1.VolumeSnapshotContentSpec{
+							Driver:                  "csi.example.com",
+							VolumeSnapshotClassName: &className,
+						},
+						Status: &vsv1beta1.VolumeSnapshotContentStatus{
+							SnapshotHandle: &handle,
+						},
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						create, ok := action.(testing.CreateAction)
+						Expect(ok).To(BeTrue())
+
+						createObj := create.GetObject().(*corev1.PersistentVolumeClaim)
+						Expect(createObj.Name).To(Equal("restore-uid-disk1"))
+						Expect(createObj.Spec.DataSource.Name).To(Equal("restore-uid-disk1"))
+
+						return true, create.GetObject(), nil
+					})
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+
+					vs, err := k8sSnapshotClient.SnapshotV1beta1().VolumeSnapshots(testNamespace).Get(context.Background(), "restore-uid-disk1", metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(*vs.Spec.Source.VolumeSnapshotContentName).To(Equal("restore-uid-disk1"))
+					Expect(vs.OwnerReferences[0].UID).To(Equal(r.UID))
+
+					vsc, err := k8sSnapshotClient.SnapshotV1beta1().VolumeSnapshotContents().Get(context.Background(), "restore-uid-disk1", metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(*vsc.Spec.Source.SnapshotHandle).To(Equal(handle))
+					Expect(vsc.Spec.Driver).To(Equal("csi.example.com"))
+					Expect(vsc.Spec.DeletionPolicy).To(Equal(vsv1beta1.VolumeSnapshotContentRetain))
+					Expect(vsc.Spec.VolumeSnapshotRef.Namespace).To(Equal(testNamespace))
+					Expect(vsc.Spec.VolumeSnapshotRef.Name).To(Equal("restore-uid-disk1"))
+				})
+
+				It("should delete VolumeSnapshot copies of completed restore", func() {
+					r := createCrossNamespaceRestore()
+					r.Status.Complete = &t
+
+					err := k8sSnapshotClient.Tracker().Add(&vsv1beta1.VolumeSnapshot{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "restore-uid-disk1",
+							Namespace: testNamespace,
+						},
+					})
+					Expect(err).ToNot(HaveOccurred())
+					err = k8sSnapshotClient.Tracker().Add(&vsv1beta1.VolumeSnapshotContent{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "restore-uid-disk1",
+						},
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					for _, pvc := range getRestorePVCs(r) {
+						pvc.Status.Phase = corev1.ClaimBound
+						pvcSource.Add(&pvc)
+					}
+					rc := r.DeepCopy()
+					rc.ResourceVersion = "1"
+					rc.Annotations = map[string]string{volumeSnapshotCopiesDeletedAnnotation: "true"}
+					expectVMRestoreUpdate(kubevirtClient, rc)
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+
+					vsl, err := k8sSnapshotClient.SnapshotV1beta1().VolumeSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vsl.Items).To(BeEmpty())
+					vscl, err := k8sSnapshotClient.SnapshotV1beta1().VolumeSnapshotContents().List(context.Background(), metav1.ListOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vscl.Items).To(BeEmpty())
+				})
+
+				It("should not delete VolumeSnapshot copies again once they are deleted", func() {
+					r := createCrossNamespaceRestore()
+					r.Status.Complete = &t
+					r.Annotations = map[string]string{volumeSnapshotCopiesDeletedAnnotation: "true"}
+
+					k8sSnapshotClient.Fake.PrependReactor("delete", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						Fail("unexpected delete of " + action.GetResource().Resource)
+						return true, nil, nil
+					})
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+
+				It("should not record the cleanup while restored PVCs are not bound", func() {
+					r := createCrossNamespaceRestore()
+					r.Status.Complete = &t
+
+					for _, pvc := range getRestorePVCs(r) {
+						pvc.Status.Phase = corev1.ClaimPending
+						pvcSource.Add(&pvc)
+					}
+					kubevirtClient.Fake.PrependReactor("update", "virtualmachinerestores", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						Fail("unexpected update of the VirtualMachineRestore")
+						return true, nil, nil
+					})
+					addVirtualMachineRestore(r)
+					controller.processVMRestoreWorkItem()
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,389 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	kubevirtv1 "kubevirt.io/client-go/api/v1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// ScheduleLabel is set on VirtualMachineSnapshots created by a VirtualMachineSnapshotSchedule
+	ScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+	// ScheduleTimeAnnotation holds the scheduled time a VirtualMachineSnapshot was created for
+	ScheduleTimeAnnotation = "snapshot.kubevirt.io/schedule-time"
+
+	vmSnapshotScheduleCreateEvent = "SuccessfulVirtualMachineSnapshotCreate"
+
+	vmSnapshotScheduleDeleteEvent = "SuccessfulVirtualMachineSnapshotDelete"
+
+	vmSnapshotScheduleFailedEvent = "VirtualMachineSnapshotScheduleFailed"
+
+	scheduleTimeFormat = "20060102150405"
+)
+
+func (ctrl *VMSnapshotController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	log.Log.V(3).Infof("Updating VirtualMachineSnapshotSchedule %s/%s", schedule.Namespace, schedule.Name)
+
+	if schedule.DeletionTimestamp != nil {
+		return 0, nil
+	}
+
+	now := currentTime().Time
+	scheduleCpy := schedule.DeepCopy()
+	if scheduleCpy.Status == nil {
+		scheduleCpy.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	}
+
+	cronSchedule, err := cron.ParseStandard(schedule.Spec.Schedule)
+	if err != nil {
+		ctrl.setScheduleFailure(scheduleCpy, fmt.Sprintf("invalid schedule %q: %v", schedule.Spec.Schedule, err))
+		return 0, ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleCpy)
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		ctrl.setScheduleFailure(scheduleCpy, fmt.Sprintf("invalid selector: %v", err))
+		return 0, ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleCpy)
+	}
+
+	disabled := schedule.Spec.Disabled != nil && *schedule.Spec.Disabled
+	if !disabled {
+		start := schedule.CreationTimestamp.Time
+		if schedule.Status != nil && schedule.Status.LastRunTime != nil {
+			start = schedule.Status.LastRunTime.Time
+		}
+
+		if runTime := lastScheduledTime(cronSchedule, start, now); runTime != nil {
+			if err := ctrl.createScheduledVMSnapshots(schedule, selector, *runTime); err != nil {
+				scheduleCpy.Status.LastFailure = newError(err.Error())
+				ctrl.Recorder.Event(scheduleCpy, corev1.EventTypeWarning, vmSnapshotScheduleFailedEvent, err.Error())
+				if updateErr := ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleCpy); updateErr != nil {
+					return 0, updateErr
+				}
+				return 0, err
+			}
+			scheduleCpy.Status.LastRunTime = &metav1.Time{Time: *runTime}
+		}
+
+		scheduleCpy.Status.NextRunTime = &metav1.Time{Time: cronSchedule.Next(now)}
+	} else {
+		scheduleCpy.Status.NextRunTime = nil
+	}
+
+	vmSnapshots, err := ctrl.getScheduledVMSnapshots(schedule)
+	if err != nil {
+		return 0, err
+	}
+
+	updateLastRunStatus(scheduleCpy, vmSnapshots)
+
+	expiresAt, err := ctrl.applyRetention(schedule, vmSnapshots, now)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := ctrl.updateVMSnapshotScheduleStatus(schedule, scheduleCpy); err != nil {
+		return 0, err
+	}
+
+	var retry time.Duration
+	if scheduleCpy.Status.NextRunTime != nil {
+		retry = scheduleCpy.Status.NextRunTime.Sub(now)
+	}
+	if expiresAt != nil && (retry == 0 || expiresAt.Sub(now) < retry) {
+		retry = expiresAt.Sub(now)
+	}
+	if retry < 0 {
+		retry = time.Second
+	}
+
+	return retry, nil
+}
+
+func (ctrl *VMSnapshotController) updateVMSnapshotScheduleStatus(schedule, scheduleCpy *snapshotv1.VirtualMachineSnapshotSchedule) error {
+	if equality.Semantic.DeepEqual(schedule.Status, scheduleCpy.Status) {
+		return nil
+	}
+
+	_, err := ctrl.Client.VirtualMachineSnapshotSchedule(scheduleCpy.Namespace).Update(context.Background(), scheduleCpy, metav1.UpdateOptions{})
+	return err
+}
+
+func (ctrl *VMSnapshotController) setScheduleFailure(schedule *snapshotv1.VirtualMachineSnapshotSchedule, message string) {
+	lastFailure := schedule.Status.LastFailure
+	if lastFailure != nil && lastFailure.Message != nil && *lastFailure.Message == message {
+		return
+	}
+
+	schedule.Status.LastFailure = newError(message)
+	schedule.Status.NextRunTime = nil
+	ctrl.Recorder.Event(schedule, corev1.EventTypeWarning, vmSnapshotScheduleFailedEvent, message)
+}
+
+// lastScheduledTime returns the latest time the schedule fires after start and not after now
+func lastScheduledTime(schedule cron.Schedule, start, now time.Time) *time.Time {
+	var last *time.Time
+	for t := schedule.Next(start); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		runTime := t
+		last = &runTime
+	}
+	return last
+}
+
+func scheduledVMSnapshotName(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmName string, runTime time.Time) string {
+	return fmt.Sprintf("%s-%s-%s", schedule.Name, vmName, runTime.UTC().Format(scheduleTimeFormat))
+}
+
+func (ctrl *VMSnapshotController) createScheduledVMSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, selector labels.Selector, runTime time.Time) error {
+	apiGroup := kubevirtv1.GroupName
+	for _, obj := range ctrl.VMInformer.GetStore().List() {
+		vm, ok := obj.(*kubevirtv1.VirtualMachine)
+		if !ok {
+			return fmt.Errorf("unexpected resource %+v", obj)
+		}
+
+		if vm.Namespace != schedule.Namespace || vm.DeletionTimestamp != nil || !selector.Matches(labels.Set(vm.Labels)) {
+			continue
+		}
+
+		vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      scheduledVMSnapshotName(schedule, vm.Name, runTime),
+				Namespace: schedule.Namespace,
+				Labels: map[string]string{
+					ScheduleLabel: schedule.Name,
+				},
+				Annotations: map[string]string{
+					ScheduleTimeAnnotation: runTime.UTC().Format(time.RFC3339),
+				},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vm.Name,
+				},
+				DeletionPolicy: schedule.Spec.DeletionPolicy,
+			},
+		}
+
+		_, err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if err != nil {
+			if errors.IsAlreadyExists(err) {
+				continue
+			}
+			return fmt.Errorf("failed to create VirtualMachineSnapshot %s: %v", vmSnapshot.Name, err)
+		}
+
+		ctrl.Recorder.Eventf(
+			schedule,
+			corev1.EventTypeNormal,
+			vmSnapshotScheduleCreateEvent,
+			"Successfully created VirtualMachineSnapshot %s",
+			vmSnapshot.Name,
+		)
+	}
+
+	return nil
+}
+
+func (ctrl *VMSnapshotController) getScheduledVMSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+	for _, obj := range ctrl.VMSnapshotInformer.GetStore().List() {
+		vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+		if !ok {
+			return nil, fmt.Errorf("unexpected resource %+v", obj)
+		}
+
+		if vmSnapshot.Namespace == schedule.Namespace && vmSnapshot.Labels[ScheduleLabel] == schedule.Name {
+			vmSnapshots = append(vmSnapshots, vmSnapshot)
+		}
+	}
+
+	return vmSnapshots, nil
+}
+
+// scheduledTime returns the time a VirtualMachineSnapshot was scheduled for,
+// falling back to its creation time
+func scheduledTime(vmSnapshot *snapshotv1.VirtualMachineSnapshot) time.Time {
+	if v, ok := vmSnapshot.Annotations[ScheduleTimeAnnotation]; ok {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t
+		}
+	}
+	return vmSnapshot.CreationTimestamp.Time
+}
+
+// updateLastRunStatus records the outcome of the VirtualMachineSnapshots of the last run
+func updateLastRunStatus(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) {
+	lastRunTime := schedule.Status.LastRunTime
+	if lastRunTime == nil {
+		return
+	}
+
+	allReady := true
+	found := false
+	for _, vmSnapshot := range vmSnapshots {
+		if !scheduledTime(vmSnapshot).Equal(lastRunTime.Time) {
+			continue
+		}
+		found = true
+
+		if snapshotErr := vmSnapshotError(vmSnapshot); snapshotErr != nil {
+			lastFailure := schedule.Status.LastFailure
+			if lastFailure == nil || lastFailure.Time == nil || lastFailure.Time.Before(lastRunTime) {
+				message := fmt.Sprintf("VirtualMachineSnapshot %s failed", vmSnapshot.Name)
+				if snapshotErr.Message != nil {
+					message = fmt.Sprintf("%s: %s", message, *snapshotErr.Message)
+				}
+				schedule.Status.LastFailure = newError(message)
+			}
+			return
+		}
+
+		if !vmSnapshotReady(vmSnapshot) {
+			allReady = false
+		}
+	}
+
+	if found && allReady {
+		schedule.Status.LastSuccessTime = lastRunTime.DeepCopy()
+	}
+}
+
+// applyRetention deletes the VirtualMachineSnapshots not kept by the retention policy
+// and returns the time the next kept VirtualMachineSnapshot expires
+func (ctrl *VMSnapshotController) applyRetention(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmSnapshots []*snapshotv1.VirtualMachineSnapshot, now time.Time) (*time.Time, error) {
+	retention := schedule.Spec.Retention
+	if retention == nil {
+		return nil, nil
+	}
+
+	bySource := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshot.DeletionTimestamp != nil {
+			continue
+		}
+		bySource[vmSnapshot.Spec.Source.Name] = append(bySource[vmSnapshot.Spec.Source.Name], vmSnapshot)
+	}
+
+	var nextExpiry *time.Time
+	for _, sourceSnapshots := range bySource {
+		expired, kept := retainedVMSnapshots(retention, sourceSnapshots, now)
+
+		for _, vmSnapshot := range expired {
+			err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+
+			ctrl.Recorder.Eventf(
+				schedule,
+				corev1.EventTypeNormal,
+				vmSnapshotScheduleDeleteEvent,
+				"Successfully deleted VirtualMachineSnapshot %s",
+				vmSnapshot.Name,
+			)
+		}
+
+		if retention.Expires == nil {
+			continue
+		}
+
+		for _, vmSnapshot := range kept {
+			expiresAt := scheduledTime(vmSnapshot).Add(retention.Expires.Duration)
+			if nextExpiry == nil || expiresAt.Before(*nextExpiry) {
+				nextExpiry = &expiresAt
+			}
+		}
+	}
+
+	return nextExpiry, nil
+}
+
+// retainedVMSnapshots splits the VirtualMachineSnapshots of one source into the ones
+// to delete and the ones to keep. VirtualMachineSnapshots that are not ready are
+// only removed once expired.
+func retainedVMSnapshots(retention *snapshotv1.VirtualMachineSnapshotScheduleRetention, vmSnapshots []*snapshotv1.VirtualMachineSnapshot, now time.Time) (deleted, kept []*snapshotv1.VirtualMachineSnapshot) {
+	sort.SliceStable(vmSnapshots, func(i, j int) bool {
+		return scheduledTime(vmSnapshots[i]).After(scheduledTime(vmSnapshots[j]))
+	})
+
+	countRules := retention.Last != nil || retention.Daily != nil || retention.Weekly != nil
+	days := map[string]bool{}
+	weeks := map[string]bool{}
+	last := 0
+
+	for _, vmSnapshot := range vmSnapshots {
+		t := scheduledTime(vmSnapshot).UTC()
+
+		if retention.Expires != nil && !t.Add(retention.Expires.Duration).After(now) {
+			deleted = append(deleted, vmSnapshot)
+			continue
+		}
+
+		if !countRules || !vmSnapshotReady(vmSnapshot) {
+			kept = append(kept, vmSnapshot)
+			continue
+		}
+
+		keep := false
+		if retention.Last != nil && last < int(*retention.Last) {
+			last++
+			keep = true
+		}
+
+		day := t.Format("2006-01-02")
+		if retention.Daily != nil && !days[day] && len(days) < int(*retention.Daily) {
+			days[day] = true
+			keep = true
+		}
+
+		year, week := t.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%d", year, week)
+		if retention.Weekly != nil && !weeks[weekKey] && len(weeks) < int(*retention.Weekly) {
+			weeks[weekKey] = true
+			keep = true
+		}
+
+		if keep {
+			kept = append(kept, vmSnapshot)
+		} else {
+			deleted = append(deleted, vmSnapshot)
+		}
+	}
+
+	return deleted, kept
+}
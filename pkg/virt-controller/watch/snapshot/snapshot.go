@@ -58,6 +58,10 @@ const (
 	volumeSnapshotMissingEvent = "VolumeSnapshotMissing"
 
 	snapshotRetryInterval = 5 * time.Second
+
+	// guest filesystems are thawed automatically if not unfrozen in time,
+	// unless the snapshot sets its own freeze timeout
+	defaultFreezeUnfreezeTimeout = 5 * time.Minute
 )
 
 type snapshotSource interface {
@@ -65,8 +69,12 @@ type snapshotSource interface {
 	Locked() bool
 	Lock() (bool, error)
 	Unlock() (bool, error)
-	Spec() snapshotv1.SourceSpec
-	PersistentVolumeClaims() map[string]string
+	Online() (bool, error)
+	GuestAgent() (bool, error)
+	Freeze() error
+	Unfreeze() error
+	Spec() (snapshotv1.SourceSpec, error)
+	PersistentVolumeClaims() (map[string]string, error)
 }
 
 type vmSnapshotSource struct {
@@ -186,6 +194,13 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	currentlyReady := content.Status != nil && content.Status.ReadyToUse != nil && *content.Status.ReadyToUse
 	currentlyError := content.Status != nil && content.Status.Error != nil
 
+	source, err := ctrl.getContentSource(content)
+	if err != nil {
+		return 0, err
+	}
+
+	frozen := false
+
 	for _, volumeBackup := range content.Spec.VolumeBackups {
 		if volumeBackup.VolumeSnapshotName == nil {
 			continue
@@ -219,6 +234,14 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				continue
 			}
 
+			if source != nil && !frozen {
+				// freeze the guest once, before the first VolumeSnapshot is taken
+				if err := source.Freeze(); err != nil {
+					return 0, err
+				}
+				frozen = true
+			}
+
 			volumeSnapshot, err = ctrl.createVolumeSnapshot(content, volumeBackup)
 			if err != nil {
 				return 0, err
@@ -238,6 +261,21 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 		volueSnapshotStatus = append(volueSnapshotStatus, vss)
 	}
 
+	created := true
+	for _, vss := range volueSnapshotStatus {
+		if vss.CreationTime == nil {
+			created = false
+			break
+		}
+	}
+
+	// all snapshots are cut, the guest can be thawed
+	if source != nil && created && !currentlyReady {
+		if err := source.Unfreeze(); err != nil {
+			return 0, err
+		}
+	}
+
 	ready := true
 	errorMessage := ""
 	contentCpy := content.DeepCopy()
@@ -365,6 +403,23 @@ func (ctrl *VMSnapshotController) getSnapshotSource(vmSnapshot *snapshotv1.Virtu
 	return nil, fmt.Errorf("unknown source %+v", vmSnapshot.Spec.Source)
 }
 
+func (ctrl *VMSnapshotController) getContentSource(content *snapshotv1.VirtualMachineSnapshotContent) (snapshotSource, error) {
+	if content.Spec.VirtualMachineSnapshotName == nil {
+		return nil, nil
+	}
+
+	vmSnapshot, err := ctrl.getVMSnapshot(content.Namespace, *content.Spec.VirtualMachineSnapshotName)
+	if err != nil {
+		return nil, err
+	}
+
+	if vmSnapshot == nil || !vmSnapshotProgressing(vmSnapshot) {
+		return nil, nil
+	}
+
+	return ctrl.getSnapshotSource(vmSnapshot)
+}
+
 func (ctrl *VMSnapshotController) initVMSnapshot(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (bool, error) {
 	if controller.HasFinalizer(vmSnapshot, vmSnapshotFinalizer) {
 		return false, nil
@@ -436,8 +491,18 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 		return err
 	}
 
+	pvcs, err := source.PersistentVolumeClaims()
+	if err != nil {
+		return err
+	}
+
+	sourceSpec, err := source.Spec()
+	if err != nil {
+		return err
+	}
+
 	var volumeBackups []snapshotv1.VolumeBackup
-	for volumeName, pvcName := range source.PersistentVolumeClaims() {
+	for volumeName, pvcName := range pvcs {
 		pvc, err := ctrl.getSnapshotPVC(vmSnapshot.Namespace, pvcName)
 		if err != nil {
 			return err
@@ -469,7 +534,7 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 		},
 		Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
 			VirtualMachineSnapshotName: &vmSnapshot.Name,
-			Source:                     source.Spec(),
+			Source:                     sourceSpec,
 			VolumeBackups:              volumeBackups,
 		},
 	}
@@ -572,6 +637,14 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 	if source != nil {
 		uid := source.UID()
 		vmSnapshotCpy.Status.SourceUID = &uid
+
+		if source.Locked() && vmSnapshotCpy.Status.Indications == nil {
+			indications, err := getIndications(source)
+			if err != nil {
+				return err
+			}
+			vmSnapshotCpy.Status.Indications = indications
+		}
 	}
 
 	if vmSnapshotCpy.DeletionTimestamp != nil {
@@ -785,6 +858,19 @@ func (ctrl *VMSnapshotController) getVM(vmSnapshot *snapshotv1.VirtualMachineSna
 	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
 }
 
+func (ctrl *VMSnapshotController) getVMSnapshot(namespace, name string) (*snapshotv1.VirtualMachineSnapshot, error) {
+	obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, nil
+	}
+
+	return obj.(*snapshotv1.VirtualMachineSnapshot).DeepCopy(), nil
+}
+
 func (ctrl *VMSnapshotController) getContent(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (*snapshotv1.VirtualMachineSnapshotContent, error) {
 	contentName := getVMSnapshotContentName(vmSnapshot)
 	obj, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(cacheKeyFunc(vmSnapshot.Namespace, contentName))
@@ -814,40 +900,46 @@ func (s *vmSnapshotSource) Lock() (bool, error) {
 		return true, nil
 	}
 
-	rs, err := s.vm.RunStrategy()
+	vmi, exists, err := s.getVMI()
 	if err != nil {
 		return false, err
 	}
 
-	if rs != kubevirtv1.RunStrategyHalted {
-		log.Log.V(3).Infof("Snapshottting a running VM is not supported yet")
-		return false, nil
-	}
+	if exists {
+		if !vmi.IsRunning() {
+			log.Log.V(3).Infof("VMI %s not running", vmi.Name)
+			return false, nil
+		}
 
-	key, err := controller.KeyFunc(s.vm)
-	if err != nil {
-		return false, err
-	}
+		if vmi.Status.MigrationState != nil && !vmi.Status.MigrationState.Completed {
+			log.Log.V(3).Infof("VMI %s is migrating", vmi.Name)
+			return false, nil
+		}
+	} else {
+		rs, err := s.vm.RunStrategy()
+		if err != nil {
+			return false, err
+		}
 
-	_, exists, err := s.controller.VMIInformer.GetStore().GetByKey(key)
-	if err != nil {
-		return false, err
-	}
+		if rs == kubevirtv1.RunStrategyAlways || rs == kubevirtv1.RunStrategyRerunOnFailure {
+			log.Log.V(3).Infof("VM %s is starting", s.vm.Name)
+			return false, nil
+		}
 
-	if exists {
-		log.Log.V(3).Infof("VMI still running")
-		return false, nil
-	}
+		pvcNames, err := s.pvcNames()
+		if err != nil {
+			return false, err
+		}
 
-	pvcNames := s.pvcNames()
-	pods, err := podsUsingPVCs(s.controller.PodInformer, s.vm.Namespace, pvcNames)
-	if err != nil {
-		return false, err
-	}
+		pods, err := podsUsingPVCs(s.controller.PodInformer, s.vm.Namespace, pvcNames)
+		if err != nil {
+			return false, err
+		}
 
-	if len(pods) > 0 {
-		log.Log.V(3).Infof("%d pods using PVCs %+v", len(pods), pvcNames)
-		return false, nil
+		if len(pods) > 0 {
+			log.Log.V(3).Infof("%d pods using PVCs %+v", len(pods), pvcNames)
+			return false, nil
+		}
 	}
 
 	if s.vm.Status.SnapshotInProgress != nil && *s.vm.Status.SnapshotInProgress != s.snapshot.Name {
@@ -882,6 +974,10 @@ func (s *vmSnapshotSource) Unlock() (bool, error) {
 		return false, nil
 	}
 
+	if err := s.Unfreeze(); err != nil {
+		return false, err
+	}
+
 	var err error
 	vmCopy := s.vm.DeepCopy()
 
@@ -902,25 +998,155 @@ func (s *vmSnapshotSource) Unlock() (bool, error) {
 	return true, nil
 }
 
-func (s *vmSnapshotSource) Spec() snapshotv1.SourceSpec {
+func (s *vmSnapshotSource) getVMI() (*kubevirtv1.VirtualMachineInstance, bool, error) {
+	key, err := controller.KeyFunc(s.vm)
+	if err != nil {
+		return nil, false, err
+	}
+
+	obj, exists, err := s.controller.VMIInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return nil, false, err
+	}
+
+	return obj.(*kubevirtv1.VirtualMachineInstance).DeepCopy(), true, nil
+}
+
+func (s *vmSnapshotSource) Online() (bool, error) {
+	vmi, exists, err := s.getVMI()
+	if err != nil || !exists {
+		return false, err
+	}
+
+	return vmi.IsRunning(), nil
+}
+
+func (s *vmSnapshotSource) GuestAgent() (bool, error) {
+	vmi, exists, err := s.getVMI()
+	if err != nil || !exists {
+		return false, err
+	}
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	return condManager.HasConditionWithStatus(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected, corev1.ConditionTrue), nil
+}
+
+func (s *vmSnapshotSource) canFreeze() (bool, error) {
+	online, err := s.Online()
+	if err != nil || !online {
+		return false, err
+	}
+
+	return s.GuestAgent()
+}
+
+func (s *vmSnapshotSource) Freeze() error {
+	if !s.Locked() {
+		return fmt.Errorf("attempting to freeze unlocked VM")
+	}
+
+	canFreeze, err := s.canFreeze()
+	if err != nil || !canFreeze {
+		return err
+	}
+
+	log.Log.V(3).Infof("Freezing vm %s file system before taking the snapshot", s.vm.Name)
+
+	return s.controller.Client.VirtualMachineInstance(s.vm.Namespace).Freeze(s.vm.Name, s.freezeUnfreezeTimeout())
+}
+
+func (s *vmSnapshotSource) freezeUnfreezeTimeout() time.Duration {
+	if s.snapshot.Spec.FreezeTimeout != nil {
+		return s.snapshot.Spec.FreezeTimeout.Duration
+	}
+	return defaultFreezeUnfreezeTimeout
+}
+
+func (s *vmSnapshotSource) Unfreeze() error {
+	if !s.Locked() {
+		return nil
+	}
+
+	canFreeze, err := s.canFreeze()
+	if err != nil || !canFreeze {
+		return err
+	}
+
+	log.Log.V(3).Infof("Unfreezing vm %s file system after taking the snapshot", s.vm.Name)
+
+	return s.controller.Client.VirtualMachineInstance(s.vm.Namespace).Unfreeze(s.vm.Name)
+}
+
+func (s *vmSnapshotSource) Spec() (snapshotv1.SourceSpec, error) {
 	vmCpy := s.vm.DeepCopy()
 	vmCpy.Status = kubevirtv1.VirtualMachineStatus{}
+
+	vmi, exists, err := s.getVMI()
+	if err != nil {
+		return snapshotv1.SourceSpec{}, err
+	}
+
+	// a running VMI may have hotplugged volumes which are not part of the VM spec
+	if exists {
+		vmCpy.Spec.Template.Spec.Volumes = vmi.Spec.Volumes
+		vmCpy.Spec.Template.Spec.Domain.Devices.Disks = vmi.Spec.Domain.Devices.Disks
+	}
+
 	return snapshotv1.SourceSpec{
 		VirtualMachine: vmCpy,
-	}
+	}, nil
 }
 
-func (s *vmSnapshotSource) PersistentVolumeClaims() map[string]string {
-	return getPVCsFromVolumes(s.vm.Spec.Template.Spec.Volumes)
+func (s *vmSnapshotSource) PersistentVolumeClaims() (map[string]string, error) {
+	vmi, exists, err := s.getVMI()
+	if err != nil {
+		return nil, err
+	}
+
+	if exists {
+		return getPVCsFromVolumes(vmi.Spec.Volumes), nil
+	}
+
+	return getPVCsFromVolumes(s.vm.Spec.Template.Spec.Volumes), nil
 }
 
-func (s *vmSnapshotSource) pvcNames() sets.String {
-	pvcs := s.PersistentVolumeClaims()
+func (s *vmSnapshotSource) pvcNames() (sets.String, error) {
+	pvcs, err := s.PersistentVolumeClaims()
+	if err != nil {
+		return nil, err
+	}
+
 	ss := sets.NewString()
 	for _, pvc := range pvcs {
 		ss.Insert(pvc)
 	}
-	return ss
+	return ss, nil
+}
+
+func getIndications(source snapshotSource) ([]snapshotv1.Indication, error) {
+	var indications []snapshotv1.Indication
+
+	online, err := source.Online()
+	if err != nil {
+		return nil, err
+	}
+
+	if online {
+		indications = append(indications, snapshotv1.VMSnapshotOnlineSnapshotIndication)
+
+		ga, err := source.GuestAgent()
+		if err != nil {
+			return nil, err
+		}
+
+		if ga {
+			indications = append(indications, snapshotv1.VMSnapshotGuestAgentFrozenIndication)
+		} else {
+			indications = append(indications, snapshotv1.VMSnapshotCrashConsistentIndication)
+		}
+	}
+
+	return indications, nil
 }
 
 func getPVCsFromVolumes(volumes []kubevirtv1.Volume) map[string]string {
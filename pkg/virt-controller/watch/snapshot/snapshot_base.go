@@ -60,26 +60,28 @@ type dynamicInformer struct {
 type VMSnapshotController struct {
 	Client kubecli.KubevirtClient
 
-	VMSnapshotInformer        cache.SharedIndexInformer
-	VMSnapshotContentInformer cache.SharedIndexInformer
-	VMInformer                cache.SharedIndexInformer
-	VMIInformer               cache.SharedIndexInformer
-	StorageClassInformer      cache.SharedIndexInformer
-	PVCInformer               cache.SharedIndexInformer
-	CRDInformer               cache.SharedIndexInformer
-	PodInformer               cache.SharedIndexInformer
-	DVInformer                cache.SharedIndexInformer
+	VMSnapshotInformer         cache.SharedIndexInformer
+	VMSnapshotContentInformer  cache.SharedIndexInformer
+	VMInformer                 cache.SharedIndexInformer
+	VMIInformer                cache.SharedIndexInformer
+	StorageClassInformer       cache.SharedIndexInformer
+	PVCInformer                cache.SharedIndexInformer
+	CRDInformer                cache.SharedIndexInformer
+	PodInformer                cache.SharedIndexInformer
+	DVInformer                 cache.SharedIndexInformer
+	VMSnapshotScheduleInformer cache.SharedIndexInformer
 
 	Recorder record.EventRecorder
 
 	ResyncPeriod time.Duration
 
-	vmSnapshotQueue        workqueue.RateLimitingInterface
-	vmSnapshotContentQueue workqueue.RateLimitingInterface
-	crdQueue               workqueue.RateLimitingInterface
-	vmSnapshotStatusQueue  workqueue.RateLimitingInterface
-	vmQueue                workqueue.RateLimitingInterface
-	dvQueue                workqueue.RateLimitingInterface
+	vmSnapshotQueue         workqueue.RateLimitingInterface
+	vmSnapshotContentQueue  workqueue.RateLimitingInterface
+	crdQueue                workqueue.RateLimitingInterface
+	vmSnapshotStatusQueue   workqueue.RateLimitingInterface
+	vmQueue                 workqueue.RateLimitingInterface
+	dvQueue                 workqueue.RateLimitingInterface
+	vmSnapshotScheduleQueue workqueue.RateLimitingInterface
 
 	dynamicInformerMap map[string]*dynamicInformer
 	eventHandlerMap    map[string]cache.ResourceEventHandlerFuncs
@@ -97,6 +99,7 @@ func (ctrl *VMSnapshotController) Init() {
 	ctrl.vmSnapshotStatusQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "snapshot-controller-vmsnashotstatus")
 	ctrl.vmQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "snapshot-controller-vm")
 	ctrl.dvQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "snapshot-controller-dv")
+	ctrl.vmSnapshotScheduleQueue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "snapshot-controller-vmsnapshotschedule")
 
 	ctrl.dynamicInformerMap = map[string]*dynamicInformer{
 		volumeSnapshotCRD:      &dynamicInformer{informerFunc: controller.VolumeSnapshotInformer},
@@ -158,6 +161,14 @@ func (ctrl *VMSnapshotController) Init() {
 		ctrl.ResyncPeriod,
 	)
 
+	ctrl.VMSnapshotScheduleInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotSchedule(newObj) },
+		},
+		ctrl.ResyncPeriod,
+	)
+
 	ctrl.vmStatusUpdater = status.NewVMStatusUpdater(ctrl.Client)
 }
 
@@ -169,6 +180,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 	defer ctrl.crdQueue.ShutDown()
 	defer ctrl.vmSnapshotStatusQueue.ShutDown()
 	defer ctrl.vmQueue.ShutDown()
+	defer ctrl.vmSnapshotScheduleQueue.ShutDown()
 
 	log.Log.Info("Starting snapshot controller.")
 	defer log.Log.Info("Shutting down snapshot controller.")
@@ -184,6 +196,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 		ctrl.PVCInformer.HasSynced,
 		ctrl.DVInformer.HasSynced,
 		ctrl.StorageClassInformer.HasSynced,
+		ctrl.VMSnapshotScheduleInformer.HasSynced,
 	) {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
@@ -195,6 +208,7 @@ func (ctrl *VMSnapshotController) Run(threadiness int, stopCh <-chan struct{}) e
 		go wait.Until(ctrl.vmSnapshotStatusWorker, time.Second, stopCh)
 		go wait.Until(ctrl.vmWorker, time.Second, stopCh)
 		go wait.Until(ctrl.dvWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmSnapshotScheduleWorker, time.Second, stopCh)
 	}
 
 	<-stopCh
@@ -236,6 +250,11 @@ func (ctrl *VMSnapshotController) dvWorker() {
 	}
 }
 
+func (ctrl *VMSnapshotController) vmSnapshotScheduleWorker() {
+	for ctrl.processVMSnapshotScheduleWorkItem() {
+	}
+}
+
 func (ctrl *VMSnapshotController) processVMSnapshotWorkItem() bool {
 	return processWorkItem(ctrl.vmSnapshotQueue, func(key string) (time.Duration, error) {
 		log.Log.V(3).Infof("vmSnapshot worker processing key [%s]", key)
@@ -371,6 +390,24 @@ func (ctrl *VMSnapshotController) processDVWorkItem() bool {
 	})
 }
 
+func (ctrl *VMSnapshotController) processVMSnapshotScheduleWorkItem() bool {
+	return processWorkItem(ctrl.vmSnapshotScheduleQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotSchedule worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotScheduleInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		schedule, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+
+		return ctrl.updateVMSnapshotSchedule(schedule.DeepCopy())
+	})
+}
+
 func (ctrl *VMSnapshotController) handleVMSnapshot(obj interface{}) {
 	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
 		obj = unknown.Obj
@@ -384,6 +421,28 @@ func (ctrl *VMSnapshotController) handleVMSnapshot(obj interface{}) {
 		}
 		log.Log.V(3).Infof("enqueued %q for sync", objName)
 		ctrl.vmSnapshotQueue.Add(objName)
+
+		if scheduleName, ok := vmSnapshot.Labels[ScheduleLabel]; ok {
+			k := cacheKeyFunc(vmSnapshot.Namespace, scheduleName)
+			log.Log.V(5).Infof("enqueued vmsnapshotschedule %q for sync", k)
+			ctrl.vmSnapshotScheduleQueue.Add(k)
+		}
+	}
+}
+
+func (ctrl *VMSnapshotController) handleVMSnapshotSchedule(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if schedule, ok := obj.(*snapshotv1.VirtualMachineSnapshotSchedule); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(schedule)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, schedule)
+			return
+		}
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.vmSnapshotScheduleQueue.Add(objName)
 	}
 }
 
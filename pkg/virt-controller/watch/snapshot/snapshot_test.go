@@ -106,6 +106,22 @@ var _ = Describe("Snapshot controlleer", func() {
 		}
 	}
 
+	createRunningVMI := func(vm *v1.VirtualMachine, agentConnected bool) *v1.VirtualMachineInstance {
+		vmi := createVMI(vm)
+		vmi.Spec.Volumes = vm.Spec.Template.Spec.Volumes
+		vmi.Spec.Domain.Devices.Disks = vm.Spec.Template.Spec.Domain.Devices.Disks
+		vmi.Status.Phase = v1.Running
+		if agentConnected {
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceAgentConnected,
+					Status: corev1.ConditionTrue,
+				},
+			}
+		}
+		return vmi
+	}
+
 	createPersistentVolumeClaims := func() []corev1.PersistentVolumeClaim {
 		return createPVCsForVM(createLockedVM())
 	}
@@ -174,6 +190,7 @@ var _ = Describe("Snapshot controlleer", func() {
 
 		var ctrl *gomock.Controller
 		var vmInterface *kubecli.MockVirtualMachineInterface
+		var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
 		var vmSnapshotSource *framework.FakeControllerSource
 		var vmSnapshotInformer cache.SharedIndexInformer
 		var vmSnapshotContentSource *framework.FakeControllerSource
@@ -196,6 +213,7 @@ var _ = Describe("Snapshot controlleer", func() {
 		var crdSource *framework.FakeControllerSource
 		var dvInformer cache.SharedIndexInformer
 		var dvSource *framework.FakeControllerSource
+		var vmSnapshotScheduleInformer cache.SharedIndexInformer
 		var stop chan struct{}
 		var controller *VMSnapshotController
 		var recorder *record.FakeRecorder
@@ -218,6 +236,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			go vmiInformer.Run(stop)
 			go podInformer.Run(stop)
 			go dvInformer.Run(stop)
+			go vmSnapshotScheduleInformer.Run(stop)
 			Expect(cache.WaitForCacheSync(
 				stop,
 				vmSnapshotInformer.HasSynced,
@@ -229,6 +248,7 @@ var _ = Describe("Snapshot controlleer", func() {
 				vmiInformer.HasSynced,
 				podInformer.HasSynced,
 				dvInformer.HasSynced,
+				vmSnapshotScheduleInformer.HasSynced,
 			)).To(BeTrue())
 		}
 
@@ -237,6 +257,7 @@ var _ = Describe("Snapshot controlleer", func() {
 			ctrl = gomock.NewController(GinkgoT())
 			virtClient := kubecli.NewMockKubevirtClient(ctrl)
 			vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+			vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
 
 			vmSnapshotInformer, vmSnapshotSource = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, cache.Indexers{
 				"vm": func(obj interface{}) ([]string, error) {
@@ -270,23 +291,25 @@ var _ = Describe("Snapshot controlleer", func() {
 			pvcInformer, pvcSource = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
 			crdInformer, crdSource = testutils.NewFakeInformerFor(&extv1beta1.CustomResourceDefinition{})
 			dvInformer, dvSource = testutils.NewFakeInformerFor(&cdiv1alpha1.DataVolume{})
+			vmSnapshotScheduleInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
 
 			recorder = record.NewFakeRecorder(100)
 
 			controller = &VMSnapshotController{
-				Client:                    virtClient,
-				VMSnapshotInformer:        vmSnapshotInformer,
-				VMSnapshotContentInformer: vmSnapshotContentInformer,
-				VMInformer:                vmInformer,
-				VMIInformer:               vmiInformer,
-				PodInformer:               podInformer,
-				StorageClassInformer:      storageClassInformer,
-				PVCInformer:               pvcInformer,
-				CRDInformer:               crdInformer,
-				DVInformer:                dvInformer,
-				Recorder:                  recorder,
-				ResyncPeriod:              60 * time.Second,
-				vmStatusUpdater:           status.NewVMStatusUpdater(virtClient),
+				Client:                     virtClient,
+				VMSnapshotInformer:         vmSnapshotInformer,
+				VMSnapshotContentInformer:  vmSnapshotContentInformer,
+				VMInformer:                 vmInformer,
+				VMIInformer:                vmiInformer,
+				PodInformer:                podInformer,
+				StorageClassInformer:       storageClassInformer,
+				PVCInformer:                pvcInformer,
+				CRDInformer:                crdInformer,
+				DVInformer:                 dvInformer,
+				VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+				Recorder:                   recorder,
+				ResyncPeriod:               60 * time.Second,
+				vmStatusUpdater:            status.NewVMStatusUpdater(virtClient),
 			}
 			controller.Init()
 
@@ -305,12 +328,15 @@ var _ = Describe("Snapshot controlleer", func() {
 
 			// Set up mock client
 			virtClient.EXPECT().VirtualMachine(testNamespace).Return(vmInterface).AnyTimes()
+			virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
 
 			vmSnapshotClient = kubevirtfake.NewSimpleClientset()
 			virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
 				Return(vmSnapshotClient.SnapshotV1alpha1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
 			virtClient.EXPECT().VirtualMachineSnapshotContent(testNamespace).
 				Return(vmSnapshotClient.SnapshotV1alpha1().VirtualMachineSnapshotContents(testNamespace)).AnyTimes()
+			virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+				Return(vmSnapshotClient.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
 
 			k8sSnapshotClient = k8ssnapshotfake.NewSimpleClientset()
 			virtClient.EXPECT().KubernetesSnapshotClient().Return(k8sSnapshotClient).AnyTimes()
@@ -450,6 +476,24 @@ var _ = Describe("Snapshot controlleer", func() {
 				controller.processVMSnapshotWorkItem()
 			})
 
+			It("should unfreeze and unlock online source VirtualMachine", func() {
+				vmSnapshot := createVMSnapshotSuccess()
+				vm := createLockedVM()
+				vm.Spec.Running = &t
+				updatedVM := vm.DeepCopy()
+				updatedVM.Finalizers = []string{}
+				updatedVM.ResourceVersion = "1"
+				vmSource.Add(vm)
+				vmiSource.Add(createRunningVMI(vm, true))
+				vmiInterface.EXPECT().Unfreeze(vm.Name).Return(nil)
+				vmInterface.EXPECT().Update(updatedVM).Return(updatedVM, nil)
+				statusUpdate := updatedVM.DeepCopy()
+				statusUpdate.Status.SnapshotInProgress = nil
+				vmInterface.EXPECT().UpdateStatus(statusUpdate).Return(statusUpdate, nil)
+				addVirtualMachineSnapshot(vmSnapshot)
+				controller.processVMSnapshotWorkItem()
+			})
+
 			It("should finish unlock source VirtualMachine", func() {
 				vmSnapshot := createVMSnapshotSuccess()
 				vm := createLockedVM()
@@ -588,6 +632,37 @@ var _ = Describe("Snapshot controlleer", func() {
 				controller.processVMSnapshotWorkItem()
 			})
 
+			It("should lock source if VMI running", func() {
+				vmSnapshot := createVMSnapshotInProgress()
+				vm := createVM()
+				vm.Spec.Running = &t
+				vmStatusUpdate := vm.DeepCopy()
+				vmStatusUpdate.ResourceVersion = "1"
+				vmStatusUpdate.Status.SnapshotInProgress = &vmSnapshotName
+				vmUpdate := vmStatusUpdate.DeepCopy()
+				vmUpdate.Finalizers = []string{"snapshot.kubevirt.io/snapshot-source-protection"}
+
+				vmiSource.Add(createRunningVMI(vm, true))
+				vmSource.Add(vm)
+				vmInterface.EXPECT().UpdateStatus(vmStatusUpdate).Return(vmStatusUpdate, nil)
+				vmInterface.EXPECT().Update(vmUpdate).Return(vmUpdate, nil)
+				addVirtualMachineSnapshot(vmSnapshot)
+				controller.processVMSnapshotWorkItem()
+			})
+
+			It("should not lock source if VMI migrating", func() {
+				vmSnapshot := createVMSnapshotInProgress()
+				vm := createVM()
+				vm.Spec.Running = &t
+				vmi := createRunningVMI(vm, true)
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{}
+
+				vmiSource.Add(vmi)
+				vmSource.Add(vm)
+				addVirtualMachineSnapshot(vmSnapshot)
+				controller.processVMSnapshotWorkItem()
+			})
+
 			It("should not lock source if pods using PVCs", func() {
 				vmSnapshot := createVMSnapshotInProgress()
 				vm := createVM()
@@ -673,6 +748,37 @@ var _ = Describe("Snapshot controlleer", func() {
 				controller.processVMSnapshotWorkItem()
 			})
 
+			DescribeTable("should update VirtualMachineSnapshotStatus indications for online VM", func(agentConnected bool, indications []snapshotv1.Indication) {
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: &f,
+				}
+
+				vmSnapshot := createVMSnapshotInProgress()
+				updatedSnapshot := vmSnapshot.DeepCopy()
+				updatedSnapshot.ResourceVersion = "1"
+				updatedSnapshot.Status.SourceUID = &vmUID
+				updatedSnapshot.Status.VirtualMachineSnapshotContentName = &vmSnapshotContent.Name
+				updatedSnapshot.Status.Indications = indications
+				updatedSnapshot.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+					newReadyCondition(corev1.ConditionFalse, "Not ready"),
+				}
+
+				vm := createLockedVM()
+				vm.Spec.Running = &t
+
+				vmSource.Add(vm)
+				vmiSource.Add(createRunningVMI(vm, agentConnected))
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+				expectVMSnapshotUpdate(vmSnapshotClient, updatedSnapshot)
+				addVirtualMachineSnapshot(vmSnapshot)
+				controller.processVMSnapshotWorkItem()
+			},
+				Entry("with guest agent", true, []snapshotv1.Indication{snapshotv1.VMSnapshotOnlineSnapshotIndication, snapshotv1.VMSnapshotGuestAgentFrozenIndication}),
+				Entry("without guest agent", false, []snapshotv1.Indication{snapshotv1.VMSnapshotOnlineSnapshotIndication, snapshotv1.VMSnapshotCrashConsistentIndication}),
+			)
+
 			It("should create VolumeSnapshot", func() {
 				storageClass := createStorageClass()
 				volumeSnapshotClass := &createVolumeSnapshotClasses()[0]
@@ -743,6 +849,86 @@ var _ = Describe("Snapshot controlleer", func() {
 				testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
 			})
 
+			DescribeTable("should freeze online VM before creating VolumeSnapshot", func(freezeTimeout *metav1.Duration, expectedFreezeTimeout time.Duration) {
+				storageClass := createStorageClass()
+				volumeSnapshotClass := &createVolumeSnapshotClasses()[0]
+				pvcs := createPersistentVolumeClaims()
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+
+				updatedContent := vmSnapshotContent.DeepCopy()
+				updatedContent.ResourceVersion = "1"
+				updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: &f,
+				}
+
+				volumeSnapshots := createVolumeSnapshots(vmSnapshotContent)
+				for i := range volumeSnapshots {
+					vss := snapshotv1.VolumeSnapshotStatus{
+						VolumeSnapshotName: volumeSnapshots[i].Name,
+					}
+					updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, vss)
+				}
+
+				vm := createLockedVM()
+				vm.Spec.Running = &t
+
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshot.Spec.FreezeTimeout = freezeTimeout
+				vmSnapshotSource.Add(vmSnapshot)
+				vmSource.Add(vm)
+				vmiSource.Add(createRunningVMI(vm, true))
+				storageClassSource.Add(storageClass)
+				volumeSnapshotClassSource.Add(volumeSnapshotClass)
+				for i := range pvcs {
+					pvcSource.Add(&pvcs[i])
+				}
+
+				vmiInterface.EXPECT().Freeze(vm.Name, expectedFreezeTimeout).Return(nil)
+				expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClass.Name, vmSnapshotContent)
+				expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+				addVirtualMachineSnapshotContent(vmSnapshotContent)
+				controller.processVMSnapshotContentWorkItem()
+				testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+			},
+				Entry("with the default freeze timeout", nil, defaultFreezeUnfreezeTimeout),
+				Entry("with the freeze timeout of the snapshot", &metav1.Duration{Duration: time.Minute}, time.Minute),
+			)
+
+			It("should unfreeze online VM when VolumeSnapshots created", func() {
+				vmSnapshotContent := createVMSnapshotContent()
+				updatedContent := vmSnapshotContent.DeepCopy()
+				updatedContent.ResourceVersion = "1"
+				updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: &f,
+				}
+
+				vm := createLockedVM()
+				vm.Spec.Running = &t
+
+				vmSnapshotSource.Add(createVMSnapshotInProgress())
+				vmSource.Add(vm)
+				vmiSource.Add(createRunningVMI(vm, true))
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+				vmiInterface.EXPECT().Unfreeze(vm.Name).Return(nil)
+				expectVMSnapshotContentUpdate(vmSnapshotClient, updatedContent)
+
+				volumeSnapshots := createVolumeSnapshots(vmSnapshotContent)
+				for i := range volumeSnapshots {
+					volumeSnapshots[i].Status.CreationTime = timeFunc()
+					addVolumeSnapshot(&volumeSnapshots[i])
+
+					vss := snapshotv1.VolumeSnapshotStatus{
+						VolumeSnapshotName: volumeSnapshots[i].Name,
+						ReadyToUse:         volumeSnapshots[i].Status.ReadyToUse,
+						CreationTime:       volumeSnapshots[i].Status.CreationTime,
+					}
+					updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, vss)
+				}
+
+				controller.processVMSnapshotContentWorkItem()
+			})
+
 			It("should update VirtualMachineSnapshotContent", func() {
 				vmSnapshotContent := createVMSnapshotContent()
 				updatedContent := vmSnapshotContent.DeepCopy()
@@ -1361,6 +1547,228 @@ var _ = Describe("Snapshot controlleer", func() {
 			})
 		})
 
+		Context("with VirtualMachineSnapshotSchedule", func() {
+			var runTime time.Time
+
+			createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+				return &snapshotv1.VirtualMachineSnapshotSchedule{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "schedule",
+						Namespace:         testNamespace,
+						CreationTimestamp: metav1.NewTime(timeStamp.Add(-time.Hour)),
+					},
+					Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+						Schedule: "* * * * *",
+						Selector: metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"kubevirt.io/vm": "vm-alpine-datavolume",
+							},
+						},
+					},
+				}
+			}
+
+			createScheduledVMSnapshot := func(scheduleTime time.Time, ready bool) *snapshotv1.VirtualMachineSnapshot {
+				vmSnapshot := createVirtualMachineSnapshot(testNamespace, "schedule-"+vmName+"-"+scheduleTime.UTC().Format(scheduleTimeFormat), vmName)
+				vmSnapshot.CreationTimestamp = metav1.NewTime(scheduleTime)
+				vmSnapshot.Labels = map[string]string{ScheduleLabel: "schedule"}
+				vmSnapshot.Annotations = map[string]string{ScheduleTimeAnnotation: scheduleTime.UTC().Format(time.RFC3339)}
+				vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+					ReadyToUse: &ready,
+				}
+				return vmSnapshot
+			}
+
+			BeforeEach(func() {
+				runTime = timeStamp.Time.Truncate(time.Minute)
+			})
+
+			It("should create VirtualMachineSnapshots of the selected VirtualMachines", func() {
+				schedule := createSchedule()
+				schedule.Spec.DeletionPolicy = &retain
+				vm := createVM()
+				otherVM := createVirtualMachine(testNamespace, "othervm")
+				otherVM.Labels = nil
+				vmSource.Add(vm)
+				vmSource.Add(otherVM)
+				syncCaches(stop)
+
+				expectVMSnapshotCreate(vmSnapshotClient, func(vmSnapshot *snapshotv1.VirtualMachineSnapshot) {
+					Expect(vmSnapshot.Name).To(Equal("schedule-" + vmName + "-" + runTime.UTC().Format(scheduleTimeFormat)))
+					Expect(vmSnapshot.Labels).To(HaveKeyWithValue(ScheduleLabel, "schedule"))
+					Expect(vmSnapshot.Annotations).To(HaveKeyWithValue(ScheduleTimeAnnotation, runTime.UTC().Format(time.RFC3339)))
+					Expect(vmSnapshot.Spec.Source.Name).To(Equal(vmName))
+					Expect(vmSnapshot.Spec.DeletionPolicy).To(Equal(&retain))
+				})
+				expectVMSnapshotScheduleUpdate(vmSnapshotClient, func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+					Expect(s.Status.LastRunTime.Time.Equal(runTime)).To(BeTrue())
+					Expect(s.Status.NextRunTime.Time.Equal(runTime.Add(time.Minute))).To(BeTrue())
+					Expect(s.Status.LastSuccessTime).To(BeNil())
+					Expect(s.Status.LastFailure).To(BeNil())
+				})
+
+				retry, err := controller.updateVMSnapshotSchedule(schedule)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(retry).To(BeNumerically(">", 0))
+				Expect(retry).To(BeNumerically("<=", time.Minute))
+				Expect(recorder.Events).To(Receive(ContainSubstring(vmSnapshotScheduleCreateEvent)))
+			})
+
+			It("should report a failure to create a VirtualMachineSnapshot", func() {
+				schedule := createSchedule()
+				vmSource.Add(createVM())
+				syncCaches(stop)
+
+				vmSnapshotClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					return true, nil, fmt.Errorf("quota exceeded")
+				})
+				expectVMSnapshotScheduleUpdate(vmSnapshotClient, func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+					Expect(s.Status.LastFailure).ToNot(BeNil())
+					Expect(*s.Status.LastFailure.Message).To(ContainSubstring("quota exceeded"))
+					Expect(s.Status.LastRunTime).To(BeNil())
+				})
+
+				_, err := controller.updateVMSnapshotSchedule(schedule)
+				Expect(err).To(HaveOccurred())
+				Expect(recorder.Events).To(Receive(ContainSubstring(vmSnapshotScheduleFailedEvent)))
+			})
+
+			It("should not create VirtualMachineSnapshots when disabled", func() {
+				schedule := createSchedule()
+				schedule.Spec.Disabled = &t
+				schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+				vmSource.Add(createVM())
+				syncCaches(stop)
+
+				retry, err := controller.updateVMSnapshotSchedule(schedule)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(retry).To(BeZero())
+			})
+
+			It("should not create VirtualMachineSnapshots before the next run", func() {
+				schedule := createSchedule()
+				schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+					LastRunTime: &metav1.Time{Time: runTime},
+					NextRunTime: &metav1.Time{Time: runTime.Add(time.Minute)},
+				}
+				vmSource.Add(createVM())
+				syncCaches(stop)
+
+				retry, err := controller.updateVMSnapshotSchedule(schedule)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(retry).To(BeNumerically(">", 0))
+			})
+
+			It("should report an invalid schedule", func() {
+				schedule := createSchedule()
+				schedule.Spec.Schedule = "not a schedule"
+				syncCaches(stop)
+
+				expectVMSnapshotScheduleUpdate(vmSnapshotClient, func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+					Expect(s.Status.LastFailure).ToNot(BeNil())
+					Expect(*s.Status.LastFailure.Message).To(HavePrefix("invalid schedule"))
+					Expect(s.Status.NextRunTime).To(BeNil())
+				})
+
+				retry, err := controller.updateVMSnapshotSchedule(schedule)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(retry).To(BeZero())
+				Expect(recorder.Events).To(Receive(ContainSubstring(vmSnapshotScheduleFailedEvent)))
+			})
+
+			It("should set last success time when the VirtualMachineSnapshots are ready", func() {
+				schedule := createSchedule()
+				schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+					LastRunTime: &metav1.Time{Time: runTime},
+					NextRunTime: &metav1.Time{Time: runTime.Add(time.Minute)},
+				}
+				vmSnapshotSource.Add(createScheduledVMSnapshot(runTime, true))
+				syncCaches(stop)
+
+				expectVMSnapshotScheduleUpdate(vmSnapshotClient, func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+					Expect(s.Status.LastSuccessTime.Time.Equal(runTime)).To(BeTrue())
+					Expect(s.Status.LastFailure).To(BeNil())
+				})
+
+				_, err := controller.updateVMSnapshotSchedule(schedule)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should set last failure when a VirtualMachineSnapshot failed", func() {
+				schedule := createSchedule()
+				schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+					LastRunTime: &metav1.Time{Time: runTime},
+					NextRunTime: &metav1.Time{Time: runTime.Add(time.Minute)},
+				}
+				vmSnapshot := createScheduledVMSnapshot(runTime, false)
+				vmSnapshot.Status.Error = &snapshotv1.Error{
+					Time:    timeFunc(),
+					Message: &[]string{"Snapshot cancelled"}[0],
+				}
+				vmSnapshotSource.Add(vmSnapshot)
+				syncCaches(stop)
+
+				expectVMSnapshotScheduleUpdate(vmSnapshotClient, func(s *snapshotv1.VirtualMachineSnapshotSchedule) {
+					Expect(s.Status.LastSuccessTime).To(BeNil())
+					Expect(*s.Status.LastFailure.Message).To(Equal(fmt.Sprintf("VirtualMachineSnapshot %s failed: Snapshot cancelled", vmSnapshot.Name)))
+				})
+
+				_, err := controller.updateVMSnapshotSchedule(schedule)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should delete VirtualMachineSnapshots not kept by the retention policy", func() {
+				schedule := createSchedule()
+				schedule.Spec.Retention = &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+					Last:    &[]int32{2}[0],
+					Expires: &metav1.Duration{Duration: 10 * time.Minute},
+				}
+				schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+					LastRunTime:     &metav1.Time{Time: runTime},
+					NextRunTime:     &metav1.Time{Time: runTime.Add(time.Minute)},
+					LastSuccessTime: &metav1.Time{Time: runTime},
+				}
+				vmSnapshotSource.Add(createScheduledVMSnapshot(runTime, true))
+				vmSnapshotSource.Add(createScheduledVMSnapshot(runTime.Add(-time.Minute), true))
+				tooMany := createScheduledVMSnapshot(runTime.Add(-2*time.Minute), true)
+				expired := createScheduledVMSnapshot(runTime.Add(-time.Hour), false)
+				vmSnapshotSource.Add(tooMany)
+				vmSnapshotSource.Add(expired)
+				syncCaches(stop)
+
+				deleted := expectVMSnapshotDeletes(vmSnapshotClient)
+
+				retry, err := controller.updateVMSnapshotSchedule(schedule)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(retry).To(BeNumerically("<=", time.Minute))
+				Expect(*deleted).To(ConsistOf(tooMany.Name, expired.Name))
+			})
+
+			It("should keep the latest VirtualMachineSnapshot of each day and week", func() {
+				day := time.Date(2021, 3, 10, 12, 0, 0, 0, time.UTC)
+				vmSnapshots := []*snapshotv1.VirtualMachineSnapshot{
+					createScheduledVMSnapshot(day, true),
+					createScheduledVMSnapshot(day.Add(-time.Hour), true),
+					createScheduledVMSnapshot(day.AddDate(0, 0, -1), true),
+					createScheduledVMSnapshot(day.AddDate(0, 0, -2), true),
+					createScheduledVMSnapshot(day.AddDate(0, 0, -7), true),
+					createScheduledVMSnapshot(day.AddDate(0, 0, -14), true),
+					createScheduledVMSnapshot(day.AddDate(0, 0, -3), false),
+				}
+				retention := &snapshotv1.VirtualMachineSnapshotScheduleRetention{
+					Daily:  &[]int32{2}[0],
+					Weekly: &[]int32{2}[0],
+				}
+
+				expectedKept := []*snapshotv1.VirtualMachineSnapshot{vmSnapshots[0], vmSnapshots[2], vmSnapshots[4], vmSnapshots[6]}
+				expectedDeleted := []*snapshotv1.VirtualMachineSnapshot{vmSnapshots[1], vmSnapshots[3], vmSnapshots[5]}
+
+				deleted, kept := retainedVMSnapshots(retention, vmSnapshots, day)
+				Expect(kept).To(ConsistOf(expectedKept))
+				Expect(deleted).To(ConsistOf(expectedDeleted))
+			})
+		})
+
 		Context("without VolumeSnapshot and VolumeSnapshotClass informers", func() {
 			BeforeEach(func() {
 				controller.dynamicInformerMap[volumeSnapshotCRD].informerFunc = func(kubecli.KubevirtClient, time.Duration) cache.SharedIndexInformer {
@@ -1411,6 +1819,41 @@ func expectVMSnapshotUpdate(client *kubevirtfake.Clientset, vmSnapshot *snapshot
 	})
 }
 
+func expectVMSnapshotCreate(client *kubevirtfake.Clientset, check func(*snapshotv1.VirtualMachineSnapshot)) {
+	client.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		create, ok := action.(testing.CreateAction)
+		Expect(ok).To(BeTrue())
+
+		check(create.GetObject().(*snapshotv1.VirtualMachineSnapshot))
+
+		return true, create.GetObject(), nil
+	})
+}
+
+func expectVMSnapshotDeletes(client *kubevirtfake.Clientset) *[]string {
+	var names []string
+	client.Fake.PrependReactor("delete", "virtualmachinesnapshots", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		delete, ok := action.(testing.DeleteAction)
+		Expect(ok).To(BeTrue())
+
+		names = append(names, delete.GetName())
+
+		return true, nil, nil
+	})
+	return &names
+}
+
+func expectVMSnapshotScheduleUpdate(client *kubevirtfake.Clientset, check func(*snapshotv1.VirtualMachineSnapshotSchedule)) {
+	client.Fake.PrependReactor("update", "virtualmachinesnapshotschedules", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+		update, ok := action.(testing.UpdateAction)
+		Expect(ok).To(BeTrue())
+
+		check(update.GetObject().(*snapshotv1.VirtualMachineSnapshotSchedule))
+
+		return true, update.GetObject(), nil
+	})
+}
+
 func expectVMSnapshotContentCreate(client *kubevirtfake.Clientset, content *snapshotv1.VirtualMachineSnapshotContent) {
 	client.Fake.PrependReactor("create", "virtualmachinesnapshotcontents", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
 		create, ok := action.(testing.CreateAction)
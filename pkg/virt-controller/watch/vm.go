@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -43,6 +44,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
 	cdiclone "kubevirt.io/containerized-data-importer/pkg/clone"
 	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/util/status"
 )
 
@@ -61,6 +63,7 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 	vmiVMInformer cache.SharedIndexInformer,
 	dataVolumeInformer cache.SharedIndexInformer,
 	pvcInformer cache.SharedIndexInformer,
+	instancetypeMethods instancetype.Methods,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient) *VMController {
 
@@ -72,6 +75,7 @@ func NewVMController(vmiInformer cache.SharedIndexInformer,
 		vmiVMInformer:          vmiVMInformer,
 		dataVolumeInformer:     dataVolumeInformer,
 		pvcInformer:            pvcInformer,
+		instancetypeMethods:    instancetypeMethods,
 		recorder:               recorder,
 		clientset:              clientset,
 		expectations:           controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -118,6 +122,7 @@ type VMController struct {
 	vmiVMInformer          cache.SharedIndexInformer
 	dataVolumeInformer     cache.SharedIndexInformer
 	pvcInformer            cache.SharedIndexInformer
+	instancetypeMethods    instancetype.Methods
 	recorder               record.EventRecorder
 	expectations           *controller.UIDTrackingControllerExpectations
 	dataVolumeExpectations *controller.UIDTrackingControllerExpectations
@@ -259,6 +264,17 @@ func (c *VMController) execute(key string) error {
 
 	var createErr error
 
+	// Record the referenced instancetype and preference before a VMI gets created from them,
+	// later changes to these objects must not affect the VM.
+	if (vm.Spec.Instancetype != nil || vm.Spec.Preference != nil) && vm.ObjectMeta.DeletionTimestamp == nil {
+		vm = vm.DeepCopy()
+		if err := c.instancetypeMethods.StoreControllerRevisions(vm); err != nil {
+			logger.Reason(err).Error("Failed to store the instancetype and preference of the VirtualMachine.")
+			c.recorder.Eventf(vm, k8score.EventTypeWarning, FailedCreateVirtualMachineReason, "Error storing the instancetype and preference: %v", err)
+			return err
+		}
+	}
+
 	// Scale up or down, if all expected creates and deletes were report by the listener
 	if c.needsSync(key) && vm.ObjectMeta.DeletionTimestamp == nil {
 
@@ -278,6 +294,10 @@ func (c *VMController) execute(key string) error {
 
 			createErr = c.handleVolumeRequests(vm, vmi)
 		}
+
+		if c.needsSync(key) && createErr == nil {
+			createErr = c.handleInterfaceRequests(vm, vmi)
+		}
 	}
 
 	// If the controller is going to be deleted and the orphan finalizer is the next one, release the VMIs. Don't update the status
@@ -576,6 +596,80 @@ func (c *VMController) handleVolumeRequests(vm *virtv1.VirtualMachine, vmi *virt
 			if err := c.clientset.VirtualMachineInstance(vmi.Namespace).RemoveVolume(vmi.Name, request.RemoveVolumeOptions); err != nil {
 				return err
 			}
+		} else if request.EjectCDRomOptions != nil {
+			if _, exists := vmiVolumeMap[request.EjectCDRomOptions.Name]; !exists {
+				continue
+			}
+
+			if err := c.clientset.VirtualMachineInstance(vmi.Namespace).EjectCDRom(vmi.Name, request.EjectCDRomOptions); err != nil {
+				return err
+			}
+		} else if request.InsertCDRomOptions != nil {
+			if _, exists := vmiVolumeMap[request.InsertCDRomOptions.Name]; exists {
+				continue
+			}
+
+			if err := c.clientset.VirtualMachineInstance(vmi.Namespace).InsertCDRom(vmi.Name, request.InsertCDRomOptions); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(vm, vmCopy) {
+		_, err := c.clientset.VirtualMachine(vmCopy.Namespace).Update(vmCopy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *VMController) handleInterfaceRequests(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if len(vm.Status.InterfaceRequests) == 0 {
+		return nil
+	}
+
+	vmCopy := vm.DeepCopy()
+	vmiNetworkMap := make(map[string]virtv1.Network)
+	vmiHotplugInterfaceMap := make(map[string]virtv1.HotplugInterfaceStatus)
+	if vmi != nil {
+		for _, network := range vmi.Spec.Networks {
+			vmiNetworkMap[network.Name] = network
+		}
+		for _, status := range vmi.Status.HotplugInterfaces {
+			vmiHotplugInterfaceMap[status.Name] = status
+		}
+	}
+
+	for i, request := range vm.Status.InterfaceRequests {
+		vmCopy.Spec.Template.Spec = *controller.ApplyInterfaceRequestOnVMISpec(&vmCopy.Spec.Template.Spec, &vm.Status.InterfaceRequests[i])
+
+		if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+			continue
+		}
+
+		if request.AddInterfaceOptions != nil {
+			if _, exists := vmiNetworkMap[request.AddInterfaceOptions.Name]; exists {
+				continue
+			}
+
+			if err := c.clientset.VirtualMachineInstance(vmi.Namespace).AddInterface(vmi.Name, request.AddInterfaceOptions); err != nil {
+				return err
+			}
+		} else if request.RemoveInterfaceOptions != nil {
+			if _, exists := vmiNetworkMap[request.RemoveInterfaceOptions.Name]; !exists {
+				continue
+			}
+			// Interfaces the VMI was started with can't be unplugged, the
+			// removal takes effect on the next restart of the VMI
+			if _, hotplugged := vmiHotplugInterfaceMap[request.RemoveInterfaceOptions.Name]; !hotplugged {
+				continue
+			}
+
+			if err := c.clientset.VirtualMachineInstance(vmi.Namespace).RemoveInterface(vmi.Name, request.RemoveInterfaceOptions); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -741,6 +835,13 @@ func (c *VMController) startVMI(vm *virtv1.VirtualMachine) error {
 	// start it
 	vmi := c.setupVMIFromVM(vm)
 
+	err = c.applyInstancetypeToVmi(vm, vmi)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to apply the instancetype and preference to the VirtualMachineInstance")
+		c.recorder.Eventf(vm, k8score.EventTypeWarning, FailedCreateVirtualMachineReason, "Error applying the instancetype and preference: %v", err)
+		return err
+	}
+
 	c.expectations.ExpectCreations(vmKey, 1)
 	vmi, err = c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Create(vmi)
 	if err != nil {
@@ -792,7 +893,7 @@ func (c *VMController) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.Virtual
 	vmi.ObjectMeta.Name = vm.ObjectMeta.Name
 	vmi.ObjectMeta.GenerateName = ""
 	vmi.ObjectMeta.Namespace = vm.ObjectMeta.Namespace
-	vmi.Spec = vm.Spec.Template.Spec
+	vmi.Spec = *vm.Spec.Template.Spec.DeepCopy()
 
 	setupStableFirmwareUUID(vm, vmi)
 
@@ -805,6 +906,27 @@ func (c *VMController) setupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.Virtual
 	return vmi
 }
 
+// applyInstancetypeToVmi expands the instancetype and preference referenced by the VM into the VMI.
+func (c *VMController) applyInstancetypeToVmi(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	instancetypeSpec, err := c.instancetypeMethods.FindInstancetypeSpec(vm)
+	if err != nil {
+		return err
+	}
+	preferenceSpec, err := c.instancetypeMethods.FindPreferenceSpec(vm)
+	if err != nil {
+		return err
+	}
+	if instancetypeSpec == nil && preferenceSpec == nil {
+		return nil
+	}
+
+	conflicts := c.instancetypeMethods.ApplyToVmi(k8sfield.NewPath("spec"), instancetypeSpec, preferenceSpec, &vmi.Spec)
+	if len(conflicts) > 0 {
+		return fmt.Errorf("VMI conflicts with instancetype spec in fields: [%s]", conflicts.String())
+	}
+	return nil
+}
+
 // no special meaning, randomly generated on my box.
 // TODO: do we want to use another constants? see examples in RFC4122
 const magicUUID = "6a1a24a1-4061-4607-8bf4-a3963d0c5895"
@@ -1263,6 +1385,7 @@ func (c *VMController) updateStatus(vmOrig *virtv1.VirtualMachine, vmi *virtv1.V
 
 			var added bool
 			var volName string
+			var cdrom bool
 
 			removeRequest := false
 
@@ -1272,6 +1395,14 @@ func (c *VMController) updateStatus(vmOrig *virtv1.VirtualMachine, vmi *virtv1.V
 			} else if request.RemoveVolumeOptions != nil {
 				volName = request.RemoveVolumeOptions.Name
 				added = false
+			} else if request.InsertCDRomOptions != nil {
+				volName = request.InsertCDRomOptions.Name
+				added = true
+				cdrom = true
+			} else if request.EjectCDRomOptions != nil {
+				volName = request.EjectCDRomOptions.Name
+				added = false
+				cdrom = true
 			}
 
 			_, volExists := volumeMap[volName]
@@ -1279,7 +1410,8 @@ func (c *VMController) updateStatus(vmOrig *virtv1.VirtualMachine, vmi *virtv1.V
 
 			if added && volExists && diskExists {
 				removeRequest = true
-			} else if !added && !volExists && !diskExists {
+			} else if !added && !volExists && (cdrom == diskExists) {
+				// The disk of a CD-ROM stays in the spec when its media is ejected
 				removeRequest = true
 			}
 
@@ -1290,6 +1422,49 @@ func (c *VMController) updateStatus(vmOrig *virtv1.VirtualMachine, vmi *virtv1.V
 		vm.Status.VolumeRequests = tmpVolRequests
 	}
 
+	if len(vm.Status.InterfaceRequests) > 0 {
+		networkMap := make(map[string]virtv1.Network)
+		interfaceMap := make(map[string]virtv1.Interface)
+
+		for _, network := range vm.Spec.Template.Spec.Networks {
+			networkMap[network.Name] = network
+		}
+		for _, iface := range vm.Spec.Template.Spec.Domain.Devices.Interfaces {
+			interfaceMap[iface.Name] = iface
+		}
+
+		tmpInterfaceRequests := vm.Status.InterfaceRequests[:0]
+		for _, request := range vm.Status.InterfaceRequests {
+
+			var added bool
+			var ifaceName string
+
+			removeRequest := false
+
+			if request.AddInterfaceOptions != nil {
+				ifaceName = request.AddInterfaceOptions.Name
+				added = true
+			} else if request.RemoveInterfaceOptions != nil {
+				ifaceName = request.RemoveInterfaceOptions.Name
+				added = false
+			}
+
+			_, networkExists := networkMap[ifaceName]
+			_, ifaceExists := interfaceMap[ifaceName]
+
+			if added && networkExists && ifaceExists {
+				removeRequest = true
+			} else if !added && !networkExists && !ifaceExists {
+				removeRequest = true
+			}
+
+			if !removeRequest {
+				tmpInterfaceRequests = append(tmpInterfaceRequests, request)
+			}
+		}
+		vm.Status.InterfaceRequests = tmpInterfaceRequests
+	}
+
 	if vmRenamedAndDeleted {
 		return nil
 	}
@@ -1,6 +1,8 @@
 package watch
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/go-openapi/errors"
@@ -9,10 +11,13 @@ import (
 	"github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 	"github.com/pborman/uuid"
+	appsv1 "k8s.io/api/apps/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	framework "k8s.io/client-go/tools/cache/testing"
@@ -20,10 +25,12 @@ import (
 
 	v1 "kubevirt.io/client-go/api/v1"
 	virtv1 "kubevirt.io/client-go/api/v1"
+	instancetypev1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
 	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer/pkg/apis/core/v1alpha1"
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -48,6 +55,9 @@ var _ = Describe("VirtualMachine", func() {
 		var vmiFeeder *testutils.VirtualMachineFeeder
 		var dataVolumeFeeder *testutils.DataVolumeFeeder
 		var cdiClient *cdifake.Clientset
+		var kubeClient *fake.Clientset
+		var clusterInstancetypeStore cache.Store
+		var clusterPreferenceStore cache.Store
 
 		syncCaches := func(stop chan struct{}) {
 			go vmiInformer.Run(stop)
@@ -69,7 +79,11 @@ var _ = Describe("VirtualMachine", func() {
 			pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
 			recorder = record.NewFakeRecorder(100)
 
-			controller = NewVMController(vmiInformer, vmInformer, dataVolumeInformer, pvcInformer, recorder, virtClient)
+			clusterInstancetypeStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+			clusterPreferenceStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+			instancetypeMethods := instancetype.NewMethods(nil, clusterInstancetypeStore, nil, clusterPreferenceStore, virtClient)
+
+			controller = NewVMController(vmiInformer, vmInformer, dataVolumeInformer, pvcInformer, instancetypeMethods, recorder, virtClient)
 			// Wrap our workqueue to have a way to detect when we are done processing updates
 			mockQueue = testutils.NewMockWorkQueue(controller.Queue)
 			controller.Queue = mockQueue
@@ -81,6 +95,9 @@ var _ = Describe("VirtualMachine", func() {
 			virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
 			virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
 
+			kubeClient = fake.NewSimpleClientset()
+			virtClient.EXPECT().AppsV1().Return(kubeClient.AppsV1()).AnyTimes()
+
 			cdiClient = cdifake.NewSimpleClientset()
 			virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 			cdiClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -328,6 +345,266 @@ var _ = Describe("VirtualMachine", func() {
 			table.Entry("that is not running", false),
 		)
 
+		table.DescribeTable("should eject the media of a CD-ROM of a vm", func(isRunning bool) {
+			vm, vmi := DefaultVirtualMachine(isRunning)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.VolumeRequests = []v1.VirtualMachineVolumeRequest{
+				{
+					EjectCDRomOptions: &v1.EjectCDRomOptions{
+						Name: "cdrom",
+					},
+				},
+			}
+			vm.Spec.Template.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "cdrom",
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{},
+				},
+			})
+			vm.Spec.Template.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "cdrom",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "iso",
+					},
+				},
+			})
+
+			addVirtualMachine(vm)
+
+			if isRunning {
+				vmi.Spec.Volumes = vm.Spec.Template.Spec.Volumes
+				vmi.Spec.Domain.Devices.Disks = vm.Spec.Template.Spec.Domain.Devices.Disks
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+				vmiInterface.EXPECT().EjectCDRom(vmi.ObjectMeta.Name, vm.Status.VolumeRequests[0].EjectCDRomOptions)
+			}
+
+			vmInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				spec := arg.(*v1.VirtualMachine).Spec.Template.Spec
+				Expect(spec.Volumes).To(BeEmpty())
+				Expect(spec.Domain.Devices.Disks).To(HaveLen(1))
+			}).Return(nil, nil)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Status.VolumeRequests).To(HaveLen(1))
+			}).Return(nil, nil)
+
+			controller.Execute()
+		},
+
+			table.Entry("that is running", true),
+			table.Entry("that is not running", false),
+		)
+
+		table.DescribeTable("should insert a media into a CD-ROM of a vm", func(isRunning bool) {
+			vm, vmi := DefaultVirtualMachine(isRunning)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.VolumeRequests = []v1.VirtualMachineVolumeRequest{
+				{
+					InsertCDRomOptions: &v1.InsertCDRomOptions{
+						Name: "cdrom",
+						VolumeSource: &v1.HotplugVolumeSource{
+							DataVolume: &v1.DataVolumeSource{
+								Name: "iso",
+							},
+						},
+					},
+				},
+			}
+			vm.Spec.Template.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "cdrom",
+				DiskDevice: v1.DiskDevice{
+					CDRom: &v1.CDRomTarget{},
+				},
+			})
+
+			addVirtualMachine(vm)
+
+			if isRunning {
+				vmi.Spec.Domain.Devices.Disks = vm.Spec.Template.Spec.Domain.Devices.Disks
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+				vmiInterface.EXPECT().InsertCDRom(vmi.ObjectMeta.Name, vm.Status.VolumeRequests[0].InsertCDRomOptions)
+			}
+
+			vmInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				spec := arg.(*v1.VirtualMachine).Spec.Template.Spec
+				Expect(spec.Volumes).To(HaveLen(1))
+				Expect(spec.Volumes[0].Name).To(Equal("cdrom"))
+				Expect(spec.Volumes[0].DataVolume.Name).To(Equal("iso"))
+			}).Return(nil, nil)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Status.VolumeRequests).To(HaveLen(1))
+			}).Return(nil, nil)
+
+			controller.Execute()
+		},
+
+			table.Entry("that is running", true),
+			table.Entry("that is not running", false),
+		)
+
+		It("should clear VolumeRequests for ejected CD-ROM media that are satisfied", func() {
+			vm, _ := DefaultVirtualMachine(false)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.VolumeRequests = []v1.VirtualMachineVolumeRequest{
+				{
+					EjectCDRomOptions: &v1.EjectCDRomOptions{
+						Name: "cdrom",
+					},
+				},
+			}
+			vm.Spec.Template.Spec.Volumes = []v1.Volume{}
+			vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{
+				{
+					Name: "cdrom",
+					DiskDevice: v1.DiskDevice{
+						CDRom: &v1.CDRomTarget{},
+					},
+				},
+			}
+
+			addVirtualMachine(vm)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Status.VolumeRequests).To(BeEmpty())
+			}).Return(nil, nil)
+
+			controller.Execute()
+		})
+
+		table.DescribeTable("should hotplug an interface to a vm", func(isRunning bool) {
+
+			vm, vmi := DefaultVirtualMachine(isRunning)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.InterfaceRequests = []v1.VirtualMachineInterfaceRequest{
+				{
+					AddInterfaceOptions: &v1.AddInterfaceOptions{
+						Name:                            "blue",
+						NetworkAttachmentDefinitionName: "blue-nad",
+					},
+				},
+			}
+
+			addVirtualMachine(vm)
+
+			if isRunning {
+				markAsReady(vmi)
+				vmiFeeder.Add(vmi)
+				vmiInterface.EXPECT().AddInterface(vmi.ObjectMeta.Name, vm.Status.InterfaceRequests[0].AddInterfaceOptions)
+			}
+
+			vmInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				spec := arg.(*v1.VirtualMachine).Spec.Template.Spec
+				Expect(spec.Networks[0].Name).To(Equal("blue"))
+				Expect(spec.Networks[0].Multus.NetworkName).To(Equal("blue-nad"))
+				Expect(spec.Domain.Devices.Interfaces[0].Name).To(Equal("blue"))
+				Expect(spec.Domain.Devices.Interfaces[0].Bridge).ToNot(BeNil())
+			}).Return(nil, nil)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				// interface request shouldn't be cleared until update status observes the new interface
+				Expect(arg.(*v1.VirtualMachine).Status.InterfaceRequests).To(HaveLen(1))
+			}).Return(nil, nil)
+
+			controller.Execute()
+		},
+
+			table.Entry("that is running", true),
+			table.Entry("that is not running", false),
+		)
+
+		table.DescribeTable("should unplug an interface from a vm", func(isHotplugged bool) {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.InterfaceRequests = []v1.VirtualMachineInterfaceRequest{
+				{
+					RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{
+						Name: "blue",
+					},
+				},
+			}
+			vm.Spec.Template.Spec.Networks = []v1.Network{
+				{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-nad"}}},
+			}
+			vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			}
+
+			addVirtualMachine(vm)
+
+			vmi.Spec.Networks = vm.Spec.Template.Spec.Networks
+			vmi.Spec.Domain.Devices.Interfaces = vm.Spec.Template.Spec.Domain.Devices.Interfaces
+			if isHotplugged {
+				vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+					{Name: "blue", PodInterfaceName: "net1", Phase: v1.InterfaceHotplugReady},
+				}
+				vmiInterface.EXPECT().RemoveInterface(vmi.ObjectMeta.Name, vm.Status.InterfaceRequests[0].RemoveInterfaceOptions)
+			}
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Spec.Template.Spec.Networks).To(BeEmpty())
+				Expect(arg.(*v1.VirtualMachine).Spec.Template.Spec.Domain.Devices.Interfaces).To(BeEmpty())
+			}).Return(nil, nil)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Status.InterfaceRequests).To(HaveLen(1))
+			}).Return(nil, nil)
+
+			controller.Execute()
+		},
+
+			table.Entry("that was hotplugged", true),
+			table.Entry("that the vmi was started with, only on the template", false),
+		)
+
+		table.DescribeTable("should clear InterfaceRequests that are satisfied", func(request v1.VirtualMachineInterfaceRequest, withInterface bool) {
+			vm, vmi := DefaultVirtualMachine(true)
+			vm.Status.Created = true
+			vm.Status.Ready = true
+			vm.Status.InterfaceRequests = []v1.VirtualMachineInterfaceRequest{request}
+			if withInterface {
+				vm.Spec.Template.Spec.Networks = []v1.Network{
+					{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-nad"}}},
+				}
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{
+					{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+				}
+			} else {
+				vm.Spec.Template.Spec.Networks = []v1.Network{}
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{}
+			}
+
+			addVirtualMachine(vm)
+
+			vmi.Spec.Networks = vm.Spec.Template.Spec.Networks
+			vmi.Spec.Domain.Devices.Interfaces = vm.Spec.Template.Spec.Domain.Devices.Interfaces
+			markAsReady(vmi)
+			vmiFeeder.Add(vmi)
+
+			vmInterface.EXPECT().UpdateStatus(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachine).Status.InterfaceRequests).To(BeEmpty())
+			}).Return(nil, nil)
+
+			controller.Execute()
+		},
+			table.Entry("for added interfaces", v1.VirtualMachineInterfaceRequest{
+				AddInterfaceOptions: &v1.AddInterfaceOptions{Name: "blue", NetworkAttachmentDefinitionName: "blue-nad"},
+			}, true),
+			table.Entry("for removed interfaces", v1.VirtualMachineInterfaceRequest{
+				RemoveInterfaceOptions: &v1.RemoveInterfaceOptions{Name: "blue"},
+			}, false),
+		)
+
 		It("should not delete failed DataVolume for VirtualMachineInstance", func() {
 			vm, _ := DefaultVirtualMachine(true)
 			vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
@@ -1178,6 +1455,142 @@ var _ = Describe("VirtualMachine", func() {
 				})
 			})
 		})
+
+		Context("with instancetypes and preferences", func() {
+
+			var clusterInstancetype *instancetypev1.VirtualMachineClusterInstancetype
+			var clusterPreference *instancetypev1.VirtualMachineClusterPreference
+
+			BeforeEach(func() {
+				clusterInstancetype = &instancetypev1.VirtualMachineClusterInstancetype{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "instancetype",
+						UID:        "instancetype-uid",
+						Generation: 1,
+					},
+					Spec: instancetypev1.VirtualMachineInstancetypeSpec{
+						CPU: instancetypev1.CPUInstancetype{
+							Guest: 2,
+						},
+						Memory: instancetypev1.MemoryInstancetype{
+							Guest: resource.MustParse("128Mi"),
+						},
+					},
+				}
+				Expect(clusterInstancetypeStore.Add(clusterInstancetype)).To(Succeed())
+
+				clusterPreference = &instancetypev1.VirtualMachineClusterPreference{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "preference",
+						UID:        "preference-uid",
+						Generation: 1,
+					},
+					Spec: instancetypev1.VirtualMachinePreferenceSpec{
+						CPU: &instancetypev1.CPUPreferences{
+							PreferredCPUTopology: instancetypev1.PreferCores,
+						},
+					},
+				}
+				Expect(clusterPreferenceStore.Add(clusterPreference)).To(Succeed())
+			})
+
+			instancetypeVirtualMachine := func() (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+				vm, vmi := DefaultVirtualMachine(true)
+				vm.Spec.Template.Spec.Domain.Resources = v1.ResourceRequirements{}
+				vm.Spec.Instancetype = &v1.InstancetypeMatcher{Name: clusterInstancetype.Name}
+				vm.Spec.Preference = &v1.PreferenceMatcher{Name: clusterPreference.Name}
+				return vm, vmi
+			}
+
+			It("should store ControllerRevisions and apply the instancetype and preference to the VirtualMachineInstance", func() {
+				vm, vmi := instancetypeVirtualMachine()
+				addVirtualMachine(vm)
+
+				instancetypeRevisionName := instancetype.GetRevisionName(vm.Name, clusterInstancetype.Name, clusterInstancetype.UID, clusterInstancetype.Generation)
+				preferenceRevisionName := instancetype.GetRevisionName(vm.Name, clusterPreference.Name, clusterPreference.UID, clusterPreference.Generation)
+
+				vmInterface.EXPECT().Patch(vm.Name, types.JSONPatchType, gomock.Any()).DoAndReturn(func(_ string, _ types.PatchType, data []byte, _ ...string) (*v1.VirtualMachine, error) {
+					Expect(string(data)).To(ContainSubstring(instancetypeRevisionName))
+					Expect(string(data)).To(ContainSubstring(preferenceRevisionName))
+					return vm, nil
+				})
+
+				vmiInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(arg *v1.VirtualMachineInstance) (*v1.VirtualMachineInstance, error) {
+					Expect(*arg.Spec.Domain.CPU).To(Equal(v1.CPU{Sockets: 1, Cores: 2, Threads: 1}))
+					Expect(arg.Spec.Domain.Memory.Guest.String()).To(Equal("128Mi"))
+					return vmi, nil
+				})
+
+				vmInterface.EXPECT().UpdateStatus(gomock.Any()).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+
+				_, err := kubeClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), instancetypeRevisionName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				_, err = kubeClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), preferenceRevisionName, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				// The template in the cache must not be modified
+				Expect(vm.Spec.Template.Spec.Domain.CPU).To(BeNil())
+			})
+
+			It("should use the stored ControllerRevision even if the instancetype changed", func() {
+				vm, vmi := instancetypeVirtualMachine()
+				vm.Spec.Preference = nil
+
+				data, err := json.Marshal(clusterInstancetype)
+				Expect(err).ToNot(HaveOccurred())
+				revision := &appsv1.ControllerRevision{
+					ObjectMeta: metav1.ObjectMeta{Name: "instancetype-revision", Namespace: vm.Namespace},
+					Data:       runtime.RawExtension{Raw: data},
+				}
+				_, err = kubeClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), revision, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				vm.Spec.Instancetype.RevisionName = revision.Name
+
+				updatedInstancetype := clusterInstancetype.DeepCopy()
+				updatedInstancetype.Spec.CPU.Guest = 8
+				Expect(clusterInstancetypeStore.Update(updatedInstancetype)).To(Succeed())
+
+				addVirtualMachine(vm)
+
+				vmiInterface.EXPECT().Create(gomock.Any()).DoAndReturn(func(arg *v1.VirtualMachineInstance) (*v1.VirtualMachineInstance, error) {
+					Expect(arg.Spec.Domain.CPU.Sockets).To(Equal(uint32(2)))
+					return vmi, nil
+				})
+
+				vmInterface.EXPECT().UpdateStatus(gomock.Any()).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			})
+
+			It("should not create the VirtualMachineInstance when the template conflicts with the instancetype", func() {
+				vm, _ := instancetypeVirtualMachine()
+				vm.Spec.Preference = nil
+				vm.Spec.Instancetype.RevisionName = "instancetype-revision"
+				vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 1}
+
+				data, err := json.Marshal(clusterInstancetype)
+				Expect(err).ToNot(HaveOccurred())
+				revision := &appsv1.ControllerRevision{
+					ObjectMeta: metav1.ObjectMeta{Name: "instancetype-revision", Namespace: vm.Namespace},
+					Data:       runtime.RawExtension{Raw: data},
+				}
+				_, err = kubeClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), revision, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+
+				addVirtualMachine(vm)
+
+				vmInterface.EXPECT().UpdateStatus(gomock.Any()).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, FailedCreateVirtualMachineReason)
+			})
+		})
 	})
 })
 
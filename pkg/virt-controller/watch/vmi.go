@@ -25,10 +25,12 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -108,6 +110,19 @@ const (
 	PVCNotReadyReason = "PVCNotReady"
 	// FailedHotplugSyncReason is set when a hotplug specific failure occurs during sync
 	FailedHotplugSyncReason = "FailedHotplugSync"
+	// NetworkNotAttachedReason is set when the network of a hotplugged interface is not yet attached to the pod.
+	NetworkNotAttachedReason = "NetworkNotAttached"
+	// NetworkAttachedReason is set when the network of a hotplugged interface is attached to the pod.
+	NetworkAttachedReason = "NetworkAttached"
+	// SuccessfulPodNetworksUpdateReason is added in an event when the networks of the pod are updated
+	// for a hotplugged or unplugged interface.
+	SuccessfulPodNetworksUpdateReason = "SuccessfulPodNetworksUpdate"
+	// FailedVolumeMigrationReason is added in an event when the destination claims of a
+	// volume migration can't be used, or the claims of the VMI can't be updated after it.
+	FailedVolumeMigrationReason = "FailedVolumeMigration"
+	// SuccessfulVolumeMigrationReason is added in an event when the VMI and its VM use the
+	// destination claims of a completed volume migration.
+	SuccessfulVolumeMigrationReason = "SuccessfulVolumeMigration"
 )
 
 const failedToRenderLaunchManifestErrFormat = "failed to render launch manifest: %v"
@@ -150,6 +165,10 @@ func NewVMIController(templateService services.TemplateService,
 		UpdateFunc: c.updateDataVolume,
 	})
 
+	c.pvcInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updatePVC,
+	})
+
 	return c
 }
 
@@ -189,7 +208,7 @@ func (c *VMIController) Run(threadiness int, stopCh <-chan struct{}) {
 	log.Log.Info("Starting vmi controller.")
 
 	// Wait for cache sync before we start the pod controller
-	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.podInformer.HasSynced, c.dataVolumeInformer.HasSynced)
+	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.podInformer.HasSynced, c.dataVolumeInformer.HasSynced, c.pvcInformer.HasSynced)
 
 	// Start the actual work
 	for i := 0; i < threadiness; i++ {
@@ -493,6 +512,9 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 		patchOps := []string{}
 		if vmiPodExists {
 			c.updateVolumeStatus(vmiCopy, pod)
+			if err := c.updateHotplugInterfaceStatus(vmiCopy, pod); err != nil {
+				return err
+			}
 		}
 		if !reflect.DeepEqual(vmiCopy.Status.VolumeStatus, vmi.Status.VolumeStatus) {
 			// VolumeStatus changed which means either removed or added volumes.
@@ -512,6 +534,23 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 			}
 			log.Log.V(3).Object(vmi).Infof("Patching Volume Status")
 		}
+		if !reflect.DeepEqual(vmiCopy.Status.HotplugInterfaces, vmi.Status.HotplugInterfaces) {
+			newInterfaceStatus, err := json.Marshal(vmiCopy.Status.HotplugInterfaces)
+			if err != nil {
+				return err
+			}
+			oldInterfaceStatus, err := json.Marshal(vmi.Status.HotplugInterfaces)
+			if err != nil {
+				return err
+			}
+			if string(oldInterfaceStatus) == "null" {
+				patchOps = append(patchOps, fmt.Sprintf(`{ "op": "add", "path": "/status/hotplugInterfaces", "value": %s }`, string(newInterfaceStatus)))
+			} else {
+				patchOps = append(patchOps, fmt.Sprintf(`{ "op": "test", "path": "/status/hotplugInterfaces", "value": %s }`, string(oldInterfaceStatus)))
+				patchOps = append(patchOps, fmt.Sprintf(`{ "op": "replace", "path": "/status/hotplugInterfaces", "value": %s }`, string(newInterfaceStatus)))
+			}
+			log.Log.V(3).Object(vmi).Infof("Patching Hotplug Interface Status")
+		}
 		// We don't own the object anymore, so patch instead of update
 		if !conditionsEqual(vmiCopy.Status.Conditions, vmi.Status.Conditions) {
 
@@ -768,6 +807,12 @@ func (c *VMIController) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod,
 				}
 			}
 		}
+
+		if pod.DeletionTimestamp == nil && vmi.IsRunning() {
+			if err := c.handleHotplugInterfaces(vmi, pod); err != nil {
+				return &syncErrorImpl{fmt.Errorf("failed to update pod networks: %v", err), FailedHotplugSyncReason}
+			}
+		}
 	}
 	return nil
 }
@@ -1051,6 +1096,49 @@ func (c *VMIController) resolveControllerRef(namespace string, controllerRef *v1
 	return vmi.(*virtv1.VirtualMachineInstance)
 }
 
+// When the capacity of a claim changes, enqueue the vmis which use it to record the new capacity.
+func (c *VMIController) updatePVC(old, cur interface{}) {
+	curPVC := cur.(*k8sv1.PersistentVolumeClaim)
+	oldPVC := old.(*k8sv1.PersistentVolumeClaim)
+	if curPVC.ResourceVersion == oldPVC.ResourceVersion {
+		// Periodic resync will send update events for all known PVCs.
+		return
+	}
+	if equality.Semantic.DeepEqual(curPVC.Status.Capacity, oldPVC.Status.Capacity) {
+		return
+	}
+
+	vmis, err := c.listVMIsMatchingClaim(curPVC.Namespace, curPVC.Name)
+	if err != nil {
+		log.Log.V(4).Object(curPVC).Errorf("Error encountered during pvc update: %v", err)
+		return
+	}
+	for _, vmi := range vmis {
+		log.Log.V(4).Object(curPVC).Infof("PVC capacity changed for vmi %s", vmi.Name)
+		c.enqueueVirtualMachine(vmi)
+	}
+}
+
+// takes a namespace and returns all VMIs from the vmi cache which use the claim
+func (c *VMIController) listVMIsMatchingClaim(namespace string, claimName string) ([]*virtv1.VirtualMachineInstance, error) {
+	objs, err := c.vmiInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+	vmis := []*virtv1.VirtualMachineInstance{}
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		for _, volume := range vmi.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == claimName ||
+				volume.DataVolume != nil && volume.DataVolume.Name == claimName {
+				vmis = append(vmis, vmi)
+				break
+			}
+		}
+	}
+	return vmis, nil
+}
+
 // takes a namespace and returns all Pods from the pod cache which run in this namespace
 func (c *VMIController) listVMIsMatchingDataVolume(namespace string, dataVolumeName string) ([]*virtv1.VirtualMachineInstance, error) {
 	objs, err := c.vmiInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
@@ -1214,13 +1302,29 @@ func (c *VMIController) getHotplugVolumes(vmi *virtv1.VirtualMachineInstance, vi
 		podVolumeMap[podVolume.Name] = podVolume
 	}
 	for _, vmiVolume := range vmiVolumes {
-		if _, ok := podVolumeMap[vmiVolume.Name]; !ok && (vmiVolume.DataVolume != nil || vmiVolume.PersistentVolumeClaim != nil) {
+		if vmiVolume.DataVolume == nil && vmiVolume.PersistentVolumeClaim == nil {
+			continue
+		}
+		// A media inserted into a CD-ROM can reuse the name of a volume of the
+		// launcher pod, it is only part of the pod if it refers to the same claim
+		if podVolume, ok := podVolumeMap[vmiVolume.Name]; !ok || !podVolumeHasClaim(podVolume, vmiVolumeClaimName(vmiVolume)) {
 			hotplugVolumes = append(hotplugVolumes, vmiVolume.DeepCopy())
 		}
 	}
 	return hotplugVolumes
 }
 
+func vmiVolumeClaimName(volume virtv1.Volume) string {
+	if volume.PersistentVolumeClaim != nil {
+		return volume.PersistentVolumeClaim.ClaimName
+	}
+	return volume.DataVolume.Name
+}
+
+func podVolumeHasClaim(volume k8sv1.Volume, claimName string) bool {
+	return volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName == claimName
+}
+
 func (c *VMIController) cleanupWaitForFirstConsumerTemporaryPods(vmi *virtv1.VirtualMachineInstance) error {
 	// Get all pods from the namespace
 	pods, err := c.listPodsFromNamespace(vmi.Namespace)
@@ -1641,6 +1745,9 @@ func (c *VMIController) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, v
 				}
 			}
 		}
+		if err := c.updatePersistentVolumeClaimInfo(vmi.Namespace, &status, &vmi.Spec.Volumes[i]); err != nil {
+			return err
+		}
 		newStatus = append(newStatus, status)
 	}
 
@@ -1667,6 +1774,34 @@ func (c *VMIController) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, v
 	return nil
 }
 
+// updatePersistentVolumeClaimInfo records the capacity and the volume mode of the claim backing the volume.
+// virt-handler resizes the disk of the volume when the recorded capacity grows.
+func (c *VMIController) updatePersistentVolumeClaimInfo(namespace string, status *virtv1.VolumeStatus, volume *virtv1.Volume) error {
+	var claimName string
+	if volume.DataVolume != nil {
+		// Using fact that PVC name = DV name.
+		claimName = volume.DataVolume.Name
+	} else if volume.PersistentVolumeClaim != nil {
+		claimName = volume.PersistentVolumeClaim.ClaimName
+	} else {
+		return nil
+	}
+
+	pvc, exists, _, err := kubevirttypes.IsPVCBlockFromStore(c.pvcInformer.GetStore(), namespace, claimName)
+	if err != nil {
+		return err
+	}
+	// A claim which is not bound yet has no capacity, keep what was recorded
+	if !exists || len(pvc.Status.Capacity) == 0 {
+		return nil
+	}
+	status.PersistentVolumeClaimInfo = &virtv1.PersistentVolumeClaimInfo{
+		Capacity:   pvc.Status.Capacity.DeepCopy(),
+		VolumeMode: pvc.Spec.VolumeMode,
+	}
+	return nil
+}
+
 func (c *VMIController) canMoveToAttachedPhase(currentPhase virtv1.VolumePhase) bool {
 	return currentPhase == "" || currentPhase == virtv1.VolumeBound || currentPhase == virtv1.VolumePending ||
 		currentPhase == virtv1.HotplugVolumeAttachedToNode
@@ -1704,3 +1839,150 @@ func (c *VMIController) getVolumePhaseMessageReason(volume *virtv1.Volume, names
 	}
 	return virtv1.VolumePending, PVCNotReadyReason, "PVC is in phase Lost"
 }
+
+func getPodInterfaceIndex(podInterfaceName string) int {
+	index, err := strconv.Atoi(strings.TrimPrefix(podInterfaceName, "net"))
+	if err != nil {
+		return 0
+	}
+	return index
+}
+
+// updateHotplugInterfaceStatus tracks the secondary networks added to or removed from a running VMI.
+// Networks requested by the pod networks annotation which are not claimed by a hotplug interface
+// status were attached when the pod was created, so only the multus networks beyond those are new.
+func (c *VMIController) updateHotplugInterfaceStatus(vmi *virtv1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod) error {
+	requestedInterfaces, err := services.MultusAnnotationPodInterfaceNames(virtlauncherPod.Annotations[services.MultusNetworksAnnotation])
+	if err != nil {
+		return err
+	}
+	attachedInterfaces, err := services.MultusNetworkStatusPodInterfaceNames(virtlauncherPod.Annotations[services.MultusNetworkStatusAnnotation])
+	if err != nil {
+		return err
+	}
+
+	statusMap := map[string]virtv1.HotplugInterfaceStatus{}
+	claimedInterfaces := map[string]bool{}
+	maxIndex := 0
+	for _, status := range vmi.Status.HotplugInterfaces {
+		statusMap[status.Name] = status
+		claimedInterfaces[status.PodInterfaceName] = true
+		if index := getPodInterfaceIndex(status.PodInterfaceName); index > maxIndex {
+			maxIndex = index
+		}
+	}
+	bootTimeNetworks := 0
+	for podInterfaceName := range requestedInterfaces {
+		if !claimedInterfaces[podInterfaceName] {
+			bootTimeNetworks++
+		}
+		if index := getPodInterfaceIndex(podInterfaceName); index > maxIndex {
+			maxIndex = index
+		}
+	}
+	// Networks without an explicit interface name, e.g. those of binding plugins, are named by multus
+	for podInterfaceName := range attachedInterfaces {
+		if index := getPodInterfaceIndex(podInterfaceName); index > maxIndex {
+			maxIndex = index
+		}
+	}
+
+	newStatus := []virtv1.HotplugInterfaceStatus{}
+	specNetworks := map[string]bool{}
+	unclaimedNetworks := 0
+	for _, network := range vmi.Spec.Networks {
+		specNetworks[network.Name] = true
+		if network.Multus == nil || network.Multus.Default {
+			continue
+		}
+		status, exists := statusMap[network.Name]
+		if !exists {
+			unclaimedNetworks++
+			if unclaimedNetworks <= bootTimeNetworks {
+				continue
+			}
+			maxIndex++
+			status = virtv1.HotplugInterfaceStatus{
+				Name:             network.Name,
+				PodInterfaceName: fmt.Sprintf("net%d", maxIndex),
+				Phase:            virtv1.InterfaceHotplugPending,
+				Reason:           NetworkNotAttachedReason,
+				Message:          "Waiting for the network to be attached to the virt-launcher pod",
+			}
+		}
+		if status.Phase == virtv1.InterfaceHotplugPending && attachedInterfaces[status.PodInterfaceName] {
+			status.Phase = virtv1.InterfaceHotplugAttachedToPod
+			status.Reason = NetworkAttachedReason
+			status.Message = "Network attached to the virt-launcher pod"
+		}
+		newStatus = append(newStatus, status)
+	}
+
+	for _, status := range vmi.Status.HotplugInterfaces {
+		if specNetworks[status.Name] {
+			continue
+		}
+		// Keep the status of an unplugged interface until the network is gone from the pod
+		if requestedInterfaces[status.PodInterfaceName] || attachedInterfaces[status.PodInterfaceName] {
+			newStatus = append(newStatus, status)
+		}
+	}
+
+	if len(newStatus) == 0 && len(vmi.Status.HotplugInterfaces) == 0 {
+		return nil
+	}
+	vmi.Status.HotplugInterfaces = newStatus
+	return nil
+}
+
+// handleHotplugInterfaces adds the networks of pending hotplug interfaces to the pod networks
+// annotation, and removes the networks of interfaces which are detached from the domain.
+func (c *VMIController) handleHotplugInterfaces(vmi *virtv1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod) error {
+	if len(vmi.Status.HotplugInterfaces) == 0 {
+		return nil
+	}
+	annotation := virtlauncherPod.Annotations[services.MultusNetworksAnnotation]
+	requestedInterfaces, err := services.MultusAnnotationPodInterfaceNames(annotation)
+	if err != nil {
+		return err
+	}
+	networks := map[string]virtv1.Network{}
+	for _, network := range vmi.Spec.Networks {
+		networks[network.Name] = network
+	}
+
+	newAnnotation := annotation
+	for _, status := range vmi.Status.HotplugInterfaces {
+		network, inSpec := networks[status.Name]
+		if inSpec && status.Phase == virtv1.InterfaceHotplugPending && !requestedInterfaces[status.PodInterfaceName] {
+			newAnnotation, err = services.AddMultusNetworkToAnnotation(vmi, newAnnotation, network, status.PodInterfaceName)
+		} else if !inSpec && status.Phase != virtv1.InterfaceHotplugReady && requestedInterfaces[status.PodInterfaceName] {
+			newAnnotation, err = services.RemoveMultusNetworkFromAnnotation(newAnnotation, status.PodInterfaceName)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if newAnnotation == annotation {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				services.MultusNetworksAnnotation: newAnnotation,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	log.Log.V(3).Object(vmi).Infof("Patching networks of pod %s", virtlauncherPod.Name)
+	_, err = c.clientset.CoreV1().Pods(virtlauncherPod.Namespace).Patch(context.Background(), virtlauncherPod.Name, types.MergePatchType, patch, v1.PatchOptions{})
+	if err != nil {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedHotplugSyncReason, "Error updating networks of pod %s: %v", virtlauncherPod.Name, err)
+		return err
+	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulPodNetworksUpdateReason, "Updated networks of pod %s", virtlauncherPod.Name)
+	return nil
+}
@@ -2026,6 +2026,11 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			table.Entry("should return a volume if vmi has one more than virtlauncher", makeK8sVolumes(), makeVolumes(1), 1),
 			table.Entry("should return a volume if vmi has one more than virtlauncher, with matching volumes", makeK8sVolumes(1, 3), makeVolumes(1, 2, 3), 2),
 			table.Entry("should return multiple volumes if vmi has multiple more than virtlauncher, with matching volumes", makeK8sVolumes(1, 3), makeVolumes(1, 2, 3, 4, 5), 2, 4, 5),
+			table.Entry("should return a volume if vmi refers to another claim than the virtlauncher volume of the same name", makeK8sVolumes(1, 2), func() []*v1.Volume {
+				volumes := makeVolumes(1, 2)
+				volumes[1].PersistentVolumeClaim.ClaimName = "claim-iso"
+				return volumes
+			}(), 2),
 		)
 
 		truncateSprintf := func(str string, args ...interface{}) string {
@@ -2293,6 +2298,207 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			Expect(vmi.Status.Phase).To(Equal(v1.Running))
 		})
 	})
+
+	Context("expanded claims", func() {
+		newClaim := func(resourceVersion, capacity string) *k8sv1.PersistentVolumeClaim {
+			mode := k8sv1.PersistentVolumeFilesystem
+			pvc := NewHotplugPVC("claim0", k8sv1.NamespaceDefault, k8sv1.ClaimBound)
+			pvc.Spec.VolumeMode = &mode
+			pvc.ResourceVersion = resourceVersion
+			if capacity != "" {
+				pvc.Status.Capacity = k8sv1.ResourceList{
+					k8sv1.ResourceStorage: resource.MustParse(capacity),
+				}
+			}
+			return pvc
+		}
+
+		newVMIWithClaim := func() *v1.VirtualMachineInstance {
+			vmi := NewPendingVirtualMachine("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: "volume0",
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "claim0",
+					},
+				},
+			}}
+			return vmi
+		}
+
+		It("should record the capacity and the volume mode of the claim in the volume status", func() {
+			vmi := newVMIWithClaim()
+			pvcInformer.GetIndexer().Add(newClaim("1", "2Gi"))
+
+			Expect(controller.updateVolumeStatus(vmi, NewPodForVirtualMachine(vmi, k8sv1.PodRunning))).To(Succeed())
+			Expect(vmi.Status.VolumeStatus).To(HaveLen(1))
+			claimInfo := vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo
+			Expect(claimInfo).ToNot(BeNil())
+			Expect(*claimInfo.VolumeMode).To(Equal(k8sv1.PersistentVolumeFilesystem))
+			capacity := claimInfo.Capacity[k8sv1.ResourceStorage]
+			Expect(capacity.Cmp(resource.MustParse("2Gi"))).To(BeZero())
+		})
+
+		It("should keep the recorded capacity while the claim reports none", func() {
+			vmi := newVMIWithClaim()
+			claimInfo := &v1.PersistentVolumeClaimInfo{
+				Capacity: k8sv1.ResourceList{
+					k8sv1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{Name: "volume0", PersistentVolumeClaimInfo: claimInfo}}
+			pvcInformer.GetIndexer().Add(newClaim("1", ""))
+
+			Expect(controller.updateVolumeStatus(vmi, NewPodForVirtualMachine(vmi, k8sv1.PodRunning))).To(Succeed())
+			Expect(vmi.Status.VolumeStatus).To(HaveLen(1))
+			Expect(vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo).To(Equal(claimInfo))
+		})
+
+		It("should enqueue the VMIs which use a claim when its capacity changes", func() {
+			addVirtualMachine(newVMIWithClaim())
+			other := newVMIWithClaim()
+			other.Name = "other"
+			other.Spec.Volumes[0].PersistentVolumeClaim.ClaimName = "other"
+			addVirtualMachine(other)
+			Expect(controller.Queue.Len()).To(Equal(2))
+			for controller.Queue.Len() > 0 {
+				key, _ := controller.Queue.Get()
+				controller.Queue.Done(key)
+				controller.Queue.Forget(key)
+			}
+
+			controller.updatePVC(newClaim("1", "1Gi"), newClaim("2", "1Gi"))
+			Expect(controller.Queue.Len()).To(Equal(0))
+
+			controller.updatePVC(newClaim("2", "1Gi"), newClaim("3", "2Gi"))
+			Expect(controller.Queue.Len()).To(Equal(1))
+			key, _ := controller.Queue.Get()
+			Expect(key).To(Equal("default/testvmi"))
+		})
+	})
+
+	Context("hotplug interface", func() {
+		newVMIWithNetworks := func(networkNames ...string) *v1.VirtualMachineInstance {
+			vmi := NewPendingVirtualMachine("testvmi")
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+			for _, name := range networkNames {
+				vmi.Spec.Networks = append(vmi.Spec.Networks, v1.Network{
+					Name: name,
+					NetworkSource: v1.NetworkSource{
+						Multus: &v1.MultusNetwork{NetworkName: name + "-nad"},
+					},
+				})
+			}
+			return vmi
+		}
+
+		It("should not add a status for networks attached when the pod was created", func() {
+			vmi := newVMIWithNetworks("blue")
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pod.Annotations[services.MultusNetworksAnnotation] = `[{"interface":"net1","name":"blue-nad","namespace":"default"}]`
+
+			Expect(controller.updateHotplugInterfaceStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.HotplugInterfaces).To(BeNil())
+		})
+
+		It("should add a pending status for a hotplugged network", func() {
+			vmi := newVMIWithNetworks("blue", "red")
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pod.Annotations[services.MultusNetworksAnnotation] = `[{"interface":"net1","name":"blue-nad","namespace":"default"}]`
+
+			Expect(controller.updateHotplugInterfaceStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.HotplugInterfaces).To(HaveLen(1))
+			Expect(vmi.Status.HotplugInterfaces[0].Name).To(Equal("red"))
+			Expect(vmi.Status.HotplugInterfaces[0].PodInterfaceName).To(Equal("net2"))
+			Expect(vmi.Status.HotplugInterfaces[0].Phase).To(Equal(v1.InterfaceHotplugPending))
+		})
+
+		It("should not reuse the pod interface name multus gave to a binding plugin network", func() {
+			vmi := newVMIWithNetworks("blue", "red")
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pod.Annotations[services.MultusNetworksAnnotation] = `[{"interface":"net1","name":"blue-nad","namespace":"default"},{"name":"binding-nad","namespace":"default","cni-args":{"logicNetworkName":"default"}}]`
+			pod.Annotations[services.MultusNetworkStatusAnnotation] = `[{"name":"kindnet","interface":"eth0"},{"name":"default/blue-nad","interface":"net1"},{"name":"default/binding-nad","interface":"net2"}]`
+
+			Expect(controller.updateHotplugInterfaceStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.HotplugInterfaces).To(HaveLen(1))
+			Expect(vmi.Status.HotplugInterfaces[0].Name).To(Equal("red"))
+			Expect(vmi.Status.HotplugInterfaces[0].PodInterfaceName).To(Equal("net3"))
+		})
+
+		It("should mark a pending interface as attached once multus reports its network", func() {
+			vmi := newVMIWithNetworks("red")
+			vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: "red", PodInterfaceName: "net1", Phase: v1.InterfaceHotplugPending},
+			}
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pod.Annotations[services.MultusNetworksAnnotation] = `[{"interface":"net1","name":"red-nad","namespace":"default"}]`
+			pod.Annotations[services.MultusNetworkStatusAnnotation] = `[{"name":"kindnet","interface":"eth0"},{"name":"default/red-nad","interface":"net1"}]`
+
+			Expect(controller.updateHotplugInterfaceStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.HotplugInterfaces).To(HaveLen(1))
+			Expect(vmi.Status.HotplugInterfaces[0].Phase).To(Equal(v1.InterfaceHotplugAttachedToPod))
+			Expect(vmi.Status.HotplugInterfaces[0].Reason).To(Equal(NetworkAttachedReason))
+		})
+
+		It("should remove the status of an unplugged interface once its network is gone from the pod", func() {
+			vmi := newVMIWithNetworks()
+			vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: "red", PodInterfaceName: "net1", Phase: v1.InterfaceHotplugDetachedFromDomain},
+			}
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+
+			Expect(controller.updateHotplugInterfaceStatus(vmi, pod)).To(Succeed())
+			Expect(vmi.Status.HotplugInterfaces).To(BeEmpty())
+		})
+
+		It("should add the network of a pending interface to the pod", func() {
+			vmi := newVMIWithNetworks("red")
+			vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: "red", PodInterfaceName: "net1", Phase: v1.InterfaceHotplugPending},
+			}
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			kubeClient.Fake.PrependReactor("patch", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				patch, ok := action.(testing.PatchAction)
+				Expect(ok).To(BeTrue())
+				Expect(patch.GetPatchType()).To(Equal(types.MergePatchType))
+				Expect(string(patch.GetPatch())).To(Equal(`{"metadata":{"annotations":{"k8s.v1.cni.cncf.io/networks":"[{\"interface\":\"net1\",\"name\":\"red-nad\",\"namespace\":\"default\"}]"}}}`))
+				return true, pod, nil
+			})
+
+			Expect(controller.handleHotplugInterfaces(vmi, pod)).To(Succeed())
+			testutils.ExpectEvent(recorder, SuccessfulPodNetworksUpdateReason)
+		})
+
+		It("should remove the network of an interface detached from the domain", func() {
+			vmi := newVMIWithNetworks()
+			vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: "red", PodInterfaceName: "net1", Phase: v1.InterfaceHotplugDetachedFromDomain},
+			}
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pod.Annotations[services.MultusNetworksAnnotation] = `[{"interface":"net1","name":"red-nad","namespace":"default"}]`
+			kubeClient.Fake.PrependReactor("patch", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				patch, ok := action.(testing.PatchAction)
+				Expect(ok).To(BeTrue())
+				Expect(string(patch.GetPatch())).To(Equal(`{"metadata":{"annotations":{"k8s.v1.cni.cncf.io/networks":"[]"}}}`))
+				return true, pod, nil
+			})
+
+			Expect(controller.handleHotplugInterfaces(vmi, pod)).To(Succeed())
+			testutils.ExpectEvent(recorder, SuccessfulPodNetworksUpdateReason)
+		})
+
+		It("should not remove the network of an interface still attached to the domain", func() {
+			vmi := newVMIWithNetworks()
+			vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: "red", PodInterfaceName: "net1", Phase: v1.InterfaceHotplugReady},
+			}
+			pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			pod.Annotations[services.MultusNetworksAnnotation] = `[{"interface":"net1","name":"red-nad","namespace":"default"}]`
+
+			Expect(controller.handleHotplugInterfaces(vmi, pod)).To(Succeed())
+		})
+	})
 })
 
 func NewDv(namespace string, name string, phase cdiv1.DataVolumePhase) *cdiv1.DataVolume {
@@ -0,0 +1,392 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package exportserver
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// TokenHeader is the header, or query parameter, carrying the export token
+	TokenHeader = "x-kubevirt-export-token"
+
+	// ChecksumTrailer is the trailer carrying the sha256 checksum of the response body
+	ChecksumTrailer = "X-Kubevirt-Export-Checksum"
+
+	// SizeHeader is the size of the response body, if it is known in advance
+	SizeHeader = "X-Kubevirt-Export-Size"
+
+	// ChecksumPrefix prefixes the hex encoded checksum in the ChecksumTrailer
+	ChecksumPrefix = "sha256:"
+
+	// HealthzPath is the unauthenticated readiness endpoint
+	HealthzPath = "/healthz"
+
+	volumesPath   = "/volumes/"
+	manifestsPath = "/manifests/"
+
+	rawFile   = "disk.img"
+	gzipFile  = "disk.img.gz"
+	qcow2File = "disk.qcow2"
+
+	vmManifestFile   = "vm.yaml"
+	allManifestsFile = "all.tar.gz"
+)
+
+// VolumePath returns the path at which the volume name is served in format
+func VolumePath(name string, format exportv1.ExportVolumeFormat) string {
+	file := rawFile
+	switch format {
+	case exportv1.FormatGzip:
+		file = gzipFile
+	case exportv1.FormatQcow2:
+		file = qcow2File
+	}
+
+	return path.Join(volumesPath, name, file)
+}
+
+// ManifestPath returns the path at which the manifests of manifestType are served
+func ManifestPath(manifestType exportv1.ExportManifestType) string {
+	if manifestType == exportv1.AllManifests {
+		return path.Join(manifestsPath, allManifestsFile)
+	}
+
+	return path.Join(manifestsPath, vmManifestFile)
+}
+
+// ExportServer serves the disk images and the manifests of a VirtualMachineExport
+type ExportServer struct {
+	ListenAddr  string
+	CertFile    string
+	KeyFile     string
+	TokenFile   string
+	ManifestDir string
+	ScratchDir  string
+
+	// Volumes maps the exported volume names to the paths of their disk images,
+	// which are either image files or block devices
+	Volumes map[string]string
+
+	token string
+
+	convertLock sync.Mutex
+	converted   map[string]string
+	// variable so can be overridden in tests
+	convert func(src, dst string) error
+}
+
+// NewExportServer returns an ExportServer serving volumes
+func NewExportServer(listenAddr, certFile, keyFile, tokenFile, manifestDir, scratchDir string, volumes map[string]string) *ExportServer {
+	return &ExportServer{
+		ListenAddr:  listenAddr,
+		CertFile:    certFile,
+		KeyFile:     keyFile,
+		TokenFile:   tokenFile,
+		ManifestDir: manifestDir,
+		ScratchDir:  scratchDir,
+		Volumes:     volumes,
+		converted:   make(map[string]string),
+		convert:     qemuImgConvert,
+	}
+}
+
+// Run reads the token and serves until an error occurs
+func (s *ExportServer) Run() error {
+	token, err := ioutil.ReadFile(s.TokenFile)
+	if err != nil {
+		return fmt.Errorf("failed to read token: %v", err)
+	}
+
+	s.token = strings.TrimSpace(string(token))
+	if s.token == "" {
+		return fmt.Errorf("token file %s is empty", s.TokenFile)
+	}
+
+	server := &http.Server{
+		Addr:    s.ListenAddr,
+		Handler: s.Handler(),
+	}
+
+	log.Log.Infof("Serving %d volumes on %s", len(s.Volumes), s.ListenAddr)
+
+	return server.ListenAndServeTLS(s.CertFile, s.KeyFile)
+}
+
+// Handler returns the handler serving the export
+func (s *ExportServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(HealthzPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.Handle(volumesPath, s.authorized(s.serveVolume))
+	mux.Handle(manifestsPath, s.authorized(s.serveManifest))
+
+	return mux
+}
+
+func (s *ExportServer) authorized(handler http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		token := r.Header.Get(TokenHeader)
+		if token == "" {
+			token = r.URL.Query().Get(TokenHeader)
+		}
+
+		if s.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		handler(w, r)
+	})
+}
+
+func (s *ExportServer) serveVolume(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, volumesPath), "/")
+	if len(parts) != 2 {
+		http.NotFound(w, r)
+		return
+	}
+
+	name, file := parts[0], parts[1]
+	src, ok := s.Volumes[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch file {
+	case rawFile:
+		serveFile(w, src, false)
+	case gzipFile:
+		serveFile(w, src, true)
+	case qcow2File:
+		dst, err := s.convertedImage(name, src)
+		if err != nil {
+			log.Log.Reason(err).Errorf("Failed to convert volume %s to qcow2", name)
+			http.Error(w, "failed to convert volume to qcow2", http.StatusInternalServerError)
+			return
+		}
+		serveFile(w, dst, false)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *ExportServer) serveManifest(w http.ResponseWriter, r *http.Request) {
+	switch strings.TrimPrefix(r.URL.Path, manifestsPath) {
+	case vmManifestFile:
+		serveFile(w, filepath.Join(s.ManifestDir, vmManifestFile), false)
+	case allManifestsFile:
+		files, err := manifestFiles(s.ManifestDir)
+		if err != nil {
+			log.Log.Reason(err).Error("Failed to list manifests")
+			http.Error(w, "failed to list manifests", http.StatusInternalServerError)
+			return
+		}
+
+		serveChecksummed(w, -1, func(out io.Writer) error {
+			return writeArchive(out, files)
+		})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// convertedImage converts the image at src to qcow2 in the scratch directory.
+// The image is only converted once, later requests are served from the scratch directory.
+func (s *ExportServer) convertedImage(name, src string) (string, error) {
+	s.convertLock.Lock()
+	defer s.convertLock.Unlock()
+
+	if dst, ok := s.converted[name]; ok {
+		return dst, nil
+	}
+
+	dst := filepath.Join(s.ScratchDir, name+".qcow2")
+	tmp := dst + ".tmp"
+	if err := s.convert(src, tmp); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+
+	if err := os.Rename(tmp, dst); err != nil {
+		return "", err
+	}
+
+	s.converted[name] = dst
+	return dst, nil
+}
+
+func qemuImgConvert(src, dst string) error {
+	out, err := exec.Command("qemu-img", "convert", "-O", "qcow2", src, dst).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("qemu-img convert failed: %v: %s", err, string(out))
+	}
+
+	return nil
+}
+
+// serveFile serves the file at p, which may be a block device, optionally gzip compressed
+func serveFile(w http.ResponseWriter, p string, compress bool) {
+	f, err := os.Open(p)
+	if err != nil {
+		if os.IsNotExist(err) {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		log.Log.Reason(err).Errorf("Failed to open %s", p)
+		http.Error(w, "failed to open file", http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+
+	// seeking works for block devices whose stat size is 0
+	size, err := f.Seek(0, io.SeekEnd)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to determine the size of %s", p)
+		http.Error(w, "failed to read file", http.StatusInternalServerError)
+		return
+	}
+
+	if compress {
+		size = -1
+	}
+
+	serveChecksummed(w, size, func(out io.Writer) error {
+		if !compress {
+			_, err := io.Copy(out, f)
+			return err
+		}
+
+		gz := gzip.NewWriter(out)
+		if _, err := io.Copy(gz, f); err != nil {
+			return err
+		}
+		return gz.Close()
+	})
+}
+
+// serveChecksummed streams the body written by write and sends its sha256 checksum
+// in the ChecksumTrailer. The Content-Length is never set since trailers require a
+// chunked response, the size is announced in the SizeHeader instead.
+func serveChecksummed(w http.ResponseWriter, size int64, write func(io.Writer) error) {
+	w.Header().Set("Trailer", ChecksumTrailer)
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if size >= 0 {
+		w.Header().Set(SizeHeader, strconv.FormatInt(size, 10))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	hash := sha256.New()
+	if err := write(io.MultiWriter(w, hash)); err != nil {
+		// without the trailer the client fails the checksum verification
+		log.Log.Reason(err).Error("Failed to write response")
+		return
+	}
+
+	w.Header().Set(ChecksumTrailer, ChecksumPrefix+hex.EncodeToString(hash.Sum(nil)))
+}
+
+// manifestFiles returns the manifests in dir, skipping the hidden
+// entries the kubelet creates in ConfigMap volumes
+func manifestFiles(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		p := filepath.Join(dir, entry.Name())
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+
+		if info.Mode().IsRegular() {
+			files = append(files, p)
+		}
+	}
+
+	sort.Strings(files)
+	return files, nil
+}
+
+func writeArchive(out io.Writer, files []string) error {
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+
+	for _, p := range files {
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		hdr := &tar.Header{
+			Name: filepath.Base(p),
+			Mode: 0644,
+			Size: int64(len(content)),
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err := tw.Write(content); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
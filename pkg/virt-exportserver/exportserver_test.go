@@ -0,0 +1,196 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package exportserver
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+)
+
+const testToken = "secret-token"
+
+var _ = Describe("Export server", func() {
+	var tmpDir string
+	var server *ExportServer
+	var httpServer *httptest.Server
+	var diskContent []byte
+
+	checksum := func(b []byte) string {
+		sum := sha256.Sum256(b)
+		return ChecksumPrefix + hex.EncodeToString(sum[:])
+	}
+
+	get := func(p string, token string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, httpServer.URL+p, nil)
+		Expect(err).ToNot(HaveOccurred())
+		if token != "" {
+			req.Header.Set(TokenHeader, token)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	readBody := func(resp *http.Response) []byte {
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return body
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "exportserver")
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, dir := range []string{"disk", "manifests", "scratch"} {
+			Expect(os.Mkdir(filepath.Join(tmpDir, dir), 0755)).To(Succeed())
+		}
+
+		diskContent = []byte("this is a disk image")
+		Expect(ioutil.WriteFile(filepath.Join(tmpDir, "disk", "disk.img"), diskContent, 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(tmpDir, "manifests", "vm.yaml"), []byte("kind: VirtualMachine\n"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(tmpDir, "manifests", "pvc-disk.yaml"), []byte("kind: PersistentVolumeClaim\n"), 0644)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(tmpDir, "manifests", "..data"), 0755)).To(Succeed())
+
+		server = NewExportServer("", "", "", "", filepath.Join(tmpDir, "manifests"), filepath.Join(tmpDir, "scratch"),
+			map[string]string{"disk": filepath.Join(tmpDir, "disk", "disk.img")})
+		server.token = testToken
+		httpServer = httptest.NewServer(server.Handler())
+	})
+
+	AfterEach(func() {
+		httpServer.Close()
+		os.RemoveAll(tmpDir)
+	})
+
+	It("should serve the health endpoint without a token", func() {
+		resp := get(HealthzPath, "")
+		readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	table.DescribeTable("should reject requests", func(p, token string) {
+		resp := get(p, token)
+		readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+	},
+		table.Entry("to volumes without a token", VolumePath("disk", exportv1.FormatRaw), ""),
+		table.Entry("to volumes with a wrong token", VolumePath("disk", exportv1.FormatRaw), "wrong"),
+		table.Entry("to manifests without a token", ManifestPath(exportv1.VirtualMachineManifest), ""),
+	)
+
+	It("should accept the token as query parameter", func() {
+		resp := get(VolumePath("disk", exportv1.FormatRaw)+"?"+TokenHeader+"="+testToken, "")
+		Expect(readBody(resp)).To(Equal(diskContent))
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("should serve the raw image with its size and checksum", func() {
+		resp := get(VolumePath("disk", exportv1.FormatRaw), testToken)
+		body := readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(body).To(Equal(diskContent))
+		Expect(resp.Header.Get(SizeHeader)).To(Equal("20"))
+		Expect(resp.Trailer.Get(ChecksumTrailer)).To(Equal(checksum(diskContent)))
+	})
+
+	It("should serve the gzip compressed image", func() {
+		resp := get(VolumePath("disk", exportv1.FormatGzip), testToken)
+		body := readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get(SizeHeader)).To(BeEmpty())
+		Expect(resp.Trailer.Get(ChecksumTrailer)).To(Equal(checksum(body)))
+
+		gz, err := gzip.NewReader(bytes.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		uncompressed, err := ioutil.ReadAll(gz)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(uncompressed).To(Equal(diskContent))
+	})
+
+	It("should convert the image to qcow2 only once", func() {
+		conversions := 0
+		server.convert = func(src, dst string) error {
+			conversions++
+			Expect(src).To(Equal(filepath.Join(tmpDir, "disk", "disk.img")))
+			return ioutil.WriteFile(dst, []byte("qcow2"), 0644)
+		}
+
+		for i := 0; i < 2; i++ {
+			resp := get(VolumePath("disk", exportv1.FormatQcow2), testToken)
+			Expect(readBody(resp)).To(Equal([]byte("qcow2")))
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Trailer.Get(ChecksumTrailer)).To(Equal(checksum([]byte("qcow2"))))
+		}
+		Expect(conversions).To(Equal(1))
+	})
+
+	It("should return not found for unknown volumes and files", func() {
+		for _, p := range []string{"/volumes/other/disk.img", "/volumes/disk/disk.vmdk", "/volumes/disk", "/manifests/other.yaml"} {
+			resp := get(p, testToken)
+			readBody(resp)
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound), p)
+		}
+	})
+
+	It("should serve the VirtualMachine manifest", func() {
+		resp := get(ManifestPath(exportv1.VirtualMachineManifest), testToken)
+		Expect(string(readBody(resp))).To(Equal("kind: VirtualMachine\n"))
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("should serve all manifests as archive", func() {
+		resp := get(ManifestPath(exportv1.AllManifests), testToken)
+		body := readBody(resp)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Trailer.Get(ChecksumTrailer)).To(Equal(checksum(body)))
+
+		gz, err := gzip.NewReader(bytes.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		tr := tar.NewReader(gz)
+
+		var names []string
+		for {
+			hdr, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).ToNot(HaveOccurred())
+			names = append(names, hdr.Name)
+		}
+		Expect(names).To(Equal([]string{"pvc-disk.yaml", "vm.yaml"}))
+	})
+})
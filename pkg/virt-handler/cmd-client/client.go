@@ -81,6 +81,12 @@ type LauncherClient interface {
 	SyncVirtualMachine(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	PauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	UnpauseVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	FreezeVirtualMachine(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error
+	UnfreezeVirtualMachine(vmi *v1.VirtualMachineInstance) error
+	ResizeVirtualMachineVolumes(vmi *v1.VirtualMachineInstance) error
+	BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceBackupOptions) error
+	AbortVirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceAbortBackupOptions) error
+	SetVirtualMachineBlockIOTune(vmi *v1.VirtualMachineInstance, options *v1.SetBlockIOTuneOptions) error
 	SyncMigrationTarget(vmi *v1.VirtualMachineInstance) error
 	ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error
 	KillVirtualMachine(vmi *v1.VirtualMachineInstance) error
@@ -414,6 +420,103 @@ func (c *VirtLauncherClient) UnpauseVirtualMachine(vmi *v1.VirtualMachineInstanc
 	return c.genericSendVMICmd("Unpause", c.v1client.UnpauseVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
 
+func (c *VirtLauncherClient) FreezeVirtualMachine(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error {
+	return c.genericSendFreezeCmd("Freeze", c.v1client.FreezeVirtualMachine, vmi, unfreezeTimeoutSeconds)
+}
+
+func (c *VirtLauncherClient) UnfreezeVirtualMachine(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendFreezeCmd("Unfreeze", c.v1client.UnfreezeVirtualMachine, vmi, 0)
+}
+
+func (c *VirtLauncherClient) ResizeVirtualMachineVolumes(vmi *v1.VirtualMachineInstance) error {
+	return c.genericSendVMICmd("ResizeVolumes", c.v1client.ResizeVirtualMachineVolumes, vmi, &cmdv1.VirtualMachineOptions{})
+}
+
+func (c *VirtLauncherClient) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceBackupOptions) error {
+	return c.genericSendBackupCmd("Backup", c.v1client.BackupVirtualMachine, vmi, options)
+}
+
+func (c *VirtLauncherClient) AbortVirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceAbortBackupOptions) error {
+	return c.genericSendBackupCmd("AbortBackup", c.v1client.AbortVirtualMachineBackup, vmi, options)
+}
+
+func (c *VirtLauncherClient) genericSendBackupCmd(cmdName string,
+	cmdFunc func(ctx context.Context, request *cmdv1.BackupRequest, opts ...grpc.CallOption) (*cmdv1.Response, error),
+	vmi *v1.VirtualMachineInstance, options interface{}) error {
+
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.BackupRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := cmdFunc(ctx, request)
+
+	err = handleError(err, cmdName, response)
+	return err
+}
+
+func (c *VirtLauncherClient) SetVirtualMachineBlockIOTune(vmi *v1.VirtualMachineInstance, options *v1.SetBlockIOTuneOptions) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.BlockIOTuneRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+	response, err := c.v1client.SetVirtualMachineBlockIOTune(ctx, request)
+
+	err = handleError(err, "SetBlockIOTune", response)
+	return err
+}
+
+func (c *VirtLauncherClient) genericSendFreezeCmd(cmdName string,
+	cmdFunc func(ctx context.Context, request *cmdv1.FreezeUnfreezeRequest, opts ...grpc.CallOption) (*cmdv1.Response, error),
+	vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error {
+
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.FreezeUnfreezeRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		UnfreezeTimeoutSeconds: unfreezeTimeoutSeconds,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := cmdFunc(ctx, request)
+
+	err = handleError(err, cmdName, response)
+	return err
+}
+
 func (c *VirtLauncherClient) ShutdownVirtualMachine(vmi *v1.VirtualMachineInstance) error {
 	return c.genericSendVMICmd("Shutdown", c.v1client.ShutdownVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
 }
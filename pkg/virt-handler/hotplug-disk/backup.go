@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package hotplug_volume
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/log"
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+)
+
+// The claims of push backups are mounted by a backup target pod on the node of the VMI. The pod creates a
+// directory named after the backup in the root of the claim, which is used to find the claim in the pod volumes.
+func getBackupTargetSourcePath(backupName string, targetPodUID types.UID) (string, error) {
+	sourcePath := ""
+	if targetPodUID != types.UID("") {
+		err := filepath.Walk(sourcePodBasePath(targetPodUID), func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() && filepath.Base(filePath) == backupName {
+				sourcePath = filepath.Dir(filePath)
+				return io.EOF
+			}
+			return nil
+		})
+		if err != nil && err != io.EOF {
+			return "", err
+		}
+	}
+	if sourcePath == "" {
+		return "", fmt.Errorf("Unable to find the target of backup %s in pod %s", backupName, targetPodUID)
+	}
+	return sourcePath, nil
+}
+
+// MountBackupTarget bind mounts the claim of a push backup from the backup target pod into the virt-launcher pod of the VMI.
+func MountBackupTarget(vmi *v1.VirtualMachineInstance, backupName string, targetPodUID types.UID) error {
+	virtlauncherUID := findVirtlauncherUID(vmi)
+	if virtlauncherUID == "" {
+		return fmt.Errorf("Unable to determine virt-launcher UID")
+	}
+	sourcePath, err := getBackupTargetSourcePath(backupName, targetPodUID)
+	if err != nil {
+		return err
+	}
+	targetPath, err := hotplugdisk.GetFileSystemDiskTargetPathFromHostView(virtlauncherUID, hotplugdisk.GetBackupTargetDirName(backupName), true)
+	if err != nil {
+		return err
+	}
+
+	if isMounted, err := isMounted(targetPath); err != nil {
+		return fmt.Errorf("failed to determine if %s is already mounted: %v", targetPath, err)
+	} else if !isMounted {
+		if out, err := mountCommand(sourcePath, targetPath); err != nil {
+			return fmt.Errorf("failed to bindmount the target of backup %s: %v : %v", backupName, string(out), err)
+		}
+	}
+	return nil
+}
+
+func unmountBackupTarget(targetPath string) error {
+	if mounted, err := isMounted(targetPath); err != nil {
+		return fmt.Errorf("failed to check mount point for backup target %v: %v", targetPath, err)
+	} else if mounted {
+		if out, err := unmountCommand(targetPath); err != nil {
+			return fmt.Errorf("failed to unmount backup target %v: %v : %v", targetPath, string(out), err)
+		}
+	}
+	if err := os.Remove(targetPath); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove backup target directory %v: %v", targetPath, err)
+	}
+	return nil
+}
+
+// UnmountBackupTarget unmounts the claim of a push backup from the virt-launcher pod of the VMI, if it is mounted.
+func UnmountBackupTarget(vmi *v1.VirtualMachineInstance, backupName string) error {
+	virtlauncherUID := findVirtlauncherUID(vmi)
+	if virtlauncherUID == "" {
+		return nil
+	}
+	basePath, err := hotplugdisk.GetHotplugTargetPodPathOnHost(virtlauncherUID)
+	if err != nil {
+		// The pod is gone, and so are its mounts
+		return nil
+	}
+	return unmountBackupTarget(filepath.Join(basePath, hotplugdisk.GetBackupTargetDirName(backupName)))
+}
+
+// UnmountAllBackupTargets unmounts the claims of all push backups from the virt-launcher pod of the VMI.
+func UnmountAllBackupTargets(vmi *v1.VirtualMachineInstance) error {
+	virtlauncherUID := findVirtlauncherUID(vmi)
+	if virtlauncherUID == "" {
+		return nil
+	}
+	basePath, err := hotplugdisk.GetHotplugTargetPodPathOnHost(virtlauncherUID)
+	if err != nil {
+		return nil
+	}
+	entries, err := ioutil.ReadDir(basePath)
+	if err != nil {
+		return err
+	}
+	prefix := hotplugdisk.GetBackupTargetDirName("")
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+		if err := unmountBackupTarget(filepath.Join(basePath, entry.Name())); err != nil {
+			log.Log.Object(vmi).Reason(err).Infof("Unable to unmount backup target %s", entry.Name())
+			// Don't return error, try next.
+		}
+	}
+	return nil
+}
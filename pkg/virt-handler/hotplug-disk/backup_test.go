@@ -0,0 +1,139 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package hotplug_volume
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+)
+
+var _ = Describe("HotplugVolume backup targets", func() {
+	var (
+		err           error
+		vmi           *v1.VirtualMachineInstance
+		sourcePath    string
+		targetPodPath string
+	)
+
+	BeforeEach(func() {
+		tempDir, err = ioutil.TempDir("", "hotplug-volume-test")
+		Expect(err).ToNot(HaveOccurred())
+		vmi = v1.NewMinimalVMI("fake-vmi")
+		vmi.UID = "1234"
+		vmi.Status.ActivePods = map[types.UID]string{"abcd": "host"}
+
+		sourcePodBasePath = func(podUID types.UID) string {
+			return filepath.Join(tempDir, string(podUID), "volumes")
+		}
+		sourcePath = filepath.Join(tempDir, "target-pod", "volumes", "kubernetes.io~csi", "pvc-1234", "mount")
+		Expect(os.MkdirAll(filepath.Join(sourcePath, "mybackup"), 0755)).To(Succeed())
+
+		hotplugdisk.SetKubeletPodsDirectory(tempDir)
+		targetPodPath = filepath.Join(tempDir, "abcd", "volumes/kubernetes.io~empty-dir/hotplug-disks")
+		Expect(os.MkdirAll(targetPodPath, 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(tempDir)
+		sourcePodBasePath = orgSourcePodBasePath
+		mountCommand = orgMountCommand
+		unmountCommand = orgUnMountCommand
+		isMounted = orgIsMounted
+	})
+
+	It("should bind mount the root of the claim with the directory of the backup", func() {
+		targetPath := filepath.Join(targetPodPath, "backup.mybackup")
+		isMounted = func(path string) (bool, error) {
+			Expect(path).To(Equal(targetPath))
+			return false, nil
+		}
+		mounted := false
+		mountCommand = func(source, target string) ([]byte, error) {
+			Expect(source).To(Equal(sourcePath))
+			Expect(target).To(Equal(targetPath))
+			mounted = true
+			return []byte("Success"), nil
+		}
+
+		Expect(MountBackupTarget(vmi, "mybackup", "target-pod")).To(Succeed())
+		Expect(mounted).To(BeTrue())
+		Expect(targetPath).To(BeADirectory())
+	})
+
+	It("should not mount the target twice", func() {
+		isMounted = func(path string) (bool, error) {
+			return true, nil
+		}
+		mountCommand = func(source, target string) ([]byte, error) {
+			Fail("the target should not be mounted again")
+			return nil, nil
+		}
+
+		Expect(MountBackupTarget(vmi, "mybackup", "target-pod")).To(Succeed())
+	})
+
+	It("should fail if the target pod does not have the directory of the backup", func() {
+		err := MountBackupTarget(vmi, "otherbackup", "target-pod")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("Unable to find the target of backup otherbackup"))
+	})
+
+	It("should unmount and remove the target of a backup", func() {
+		targetPath := filepath.Join(targetPodPath, "backup.mybackup")
+		Expect(os.Mkdir(targetPath, 0755)).To(Succeed())
+		isMounted = func(path string) (bool, error) {
+			return true, nil
+		}
+		unmountCommand = func(path string) ([]byte, error) {
+			Expect(path).To(Equal(targetPath))
+			return []byte("Success"), nil
+		}
+
+		Expect(UnmountBackupTarget(vmi, "mybackup")).To(Succeed())
+		Expect(targetPath).ToNot(BeADirectory())
+	})
+
+	It("should unmount the targets of all backups, but no volumes", func() {
+		Expect(os.Mkdir(filepath.Join(targetPodPath, "backup.first"), 0755)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(targetPodPath, "backup.second"), 0755)).To(Succeed())
+		Expect(os.Mkdir(filepath.Join(targetPodPath, "testvolume"), 0755)).To(Succeed())
+		isMounted = func(path string) (bool, error) {
+			return true, nil
+		}
+		unmounted := []string{}
+		unmountCommand = func(path string) ([]byte, error) {
+			unmounted = append(unmounted, filepath.Base(path))
+			return []byte("Success"), nil
+		}
+
+		Expect(UnmountAllBackupTargets(vmi)).To(Succeed())
+		Expect(unmounted).To(ConsistOf("backup.first", "backup.second"))
+		Expect(filepath.Join(targetPodPath, "testvolume")).To(BeADirectory())
+	})
+})
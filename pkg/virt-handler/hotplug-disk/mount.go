@@ -551,6 +551,10 @@ func (m *volumeMounter) mountFileSystemHotplugVolume(vmi *v1.VirtualMachineInsta
 }
 
 func (m *volumeMounter) findVirtlauncherUID(vmi *v1.VirtualMachineInstance) types.UID {
+	return findVirtlauncherUID(vmi)
+}
+
+func findVirtlauncherUID(vmi *v1.VirtualMachineInstance) types.UID {
 	if len(vmi.Status.ActivePods) == 1 {
 		for k := range vmi.Status.ActivePods {
 			return k
@@ -0,0 +1,428 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package networkpolicy
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network"
+)
+
+// EgressNotIsolatedReason is the reason of the warning event recorded when the egress of
+// the macvtap interfaces of a VMI can not be isolated on its node
+const EgressNotIsolatedReason = "EgressNotIsolated"
+
+// NetworkPolicyController loads the firewall resulting from the VirtualMachineNetworkPolicies
+// into the network namespace of the virt-launcher pods on the node. The firewall is reloaded
+// when the policies change, including the addresses of their peers which virt-controller
+// keeps in their status.
+type NetworkPolicyController struct {
+	vmiSourceInformer    cache.SharedIndexInformer
+	vmiTargetInformer    cache.SharedIndexInformer
+	policyInformer       cache.SharedIndexInformer
+	podIsolationDetector isolation.PodIsolationDetector
+	recorder             record.EventRecorder
+	queue                workqueue.RateLimitingInterface
+
+	// loadedFirewalls holds the firewall last loaded for each VMI key
+	loadedFirewalls     map[string]loadedFirewall
+	loadedFirewallsLock sync.Mutex
+
+	// egressHook holds whether the egress hook is supported on the node, once it is probed
+	egressHook     *bool
+	egressHookLock sync.Mutex
+}
+
+type loadedFirewall struct {
+	pid     int
+	ruleset string
+}
+
+func NewNetworkPolicyController(
+	vmiSourceInformer cache.SharedIndexInformer,
+	vmiTargetInformer cache.SharedIndexInformer,
+	policyInformer cache.SharedIndexInformer,
+	podIsolationDetector isolation.PodIsolationDetector,
+	recorder record.EventRecorder,
+) *NetworkPolicyController {
+	c := &NetworkPolicyController{
+		vmiSourceInformer:    vmiSourceInformer,
+		vmiTargetInformer:    vmiTargetInformer,
+		policyInformer:       policyInformer,
+		podIsolationDetector: podIsolationDetector,
+		recorder:             recorder,
+		queue:                workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		loadedFirewalls:      map[string]loadedFirewall{},
+	}
+
+	for _, informer := range []cache.SharedIndexInformer{vmiSourceInformer, vmiTargetInformer} {
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueVMI,
+			DeleteFunc: c.enqueueVMI,
+			UpdateFunc: func(_, obj interface{}) { c.enqueueVMI(obj) },
+		})
+	}
+
+	policyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNamespaceOf,
+		DeleteFunc: c.enqueueNamespaceOf,
+		UpdateFunc: func(_, obj interface{}) { c.enqueueNamespaceOf(obj) },
+	})
+
+	return c
+}
+
+// Run keeps the firewalls of the VMIs on the node up to date until stop is closed
+func (c *NetworkPolicyController) Run(threadiness int, stop chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting network policy controller")
+
+	cache.WaitForCacheSync(stop, c.vmiSourceInformer.HasSynced, c.vmiTargetInformer.HasSynced, c.policyInformer.HasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stop)
+	}
+
+	<-stop
+	log.Log.Info("Stopping network policy controller")
+}
+
+func (c *NetworkPolicyController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *NetworkPolicyController) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(key.(string)); err != nil {
+		log.Log.Reason(err).Errorf("Failed to load the network policy firewall of vmi %s, reenqueuing", key)
+		c.queue.AddRateLimited(key)
+	} else {
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *NetworkPolicyController) execute(key string) error {
+	vmi, err := c.getLocalVMI(key)
+	if err != nil {
+		return err
+	}
+
+	if vmi == nil || vmi.IsFinal() || vmi.DeletionTimestamp != nil || !(vmi.IsScheduled() || vmi.IsRunning()) {
+		c.forgetFirewall(key)
+		return nil
+	}
+
+	firewalls := c.getFirewalls(vmi)
+	loaded, wasLoaded := c.getLoadedFirewall(key)
+	if len(firewalls) == 0 && !wasLoaded {
+		// nothing to isolate, and nothing to remove
+		return nil
+	}
+
+	res, err := c.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to detect isolation for launcher pod: %v", err)
+	}
+
+	needsEgressHook := network.FirewallNeedsEgressHook(vmi, firewalls)
+	egressHook := false
+	if needsEgressHook {
+		egressHook, err = c.supportsEgressHook(res)
+		if err != nil {
+			return err
+		}
+	}
+
+	ruleset := network.RenderFirewall(vmi, firewalls, egressHook)
+	if wasLoaded && loaded.pid == res.Pid() && loaded.ruleset == ruleset {
+		return nil
+	}
+	if needsEgressHook && !egressHook {
+		// the egress of the macvtap interfaces stays open, which the owner of the vmi has to know
+		msg := "The egress of the macvtap interfaces can not be isolated, it needs nftables 1.0.1 and kernel 5.16 or newer on the node"
+		log.Log.Object(vmi).Warning(msg)
+		c.recorder.Event(vmi, k8sv1.EventTypeWarning, EgressNotIsolatedReason, msg)
+	}
+	if len(firewalls) == 0 && loaded.pid != res.Pid() {
+		// the firewall was loaded into a previous pod
+		c.forgetFirewall(key)
+		return nil
+	}
+
+	if err := res.DoNetNS(func() error { return network.LoadFirewall(ruleset) }); err != nil {
+		return err
+	}
+	log.Log.Object(vmi).V(4).Infof("Loaded the network policy firewall:\n%s", ruleset)
+
+	if len(firewalls) == 0 {
+		// the firewall was removed, there is nothing to reload until a policy selects the vmi
+		c.forgetFirewall(key)
+		return nil
+	}
+	c.loadedFirewallsLock.Lock()
+	defer c.loadedFirewallsLock.Unlock()
+	c.loadedFirewalls[key] = loadedFirewall{pid: res.Pid(), ruleset: ruleset}
+	return nil
+}
+
+// supportsEgressHook probes once whether nft and the kernel of the node support the egress hook
+func (c *NetworkPolicyController) supportsEgressHook(res isolation.IsolationResult) (bool, error) {
+	c.egressHookLock.Lock()
+	defer c.egressHookLock.Unlock()
+
+	if c.egressHook == nil {
+		supported := false
+		if err := res.DoNetNS(func() error {
+			supported = network.FirewallSupportsEgressHook()
+			return nil
+		}); err != nil {
+			return false, err
+		}
+		c.egressHook = &supported
+	}
+	return *c.egressHook, nil
+}
+
+// getLocalVMI returns the VMI if it runs on the node, or if it migrates to the node
+func (c *NetworkPolicyController) getLocalVMI(key string) (*v1.VirtualMachineInstance, error) {
+	for _, informer := range []cache.SharedIndexInformer{c.vmiSourceInformer, c.vmiTargetInformer} {
+		obj, exists, err := informer.GetStore().GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			return obj.(*v1.VirtualMachineInstance), nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *NetworkPolicyController) getLoadedFirewall(key string) (loadedFirewall, bool) {
+	c.loadedFirewallsLock.Lock()
+	defer c.loadedFirewallsLock.Unlock()
+	loaded, ok := c.loadedFirewalls[key]
+	return loaded, ok
+}
+
+func (c *NetworkPolicyController) forgetFirewall(key string) {
+	c.loadedFirewallsLock.Lock()
+	defer c.loadedFirewallsLock.Unlock()
+	delete(c.loadedFirewalls, key)
+}
+
+// getFirewalls returns the firewalls of the bridge and macvtap interfaces of the vmi
+// isolated by the policies selecting it, keyed by the names of the interfaces
+func (c *NetworkPolicyController) getFirewalls(vmi *v1.VirtualMachineInstance) map[string]*network.InterfaceFirewall {
+	firewalls := map[string]*network.InterfaceFirewall{}
+
+	for _, policy := range c.listPolicies(vmi.Namespace) {
+		if !matchesSelector(policy, &policy.Spec.VirtualMachineInstanceSelector, vmi) {
+			continue
+		}
+
+		isolateIngress, isolateEgress := getPolicyTypes(&policy.Spec)
+		var ingress, egress []network.FirewallRule
+		for _, rule := range policy.Spec.Ingress {
+			ingress = append(ingress, c.getFirewallRules(policy, rule.Ports, rule.From)...)
+		}
+		for _, rule := range policy.Spec.Egress {
+			egress = append(egress, c.getFirewallRules(policy, rule.Ports, rule.To)...)
+		}
+
+		for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+			if iface.Bridge == nil && iface.Macvtap == nil {
+				continue
+			}
+			if len(policy.Spec.Networks) > 0 && !contains(policy.Spec.Networks, iface.Name) {
+				continue
+			}
+			firewall, ok := firewalls[iface.Name]
+			if !ok {
+				firewall = &network.InterfaceFirewall{}
+				firewalls[iface.Name] = firewall
+			}
+			if isolateIngress {
+				firewall.IsolateIngress = true
+				firewall.Ingress = append(firewall.Ingress, ingress...)
+			}
+			if isolateEgress {
+				firewall.IsolateEgress = true
+				firewall.Egress = append(firewall.Egress, egress...)
+			}
+		}
+	}
+
+	return firewalls
+}
+
+// getPolicyTypes returns whether the policy isolates the ingress and the egress traffic.
+// Like with NetworkPolicies, egress is only isolated by default if egress rules are set.
+func getPolicyTypes(spec *networkpolicyv1.VirtualMachineNetworkPolicySpec) (bool, bool) {
+	if len(spec.PolicyTypes) == 0 {
+		return true, len(spec.Egress) > 0
+	}
+	var isolateIngress, isolateEgress bool
+	for _, policyType := range spec.PolicyTypes {
+		switch policyType {
+		case networkpolicyv1.PolicyTypeIngress:
+			isolateIngress = true
+		case networkpolicyv1.PolicyTypeEgress:
+			isolateEgress = true
+		}
+	}
+	return isolateIngress, isolateEgress
+}
+
+// getFirewallRules expands a policy rule into a firewall rule for each pair of peer and port
+func (c *NetworkPolicyController) getFirewallRules(policy *networkpolicyv1.VirtualMachineNetworkPolicy, ports []networkpolicyv1.VirtualMachineNetworkPolicyPort, peers []networkpolicyv1.VirtualMachineNetworkPolicyPeer) []network.FirewallRule {
+	var peerRules []network.FirewallRule
+	if len(peers) == 0 {
+		peerRules = append(peerRules, network.FirewallRule{})
+	}
+	for _, peer := range peers {
+		if peer.IPBlock != nil {
+			peerRules = append(peerRules, network.FirewallRule{CIDRs: []string{peer.IPBlock.CIDR}, Except: peer.IPBlock.Except})
+		} else if peer.VirtualMachineInstanceSelector != nil {
+			// a selector without addresses allows nothing
+			if cidrs := getPeerCIDRs(policy, peer.VirtualMachineInstanceSelector); len(cidrs) > 0 {
+				peerRules = append(peerRules, network.FirewallRule{CIDRs: cidrs})
+			}
+		}
+	}
+
+	if len(ports) == 0 {
+		return peerRules
+	}
+	var rules []network.FirewallRule
+	for _, peerRule := range peerRules {
+		for _, port := range ports {
+			rule := peerRule
+			rule.Protocol = strings.ToLower(string(k8sv1.ProtocolTCP))
+			if port.Protocol != nil {
+				rule.Protocol = strings.ToLower(string(*port.Protocol))
+			}
+			if port.Port != nil {
+				rule.Port = *port.Port
+			}
+			if port.EndPort != nil {
+				rule.EndPort = *port.EndPort
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+// getPeerCIDRs returns the addresses of the VMIs matching the selector, which virt-controller
+// publishes in the status of the policy as host CIDRs
+func getPeerCIDRs(policy *networkpolicyv1.VirtualMachineNetworkPolicy, selector *metav1.LabelSelector) []string {
+	for _, peer := range policy.Status.Peers {
+		if equality.Semantic.DeepEqual(peer.VirtualMachineInstanceSelector, *selector) {
+			return peer.Addresses
+		}
+	}
+	return nil
+}
+
+func matchesSelector(policy *networkpolicyv1.VirtualMachineNetworkPolicy, labelSelector *metav1.LabelSelector, vmi *v1.VirtualMachineInstance) bool {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		log.Log.Object(policy).Reason(err).Error("Invalid vmi selector")
+		return false
+	}
+	return selector.Matches(labels.Set(vmi.Labels))
+}
+
+func (c *NetworkPolicyController) listPolicies(namespace string) []*networkpolicyv1.VirtualMachineNetworkPolicy {
+	objs, err := c.policyInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list the network policies of namespace %s", namespace)
+		return nil
+	}
+	policies := make([]*networkpolicyv1.VirtualMachineNetworkPolicy, 0, len(objs))
+	for _, obj := range objs {
+		policies = append(policies, obj.(*networkpolicyv1.VirtualMachineNetworkPolicy))
+	}
+	return policies
+}
+
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *NetworkPolicyController) enqueueVMI(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to get the key of a vmi")
+		return
+	}
+	c.queue.Add(key)
+}
+
+// enqueueNamespaceOf enqueues the local VMIs in the namespace of the object
+func (c *NetworkPolicyController) enqueueNamespaceOf(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	meta, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+	for _, informer := range []cache.SharedIndexInformer{c.vmiSourceInformer, c.vmiTargetInformer} {
+		objs, err := informer.GetIndexer().ByIndex(cache.NamespaceIndex, meta.GetNamespace())
+		if err != nil {
+			log.Log.Reason(err).Errorf("Failed to list the local vmis of namespace %s", meta.GetNamespace())
+			continue
+		}
+		for _, vmi := range objs {
+			c.enqueueVMI(vmi)
+		}
+	}
+}
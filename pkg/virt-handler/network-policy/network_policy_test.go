@@ -0,0 +1,258 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package networkpolicy
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network"
+)
+
+var _ = Describe("Network policy controller", func() {
+	var ctrl *gomock.Controller
+	var mockNetwork *network.MockNetworkHandler
+	var mockIsolationDetector *isolation.MockPodIsolationDetector
+	var mockIsolationResult *isolation.MockIsolationResult
+	var vmiSourceInformer, vmiTargetInformer, policyInformer cache.SharedIndexInformer
+	var recorder *record.FakeRecorder
+	var controller *NetworkPolicyController
+
+	newVMI := func(name string, labels map[string]string) *v1.VirtualMachineInstance {
+		vmi := v1.NewMinimalVMI(name)
+		vmi.Namespace = k8sv1.NamespaceDefault
+		vmi.Labels = labels
+		vmi.Status.Phase = v1.Running
+		vmi.Spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-nad"}}},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+		}
+		return vmi
+	}
+
+	newPolicy := func(spec networkpolicyv1.VirtualMachineNetworkPolicySpec) *networkpolicyv1.VirtualMachineNetworkPolicy {
+		return &networkpolicyv1.VirtualMachineNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: k8sv1.NamespaceDefault},
+			Spec:       spec,
+		}
+	}
+
+	webSelector := metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	allowWeb := networkpolicyv1.VirtualMachineNetworkPolicySpec{
+		VirtualMachineInstanceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+		Ingress: []networkpolicyv1.VirtualMachineNetworkPolicyIngressRule{{
+			From: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{{
+				VirtualMachineInstanceSelector: &webSelector,
+			}},
+		}},
+	}
+
+	withWebPeers := func(policy *networkpolicyv1.VirtualMachineNetworkPolicy, addresses ...string) *networkpolicyv1.VirtualMachineNetworkPolicy {
+		policy = policy.DeepCopy()
+		policy.Status.Peers = []networkpolicyv1.VirtualMachineNetworkPolicyPeerStatus{{
+			VirtualMachineInstanceSelector: webSelector,
+			Addresses:                      addresses,
+		}}
+		return policy
+	}
+
+	addLocalVMI := func(vmi *v1.VirtualMachineInstance) {
+		Expect(vmiSourceInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	expectFirewallLoad := func() *string {
+		var ruleset string
+		mockIsolationDetector.EXPECT().Detect(gomock.Any()).Return(mockIsolationResult, nil)
+		mockIsolationResult.EXPECT().DoNetNS(gomock.Any()).Do(func(f func() error) {
+			Expect(f()).To(Succeed())
+		}).Return(nil)
+		mockNetwork.EXPECT().NftablesLoadRuleset(gomock.Any()).Do(func(loaded string) {
+			ruleset = loaded
+		}).Return(nil)
+		return &ruleset
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockNetwork = network.NewMockNetworkHandler(ctrl)
+		network.Handler = mockNetwork
+		mockIsolationDetector = isolation.NewMockPodIsolationDetector(ctrl)
+		mockIsolationResult = isolation.NewMockIsolationResult(ctrl)
+		mockIsolationResult.EXPECT().Pid().Return(1234).AnyTimes()
+
+		indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+		vmiSourceInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, indexers)
+		vmiTargetInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, indexers)
+		policyInformer, _ = testutils.NewFakeInformerWithIndexersFor(&networkpolicyv1.VirtualMachineNetworkPolicy{}, indexers)
+		recorder = record.NewFakeRecorder(10)
+		controller = NewNetworkPolicyController(vmiSourceInformer, vmiTargetInformer, policyInformer, mockIsolationDetector, recorder)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should not touch the launcher pod of vmis no policy selects", func() {
+		addLocalVMI(newVMI("db", map[string]string{"app": "other"}))
+		Expect(policyInformer.GetStore().Add(newPolicy(allowWeb))).To(Succeed())
+		Expect(controller.execute("default/db")).To(Succeed())
+	})
+
+	It("should allow the peer addresses of the policy status and reload them when they change", func() {
+		addLocalVMI(newVMI("db", map[string]string{"app": "db"}))
+		policy := newPolicy(allowWeb)
+		Expect(policyInformer.GetStore().Add(withWebPeers(policy, "10.20.0.5/32", "fd00:20::5/128"))).To(Succeed())
+
+		ruleset := expectFirewallLoad()
+		Expect(controller.execute("default/db")).To(Succeed())
+		Expect(*ruleset).To(ContainSubstring(`oifname "tap1" jump ingress-net1`))
+		Expect(*ruleset).To(ContainSubstring("ip saddr { 10.20.0.5/32 } accept"))
+		Expect(*ruleset).To(ContainSubstring("ip6 saddr { fd00:20::5/128 } accept"))
+		Expect(*ruleset).ToNot(ContainSubstring("egress-net1"))
+
+		By("not reloading an unchanged firewall")
+		mockIsolationDetector.EXPECT().Detect(gomock.Any()).Return(mockIsolationResult, nil)
+		Expect(controller.execute("default/db")).To(Succeed())
+
+		By("reloading the firewall when the peer gets a new address")
+		Expect(policyInformer.GetStore().Update(withWebPeers(policy, "10.20.0.6/32"))).To(Succeed())
+		ruleset = expectFirewallLoad()
+		Expect(controller.execute("default/db")).To(Succeed())
+		Expect(*ruleset).To(ContainSubstring("ip saddr { 10.20.0.6/32 } accept"))
+	})
+
+	It("should remove the firewall when the policy is deleted", func() {
+		addLocalVMI(newVMI("db", map[string]string{"app": "db"}))
+		policy := newPolicy(allowWeb)
+		Expect(policyInformer.GetStore().Add(policy)).To(Succeed())
+
+		ruleset := expectFirewallLoad()
+		Expect(controller.execute("default/db")).To(Succeed())
+		Expect(*ruleset).To(ContainSubstring("table bridge kubevirt-network-policy {"))
+
+		Expect(policyInformer.GetStore().Delete(policy)).To(Succeed())
+		ruleset = expectFirewallLoad()
+		Expect(controller.execute("default/db")).To(Succeed())
+		Expect(*ruleset).ToNot(ContainSubstring("table bridge kubevirt-network-policy {"))
+
+		By("not reloading the firewall once it was removed")
+		Expect(controller.execute("default/db")).To(Succeed())
+	})
+
+	It("should limit the policy to the listed networks", func() {
+		vmi := newVMI("db", map[string]string{"app": "db"})
+		vmi.Spec.Networks = append(vmi.Spec.Networks, v1.Network{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-nad"}}})
+		vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Macvtap: &v1.InterfaceMacvtap{}}})
+		addLocalVMI(vmi)
+		spec := allowWeb.DeepCopy()
+		spec.Networks = []string{"red"}
+		Expect(policyInformer.GetStore().Add(newPolicy(*spec))).To(Succeed())
+
+		ruleset := expectFirewallLoad()
+		Expect(controller.execute("default/db")).To(Succeed())
+		Expect(*ruleset).To(ContainSubstring(`type filter hook ingress device "net2"`))
+		Expect(*ruleset).ToNot(ContainSubstring("net1"))
+	})
+
+	It("should only isolate the egress of macvtap interfaces when the egress hook is supported", func() {
+		vmi := newVMI("db", map[string]string{"app": "db"})
+		vmi.Spec.Networks = append(vmi.Spec.Networks, v1.Network{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-nad"}}})
+		vmi.Spec.Domain.Devices.Interfaces = append(vmi.Spec.Domain.Devices.Interfaces, v1.Interface{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Macvtap: &v1.InterfaceMacvtap{}}})
+		addLocalVMI(vmi)
+		Expect(policyInformer.GetStore().Add(newPolicy(networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Networks:    []string{"red"},
+			PolicyTypes: []networkpolicyv1.PolicyType{networkpolicyv1.PolicyTypeEgress},
+		}))).To(Succeed())
+
+		mockNetwork.EXPECT().NftablesCheckRuleset(gomock.Any()).Return(fmt.Errorf("unsupported"))
+		ruleset := expectFirewallLoad()
+		mockIsolationResult.EXPECT().DoNetNS(gomock.Any()).Do(func(f func() error) {
+			Expect(f()).To(Succeed())
+		}).Return(nil)
+		Expect(controller.execute("default/db")).To(Succeed())
+		Expect(*ruleset).ToNot(ContainSubstring("egress-net2"))
+		testutils.ExpectEvent(recorder, EgressNotIsolatedReason)
+
+		By("probing the egress hook only once")
+		Expect(policyInformer.GetStore().Update(newPolicy(networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Networks:    []string{"red"},
+			PolicyTypes: []networkpolicyv1.PolicyType{networkpolicyv1.PolicyTypeIngress, networkpolicyv1.PolicyTypeEgress},
+		}))).To(Succeed())
+		ruleset = expectFirewallLoad()
+		Expect(controller.execute("default/db")).To(Succeed())
+		Expect(*ruleset).To(ContainSubstring("ingress-net2"))
+		Expect(*ruleset).ToNot(ContainSubstring("egress-net2"))
+		testutils.ExpectEvent(recorder, EgressNotIsolatedReason)
+
+		By("not warning again while the firewall is unchanged")
+		mockIsolationDetector.EXPECT().Detect(gomock.Any()).Return(mockIsolationResult, nil)
+		Expect(controller.execute("default/db")).To(Succeed())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should expand the rules into a rule per peer and port", func() {
+		udp := k8sv1.ProtocolUDP
+		port := int32(53)
+		policy := newPolicy(networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Egress: []networkpolicyv1.VirtualMachineNetworkPolicyEgressRule{{
+				Ports: []networkpolicyv1.VirtualMachineNetworkPolicyPort{{Port: &port}, {Protocol: &udp, Port: &port}},
+				To: []networkpolicyv1.VirtualMachineNetworkPolicyPeer{
+					{IPBlock: &networkpolicyv1.IPBlock{CIDR: "10.0.0.0/8", Except: []string{"10.1.0.0/16"}}},
+					{VirtualMachineInstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "none"}}},
+				},
+			}},
+		})
+		Expect(controller.getFirewallRules(policy, policy.Spec.Egress[0].Ports, policy.Spec.Egress[0].To)).To(ConsistOf(
+			network.FirewallRule{CIDRs: []string{"10.0.0.0/8"}, Except: []string{"10.1.0.0/16"}, Protocol: "tcp", Port: 53},
+			network.FirewallRule{CIDRs: []string{"10.0.0.0/8"}, Except: []string{"10.1.0.0/16"}, Protocol: "udp", Port: 53},
+		))
+	})
+
+	table.DescribeTable("should isolate the directions of the policy types", func(spec networkpolicyv1.VirtualMachineNetworkPolicySpec, ingress, egress bool) {
+		isolateIngress, isolateEgress := getPolicyTypes(&spec)
+		Expect(isolateIngress).To(Equal(ingress))
+		Expect(isolateEgress).To(Equal(egress))
+	},
+		table.Entry("ingress by default", networkpolicyv1.VirtualMachineNetworkPolicySpec{}, true, false),
+		table.Entry("egress by default with egress rules", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			Egress: []networkpolicyv1.VirtualMachineNetworkPolicyEgressRule{{}},
+		}, true, true),
+		table.Entry("only the listed types", networkpolicyv1.VirtualMachineNetworkPolicySpec{
+			PolicyTypes: []networkpolicyv1.PolicyType{networkpolicyv1.PolicyTypeEgress},
+		}, false, true),
+	)
+})
@@ -0,0 +1,199 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+)
+
+const (
+	domCapabilitiesFile   = "virsh_domcapabilities.xml"
+	capabilitiesFile      = "capabilities.xml"
+	supportedFeaturesFile = "supported_features.xml"
+)
+
+// DomCapabilities represents the output of virConnectGetDomainCapabilities
+type DomCapabilities struct {
+	XMLName  xml.Name `xml:"domainCapabilities"`
+	Machine  string   `xml:"machine"`
+	Arch     string   `xml:"arch"`
+	CPU      CPU      `xml:"cpu"`
+	Features Features `xml:"features"`
+}
+
+type CPU struct {
+	Modes []Mode `xml:"mode"`
+}
+
+type Mode struct {
+	Name      string       `xml:"name,attr"`
+	Supported string       `xml:"supported,attr"`
+	Model     []Model      `xml:"model"`
+	Vendor    string       `xml:"vendor"`
+	Feature   []CPUFeature `xml:"feature"`
+}
+
+type Model struct {
+	Name     string `xml:",chardata"`
+	Usable   string `xml:"usable,attr"`
+	Fallback string `xml:"fallback,attr"`
+}
+
+type CPUFeature struct {
+	Name   string `xml:"name,attr"`
+	Policy string `xml:"policy,attr"`
+}
+
+type Features struct {
+	Hyperv Hyperv `xml:"hyperv"`
+}
+
+type Hyperv struct {
+	Supported string `xml:"supported,attr"`
+	Enums     []Enum `xml:"enum"`
+}
+
+type Enum struct {
+	Name   string   `xml:"name,attr"`
+	Values []string `xml:"value"`
+}
+
+// Capabilities represents the output of virConnectGetCapabilities
+type Capabilities struct {
+	XMLName xml.Name `xml:"capabilities"`
+	Guests  []Guest  `xml:"guest"`
+}
+
+type Guest struct {
+	OSType string    `xml:"os_type"`
+	Arch   GuestArch `xml:"arch"`
+}
+
+type GuestArch struct {
+	Name     string    `xml:"name,attr"`
+	Machines []Machine `xml:"machine"`
+}
+
+type Machine struct {
+	Name      string `xml:",chardata"`
+	Canonical string `xml:"canonical,attr"`
+}
+
+// SupportedFeatures represents the expanded host-model CPU returned by
+// virConnectBaselineHypervisorCPU
+type SupportedFeatures struct {
+	XMLName xml.Name     `xml:"cpu"`
+	Model   string       `xml:"model"`
+	Feature []CPUFeature `xml:"feature"`
+}
+
+// hostCPU holds everything the labeller learned about the CPUs of the node
+type hostCPU struct {
+	// usable CPU models, including obsolete ones
+	models []string
+	// host-model CPU and the features required on top of it
+	hostModel        string
+	requiredFeatures []string
+	// all features supported by the host-model CPU
+	features []string
+	// supported Hyper-V enlightenments
+	hypervFeatures []string
+	// machine types supported by the hypervisor
+	machineTypes []string
+}
+
+func loadXML(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := xml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("failed to parse %s: %v", path, err)
+	}
+	return nil
+}
+
+// loadHostCPU parses the files written by the node-labeller init container
+func loadHostCPU(volumePath string) (*hostCPU, error) {
+	domCapabilities := &DomCapabilities{}
+	if err := loadXML(filepath.Join(volumePath, domCapabilitiesFile), domCapabilities); err != nil {
+		return nil, err
+	}
+	capabilities := &Capabilities{}
+	if err := loadXML(filepath.Join(volumePath, capabilitiesFile), capabilities); err != nil {
+		return nil, err
+	}
+	supportedFeatures := &SupportedFeatures{}
+	if err := loadXML(filepath.Join(volumePath, supportedFeaturesFile), supportedFeatures); err != nil {
+		return nil, err
+	}
+
+	host := &hostCPU{}
+	for _, mode := range domCapabilities.CPU.Modes {
+		if mode.Supported != "yes" {
+			continue
+		}
+		switch mode.Name {
+		case "custom":
+			for _, model := range mode.Model {
+				if model.Usable == "yes" {
+					host.models = append(host.models, model.Name)
+				}
+			}
+		case "host-model":
+			if len(mode.Model) > 0 {
+				host.hostModel = mode.Model[0].Name
+			}
+			for _, feature := range mode.Feature {
+				if feature.Policy == "require" {
+					host.requiredFeatures = append(host.requiredFeatures, feature.Name)
+				}
+			}
+		}
+	}
+
+	for _, feature := range supportedFeatures.Feature {
+		if feature.Policy == "" || feature.Policy == "require" {
+			host.features = append(host.features, feature.Name)
+		}
+	}
+
+	if domCapabilities.Features.Hyperv.Supported == "yes" {
+		for _, enum := range domCapabilities.Features.Hyperv.Enums {
+			if enum.Name == "features" {
+				host.hypervFeatures = append(host.hypervFeatures, enum.Values...)
+			}
+		}
+	}
+
+	for _, guest := range capabilities.Guests {
+		if guest.OSType != "hvm" || guest.Arch.Name != domCapabilities.Arch {
+			continue
+		}
+		for _, machine := range guest.Arch.Machines {
+			host.machineTypes = append(host.machineTypes, machine.Name)
+		}
+	}
+
+	return host, nil
+}
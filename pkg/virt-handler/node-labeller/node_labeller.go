@@ -0,0 +1,252 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// NodeLabellerVolumePath is the directory in which the node-labeller init
+	// container stores the capabilities reported by libvirt
+	NodeLabellerVolumePath = "/var/lib/kubevirt-node-labeller"
+
+	// labels are re-applied periodically, in case they got removed from the node
+	labellerResyncPeriod = 3 * time.Minute
+)
+
+// libvirt and the VMI API use different names for some Hyper-V enlightenments
+var hypervLabelNames = map[string]string{
+	"stimer": "synictimer",
+}
+
+// labellerPrefixes are the label prefixes owned by the node labeller. Labels
+// with these prefixes which are not reported by libvirt anymore are removed.
+var labellerPrefixes = []string{
+	v1.CPUModelLabel,
+	v1.CPUFeatureLabel,
+	v1.HostModelCPULabel,
+	v1.HostModelRequiredFeaturesLabel,
+	v1.SupportedHostModelMigrationCPU,
+	v1.HypervLabel,
+	v1.SupportedMachineTypeLabel,
+	v1.NodeHostModelIsObsoleteLabel,
+}
+
+// NodeLabeller labels the node virt-handler runs on with the CPU models,
+// CPU features, Hyper-V enlightenments and machine types libvirt supports there.
+type NodeLabeller struct {
+	clientset     kubecli.KubevirtClient
+	clusterConfig *virtconfig.ClusterConfig
+	host          string
+	logger        *log.FilteredLogger
+	hostCPU       *hostCPU
+	queue         workqueue.RateLimitingInterface
+}
+
+func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, clientset kubecli.KubevirtClient, host string) (*NodeLabeller, error) {
+	return newNodeLabeller(clusterConfig, clientset, host, NodeLabellerVolumePath)
+}
+
+func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, clientset kubecli.KubevirtClient, host string, volumePath string) (*NodeLabeller, error) {
+	hostCPU, err := loadHostCPU(volumePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the capabilities of the node: %v", err)
+	}
+
+	n := &NodeLabeller{
+		clientset:     clientset,
+		clusterConfig: clusterConfig,
+		host:          host,
+		logger:        log.DefaultLogger(),
+		hostCPU:       hostCPU,
+		queue:         workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+	// the set of obsolete CPU models may have changed
+	clusterConfig.SetConfigModifiedCallback(func() {
+		n.queue.Add(n.host)
+	})
+
+	return n, nil
+}
+
+// Run labels the node and keeps the labels up to date until stop is closed
+func (n *NodeLabeller) Run(threadiness int, stop chan struct{}) {
+	defer controller.HandlePanic()
+	defer n.queue.ShutDown()
+	n.logger.Info("Starting node labeller")
+
+	go wait.Until(func() {
+		n.queue.Add(n.host)
+	}, labellerResyncPeriod, stop)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(n.runWorker, time.Second, stop)
+	}
+
+	<-stop
+	n.logger.Info("Stopping node labeller")
+}
+
+func (n *NodeLabeller) runWorker() {
+	for n.Execute() {
+	}
+}
+
+func (n *NodeLabeller) Execute() bool {
+	key, quit := n.queue.Get()
+	if quit {
+		return false
+	}
+	defer n.queue.Done(key)
+
+	if err := n.execute(); err != nil {
+		n.logger.Reason(err).Errorf("Failed to label node %s, reenqueuing", n.host)
+		n.queue.AddRateLimited(key)
+	} else {
+		n.queue.Forget(key)
+	}
+	return true
+}
+
+func (n *NodeLabeller) execute() error {
+	node, err := n.clientset.CoreV1().Nodes().Get(context.Background(), n.host, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if skip, _ := strconv.ParseBool(node.Annotations[v1.LabellerSkipNodeAnnotation]); skip {
+		n.logger.V(4).Infof("Node %s is annotated with %s, not labelling it", n.host, v1.LabellerSkipNodeAnnotation)
+		return nil
+	}
+
+	return n.patchNode(node, n.prepareLabels(n.clusterConfig.GetObsoleteCPUModels()))
+}
+
+// prepareLabels returns all labels the node should carry
+func (n *NodeLabeller) prepareLabels(obsoleteCPUModels map[string]bool) map[string]string {
+	labels := make(map[string]string)
+	addLabel := func(key string) {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			n.logger.V(4).Infof("Not adding invalid label %s: %s", key, strings.Join(errs, ", "))
+			return
+		}
+		labels[key] = "true"
+	}
+
+	for _, model := range n.hostCPU.models {
+		if obsoleteCPUModels[model] {
+			continue
+		}
+		addLabel(v1.CPUModelLabel + model)
+		addLabel(v1.SupportedHostModelMigrationCPU + model)
+	}
+
+	for _, feature := range n.hostCPU.features {
+		addLabel(v1.CPUFeatureLabel + feature)
+	}
+
+	if hostModel := n.hostCPU.hostModel; hostModel != "" {
+		if obsoleteCPUModels[hostModel] {
+			addLabel(v1.NodeHostModelIsObsoleteLabel)
+		} else {
+			addLabel(v1.HostModelCPULabel + hostModel)
+			addLabel(v1.SupportedHostModelMigrationCPU + hostModel)
+			for _, feature := range n.hostCPU.requiredFeatures {
+				addLabel(v1.HostModelRequiredFeaturesLabel + feature)
+			}
+		}
+	}
+
+	for _, feature := range n.hostCPU.hypervFeatures {
+		if name, exists := hypervLabelNames[feature]; exists {
+			feature = name
+		}
+		addLabel(v1.HypervLabel + feature)
+	}
+
+	for _, machineType := range n.hostCPU.machineTypes {
+		addLabel(v1.SupportedMachineTypeLabel + machineType)
+	}
+
+	return labels
+}
+
+func isLabellerLabel(key string) bool {
+	for _, prefix := range labellerPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// patchNode adds the missing labels and removes the stale labels of the node labeller
+func (n *NodeLabeller) patchNode(node *k8sv1.Node, labels map[string]string) error {
+	patch := make(map[string]interface{})
+	for key := range node.Labels {
+		if _, exists := labels[key]; !exists && isLabellerLabel(key) {
+			patch[key] = nil
+		}
+	}
+	for key, value := range labels {
+		if current, exists := node.Labels[key]; !exists || current != value {
+			patch[key] = value
+		}
+	}
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": patch,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = n.clientset.CoreV1().Nodes().Patch(context.Background(), node.Name, types.MergePatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to patch the labels of node %s: %v", node.Name, err)
+	}
+	n.logger.V(4).Infof("Updated %d labels of node %s", len(patch), node.Name)
+	return nil
+}
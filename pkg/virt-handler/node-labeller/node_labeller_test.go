@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Node-labeller", func() {
+	const nodeName = "testnode"
+
+	var ctrl *gomock.Controller
+	var kubeClient *fake.Clientset
+	var labeller *NodeLabeller
+
+	newNode := func(labels, annotations map[string]string) *k8sv1.Node {
+		return &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        nodeName,
+				Labels:      labels,
+				Annotations: annotations,
+			},
+		}
+	}
+
+	getNodeLabels := func() map[string]string {
+		node, err := kubeClient.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node.Labels
+	}
+
+	setup := func(node *k8sv1.Node, cfgMap *k8sv1.ConfigMap) {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset(node)
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		clusterConfig, _, _, _ := testutils.NewFakeClusterConfig(cfgMap)
+
+		var err error
+		labeller, err = newNodeLabeller(clusterConfig, virtClient, nodeName, "testdata")
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should label the node with usable, non-obsolete CPU models", func() {
+		setup(newNode(nil, nil), &k8sv1.ConfigMap{})
+		Expect(labeller.execute()).To(Succeed())
+
+		labels := getNodeLabels()
+		Expect(labels).To(HaveKeyWithValue(v1.CPUModelLabel+"Penryn", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.CPUModelLabel+"IvyBridge", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.SupportedHostModelMigrationCPU+"IvyBridge", "true"))
+		Expect(labels).ToNot(HaveKey(v1.CPUModelLabel + "EPYC"))
+		Expect(labels).ToNot(HaveKey(v1.CPUModelLabel + "qemu64"))
+		Expect(labels).ToNot(HaveKey(v1.CPUModelLabel + "Conroe"))
+	})
+
+	It("should respect the configured obsolete CPU models", func() {
+		setup(newNode(nil, nil), &k8sv1.ConfigMap{
+			Data: map[string]string{virtconfig.ObsoleteCPUModelsKey: "Penryn"},
+		})
+		Expect(labeller.execute()).To(Succeed())
+
+		labels := getNodeLabels()
+		Expect(labels).ToNot(HaveKey(v1.CPUModelLabel + "Penryn"))
+		Expect(labels).To(HaveKeyWithValue(v1.CPUModelLabel+"qemu64", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.CPUModelLabel+"Conroe", "true"))
+	})
+
+	It("should label the node with the host-model CPU and its features", func() {
+		setup(newNode(nil, nil), &k8sv1.ConfigMap{})
+		Expect(labeller.execute()).To(Succeed())
+
+		labels := getNodeLabels()
+		Expect(labels).To(HaveKeyWithValue(v1.HostModelCPULabel+"Skylake-Client-IBRS", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.SupportedHostModelMigrationCPU+"Skylake-Client-IBRS", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.HostModelRequiredFeaturesLabel+"vmx", "true"))
+		Expect(labels).ToNot(HaveKey(v1.HostModelRequiredFeaturesLabel + "mpx"))
+		Expect(labels).To(HaveKeyWithValue(v1.CPUFeatureLabel+"avx2", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.CPUFeatureLabel+"vmx", "true"))
+		Expect(labels).ToNot(HaveKey(v1.CPUFeatureLabel + "mpx"))
+		Expect(labels).ToNot(HaveKey(v1.NodeHostModelIsObsoleteLabel))
+	})
+
+	It("should mark the node if its host-model CPU is obsolete", func() {
+		setup(newNode(nil, nil), &k8sv1.ConfigMap{
+			Data: map[string]string{virtconfig.ObsoleteCPUModelsKey: "Skylake-Client-IBRS"},
+		})
+		Expect(labeller.execute()).To(Succeed())
+
+		labels := getNodeLabels()
+		Expect(labels).To(HaveKeyWithValue(v1.NodeHostModelIsObsoleteLabel, "true"))
+		Expect(labels).ToNot(HaveKey(v1.HostModelCPULabel + "Skylake-Client-IBRS"))
+		Expect(labels).ToNot(HaveKey(v1.HostModelRequiredFeaturesLabel + "vmx"))
+	})
+
+	It("should label the node with Hyper-V enlightenments and machine types", func() {
+		setup(newNode(nil, nil), &k8sv1.ConfigMap{})
+		Expect(labeller.execute()).To(Succeed())
+
+		labels := getNodeLabels()
+		Expect(labels).To(HaveKeyWithValue(v1.HypervLabel+"synic", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.HypervLabel+"synictimer", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.HypervLabel+"ipi", "true"))
+		Expect(labels).ToNot(HaveKey(v1.HypervLabel + "stimer"))
+		Expect(labels).To(HaveKeyWithValue(v1.SupportedMachineTypeLabel+"q35", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.SupportedMachineTypeLabel+"pc-q35-rhel8.3.0", "true"))
+	})
+
+	It("should remove stale labels and keep foreign ones", func() {
+		setup(newNode(map[string]string{
+			v1.CPUModelLabel + "Haswell":  "true",
+			v1.HypervLabel + "evmcs":      "true",
+			v1.NodeSchedulable:            "true",
+			"kubernetes.io/hostname":      nodeName,
+			v1.CPUModelLabel + "Penryn":   "true",
+			v1.CPUFeatureLabel + "avx512": "true",
+		}, nil), &k8sv1.ConfigMap{})
+		Expect(labeller.execute()).To(Succeed())
+
+		labels := getNodeLabels()
+		Expect(labels).ToNot(HaveKey(v1.CPUModelLabel + "Haswell"))
+		Expect(labels).ToNot(HaveKey(v1.HypervLabel + "evmcs"))
+		Expect(labels).ToNot(HaveKey(v1.CPUFeatureLabel + "avx512"))
+		Expect(labels).To(HaveKeyWithValue(v1.CPUModelLabel+"Penryn", "true"))
+		Expect(labels).To(HaveKeyWithValue(v1.NodeSchedulable, "true"))
+		Expect(labels).To(HaveKeyWithValue("kubernetes.io/hostname", nodeName))
+	})
+
+	It("should not touch nodes annotated to be skipped", func() {
+		setup(newNode(map[string]string{
+			v1.CPUModelLabel + "Haswell": "true",
+		}, map[string]string{
+			v1.LabellerSkipNodeAnnotation: "true",
+		}), &k8sv1.ConfigMap{})
+		Expect(labeller.execute()).To(Succeed())
+
+		labels := getNodeLabels()
+		Expect(labels).To(HaveLen(1))
+		Expect(labels).To(HaveKeyWithValue(v1.CPUModelLabel+"Haswell", "true"))
+	})
+
+	It("should fail if the capabilities of the node are missing", func() {
+		clusterConfig, _, _, _ := testutils.NewFakeClusterConfig(&k8sv1.ConfigMap{})
+		ctrl = gomock.NewController(GinkgoT())
+		_, err := newNodeLabeller(clusterConfig, kubecli.NewMockKubevirtClient(ctrl), nodeName, "nonexistent")
+		Expect(err).To(HaveOccurred())
+	})
+})
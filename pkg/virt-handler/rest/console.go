@@ -20,6 +20,7 @@
 package rest
 
 import (
+	"fmt"
 	"io"
 	"net"
 	"net/http"
@@ -76,7 +77,7 @@ func (t *ConsoleHandler) VNCHandler(request *restful.Request, response *restful.
 	cleanup := func() {
 		deleteStopChan(uid, stopChn, t.vncLock, t.vncStopChans)
 	}
-	t.stream(vmi, request, response, unixSocketPath, stopChn, cleanup)
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChn, cleanup)
 }
 
 func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restful.Response) {
@@ -97,7 +98,86 @@ func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restf
 	cleanup := func() {
 		deleteStopChan(uid, stopCh, t.serialLock, t.serialStopChans)
 	}
-	t.stream(vmi, request, response, unixSocketPath, stopCh, cleanup)
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopCh, cleanup)
+}
+
+func (t *ConsoleHandler) PortForwardHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to retrieve VMI")
+		response.WriteError(code, err)
+		return
+	}
+
+	port, err := strconv.Atoi(request.PathParameter("port"))
+	if err != nil || port < 1 || port > 65535 {
+		err = fmt.Errorf("invalid port %q", request.PathParameter("port"))
+		log.Log.Object(vmi).Reason(err).Error("Failed to parse port for port-forward")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	protocol := "tcp"
+	if p := request.PathParameter("protocol"); p != "" {
+		protocol = p
+	}
+	if protocol != "tcp" && protocol != "udp" {
+		err = fmt.Errorf("unsupported protocol %q", protocol)
+		log.Log.Object(vmi).Reason(err).Error("Failed to parse protocol for port-forward")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	result, err := t.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect the network namespace of the VMI")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	// several port-forward connections may be open at the same time, they are never stopped by a newer one
+	t.stream(vmi, request, response, netNSDialer(vmi, result, protocol, port), nil, func() {})
+}
+
+func (t *ConsoleHandler) NBDHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to retrieve VMI")
+		response.WriteError(code, err)
+		return
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-backup-nbd")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for the NBD export of the backup")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+
+	// a backup tool reads the disks of a pull backup over several connections at the same time
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), nil, func() {})
+}
+
+// netNSDialer dials the given port on localhost inside the network namespace of the virt-launcher pod
+func netNSDialer(vmi *v1.VirtualMachineInstance, result isolation.IsolationResult, protocol string, port int) dialer {
+	return func() (net.Conn, error) {
+		address := net.JoinHostPort("localhost", strconv.Itoa(port))
+		log.Log.Object(vmi).Infof("Connecting to %s/%s", protocol, address)
+
+		var conn net.Conn
+		err := result.DoNetNS(func() error {
+			var err error
+			conn, err = net.Dial(protocol, address)
+			return err
+		})
+		return conn, err
+	}
+}
+
+func unixSocketDialer(vmi *v1.VirtualMachineInstance, unixSocketPath string) dialer {
+	return func() (net.Conn, error) {
+		log.Log.Object(vmi).Infof("Connecting to %s", unixSocketPath)
+		return net.Dial("unix", unixSocketPath)
+	}
 }
 
 func newStopChan(uid types.UID, lock *sync.Mutex, stopChans map[types.UID](chan struct{})) chan struct{} {
@@ -139,7 +219,9 @@ func (t *ConsoleHandler) getUnixSocketPath(vmi *v1.VirtualMachineInstance, socke
 
 type cleanupOnError func()
 
-func (t *ConsoleHandler) stream(vmi *v1.VirtualMachineInstance, request *restful.Request, response *restful.Response, unixSocketPath string, stopCh chan struct{}, cleanup cleanupOnError) {
+type dialer func() (net.Conn, error)
+
+func (t *ConsoleHandler) stream(vmi *v1.VirtualMachineInstance, request *restful.Request, response *restful.Response, dial dialer, stopCh chan struct{}, cleanup cleanupOnError) {
 	var upgrader = kubecli.NewUpgrader()
 	clientSocket, err := upgrader.Upgrade(response.ResponseWriter, request.Request, nil)
 	if err != nil {
@@ -150,22 +232,21 @@ func (t *ConsoleHandler) stream(vmi *v1.VirtualMachineInstance, request *restful
 	defer clientSocket.Close()
 
 	log.Log.Object(vmi).Infof("Websocket connection upgraded")
-	log.Log.Object(vmi).Infof("Connecting to %s", unixSocketPath)
 
-	fd, err := net.Dial("unix", unixSocketPath)
+	fd, err := dial()
 	if err != nil {
-		log.Log.Object(vmi).Reason(err).Errorf("failed to dial unix socket %s", unixSocketPath)
+		log.Log.Object(vmi).Reason(err).Error("failed to dial the VMI stream")
 		response.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 	defer fd.Close()
 
-	log.Log.Object(vmi).Infof("Connected to %s", unixSocketPath)
+	log.Log.Object(vmi).Infof("Connected to %s", fd.RemoteAddr())
 
 	errCh := make(chan error)
 	go func() {
 		_, err := kubecli.CopyTo(clientSocket, fd)
-		log.Log.Object(vmi).Reason(err).Error("error encountered reading from unix socket")
+		log.Log.Object(vmi).Reason(err).Error("error encountered reading from the VMI stream")
 		errCh <- err
 	}()
 
@@ -180,7 +261,7 @@ func (t *ConsoleHandler) stream(vmi *v1.VirtualMachineInstance, request *restful
 		break
 	case err := <-errCh:
 		if err != nil && err != io.EOF {
-			log.Log.Object(vmi).Reason(err).Error("Error in proxing websocket and unix socket")
+			log.Log.Object(vmi).Reason(err).Error("Error in proxing websocket and the VMI stream")
 			response.WriteHeader(http.StatusInternalServerError)
 		}
 
@@ -19,14 +19,18 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/emicklei/go-restful"
 
+	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/tools/cache"
 
+	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/log"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	hotplug_volume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
 )
 
 type LifecycleHandler struct {
@@ -97,6 +101,252 @@ func (lh *LifecycleHandler) UnpauseHandler(request *restful.Request, response *r
 	response.WriteHeader(http.StatusAccepted)
 }
 
+func (lh *LifecycleHandler) FreezeHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, lh.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to retrieve VMI")
+		response.WriteError(code, err)
+		return
+	}
+
+	unfreezeTimeout := &v1.FreezeUnfreezeTimeout{}
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Reason(err).Error("No unfreeze timeout in freeze request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve unfreeze timeout"))
+		return
+	}
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(unfreezeTimeout)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal unfreeze timeout")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	if unfreezeTimeout.UnfreezeTimeout == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("unfreeze timeout not set"))
+		return
+	}
+
+	sockFile, err := cmdclient.FindSocketOnHost(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	client, err := cmdclient.NewClient(sockFile)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to connect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	unfreezeTimeoutSeconds := int32(unfreezeTimeout.UnfreezeTimeout.Seconds())
+	err = client.FreezeVirtualMachine(vmi, unfreezeTimeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to freeze VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) UnfreezeHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, lh.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to retrieve VMI")
+		response.WriteError(code, err)
+		return
+	}
+
+	sockFile, err := cmdclient.FindSocketOnHost(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	client, err := cmdclient.NewClient(sockFile)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to connect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	err = client.UnfreezeVirtualMachine(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unfreeze VMI")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) BackupHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, lh.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to retrieve VMI")
+		response.WriteError(code, err)
+		return
+	}
+
+	options := &v1.VirtualMachineInstanceBackupOptions{}
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Reason(err).Error("No options in backup request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve backup options"))
+		return
+	}
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal backup options")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	if options.BackupName == "" || options.Checkpoint == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("backup name and checkpoint must be set"))
+		return
+	}
+	if options.Mode == v1.BackupModePush && options.Target == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("a push backup needs a target"))
+		return
+	}
+
+	sockFile, err := cmdclient.FindSocketOnHost(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	client, err := cmdclient.NewClient(sockFile)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to connect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	if options.Mode == v1.BackupModePush {
+		err = hotplug_volume.MountBackupTarget(vmi, options.BackupName, options.Target.PodUID)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("Failed to mount the target of backup %s", options.BackupName)
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	err = client.BackupVirtualMachine(vmi, options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to start backup %s", options.BackupName)
+		if options.Mode == v1.BackupModePush {
+			if err := hotplug_volume.UnmountBackupTarget(vmi, options.BackupName); err != nil {
+				log.Log.Object(vmi).Reason(err).Errorf("Failed to unmount the target of backup %s", options.BackupName)
+			}
+		}
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) AbortBackupHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, lh.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to retrieve VMI")
+		response.WriteError(code, err)
+		return
+	}
+
+	options := &v1.VirtualMachineInstanceAbortBackupOptions{}
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Reason(err).Error("No options in abort backup request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve abort backup options"))
+		return
+	}
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal abort backup options")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	if options.BackupName == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("backup name must be set"))
+		return
+	}
+
+	sockFile, err := cmdclient.FindSocketOnHost(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	client, err := cmdclient.NewClient(sockFile)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to connect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	err = client.AbortVirtualMachineBackup(vmi, options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to abort backup %s", options.BackupName)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) SetBlockIOTuneHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, lh.vmiInformer)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to retrieve VMI")
+		response.WriteError(code, err)
+		return
+	}
+
+	options := &v1.SetBlockIOTuneOptions{}
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Reason(err).Error("No options in block I/O tune request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve block I/O tune options"))
+		return
+	}
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal block I/O tune options")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	if options.Name == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("disk name must be set"))
+		return
+	}
+
+	sockFile, err := cmdclient.FindSocketOnHost(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to detect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	client, err := cmdclient.NewClient(sockFile)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to connect cmd client")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	err = client.SetVirtualMachineBlockIOTune(vmi, options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to set the I/O limits of disk %s", options.Name)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
 func (lh *LifecycleHandler) GetGuestInfo(request *restful.Request, response *restful.Response) {
 	log.Log.Info("Retreiving guestinfo")
 	vmi, code, err := getVMI(request, lh.vmiInformer)
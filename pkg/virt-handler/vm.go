@@ -39,6 +39,7 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -69,6 +70,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/sriov"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network"
 	"kubevirt.io/kubevirt/pkg/watchdog"
 )
@@ -149,6 +151,7 @@ func NewController(
 	c.launcherClients = make(map[types.UID]*launcherClientInfo)
 	c.phase1NetworkSetupCache = make(map[types.UID]int)
 	c.podInterfaceCache = make(map[string]*network.PodCacheInterface)
+	c.sizedVolumeCapacities = make(map[types.UID]map[string]resource.Quantity)
 
 	c.domainNotifyPipes = make(map[string]string)
 
@@ -192,6 +195,10 @@ type VirtualMachineController struct {
 	podInterfaceCache     map[string]*network.PodCacheInterface
 	podInterfaceCacheLock sync.Mutex
 
+	// records the claim capacities the disks of a VMI are sized to
+	sizedVolumeCapacities     map[types.UID]map[string]resource.Quantity
+	sizedVolumeCapacitiesLock sync.Mutex
+
 	domainNotifyPipes map[string]string
 }
 
@@ -455,6 +462,42 @@ func (d *VirtualMachineController) setPodNetworkPhase1(vmi *v1.VirtualMachineIns
 	return false, nil
 }
 
+// setHotplugPodNetworkPhase1 prepares the pod side of interfaces whose network was attached
+// to the pod while the VMI is running. Interfaces prepared before are skipped by their cache.
+func (d *VirtualMachineController) setHotplugPodNetworkPhase1(vmi *v1.VirtualMachineInstance) error {
+	res, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to detect isolation for launcher pod: %v", err)
+	}
+	pid := res.Pid()
+	return res.DoNetNS(func() error { return network.SetupPodNetworkPhase1(vmi, pid) })
+}
+
+// updateHotplugInterfaceStatus marks hotplugged interfaces as ready once they are plugged into
+// the domain, and as detached once they are removed from both the VMI spec and the domain.
+func updateHotplugInterfaceStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	domainInterfaces := map[string]bool{}
+	for _, iface := range domain.Spec.Devices.Interfaces {
+		domainInterfaces[iface.Alias.GetName()] = true
+	}
+	specInterfaces := map[string]bool{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		specInterfaces[iface.Name] = true
+	}
+	for i := range vmi.Status.HotplugInterfaces {
+		status := &vmi.Status.HotplugInterfaces[i]
+		if status.Phase == v1.InterfaceHotplugAttachedToPod && specInterfaces[status.Name] && domainInterfaces[status.Name] {
+			status.Phase = v1.InterfaceHotplugReady
+			status.Reason = ""
+			status.Message = "Interface is plugged into the domain"
+		} else if status.Phase == v1.InterfaceHotplugReady && !specInterfaces[status.Name] && !domainInterfaces[status.Name] {
+			status.Phase = v1.InterfaceHotplugDetachedFromDomain
+			status.Reason = ""
+			status.Message = "Interface is unplugged from the domain"
+		}
+	}
+}
+
 func domainMigrated(domain *api.Domain) bool {
 	if domain != nil && domain.Status.Status == api.Shutoff && domain.Status.Reason == api.ReasonMigrated {
 		return true
@@ -483,6 +526,176 @@ func (d *VirtualMachineController) getPodInterfacefromFileCache(uid types.UID, i
 	return result, nil
 }
 
+// recorderNotifier sends the events of the host disk creator through the event recorder
+type recorderNotifier struct {
+	recorder record.EventRecorder
+}
+
+func (n recorderNotifier) SendK8sEvent(vmi *v1.VirtualMachineInstance, severity string, reason string, message string) error {
+	n.recorder.Event(vmi, severity, reason, message)
+	return nil
+}
+
+func isFilesystemClaim(claimInfo *v1.PersistentVolumeClaimInfo) bool {
+	return claimInfo.VolumeMode == nil || *claimInfo.VolumeMode == k8sv1.PersistentVolumeFilesystem
+}
+
+// resizeVolumes grows the disks of the volumes whose claims got expanded. virt-controller records the
+// capacity of the claims in the VolumeStatus, the disks are resized when it differs from the capacity
+// they are sized to. The disk.img of a filesystem claim is grown first, then virt-launcher resizes the
+// disks, so that the guest sees the new size right away.
+// Both only grow disks which are smaller than their claim. The disks virt-handler did not size yet, e.g.
+// after a restart, are sized on a best effort basis to pick up expansions which happened in between.
+func (d *VirtualMachineController) resizeVolumes(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient) error {
+	sizedCapacities := d.getSizedVolumeCapacities(vmi.UID)
+
+	capacities := make(map[string]resource.Quantity)
+	var expanded []string
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		claimInfo := volumeStatus.PersistentVolumeClaimInfo
+		// Only the disks attached to the domain can be resized
+		if claimInfo == nil || volumeStatus.Target == "" {
+			continue
+		}
+		capacity, ok := claimInfo.Capacity[k8sv1.ResourceStorage]
+		if !ok {
+			continue
+		}
+		sizedCapacity, sized := sizedCapacities[volumeStatus.Name]
+		if sized && capacity.Cmp(sizedCapacity) == 0 {
+			continue
+		}
+		capacities[volumeStatus.Name] = capacity
+
+		if isFilesystemClaim(claimInfo) {
+			if volumeStatus.HotplugVolume != nil {
+				// The disk.img of a hotplugged volume is bind mounted into the pod on its own,
+				// the filesystem of the claim is not reachable through it
+				if sized {
+					log.Log.Object(vmi).Warningf("Can not grow the disk image of hotplugged volume %s", volumeStatus.Name)
+				}
+				continue
+			}
+			if err := d.growDiskImage(vmi, volumeStatus.Name, capacity); err != nil {
+				if sized {
+					return err
+				}
+				log.Log.Object(vmi).Reason(err).Warningf("Failed to size the disk image of volume %s", volumeStatus.Name)
+			}
+		}
+		if sized {
+			expanded = append(expanded, volumeStatus.Name)
+		}
+	}
+
+	if len(capacities) == 0 {
+		return nil
+	}
+	if err := client.ResizeVirtualMachineVolumes(vmi); err != nil {
+		if len(expanded) > 0 {
+			return err
+		}
+		log.Log.Object(vmi).Reason(err).Warning("Failed to size the disks")
+	}
+
+	d.sizedVolumeCapacitiesLock.Lock()
+	if _, ok := d.sizedVolumeCapacities[vmi.UID]; !ok {
+		d.sizedVolumeCapacities[vmi.UID] = make(map[string]resource.Quantity)
+	}
+	for name, capacity := range capacities {
+		d.sizedVolumeCapacities[vmi.UID][name] = capacity
+	}
+	d.sizedVolumeCapacitiesLock.Unlock()
+
+	for _, name := range expanded {
+		capacity := capacities[name]
+		d.recorder.Eventf(vmi, k8sv1.EventTypeNormal, v1.VolumeResized.String(), "Resized volume %s to %s", name, capacity.String())
+	}
+	return nil
+}
+
+// growDiskImage grows the disk.img on the filesystem claim of a volume to the capacity of the claim
+func (d *VirtualMachineController) growDiskImage(vmi *v1.VirtualMachineInstance, volumeName string, capacity resource.Quantity) error {
+	res, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to detect isolation for launcher pod: %v", err)
+	}
+	creator := hostdisk.NewHostDiskCreator(recorderNotifier{d.recorder}, d.clusterConfig.GetLessPVCSpaceToleration())
+	diskPath := filepath.Join(res.MountRoot(), hostdisk.GetMountedHostDiskPath(volumeName, "disk.img"))
+	_, err = creator.Expand(vmi, diskPath, capacity)
+	return err
+}
+
+func (d *VirtualMachineController) getSizedVolumeCapacities(uid types.UID) map[string]resource.Quantity {
+	d.sizedVolumeCapacitiesLock.Lock()
+	defer d.sizedVolumeCapacitiesLock.Unlock()
+	capacities := make(map[string]resource.Quantity)
+	for name, capacity := range d.sizedVolumeCapacities[uid] {
+		capacities[name] = capacity
+	}
+	return capacities
+}
+
+func (d *VirtualMachineController) clearSizedVolumeCapacities(uid types.UID) {
+	d.sizedVolumeCapacitiesLock.Lock()
+	defer d.sizedVolumeCapacitiesLock.Unlock()
+	delete(d.sizedVolumeCapacities, uid)
+}
+
+// toV1DiskIOTune reports the I/O limits of a disk of the domain, limits which are not set are left out.
+// libvirt reports a burst length of one second for every limit once the limits were changed, the
+// lengths are only reported for the bursts which are set.
+func toV1DiskIOTune(ioTune *api.DiskIOTune) *v1.DiskIOTune {
+	if ioTune == nil {
+		return nil
+	}
+	bytes := func(b uint64) *resource.Quantity {
+		if b == 0 {
+			return nil
+		}
+		return resource.NewQuantity(int64(b), resource.BinarySI)
+	}
+	count := func(c uint64) *int64 {
+		if c == 0 {
+			return nil
+		}
+		i := int64(c)
+		return &i
+	}
+	length := func(l uint64, max uint64) *int64 {
+		if max == 0 {
+			return nil
+		}
+		return count(l)
+	}
+	v1IOTune := &v1.DiskIOTune{
+		TotalBytesSec:          bytes(ioTune.TotalBytesSec),
+		ReadBytesSec:           bytes(ioTune.ReadBytesSec),
+		WriteBytesSec:          bytes(ioTune.WriteBytesSec),
+		TotalIOPSSec:           count(ioTune.TotalIopsSec),
+		ReadIOPSSec:            count(ioTune.ReadIopsSec),
+		WriteIOPSSec:           count(ioTune.WriteIopsSec),
+		TotalBytesSecMax:       bytes(ioTune.TotalBytesSecMax),
+		ReadBytesSecMax:        bytes(ioTune.ReadBytesSecMax),
+		WriteBytesSecMax:       bytes(ioTune.WriteBytesSecMax),
+		TotalIOPSSecMax:        count(ioTune.TotalIopsSecMax),
+		ReadIOPSSecMax:         count(ioTune.ReadIopsSecMax),
+		WriteIOPSSecMax:        count(ioTune.WriteIopsSecMax),
+		TotalBytesSecMaxLength: length(ioTune.TotalBytesSecMaxLength, ioTune.TotalBytesSecMax),
+		ReadBytesSecMaxLength:  length(ioTune.ReadBytesSecMaxLength, ioTune.ReadBytesSecMax),
+		WriteBytesSecMaxLength: length(ioTune.WriteBytesSecMaxLength, ioTune.WriteBytesSecMax),
+		TotalIOPSSecMaxLength:  length(ioTune.TotalIopsSecMaxLength, ioTune.TotalIopsSecMax),
+		ReadIOPSSecMaxLength:   length(ioTune.ReadIopsSecMaxLength, ioTune.ReadIopsSecMax),
+		WriteIOPSSecMaxLength:  length(ioTune.WriteIopsSecMaxLength, ioTune.WriteIopsSecMax),
+		SizeIOPSSec:            bytes(ioTune.SizeIopsSec),
+		GroupName:              ioTune.GroupName,
+	}
+	if reflect.DeepEqual(v1IOTune, &v1.DiskIOTune{}) {
+		return nil
+	}
+	return v1IOTune
+}
+
 func canUpdateToMounted(currentPhase v1.VolumePhase) bool {
 	return currentPhase == v1.VolumeBound || currentPhase == v1.VolumePending || currentPhase == v1.HotplugVolumeAttachedToNode
 }
@@ -528,8 +741,14 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 
 		if len(vmi.Status.VolumeStatus) > 0 {
 			diskDeviceMap := make(map[string]string)
+			diskIOTuneMap := make(map[string]*api.DiskIOTune)
 			for _, disk := range domain.Spec.Devices.Disks {
+				if disk.Device == "cdrom" && disk.Source.File == "" && disk.Source.Dev == "" {
+					// The media of the CD-ROM is not inserted yet
+					continue
+				}
 				diskDeviceMap[disk.Alias.GetName()] = disk.Target.Device
+				diskIOTuneMap[disk.Alias.GetName()] = disk.IOTune
 			}
 			specVolumeMap := make(map[string]v1.Volume)
 			for _, volume := range vmi.Spec.Volumes {
@@ -540,6 +759,7 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 			for _, volumeStatus := range vmi.Status.VolumeStatus {
 				if _, ok := diskDeviceMap[volumeStatus.Name]; ok {
 					volumeStatus.Target = diskDeviceMap[volumeStatus.Name]
+					volumeStatus.IOTune = toV1DiskIOTune(diskIOTuneMap[volumeStatus.Name])
 				}
 				if volumeStatus.HotplugVolume != nil {
 					hasHotplug = true
@@ -666,6 +886,8 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 						Name: domainInterface.Alias.GetName(),
 					}
 				}
+				newInterface.Bandwidth = getInterfaceBandwidth(domainInterface.BandWidth)
+				newInterface.Conditions = getInterfaceConditions(newInterface.Conditions, domainInterface.Alias.GetName(), domain.Status.DHCPLeases)
 
 				// Update IP info based on information from domain.Status.Interfaces (Qemu guest)
 				// Remove the interface from domainInterfaceStatusByMac to mark it as handled
@@ -698,6 +920,7 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 			}
 			vmi.Status.Interfaces = newInterfaces
 		}
+		updateHotplugInterfaceStatus(vmi, domain)
 	}
 
 	// Update migration progress if domain reports anything in the migration metadata.
@@ -721,6 +944,38 @@ func (d *VirtualMachineController) updateVMIStatus(vmi *v1.VirtualMachineInstanc
 			vmi.Status.MigrationState.Completed = migrationMetadata.Completed
 			vmi.Status.MigrationState.Failed = migrationMetadata.Failed
 			vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+			vmi.Status.MigrationState.SRIOVPhase = migrationMetadata.SRIOVPhase
+		}
+	}
+
+	// Update the backup state if the domain reports anything in the backup metadata.
+	if domain != nil && domain.Spec.Metadata.KubeVirt.Backup != nil {
+		backupMetadata := domain.Spec.Metadata.KubeVirt.Backup
+		if vmi.Status.BackupState == nil || vmi.Status.BackupState.BackupName != backupMetadata.Name {
+			vmi.Status.BackupState = &v1.VirtualMachineInstanceBackupState{
+				BackupName: backupMetadata.Name,
+			}
+		}
+		if !vmi.Status.BackupState.Completed && backupMetadata.Completed {
+			if backupMetadata.Failed {
+				d.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.BackupFailed.String(), fmt.Sprintf("Backup %s failed: %s", backupMetadata.Name, backupMetadata.Message))
+			} else {
+				d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.BackupSucceeded.String(), fmt.Sprintf("Backup %s succeeded", backupMetadata.Name))
+			}
+		}
+		vmi.Status.BackupState.Checkpoint = backupMetadata.Checkpoint
+		vmi.Status.BackupState.StartTimestamp = backupMetadata.StartTimestamp
+		vmi.Status.BackupState.EndTimestamp = backupMetadata.EndTimestamp
+		vmi.Status.BackupState.Completed = backupMetadata.Completed
+		vmi.Status.BackupState.Failed = backupMetadata.Failed
+		vmi.Status.BackupState.Message = backupMetadata.Message
+	}
+
+	// The VFs are plugged back once the migration is over, either on the target or, after a failure, on the source
+	if domain != nil && vmi.Status.MigrationState != nil && vmi.Status.MigrationState.SRIOVPhase == v1.MigrationSRIOVUnplugged {
+		migrationState := vmi.Status.MigrationState
+		if (migrationState.Completed || migrationState.Failed) && hasAllSRIOVDevices(vmi, domain) {
+			migrationState.SRIOVPhase = v1.MigrationSRIOVReplugged
 		}
 	}
 
@@ -1196,6 +1451,7 @@ func (d *VirtualMachineController) migrationTargetExecute(key string,
 			log.Log.Object(vmi).Info("The target node received the migrated domain")
 			vmiCopy.Status.MigrationState.TargetNodeDomainDetected = true
 			d.setVMIGuestTime(vmi)
+			d.handleMigratedInterfaces(vmi, vmiCopy)
 		}
 		if !migrations.IsMigrating(vmi) {
 
@@ -1239,6 +1495,59 @@ func (d *VirtualMachineController) migrationTargetExecute(key string,
 	return nil
 }
 
+// handleMigratedInterfaces announces the guest addresses of bridge bound interfaces from the
+// target pod after the switchover, and reports on the VMI whether the target pod received the
+// addresses the guest holds.
+func (d *VirtualMachineController) handleMigratedInterfaces(vmi *v1.VirtualMachineInstance, vmiCopy *v1.VirtualMachineInstance) {
+	if !hasBridgeInterface(vmi) {
+		return
+	}
+
+	res, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to detect isolation for launcher pod")
+	} else if err := res.DoNetNS(func() error { return network.AnnounceMigratedInterfaces(vmi) }); err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("failed to announce the addresses of the migrated interfaces")
+	}
+
+	changes, err := network.GetChangedMigrationAddresses(vmi, func(ifaceName string) (*network.PodCacheInterface, error) {
+		var podIface *network.PodCacheInterface
+		err := network.ReadFromVirtHandlerCachedFile(&podIface, vmi.UID, ifaceName)
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return podIface, err
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("failed to compare the addresses of the migrated interfaces")
+		return
+	}
+
+	condition := v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstancePodNetworkAddressPreserved,
+		Status:             k8sv1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+	}
+	if len(changes) > 0 {
+		condition.Status = k8sv1.ConditionFalse
+		condition.Reason = v1.VirtualMachineInstanceReasonPodAddressChanged
+		condition.Message = fmt.Sprintf("The migration target pod did not receive the addresses of the guest, "+
+			"the guest has to renew its DHCP lease: %s", strings.Join(changes, "; "))
+		d.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.Migrated.String(), condition.Message)
+	}
+	controller.NewVirtualMachineInstanceConditionManager().RemoveCondition(vmiCopy, v1.VirtualMachineInstancePodNetworkAddressPreserved)
+	vmiCopy.Status.Conditions = append(vmiCopy.Status.Conditions, condition)
+}
+
+func hasBridgeInterface(vmi *v1.VirtualMachineInstance) bool {
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Bridge != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Legacy, remove once we're certain we are no longer supporting
 // VMIs running with the old graceful shutdown trigger logic
 func gracefulShutdownTriggerFromNamespaceName(baseDir string, namespace string, name string) string {
@@ -1570,8 +1879,12 @@ func (d *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 	if err := d.hotplugVolumeMounter.UnmountAll(vmi); err != nil {
 		return err
 	}
+	if err := hotplug_volume.UnmountAllBackupTargets(vmi); err != nil {
+		return err
+	}
 
 	d.clearPodNetworkPhase1(vmi.UID)
+	d.clearSizedVolumeCapacities(vmi.UID)
 
 	// Watch dog file and command client must be the last things removed here
 	err = d.closeLauncherClient(vmi)
@@ -1895,7 +2208,7 @@ func (d *VirtualMachineController) isPreMigrationTarget(vmi *v1.VirtualMachineIn
 }
 
 func (d *VirtualMachineController) checkNetworkInterfacesForMigration(vmi *v1.VirtualMachineInstance) error {
-	err := validatePodNetworkInterfaceUsesMasqueradeBinding(vmi)
+	err := validatePodNetworkInterfaceBindingForMigration(vmi)
 	if err != nil {
 		return err
 	}
@@ -1908,15 +2221,21 @@ func (d *VirtualMachineController) checkNetworkInterfacesForMigration(vmi *v1.Vi
 	return nil
 }
 
-func validatePodNetworkInterfaceUsesMasqueradeBinding(vmi *v1.VirtualMachineInstance) error {
+func validatePodNetworkInterfaceBindingForMigration(vmi *v1.VirtualMachineInstance) error {
 	interfacesByName := map[string]v1.Interface{}
 	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
 		interfacesByName[iface.Name] = iface
 	}
 
 	vmiPodNetworkName := lookupVMIPodNetworkName(vmi.Spec.Networks)
-	if vmiPodNetworkName != "" && interfacesByName[vmiPodNetworkName].Masquerade == nil {
-		return fmt.Errorf("cannot migrate VMI which does not use masquerade to connect to the pod network")
+	if vmiPodNetworkName == "" {
+		return nil
+	}
+	// the target pod recreates the bridge with the MAC of the guest, the guest keeps
+	// its address when the CNI hands out the same IP to the target pod
+	podIface := interfacesByName[vmiPodNetworkName]
+	if podIface.Masquerade == nil && podIface.Bridge == nil {
+		return fmt.Errorf("cannot migrate VMI which does not use masquerade or bridge to connect to the pod network")
 	}
 
 	return nil
@@ -1933,6 +2252,10 @@ func lookupVMIPodNetworkName(networks []v1.Network) string {
 }
 
 func (d *VirtualMachineController) validateSRIOVInterfacesForMigration(vmi *v1.VirtualMachineInstance) error {
+	// the VFs are unplugged before the migration and the target plugs VFs of its own
+	if d.clusterConfig.SRIOVLiveMigrationEnabled() {
+		return nil
+	}
 	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
 		if iface.SRIOV != nil {
 			return fmt.Errorf("Live migration of guest with SR-IOV interfaces is not supported")
@@ -1942,6 +2265,20 @@ func (d *VirtualMachineController) validateSRIOVInterfacesForMigration(vmi *v1.V
 	return nil
 }
 
+func hasAllSRIOVDevices(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	sriovInterfaces := 0
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.SRIOV != nil {
+			sriovInterfaces++
+		}
+	}
+	sriovDevices, err := sriov.GetDomainDevices(&domain.Spec)
+	if err != nil {
+		return false
+	}
+	return len(sriovDevices) >= sriovInterfaces
+}
+
 func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachineInstance) (blockMigrate bool, err error) {
 	// Check if all VMI volumes can be shared between the source and the destination
 	// of a live migration. blockMigrate will be returned as false, only if all volumes
@@ -1950,6 +2287,11 @@ func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 	// A relevant error will be returned in this case.
 	for _, volume := range vmi.Spec.Volumes {
 		volSrc := volume.VolumeSource
+		// Volumes moved to new claims are copied to the target like local disks
+		if migrations.IsMigratedVolume(vmi, volume.Name) {
+			blockMigrate = true
+			continue
+		}
 		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil {
 			var volName string
 			if volSrc.PersistentVolumeClaim != nil {
@@ -2006,7 +2348,7 @@ func (d *VirtualMachineController) handlePostSyncMigrationProxy(vmi *v1.VirtualM
 	baseDir := fmt.Sprintf("/proc/%d/root/var/run/kubevirt", res.Pid())
 	migrationTargetSockets = append(migrationTargetSockets, socketFile)
 
-	isBlockMigration := vmi.Status.MigrationMethod == v1.BlockMigration
+	isBlockMigration := migrations.IsBlockMigration(vmi)
 	migrationPortsRange := migrationproxy.GetMigrationPortsList(isBlockMigration)
 	for _, port := range migrationPortsRange {
 		key := migrationproxy.ConstructProxyKey(string(vmi.UID), port)
@@ -2074,6 +2416,11 @@ func (d *VirtualMachineController) processVmUpdate(origVMI *v1.VirtualMachineIns
 		return goerror.New(fmt.Sprintf("Can not update a VirtualMachineInstance with unresponsive command server."))
 	}
 
+	// The migration target pod mounts the destination claims of the migrated volumes
+	if d.isPreMigrationTarget(vmi) {
+		vmi.Spec.Volumes = migrations.ApplyVolumeMigrations(vmi.Spec.Volumes, migrations.GetVolumeMigrations(vmi))
+	}
+
 	err = hostdisk.ReplacePVCByHostDisk(vmi, d.clientset)
 	if err != nil {
 		return err
@@ -2141,6 +2488,11 @@ func (d *VirtualMachineController) processVmUpdate(origVMI *v1.VirtualMachineIns
 			}
 		} else {
 			migrationConfiguration := d.clusterConfig.GetMigrationConfiguration()
+			// the migration controller records the configuration, with the matching
+			// MigrationPolicy applied, when handing the migration over
+			if vmi.Status.MigrationState.MigrationConfiguration != nil {
+				migrationConfiguration = vmi.Status.MigrationState.MigrationConfiguration
+			}
 
 			options := &cmdclient.MigrationOptions{
 				Bandwidth:               *migrationConfiguration.BandwidthPerMigration,
@@ -2193,6 +2545,11 @@ func (d *VirtualMachineController) processVmUpdate(origVMI *v1.VirtualMachineIns
 			if err := d.hotplugVolumeMounter.Mount(vmi); err != nil {
 				return err
 			}
+			if network.HasHotplugInterfacesToPlug(vmi) {
+				if err := d.setHotplugPodNetworkPhase1(vmi); err != nil {
+					return fmt.Errorf("failed to configure hotplugged vmi network: %v", err)
+				}
+			}
 		}
 
 		smbios := d.clusterConfig.GetSMBIOS()
@@ -2223,6 +2580,15 @@ func (d *VirtualMachineController) processVmUpdate(origVMI *v1.VirtualMachineIns
 			if err := d.hotplugVolumeMounter.Unmount(vmi); err != nil {
 				return err
 			}
+			// The claim of a push backup is no longer needed once the backup ended
+			if backupState := vmi.Status.BackupState; backupState != nil && backupState.Completed {
+				if err := hotplug_volume.UnmountBackupTarget(vmi, backupState.BackupName); err != nil {
+					return err
+				}
+			}
+			if err := d.resizeVolumes(origVMI, client); err != nil {
+				return fmt.Errorf("failed to resize volumes: %v", err)
+			}
 		}
 	}
 
@@ -2446,6 +2812,73 @@ func isACPIEnabled(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
 		domain.Spec.Features.ACPI != nil
 }
 
+// getInterfaceBandwidth reports the bandwidth limits libvirt applies, converted back from kilobytes
+func getInterfaceBandwidth(bandwidth *api.BandWidth) *v1.InterfaceBandwidth {
+	if bandwidth == nil || (bandwidth.Inbound == nil && bandwidth.Outbound == nil) {
+		return nil
+	}
+	return &v1.InterfaceBandwidth{
+		Inbound:  getBandwidthLimits(bandwidth.Inbound),
+		Outbound: getBandwidthLimits(bandwidth.Outbound),
+	}
+}
+
+// getInterfaceConditions reports the addresses the DHCP servers of the launcher leased on the interface
+func getInterfaceConditions(conditions []v1.VirtualMachineInstanceNetworkInterfaceCondition, ifaceName string, leases []api.DHCPLease) []v1.VirtualMachineInstanceNetworkInterfaceCondition {
+	var leasedIPs []string
+	var lastLeased metav1.Time
+	for _, lease := range leases {
+		if lease.Name != ifaceName {
+			continue
+		}
+		leasedIPs = append(leasedIPs, lease.IP)
+		if lastLeased.Before(&lease.Time) {
+			lastLeased = lease.Time
+		}
+	}
+	if len(leasedIPs) == 0 {
+		return conditions
+	}
+
+	leased := v1.VirtualMachineInstanceNetworkInterfaceCondition{
+		Type:               v1.InterfaceDHCPLeased,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      lastLeased,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "Leased",
+		Message:            fmt.Sprintf("Leased %s", strings.Join(leasedIPs, ", ")),
+	}
+	newConditions := []v1.VirtualMachineInstanceNetworkInterfaceCondition{}
+	for _, condition := range conditions {
+		if condition.Type != v1.InterfaceDHCPLeased {
+			newConditions = append(newConditions, condition)
+			continue
+		}
+		if condition.Status == leased.Status {
+			leased.LastTransitionTime = condition.LastTransitionTime
+		}
+	}
+	return append(newConditions, leased)
+}
+
+func getBandwidthLimits(limits *api.BandWidthLimits) *v1.BandwidthLimits {
+	if limits == nil {
+		return nil
+	}
+
+	const kiloByte = 1024
+	bandwidthLimits := &v1.BandwidthLimits{
+		Average: *resource.NewQuantity(int64(limits.Average*kiloByte*8), resource.DecimalSI),
+	}
+	if limits.Peak != 0 {
+		bandwidthLimits.Peak = resource.NewQuantity(int64(limits.Peak*kiloByte*8), resource.DecimalSI)
+	}
+	if limits.Burst != 0 {
+		bandwidthLimits.Burst = resource.NewQuantity(int64(limits.Burst*kiloByte), resource.BinarySI)
+	}
+	return bandwidthLimits
+}
+
 func setMissingSRIOVInterfacesNames(interfacesSpecByName map[string]v1.Interface, interfacesStatusByMac map[string]api.InterfaceStatus) {
 	for name, ifaceSpec := range interfacesSpecByName {
 		if ifaceSpec.SRIOV == nil || ifaceSpec.MacAddress == "" {
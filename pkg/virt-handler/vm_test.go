@@ -60,11 +60,13 @@ import (
 	"kubevirt.io/client-go/log"
 	"kubevirt.io/client-go/precond"
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/sriov"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network"
 	"kubevirt.io/kubevirt/pkg/watchdog"
 )
@@ -972,6 +974,97 @@ var _ = Describe("VirtualMachineInstance", func() {
 			expectEvent(string(v1.AccessCredentialsSyncFailed), true)
 		})
 
+		table.DescribeTable("should report the state of a backup which ended", func(failed bool, message string, event v1.SyncEvent) {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.BackupState = &v1.VirtualMachineInstanceBackupState{
+				BackupName: "mybackup",
+			}
+
+			mockWatchdog.CreateFile(vmi)
+
+			startTimestamp := metav1.Now()
+			endTimestamp := metav1.Now()
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+				Name:           "mybackup",
+				Checkpoint:     "mybackup-checkpoint",
+				Mode:           v1.BackupModePull,
+				StartTimestamp: &startTimestamp,
+				EndTimestamp:   &endTimestamp,
+				Completed:      true,
+				Failed:         failed,
+				Message:        message,
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(vmi *v1.VirtualMachineInstance) {
+				Expect(vmi.Status.BackupState).To(Equal(&v1.VirtualMachineInstanceBackupState{
+					BackupName:     "mybackup",
+					Checkpoint:     "mybackup-checkpoint",
+					StartTimestamp: &startTimestamp,
+					EndTimestamp:   &endTimestamp,
+					Completed:      true,
+					Failed:         failed,
+					Message:        message,
+				}))
+			})
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+
+			controller.Execute()
+			expectEvent(string(event), true)
+		},
+			table.Entry("successfully", false, "", v1.BackupSucceeded),
+			table.Entry("with a failure", true, "job failed", v1.BackupFailed),
+		)
+
+		It("should start to report the state of a new backup", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.BackupState = &v1.VirtualMachineInstanceBackupState{
+				BackupName: "oldbackup",
+				Checkpoint: "oldbackup-checkpoint",
+				Completed:  true,
+			}
+
+			mockWatchdog.CreateFile(vmi)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+				Name:       "newbackup",
+				Checkpoint: "newbackup-checkpoint",
+				Mode:       v1.BackupModePush,
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(vmi *v1.VirtualMachineInstance) {
+				Expect(vmi.Status.BackupState).To(Equal(&v1.VirtualMachineInstanceBackupState{
+					BackupName: "newbackup",
+					Checkpoint: "newbackup-checkpoint",
+				}))
+			})
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+
+			controller.Execute()
+			expectEvent(string(v1.BackupSucceeded), false)
+		})
+
 		It("should add and remove paused condition", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1440,6 +1533,62 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 		}, 3)
 
+		It("should migrate vmi with the migration configuration recorded in the migration state", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = make(map[string]string)
+			vmi.Status.NodeName = host
+			vmi.Labels[v1.MigrationTargetNodeNameLabel] = "othernode"
+			vmi.Status.Interfaces = make([]v1.VirtualMachineInstanceNetworkInterface, 0)
+
+			bandwidth := resource.MustParse("1Gi")
+			progressTimeout := int64(300)
+			completionTimeout := int64(1600)
+			unsafeMigration := false
+			allowAutoConverge := true
+			allowPostCopy := true
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:                     "othernode",
+				TargetNodeAddress:              "127.0.0.1:12345",
+				SourceNode:                     host,
+				MigrationUID:                   "123",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+				MigrationConfiguration: &v1.MigrationConfiguration{
+					BandwidthPerMigration:   &bandwidth,
+					ProgressTimeout:         &progressTimeout,
+					CompletionTimeoutPerGiB: &completionTimeout,
+					UnsafeMigrationOverride: &unsafeMigration,
+					AllowAutoConverge:       &allowAutoConverge,
+					AllowPostCopy:           &allowPostCopy,
+				},
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domainFeeder.Add(domain)
+			vmiFeeder.Add(vmi)
+			options := &cmdclient.MigrationOptions{
+				Bandwidth:               resource.MustParse("1Gi"),
+				ProgressTimeout:         300,
+				CompletionTimeoutPerGiB: 1600,
+				UnsafeMigration:         false,
+				AllowAutoConverge:       true,
+				AllowPostCopy:           true,
+			}
+			client.EXPECT().MigrateVirtualMachine(vmi, options)
+			controller.Execute()
+		}, 3)
+
 		It("should abort vmi migration vmi when migration object indicates deletion", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1673,6 +1822,30 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI: PVC testblock is not shared, live migration requires that all PVCs must be shared (using ReadWriteMany access mode)")))
 		})
+		It("should block migrate non-shared PVCs moved to new claims", func() {
+
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "myvolume",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testblock",
+						},
+					},
+				},
+			}
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				VolumeMigrations: []v1.VolumeMigration{{SourceVolume: "myvolume", DestinationPVC: "newblock"}},
+			}
+
+			testBlockPvc.Spec.AccessModes = []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce}
+
+			virtClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), testBlockPvc, metav1.CreateOptions{})
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			Expect(blockMigrate).To(BeTrue())
+			Expect(err).ToNot(HaveOccurred())
+		})
 		It("should fail migration for non-shared data volume PVCs", func() {
 
 			vmi := v1.NewMinimalVMI("testvmi")
@@ -1882,7 +2055,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 		})
 
 		Context("with network configuration", func() {
-			It("should block migration for bridge binding assigned to the pod network", func() {
+			It("should not block migration for bridge binding assigned to the pod network", func() {
 				vmi := v1.NewMinimalVMI("testvmi")
 				interface_name := "interface_name"
 
@@ -1901,6 +2074,28 @@ var _ = Describe("VirtualMachineInstance", func() {
 					},
 				}
 
+				err := controller.checkNetworkInterfacesForMigration(vmi)
+				Expect(err).ToNot(HaveOccurred())
+			})
+			It("should block migration for slirp binding assigned to the pod network", func() {
+				vmi := v1.NewMinimalVMI("testvmi")
+				interface_name := "interface_name"
+
+				vmi.Spec.Networks = []v1.Network{
+					{
+						Name:          interface_name,
+						NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}},
+					},
+				}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+					{
+						Name: interface_name,
+						InterfaceBindingMethod: v1.InterfaceBindingMethod{
+							Slirp: &v1.InterfaceSlirp{},
+						},
+					},
+				}
+
 				err := controller.checkNetworkInterfacesForMigration(vmi)
 				Expect(err).To(HaveOccurred())
 			})
@@ -1927,7 +2122,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Expect(controller.checkNetworkInterfacesForMigration(vmi)).ShouldNot(Succeed())
 			})
 
-			It("should block migration for VMI with SRIOV interface when feature-gate SRIOVLiveMigration is on", func() {
+			It("should not block migration for VMI with SRIOV interface when feature-gate SRIOVLiveMigration is on", func() {
 				vmi := v1.NewMinimalVMI("testvmi")
 				sriovInterfaceName := "sriovnet1"
 				kubevirtConfigMapFeatureGate := map[string]string{virtconfig.FeatureGatesKey: virtconfig.SRIOVLiveMigrationGate}
@@ -1952,7 +2147,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 				})
 				controller.clusterConfig = config
 
-				Expect(controller.checkNetworkInterfacesForMigration(vmi)).ShouldNot(Succeed())
+				Expect(controller.checkNetworkInterfacesForMigration(vmi)).To(Succeed())
 			})
 
 			It("should not block migration for masquerade binding assigned to the pod network", func() {
@@ -2174,6 +2369,86 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 		})
 
+		It("should report the bandwidth limits of the interface", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			interfaceName := "interface_name"
+			mac := "1C:CE:C0:01:BE:E7"
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			domain.Spec.Devices.Interfaces = []api.Interface{
+				{
+					MAC:   &api.MAC{MAC: mac},
+					Alias: api.NewUserDefinedAlias(interfaceName),
+					BandWidth: &api.BandWidth{
+						Inbound: &api.BandWidthLimits{Average: 1000, Peak: 2000, Burst: 1024},
+					},
+				},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				interfaces := arg.(*v1.VirtualMachineInstance).Status.Interfaces
+				Expect(interfaces).To(HaveLen(1))
+				Expect(interfaces[0].Bandwidth).ToNot(BeNil())
+				Expect(interfaces[0].Bandwidth.Outbound).To(BeNil())
+				inbound := interfaces[0].Bandwidth.Inbound
+				Expect(inbound.Average.Value()).To(Equal(int64(8192000)))
+				Expect(inbound.Peak.Value()).To(Equal(int64(16384000)))
+				Expect(inbound.Burst.String()).To(Equal("1Mi"))
+			}).Return(vmi, nil)
+
+			controller.Execute()
+		})
+
+		It("should report the addresses leased on the interface", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			interfaceName := "interface_name"
+			mac := "1C:CE:C0:01:BE:E7"
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			domain.Spec.Devices.Interfaces = []api.Interface{
+				{
+					MAC:   &api.MAC{MAC: mac},
+					Alias: api.NewUserDefinedAlias(interfaceName),
+				},
+			}
+			domain.Status.DHCPLeases = []api.DHCPLease{
+				{Name: interfaceName, IP: "10.0.2.2", Time: metav1.Now()},
+				{Name: interfaceName, IP: "fd10:0:2::2", Time: metav1.Now()},
+				{Name: "other", IP: "10.1.0.5", Time: metav1.Now()},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				interfaces := arg.(*v1.VirtualMachineInstance).Status.Interfaces
+				Expect(interfaces).To(HaveLen(1))
+				Expect(interfaces[0].Conditions).To(HaveLen(1))
+				Expect(interfaces[0].Conditions[0].Type).To(Equal(v1.InterfaceDHCPLeased))
+				Expect(interfaces[0].Conditions[0].Status).To(Equal(k8sv1.ConditionTrue))
+				Expect(interfaces[0].Conditions[0].Message).To(Equal("Leased 10.0.2.2, fd10:0:2::2"))
+			}).Return(vmi, nil)
+
+			controller.Execute()
+		})
+
 		It("should update existing interface with IPs", func() {
 			vmi := v1.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -2352,6 +2627,69 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 			controller.Execute()
 		})
+
+		It("should mark a hotplugged interface as ready once it is plugged into the domain", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			interfaceName := "hotplugged"
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{Name: interfaceName, InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			}
+			vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: interfaceName, PodInterfaceName: "net1", Phase: v1.InterfaceHotplugAttachedToPod},
+			}
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Interfaces = []api.Interface{
+				{
+					MAC:   &api.MAC{MAC: "1C:CE:C0:01:BE:E7"},
+					Alias: api.NewUserDefinedAlias(interfaceName),
+				},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				hotplugInterfaces := arg.(*v1.VirtualMachineInstance).Status.HotplugInterfaces
+				Expect(hotplugInterfaces).To(HaveLen(1))
+				Expect(hotplugInterfaces[0].Phase).To(Equal(v1.InterfaceHotplugReady))
+			}).Return(vmi, nil)
+
+			controller.Execute()
+		})
+
+		It("should mark an unplugged interface as detached once it is removed from the domain", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			interfaceName := "hotplugged"
+			vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: interfaceName, PodInterfaceName: "net1", Phase: v1.InterfaceHotplugReady},
+			}
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				hotplugInterfaces := arg.(*v1.VirtualMachineInstance).Status.HotplugInterfaces
+				Expect(hotplugInterfaces).To(HaveLen(1))
+				Expect(hotplugInterfaces[0].Phase).To(Equal(v1.InterfaceHotplugDetachedFromDomain))
+			}).Return(vmi, nil)
+
+			controller.Execute()
+		})
 	})
 
 	Context("with SRIOV configuration", func() {
@@ -2403,6 +2741,57 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 			controller.Execute()
 		})
+
+		It("should report the VFs as replugged once the migration is over", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+			const sriovInterfaceName = "sriov_network"
+
+			vmi.Spec.Networks = []v1.Network{
+				{
+					Name: sriovInterfaceName,
+					NetworkSource: v1.NetworkSource{
+						Multus: &v1.MultusNetwork{
+							NetworkName: sriovInterfaceName,
+						},
+					},
+				},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				{
+					Name: sriovInterfaceName,
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{
+						SRIOV: &v1.InterfaceSRIOV{},
+					},
+				},
+			}
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				MigrationUID: "123",
+				Completed:    true,
+				SRIOVPhase:   v1.MigrationSRIOVUnplugged,
+			}
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.HostDevices = []api.HostDevice{
+				{
+					Type:  "pci",
+					Alias: api.NewUserDefinedAlias(sriov.AliasPrefix + sriovInterfaceName),
+				},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				Expect(arg.(*v1.VirtualMachineInstance).Status.MigrationState.SRIOVPhase).To(Equal(v1.MigrationSRIOVReplugged))
+			}).Return(vmi, nil)
+
+			controller.Execute()
+		})
 	})
 
 	Context("VirtualMachineInstance controller gets informed about disk information", func() {
@@ -2494,6 +2883,198 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 			controller.Execute()
 		})
+
+		It("should report the I/O limits in effect in the volume status", func() {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name:  "permvolume",
+					Phase: v1.VolumeReady,
+				},
+			}
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Disks = []api.Disk{
+				{
+					Device: "disk",
+					Type:   "file",
+					Source: api.DiskSource{
+						File: "/var/run/kubevirt-private/vmi-disks/permvolume1/disk.img",
+					},
+					Target: api.DiskTarget{
+						Bus:    "virtio",
+						Device: "vda",
+					},
+					Alias: api.NewUserDefinedAlias("permvolume"),
+					IOTune: &api.DiskIOTune{
+						TotalBytesSec:          104857600,
+						TotalIopsSec:           1000,
+						TotalIopsSecMax:        2000,
+						TotalIopsSecMaxLength:  10,
+						TotalBytesSecMaxLength: 1,
+						ReadIopsSecMaxLength:   1,
+					},
+				},
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+			vmiInterface.EXPECT().Update(gomock.Any()).Do(func(arg interface{}) {
+				status := arg.(*v1.VirtualMachineInstance).Status.VolumeStatus
+				Expect(status).To(HaveLen(1))
+				ioTune := status[0].IOTune
+				Expect(ioTune).ToNot(BeNil())
+				Expect(ioTune.TotalBytesSec.Cmp(resource.MustParse("100Mi"))).To(BeZero())
+				Expect(*ioTune.TotalIOPSSec).To(Equal(int64(1000)))
+				Expect(*ioTune.TotalIOPSSecMax).To(Equal(int64(2000)))
+				Expect(*ioTune.TotalIOPSSecMaxLength).To(Equal(int64(10)))
+				Expect(ioTune.TotalBytesSecMaxLength).To(BeNil())
+				Expect(ioTune.ReadIOPSSecMaxLength).To(BeNil())
+			}).Return(vmi, nil)
+
+			controller.Execute()
+		})
+	})
+
+	Context("VirtualMachineInstance controller gets informed about expanded claims", func() {
+		newVMIWithClaim := func(mode k8sv1.PersistentVolumeMode, capacity string) *v1.VirtualMachineInstance {
+			vmi := v1.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "permvolume",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "testclaim",
+						},
+					},
+				},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name:   "permvolume",
+					Target: "vda",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						Capacity: k8sv1.ResourceList{
+							k8sv1.ResourceStorage: resource.MustParse(capacity),
+						},
+						VolumeMode: &mode,
+					},
+				},
+			}
+			return vmi
+		}
+
+		newDomain := func() *api.Domain {
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Devices.Disks = []api.Disk{
+				{
+					Device: "disk",
+					Target: api.DiskTarget{
+						Bus:    "virtio",
+						Device: "vda",
+					},
+					Alias: api.NewUserDefinedAlias("permvolume"),
+				},
+			}
+			return domain
+		}
+
+		sizedTo := func(capacity string) {
+			controller.sizedVolumeCapacities[vmiTestUUID] = map[string]resource.Quantity{
+				"permvolume": resource.MustParse(capacity),
+			}
+		}
+
+		expectSizedTo := func(capacity string) {
+			sized := controller.getSizedVolumeCapacities(vmiTestUUID)["permvolume"]
+			Expect(sized.Cmp(resource.MustParse(capacity))).To(BeZero())
+		}
+
+		BeforeEach(func() {
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any())
+			vmiInterface.EXPECT().Update(gomock.Any()).Return(nil, nil).AnyTimes()
+		})
+
+		addVMI := func(vmi *v1.VirtualMachineInstance) {
+			mockWatchdog.CreateFile(vmi)
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(newDomain())
+		}
+
+		It("should size the disks once which were not sized yet", func() {
+			addVMI(newVMIWithClaim(k8sv1.PersistentVolumeBlock, "1Gi"))
+			client.EXPECT().ResizeVirtualMachineVolumes(gomock.Any())
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, v1.Created.String())
+			expectSizedTo("1Gi")
+		})
+
+		It("should not resize the disks which are sized to the capacity of their claim", func() {
+			sizedTo("1Gi")
+			addVMI(newVMIWithClaim(k8sv1.PersistentVolumeBlock, "1Gi"))
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, v1.Created.String())
+		})
+
+		It("should resize the disk of an expanded block claim", func() {
+			sizedTo("1Gi")
+			addVMI(newVMIWithClaim(k8sv1.PersistentVolumeBlock, "2Gi"))
+			client.EXPECT().ResizeVirtualMachineVolumes(gomock.Any())
+
+			controller.Execute()
+			testutils.ExpectEvents(recorder, v1.Created.String(), v1.VolumeResized.String())
+			expectSizedTo("2Gi")
+		})
+
+		It("should grow the disk.img of an expanded filesystem claim before resizing the disk", func() {
+			sizedTo("64Mi")
+			vmi := newVMIWithClaim(k8sv1.PersistentVolumeFilesystem, "128Mi")
+
+			diskPath := filepath.Join(vmiShareDir, hostdisk.GetMountedHostDiskPath("permvolume", "disk.img"))
+			Expect(os.MkdirAll(filepath.Dir(diskPath), 0755)).To(Succeed())
+			diskImg, err := os.Create(diskPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(diskImg.Truncate(67108864)).To(Succeed())
+			Expect(diskImg.Close()).To(Succeed())
+
+			addVMI(vmi)
+			client.EXPECT().ResizeVirtualMachineVolumes(gomock.Any()).Do(func(_ *v1.VirtualMachineInstance) {
+				info, err := os.Stat(diskPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(info.Size()).To(Equal(int64(134217728)))
+			})
+
+			controller.Execute()
+			testutils.ExpectEvents(recorder, v1.Created.String(), v1.VolumeResized.String())
+			expectSizedTo("128Mi")
+		})
+
+		It("should fail the sync when an expanded disk can not be resized", func() {
+			sizedTo("1Gi")
+			addVMI(newVMIWithClaim(k8sv1.PersistentVolumeBlock, "2Gi"))
+			client.EXPECT().ResizeVirtualMachineVolumes(gomock.Any()).Return(fmt.Errorf("resize failed"))
+
+			controller.Execute()
+			testutils.ExpectEvents(recorder, v1.Created.String(), v1.SyncFailed.String())
+			expectSizedTo("1Gi")
+		})
 	})
 })
 
@@ -29,6 +29,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
 	domainerrors "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
 )
 
@@ -288,6 +289,9 @@ func eventCallback(c cli.Connection, domain *api.Domain, libvirtEvent libvirtEve
 		if osInfo != nil {
 			domain.Status.OSInfo = *osInfo
 		}
+		if leases := network.DHCPLeases.List(); len(leases) > 0 {
+			domain.Status.DHCPLeases = leases
+		}
 
 		err := client.SendDomainEvent(watch.Event{Type: watch.Modified, Object: domain})
 		if err != nil {
@@ -370,6 +374,11 @@ func (n *Notifier) StartDomainNotifier(
 
 				eventCallback(domainConn, domainCache, libvirtEvent{}, n, deleteNotificationSent,
 					interfaceStatuses, guestOsInfo, vmi)
+			case <-network.DHCPLeases.Updated:
+				if domainCache != nil {
+					eventCallback(domainConn, domainCache, libvirtEvent{}, n, deleteNotificationSent,
+						interfaceStatuses, guestOsInfo, vmi)
+				}
 			case <-reconnectChan:
 				n.SendDomainEvent(newWatchEventError(fmt.Errorf("Libvirt reconnect, domain %s", domainName)))
 			}
@@ -416,6 +425,22 @@ func (n *Notifier) StartDomainNotifier(
 		}
 	}
 
+	domainEventTunableCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventTunable) {
+		if event.BlkdevDiskSet {
+			log.Log.Infof("Domain block I/O tune event of disk %s received", event.BlkdevDisk)
+		}
+		name, err := d.GetName()
+		if err != nil {
+			log.Log.Reason(err).Info("Could not determine name of libvirt domain in event callback.")
+		}
+
+		select {
+		case eventChan <- libvirtEvent{Domain: name}:
+		default:
+			log.Log.Infof("Libvirt event channel is full, dropping event.")
+		}
+	}
+
 	err := domainConn.DomainEventLifecycleRegister(domainEventLifecycleCallback)
 	if err != nil {
 		log.Log.Reason(err).Errorf("failed to register event callback with libvirt")
@@ -432,6 +457,11 @@ func (n *Notifier) StartDomainNotifier(
 		log.Log.Reason(err).Errorf("failed to register device removed event callback with libvirt")
 		return err
 	}
+	err = domainConn.DomainEventTunableRegister(domainEventTunableCallback)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to register tunable event callback with libvirt")
+		return err
+	}
 
 	agentEventLifecycleCallback := func(c *libvirt.Connect, d *libvirt.Domain, event *libvirt.DomainEventAgentLifecycle) {
 		log.Log.Infof("GuestAgentLifecycle event state %d with reason %d received", event.State, event.Reason)
@@ -106,6 +106,7 @@ type DomainStatus struct {
 	Reason     StateChangeReason
 	Interfaces []InterfaceStatus
 	OSInfo     GuestOSInfo
+	DHCPLeases []DHCPLease
 }
 
 type DomainSysInfo struct {
@@ -133,6 +134,15 @@ type InterfaceStatus struct {
 	InterfaceName string
 }
 
+// DHCPLease is an address the DHCP servers of the launcher leased to the guest
+type DHCPLease struct {
+	// Name of the interface of the VMI the address was leased on
+	Name string
+	IP   string
+	// Time is when the lease was last acknowledged
+	Time metav1.Time
+}
+
 type Timezone struct {
 	Zone   string
 	Offset int
@@ -308,6 +318,19 @@ type KubeVirtMetadata struct {
 	GracePeriod      *GracePeriodMetadata      `xml:"graceperiod,omitempty"`
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
+	Backup           *BackupMetadata           `xml:"backup,omitempty"`
+}
+
+type BackupMetadata struct {
+	Name           string        `xml:"name"`
+	Checkpoint     string        `xml:"checkpoint,omitempty"`
+	Mode           v1.BackupMode `xml:"mode,omitempty"`
+	StartTimestamp *metav1.Time  `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time  `xml:"endTimestamp,omitempty"`
+	Completed      bool          `xml:"completed,omitempty"`
+	Failed         bool          `xml:"failed,omitempty"`
+	Aborted        bool          `xml:"aborted,omitempty"`
+	Message        string        `xml:"message,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -316,14 +339,15 @@ type AccessCredentialMetadata struct {
 }
 
 type MigrationMetadata struct {
-	UID            types.UID        `xml:"uid,omitempty"`
-	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
-	EndTimestamp   *metav1.Time     `xml:"endTimestamp,omitempty"`
-	Completed      bool             `xml:"completed,omitempty"`
-	Failed         bool             `xml:"failed,omitempty"`
-	FailureReason  string           `xml:"failureReason,omitempty"`
-	AbortStatus    string           `xml:"abortStatus,omitempty"`
-	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	UID            types.UID              `xml:"uid,omitempty"`
+	StartTimestamp *metav1.Time           `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time           `xml:"endTimestamp,omitempty"`
+	Completed      bool                   `xml:"completed,omitempty"`
+	Failed         bool                   `xml:"failed,omitempty"`
+	FailureReason  string                 `xml:"failureReason,omitempty"`
+	AbortStatus    string                 `xml:"abortStatus,omitempty"`
+	Mode           v1.MigrationMode       `xml:"mode,omitempty"`
+	SRIOVPhase     v1.MigrationSRIOVPhase `xml:"sriovPhase,omitempty"`
 }
 
 type GracePeriodMetadata struct {
@@ -502,6 +526,30 @@ type Disk struct {
 	BootOrder    *BootOrder    `xml:"boot,omitempty"`
 	Address      *Address      `xml:"address,omitempty"`
 	Model        string        `xml:"model,attr,omitempty"`
+	IOTune       *DiskIOTune   `xml:"iotune,omitempty"`
+}
+
+type DiskIOTune struct {
+	TotalBytesSec          uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec           uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec           uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIopsSecMax        uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIopsSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIopsSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	SizeIopsSec            uint64 `xml:"size_iops_sec,omitempty"`
+	GroupName              string `xml:"group_name,omitempty"`
+	TotalBytesSecMaxLength uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIopsSecMaxLength  uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIopsSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIopsSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
 }
 
 type DiskAuth struct {
@@ -524,6 +572,7 @@ type DiskSource struct {
 	Protocol      string          `xml:"protocol,attr,omitempty"`
 	Name          string          `xml:"name,attr,omitempty"`
 	Host          *DiskSourceHost `xml:"host,omitempty"`
+	DataStore     *DataStore      `xml:"dataStore,omitempty"`
 }
 
 type DiskTarget struct {
@@ -557,8 +606,76 @@ type BackingStoreFormat struct {
 	Type string `xml:"type,attr"`
 }
 
+// DataStore is the raw image holding the data of a qcow2 image, which then only holds the metadata
+type DataStore struct {
+	Type   string              `xml:"type,attr"`
+	Format *BackingStoreFormat `xml:"format,omitempty"`
+	Source *DiskSource         `xml:"source,omitempty"`
+}
+
 // END Disk -----------------------------
 
+// BEGIN Backup -----------------------------
+
+type DomainBackup struct {
+	XMLName     xml.Name            `xml:"domainbackup"`
+	Mode        string              `xml:"mode,attr,omitempty"`
+	Incremental string              `xml:"incremental,omitempty"`
+	Server      *DomainBackupServer `xml:"server,omitempty"`
+	Disks       *DomainBackupDisks  `xml:"disks,omitempty"`
+}
+
+type DomainBackupServer struct {
+	Transport string `xml:"transport,attr,omitempty"`
+	Socket    string `xml:"socket,attr,omitempty"`
+}
+
+type DomainBackupDisks struct {
+	Disks []DomainBackupDisk `xml:"disk"`
+}
+
+type DomainBackupDisk struct {
+	Name       string                  `xml:"name,attr"`
+	Backup     string                  `xml:"backup,attr,omitempty"`
+	Type       string                  `xml:"type,attr,omitempty"`
+	ExportName string                  `xml:"exportname,attr,omitempty"`
+	Target     *DomainBackupDiskFile   `xml:"target,omitempty"`
+	Scratch    *DomainBackupDiskFile   `xml:"scratch,omitempty"`
+	Driver     *DomainBackupDiskDriver `xml:"driver,omitempty"`
+}
+
+type DomainBackupDiskFile struct {
+	File string `xml:"file,attr"`
+}
+
+type DomainBackupDiskDriver struct {
+	Type string `xml:"type,attr"`
+}
+
+type DomainCheckpoint struct {
+	XMLName      xml.Name                `xml:"domaincheckpoint"`
+	Name         string                  `xml:"name"`
+	CreationTime int64                   `xml:"creationTime,omitempty"`
+	Parent       *DomainCheckpointParent `xml:"parent,omitempty"`
+	Disks        *DomainCheckpointDisks  `xml:"disks,omitempty"`
+}
+
+type DomainCheckpointParent struct {
+	Name string `xml:"name"`
+}
+
+type DomainCheckpointDisks struct {
+	Disks []DomainCheckpointDisk `xml:"disk"`
+}
+
+type DomainCheckpointDisk struct {
+	Name       string `xml:"name,attr"`
+	Checkpoint string `xml:"checkpoint,attr,omitempty"`
+	Bitmap     string `xml:"bitmap,attr,omitempty"`
+}
+
+// END Backup -----------------------------
+
 // BEGIN Serial -----------------------------
 
 type Serial struct {
@@ -603,21 +720,30 @@ type ConsoleSource struct {
 // BEGIN Inteface -----------------------------
 
 type Interface struct {
-	Address             *Address         `xml:"address,omitempty"`
-	Type                string           `xml:"type,attr"`
-	TrustGuestRxFilters string           `xml:"trustGuestRxFilters,attr,omitempty"`
-	Source              InterfaceSource  `xml:"source"`
-	Target              *InterfaceTarget `xml:"target,omitempty"`
-	Model               *Model           `xml:"model,omitempty"`
-	MAC                 *MAC             `xml:"mac,omitempty"`
-	MTU                 *MTU             `xml:"mtu,omitempty"`
-	BandWidth           *BandWidth       `xml:"bandwidth,omitempty"`
-	BootOrder           *BootOrder       `xml:"boot,omitempty"`
-	LinkState           *LinkState       `xml:"link,omitempty"`
-	FilterRef           *FilterRef       `xml:"filterref,omitempty"`
-	Alias               *Alias           `xml:"alias,omitempty"`
-	Driver              *InterfaceDriver `xml:"driver,omitempty"`
-	Rom                 *Rom             `xml:"rom,omitempty"`
+	Address             *Address          `xml:"address,omitempty"`
+	Type                string            `xml:"type,attr"`
+	Managed             string            `xml:"managed,attr,omitempty"`
+	TrustGuestRxFilters string            `xml:"trustGuestRxFilters,attr,omitempty"`
+	Source              InterfaceSource   `xml:"source"`
+	Target              *InterfaceTarget  `xml:"target,omitempty"`
+	Model               *Model            `xml:"model,omitempty"`
+	MAC                 *MAC              `xml:"mac,omitempty"`
+	MTU                 *MTU              `xml:"mtu,omitempty"`
+	BandWidth           *BandWidth        `xml:"bandwidth,omitempty"`
+	BootOrder           *BootOrder        `xml:"boot,omitempty"`
+	LinkState           *LinkState        `xml:"link,omitempty"`
+	FilterRef           *FilterRef        `xml:"filterref,omitempty"`
+	Alias               *Alias            `xml:"alias,omitempty"`
+	Driver              *InterfaceDriver  `xml:"driver,omitempty"`
+	Rom                 *Rom              `xml:"rom,omitempty"`
+	Teaming             *InterfaceTeaming `xml:"teaming,omitempty"`
+}
+
+// InterfaceTeaming pairs a transient hostdev interface with a persistent virtio interface,
+// which keeps the guest connected while the hostdev is unplugged
+type InterfaceTeaming struct {
+	Type       string `xml:"type,attr"`
+	Persistent string `xml:"persistent,attr,omitempty"`
 }
 
 type InterfaceDriver struct {
@@ -630,6 +756,15 @@ type LinkState struct {
 }
 
 type BandWidth struct {
+	Inbound  *BandWidthLimits `xml:"inbound,omitempty"`
+	Outbound *BandWidthLimits `xml:"outbound,omitempty"`
+}
+
+// BandWidthLimits are in kilobytes per second, and kilobytes for the burst
+type BandWidthLimits struct {
+	Average uint64 `xml:"average,attr"`
+	Peak    uint64 `xml:"peak,attr,omitempty"`
+	Burst   uint64 `xml:"burst,attr,omitempty"`
 }
 
 type BootOrder struct {
@@ -0,0 +1,492 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	libvirt "libvirt.org/libvirt-go"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/log"
+	hotplugdisk "kubevirt.io/kubevirt/pkg/hotplug-disk"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	domainerrors "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/errors"
+)
+
+var backupMonitorInterval = 1 * time.Second
+
+// changedBlockTrackingLibvirtVersion is libvirt 10.10.0, the first version which supports the
+// dataStore of qcow2 disks, used to keep the bitmaps of raw disk images
+const changedBlockTrackingLibvirtVersion = 10010000
+
+// GetBackupNBDSocketPath returns the socket of the NBD server which exports the disks of a pull backup
+func GetBackupNBDSocketPath(vmi *v1.VirtualMachineInstance) string {
+	return fmt.Sprintf("/var/run/kubevirt-private/%s/virt-backup-nbd", vmi.ObjectMeta.UID)
+}
+
+// getChangedBlockTrackingDisks returns the disks whose data is stored in a raw image, and whose changed blocks
+// are tracked in the bitmaps of their qcow2 image
+func getChangedBlockTrackingDisks(domainSpec *api.DomainSpec) []api.Disk {
+	var disks []api.Disk
+	for _, disk := range domainSpec.Devices.Disks {
+		if disk.Source.DataStore != nil && disk.Alias != nil {
+			disks = append(disks, disk)
+		}
+	}
+	return disks
+}
+
+// checkChangedBlockTrackingSupported fails if libvirt can not attach the qcow2 images which keep
+// the bitmaps of the raw disk images with changed block tracking
+func checkChangedBlockTrackingSupported(virConn cli.Connection) error {
+	version, err := virConn.GetLibVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get the libvirt version: %v", err)
+	}
+	if version < changedBlockTrackingLibvirtVersion {
+		return fmt.Errorf("changed block tracking needs libvirt %s or newer, found libvirt %s",
+			formatLibvirtVersion(changedBlockTrackingLibvirtVersion), formatLibvirtVersion(version))
+	}
+	return nil
+}
+
+// formatLibvirtVersion formats a version encoded as major * 1,000,000 + minor * 1,000 + release
+func formatLibvirtVersion(version uint32) string {
+	return fmt.Sprintf("%d.%d.%d", version/1000000, version/1000%1000, version%1000)
+}
+
+func generateBackupXML(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceBackupOptions, disks []api.Disk) (string, error) {
+	backup := api.DomainBackup{
+		Disks: &api.DomainBackupDisks{},
+	}
+	if len(options.Checkpoints) > 0 {
+		backup.Incremental = options.Checkpoints[len(options.Checkpoints)-1].Name
+	}
+
+	switch options.Mode {
+	case v1.BackupModePull:
+		backup.Mode = "pull"
+		backup.Server = &api.DomainBackupServer{
+			Transport: "unix",
+			Socket:    GetBackupNBDSocketPath(vmi),
+		}
+	case v1.BackupModePush, "":
+		if options.Target == nil {
+			return "", fmt.Errorf("a push backup needs a target")
+		}
+		backup.Mode = "push"
+	default:
+		return "", fmt.Errorf("unknown backup mode %s", options.Mode)
+	}
+
+	for _, disk := range disks {
+		volumeName := disk.Alias.GetName()
+		backupDisk := api.DomainBackupDisk{
+			Name:   disk.Target.Device,
+			Backup: "yes",
+			Type:   "file",
+		}
+		if backup.Mode == "pull" {
+			backupDisk.ExportName = volumeName
+			// The scratch image keeps the blocks which the guest overwrites while they are exported
+			backupDisk.Scratch = &api.DomainBackupDiskFile{
+				File: fmt.Sprintf("/var/run/kubevirt-private/%s/backup-scratch-%s.qcow2", vmi.ObjectMeta.UID, volumeName),
+			}
+		} else {
+			backupDisk.Target = &api.DomainBackupDiskFile{
+				File: filepath.Join(hotplugdisk.GetBackupTargetDir(options.BackupName), hotplugdisk.GetBackupVolumePath(options.BackupName, volumeName)),
+			}
+			backupDisk.Driver = &api.DomainBackupDiskDriver{Type: "qcow2"}
+		}
+		backup.Disks.Disks = append(backup.Disks.Disks, backupDisk)
+	}
+
+	data, err := xml.Marshal(backup)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func generateCheckpointXML(name string, disks []api.Disk) (string, error) {
+	checkpoint := api.DomainCheckpoint{
+		Name:  name,
+		Disks: &api.DomainCheckpointDisks{},
+	}
+	for _, disk := range disks {
+		checkpoint.Disks.Disks = append(checkpoint.Disks.Disks, api.DomainCheckpointDisk{
+			Name:       disk.Target.Device,
+			Checkpoint: "bitmap",
+		})
+	}
+	data, err := xml.Marshal(checkpoint)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// generateRedefinedCheckpointXML describes a checkpoint whose bitmaps exist in the images of the disks,
+// but which is unknown to libvirt, as after a restart of the domain
+func generateRedefinedCheckpointXML(checkpoint v1.BackupCheckpoint, parent string, disks []api.Disk) (string, error) {
+	targets := map[string]string{}
+	for _, disk := range disks {
+		targets[disk.Alias.GetName()] = disk.Target.Device
+	}
+
+	domainCheckpoint := api.DomainCheckpoint{
+		Name:         checkpoint.Name,
+		CreationTime: checkpoint.CreationTimestamp.Unix(),
+		Disks:        &api.DomainCheckpointDisks{},
+	}
+	if parent != "" {
+		domainCheckpoint.Parent = &api.DomainCheckpointParent{Name: parent}
+	}
+	for _, volumeName := range checkpoint.Volumes {
+		target, ok := targets[volumeName]
+		if !ok {
+			return "", fmt.Errorf("volume %s of checkpoint %s does not have changed block tracking", volumeName, checkpoint.Name)
+		}
+		domainCheckpoint.Disks.Disks = append(domainCheckpoint.Disks.Disks, api.DomainCheckpointDisk{
+			Name:       target,
+			Checkpoint: "bitmap",
+			Bitmap:     checkpoint.Name,
+		})
+	}
+	data, err := xml.Marshal(domainCheckpoint)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// verifyIncrementalDisks ensures that the changed blocks of all disks are tracked since the checkpoint
+// an incremental backup is taken to
+func verifyIncrementalDisks(checkpoint v1.BackupCheckpoint, disks []api.Disk) error {
+	tracked := map[string]bool{}
+	for _, volumeName := range checkpoint.Volumes {
+		tracked[volumeName] = true
+	}
+	for _, disk := range disks {
+		if !tracked[disk.Alias.GetName()] {
+			return fmt.Errorf("the changed blocks of volume %s are not tracked since checkpoint %s, a full backup is needed", disk.Alias.GetName(), checkpoint.Name)
+		}
+	}
+	return nil
+}
+
+func listCheckpointNames(dom cli.VirDomain) (map[string]bool, error) {
+	checkpoints, err := dom.ListAllCheckpoints(0)
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]bool{}
+	for i := range checkpoints {
+		name, err := checkpoints[i].GetName()
+		checkpoints[i].Free()
+		if err != nil {
+			return nil, err
+		}
+		names[name] = true
+	}
+	return names, nil
+}
+
+// redefineCheckpoints makes the chain of checkpoints known to libvirt again
+func redefineCheckpoints(dom cli.VirDomain, checkpoints []v1.BackupCheckpoint, disks []api.Disk) error {
+	existing, err := listCheckpointNames(dom)
+	if err != nil {
+		return err
+	}
+
+	parent := ""
+	for _, checkpoint := range checkpoints {
+		if !existing[checkpoint.Name] {
+			checkpointXML, err := generateRedefinedCheckpointXML(checkpoint, parent, disks)
+			if err != nil {
+				return err
+			}
+			domainCheckpoint, err := dom.CreateCheckpointXML(checkpointXML, libvirt.DOMAIN_CHECKPOINT_CREATE_REDEFINE)
+			if err != nil {
+				return fmt.Errorf("failed to redefine checkpoint %s: %v", checkpoint.Name, err)
+			}
+			domainCheckpoint.Free()
+		}
+		parent = checkpoint.Name
+	}
+	return nil
+}
+
+// deleteCheckpoints removes all checkpoints and their bitmaps before a full backup starts a new chain
+func deleteCheckpoints(dom cli.VirDomain) error {
+	roots, err := dom.ListAllCheckpoints(libvirt.DOMAIN_CHECKPOINT_LIST_ROOTS)
+	if err != nil {
+		return err
+	}
+	for i := range roots {
+		err := roots[i].Delete(libvirt.DOMAIN_CHECKPOINT_DELETE_CHILDREN)
+		roots[i].Free()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// BackupVMI starts a backup job of the disks with changed block tracking. A backup is one shot,
+// the same backup is never started twice.
+func (l *LibvirtDomainManager) BackupVMI(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceBackupOptions) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	logger := log.Log.Object(vmi)
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		if domainerrors.IsNotFound(err) {
+			return fmt.Errorf("Domain not found.")
+		}
+		logger.Reason(err).Error("Getting the domain for backup failed.")
+		return err
+	}
+	defer dom.Free()
+
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+
+	if backupMetadata := domainSpec.Metadata.KubeVirt.Backup; backupMetadata != nil {
+		if backupMetadata.Name == options.BackupName {
+			return nil
+		}
+		if backupMetadata.EndTimestamp == nil {
+			return fmt.Errorf("backup %s is still running", backupMetadata.Name)
+		}
+	}
+	if isMigrationInProgress(domainSpec) {
+		return fmt.Errorf("the VMI is migrating")
+	}
+
+	disks := getChangedBlockTrackingDisks(domainSpec)
+	if len(disks) == 0 {
+		return fmt.Errorf("the VMI does not have disks with changed block tracking")
+	}
+
+	if len(options.Checkpoints) > 0 {
+		if err := verifyIncrementalDisks(options.Checkpoints[len(options.Checkpoints)-1], disks); err != nil {
+			return err
+		}
+		if err := redefineCheckpoints(dom, options.Checkpoints, disks); err != nil {
+			return err
+		}
+	} else if err := deleteCheckpoints(dom); err != nil {
+		return fmt.Errorf("failed to delete the checkpoints of previous backups: %v", err)
+	}
+
+	backupXML, err := generateBackupXML(vmi, options, disks)
+	if err != nil {
+		return err
+	}
+	checkpointXML, err := generateCheckpointXML(options.Checkpoint, disks)
+	if err != nil {
+		return err
+	}
+	logger.V(3).Infof("Starting backup with %s and checkpoint %s", backupXML, checkpointXML)
+	if err := dom.BackupBegin(backupXML, checkpointXML, 0); err != nil {
+		logger.Reason(err).Errorf("Starting backup %s failed.", options.BackupName)
+		return err
+	}
+
+	now := metav1.Now()
+	domainSpec.Metadata.KubeVirt.Backup = &api.BackupMetadata{
+		Name:           options.BackupName,
+		Checkpoint:     options.Checkpoint,
+		Mode:           options.Mode,
+		StartTimestamp: &now,
+	}
+	d, err := l.setDomainSpecWithHooks(vmi, domainSpec)
+	if err != nil {
+		return err
+	}
+	defer d.Free()
+
+	logger.Infof("Started backup %s", options.BackupName)
+	go l.backupMonitor(vmi, options.BackupName)
+	return nil
+}
+
+// AbortVMIBackup aborts a running backup job. This is how the export of the disks of a pull backup ends.
+func (l *LibvirtDomainManager) AbortVMIBackup(vmi *v1.VirtualMachineInstance, options *v1.VirtualMachineInstanceAbortBackupOptions) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		if domainerrors.IsNotFound(err) {
+			return fmt.Errorf("Domain not found.")
+		}
+		return err
+	}
+	defer dom.Free()
+
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+
+	backupMetadata := domainSpec.Metadata.KubeVirt.Backup
+	if backupMetadata == nil || backupMetadata.Name != options.BackupName {
+		return fmt.Errorf("backup %s is not running", options.BackupName)
+	}
+	if backupMetadata.EndTimestamp != nil || backupMetadata.Aborted {
+		return nil
+	}
+
+	backupMetadata.Aborted = true
+	d, err := l.setDomainSpecWithHooks(vmi, domainSpec)
+	if err != nil {
+		return err
+	}
+	defer d.Free()
+
+	if err := dom.AbortJob(); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Aborting backup %s failed.", options.BackupName)
+		return err
+	}
+	log.Log.Object(vmi).Infof("Aborted backup %s", options.BackupName)
+	return nil
+}
+
+// backupMonitor waits for the end of the backup job, and records its result
+func (l *LibvirtDomainManager) backupMonitor(vmi *v1.VirtualMachineInstance, backupName string) {
+	logger := log.Log.Object(vmi)
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		logger.Reason(err).Errorf("Monitoring backup %s failed.", backupName)
+		return
+	}
+	defer dom.Free()
+
+	for {
+		time.Sleep(backupMonitorInterval)
+
+		info, err := dom.GetJobInfo()
+		if err != nil {
+			logger.Reason(err).Error("failed to get domain job info")
+			if domainerrors.IsNotFound(err) {
+				return
+			}
+			continue
+		}
+		if info.Type != libvirt.DOMAIN_JOB_NONE {
+			continue
+		}
+
+		stats, err := dom.GetJobStats(libvirt.DOMAIN_JOB_STATS_COMPLETED)
+		if err != nil {
+			logger.Reason(err).Error("failed to get the stats of the completed domain job")
+			l.setBackupResult(vmi, backupName, false, fmt.Sprintf("unable to get the result of the backup: %v", err))
+			return
+		}
+		message := ""
+		succeeded := stats.Type == libvirt.DOMAIN_JOB_COMPLETED
+		if !succeeded {
+			message = "backup job failed"
+			if stats.ErrorMessageSet {
+				message = stats.ErrorMessage
+			}
+		}
+		l.setBackupResult(vmi, backupName, succeeded, message)
+		return
+	}
+}
+
+func (l *LibvirtDomainManager) setBackupResult(vmi *v1.VirtualMachineInstance, backupName string, succeeded bool, message string) {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	logger := log.Log.Object(vmi)
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		logger.Reason(err).Errorf("Recording the result of backup %s failed.", backupName)
+		return
+	}
+	defer dom.Free()
+
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		logger.Reason(err).Errorf("Recording the result of backup %s failed.", backupName)
+		return
+	}
+	backupMetadata := domainSpec.Metadata.KubeVirt.Backup
+	if backupMetadata == nil || backupMetadata.Name != backupName {
+		return
+	}
+
+	// The export of the disks of a pull backup ends by aborting it
+	if !succeeded && backupMetadata.Aborted && backupMetadata.Mode == v1.BackupModePull {
+		succeeded = true
+		message = ""
+	}
+
+	now := metav1.Now()
+	backupMetadata.Completed = true
+	backupMetadata.EndTimestamp = &now
+	if !succeeded {
+		backupMetadata.Failed = true
+		backupMetadata.Message = message
+		if backupMetadata.Aborted {
+			backupMetadata.Message = "backup aborted"
+		}
+		// The checkpoint of a failed backup is deleted, which merges its bitmaps into the previous checkpoint
+		if checkpoint, err := dom.CheckpointLookupByName(backupMetadata.Checkpoint, 0); err == nil {
+			if err := checkpoint.Delete(0); err != nil {
+				logger.Reason(err).Warningf("Deleting checkpoint %s of the failed backup %s failed.", backupMetadata.Checkpoint, backupName)
+			}
+			checkpoint.Free()
+		}
+	}
+
+	d, err := l.setDomainSpecWithHooks(vmi, domainSpec)
+	if err != nil {
+		logger.Reason(err).Errorf("Recording the result of backup %s failed.", backupName)
+		return
+	}
+	defer d.Free()
+
+	if succeeded {
+		logger.Infof("Backup %s succeeded", backupName)
+	} else {
+		logger.Infof("Backup %s failed: %s", backupName, backupMetadata.Message)
+	}
+}
@@ -47,11 +47,13 @@ type Connection interface {
 	DomainEventLifecycleRegister(callback libvirt.DomainEventLifecycleCallback) error
 	DomainEventDeviceAddedRegister(callback libvirt.DomainEventDeviceAddedCallback) error
 	DomainEventDeviceRemovedRegister(callback libvirt.DomainEventDeviceRemovedCallback) error
+	DomainEventTunableRegister(callback libvirt.DomainEventTunableCallback) error
 	AgentEventLifecycleRegister(callback libvirt.DomainEventAgentLifecycleCallback) error
 	ListAllDomains(flags libvirt.ConnectListAllDomainsFlags) ([]VirDomain, error)
 	NewStream(flags libvirt.StreamFlags) (Stream, error)
 	SetReconnectChan(reconnect chan bool)
 	QemuAgentCommand(command string, domainName string) (string, error)
+	GetLibVersion() (uint32, error)
 	GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error)
 	// helper method, not found in libvirt
 	// We add this helper to
@@ -82,6 +84,7 @@ type LibvirtConnection struct {
 	domainEventCallbacks                   []libvirt.DomainEventLifecycleCallback
 	domainDeviceAddedEventCallbacks        []libvirt.DomainEventDeviceAddedCallback
 	domainDeviceRemovedEventCallbacks      []libvirt.DomainEventDeviceRemovedCallback
+	domainTunableEventCallbacks            []libvirt.DomainEventTunableCallback
 	domainEventMigrationIterationCallbacks []libvirt.DomainEventMigrationIterationCallback
 	agentEventCallbacks                    []libvirt.DomainEventAgentLifecycleCallback
 }
@@ -170,6 +173,17 @@ func (l *LibvirtConnection) DomainEventDeviceRemovedRegister(callback libvirt.Do
 	return
 }
 
+func (l *LibvirtConnection) DomainEventTunableRegister(callback libvirt.DomainEventTunableCallback) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	l.domainTunableEventCallbacks = append(l.domainTunableEventCallbacks, callback)
+	_, err = l.Connect.DomainEventTunableRegister(nil, callback)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) AgentEventLifecycleRegister(callback libvirt.DomainEventAgentLifecycleCallback) (err error) {
 	if err = l.reconnectIfNecessary(); err != nil {
 		return
@@ -234,6 +248,13 @@ func (l *LibvirtConnection) QemuAgentCommand(command string, domainName string)
 	return result, err
 }
 
+func (l *LibvirtConnection) GetLibVersion() (uint32, error) {
+	if err := l.reconnectIfNecessary(); err != nil {
+		return 0, err
+	}
+	return l.Connect.GetLibVersion()
+}
+
 func (l *LibvirtConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	if err := l.reconnectIfNecessary(); err != nil {
 		return nil, err
@@ -347,6 +368,10 @@ func (l *LibvirtConnection) reconnectIfNecessary() (err error) {
 			log.Log.Info("Re-registered domain device removed callback")
 			_, err = l.Connect.DomainEventDeviceRemovedRegister(nil, callback)
 		}
+		for _, callback := range l.domainTunableEventCallbacks {
+			log.Log.Info("Re-registered domain tunable callback")
+			_, err = l.Connect.DomainEventTunableRegister(nil, callback)
+		}
 
 		log.Log.Error("Re-registered domain and agent callbacks for new connection")
 
@@ -394,6 +419,10 @@ type VirDomain interface {
 	Resume() error
 	AttachDevice(xml string) error
 	DetachDevice(xml string) error
+	UpdateDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error
+	GetBlockInfo(disk string, flags uint) (*libvirt.DomainBlockInfo, error)
+	BlockResize(disk string, size uint64, flags libvirt.DomainBlockResizeFlags) error
+	SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error
 	DestroyFlags(flags libvirt.DomainDestroyFlags) error
 	ShutdownFlags(flags libvirt.DomainShutdownFlags) error
 	UndefineFlags(flags libvirt.DomainUndefineFlagsValues) error
@@ -411,6 +440,10 @@ type VirDomain interface {
 	SetTime(secs int64, nsecs uint, flags libvirt.DomainSetTimeFlags) error
 	IsPersistent() (bool, error)
 	AbortJob() error
+	BackupBegin(backupXML string, checkpointXML string, flags libvirt.DomainBackupBeginFlags) error
+	CreateCheckpointXML(xml string, flags libvirt.DomainCheckpointCreateFlags) (*libvirt.DomainCheckpoint, error)
+	CheckpointLookupByName(name string, flags uint32) (*libvirt.DomainCheckpoint, error)
+	ListAllCheckpoints(flags libvirt.DomainCheckpointListFlags) ([]libvirt.DomainCheckpoint, error)
 	Free() error
 }
 
@@ -202,6 +202,129 @@ func (l *Launcher) UnpauseVirtualMachine(ctx context.Context, request *cmdv1.VMI
 	return response, nil
 }
 
+func (l *Launcher) FreezeVirtualMachine(ctx context.Context, request *cmdv1.FreezeUnfreezeRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.FreezeVMI(vmi, request.UnfreezeTimeoutSeconds); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to freeze vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Freezed vmi")
+	return response, nil
+}
+
+func (l *Launcher) UnfreezeVirtualMachine(ctx context.Context, request *cmdv1.FreezeUnfreezeRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.UnfreezeVMI(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to unfreeze vmi")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Unfreezed vmi")
+	return response, nil
+}
+
+func (l *Launcher) ResizeVirtualMachineVolumes(ctx context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.ResizeVMIVolumes(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to resize vmi volumes")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Info("Resized vmi volumes")
+	return response, nil
+}
+
+func (l *Launcher) BackupVirtualMachine(ctx context.Context, request *cmdv1.BackupRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	options := &v1.VirtualMachineInstanceBackupOptions{}
+	if err := json.Unmarshal(request.Options, options); err != nil {
+		response.Success = false
+		response.Message = fmt.Sprintf("no valid backup options object present in command server request: %v", err)
+		return response, nil
+	}
+
+	if err := l.domainManager.BackupVMI(vmi, options); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to start backup %s", options.BackupName)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Signaled backup %s", options.BackupName)
+	return response, nil
+}
+
+func (l *Launcher) AbortVirtualMachineBackup(ctx context.Context, request *cmdv1.BackupRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	options := &v1.VirtualMachineInstanceAbortBackupOptions{}
+	if err := json.Unmarshal(request.Options, options); err != nil {
+		response.Success = false
+		response.Message = fmt.Sprintf("no valid abort backup options object present in command server request: %v", err)
+		return response, nil
+	}
+
+	if err := l.domainManager.AbortVMIBackup(vmi, options); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to abort backup %s", options.BackupName)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Signaled abort of backup %s", options.BackupName)
+	return response, nil
+}
+
+func (l *Launcher) SetVirtualMachineBlockIOTune(ctx context.Context, request *cmdv1.BlockIOTuneRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	options := &v1.SetBlockIOTuneOptions{}
+	if err := json.Unmarshal(request.Options, options); err != nil {
+		response.Success = false
+		response.Message = fmt.Sprintf("no valid block I/O tune options object present in command server request: %v", err)
+		return response, nil
+	}
+
+	if err := l.domainManager.SetVMIBlockIOTune(vmi, options); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to set the I/O limits of disk %s", options.Name)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Set the I/O limits of disk %s", options.Name)
+	return response, nil
+}
+
 func (l *Launcher) KillVirtualMachine(ctx context.Context, request *cmdv1.VMIRequest) (*cmdv1.Response, error) {
 
 	vmi, response := getVMIFromRequest(request.Vmi)
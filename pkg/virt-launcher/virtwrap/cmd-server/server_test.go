@@ -120,6 +120,60 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should freeze a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().FreezeVMI(vmi, int32(300))
+			err := client.FreezeVirtualMachine(vmi, 300)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should unfreeze a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().UnfreezeVMI(vmi)
+			err := client.UnfreezeVirtualMachine(vmi)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should resize the volumes of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().ResizeVMIVolumes(vmi)
+			err := client.ResizeVirtualMachineVolumes(vmi)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should back up a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			options := &v1.VirtualMachineInstanceBackupOptions{
+				BackupName: "mybackup",
+				Mode:       v1.BackupModePush,
+				Checkpoint: "mybackup",
+				Target:     &v1.BackupTarget{ClaimName: "backups", PodUID: "1234"},
+			}
+			domainManager.EXPECT().BackupVMI(vmi, options)
+			err := client.BackupVirtualMachine(vmi, options)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should abort the backup of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			options := &v1.VirtualMachineInstanceAbortBackupOptions{BackupName: "mybackup"}
+			domainManager.EXPECT().AbortVMIBackup(vmi, options)
+			err := client.AbortVirtualMachineBackup(vmi, options)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should set the I/O limits of a disk of a vmi", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			totalIOPS := int64(500)
+			options := &v1.SetBlockIOTuneOptions{
+				Name:   "rootdisk",
+				IOTune: v1.DiskIOTune{TotalIOPSSec: &totalIOPS},
+			}
+			domainManager.EXPECT().SetVMIBlockIOTune(vmi, options)
+			err := client.SetVirtualMachineBlockIOTune(vmi, options)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should list domains", func() {
 			var list []*api.Domain
 			list = append(list, api.NewMinimalDomain("testvmi1"))
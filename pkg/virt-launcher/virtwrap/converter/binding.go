@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package converter
+
+import (
+	"fmt"
+	"sync"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// The names of the built-in bindings, the bindings selected with a binding
+// plugin are named after the plugin.
+const (
+	BridgeBindingName     = "bridge"
+	MasqueradeBindingName = "masquerade"
+	SlirpBindingName      = "slirp"
+	SriovBindingName      = "sriov"
+	MacvtapBindingName    = "macvtap"
+)
+
+// InterfaceBinding completes the libvirt interface of a VMI interface, which
+// is added to the domain afterwards.
+type InterfaceBinding func(iface *v1.Interface, network *v1.Network, domain *api.Domain, domainIface *api.Interface) error
+
+var (
+	interfaceBindingsLock sync.RWMutex
+	interfaceBindings     = map[string]InterfaceBinding{}
+)
+
+// SR-IOV devices are passed through as host devices and the interfaces of
+// binding plugins are added by their hook sidecar, so no libvirt interface
+// is converted for them.
+func init() {
+	RegisterInterfaceBinding(BridgeBindingName, convertTapInterface)
+	RegisterInterfaceBinding(MasqueradeBindingName, convertTapInterface)
+	RegisterInterfaceBinding(SlirpBindingName, convertSlirpInterface)
+	RegisterInterfaceBinding(MacvtapBindingName, convertMacvtapInterface)
+}
+
+// RegisterInterfaceBinding registers the conversion of the libvirt interface
+// of the binding with the given name, replacing any conversion previously
+// registered under the same name.
+func RegisterInterfaceBinding(name string, binding InterfaceBinding) {
+	interfaceBindingsLock.Lock()
+	defer interfaceBindingsLock.Unlock()
+	interfaceBindings[name] = binding
+}
+
+func lookupInterfaceBinding(name string) (InterfaceBinding, bool) {
+	interfaceBindingsLock.RLock()
+	defer interfaceBindingsLock.RUnlock()
+	binding, exists := interfaceBindings[name]
+	return binding, exists
+}
+
+// GetInterfaceBindingName returns the name of the binding selected by the interface
+func GetInterfaceBindingName(iface *v1.Interface) string {
+	switch {
+	case iface.Binding != nil:
+		return iface.Binding.Name
+	case iface.Bridge != nil:
+		return BridgeBindingName
+	case iface.Masquerade != nil:
+		return MasqueradeBindingName
+	case iface.Slirp != nil:
+		return SlirpBindingName
+	case iface.SRIOV != nil:
+		return SriovBindingName
+	case iface.Macvtap != nil:
+		return MacvtapBindingName
+	}
+	return ""
+}
+
+// convertTapInterface uses the "ethernet" interface type, since the bindings
+// pre-configure the tap devices
+// https://libvirt.org/formatdomain.html#elementsNICSEthernet
+func convertTapInterface(iface *v1.Interface, network *v1.Network, domain *api.Domain, domainIface *api.Interface) error {
+	domainIface.Type = "ethernet"
+	if iface.BootOrder != nil {
+		domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
+	} else {
+		domainIface.Rom = &api.Rom{Enabled: "no"}
+	}
+	return nil
+}
+
+func convertSlirpInterface(iface *v1.Interface, network *v1.Network, domain *api.Domain, domainIface *api.Interface) error {
+	domainIface.Type = "user"
+
+	// Create network interface
+	initializeQEMUCmdAndQEMUArg(domain)
+
+	// TODO: (seba) Need to change this if multiple interface can be connected to the same network
+	// append the ports from all the interfaces connected to the same network
+	return createSlirpNetwork(*iface, *network, domain)
+}
+
+func convertMacvtapInterface(iface *v1.Interface, network *v1.Network, domain *api.Domain, domainIface *api.Interface) error {
+	if network.Multus == nil {
+		return fmt.Errorf("macvtap interface %s requires Multus meta-cni", iface.Name)
+	}
+	return convertTapInterface(iface, network, domain, domainIface)
+}
@@ -39,6 +39,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/sriov"
 
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/client-go/log"
@@ -94,6 +95,7 @@ type ConverterContext struct {
 	PermanentVolumes      map[string]v1.VolumeStatus
 	DiskType              map[string]*containerdisk.DiskInfo
 	SRIOVDevices          []api.HostDevice
+	SRIOVInterfaces       []api.Interface
 	SMBios                *cmdv1.SMBios
 	GpuDevices            []string
 	VgpuDevices           []string
@@ -219,10 +221,51 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
+	if diskDevice.IOTune != nil {
+		disk.IOTune = Convert_v1_DiskIOTune_To_api_DiskIOTune(diskDevice.IOTune)
+	}
 
 	return nil
 }
 
+// Convert_v1_DiskIOTune_To_api_DiskIOTune converts the I/O limits of a disk, unset limits are left out
+func Convert_v1_DiskIOTune_To_api_DiskIOTune(ioTune *v1.DiskIOTune) *api.DiskIOTune {
+	bytes := func(q *resource.Quantity) uint64 {
+		if q == nil {
+			return 0
+		}
+		return uint64(q.Value())
+	}
+	count := func(i *int64) uint64 {
+		if i == nil {
+			return 0
+		}
+		return uint64(*i)
+	}
+	return &api.DiskIOTune{
+		TotalBytesSec:          bytes(ioTune.TotalBytesSec),
+		ReadBytesSec:           bytes(ioTune.ReadBytesSec),
+		WriteBytesSec:          bytes(ioTune.WriteBytesSec),
+		TotalIopsSec:           count(ioTune.TotalIOPSSec),
+		ReadIopsSec:            count(ioTune.ReadIOPSSec),
+		WriteIopsSec:           count(ioTune.WriteIOPSSec),
+		TotalBytesSecMax:       bytes(ioTune.TotalBytesSecMax),
+		ReadBytesSecMax:        bytes(ioTune.ReadBytesSecMax),
+		WriteBytesSecMax:       bytes(ioTune.WriteBytesSecMax),
+		TotalIopsSecMax:        count(ioTune.TotalIOPSSecMax),
+		ReadIopsSecMax:         count(ioTune.ReadIOPSSecMax),
+		WriteIopsSecMax:        count(ioTune.WriteIOPSSecMax),
+		SizeIopsSec:            bytes(ioTune.SizeIOPSSec),
+		GroupName:              ioTune.GroupName,
+		TotalBytesSecMaxLength: count(ioTune.TotalBytesSecMaxLength),
+		ReadBytesSecMaxLength:  count(ioTune.ReadBytesSecMaxLength),
+		WriteBytesSecMaxLength: count(ioTune.WriteBytesSecMaxLength),
+		TotalIopsSecMaxLength:  count(ioTune.TotalIOPSSecMaxLength),
+		ReadIopsSecMaxLength:   count(ioTune.ReadIOPSSecMaxLength),
+		WriteIopsSecMaxLength:  count(ioTune.WriteIOPSSecMaxLength),
+	}
+}
+
 func checkDirectIOFlag(path string) bool {
 	// check if fs where disk.img file is located or block device
 	// support direct i/o
@@ -240,7 +283,10 @@ func SetDriverCacheMode(disk *api.Disk) error {
 	supportDirectIO := true
 	mode := v1.DriverCache(disk.Driver.Cache)
 
-	if disk.Source.File != "" {
+	if IsEmptyCDRom(disk) {
+		// The cache mode is set when a media is inserted
+		return nil
+	} else if disk.Source.File != "" {
 		path = disk.Source.File
 	} else if disk.Source.Dev != "" {
 		path = disk.Source.Dev
@@ -263,6 +309,15 @@ func SetDriverCacheMode(disk *api.Disk) error {
 			}
 			supportDirectIO = supportDirectIO && backFileDirectIOSupport
 		}
+		// the same applies to the raw image holding the data of a qcow2 image
+		if dataStore := disk.Source.DataStore; dataStore != nil && dataStore.Source != nil {
+			dataFilePath := dataStore.Source.File
+			dataFileDirectIOSupport := checkDirectIOFlag(dataFilePath)
+			if !dataFileDirectIOSupport {
+				log.Log.Infof("%s data file system does not support direct I/O", dataFilePath)
+			}
+			supportDirectIO = supportDirectIO && dataFileDirectIOSupport
+		}
 	}
 
 	// if user set a cache mode = 'none' and fs does not support direct I/O then return an error
@@ -293,6 +348,23 @@ func isPreAllocated(path string) bool {
 	return diskInf.VirtualSize <= diskInf.ActualSize
 }
 
+// isHotplugVolumeAttached returns true if the hotplugged volume is mounted into the virt-launcher pod
+func isHotplugVolumeAttached(status v1.VolumeStatus) bool {
+	return status.Phase == v1.HotplugVolumeMounted || status.Phase == v1.VolumeReady
+}
+
+// convertEmptyCDRomToApiDisk turns the disk into a CD-ROM without media
+func convertEmptyCDRomToApiDisk(disk *api.Disk) {
+	disk.Type = "file"
+	disk.Source = api.DiskSource{}
+	disk.Driver.Type = "raw"
+}
+
+// IsEmptyCDRom returns true if no media is inserted in the CD-ROM
+func IsEmptyCDRom(disk *api.Disk) bool {
+	return disk.Device == "cdrom" && disk.Source.File == "" && disk.Source.Dev == ""
+}
+
 // Set optimal io mode automatically
 func SetOptimalIOMode(disk *api.Disk) error {
 	var path string
@@ -584,6 +656,31 @@ func Convert_v1_HostDisk_To_api_Disk(volumeName string, path string, disk *api.D
 	return nil
 }
 
+// GetChangedBlockTrackingImagePath returns the path of the qcow2 image which holds the dirty bitmaps
+// of the raw disk image at path. It is kept next to the raw image, so that the bitmaps survive restarts.
+func GetChangedBlockTrackingImagePath(path string) string {
+	return path + ".cbt.qcow2"
+}
+
+// Convert_api_Disk_To_ChangedBlockTracking_Disk turns a raw file disk into a qcow2 disk whose data
+// is stored in the raw image, so that persistent dirty bitmaps can be added for incremental backups
+// while the raw image stays usable on its own
+func Convert_api_Disk_To_ChangedBlockTracking_Disk(disk *api.Disk) error {
+	if disk.Type != "file" || disk.Driver.Type != "raw" || disk.Source.File == "" {
+		return fmt.Errorf("changed block tracking requires a raw disk image on a filesystem, disk %s is not", disk.Alias.GetName())
+	}
+	disk.Driver.Type = "qcow2"
+	disk.Source = api.DiskSource{
+		File: GetChangedBlockTrackingImagePath(disk.Source.File),
+		DataStore: &api.DataStore{
+			Type:   "file",
+			Format: &api.BackingStoreFormat{Type: "raw"},
+			Source: &api.DiskSource{File: disk.Source.File},
+		},
+	}
+	return nil
+}
+
 func Convert_v1_SysprepSource_To_api_Disk(volumeName string, disk *api.Disk, c *ConverterContext) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf("device %s is of type lun. Not compatible with a file based disk", disk.Alias.GetName())
@@ -1189,17 +1286,33 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			return err
 		}
 		volume := volumes[disk.Name]
-		if volume == nil {
-			return fmt.Errorf("No matching volume with name %s found", disk.Name)
-		}
-
-		if _, ok := c.HotplugVolumes[disk.Name]; !ok {
-			err = Convert_v1_Volume_To_api_Disk(volume, &newDisk, c, volumeIndices[disk.Name])
+		hpStatus, hpOk := c.HotplugVolumes[disk.Name]
+		// A CD-ROM without media, or whose media is not attached yet, has an empty tray
+		emptyTray := disk.CDRom != nil && (volume == nil || (hpOk && !isHotplugVolumeAttached(hpStatus)))
+		if emptyTray {
+			convertEmptyCDRomToApiDisk(&newDisk)
 		} else {
-			err = Convert_v1_Hotplug_Volume_To_api_Disk(volume, &newDisk, c)
-		}
-		if err != nil {
-			return err
+			if volume == nil {
+				return fmt.Errorf("No matching volume with name %s found", disk.Name)
+			}
+
+			if !hpOk {
+				err = Convert_v1_Volume_To_api_Disk(volume, &newDisk, c, volumeIndices[disk.Name])
+			} else {
+				err = Convert_v1_Hotplug_Volume_To_api_Disk(volume, &newDisk, c)
+			}
+			if err != nil {
+				return err
+			}
+
+			if disk.ChangedBlockTracking {
+				if hpOk {
+					return fmt.Errorf("changed block tracking is not supported for the hotplugged disk %s", disk.Name)
+				}
+				if err := Convert_api_Disk_To_ChangedBlockTracking_Disk(&newDisk); err != nil {
+					return err
+				}
+			}
 		}
 
 		if useIOThreads {
@@ -1221,9 +1334,8 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			newDisk.Driver.IOThread = &ioThreadId
 		}
 
-		hpStatus, hpOk := c.HotplugVolumes[disk.Name]
 		// if len(c.PermanentVolumes) == 0, it means the vmi is not ready yet, add all disks
-		if _, ok := c.PermanentVolumes[disk.Name]; ok || len(c.PermanentVolumes) == 0 || (hpOk && (hpStatus.Phase == v1.HotplugVolumeMounted || hpStatus.Phase == v1.VolumeReady)) {
+		if _, ok := c.PermanentVolumes[disk.Name]; ok || len(c.PermanentVolumes) == 0 || emptyTray || (hpOk && isHotplugVolumeAttached(hpStatus)) {
 			domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, newDisk)
 		}
 	}
@@ -1495,7 +1607,8 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			return fmt.Errorf("failed to find network %s", iface.Name)
 		}
 
-		if iface.SRIOV != nil {
+		binding, isRegistered := lookupInterfaceBinding(GetInterfaceBindingName(&iface))
+		if !isRegistered {
 			continue
 		}
 
@@ -1529,46 +1642,22 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			domainIface.Address = addr
 		}
 
-		if iface.Bridge != nil || iface.Masquerade != nil {
-			// TODO:(ihar) consider abstracting interface type conversion /
-			// detection into drivers
-
-			// use "ethernet" interface type, since we're using pre-configured tap devices
-			// https://libvirt.org/formatdomain.html#elementsNICSEthernet
-			domainIface.Type = "ethernet"
-			if iface.BootOrder != nil {
-				domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
-			} else {
-				domainIface.Rom = &api.Rom{Enabled: "no"}
-			}
-		} else if iface.Slirp != nil {
-			domainIface.Type = "user"
-
-			// Create network interface
-			initializeQEMUCmdAndQEMUArg(domain)
-
-			// TODO: (seba) Need to change this if multiple interface can be connected to the same network
-			// append the ports from all the interfaces connected to the same network
-			err := createSlirpNetwork(iface, *net, domain)
-			if err != nil {
-				return err
-			}
-		} else if iface.Macvtap != nil {
-			if net.Multus == nil {
-				return fmt.Errorf("macvtap interface %s requires Multus meta-cni", iface.Name)
-			}
+		if err := binding(&iface, net, domain, &domainIface); err != nil {
+			return err
+		}
 
-			domainIface.Type = "ethernet"
-			if iface.BootOrder != nil {
-				domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
-			} else {
-				domainIface.Rom = &api.Rom{Enabled: "no"}
-			}
+		// Only traffic of tap devices can be shaped by libvirt
+		if iface.Bandwidth != nil && domainIface.Type == "ethernet" {
+			domainIface.BandWidth = convertInterfaceBandwidth(iface.Bandwidth)
+		}
+		if sriov.IsStandbyInterface(vmi, iface.Name) {
+			domainIface.Teaming = sriov.NewStandbyTeaming()
 		}
 		domain.Spec.Devices.Interfaces = append(domain.Spec.Devices.Interfaces, domainIface)
 	}
 
 	domain.Spec.Devices.HostDevices = append(domain.Spec.Devices.HostDevices, c.SRIOVDevices...)
+	domain.Spec.Devices.Interfaces = append(domain.Spec.Devices.Interfaces, c.SRIOVInterfaces...)
 
 	// Add Ignition Command Line if present
 	ignitiondata, _ := vmi.Annotations[v1.IgnitionAnnotation]
@@ -1585,6 +1674,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		}
 	}
 
+	if maxHotpluggedDisks := vmi.Spec.Domain.Devices.MaxHotpluggedDisks; maxHotpluggedDisks > 0 && strings.Contains(domain.Spec.OS.Type.Machine, "q35") {
+		ReservePCIeRootPortsForHotplug(&domain.Spec, maxHotpluggedDisks)
+	}
+
 	if virtLauncherLogVerbosity, err := strconv.Atoi(os.Getenv(services.ENV_VAR_VIRT_LAUNCHER_LOG_VERBOSITY)); err == nil && (virtLauncherLogVerbosity > services.EXT_LOG_VERBOSITY_THRESHOLD) {
 
 		initializeQEMUCmdAndQEMUArg(domain)
@@ -1778,6 +1871,40 @@ func indexNetworksByName(networks []v1.Network) map[string]*v1.Network {
 	return netsByName
 }
 
+func convertInterfaceBandwidth(bandwidth *v1.InterfaceBandwidth) *api.BandWidth {
+	return &api.BandWidth{
+		Inbound:  convertBandwidthLimits(bandwidth.Inbound),
+		Outbound: convertBandwidthLimits(bandwidth.Outbound),
+	}
+}
+
+// convertBandwidthLimits converts the rates from bits per second and the burst
+// from bytes to the kilobytes libvirt expects, rounding up.
+func convertBandwidthLimits(limits *v1.BandwidthLimits) *api.BandWidthLimits {
+	if limits == nil {
+		return nil
+	}
+
+	domainLimits := &api.BandWidthLimits{
+		Average: toKiloBytes(limits.Average.Value(), 8),
+	}
+	if limits.Peak != nil {
+		domainLimits.Peak = toKiloBytes(limits.Peak.Value(), 8)
+	}
+	if limits.Burst != nil {
+		domainLimits.Burst = toKiloBytes(limits.Burst.Value(), 1)
+	}
+	return domainLimits
+}
+
+func toKiloBytes(value int64, unitsPerByte int64) uint64 {
+	if value <= 0 {
+		return 0
+	}
+	const kiloByte = 1024
+	return uint64((value + unitsPerByte*kiloByte - 1) / (unitsPerByte * kiloByte))
+}
+
 func createSlirpNetwork(iface v1.Interface, network v1.Network, domain *api.Domain) error {
 	qemuArg := api.Arg{Value: fmt.Sprintf("user,id=%s", iface.Name)}
 
@@ -1928,6 +2055,17 @@ func GetResolvConfDetailsFromPod() ([][]byte, []string, error) {
 	return nameservers, searchDomains, err
 }
 
+// GetIPv6NameserversFromPod returns the IPv6 nameservers of the pod
+func GetIPv6NameserversFromPod() ([]net.IP, error) {
+	// #nosec No risk for path injection. resolvConf is static "/etc/resolve.conf"
+	b, err := ioutil.ReadFile(resolvConf)
+	if err != nil {
+		return nil, err
+	}
+
+	return dns.ParseIPv6Nameservers(string(b))
+}
+
 func createHostDevicesFromAddress(devType HostDeviceType, deviceID string, name string) (api.HostDevice, error) {
 	switch devType {
 	case HostDevicePCI:
@@ -117,6 +117,37 @@ var _ = Describe("Converter", func() {
 			Expect(xml).To(Equal(expectedXML))
 		})
 
+		It("should set the I/O limits of the disk", func() {
+			totalBytes := resource.MustParse("100Mi")
+			totalBytesMax := resource.MustParse("200Mi")
+			readIOPS := int64(1000)
+			burstLength := int64(30)
+			v1Disk := &v1.Disk{
+				IOTune: &v1.DiskIOTune{
+					TotalBytesSec:          &totalBytes,
+					TotalBytesSecMax:       &totalBytesMax,
+					TotalBytesSecMaxLength: &burstLength,
+					ReadIOPSSec:            &readIOPS,
+					GroupName:              "shared",
+				},
+			}
+			xml := diskToDiskXML(v1Disk)
+			expectedXML := `<Disk device="" type="">
+  <source></source>
+  <target></target>
+  <driver error_policy="stop" name="qemu" type=""></driver>
+  <alias name="ua-"></alias>
+  <iotune>
+    <total_bytes_sec>104857600</total_bytes_sec>
+    <read_iops_sec>1000</read_iops_sec>
+    <total_bytes_sec_max>209715200</total_bytes_sec_max>
+    <group_name>shared</group_name>
+    <total_bytes_sec_max_length>30</total_bytes_sec_max_length>
+  </iotune>
+</Disk>`
+			Expect(xml).To(Equal(expectedXML))
+		})
+
 		It("Should omit boot order when not provided", func() {
 			kubevirtDisk := &v1.Disk{
 				Name: "mydisk",
@@ -1804,6 +1835,29 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[1].Type).To(Equal("ethernet"))
 			Expect(domain.Spec.Devices.Interfaces[2].Type).To(Equal("ethernet"))
 		})
+		It("Should set the bandwidth of tap based interfaces", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			peak := resource.MustParse("20M")
+			burst := resource.MustParse("1Mi")
+			iface := v1.DefaultBridgeNetworkInterface()
+			iface.Bandwidth = &v1.InterfaceBandwidth{
+				Inbound:  &v1.BandwidthLimits{Average: resource.MustParse("10M"), Peak: &peak, Burst: &burst},
+				Outbound: &v1.BandwidthLimits{Average: resource.MustParse("1M")},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{
+				Inbound:  &api.BandWidthLimits{Average: 1221, Peak: 2442, Burst: 1024},
+				Outbound: &api.BandWidthLimits{Average: 123},
+			}))
+
+			xml := vmiToDomainXML(vmi, c)
+			Expect(xml).To(ContainSubstring(`<inbound average="1221" peak="2442" burst="1024"></inbound>`))
+			Expect(xml).To(ContainSubstring(`<outbound average="123"></outbound>`))
+		})
 		It("Should set domain interface source correctly for default multus", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
@@ -1990,6 +2044,54 @@ var _ = Describe("Converter", func() {
 			domain := &api.Domain{}
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(HaveOccurred(), "conversion should fail because a macvtap interface requires a multus network attachment")
 		})
+		It("should convert interfaces of binding plugins with their registered interface binding", func() {
+			RegisterInterfaceBinding("mybinding", func(iface *v1.Interface, network *v1.Network, domain *api.Domain, domainIface *api.Interface) error {
+				domainIface.Type = "vhostuser"
+				return nil
+			})
+			defer func() {
+				interfaceBindingsLock.Lock()
+				delete(interfaceBindings, "mybinding")
+				interfaceBindingsLock.Unlock()
+			}()
+
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			name1 := "net1"
+
+			iface1 := v1.Interface{Name: name1, Binding: &v1.PluginBinding{Name: "mybinding"}}
+			podNetwork := v1.Network{
+				Name: name1,
+				NetworkSource: v1.NetworkSource{
+					Pod: &v1.PodNetwork{},
+				},
+			}
+			vmi.Spec.Networks = []v1.Network{podNetwork}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface1}
+
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(Succeed())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].Type).To(Equal("vhostuser"))
+			Expect(domain.Spec.Devices.Interfaces[0].Alias.GetName()).To(Equal(name1))
+		})
+		It("should leave interfaces of binding plugins to their sidecar", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			name1 := "net1"
+
+			iface1 := v1.Interface{Name: name1, Binding: &v1.PluginBinding{Name: "mybinding"}}
+			podNetwork := v1.Network{
+				Name: name1,
+				NetworkSource: v1.NetworkSource{
+					Pod: &v1.PodNetwork{},
+				},
+			}
+			vmi.Spec.Networks = []v1.Network{podNetwork}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface1}
+
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(Succeed())
+			Expect(domain.Spec.Devices.Interfaces).To(BeEmpty())
+		})
 		It("creates SRIOV hostdev", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			domain := &api.Domain{}
@@ -2000,6 +2102,35 @@ var _ = Describe("Converter", func() {
 			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(Succeed())
 			Expect(domain.Spec.Devices.HostDevices).To(Equal([]api.HostDevice{{Type: identifyDevice}}))
 		})
+		It("teams SRIOV failover interfaces with their standby interface", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			standby := v1.Interface{
+				Name:                   "standby",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}},
+			}
+			failover := v1.Interface{
+				Name:                   "failover",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{SRIOV: &v1.InterfaceSRIOV{StandbyInterface: "standby"}},
+			}
+			vmi.Spec.Networks = []v1.Network{
+				{Name: "standby", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "standby-net"}}},
+				{Name: "failover", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "failover-net"}}},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{standby, failover}
+			failoverInterface := api.Interface{
+				Type:    "hostdev",
+				Alias:   api.NewUserDefinedAlias("failover"),
+				Teaming: &api.InterfaceTeaming{Type: "transient", Persistent: "ua-standby"},
+			}
+			c.SRIOVInterfaces = []api.Interface{failoverInterface}
+
+			domain := &api.Domain{}
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(Succeed())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(2))
+			Expect(domain.Spec.Devices.Interfaces[0].Alias.GetName()).To(Equal("standby"))
+			Expect(domain.Spec.Devices.Interfaces[0].Teaming).To(Equal(&api.InterfaceTeaming{Type: "persistent"}))
+			Expect(domain.Spec.Devices.Interfaces[1]).To(Equal(failoverInterface))
+		})
 	})
 
 	Context("graphics and video device", func() {
@@ -2842,6 +2973,170 @@ var _ = Describe("Converter", func() {
 			domain := vmiToDomain(vmi, c)
 			Expect(len(domain.Spec.Devices.Controllers)).To(Equal(2))
 		})
+
+		countRootPorts := func(domain *api.Domain) int {
+			count := 0
+			for _, controller := range domain.Spec.Devices.Controllers {
+				if controller.Model == "pcie-root-port" {
+					count++
+				}
+			}
+			return count
+		}
+
+		It("should reserve pcie-root-ports for hotplugged disks on q35", func() {
+			vmi.Spec.Domain.Machine = v1.Machine{Type: "q35"}
+			vmi.Spec.Domain.Devices.MaxHotpluggedDisks = 4
+			domain := vmiToDomain(vmi, c)
+			// usb, scsi and virtio-serial controllers, the memballoon and the reserved ports
+			Expect(countRootPorts(domain)).To(Equal(4 + 4))
+			Expect(domain.Spec.Devices.Controllers[len(domain.Spec.Devices.Controllers)-1].Index).To(Equal("8"))
+		})
+
+		It("should not reserve pcie-root-ports when the machine type is not q35", func() {
+			vmi.Spec.Domain.Machine = v1.Machine{Type: "pc"}
+			vmi.Spec.Domain.Devices.MaxHotpluggedDisks = 4
+			domain := vmiToDomain(vmi, c)
+			Expect(countRootPorts(domain)).To(Equal(0))
+		})
+
+		It("should not reserve pcie-root-ports without maxHotpluggedDisks", func() {
+			vmi.Spec.Domain.Machine = v1.Machine{Type: "q35"}
+			domain := vmiToDomain(vmi, c)
+			Expect(countRootPorts(domain)).To(Equal(0))
+		})
+
+		Context("with a CD-ROM", func() {
+			BeforeEach(func() {
+				vmi.Spec.Domain.Devices.Disks = []v1.Disk{
+					{
+						Name: "cdrom",
+						DiskDevice: v1.DiskDevice{
+							CDRom: &v1.CDRomTarget{
+								Bus: "sata",
+							},
+						},
+					},
+				}
+			})
+
+			It("should convert a CD-ROM without a volume to an empty tray", func() {
+				domain := vmiToDomain(vmi, c)
+				Expect(domain.Spec.Devices.Disks).To(HaveLen(1))
+				disk := domain.Spec.Devices.Disks[0]
+				Expect(disk.Device).To(Equal("cdrom"))
+				Expect(IsEmptyCDRom(&disk)).To(BeTrue())
+				Expect(disk.Type).To(Equal("file"))
+				Expect(disk.Driver.Type).To(Equal("raw"))
+				Expect(SetDriverCacheMode(&disk)).To(Succeed())
+			})
+
+			It("should keep the tray empty until the hotplugged media is attached", func() {
+				vmi.Spec.Volumes = []v1.Volume{
+					{
+						Name: "cdrom",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "iso",
+							},
+						},
+					},
+				}
+				c.HotplugVolumes = map[string]v1.VolumeStatus{
+					"cdrom": {
+						Name:          "cdrom",
+						HotplugVolume: &v1.HotplugVolumeStatus{},
+						Phase:         v1.HotplugVolumeAttachedToNode,
+					},
+				}
+				c.PermanentVolumes = map[string]v1.VolumeStatus{
+					"other": {Name: "other"},
+				}
+				domain := vmiToDomain(vmi, c)
+				Expect(domain.Spec.Devices.Disks).To(HaveLen(1))
+				Expect(IsEmptyCDRom(&domain.Spec.Devices.Disks[0])).To(BeTrue())
+			})
+		})
+	})
+
+	Context("changed block tracking", func() {
+		var vmi *v1.VirtualMachineInstance
+		var c *ConverterContext
+
+		BeforeEach(func() {
+			vmi = &v1.VirtualMachineInstance{
+				ObjectMeta: k8smeta.ObjectMeta{
+					Name:      "testvmi",
+					Namespace: "mynamespace",
+				},
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{
+						Devices: v1.Devices{
+							Disks: []v1.Disk{
+								{
+									Name:                 "disk",
+									ChangedBlockTracking: true,
+									DiskDevice: v1.DiskDevice{
+										Disk: &v1.DiskTarget{Bus: "virtio"},
+									},
+								},
+							},
+						},
+					},
+					Volumes: []v1.Volume{
+						{
+							Name: "disk",
+							VolumeSource: v1.VolumeSource{
+								HostDisk: &v1.HostDisk{
+									Path: "/var/run/kubevirt-private/vmi-disks/disk/disk.img",
+									Type: v1.HostDiskExistsOrCreate,
+								},
+							},
+						},
+					},
+				},
+			}
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+
+			c = &ConverterContext{
+				VirtualMachine: vmi,
+				UseEmulation:   true,
+			}
+		})
+
+		It("should store the data of the qcow2 image in the raw disk image", func() {
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.Disks).To(HaveLen(1))
+			disk := domainSpec.Devices.Disks[0]
+			Expect(disk.Type).To(Equal("file"))
+			Expect(disk.Driver.Type).To(Equal("qcow2"))
+			Expect(disk.Source.File).To(Equal("/var/run/kubevirt-private/vmi-disks/disk/disk.img.cbt.qcow2"))
+			Expect(disk.Source.DataStore).ToNot(BeNil())
+			Expect(disk.Source.DataStore.Type).To(Equal("file"))
+			Expect(disk.Source.DataStore.Format.Type).To(Equal("raw"))
+			Expect(disk.Source.DataStore.Source.File).To(Equal("/var/run/kubevirt-private/vmi-disks/disk/disk.img"))
+		})
+
+		It("should keep the raw disk image without changed block tracking", func() {
+			vmi.Spec.Domain.Devices.Disks[0].ChangedBlockTracking = false
+			disk := vmiToDomain(vmi, c).Spec.Devices.Disks[0]
+			Expect(disk.Driver.Type).To(Equal("raw"))
+			Expect(disk.Source.File).To(Equal("/var/run/kubevirt-private/vmi-disks/disk/disk.img"))
+			Expect(disk.Source.DataStore).To(BeNil())
+		})
+
+		It("should fail on block volumes", func() {
+			vmi.Spec.Volumes[0].VolumeSource = v1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: "claim",
+				},
+			}
+			c.IsBlockPVC = map[string]bool{"disk": true}
+			domain := &api.Domain{}
+			err := Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("changed block tracking requires a raw disk image on a filesystem"))
+		})
 	})
 
 })
@@ -2,14 +2,45 @@ package converter
 
 import (
 	"fmt"
+	"strconv"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 func PlacePCIDevicesOnRootComplex(spec *api.DomainSpec) (err error) {
 	assigner := newRootSlotAssigner()
+	return forEachPCIDevice(spec, assigner.PlacePCIDeviceAtNextSlot)
+}
+
+// ReservePCIeRootPortsForHotplug adds a pcie-root-port for every device libvirt still has to place,
+// and hotpluggedDisks free ones on top, since libvirt fills existing empty root ports first
+func ReservePCIeRootPortsForHotplug(spec *api.DomainSpec, hotpluggedDisks uint32) {
+	ports := countUnplacedPCIDevices(spec) + int(hotpluggedDisks)
+	for i := 0; i < ports; i++ {
+		spec.Devices.Controllers = append(spec.Devices.Controllers, api.Controller{
+			Type:  "pci",
+			Index: strconv.Itoa(i + 1),
+			Model: "pcie-root-port",
+		})
+	}
+}
+
+func countUnplacedPCIDevices(spec *api.DomainSpec) int {
+	count := 0
+	forEachPCIDevice(spec, func(address *api.Address) (*api.Address, error) {
+		if address == nil || address.Type == "" || (address.Type == "pci" && address.Domain == "") {
+			count++
+		}
+		return address, nil
+	})
+	return count
+}
+
+// forEachPCIDevice calls placeFn with the address of every device which can be placed on a PCI bus,
+// and stores the address it returns
+func forEachPCIDevice(spec *api.DomainSpec, placeFn func(address *api.Address) (*api.Address, error)) (err error) {
 	for i, iface := range spec.Devices.Interfaces {
-		spec.Devices.Interfaces[i].Address, err = assigner.PlacePCIDeviceAtNextSlot(iface.Address)
+		spec.Devices.Interfaces[i].Address, err = placeFn(iface.Address)
 		if err != nil {
 			return err
 		}
@@ -18,7 +49,7 @@ func PlacePCIDevicesOnRootComplex(spec *api.DomainSpec) (err error) {
 		if hostDev.Type != "pci" {
 			continue
 		}
-		spec.Devices.HostDevices[i].Address, err = assigner.PlacePCIDeviceAtNextSlot(hostDev.Address)
+		spec.Devices.HostDevices[i].Address, err = placeFn(hostDev.Address)
 		if err != nil {
 			return err
 		}
@@ -28,7 +59,7 @@ func PlacePCIDevicesOnRootComplex(spec *api.DomainSpec) (err error) {
 		if controller.Model == "pci-root" || controller.Model == "pcie-root" {
 			continue
 		}
-		spec.Devices.Controllers[i].Address, err = assigner.PlacePCIDeviceAtNextSlot(controller.Address)
+		spec.Devices.Controllers[i].Address, err = placeFn(controller.Address)
 		if err != nil {
 			return err
 		}
@@ -37,7 +68,7 @@ func PlacePCIDevicesOnRootComplex(spec *api.DomainSpec) (err error) {
 		if disk.Target.Bus != "virtio" {
 			continue
 		}
-		spec.Devices.Disks[i].Address, err = assigner.PlacePCIDeviceAtNextSlot(disk.Address)
+		spec.Devices.Disks[i].Address, err = placeFn(disk.Address)
 		if err != nil {
 			return err
 		}
@@ -46,25 +77,25 @@ func PlacePCIDevicesOnRootComplex(spec *api.DomainSpec) (err error) {
 		if input.Bus != "virtio" {
 			continue
 		}
-		spec.Devices.Inputs[i].Address, err = assigner.PlacePCIDeviceAtNextSlot(input.Address)
+		spec.Devices.Inputs[i].Address, err = placeFn(input.Address)
 		if err != nil {
 			return err
 		}
 	}
 	if spec.Devices.Watchdog != nil {
-		spec.Devices.Watchdog.Address, err = assigner.PlacePCIDeviceAtNextSlot(spec.Devices.Watchdog.Address)
+		spec.Devices.Watchdog.Address, err = placeFn(spec.Devices.Watchdog.Address)
 		if err != nil {
 			return err
 		}
 	}
 	if spec.Devices.Rng != nil {
-		spec.Devices.Rng.Address, err = assigner.PlacePCIDeviceAtNextSlot(spec.Devices.Rng.Address)
+		spec.Devices.Rng.Address, err = placeFn(spec.Devices.Rng.Address)
 		if err != nil {
 			return err
 		}
 	}
 	if spec.Devices.Ballooning != nil {
-		spec.Devices.Ballooning.Address, err = assigner.PlacePCIDeviceAtNextSlot(spec.Devices.Ballooning.Address)
+		spec.Devices.Ballooning.Address, err = placeFn(spec.Devices.Ballooning.Address)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package sriov
+
+import (
+	"encoding/xml"
+	"strings"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// DomainDevice is a device of the domain backed by a VF
+type DomainDevice struct {
+	Alias string
+	XML   []byte
+}
+
+// GetDomainDevices returns the devices of the domain backed by VFs
+func GetDomainDevices(spec *api.DomainSpec) ([]DomainDevice, error) {
+	var devices []DomainDevice
+	for _, hostDevice := range spec.Devices.HostDevices {
+		if hostDevice.Alias == nil || !strings.HasPrefix(hostDevice.Alias.GetName(), AliasPrefix) {
+			continue
+		}
+		data, err := xml.Marshal(struct {
+			XMLName xml.Name `xml:"hostdev"`
+			api.HostDevice
+		}{HostDevice: hostDevice})
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, DomainDevice{Alias: hostDevice.Alias.GetName(), XML: data})
+	}
+	for _, iface := range spec.Devices.Interfaces {
+		if iface.Type != "hostdev" || iface.Alias == nil {
+			continue
+		}
+		data, err := xml.Marshal(struct {
+			XMLName xml.Name `xml:"interface"`
+			api.Interface
+		}{Interface: iface})
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, DomainDevice{Alias: iface.Alias.GetName(), XML: data})
+	}
+	return devices, nil
+}
+
+// GetMissingDomainDevices returns the devices backed by VFs of the desired domain which are missing
+// from the current domain
+func GetMissingDomainDevices(current, desired *api.DomainSpec) ([]DomainDevice, error) {
+	currentDevices, err := GetDomainDevices(current)
+	if err != nil {
+		return nil, err
+	}
+	desiredDevices, err := GetDomainDevices(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	currentAliases := map[string]bool{}
+	for _, device := range currentDevices {
+		currentAliases[device.Alias] = true
+	}
+	var missing []DomainDevice
+	for _, device := range desiredDevices {
+		if !currentAliases[device.Alias] {
+			missing = append(missing, device)
+		}
+	}
+	return missing, nil
+}
@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package sriov_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/sriov"
+)
+
+var _ = Describe("SRIOV domain devices", func() {
+	newDomainSpec := func(hostDeviceAliases []string, hostdevInterfaceAliases []string) *api.DomainSpec {
+		spec := &api.DomainSpec{}
+		for _, alias := range hostDeviceAliases {
+			spec.Devices.HostDevices = append(spec.Devices.HostDevices, api.HostDevice{
+				Type:    "pci",
+				Managed: "no",
+				Alias:   api.NewUserDefinedAlias(alias),
+			})
+		}
+		for _, alias := range hostdevInterfaceAliases {
+			spec.Devices.Interfaces = append(spec.Devices.Interfaces, api.Interface{
+				Type:    "hostdev",
+				Managed: "no",
+				Alias:   api.NewUserDefinedAlias(alias),
+			})
+		}
+		return spec
+	}
+
+	It("lists the devices backed by VFs", func() {
+		spec := newDomainSpec([]string{sriov.AliasPrefix + "net1", "gpu1"}, []string{"net2"})
+		spec.Devices.Interfaces = append(spec.Devices.Interfaces, api.Interface{Type: "ethernet", Alias: api.NewUserDefinedAlias("default")})
+
+		devices, err := sriov.GetDomainDevices(spec)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(devices).To(HaveLen(2))
+		Expect(devices[0].Alias).To(Equal(sriov.AliasPrefix + "net1"))
+		Expect(string(devices[0].XML)).To(HavePrefix(`<hostdev type="pci" managed="no">`))
+		Expect(string(devices[0].XML)).To(ContainSubstring(`<alias name="ua-sriov-net1"></alias>`))
+		Expect(devices[1].Alias).To(Equal("net2"))
+		Expect(string(devices[1].XML)).To(HavePrefix(`<interface type="hostdev" managed="no">`))
+	})
+
+	It("lists the devices missing from the current domain", func() {
+		current := newDomainSpec([]string{sriov.AliasPrefix + "net1"}, nil)
+		desired := newDomainSpec([]string{sriov.AliasPrefix + "net1", sriov.AliasPrefix + "net3"}, []string{"net2"})
+
+		devices, err := sriov.GetMissingDomainDevices(current, desired)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(devices).To(HaveLen(2))
+		Expect(devices[0].Alias).To(Equal(sriov.AliasPrefix + "net3"))
+		Expect(devices[1].Alias).To(Equal("net2"))
+	})
+})
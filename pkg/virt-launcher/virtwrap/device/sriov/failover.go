@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package sriov
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/log"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+)
+
+const (
+	teamingPersistent = "persistent"
+	teamingTransient  = "transient"
+)
+
+// NewStandbyTeaming returns the teaming of a standby interface, which the guest keeps using while the
+// VFs teamed with it are unplugged
+func NewStandbyTeaming() *api.InterfaceTeaming {
+	return &api.InterfaceTeaming{Type: teamingPersistent}
+}
+
+// CreateFailoverInterfacesFromIfacesAndPool creates hostdev interfaces for the SR-IOV interfaces, teamed
+// with their standby interface
+func CreateFailoverInterfacesFromIfacesAndPool(SRIOVInterfaces []v1.Interface, pciAddrPool pool) ([]api.Interface, error) {
+	var interfaces []api.Interface
+
+	for _, iface := range SRIOVInterfaces {
+		pciAddress, err := pciAddrPool.Pop(iface.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SRIOV failover interface for %s: %v", iface.Name, err)
+		}
+
+		domainIface, err := createFailoverInterface(iface, pciAddress)
+		if err != nil {
+			return nil, err
+		}
+		interfaces = append(interfaces, *domainIface)
+		log.Log.Infof("SR-IOV PCI device created: %s, standby interface: %s", pciAddress, iface.SRIOV.StandbyInterface)
+	}
+	return interfaces, nil
+}
+
+func createFailoverInterface(iface v1.Interface, hostPCIAddress string) (*api.Interface, error) {
+	hostAddr, err := device.NewPciAddressField(hostPCIAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SRIOV failover interface for %s, host PCI: %v", iface.Name, err)
+	}
+	domainIface := &api.Interface{
+		Type:    "hostdev",
+		Managed: "no",
+		Source:  api.InterfaceSource{Address: hostAddr},
+		Alias:   api.NewUserDefinedAlias(iface.Name),
+		Teaming: &api.InterfaceTeaming{
+			Type:       teamingTransient,
+			Persistent: api.UserAliasPrefix + iface.SRIOV.StandbyInterface,
+		},
+	}
+
+	if iface.MacAddress != "" {
+		domainIface.MAC = &api.MAC{MAC: iface.MacAddress}
+	}
+
+	if iface.PciAddress != "" {
+		addr, err := device.NewPciAddressField(iface.PciAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SRIOV failover interface for %s, guest PCI: %v", iface.Name, err)
+		}
+		domainIface.Address = addr
+	}
+
+	if iface.BootOrder != nil {
+		domainIface.BootOrder = &api.BootOrder{Order: *iface.BootOrder}
+	}
+
+	return domainIface, nil
+}
@@ -0,0 +1,95 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package sriov_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/sriov"
+)
+
+var _ = Describe("SRIOV failover interface", func() {
+	newFailoverInterface := func(name, standby string) v1.Interface {
+		iface := newSRIOVInterface(name)
+		iface.SRIOV.StandbyInterface = standby
+		iface.MacAddress = "de:ad:00:00:be:af"
+		return iface
+	}
+
+	It("creates a hostdev interface teamed with the standby interface", func() {
+		iface := newFailoverInterface("net1", "standby1")
+		pool := newPCIAddressPoolStub("0000:81:01.0")
+
+		interfaces, err := sriov.CreateFailoverInterfacesFromIfacesAndPool([]v1.Interface{iface}, pool)
+
+		hostPCIAddress := api.Address{Type: "pci", Domain: "0x0000", Bus: "0x81", Slot: "0x01", Function: "0x0"}
+		expectedInterface := api.Interface{
+			Type:    "hostdev",
+			Managed: "no",
+			Source:  api.InterfaceSource{Address: &hostPCIAddress},
+			MAC:     &api.MAC{MAC: "de:ad:00:00:be:af"},
+			Alias:   api.NewUserDefinedAlias("net1"),
+			Teaming: &api.InterfaceTeaming{Type: "transient", Persistent: "ua-standby1"},
+		}
+		Expect(interfaces, err).To(Equal([]api.Interface{expectedInterface}))
+	})
+
+	It("fails to create an interface given bad host PCI address", func() {
+		iface := newFailoverInterface("net1", "standby1")
+		pool := newPCIAddressPoolStub("0bad0pci0address0")
+
+		_, err := sriov.CreateFailoverInterfacesFromIfacesAndPool([]v1.Interface{iface}, pool)
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("creates host devices and failover interfaces from the same pool", func() {
+		resource := newResourceData("resource1", "0000:81:01.0", "0000:81:02.0")
+		net1 := newNetworkData("net1", resource)
+		net2 := newNetworkData("net2", resource)
+		env := []envData{net1.ResourceEnv, net1.DeviceEnv, net2.ResourceEnv}
+		withEnvironmentContext(env, func() {
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				newSRIOVInterface(net1.Name),
+				newFailoverInterface(net2.Name, "standby"),
+			}
+
+			hostDevices, interfaces, err := sriov.CreateDevices(vmi)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hostDevices).To(HaveLen(1))
+			Expect(hostDevices[0].Source.Address.Slot).To(Equal("0x01"))
+			Expect(interfaces).To(HaveLen(1))
+			Expect(interfaces[0].Source.Address.Slot).To(Equal("0x02"))
+		})
+	})
+
+	It("identifies the standby interfaces", func() {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{newFailoverInterface("net1", "standby")}
+
+		Expect(sriov.IsStandbyInterface(vmi, "standby")).To(BeTrue())
+		Expect(sriov.IsStandbyInterface(vmi, "net1")).To(BeFalse())
+	})
+})
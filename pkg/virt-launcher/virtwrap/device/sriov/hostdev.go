@@ -28,13 +28,28 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 )
 
+// AliasPrefix prefixes the aliases of the host devices backing SR-IOV interfaces
+const AliasPrefix = "sriov-"
+
 type pool interface {
 	Pop(key string) (value string, err error)
 }
 
-func CreateHostDevices(vmi *v1.VirtualMachineInstance) ([]api.HostDevice, error) {
+// CreateDevices creates the devices backing the SR-IOV interfaces of the VMI: host devices, and
+// hostdev interfaces for the interfaces which are teamed with a standby interface.
+func CreateDevices(vmi *v1.VirtualMachineInstance) ([]api.HostDevice, []api.Interface, error) {
 	SRIOVInterfaces := filterVMISRIOVInterfaces(vmi)
-	return CreateHostDevicesFromIfacesAndPool(SRIOVInterfaces, NewPCIAddressPool(SRIOVInterfaces))
+	pciAddrPool := NewPCIAddressPool(SRIOVInterfaces)
+
+	hostDevices, err := CreateHostDevicesFromIfacesAndPool(filterInterfacesWithoutStandby(SRIOVInterfaces), pciAddrPool)
+	if err != nil {
+		return nil, nil, err
+	}
+	failoverInterfaces, err := CreateFailoverInterfacesFromIfacesAndPool(filterInterfacesWithStandby(SRIOVInterfaces), pciAddrPool)
+	if err != nil {
+		return nil, nil, err
+	}
+	return hostDevices, failoverInterfaces, nil
 }
 
 func CreateHostDevicesFromIfacesAndPool(SRIOVInterfaces []v1.Interface, pciAddrPool pool) ([]api.HostDevice, error) {
@@ -65,6 +80,7 @@ func createHostDevice(iface v1.Interface, hostPCIAddress string) (*api.HostDevic
 		Source:  api.HostDeviceSource{Address: hostAddr},
 		Type:    "pci",
 		Managed: "no",
+		Alias:   api.NewUserDefinedAlias(AliasPrefix + iface.Name),
 	}
 
 	guestPCIAddress := iface.PciAddress
@@ -34,7 +34,10 @@ var _ = Describe("SRIOV HostDevice", func() {
 	It("creates no device given no interfaces", func() {
 		vmi := &v1.VirtualMachineInstance{}
 
-		Expect(sriov.CreateHostDevices(vmi)).To(BeEmpty())
+		hostDevices, interfaces, err := sriov.CreateDevices(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostDevices).To(BeEmpty())
+		Expect(interfaces).To(BeEmpty())
 	})
 
 	It("creates no device given no SRIOV interfaces", func() {
@@ -43,7 +46,10 @@ var _ = Describe("SRIOV HostDevice", func() {
 		vmi := &v1.VirtualMachineInstance{}
 		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
 
-		Expect(sriov.CreateHostDevices(vmi)).To(BeEmpty())
+		hostDevices, interfaces, err := sriov.CreateDevices(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hostDevices).To(BeEmpty())
+		Expect(interfaces).To(BeEmpty())
 	})
 
 	It("fails to create device given no available host PCI", func() {
@@ -51,7 +57,7 @@ var _ = Describe("SRIOV HostDevice", func() {
 		vmi := &v1.VirtualMachineInstance{}
 		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
 
-		_, err := sriov.CreateHostDevices(vmi)
+		_, _, err := sriov.CreateDevices(vmi)
 
 		Expect(err).To(HaveOccurred())
 	})
@@ -99,12 +105,14 @@ var _ = Describe("SRIOV HostDevice", func() {
 			Source:  api.HostDeviceSource{Address: &hostPCIAddress1},
 			Type:    "pci",
 			Managed: "no",
+			Alias:   api.NewUserDefinedAlias(sriov.AliasPrefix + netname),
 		}
 		hostPCIAddress2 := api.Address{Type: "pci", Domain: "0x0000", Bus: "0x81", Slot: "0x01", Function: "0x1"}
 		expectHostDevice2 := api.HostDevice{
 			Source:  api.HostDeviceSource{Address: &hostPCIAddress2},
 			Type:    "pci",
 			Managed: "no",
+			Alias:   api.NewUserDefinedAlias(sriov.AliasPrefix + netname),
 		}
 		Expect(devices, err).To(Equal([]api.HostDevice{expectHostDevice1, expectHostDevice2}))
 	})
@@ -121,12 +129,14 @@ var _ = Describe("SRIOV HostDevice", func() {
 			Source:  api.HostDeviceSource{Address: &hostPCIAddress1},
 			Type:    "pci",
 			Managed: "no",
+			Alias:   api.NewUserDefinedAlias(sriov.AliasPrefix + iface1.Name),
 		}
 		hostPCIAddress2 := api.Address{Type: "pci", Domain: "0x0000", Bus: "0x81", Slot: "0x02", Function: "0x0"}
 		expectHostDevice2 := api.HostDevice{
 			Source:  api.HostDeviceSource{Address: &hostPCIAddress2},
 			Type:    "pci",
 			Managed: "no",
+			Alias:   api.NewUserDefinedAlias(sriov.AliasPrefix + iface2.Name),
 		}
 		Expect(devices, err).To(Equal([]api.HostDevice{expectHostDevice1, expectHostDevice2}))
 	})
@@ -145,6 +155,7 @@ var _ = Describe("SRIOV HostDevice", func() {
 			Type:    "pci",
 			Managed: "no",
 			Address: &guestPCIAddress1,
+			Alias:   api.NewUserDefinedAlias(sriov.AliasPrefix + iface.Name),
 		}
 		Expect(devices, err).To(Equal([]api.HostDevice{expectHostDevice1}))
 	})
@@ -163,6 +174,7 @@ var _ = Describe("SRIOV HostDevice", func() {
 			Type:      "pci",
 			Managed:   "no",
 			BootOrder: &api.BootOrder{Order: *iface.BootOrder},
+			Alias:     api.NewUserDefinedAlias(sriov.AliasPrefix + iface.Name),
 		}
 		Expect(devices, err).To(Equal([]api.HostDevice{expectHostDevice1}))
 	})
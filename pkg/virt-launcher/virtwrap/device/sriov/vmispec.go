@@ -32,3 +32,33 @@ func filterVMISRIOVInterfaces(vmi *v1.VirtualMachineInstance) []v1.Interface {
 	}
 	return interfaces
 }
+
+func filterInterfacesWithStandby(ifaces []v1.Interface) []v1.Interface {
+	var interfaces []v1.Interface
+	for _, iface := range ifaces {
+		if iface.SRIOV.StandbyInterface != "" {
+			interfaces = append(interfaces, iface)
+		}
+	}
+	return interfaces
+}
+
+func filterInterfacesWithoutStandby(ifaces []v1.Interface) []v1.Interface {
+	var interfaces []v1.Interface
+	for _, iface := range ifaces {
+		if iface.SRIOV.StandbyInterface == "" {
+			interfaces = append(interfaces, iface)
+		}
+	}
+	return interfaces
+}
+
+// IsStandbyInterface tells whether the interface stands by for a SR-IOV interface of the VMI
+func IsStandbyInterface(vmi *v1.VirtualMachineInstance, name string) bool {
+	for _, iface := range filterVMISRIOVInterfaces(vmi) {
+		if iface.SRIOV.StandbyInterface == name {
+			return true
+		}
+	}
+	return false
+}
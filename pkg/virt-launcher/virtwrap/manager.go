@@ -28,6 +28,7 @@ package virtwrap
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -63,6 +64,7 @@ import (
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
 	kutil "kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	accesscredentials "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/access-credentials"
@@ -93,6 +95,8 @@ type DomainManager interface {
 	SyncVMI(*v1.VirtualMachineInstance, bool, *cmdv1.VirtualMachineOptions) (*api.DomainSpec, error)
 	PauseVMI(*v1.VirtualMachineInstance) error
 	UnpauseVMI(*v1.VirtualMachineInstance) error
+	FreezeVMI(*v1.VirtualMachineInstance, int32) error
+	UnfreezeVMI(*v1.VirtualMachineInstance) error
 	KillVMI(*v1.VirtualMachineInstance) error
 	DeleteVMI(*v1.VirtualMachineInstance) error
 	SignalShutdownVMI(*v1.VirtualMachineInstance) error
@@ -106,6 +110,10 @@ type DomainManager interface {
 	GetUsers() ([]v1.VirtualMachineInstanceGuestOSUser, error)
 	GetFilesystems() ([]v1.VirtualMachineInstanceFileSystem, error)
 	SetGuestTime(*v1.VirtualMachineInstance) error
+	ResizeVMIVolumes(*v1.VirtualMachineInstance) error
+	BackupVMI(*v1.VirtualMachineInstance, *v1.VirtualMachineInstanceBackupOptions) error
+	AbortVMIBackup(*v1.VirtualMachineInstance, *v1.VirtualMachineInstanceAbortBackupOptions) error
+	SetVMIBlockIOTune(*v1.VirtualMachineInstance, *v1.SetBlockIOTuneOptions) error
 }
 
 type LibvirtDomainManager struct {
@@ -115,6 +123,8 @@ type LibvirtDomainManager struct {
 	domainModifyLock sync.Mutex
 	// mutex to control access to the guest time context
 	setGuestTimeLock sync.Mutex
+	// mutex to control access to the safety unfreeze context
+	safetyUnfreezeLock sync.Mutex
 
 	credManager *accesscredentials.AccessCredentialManager
 
@@ -125,6 +135,7 @@ type LibvirtDomainManager struct {
 	agentData              *agentpoller.AsyncAgentStore
 	cloudInitDataStore     *cloudinit.CloudInitData
 	setGuestTimeContextPtr *contextStore
+	safetyUnfreezeCtxPtr   *contextStore
 	ovmfPath               string
 }
 
@@ -319,6 +330,83 @@ func (l *LibvirtDomainManager) setMigrationResultHelper(vmi *v1.VirtualMachineIn
 
 }
 
+// setMigrationSRIOVPhase reports the step of the unplug of the SR-IOV devices in the migration metadata
+func (l *LibvirtDomainManager) setMigrationSRIOVPhase(vmi *v1.VirtualMachineInstance, phase v1.MigrationSRIOVPhase) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+	if domainSpec.Metadata.KubeVirt.Migration == nil {
+		return nil
+	}
+	domainSpec.Metadata.KubeVirt.Migration.SRIOVPhase = phase
+	d, err := l.setDomainSpecWithHooks(vmi, domainSpec)
+	if err != nil {
+		return err
+	}
+	defer d.Free()
+	return nil
+}
+
+// unplugSRIOVDevices unplugs the devices backed by VFs and waits for the guest to release them
+func (l *LibvirtDomainManager) unplugSRIOVDevices(vmi *v1.VirtualMachineInstance, dom cli.VirDomain) error {
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+	sriovDevices, err := sriov.GetDomainDevices(domainSpec)
+	if err != nil {
+		return err
+	}
+	if len(sriovDevices) == 0 {
+		return nil
+	}
+
+	if err := l.setMigrationSRIOVPhase(vmi, v1.MigrationSRIOVUnplugging); err != nil {
+		return err
+	}
+	for _, sriovDevice := range sriovDevices {
+		log.Log.Object(vmi).Infof("Detaching SR-IOV device %s", sriovDevice.Alias)
+		if err := dom.DetachDevice(string(sriovDevice.XML)); err != nil {
+			return fmt.Errorf("failed to detach SR-IOV device %s: %v", sriovDevice.Alias, err)
+		}
+	}
+
+	// The guest releases the devices asynchronously
+	unplugInterval := 1 * time.Second
+	unplugTimeout := 2 * time.Minute
+	err = utilwait.PollImmediate(unplugInterval, unplugTimeout, func() (bool, error) {
+		domainSpec, err := l.getDomainSpec(dom)
+		if err != nil {
+			return false, err
+		}
+		sriovDevices, err := sriov.GetDomainDevices(domainSpec)
+		if err != nil {
+			return false, err
+		}
+		return len(sriovDevices) == 0, nil
+	})
+	if err != nil {
+		return fmt.Errorf("the guest did not release the SR-IOV devices: %v", err)
+	}
+	return l.setMigrationSRIOVPhase(vmi, v1.MigrationSRIOVUnplugged)
+}
+
+func isMigrationInProgress(domainSpec *api.DomainSpec) bool {
+	migrationMetadata := domainSpec.Metadata.KubeVirt.Migration
+	return migrationMetadata != nil && migrationMetadata.EndTimestamp == nil
+}
+
 func prepareMigrationFlags(isBlockMigration, isUnsafeMigration, allowAutoConverge, allowPostyCopy bool) libvirt.DomainMigrateFlags {
 	migrateFlags := libvirt.MIGRATE_LIVE | libvirt.MIGRATE_PEER2PEER | libvirt.MIGRATE_PERSIST_DEST
 
@@ -362,6 +450,11 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 	}
 	for _, volume := range vmi.Spec.Volumes {
 		volSrc := volume.VolumeSource
+		// Volumes moved to new claims are copied to the target like local disks. The
+		// target creates them empty, so copying their top image copies all of them.
+		if migrations.IsMigratedVolume(vmi, volume.Name) {
+			continue
+		}
 		if volSrc.PersistentVolumeClaim != nil || volSrc.DataVolume != nil ||
 			(volSrc.HostDisk != nil && *volSrc.HostDisk.Shared) {
 			disks.shared[volume.Name] = true
@@ -490,7 +583,7 @@ func (l *LibvirtDomainManager) asyncMigrate(vmi *v1.VirtualMachineInstance, opti
 		// This also creates a tcp server for each additional direct migration connections
 		// that will be proxied to the destination pod
 
-		isBlockMigration := (vmi.Status.MigrationMethod == v1.BlockMigration)
+		isBlockMigration := migrations.IsBlockMigration(vmi)
 		migrationPortsRange := migrationproxy.GetMigrationPortsList(isBlockMigration)
 
 		loopbackAddress := ip.GetLoopbackAddress()
@@ -540,6 +633,14 @@ func (l *LibvirtDomainManager) asyncMigrate(vmi *v1.VirtualMachineInstance, opti
 			return
 		}
 
+		// VFs can not be migrated, the target plugs VFs of its own pod after the migration
+		err = l.unplugSRIOVDevices(vmi, dom)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Live migration failed. Could not unplug the SR-IOV devices.")
+			l.setMigrationResult(vmi, true, fmt.Sprintf("%v", err), "")
+			return
+		}
+
 		xmlstr, err := domXMLWithoutKubevirtMetadata(dom, vmi)
 		if err != nil {
 			log.Log.Object(vmi).Reason(err).Error("Live migration failed. Could not compute target XML.")
@@ -639,6 +740,137 @@ func (l *LibvirtDomainManager) SetGuestTime(vmi *v1.VirtualMachineInstance) erro
 	return nil
 }
 
+const (
+	fsFrozen = "frozen"
+	fsThawed = "thawed"
+
+	fsFreezeCmd       = `{"execute":"guest-fsfreeze-freeze"}`
+	fsThawCmd         = `{"execute":"guest-fsfreeze-thaw"}`
+	fsFreezeStatusCmd = `{"execute":"guest-fsfreeze-status"}`
+)
+
+type fsFreezeStatusReturn struct {
+	Return string `json:"return"`
+}
+
+func (l *LibvirtDomainManager) getFSFreezeStatus(domName string) (string, error) {
+	output, err := l.virConn.QemuAgentCommand(fsFreezeStatusCmd, domName)
+	if err != nil {
+		return "", err
+	}
+
+	status := &fsFreezeStatusReturn{}
+	if err := json.Unmarshal([]byte(output), status); err != nil {
+		return "", err
+	}
+
+	return status.Return, nil
+}
+
+// FreezeVMI freezes the guest filesystems through the qemu-guest-agent.
+// If unfreezeTimeoutSeconds is greater than zero, the filesystems are thawed
+// automatically once the timeout expires, in case no unfreeze request arrives.
+func (l *LibvirtDomainManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreezeTimeoutSeconds int32) error {
+	if unfreezeTimeoutSeconds < 0 {
+		return fmt.Errorf("unfreezeTimeoutSeconds has to be a non-negative value")
+	}
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	logger := log.Log.Object(vmi)
+	domName := util.VMINamespaceKeyFunc(vmi)
+
+	status, err := l.getFSFreezeStatus(domName)
+	if err != nil {
+		logger.Reason(err).Error("Getting the guest filesystems freeze status failed.")
+		return err
+	}
+
+	if status == fsFrozen {
+		logger.Infof("Guest filesystems are already frozen for %s", vmi.GetObjectMeta().GetName())
+		return nil
+	}
+
+	if _, err := l.virConn.QemuAgentCommand(fsFreezeCmd, domName); err != nil {
+		logger.Reason(err).Error("Freezing the guest filesystems failed.")
+		return err
+	}
+	logger.Infof("Froze guest filesystems for %s", vmi.GetObjectMeta().GetName())
+
+	if unfreezeTimeoutSeconds > 0 {
+		l.scheduleSafetyUnfreeze(vmi, time.Duration(unfreezeTimeoutSeconds)*time.Second)
+	}
+
+	return nil
+}
+
+// UnfreezeVMI thaws the guest filesystems through the qemu-guest-agent
+func (l *LibvirtDomainManager) UnfreezeVMI(vmi *v1.VirtualMachineInstance) error {
+	l.cancelSafetyUnfreeze()
+
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	logger := log.Log.Object(vmi)
+	domName := util.VMINamespaceKeyFunc(vmi)
+
+	status, err := l.getFSFreezeStatus(domName)
+	if err != nil {
+		logger.Reason(err).Error("Getting the guest filesystems freeze status failed.")
+		return err
+	}
+
+	if status == fsThawed {
+		logger.Infof("Guest filesystems are not frozen for %s", vmi.GetObjectMeta().GetName())
+		return nil
+	}
+
+	if _, err := l.virConn.QemuAgentCommand(fsThawCmd, domName); err != nil {
+		logger.Reason(err).Error("Thawing the guest filesystems failed.")
+		return err
+	}
+	logger.Infof("Thawed guest filesystems for %s", vmi.GetObjectMeta().GetName())
+
+	return nil
+}
+
+func (l *LibvirtDomainManager) scheduleSafetyUnfreeze(vmi *v1.VirtualMachineInstance, unfreezeTimeout time.Duration) {
+	l.safetyUnfreezeLock.Lock()
+	defer l.safetyUnfreezeLock.Unlock()
+
+	// cancel an already scheduled safety unfreeze if such exist
+	if l.safetyUnfreezeCtxPtr != nil {
+		l.safetyUnfreezeCtxPtr.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	l.safetyUnfreezeCtxPtr = &contextStore{ctx: ctx, cancel: cancel}
+
+	go func() {
+		timer := time.NewTimer(unfreezeTimeout)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			log.Log.Object(vmi).Warningf("Guest filesystems were not unfrozen within %v, unfreezing", unfreezeTimeout)
+			if err := l.UnfreezeVMI(vmi); err != nil {
+				log.Log.Object(vmi).Reason(err).Error("Safety unfreeze failed")
+			}
+		}
+	}()
+}
+
+func (l *LibvirtDomainManager) cancelSafetyUnfreeze() {
+	l.safetyUnfreezeLock.Lock()
+	defer l.safetyUnfreezeLock.Unlock()
+
+	if l.safetyUnfreezeCtxPtr != nil {
+		l.safetyUnfreezeCtxPtr.cancel()
+		l.safetyUnfreezeCtxPtr = nil
+	}
+}
+
 func (l *LibvirtDomainManager) getGuestTimeContext() context.Context {
 	l.setGuestTimeLock.Lock()
 	defer l.setGuestTimeLock.Unlock()
@@ -670,6 +902,34 @@ func getVMIEphemeralDisksTotalSize() *resource.Quantity {
 	return resource.NewScaledQuantity(totalSize, 0)
 }
 
+// getMigratedVolumesTotalSize returns the size of the disks which are moved to new claims by the migration
+func getMigratedVolumesTotalSize(vmi *v1.VirtualMachineInstance) *resource.Quantity {
+	totalSize := int64(0)
+	for _, volume := range vmi.Spec.Volumes {
+		if !migrations.IsMigratedVolume(vmi, volume.Name) {
+			continue
+		}
+		if fileInfo, err := os.Stat(converter.GetFilesystemVolumePath(volume.Name)); err == nil {
+			totalSize += fileInfo.Size()
+			continue
+		}
+		device, err := os.Open(converter.GetBlockDeviceVolumePath(volume.Name))
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to get the size of migrated volume %s", volume.Name)
+			continue
+		}
+		size, err := device.Seek(0, io.SeekEnd)
+		device.Close()
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to get the size of migrated volume %s", volume.Name)
+			continue
+		}
+		totalSize += size
+	}
+
+	return resource.NewScaledQuantity(totalSize, 0)
+}
+
 func getVMIMigrationDataSize(vmi *v1.VirtualMachineInstance) int64 {
 	var memory resource.Quantity
 
@@ -687,6 +947,10 @@ func getVMIMigrationDataSize(vmi *v1.VirtualMachineInstance) int64 {
 		disksSize := getVMIEphemeralDisksTotalSize()
 		memory.Add(*disksSize)
 	}
+	if len(migrations.GetVolumeMigrations(vmi)) > 0 {
+		disksSize := getMigratedVolumesTotalSize(vmi)
+		memory.Add(*disksSize)
+	}
 	return memory.ScaledValue(resource.Giga)
 }
 
@@ -1011,7 +1275,7 @@ func (l *LibvirtDomainManager) PrepareMigrationTarget(vmi *v1.VirtualMachineInst
 		return fmt.Errorf("failed to update the hosts file: %v", err)
 	}
 
-	isBlockMigration := (vmi.Status.MigrationMethod == v1.BlockMigration)
+	isBlockMigration := migrations.IsBlockMigration(vmi)
 	migrationPortsRange := migrationproxy.GetMigrationPortsList(isBlockMigration)
 	for _, port := range migrationPortsRange {
 		// Prepare the direct migration proxy
@@ -1118,6 +1382,20 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("preparing host-disks failed: %v", err)
 	}
 
+	// create the images which keep the dirty bitmaps of the disks with changed block tracking
+	if len(getChangedBlockTrackingDisks(&domain.Spec)) > 0 {
+		if err := checkChangedBlockTrackingSupported(l.virConn); err != nil {
+			return domain, err
+		}
+	}
+	for _, disk := range domain.Spec.Devices.Disks {
+		if dataStore := disk.Source.DataStore; dataStore != nil && dataStore.Source != nil {
+			if err := hostdisk.CreateChangedBlockTrackingImage(disk.Source.File, dataStore.Source.File); err != nil {
+				return domain, fmt.Errorf("preparing changed block tracking of disk %s failed: %v", disk.Alias.GetName(), err)
+			}
+		}
+	}
+
 	// Create ephemeral disk for container disks
 	err = containerdisk.CreateEphemeralImages(vmi)
 	if err != nil {
@@ -1338,7 +1616,7 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, useEmulat
 		}
 	}
 
-	sriovDevices, err := sriov.CreateHostDevices(vmi)
+	sriovDevices, sriovInterfaces, err := sriov.CreateDevices(vmi)
 	if err != nil {
 		return nil, err
 	}
@@ -1355,6 +1633,7 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, useEmulat
 		PermanentVolumes:      permanentVolumes,
 		DiskType:              diskInfo,
 		SRIOVDevices:          sriovDevices,
+		SRIOVInterfaces:       sriovInterfaces,
 		GpuDevices:            getEnvAddressListByPrefix(gpuEnvPrefix),
 		VgpuDevices:           getEnvAddressListByPrefix(vgpuEnvPrefix),
 		HostDevices:           getDevicesForAssignment(vmi.Spec.Domain.Devices),
@@ -1493,6 +1772,87 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, useEmulat
 			return nil, err
 		}
 	}
+	//Look up all the CD-ROMs whose media got ejected or inserted
+	for _, cdrom := range getChangedCDRomMedia(oldSpec.Devices.Disks, domain.Spec.Devices.Disks) {
+		if !converter.IsEmptyCDRom(&cdrom) {
+			allowInsert, err := checkIfDiskReadyToUse(getSourceFile(cdrom))
+			if err != nil {
+				return nil, err
+			}
+			if !allowInsert {
+				continue
+			}
+		}
+		logger.V(1).Infof("Changing the media of CD-ROM %s to %q", cdrom.Alias.GetName(), getSourceFile(cdrom))
+		cdromBytes, err := xml.Marshal(cdrom)
+		if err != nil {
+			logger.Reason(err).Error("marshalling CD-ROM failed")
+			return nil, err
+		}
+		err = dom.UpdateDeviceFlags(strings.ToLower(string(cdromBytes)), libvirt.DOMAIN_DEVICE_MODIFY_LIVE)
+		if err != nil {
+			logger.Reason(err).Error("changing the media of CD-ROM")
+			return nil, err
+		}
+	}
+
+	//Look up all the interfaces to detach
+	for _, detachInterface := range getDetachedInterfaces(oldSpec.Devices.Interfaces, domain.Spec.Devices.Interfaces) {
+		logger.V(1).Infof("Detaching interface %s", detachInterface.Alias.GetName())
+		detachBytes, err := marshalInterface(detachInterface)
+		if err != nil {
+			logger.Reason(err).Error("marshalling detached interface failed")
+			return nil, err
+		}
+		err = dom.DetachDevice(string(detachBytes))
+		if err != nil {
+			logger.Reason(err).Error("detaching interface")
+			return nil, err
+		}
+	}
+	//Look up all the interfaces to attach
+	attachInterfaces := getAttachedInterfaces(vmi, oldSpec.Devices.Interfaces, domain.Spec.Devices.Interfaces)
+	if len(attachInterfaces) > 0 {
+		// complete the configuration of the pod interfaces prepared by virt-handler
+		err = network.SetupPodNetworkPhase2(vmi, domain)
+		if err != nil {
+			logger.Reason(err).Error("preparing the pod network of hotplugged interfaces failed")
+			return nil, err
+		}
+	}
+	for _, attachInterface := range domain.Spec.Devices.Interfaces {
+		if attachInterface.Alias == nil || !attachInterfaces[attachInterface.Alias.GetName()] {
+			continue
+		}
+		logger.V(1).Infof("Attaching interface %s", attachInterface.Alias.GetName())
+		attachBytes, err := marshalInterface(attachInterface)
+		if err != nil {
+			logger.Reason(err).Error("marshalling attached interface failed")
+			return nil, err
+		}
+		err = dom.AttachDevice(string(attachBytes))
+		if err != nil {
+			logger.Reason(err).Error("attaching interface")
+			return nil, err
+		}
+	}
+
+	// Plug the VFs back after a migration, VFs can not be migrated and are unplugged before
+	if !isMigrationInProgress(&oldSpec) {
+		sriovDevices, err := sriov.GetMissingDomainDevices(&oldSpec, &domain.Spec)
+		if err != nil {
+			logger.Reason(err).Error("marshalling SR-IOV devices failed")
+			return nil, err
+		}
+		for _, sriovDevice := range sriovDevices {
+			logger.Infof("Attaching SR-IOV device %s", sriovDevice.Alias)
+			err = dom.AttachDevice(string(sriovDevice.XML))
+			if err != nil {
+				logger.Reason(err).Error("attaching SR-IOV device")
+				return nil, err
+			}
+		}
+	}
 
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
 	return &oldSpec, nil
@@ -1506,6 +1866,15 @@ func getSourceFile(disk api.Disk) string {
 	return file
 }
 
+// getDataFile returns the image or block device which holds the data of the disk, which is the raw image
+// of a qcow2 image with an external data file
+func getDataFile(disk api.Disk) string {
+	if dataStore := disk.Source.DataStore; dataStore != nil && dataStore.Source != nil {
+		return dataStore.Source.File
+	}
+	return getSourceFile(disk)
+}
+
 var checkIfDiskReadyToUse = checkIfDiskReadyToUseFunc
 
 func checkIfDiskReadyToUseFunc(filename string) (bool, error) {
@@ -1539,6 +1908,44 @@ func checkIfDiskReadyToUseFunc(filename string) (bool, error) {
 	return true, nil
 }
 
+// getResizableDisks returns the disks of the domain which are backed by a claim,
+// either directly as block device or as disk image on the filesystem of the claim
+func getResizableDisks(vmi *v1.VirtualMachineInstance, disks []api.Disk) []api.Disk {
+	claimVolumes := make(map[string]bool)
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil || volume.DataVolume != nil || volume.HostDisk != nil {
+			claimVolumes[volume.Name] = true
+		}
+	}
+	res := make([]api.Disk, 0)
+	for _, disk := range disks {
+		// read-only media can not be resized
+		if disk.Device == "cdrom" || disk.Alias == nil || !claimVolumes[disk.Alias.GetName()] {
+			continue
+		}
+		if getSourceFile(disk) != "" {
+			res = append(res, disk)
+		}
+	}
+	return res
+}
+
+var getDiskImageSize = getDiskImageSizeFunc
+
+func getDiskImageSizeFunc(filename string) (uint64, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	// The size of a block device is only known by seeking to its end
+	size, err := file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(size), nil
+}
+
 func getDetachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	newDiskMap := make(map[string]api.Disk)
 	for _, disk := range newDisks {
@@ -1549,6 +1956,10 @@ func getDetachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	}
 	res := make([]api.Disk, 0)
 	for _, oldDisk := range oldDisks {
+		// CD-ROMs stay attached, only their media changes
+		if oldDisk.Device == "cdrom" {
+			continue
+		}
 		if _, ok := newDiskMap[getSourceFile(oldDisk)]; !ok {
 			// This disk got detached, add it to the list
 			res = append(res, oldDisk)
@@ -1567,6 +1978,9 @@ func getAttachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	}
 	res := make([]api.Disk, 0)
 	for _, newDisk := range newDisks {
+		if newDisk.Device == "cdrom" {
+			continue
+		}
 		if _, ok := oldDiskMap[getSourceFile(newDisk)]; !ok {
 			// This disk got attached, add it to the list
 			res = append(res, newDisk)
@@ -1575,6 +1989,92 @@ func getAttachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	return res
 }
 
+// getChangedCDRomMedia returns the CD-ROMs of the domain with the media of the new spec
+func getChangedCDRomMedia(oldDisks, newDisks []api.Disk) []api.Disk {
+	newCDRomMap := make(map[string]api.Disk)
+	for _, disk := range newDisks {
+		if disk.Device == "cdrom" && disk.Alias != nil {
+			newCDRomMap[disk.Alias.GetName()] = disk
+		}
+	}
+	res := make([]api.Disk, 0)
+	for _, oldDisk := range oldDisks {
+		if oldDisk.Device != "cdrom" || oldDisk.Alias == nil {
+			continue
+		}
+		newDisk, ok := newCDRomMap[oldDisk.Alias.GetName()]
+		if !ok || getSourceFile(newDisk) == getSourceFile(oldDisk) {
+			continue
+		}
+		// libvirt only allows to change the source and its format, the rest of the device stays as it is
+		cdrom := oldDisk.DeepCopy()
+		cdrom.Type = newDisk.Type
+		cdrom.Source = newDisk.Source
+		if cdrom.Driver != nil && newDisk.Driver != nil {
+			cdrom.Driver.Type = newDisk.Driver.Type
+		}
+		res = append(res, *cdrom)
+	}
+	return res
+}
+
+func getDetachedInterfaces(oldInterfaces, newInterfaces []api.Interface) []api.Interface {
+	newInterfaceMap := make(map[string]bool)
+	for _, iface := range newInterfaces {
+		if iface.Alias != nil {
+			newInterfaceMap[iface.Alias.GetName()] = true
+		}
+	}
+	res := make([]api.Interface, 0)
+	for _, oldInterface := range oldInterfaces {
+		if oldInterface.Alias == nil {
+			continue
+		}
+		if name := oldInterface.Alias.GetName(); name != "" && !newInterfaceMap[name] {
+			// This interface got unplugged, add it to the list
+			res = append(res, oldInterface)
+		}
+	}
+	return res
+}
+
+// getAttachedInterfaces returns the names of the hotplugged interfaces which are
+// missing from the domain and whose network is attached to the pod.
+func getAttachedInterfaces(vmi *v1.VirtualMachineInstance, oldInterfaces, newInterfaces []api.Interface) map[string]bool {
+	oldInterfaceMap := make(map[string]bool)
+	for _, iface := range oldInterfaces {
+		if iface.Alias != nil {
+			oldInterfaceMap[iface.Alias.GetName()] = true
+		}
+	}
+	attachedToPod := make(map[string]bool)
+	for _, status := range vmi.Status.HotplugInterfaces {
+		if status.Phase == v1.InterfaceHotplugAttachedToPod {
+			attachedToPod[status.Name] = true
+		}
+	}
+	res := make(map[string]bool)
+	for _, newInterface := range newInterfaces {
+		if newInterface.Alias == nil {
+			continue
+		}
+		name := newInterface.Alias.GetName()
+		if !oldInterfaceMap[name] && attachedToPod[name] {
+			// This interface got hotplugged, add it to the list
+			res[name] = true
+		}
+	}
+	return res
+}
+
+// marshalInterface returns the XML of the interface expected by the device hotplug API of libvirt
+func marshalInterface(iface api.Interface) ([]byte, error) {
+	return xml.Marshal(struct {
+		XMLName xml.Name `xml:"interface"`
+		api.Interface
+	}{Interface: iface})
+}
+
 var isHotplugBlockDeviceVolume = isHotplugBlockDeviceVolumeFunc
 
 func isHotplugBlockDeviceVolumeFunc(volumeName string) bool {
@@ -1719,6 +2219,153 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 	return nil
 }
 
+// ResizeVMIVolumes grows the disks of the claim volumes to the size of their image or block device,
+// so that the guest sees the new size of an expanded claim right away
+func (l *LibvirtDomainManager) ResizeVMIVolumes(vmi *v1.VirtualMachineInstance) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	logger := log.Log.Object(vmi)
+
+	domName := util.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		if domainerrors.IsNotFound(err) {
+			return fmt.Errorf("Domain not found.")
+		}
+		logger.Reason(err).Error("Getting the domain failed during volume resize.")
+		return err
+	}
+	defer dom.Free()
+
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+
+	for _, disk := range getResizableDisks(vmi, domainSpec.Devices.Disks) {
+		size, err := getDiskImageSize(getDataFile(disk))
+		if err != nil {
+			logger.Reason(err).Errorf("Getting the size of disk %s failed.", disk.Alias.GetName())
+			return err
+		}
+		info, err := dom.GetBlockInfo(disk.Target.Device, 0)
+		if err != nil {
+			logger.Reason(err).Errorf("Getting the block info of disk %s failed.", disk.Alias.GetName())
+			return err
+		}
+		if size <= info.Capacity {
+			continue
+		}
+		if err := dom.BlockResize(disk.Target.Device, size, libvirt.DOMAIN_BLOCK_RESIZE_BYTES); err != nil {
+			logger.Reason(err).Errorf("Resizing disk %s failed.", disk.Alias.GetName())
+			return err
+		}
+		logger.Infof("Resized disk %s from %d to %d bytes", disk.Alias.GetName(), info.Capacity, size)
+	}
+
+	return nil
+}
+
+// SetVMIBlockIOTune replaces the I/O limits of a disk of the running domain.
+// The limits of the other disks of its group change with it.
+func (l *LibvirtDomainManager) SetVMIBlockIOTune(vmi *v1.VirtualMachineInstance, options *v1.SetBlockIOTuneOptions) error {
+	l.domainModifyLock.Lock()
+	defer l.domainModifyLock.Unlock()
+
+	logger := log.Log.Object(vmi)
+
+	domName := util.VMINamespaceKeyFunc(vmi)
+	dom, err := l.virConn.LookupDomainByName(domName)
+	if err != nil {
+		if domainerrors.IsNotFound(err) {
+			return fmt.Errorf("Domain not found.")
+		}
+		logger.Reason(err).Error("Getting the domain failed during block I/O tune.")
+		return err
+	}
+	defer dom.Free()
+
+	domainSpec, err := l.getDomainSpec(dom)
+	if err != nil {
+		return err
+	}
+
+	var target string
+	for _, disk := range domainSpec.Devices.Disks {
+		if disk.Alias.GetName() == options.Name {
+			target = disk.Target.Device
+			break
+		}
+	}
+	if target == "" {
+		return fmt.Errorf("disk %s is not attached to the domain", options.Name)
+	}
+
+	params := toBlockIoTuneParameters(converter.Convert_v1_DiskIOTune_To_api_DiskIOTune(&options.IOTune))
+	if err := dom.SetBlockIoTune(target, params, libvirt.DOMAIN_AFFECT_LIVE); err != nil {
+		logger.Reason(err).Errorf("Setting the I/O limits of disk %s failed.", options.Name)
+		return err
+	}
+	logger.Infof("Set the I/O limits of disk %s", options.Name)
+
+	return nil
+}
+
+// toBlockIoTuneParameters sets every limit, so that unset limits are removed.
+// The burst lengths can't be 0, they are reset to their default of one second.
+func toBlockIoTuneParameters(ioTune *api.DiskIOTune) *libvirt.DomainBlockIoTuneParameters {
+	length := func(l uint64) uint64 {
+		if l == 0 {
+			return 1
+		}
+		return l
+	}
+	return &libvirt.DomainBlockIoTuneParameters{
+		TotalBytesSecSet:          true,
+		TotalBytesSec:             ioTune.TotalBytesSec,
+		ReadBytesSecSet:           true,
+		ReadBytesSec:              ioTune.ReadBytesSec,
+		WriteBytesSecSet:          true,
+		WriteBytesSec:             ioTune.WriteBytesSec,
+		TotalIopsSecSet:           true,
+		TotalIopsSec:              ioTune.TotalIopsSec,
+		ReadIopsSecSet:            true,
+		ReadIopsSec:               ioTune.ReadIopsSec,
+		WriteIopsSecSet:           true,
+		WriteIopsSec:              ioTune.WriteIopsSec,
+		TotalBytesSecMaxSet:       true,
+		TotalBytesSecMax:          ioTune.TotalBytesSecMax,
+		ReadBytesSecMaxSet:        true,
+		ReadBytesSecMax:           ioTune.ReadBytesSecMax,
+		WriteBytesSecMaxSet:       true,
+		WriteBytesSecMax:          ioTune.WriteBytesSecMax,
+		TotalIopsSecMaxSet:        true,
+		TotalIopsSecMax:           ioTune.TotalIopsSecMax,
+		ReadIopsSecMaxSet:         true,
+		ReadIopsSecMax:            ioTune.ReadIopsSecMax,
+		WriteIopsSecMaxSet:        true,
+		WriteIopsSecMax:           ioTune.WriteIopsSecMax,
+		TotalBytesSecMaxLengthSet: true,
+		TotalBytesSecMaxLength:    length(ioTune.TotalBytesSecMaxLength),
+		ReadBytesSecMaxLengthSet:  true,
+		ReadBytesSecMaxLength:     length(ioTune.ReadBytesSecMaxLength),
+		WriteBytesSecMaxLengthSet: true,
+		WriteBytesSecMaxLength:    length(ioTune.WriteBytesSecMaxLength),
+		TotalIopsSecMaxLengthSet:  true,
+		TotalIopsSecMaxLength:     length(ioTune.TotalIopsSecMaxLength),
+		ReadIopsSecMaxLengthSet:   true,
+		ReadIopsSecMaxLength:      length(ioTune.ReadIopsSecMaxLength),
+		WriteIopsSecMaxLengthSet:  true,
+		WriteIopsSecMaxLength:     length(ioTune.WriteIopsSecMaxLength),
+		SizeIopsSecSet:            true,
+		SizeIopsSec:               ioTune.SizeIopsSec,
+		// An empty group name would put the disk into a group named ""
+		GroupNameSet: ioTune.GroupName != "",
+		GroupName:    ioTune.GroupName,
+	}
+}
+
 func (l *LibvirtDomainManager) MarkGracefulShutdownVMI(vmi *v1.VirtualMachineInstance) error {
 	l.domainModifyLock.Lock()
 	defer l.domainModifyLock.Unlock()
@@ -259,6 +259,156 @@ var _ = Describe("Manager", func() {
 			Expect(err).To(BeNil())
 			Expect(newspec).ToNot(BeNil())
 		})
+		It("should resize the disks of expanded volumes", func() {
+			// Make sure that we always free the domain after use
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "expanded",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "expanded"},
+					},
+				},
+				{
+					Name: "unchanged",
+					VolumeSource: v1.VolumeSource{
+						DataVolume: &v1.DataVolumeSource{Name: "unchanged"},
+					},
+				},
+			}
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Disks = []api.Disk{
+				{
+					Device: "disk",
+					Source: api.DiskSource{Dev: "/dev/expanded"},
+					Target: api.DiskTarget{Device: "vda"},
+					Alias:  api.NewUserDefinedAlias("expanded"),
+				},
+				{
+					Device: "disk",
+					Source: api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/unchanged/disk.img"},
+					Target: api.DiskTarget{Device: "vdb"},
+					Alias:  api.NewUserDefinedAlias("unchanged"),
+				},
+			}
+			domainXml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			getDiskImageSize = func(filename string) (uint64, error) {
+				return 2147483648, nil
+			}
+			defer func() { getDiskImageSize = getDiskImageSizeFunc }()
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXml), nil)
+			mockDomain.EXPECT().GetMetadata(libvirt.DOMAIN_METADATA_ELEMENT, "http://kubevirt.io", libvirt.DOMAIN_AFFECT_CONFIG).Return("<kubevirt></kubevirt>", nil)
+			mockDomain.EXPECT().GetBlockInfo("vda", uint(0)).Return(&libvirt.DomainBlockInfo{Capacity: 1073741824}, nil)
+			mockDomain.EXPECT().GetBlockInfo("vdb", uint(0)).Return(&libvirt.DomainBlockInfo{Capacity: 2147483648}, nil)
+			mockDomain.EXPECT().BlockResize("vda", uint64(2147483648), libvirt.DOMAIN_BLOCK_RESIZE_BYTES).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err = manager.ResizeVMIVolumes(vmi)
+			Expect(err).To(BeNil())
+		})
+		It("should replace the I/O limits of a disk", func() {
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+			domainSpec := &api.DomainSpec{}
+			domainSpec.Devices.Disks = []api.Disk{
+				{
+					Device: "disk",
+					Source: api.DiskSource{Dev: "/dev/rootdisk"},
+					Target: api.DiskTarget{Device: "vda"},
+					Alias:  api.NewUserDefinedAlias("rootdisk"),
+				},
+				{
+					Device: "disk",
+					Source: api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/data/disk.img"},
+					Target: api.DiskTarget{Device: "vdb"},
+					Alias:  api.NewUserDefinedAlias("data"),
+				},
+			}
+			domainXml, err := xml.MarshalIndent(domainSpec, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			readBytes := resource.MustParse("10Mi")
+			readBytesMax := resource.MustParse("20Mi")
+			writeIOPS := int64(100)
+			readBytesMaxLength := int64(10)
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXml), nil)
+			mockDomain.EXPECT().GetMetadata(libvirt.DOMAIN_METADATA_ELEMENT, "http://kubevirt.io", libvirt.DOMAIN_AFFECT_CONFIG).Return("<kubevirt></kubevirt>", nil)
+			mockDomain.EXPECT().SetBlockIoTune("vdb", gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE).DoAndReturn(
+				func(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error {
+					Expect(params.ReadBytesSecSet).To(BeTrue())
+					Expect(params.ReadBytesSec).To(Equal(uint64(10485760)))
+					Expect(params.ReadBytesSecMax).To(Equal(uint64(20971520)))
+					Expect(params.ReadBytesSecMaxLength).To(Equal(uint64(10)))
+					Expect(params.WriteIopsSec).To(Equal(uint64(100)))
+					// unset limits are removed
+					Expect(params.TotalBytesSecSet).To(BeTrue())
+					Expect(params.TotalBytesSec).To(BeZero())
+					Expect(params.WriteIopsSecMaxLength).To(Equal(uint64(1)))
+					Expect(params.GroupNameSet).To(BeFalse())
+					return nil
+				})
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err = manager.SetVMIBlockIOTune(vmi, &v1.SetBlockIOTuneOptions{
+				Name: "data",
+				IOTune: v1.DiskIOTune{
+					ReadBytesSec:          &readBytes,
+					ReadBytesSecMax:       &readBytesMax,
+					ReadBytesSecMaxLength: &readBytesMaxLength,
+					WriteIOPSSec:          &writeIOPS,
+				},
+			})
+			Expect(err).To(BeNil())
+		})
+		It("should fail to set the I/O limits of a disk which is not attached", func() {
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+			domainXml, err := xml.MarshalIndent(&api.DomainSpec{}, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXml), nil)
+			mockDomain.EXPECT().GetMetadata(libvirt.DOMAIN_METADATA_ELEMENT, "http://kubevirt.io", libvirt.DOMAIN_AFFECT_CONFIG).Return("<kubevirt></kubevirt>", nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err = manager.SetVMIBlockIOTune(vmi, &v1.SetBlockIOTuneOptions{Name: "data"})
+			Expect(err).To(MatchError("disk data is not attached to the domain"))
+		})
+		It("should not start the same backup twice", func() {
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+			domainXml, err := xml.MarshalIndent(&api.DomainSpec{}, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXml), nil)
+			mockDomain.EXPECT().GetMetadata(libvirt.DOMAIN_METADATA_ELEMENT, "http://kubevirt.io", libvirt.DOMAIN_AFFECT_CONFIG).Return("<kubevirt><backup><name>mybackup</name></backup></kubevirt>", nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err = manager.BackupVMI(vmi, &v1.VirtualMachineInstanceBackupOptions{BackupName: "mybackup", Mode: v1.BackupModePull, Checkpoint: "mybackup"})
+			Expect(err).To(BeNil())
+		})
+		It("should not start a backup while another one is running", func() {
+			mockDomain.EXPECT().Free()
+			vmi := newVMI(testNamespace, testVmName)
+			domainXml, err := xml.MarshalIndent(&api.DomainSpec{}, "", "\t")
+			Expect(err).NotTo(HaveOccurred())
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(domainXml), nil)
+			mockDomain.EXPECT().GetMetadata(libvirt.DOMAIN_METADATA_ELEMENT, "http://kubevirt.io", libvirt.DOMAIN_AFFECT_CONFIG).Return("<kubevirt><backup><name>other</name></backup></kubevirt>", nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", nil, 0, nil, "/usr/share/OVMF")
+
+			err = manager.BackupVMI(vmi, &v1.VirtualMachineInstanceBackupOptions{BackupName: "mybackup", Mode: v1.BackupModePull, Checkpoint: "mybackup"})
+			Expect(err).To(MatchError("backup other is still running"))
+		})
 		It("should pause a VirtualMachineInstance", func() {
 			// Make sure that we always free the domain after use
 			mockDomain.EXPECT().Free()
@@ -1210,6 +1360,15 @@ var _ = Describe("Manager", func() {
 
 			copyDisks := getDiskTargetsForMigration(mockDomain, vmi)
 			Expect(copyDisks).Should(ConsistOf("vdb", "vdd"))
+
+			// shared volumes moved to new claims are copied as well
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				VolumeMigrations: []v1.VolumeMigration{{SourceVolume: "myvolumehost", DestinationPVC: "newclaim"}},
+			}
+			mockDomain.EXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return(string(convertedDomain), nil)
+
+			copyDisks = getDiskTargetsForMigration(mockDomain, vmi)
+			Expect(copyDisks).Should(ConsistOf("vdb", "vdc", "vdd"))
 		})
 		AfterEach(func() {
 			ip.GetLoopbackAddress = funcPreviousValue
@@ -1470,6 +1629,21 @@ var _ = Describe("getAttachedDisks", func() {
 					},
 				},
 			}),
+		table.Entry("be empty when the media of a CD-ROM got inserted",
+			[]api.Disk{
+				{
+					Device: "cdrom",
+				},
+			},
+			[]api.Disk{
+				{
+					Device: "cdrom",
+					Source: api.DiskSource{
+						File: "file",
+					},
+				},
+			},
+			[]api.Disk{}),
 	)
 })
 
@@ -1531,6 +1705,186 @@ var _ = Describe("getDetachedDisks", func() {
 					},
 				},
 			}),
+		table.Entry("be empty when the media of a CD-ROM got ejected",
+			[]api.Disk{
+				{
+					Device: "cdrom",
+					Source: api.DiskSource{
+						File: "file",
+					},
+				},
+			},
+			[]api.Disk{
+				{
+					Device: "cdrom",
+				},
+			},
+			[]api.Disk{}),
+	)
+})
+
+var _ = Describe("getChangedCDRomMedia", func() {
+	newCDRom := func(file string) api.Disk {
+		return api.Disk{
+			Device: "cdrom",
+			Type:   "file",
+			Source: api.DiskSource{
+				File: file,
+			},
+			Target: api.DiskTarget{
+				Bus:    "sata",
+				Device: "sda",
+			},
+			Driver: &api.DiskDriver{
+				Name:  "qemu",
+				Type:  "raw",
+				Cache: "none",
+			},
+			Alias: api.NewUserDefinedAlias("cdrom"),
+		}
+	}
+
+	table.DescribeTable("should return the correct values", func(oldDisks, newDisks, expected []api.Disk) {
+		res := getChangedCDRomMedia(oldDisks, newDisks)
+		Expect(res).To(Equal(expected))
+	},
+		table.Entry("be empty with empty old and new",
+			[]api.Disk{},
+			[]api.Disk{},
+			[]api.Disk{}),
+		table.Entry("be empty when the media did not change",
+			[]api.Disk{newCDRom("file")},
+			[]api.Disk{newCDRom("file")},
+			[]api.Disk{}),
+		table.Entry("contain the CD-ROM without source when the media got ejected",
+			[]api.Disk{newCDRom("file")},
+			[]api.Disk{newCDRom("")},
+			[]api.Disk{newCDRom("")}),
+		table.Entry("contain the CD-ROM with the new source when the media got inserted",
+			[]api.Disk{newCDRom("")},
+			[]api.Disk{newCDRom("file2")},
+			[]api.Disk{newCDRom("file2")}),
+		table.Entry("keep the cache mode of the domain",
+			[]api.Disk{newCDRom("")},
+			[]api.Disk{func() api.Disk {
+				disk := newCDRom("file2")
+				disk.Driver.Cache = "writethrough"
+				return disk
+			}()},
+			[]api.Disk{newCDRom("file2")}),
+		table.Entry("be empty for disks which are not CD-ROMs",
+			[]api.Disk{
+				{
+					Device: "disk",
+					Source: api.DiskSource{
+						File: "file",
+					},
+					Alias: api.NewUserDefinedAlias("disk"),
+				},
+			},
+			[]api.Disk{
+				{
+					Device: "disk",
+					Source: api.DiskSource{
+						File: "file2",
+					},
+					Alias: api.NewUserDefinedAlias("disk"),
+				},
+			},
+			[]api.Disk{}),
+	)
+})
+
+var _ = Describe("getResizableDisks", func() {
+	vmi := v1.NewMinimalVMI("testvmi")
+	vmi.Spec.Volumes = []v1.Volume{
+		{
+			Name: "pvc",
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "claim"},
+			},
+		},
+		{
+			Name: "hostdisk",
+			VolumeSource: v1.VolumeSource{
+				HostDisk: &v1.HostDisk{Path: "/var/run/kubevirt-private/vmi-disks/hostdisk/disk.img"},
+			},
+		},
+		{
+			Name: "containerdisk",
+			VolumeSource: v1.VolumeSource{
+				ContainerDisk: &v1.ContainerDiskSource{Image: "image"},
+			},
+		},
+	}
+
+	table.DescribeTable("should return the correct values", func(disks, expected []api.Disk) {
+		Expect(getResizableDisks(vmi, disks)).To(Equal(expected))
+	},
+		table.Entry("be empty without disks",
+			[]api.Disk{},
+			[]api.Disk{}),
+		table.Entry("contain the disks of claim and host disk volumes",
+			[]api.Disk{
+				{Device: "disk", Source: api.DiskSource{Dev: "/dev/pvc"}, Alias: api.NewUserDefinedAlias("pvc")},
+				{Device: "disk", Source: api.DiskSource{File: "disk.img"}, Alias: api.NewUserDefinedAlias("hostdisk")},
+			},
+			[]api.Disk{
+				{Device: "disk", Source: api.DiskSource{Dev: "/dev/pvc"}, Alias: api.NewUserDefinedAlias("pvc")},
+				{Device: "disk", Source: api.DiskSource{File: "disk.img"}, Alias: api.NewUserDefinedAlias("hostdisk")},
+			}),
+		table.Entry("be empty for other volumes",
+			[]api.Disk{
+				{Device: "disk", Source: api.DiskSource{File: "disk.qcow2"}, Alias: api.NewUserDefinedAlias("containerdisk")},
+			},
+			[]api.Disk{}),
+		table.Entry("be empty for CD-ROMs",
+			[]api.Disk{
+				{Device: "cdrom", Source: api.DiskSource{Dev: "/dev/pvc"}, Alias: api.NewUserDefinedAlias("pvc")},
+			},
+			[]api.Disk{}),
+	)
+})
+
+var _ = Describe("getDetachedInterfaces", func() {
+	table.DescribeTable("should return the correct values", func(oldInterfaces, newInterfaces, expected []api.Interface) {
+		res := getDetachedInterfaces(oldInterfaces, newInterfaces)
+		Expect(res).To(Equal(expected))
+	},
+		table.Entry("be empty with empty old and new",
+			[]api.Interface{},
+			[]api.Interface{},
+			[]api.Interface{}),
+		table.Entry("be empty with old and new being identical",
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}},
+			[]api.Interface{}),
+		table.Entry("contain the interface removed from new",
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}, {Alias: api.NewUserDefinedAlias("blue")}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("blue")}}),
+	)
+})
+
+var _ = Describe("getAttachedInterfaces", func() {
+	table.DescribeTable("should return the correct values", func(phase v1.InterfaceHotplugPhase, oldInterfaces, newInterfaces []api.Interface, expected map[string]bool) {
+		vmi := v1.NewMinimalVMI("testvmi")
+		vmi.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{{Name: "blue", PodInterfaceName: "net1", Phase: phase}}
+		res := getAttachedInterfaces(vmi, oldInterfaces, newInterfaces)
+		Expect(res).To(Equal(expected))
+	},
+		table.Entry("be empty with old and new being identical", v1.InterfaceHotplugAttachedToPod,
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}, {Alias: api.NewUserDefinedAlias("blue")}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}, {Alias: api.NewUserDefinedAlias("blue")}},
+			map[string]bool{}),
+		table.Entry("contain a new interface whose network is attached to the pod", v1.InterfaceHotplugAttachedToPod,
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}, {Alias: api.NewUserDefinedAlias("blue")}},
+			map[string]bool{"blue": true}),
+		table.Entry("be empty when the network of the new interface is not attached to the pod", v1.InterfaceHotplugPending,
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}},
+			[]api.Interface{{Alias: api.NewUserDefinedAlias("default")}, {Alias: api.NewUserDefinedAlias("blue")}},
+			map[string]bool{}),
 	)
 })
 
@@ -1584,6 +1938,127 @@ var _ = Describe("domXMLWithoutKubevirtMetadata", func() {
 	})
 })
 
+var _ = Describe("backup", func() {
+	cbtDisk := func(name, target string) api.Disk {
+		return api.Disk{
+			Device: "disk",
+			Source: api.DiskSource{
+				File: "/var/run/kubevirt-private/vmi-disks/" + name + "/disk.img.cbt.qcow2",
+				DataStore: &api.DataStore{
+					Type:   "file",
+					Format: &api.BackingStoreFormat{Type: "raw"},
+					Source: &api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/" + name + "/disk.img"},
+				},
+			},
+			Target: api.DiskTarget{Device: target},
+			Alias:  api.NewUserDefinedAlias(name),
+		}
+	}
+
+	var vmi *v1.VirtualMachineInstance
+	var disks []api.Disk
+
+	BeforeEach(func() {
+		vmi = v1.NewMinimalVMI("testvmi")
+		vmi.UID = "1234"
+		disks = []api.Disk{cbtDisk("rootdisk", "vda"), cbtDisk("datadisk", "vdb")}
+	})
+
+	It("should only back up the disks with changed block tracking", func() {
+		domainSpec := &api.DomainSpec{}
+		domainSpec.Devices.Disks = []api.Disk{
+			disks[0],
+			{Device: "disk", Source: api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/raw/disk.img"}, Alias: api.NewUserDefinedAlias("raw")},
+			disks[1],
+		}
+		Expect(getChangedBlockTrackingDisks(domainSpec)).To(Equal(disks))
+	})
+
+	table.DescribeTable("should check the libvirt version for changed block tracking", func(version uint32, expectedErr string) {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		mockConn := cli.NewMockConnection(ctrl)
+		mockConn.EXPECT().GetLibVersion().Return(version, nil)
+
+		err := checkChangedBlockTrackingSupported(mockConn)
+		if expectedErr == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(expectedErr))
+		}
+	},
+		table.Entry("and reject libvirt 6.6.0", uint32(6006000), "changed block tracking needs libvirt 10.10.0 or newer, found libvirt 6.6.0"),
+		table.Entry("and accept libvirt 10.10.0", uint32(10010000), ""),
+	)
+
+	It("should write a full push backup to the target claim", func() {
+		backupXML, err := generateBackupXML(vmi, &v1.VirtualMachineInstanceBackupOptions{
+			BackupName: "mybackup",
+			Mode:       v1.BackupModePush,
+			Target:     &v1.BackupTarget{ClaimName: "backups"},
+		}, disks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backupXML).To(Equal(`<domainbackup mode="push"><disks>` +
+			`<disk name="vda" backup="yes" type="file"><target file="/var/run/kubevirt/hotplug-disks/backup.mybackup/mybackup/rootdisk.qcow2"></target><driver type="qcow2"></driver></disk>` +
+			`<disk name="vdb" backup="yes" type="file"><target file="/var/run/kubevirt/hotplug-disks/backup.mybackup/mybackup/datadisk.qcow2"></target><driver type="qcow2"></driver></disk>` +
+			`</disks></domainbackup>`))
+	})
+
+	It("should export an incremental pull backup through the NBD server", func() {
+		backupXML, err := generateBackupXML(vmi, &v1.VirtualMachineInstanceBackupOptions{
+			BackupName: "mybackup",
+			Mode:       v1.BackupModePull,
+			Checkpoints: []v1.BackupCheckpoint{
+				{Name: "full"},
+				{Name: "incremental"},
+			},
+		}, disks[:1])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(backupXML).To(Equal(`<domainbackup mode="pull"><incremental>incremental</incremental>` +
+			`<server transport="unix" socket="/var/run/kubevirt-private/1234/virt-backup-nbd"></server><disks>` +
+			`<disk name="vda" backup="yes" type="file" exportname="rootdisk"><scratch file="/var/run/kubevirt-private/1234/backup-scratch-rootdisk.qcow2"></scratch></disk>` +
+			`</disks></domainbackup>`))
+	})
+
+	It("should not push a backup without a target", func() {
+		_, err := generateBackupXML(vmi, &v1.VirtualMachineInstanceBackupOptions{BackupName: "mybackup"}, disks)
+		Expect(err).To(MatchError("a push backup needs a target"))
+	})
+
+	It("should create a checkpoint with a bitmap on every disk", func() {
+		checkpointXML, err := generateCheckpointXML("mybackup", disks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(checkpointXML).To(Equal(`<domaincheckpoint><name>mybackup</name><disks>` +
+			`<disk name="vda" checkpoint="bitmap"></disk><disk name="vdb" checkpoint="bitmap"></disk>` +
+			`</disks></domaincheckpoint>`))
+	})
+
+	It("should redefine a checkpoint with the bitmaps of its volumes", func() {
+		checkpoint := v1.BackupCheckpoint{
+			Name:              "incremental",
+			CreationTimestamp: metav1.Unix(1600000000, 0),
+			Volumes:           []string{"datadisk"},
+		}
+		checkpointXML, err := generateRedefinedCheckpointXML(checkpoint, "full", disks)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(checkpointXML).To(Equal(`<domaincheckpoint><name>incremental</name><creationTime>1600000000</creationTime>` +
+			`<parent><name>full</name></parent><disks><disk name="vdb" checkpoint="bitmap" bitmap="incremental"></disk></disks>` +
+			`</domaincheckpoint>`))
+	})
+
+	It("should not redefine a checkpoint of a volume without changed block tracking", func() {
+		checkpoint := v1.BackupCheckpoint{Name: "full", Volumes: []string{"removed"}}
+		_, err := generateRedefinedCheckpointXML(checkpoint, "", disks)
+		Expect(err).To(MatchError("volume removed of checkpoint full does not have changed block tracking"))
+	})
+
+	It("should need a full backup of disks which are not tracked since the checkpoint", func() {
+		checkpoint := v1.BackupCheckpoint{Name: "full", Volumes: []string{"rootdisk"}}
+		Expect(verifyIncrementalDisks(checkpoint, disks[:1])).To(Succeed())
+		Expect(verifyIncrementalDisks(checkpoint, disks)).To(MatchError("the changed blocks of volume datadisk are not tracked since checkpoint full, a full backup is needed"))
+	})
+})
+
 func newVMI(namespace, name string) *v1.VirtualMachineInstance {
 	vmi := v1.NewMinimalVMIWithNS(namespace, name)
 	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
@@ -0,0 +1,151 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package network
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	etherTypeARP  = 0x0806
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+
+	ethernetHeaderLen = 14
+	ipv6HeaderLen     = 40
+
+	icmpv6ProtocolNumber          = 58
+	icmpv6NeighborAdvertisement   = 136
+	icmpv6OverrideFlag            = 0x20
+	ndpTargetLinkLayerAddressType = 2
+)
+
+var (
+	ethernetBroadcast = net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	// the ethernet address of the IPv6 all-nodes multicast group
+	ipv6AllNodesEthernet = net.HardwareAddr{0x33, 0x33, 0x00, 0x00, 0x00, 0x01}
+)
+
+func newEthernetFrame(dst, src net.HardwareAddr, etherType uint16, payload []byte) []byte {
+	frame := make([]byte, ethernetHeaderLen, ethernetHeaderLen+len(payload))
+	copy(frame[0:6], dst)
+	copy(frame[6:12], src)
+	binary.BigEndian.PutUint16(frame[12:14], etherType)
+	return append(frame, payload...)
+}
+
+// newGratuitousARP builds a broadcast ARP request which announces the given IPv4 address
+// as its own target, so that neighbours update the hardware address they cached for it.
+func newGratuitousARP(mac net.HardwareAddr, ip net.IP) ([]byte, error) {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return nil, fmt.Errorf("%s is not an IPv4 address", ip)
+	}
+	arp := make([]byte, 28)
+	// ethernet hardware, IPv4 protocol and their address lengths
+	binary.BigEndian.PutUint16(arp[0:2], 1)
+	binary.BigEndian.PutUint16(arp[2:4], etherTypeIPv4)
+	arp[4] = 6
+	arp[5] = 4
+	// operation: request
+	binary.BigEndian.PutUint16(arp[6:8], 1)
+	copy(arp[8:14], mac)
+	copy(arp[14:18], ip4)
+	copy(arp[24:28], ip4)
+	return newEthernetFrame(ethernetBroadcast, mac, etherTypeARP, arp), nil
+}
+
+// newUnsolicitedNeighborAdvertisement builds a neighbor advertisement with the override flag
+// sent to all nodes, the IPv6 counterpart of a gratuitous ARP (RFC 4861, section 7.2.6).
+func newUnsolicitedNeighborAdvertisement(mac net.HardwareAddr, ip net.IP) ([]byte, error) {
+	if ip.To4() != nil || ip.To16() == nil {
+		return nil, fmt.Errorf("%s is not an IPv6 address", ip)
+	}
+	src := ip.To16()
+	dst := net.IPv6linklocalallnodes.To16()
+
+	icmp := make([]byte, 32)
+	icmp[0] = icmpv6NeighborAdvertisement
+	icmp[4] = icmpv6OverrideFlag
+	copy(icmp[8:24], src)
+	icmp[24] = ndpTargetLinkLayerAddressType
+	icmp[25] = 1 // option length in units of 8 octets
+	copy(icmp[26:32], mac)
+	binary.BigEndian.PutUint16(icmp[2:4], icmpv6Checksum(src, dst, icmp))
+
+	packet := make([]byte, ipv6HeaderLen, ipv6HeaderLen+len(icmp))
+	packet[0] = 0x60 // version 6
+	binary.BigEndian.PutUint16(packet[4:6], uint16(len(icmp)))
+	packet[6] = icmpv6ProtocolNumber
+	packet[7] = 255 // hop limit required for neighbor discovery
+	copy(packet[8:24], src)
+	copy(packet[24:40], dst)
+	packet = append(packet, icmp...)
+	return newEthernetFrame(ipv6AllNodesEthernet, mac, etherTypeIPv6, packet), nil
+}
+
+func icmpv6Checksum(src, dst net.IP, icmp []byte) uint16 {
+	pseudoHeader := make([]byte, 40, 40+len(icmp))
+	copy(pseudoHeader[0:16], src)
+	copy(pseudoHeader[16:32], dst)
+	binary.BigEndian.PutUint32(pseudoHeader[32:36], uint32(len(icmp)))
+	pseudoHeader[39] = icmpv6ProtocolNumber
+	data := append(pseudoHeader, icmp...)
+
+	var sum uint32
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(binary.BigEndian.Uint16(data[i : i+2]))
+	}
+	if len(data)%2 == 1 {
+		sum += uint32(data[len(data)-1]) << 8
+	}
+	for sum > 0xffff {
+		sum = (sum >> 16) + (sum & 0xffff)
+	}
+	return ^uint16(sum)
+}
+
+func sendEthernetFrame(ifaceName string, frame []byte) error {
+	iface, err := net.InterfaceByName(ifaceName)
+	if err != nil {
+		return err
+	}
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW, 0)
+	if err != nil {
+		return err
+	}
+	defer unix.Close(fd)
+
+	addr := &unix.SockaddrLinklayer{
+		Protocol: htons(binary.BigEndian.Uint16(frame[12:14])),
+		Ifindex:  iface.Index,
+		Halen:    6,
+	}
+	copy(addr.Addr[:], frame[0:6])
+	return unix.Sendto(fd, frame, 0, addr)
+}
+
+func htons(v uint16) uint16 {
+	return (v << 8) | (v >> 8)
+}
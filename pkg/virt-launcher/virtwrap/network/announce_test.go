@@ -0,0 +1,76 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package network
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Address announcement", func() {
+	mac, _ := net.ParseMAC("de:ad:00:00:be:af")
+
+	It("should build a gratuitous ARP", func() {
+		frame, err := newGratuitousARP(mac, net.ParseIP("10.244.1.5"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frame).To(Equal([]byte{
+			// ethernet: broadcast destination, guest source, ARP
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xde, 0xad, 0x00, 0x00, 0xbe, 0xaf, 0x08, 0x06,
+			// ethernet/IPv4 request
+			0x00, 0x01, 0x08, 0x00, 0x06, 0x04, 0x00, 0x01,
+			// sender
+			0xde, 0xad, 0x00, 0x00, 0xbe, 0xaf, 10, 244, 1, 5,
+			// target
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 10, 244, 1, 5,
+		}))
+	})
+
+	It("should build an unsolicited neighbor advertisement", func() {
+		ip := net.ParseIP("fd10:244::5")
+		frame, err := newUnsolicitedNeighborAdvertisement(mac, ip)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(frame).To(HaveLen(ethernetHeaderLen + ipv6HeaderLen + 32))
+		Expect(frame[0:6]).To(Equal([]byte(ipv6AllNodesEthernet)))
+		Expect(frame[6:12]).To(Equal([]byte(mac)))
+
+		packet := frame[ethernetHeaderLen:]
+		Expect(packet[6]).To(Equal(byte(icmpv6ProtocolNumber)))
+		Expect(packet[7]).To(Equal(byte(255)))
+		Expect(net.IP(packet[8:24]).Equal(ip)).To(BeTrue())
+		Expect(net.IP(packet[24:40]).Equal(net.IPv6linklocalallnodes)).To(BeTrue())
+
+		icmp := packet[ipv6HeaderLen:]
+		Expect(icmp[0]).To(Equal(byte(icmpv6NeighborAdvertisement)))
+		Expect(icmp[4]).To(Equal(byte(icmpv6OverrideFlag)))
+		Expect(net.IP(icmp[8:24]).Equal(ip)).To(BeTrue())
+		Expect(icmp[26:32]).To(Equal([]byte(mac)))
+		// a valid checksum sums up to zero
+		Expect(icmpv6Checksum(ip, net.IPv6linklocalallnodes, icmp)).To(BeZero())
+	})
+
+	It("should reject addresses of the wrong family", func() {
+		_, err := newGratuitousARP(mac, net.ParseIP("fd10:244::5"))
+		Expect(err).To(HaveOccurred())
+		_, err = newUnsolicitedNeighborAdvertisement(mac, net.ParseIP("10.244.1.5"))
+		Expect(err).To(HaveOccurred())
+	})
+})
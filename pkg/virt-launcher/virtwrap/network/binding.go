@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package network
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
+)
+
+// BindingPlugin creates the BindMechanism which sets up the pod network and
+// the libvirt interface of a VMI interface. A plugin which returns a nil
+// BindMechanism has nothing to plug in virt-launcher, for instance because
+// the device is passed through or because a hook sidecar adds the libvirt
+// interface.
+type BindingPlugin func(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error)
+
+var (
+	bindingPluginsLock sync.RWMutex
+	bindingPlugins     = map[string]BindingPlugin{}
+)
+
+func init() {
+	RegisterBindingPlugin(converter.BridgeBindingName, newBridgeBindMechanism)
+	RegisterBindingPlugin(converter.MasqueradeBindingName, newMasqueradeBindMechanism)
+	RegisterBindingPlugin(converter.SlirpBindingName, newSlirpBindMechanism)
+	RegisterBindingPlugin(converter.SriovBindingName, newSriovBindMechanism)
+	RegisterBindingPlugin(converter.MacvtapBindingName, newMacvtapBindMechanism)
+}
+
+// RegisterBindingPlugin registers a binding plugin under the given name,
+// replacing any plugin previously registered under the same name. The libvirt
+// interface of the binding is converted by the converter.InterfaceBinding
+// registered under the same name.
+func RegisterBindingPlugin(name string, plugin BindingPlugin) {
+	bindingPluginsLock.Lock()
+	defer bindingPluginsLock.Unlock()
+	bindingPlugins[name] = plugin
+}
+
+func lookupBindingPlugin(name string) (BindingPlugin, bool) {
+	bindingPluginsLock.RLock()
+	defer bindingPluginsLock.RUnlock()
+	plugin, exists := bindingPlugins[name]
+	return plugin, exists
+}
+
+func newBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	name := converter.GetInterfaceBindingName(iface)
+	plugin, exists := lookupBindingPlugin(name)
+	if !exists {
+		if iface.Binding != nil {
+			// Bindings which are not registered in virt-launcher are provided
+			// by a hook sidecar, which adds the interface to the domain.
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Not implemented")
+	}
+	return plugin(vmi, iface, network, domain, podInterfaceName)
+}
+
+func retrieveMacAddress(iface *v1.Interface) (*net.HardwareAddr, error) {
+	if iface.MacAddress != "" {
+		macAddress, err := net.ParseMAC(iface.MacAddress)
+		if err != nil {
+			return nil, err
+		}
+		return &macAddress, nil
+	}
+	return nil, nil
+}
+
+func newVIF(iface *v1.Interface, podInterfaceName string) (*VIF, error) {
+	mac, err := retrieveMacAddress(iface)
+	if err != nil {
+		return nil, err
+	}
+	vif := &VIF{Name: podInterfaceName}
+	if mac != nil {
+		vif.MAC = *mac
+	}
+	return vif, nil
+}
+
+func newBridgeBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	vif, err := newVIF(iface, podInterfaceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(vif.MAC) == 0 {
+		// The guest keeps the MAC it got from the source pod, the DHCP server has to serve it
+		vif.MAC, err = migrationSourceMacAddress(vmi, iface.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &BridgeBindMechanism{iface: iface,
+		virtIface:           &api.Interface{},
+		vmi:                 vmi,
+		vif:                 vif,
+		domain:              domain,
+		podInterfaceName:    podInterfaceName,
+		bridgeInterfaceName: fmt.Sprintf("k6t-%s", podInterfaceName)}, nil
+}
+
+// migrationSourceMacAddress returns the MAC address of a migrating guest interface, or nil
+// when the VMI is not migrating or the address is not reported yet
+func migrationSourceMacAddress(vmi *v1.VirtualMachineInstance, ifaceName string) (net.HardwareAddr, error) {
+	if vmi.Status.MigrationState == nil {
+		return nil, nil
+	}
+	for _, ifaceStatus := range vmi.Status.Interfaces {
+		if ifaceStatus.Name == ifaceName && ifaceStatus.MAC != "" {
+			return net.ParseMAC(ifaceStatus.MAC)
+		}
+	}
+	return nil, nil
+}
+
+func newMasqueradeBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	vif, err := newVIF(iface, podInterfaceName)
+	if err != nil {
+		return nil, err
+	}
+	return &MasqueradeBindMechanism{iface: iface,
+		virtIface:           &api.Interface{},
+		vmi:                 vmi,
+		vif:                 vif,
+		domain:              domain,
+		podInterfaceName:    podInterfaceName,
+		vmNetworkCIDR:       network.Pod.VMNetworkCIDR,
+		vmIpv6NetworkCIDR:   network.Pod.VMIPv6NetworkCIDR,
+		bridgeInterfaceName: fmt.Sprintf("k6t-%s", podInterfaceName)}, nil
+}
+
+func newSlirpBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	return &SlirpBindMechanism{vmi: vmi, iface: iface, domain: domain}, nil
+}
+
+// There is nothing to plug for SR-IOV devices, they are passed through as host devices
+func newSriovBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	return nil, nil
+}
+
+func newMacvtapBindMechanism(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+	mac, err := retrieveMacAddress(iface)
+	if err != nil {
+		return nil, err
+	}
+	virtIface := &api.Interface{}
+	if mac != nil {
+		virtIface.MAC = &api.MAC{MAC: mac.String()}
+	}
+	return &MacvtapBindMechanism{
+		vmi:              vmi,
+		iface:            iface,
+		virtIface:        virtIface,
+		domain:           domain,
+		podInterfaceName: podInterfaceName,
+	}, nil
+}
@@ -27,6 +27,7 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/coreos/go-iptables/iptables"
 	lmf "github.com/subgraph/libmacouflage"
@@ -34,6 +35,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter"
 
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/sysctl"
 
 	netutils "k8s.io/utils/net"
@@ -43,6 +45,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-handler/selinux"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network/dhcp"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network/dhcpv6"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/network/ndp"
 )
 
 const (
@@ -102,7 +105,7 @@ type NetworkHandler interface {
 	GenerateRandomMac() (net.HardwareAddr, error)
 	GetMacDetails(iface string) (net.HardwareAddr, error)
 	LinkSetMaster(link netlink.Link, master *netlink.Bridge) error
-	StartDHCP(nic *VIF, serverAddr net.IP, bridgeInterfaceName string, dhcpOptions *v1.DHCPOptions, filterByMAC bool) error
+	StartDHCP(nic *VIF, serverAddr net.IP, bridgeInterfaceName string, iface *v1.Interface, filterByMAC bool) error
 	HasNatIptables(proto iptables.Protocol) bool
 	IsIpv6Enabled(interfaceName string) (bool, error)
 	IsIpv4Primary() (bool, error)
@@ -113,10 +116,13 @@ type NetworkHandler interface {
 	NftablesNewChain(proto iptables.Protocol, table, chain string) error
 	NftablesAppendRule(proto iptables.Protocol, table, chain string, rulespec ...string) error
 	NftablesLoad(proto iptables.Protocol) error
+	NftablesLoadRuleset(ruleset string) error
+	NftablesCheckRuleset(ruleset string) error
 	GetNFTIPString(proto iptables.Protocol) string
 	CreateTapDevice(tapName string, queueNumber uint32, launcherPID int, mtu int) error
 	BindTapDeviceToBridge(tapName string, bridgeName string) error
 	DisableTXOffloadChecksum(ifaceName string) error
+	AnnounceAddress(ifaceName string, mac net.HardwareAddr, ip net.IP) error
 }
 
 type NetworkUtilsHandler struct{}
@@ -283,6 +289,30 @@ func (h *NetworkUtilsHandler) NftablesLoad(proto iptables.Protocol) error {
 	return nil
 }
 
+// NftablesLoadRuleset loads a ruleset in the nft script format in a single transaction
+func (h *NetworkUtilsHandler) NftablesLoadRuleset(ruleset string) error {
+	cmd := exec.Command("nft", "-f", "-")
+	cmd.Stdin = strings.NewReader(ruleset)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to load nftables ruleset error %s", string(output))
+	}
+
+	return nil
+}
+
+// NftablesCheckRuleset checks whether nft and the kernel accept a ruleset without loading it
+func (h *NetworkUtilsHandler) NftablesCheckRuleset(ruleset string) error {
+	cmd := exec.Command("nft", "--check", "-f", "-")
+	cmd.Stdin = strings.NewReader(ruleset)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("nftables ruleset not accepted error %s", string(output))
+	}
+
+	return nil
+}
+
 func composeNftablesLoad(proto iptables.Protocol) *exec.Cmd {
 	ipVersion := "4"
 	if proto == iptables.ProtocolIPv6 {
@@ -367,12 +397,24 @@ func (h *NetworkUtilsHandler) SetRandomMac(iface string) (net.HardwareAddr, erro
 	return currentMac, nil
 }
 
-func (h *NetworkUtilsHandler) StartDHCP(nic *VIF, serverAddr net.IP, bridgeInterfaceName string, dhcpOptions *v1.DHCPOptions, filterByMAC bool) error {
+func (h *NetworkUtilsHandler) StartDHCP(nic *VIF, serverAddr net.IP, bridgeInterfaceName string, iface *v1.Interface, filterByMAC bool) error {
 	log.Log.V(4).Infof("StartDHCP network Nic: %+v", nic)
 	nameservers, searchDomains, err := converter.GetResolvConfDetailsFromPod()
 	if err != nil {
 		return fmt.Errorf("Failed to get DNS servers from resolv.conf: %v", err)
 	}
+	dhcpOptions := iface.DHCPOptions
+	if dhcpOptions != nil && len(dhcpOptions.SearchDomains) > 0 {
+		searchDomains = dhcpOptions.SearchDomains
+	}
+	onLease := func(ip net.IP) {
+		DHCPLeases.Acknowledge(iface.Name, ip)
+	}
+
+	routes, err := getDHCPRoutes(nic, dhcpOptions)
+	if err != nil {
+		return err
+	}
 
 	// panic in case the DHCP server failed during the vm creation
 	// but ignore dhcp errors when the vm is destroyed or shutting down
@@ -386,10 +428,11 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *VIF, serverAddr net.IP, bridgeInter
 			serverAddr,
 			nic.Gateway,
 			nameservers,
-			nic.Routes,
+			routes,
 			searchDomains,
 			nic.Mtu,
 			dhcpOptions,
+			onLease,
 		); err != nil {
 			log.Log.Errorf("failed to run DHCP: %v", err)
 			panic(err)
@@ -397,20 +440,98 @@ func (h *NetworkUtilsHandler) StartDHCP(nic *VIF, serverAddr net.IP, bridgeInter
 	}()
 
 	if nic.IPv6.IPNet != nil {
+		ipv6Nameservers, err := converter.GetIPv6NameserversFromPod()
+		if err != nil {
+			return fmt.Errorf("Failed to get IPv6 DNS servers from resolv.conf: %v", err)
+		}
 		go func() {
 			if err = DHCPv6Server(
 				nic.IPv6.IP,
 				bridgeInterfaceName,
+				ipv6Nameservers,
+				searchDomains,
+				onLease,
 			); err != nil {
 				log.Log.Reason(err).Error("failed to run DHCPv6")
 				panic(err)
 			}
 		}()
+
+		if util.IsRouterAdvertisementsInterface(iface) {
+			routerAdvertisement, err := newRouterAdvertisement(nic, dhcpOptions, ipv6Nameservers, searchDomains)
+			if err != nil {
+				return err
+			}
+
+			// the guest keeps its DHCPv6 address without the advertisements, so the VM keeps running
+			go func() {
+				if err := RouterAdvertisementServer(bridgeInterfaceName, routerAdvertisement); err != nil {
+					log.Log.Reason(err).Errorf("failed to run the router advertisement server on %s", bridgeInterfaceName)
+				}
+			}()
+		}
 	}
 
 	return nil
 }
 
+// getDHCPRoutes returns the routes of the pod interface and the IPv4 routes of the DHCP options.
+// Guests ignore the router option when classless routes are passed, so the default route is
+// added when the pod interface has no routes.
+func getDHCPRoutes(nic *VIF, dhcpOptions *v1.DHCPOptions) (*[]netlink.Route, error) {
+	if dhcpOptions == nil || len(dhcpOptions.Routes) == 0 {
+		return nic.Routes, nil
+	}
+
+	var routes []netlink.Route
+	if nic.Routes != nil {
+		routes = append(routes, *nic.Routes...)
+	} else if nic.Gateway != nil {
+		routes = append(routes, netlink.Route{Gw: nic.Gateway})
+	}
+	for _, route := range dhcpOptions.Routes {
+		_, dst, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the destination of route %s: %v", route.Destination, err)
+		}
+		if dst.IP.To4() == nil {
+			continue
+		}
+		gateway := nic.Gateway
+		if route.Gateway != "" {
+			gateway = net.ParseIP(route.Gateway).To4()
+		}
+		routes = append(routes, netlink.Route{Dst: dst, Gw: gateway})
+	}
+	return &routes, nil
+}
+
+// newRouterAdvertisement returns the router advertisement of the IPv6 network of the interface,
+// which provides the default route to guests leased their address by DHCPv6
+func newRouterAdvertisement(nic *VIF, dhcpOptions *v1.DHCPOptions, nameservers []net.IP, searchDomains []string) (*ndp.RouterAdvertisement, error) {
+	ra := &ndp.RouterAdvertisement{
+		Prefix:        &net.IPNet{IP: nic.IPv6.IP.Mask(nic.IPv6.Mask), Mask: nic.IPv6.Mask},
+		Managed:       true,
+		MTU:           uint32(nic.Mtu),
+		DNSServers:    nameservers,
+		SearchDomains: searchDomains,
+	}
+	if dhcpOptions == nil {
+		return ra, nil
+	}
+	ra.Autonomous = dhcpOptions.SLAAC
+	for _, route := range dhcpOptions.Routes {
+		_, dst, err := net.ParseCIDR(route.Destination)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the destination of route %s: %v", route.Destination, err)
+		}
+		if dst.IP.To4() == nil {
+			ra.Routes = append(ra.Routes, dst)
+		}
+	}
+	return ra, nil
+}
+
 // Generate a random mac for interface
 // Avoid MAC address starting with reserved value 0xFE (https://github.com/kubevirt/kubevirt/issues/1494)
 func (h *NetworkUtilsHandler) GenerateRandomMac() (net.HardwareAddr, error) {
@@ -484,9 +605,30 @@ func (h *NetworkUtilsHandler) DisableTXOffloadChecksum(ifaceName string) error {
 	return nil
 }
 
+// AnnounceAddress sends a gratuitous ARP for IPv4 addresses, or an unsolicited neighbor
+// advertisement for IPv6 addresses, claiming the address for the given MAC
+func (h *NetworkUtilsHandler) AnnounceAddress(ifaceName string, mac net.HardwareAddr, ip net.IP) error {
+	var frame []byte
+	var err error
+	if ip.To4() != nil {
+		frame, err = newGratuitousARP(mac, ip)
+	} else {
+		frame, err = newUnsolicitedNeighborAdvertisement(mac, ip)
+	}
+	if err != nil {
+		return err
+	}
+	if err := sendEthernetFrame(ifaceName, frame); err != nil {
+		log.Log.Reason(err).Errorf("failed to announce address %s of %s on %s", ip, mac, ifaceName)
+		return err
+	}
+	return nil
+}
+
 // Allow mocking for tests
 var DHCPServer = dhcp.SingleClientDHCPServer
 var DHCPv6Server = dhcpv6.SingleClientDHCPv6Server
+var RouterAdvertisementServer = ndp.SingleClientRouterAdvertisementServer
 
 func initHandler() {
 	if Handler == nil {
@@ -34,6 +34,7 @@ import (
 	"github.com/vishvananda/netlink"
 	"k8s.io/apimachinery/pkg/types"
 
+	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -129,6 +130,72 @@ var _ = Describe("Common Methods", func() {
 	})
 })
 
+var _ = Describe("DHCP options", func() {
+	const mtu = 1450
+
+	var vif *VIF
+
+	BeforeEach(func() {
+		vif = createDummyVIF("test-vif", "10.0.0.200/24", "10.0.0.1", "fd10:0:2::2/120", "de:ad:00:00:be:ef", mtu)
+	})
+
+	Context("getDHCPRoutes", func() {
+		It("should keep the routes of the pod without spec routes", func() {
+			routes, err := getDHCPRoutes(vif, &v1.DHCPOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routes).To(BeNil())
+		})
+		It("should add the IPv4 spec routes to the default route", func() {
+			routes, err := getDHCPRoutes(vif, &v1.DHCPOptions{Routes: []v1.DHCPRoute{
+				{Destination: "192.168.0.0/16", Gateway: "10.0.0.254"},
+				{Destination: "172.16.0.0/12"},
+				{Destination: "fd20::/64"},
+			}})
+			Expect(err).ToNot(HaveOccurred())
+			_, dst, _ := net.ParseCIDR("192.168.0.0/16")
+			_, dstWithoutGateway, _ := net.ParseCIDR("172.16.0.0/12")
+			Expect(*routes).To(Equal([]netlink.Route{
+				{Gw: vif.Gateway},
+				{Dst: dst, Gw: net.ParseIP("10.0.0.254").To4()},
+				{Dst: dstWithoutGateway, Gw: vif.Gateway},
+			}))
+		})
+		It("should fail on an invalid destination", func() {
+			_, err := getDHCPRoutes(vif, &v1.DHCPOptions{Routes: []v1.DHCPRoute{{Destination: "192.168.0.0"}}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("newRouterAdvertisement", func() {
+		nameservers := []net.IP{net.ParseIP("fd00::10")}
+		searchDomains := []string{"example.com"}
+
+		It("should advertise the network of the interface", func() {
+			ra, err := newRouterAdvertisement(vif, nil, nameservers, searchDomains)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ra.Prefix.String()).To(Equal("fd10:0:2::/120"))
+			Expect(ra.Managed).To(BeTrue())
+			Expect(ra.Autonomous).To(BeFalse())
+			Expect(ra.MTU).To(Equal(uint32(mtu)))
+			Expect(ra.DNSServers).To(Equal(nameservers))
+			Expect(ra.SearchDomains).To(Equal(searchDomains))
+		})
+		It("should advertise SLAAC and the IPv6 spec routes", func() {
+			ra, err := newRouterAdvertisement(vif, &v1.DHCPOptions{
+				SLAAC: true,
+				Routes: []v1.DHCPRoute{
+					{Destination: "192.168.0.0/16"},
+					{Destination: "fd20::/64"},
+				},
+			}, nameservers, searchDomains)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ra.Autonomous).To(BeTrue())
+			Expect(ra.Routes).To(HaveLen(1))
+			Expect(ra.Routes[0].String()).To(Equal("fd20::/64"))
+		})
+	})
+})
+
 var _ = Describe("VIF", func() {
 	const ipv4Cidr = "10.0.0.200/24"
 	const ipv4Address = "10.0.0.200"
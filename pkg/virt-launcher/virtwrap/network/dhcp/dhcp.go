@@ -59,7 +59,8 @@ func SingleClientDHCPServer(
 	routes *[]netlink.Route,
 	searchDomains []string,
 	mtu uint16,
-	customDHCPOptions *v1.DHCPOptions) error {
+	customDHCPOptions *v1.DHCPOptions,
+	onLease func(ip net.IP)) error {
 
 	log.Log.Info("Starting SingleClientDHCPServer")
 
@@ -80,6 +81,7 @@ func SingleClientDHCPServer(
 		serverIP:      serverIP.To4(),
 		leaseDuration: infiniteLease,
 		options:       options,
+		onLease:       onLease,
 	}
 
 	l, err := NewUDP4FilterListener(serverIface, ":67")
@@ -109,11 +111,15 @@ func prepareDHCPOptions(
 
 	dhcpOptions := dhcp.Options{
 		dhcp.OptionSubnetMask:       []byte(clientMask),
-		dhcp.OptionRouter:           []byte(routerIP),
 		dhcp.OptionDomainNameServer: bytes.Join(dnsIPs, nil),
 		dhcp.OptionInterfaceMTU:     mtuArray,
 	}
 
+	// static leases do not need to have a gateway
+	if routerIP != nil {
+		dhcpOptions[dhcp.OptionRouter] = []byte(routerIP)
+	}
+
 	netRoutes := formClasslessRoutes(routes)
 
 	if netRoutes != nil {
@@ -182,6 +188,8 @@ type DHCPHandler struct {
 	filterByMAC   bool
 	leaseDuration time.Duration
 	options       dhcp.Options
+	// onLease is called with the address of every acknowledged request
+	onLease func(ip net.IP)
 }
 
 func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, options dhcp.Options) (d dhcp.Packet) {
@@ -202,6 +210,9 @@ func (h *DHCPHandler) ServeDHCP(p dhcp.Packet, msgType dhcp.MessageType, options
 
 	case dhcp.Request:
 		log.Log.V(4).Info("The request has message type REQUEST")
+		if h.onLease != nil {
+			h.onLease(h.clientIP)
+		}
 		return dhcp.ReplyPacket(p, dhcp.ACK, h.serverIP, h.clientIP, h.leaseDuration,
 			h.options.SelectOrderOrAll(nil))
 
@@ -244,5 +244,40 @@ var _ = Describe("DHCP", func() {
 			}))
 			Expect(options[240]).To(Equal([]byte("private.options.kubevirt.io")))
 		})
+
+		It("should not contain the router option without a router", func() {
+			ip := net.ParseIP("192.168.2.1")
+			options, err := prepareDHCPOptions(ip.DefaultMask(), nil, nil, nil, nil, 1500, "myhost", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options).ToNot(HaveKey(dhcp4.OptionRouter))
+		})
+	})
+
+	Context("DHCPHandler", func() {
+		It("should report the leases it acknowledges", func() {
+			clientMAC, _ := net.ParseMAC("de:ad:00:00:be:af")
+			var leases []net.IP
+			handler := &DHCPHandler{
+				serverIP:    net.ParseIP("192.168.2.1").To4(),
+				clientIP:    net.ParseIP("192.168.2.2").To4(),
+				clientMAC:   clientMAC,
+				filterByMAC: true,
+				options:     dhcp4.Options{},
+				onLease: func(ip net.IP) {
+					leases = append(leases, ip)
+				},
+			}
+
+			discover := dhcp4.RequestPacket(dhcp4.Discover, clientMAC, nil, []byte{1, 2, 3, 4}, false, nil)
+			Expect(handler.ServeDHCP(discover, dhcp4.Discover, nil)).ToNot(BeNil())
+			Expect(leases).To(BeEmpty())
+
+			request := dhcp4.RequestPacket(dhcp4.Request, clientMAC, nil, []byte{1, 2, 3, 4}, false, nil)
+			otherMAC, _ := net.ParseMAC("de:ad:00:00:be:ef")
+			otherRequest := dhcp4.RequestPacket(dhcp4.Request, otherMAC, nil, []byte{1, 2, 3, 5}, false, nil)
+			Expect(handler.ServeDHCP(otherRequest, dhcp4.Request, nil)).To(BeNil())
+			Expect(handler.ServeDHCP(request, dhcp4.Request, nil)).ToNot(BeNil())
+			Expect(leases).To(Equal([]net.IP{net.ParseIP("192.168.2.2").To4()}))
+		})
 	})
 })
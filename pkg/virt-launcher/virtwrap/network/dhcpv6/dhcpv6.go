@@ -38,9 +38,11 @@ const (
 type DHCPv6Handler struct {
 	clientIP  net.IP
 	modifiers []dhcpv6.Modifier
+	// onLease is called with the address of every request the client is assigned its address with
+	onLease func(ip net.IP)
 }
 
-func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
+func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string, dnsIPs []net.IP, searchDomains []string, onLease func(ip net.IP)) error {
 	log.Log.Info("Starting SingleClientDHCPv6Server")
 
 	iface, err := net.InterfaceByName(serverIfaceName)
@@ -48,11 +50,12 @@ func SingleClientDHCPv6Server(clientIP net.IP, serverIfaceName string) error {
 		return fmt.Errorf("couldn't create DHCPv6 server, couldn't get the dhcp6 server interface: %v", err)
 	}
 
-	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr)
+	modifiers := prepareDHCPv6Modifiers(clientIP, iface.HardwareAddr, dnsIPs, searchDomains)
 
 	handler := &DHCPv6Handler{
 		clientIP:  clientIP,
 		modifiers: modifiers,
+		onLease:   onLease,
 	}
 
 	conn, err := NewConnection(iface)
@@ -84,6 +87,10 @@ func (h *DHCPv6Handler) ServeDHCPv6(conn net.PacketConn, peer net.Addr, m dhcpv6
 
 	}
 
+	if h.onLease != nil && isLeaseRequest(m) {
+		h.onLease(h.clientIP)
+	}
+
 	if _, err := conn.WriteTo(response.ToBytes(), peer); err != nil {
 		log.Log.V(4).Reason(err).Error("DHCPv6 failed sending a response to the client")
 	}
@@ -119,9 +126,31 @@ func (h *DHCPv6Handler) buildResponse(msg dhcpv6.DHCPv6) (*dhcpv6.Message, error
 	return response, nil
 }
 
-func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr) []dhcpv6.Modifier {
+func prepareDHCPv6Modifiers(clientIP net.IP, serverInterfaceMac net.HardwareAddr, dnsIPs []net.IP, searchDomains []string) []dhcpv6.Modifier {
 	optIAAddress := dhcpv6.OptIAAddress{IPv6Addr: clientIP, PreferredLifetime: infiniteLease, ValidLifetime: infiniteLease}
 	duid := dhcpv6.Duid{Type: dhcpv6.DUID_LL, HwType: iana.HWTypeEthernet, LinkLayerAddr: serverInterfaceMac}
 
-	return []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	modifiers := []dhcpv6.Modifier{dhcpv6.WithIANA(optIAAddress), dhcpv6.WithServerID(duid)}
+	if len(dnsIPs) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDNS(dnsIPs...))
+	}
+	if len(searchDomains) > 0 {
+		modifiers = append(modifiers, dhcpv6.WithDomainSearchList(searchDomains...))
+	}
+	return modifiers
+}
+
+// isLeaseRequest returns whether the reply to the message assigns the address to the client
+func isLeaseRequest(msg dhcpv6.DHCPv6) bool {
+	dhcpv6Msg, ok := msg.(*dhcpv6.Message)
+	if !ok {
+		return false
+	}
+	switch dhcpv6Msg.Type() {
+	case dhcpv6.MessageTypeRequest, dhcpv6.MessageTypeRenew, dhcpv6.MessageTypeRebind:
+		return true
+	case dhcpv6.MessageTypeSolicit:
+		return dhcpv6Msg.GetOneOption(dhcpv6.OptionRapidCommit) != nil
+	}
+	return false
 }
@@ -34,7 +34,7 @@ var _ = Describe("DHCPv6", func() {
 		It("should contain ianaAdrress and duid", func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 			Expect(len(modifiers)).To(Equal(2))
 
 			msg := &dhcpv6.Message{
@@ -53,13 +53,50 @@ var _ = Describe("DHCPv6", func() {
 			Expect(msg.GetOneOption(dhcpv6.OptionServerID).String()).To(Equal(expectedServerId.String()))
 		})
 	})
+	Context("prepareDHCPv6Modifiers with DNS options", func() {
+		It("should contain the nameservers and the search domains", func() {
+			clientIP := net.ParseIP("fd10:0:2::2")
+			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
+			nameserver := net.ParseIP("fd00:10:96::a")
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, []net.IP{nameserver}, []string{"example.com"})
+			Expect(modifiers).To(HaveLen(4))
+
+			msg := &dhcpv6.Message{
+				MessageType: dhcpv6.MessageTypeReply,
+			}
+			for _, modifier := range modifiers {
+				modifier(msg)
+			}
+			Expect(msg.Options.DNS()).To(Equal([]net.IP{nameserver}))
+			Expect(msg.Options.DomainSearchList().Labels).To(Equal([]string{"example.com"}))
+		})
+	})
+	Context("isLeaseRequest", func() {
+		It("should only match requests the client is assigned its address with", func() {
+			for _, messageType := range []dhcpv6.MessageType{dhcpv6.MessageTypeRequest, dhcpv6.MessageTypeRenew, dhcpv6.MessageTypeRebind} {
+				msg, err := newMessage(messageType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(isLeaseRequest(msg)).To(BeTrue(), messageType.String())
+			}
+			for _, messageType := range []dhcpv6.MessageType{dhcpv6.MessageTypeSolicit, dhcpv6.MessageTypeInformationRequest, dhcpv6.MessageTypeRelease} {
+				msg, err := newMessage(messageType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(isLeaseRequest(msg)).To(BeFalse(), messageType.String())
+			}
+
+			msg, err := newMessage(dhcpv6.MessageTypeSolicit)
+			Expect(err).ToNot(HaveOccurred())
+			dhcpv6.WithRapidCommit(msg)
+			Expect(isLeaseRequest(msg)).To(BeTrue())
+		})
+	})
 	Context("buildResponse should build a response with", func() {
 		var handler *DHCPv6Handler
 
 		BeforeEach(func() {
 			clientIP := net.ParseIP("fd10:0:2::2")
 			serverInterfaceMac, _ := net.ParseMAC("12:34:56:78:9A:BC")
-			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac)
+			modifiers := prepareDHCPv6Modifiers(clientIP, serverInterfaceMac, nil, nil)
 
 			handler = &DHCPv6Handler{
 				clientIP:  clientIP,
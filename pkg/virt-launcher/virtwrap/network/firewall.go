@@ -0,0 +1,250 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package network
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	netutils "k8s.io/utils/net"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+// firewallTable holds the rules of the VirtualMachineNetworkPolicies, in the bridge family
+// for bridge bound interfaces and in the netdev family for macvtap interfaces
+const firewallTable = "kubevirt-network-policy"
+
+// egressHookProbe is a chain on the egress hook of the netdev family, which needs
+// nftables 1.0.1 and a kernel of version 5.16 or newer
+const egressHookProbe = `table netdev kubevirt-egress-hook-probe {
+	chain egress {
+		type filter hook egress device "lo" priority 0; policy accept;
+	}
+}
+`
+
+// FirewallRule allows the traffic matching all of its fields
+type FirewallRule struct {
+	// CIDRs of the peers, all the peers match when empty
+	CIDRs []string
+	// Except lists the CIDRs of the peers which do not match
+	Except []string
+	// Protocol is one of tcp, udp or sctp, all the traffic matches when empty
+	Protocol string
+	// Port is the first port of the guest for ingress rules, and of the peer for egress
+	// rules. All the ports of the protocol match when it is 0.
+	Port int32
+	// EndPort is the last port of the range starting at Port
+	EndPort int32
+}
+
+// InterfaceFirewall holds the traffic allowed to reach and to leave the guest through
+// an interface. All the traffic of a direction which is not isolated is allowed.
+type InterfaceFirewall struct {
+	IsolateIngress bool
+	Ingress        []FirewallRule
+	IsolateEgress  bool
+	Egress         []FirewallRule
+}
+
+// RenderFirewall renders the firewalls of the bridge and macvtap interfaces of the vmi, keyed
+// by the names of the interfaces, as an nft script which replaces the firewall loaded before.
+// Bridge bound interfaces are filtered statefully on their tap device in the forward chain
+// of the bridge, macvtap interfaces are filtered statelessly on the ingress and egress hooks
+// of the macvtap device. The egress of macvtap interfaces is only filtered if egressHook is
+// true, as the egress hook is not available with older nftables and kernels.
+func RenderFirewall(vmi *v1.VirtualMachineInstance, firewalls map[string]*InterfaceFirewall, egressHook bool) string {
+	var jumps, bridgeChains, netdevChains []string
+
+	networks, cniNetworks := getNetworksAndCniNetworks(vmi)
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		firewall, ok := firewalls[iface.Name]
+		if !ok {
+			continue
+		}
+		if _, ok := networks[iface.Name]; !ok {
+			continue
+		}
+		podInterfaceName := getPodInterfaceName(vmi, networks, cniNetworks, iface.Name)
+		ingressChain := "ingress-" + podInterfaceName
+		egressChain := "egress-" + podInterfaceName
+
+		if iface.Bridge != nil {
+			tapDeviceName := generateTapDeviceName(podInterfaceName)
+			if firewall.IsolateIngress {
+				jumps = append(jumps, fmt.Sprintf("oifname %q jump %s", tapDeviceName, ingressChain))
+				bridgeChains = append(bridgeChains, renderFirewallChain(ingressChain, "", true, true, firewall.Ingress))
+			}
+			if firewall.IsolateEgress {
+				jumps = append(jumps, fmt.Sprintf("iifname %q jump %s", tapDeviceName, egressChain))
+				bridgeChains = append(bridgeChains, renderFirewallChain(egressChain, "", false, true, firewall.Egress))
+			}
+		} else if iface.Macvtap != nil {
+			if firewall.IsolateIngress {
+				hook := fmt.Sprintf("type filter hook ingress device %q priority 0; policy accept;", podInterfaceName)
+				netdevChains = append(netdevChains, renderFirewallChain(ingressChain, hook, true, false, firewall.Ingress))
+			}
+			if firewall.IsolateEgress && egressHook {
+				hook := fmt.Sprintf("type filter hook egress device %q priority 0; policy accept;", podInterfaceName)
+				netdevChains = append(netdevChains, renderFirewallChain(egressChain, hook, false, false, firewall.Egress))
+			}
+		}
+	}
+
+	var ruleset strings.Builder
+	// adding the tables first makes deleting them succeed when they were not loaded yet
+	for _, family := range []string{"bridge", "netdev"} {
+		fmt.Fprintf(&ruleset, "add table %s %s\n", family, firewallTable)
+		fmt.Fprintf(&ruleset, "delete table %s %s\n", family, firewallTable)
+	}
+	if len(bridgeChains) > 0 {
+		fmt.Fprintf(&ruleset, "table bridge %s {\n", firewallTable)
+		ruleset.WriteString(renderChain("forward", append([]string{"type filter hook forward priority 0; policy accept;"}, jumps...)))
+		ruleset.WriteString(strings.Join(bridgeChains, ""))
+		ruleset.WriteString("}\n")
+	}
+	if len(netdevChains) > 0 {
+		fmt.Fprintf(&ruleset, "table netdev %s {\n", firewallTable)
+		ruleset.WriteString(strings.Join(netdevChains, ""))
+		ruleset.WriteString("}\n")
+	}
+	return ruleset.String()
+}
+
+// LoadFirewall loads a firewall rendered by RenderFirewall into the current network namespace
+func LoadFirewall(ruleset string) error {
+	return Handler.NftablesLoadRuleset(ruleset)
+}
+
+// FirewallSupportsEgressHook returns whether nft and the kernel support the egress hook of
+// the netdev family, which RenderFirewall needs to filter the egress of macvtap interfaces
+func FirewallSupportsEgressHook() bool {
+	return Handler.NftablesCheckRuleset(egressHookProbe) == nil
+}
+
+// FirewallNeedsEgressHook returns whether one of the firewalls isolates the egress of a macvtap interface
+func FirewallNeedsEgressHook(vmi *v1.VirtualMachineInstance, firewalls map[string]*InterfaceFirewall) bool {
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if firewall, ok := firewalls[iface.Name]; ok && iface.Macvtap != nil && firewall.IsolateEgress {
+			return true
+		}
+	}
+	return false
+}
+
+func renderFirewallChain(name string, hook string, ingress bool, stateful bool, rules []FirewallRule) string {
+	var lines []string
+	if hook != "" {
+		lines = append(lines, hook)
+	}
+	if stateful {
+		lines = append(lines, "ct state established,related accept")
+	}
+	// the guest can always resolve its neighbours and configure its addresses
+	lines = append(lines,
+		"meta protocol arp accept",
+		"icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept",
+	)
+	addr := "daddr"
+	if ingress {
+		addr = "saddr"
+		lines = append(lines, "udp sport 67 udp dport 68 accept", "udp sport 547 udp dport 546 accept")
+	} else {
+		lines = append(lines, "udp sport 68 udp dport 67 accept", "udp sport 546 udp dport 547 accept")
+	}
+	for _, rule := range rules {
+		lines = append(lines, renderFirewallRule(addr, rule)...)
+	}
+	lines = append(lines, "drop")
+	return renderChain(name, lines)
+}
+
+func renderChain(name string, lines []string) string {
+	var chain strings.Builder
+	fmt.Fprintf(&chain, "\tchain %s {\n", name)
+	for _, line := range lines {
+		fmt.Fprintf(&chain, "\t\t%s\n", line)
+	}
+	chain.WriteString("\t}\n")
+	return chain.String()
+}
+
+func renderFirewallRule(addr string, rule FirewallRule) []string {
+	var ports string
+	if rule.Protocol != "" {
+		switch {
+		case rule.Port == 0:
+			ports = "meta l4proto " + rule.Protocol
+		case rule.EndPort == 0 || rule.EndPort == rule.Port:
+			ports = fmt.Sprintf("%s dport %d", rule.Protocol, rule.Port)
+		default:
+			ports = fmt.Sprintf("%s dport %d-%d", rule.Protocol, rule.Port, rule.EndPort)
+		}
+	}
+
+	if len(rule.CIDRs) == 0 {
+		return []string{joinMatches(ports, "accept")}
+	}
+
+	var lines []string
+	for _, family := range []struct {
+		name string
+		ipv6 bool
+	}{{"ip", false}, {"ip6", true}} {
+		cidrs := filterCIDRs(rule.CIDRs, family.ipv6)
+		if len(cidrs) == 0 {
+			continue
+		}
+		peers := fmt.Sprintf("%s %s { %s }", family.name, addr, strings.Join(cidrs, ", "))
+		if except := filterCIDRs(rule.Except, family.ipv6); len(except) > 0 {
+			peers += fmt.Sprintf(" %s %s != { %s }", family.name, addr, strings.Join(except, ", "))
+		}
+		lines = append(lines, joinMatches(peers, ports, "accept"))
+	}
+	return lines
+}
+
+// filterCIDRs returns the sorted unique CIDRs of an IP family
+func filterCIDRs(cidrs []string, ipv6 bool) []string {
+	unique := map[string]bool{}
+	for _, cidr := range cidrs {
+		if netutils.IsIPv6CIDRString(cidr) == ipv6 {
+			unique[cidr] = true
+		}
+	}
+	filtered := make([]string, 0, len(unique))
+	for cidr := range unique {
+		filtered = append(filtered, cidr)
+	}
+	sort.Strings(filtered)
+	return filtered
+}
+
+func joinMatches(matches ...string) string {
+	var nonEmpty []string
+	for _, match := range matches {
+		if match != "" {
+			nonEmpty = append(nonEmpty, match)
+		}
+	}
+	return strings.Join(nonEmpty, " ")
+}
@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package network
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+var _ = Describe("Network policy firewall", func() {
+	newVMI := func() *v1.VirtualMachineInstance {
+		vmi := v1.NewMinimalVMI("testvmi")
+		vmi.Spec.Networks = []v1.Network{
+			*v1.DefaultPodNetwork(),
+			{Name: "blue", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-nad"}}},
+			{Name: "red", NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "red-nad"}}},
+		}
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+			{Name: "default", InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}},
+			{Name: "blue", InterfaceBindingMethod: v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}},
+			{Name: "red", InterfaceBindingMethod: v1.InterfaceBindingMethod{Macvtap: &v1.InterfaceMacvtap{}}},
+		}
+		return vmi
+	}
+
+	It("should only delete the tables without isolated interfaces", func() {
+		Expect(RenderFirewall(newVMI(), map[string]*InterfaceFirewall{}, false)).To(Equal(
+			"add table bridge kubevirt-network-policy\n" +
+				"delete table bridge kubevirt-network-policy\n" +
+				"add table netdev kubevirt-network-policy\n" +
+				"delete table netdev kubevirt-network-policy\n"))
+	})
+
+	It("should filter bridge and macvtap interfaces", func() {
+		firewalls := map[string]*InterfaceFirewall{
+			"default": {IsolateIngress: true},
+			"blue": {
+				IsolateIngress: true,
+				Ingress: []FirewallRule{
+					{CIDRs: []string{"10.10.0.0/16", "fd00:10::/64"}, Except: []string{"10.10.1.0/24"}, Protocol: "tcp", Port: 5432},
+					{CIDRs: []string{"10.20.0.5/32", "10.20.0.4/32", "10.20.0.5/32"}},
+				},
+				IsolateEgress: true,
+				Egress: []FirewallRule{
+					{Protocol: "udp", Port: 5000, EndPort: 5010},
+				},
+			},
+			"red": {
+				IsolateIngress: true,
+				Ingress: []FirewallRule{
+					{Protocol: "sctp"},
+				},
+			},
+		}
+		Expect(RenderFirewall(newVMI(), firewalls, false)).To(Equal(`add table bridge kubevirt-network-policy
+delete table bridge kubevirt-network-policy
+add table netdev kubevirt-network-policy
+delete table netdev kubevirt-network-policy
+table bridge kubevirt-network-policy {
+	chain forward {
+		type filter hook forward priority 0; policy accept;
+		oifname "tap1" jump ingress-net1
+		iifname "tap1" jump egress-net1
+	}
+	chain ingress-net1 {
+		ct state established,related accept
+		meta protocol arp accept
+		icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+		udp sport 67 udp dport 68 accept
+		udp sport 547 udp dport 546 accept
+		ip saddr { 10.10.0.0/16 } ip saddr != { 10.10.1.0/24 } tcp dport 5432 accept
+		ip6 saddr { fd00:10::/64 } tcp dport 5432 accept
+		ip saddr { 10.20.0.4/32, 10.20.0.5/32 } accept
+		drop
+	}
+	chain egress-net1 {
+		ct state established,related accept
+		meta protocol arp accept
+		icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+		udp sport 68 udp dport 67 accept
+		udp sport 546 udp dport 547 accept
+		udp dport 5000-5010 accept
+		drop
+	}
+}
+table netdev kubevirt-network-policy {
+	chain ingress-net2 {
+		type filter hook ingress device "net2" priority 0; policy accept;
+		meta protocol arp accept
+		icmpv6 type { nd-router-solicit, nd-router-advert, nd-neighbor-solicit, nd-neighbor-advert } accept
+		udp sport 67 udp dport 68 accept
+		udp sport 547 udp dport 546 accept
+		meta l4proto sctp accept
+		drop
+	}
+}
+`))
+	})
+
+	It("should only filter the egress of macvtap interfaces with the egress hook", func() {
+		firewalls := map[string]*InterfaceFirewall{"red": {IsolateEgress: true}}
+		Expect(FirewallNeedsEgressHook(newVMI(), firewalls)).To(BeTrue())
+		Expect(FirewallNeedsEgressHook(newVMI(), map[string]*InterfaceFirewall{"blue": {IsolateEgress: true}})).To(BeFalse())
+
+		Expect(RenderFirewall(newVMI(), firewalls, false)).ToNot(ContainSubstring("egress-net2"))
+		Expect(RenderFirewall(newVMI(), firewalls, true)).To(ContainSubstring(`chain egress-net2 {
+		type filter hook egress device "net2" priority 0; policy accept;`))
+	})
+
+	It("should probe the egress hook without loading it", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		mockNetwork := NewMockNetworkHandler(ctrl)
+		Handler = mockNetwork
+
+		mockNetwork.EXPECT().NftablesCheckRuleset(egressHookProbe).Return(nil)
+		Expect(FirewallSupportsEgressHook()).To(BeTrue())
+		mockNetwork.EXPECT().NftablesCheckRuleset(egressHookProbe).Return(fmt.Errorf("syntax error"))
+		Expect(FirewallSupportsEgressHook()).To(BeFalse())
+	})
+
+	It("should load the firewall in a single transaction", func() {
+		ctrl := gomock.NewController(GinkgoT())
+		defer ctrl.Finish()
+		mockNetwork := NewMockNetworkHandler(ctrl)
+		Handler = mockNetwork
+
+		ruleset := RenderFirewall(newVMI(), map[string]*InterfaceFirewall{"blue": {IsolateEgress: true}}, false)
+		mockNetwork.EXPECT().NftablesLoadRuleset(ruleset).Return(nil)
+		Expect(LoadFirewall(ruleset)).To(Succeed())
+	})
+})
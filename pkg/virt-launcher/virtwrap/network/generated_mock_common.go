@@ -213,8 +213,8 @@ func (_mr *_MockNetworkHandlerRecorder) LinkSetMaster(arg0, arg1 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "LinkSetMaster", arg0, arg1)
 }
 
-func (_m *MockNetworkHandler) StartDHCP(nic *VIF, serverAddr net.IP, bridgeInterfaceName string, dhcpOptions *v1.DHCPOptions, filterByMAC bool) error {
-	ret := _m.ctrl.Call(_m, "StartDHCP", nic, serverAddr, bridgeInterfaceName, dhcpOptions, filterByMAC)
+func (_m *MockNetworkHandler) StartDHCP(nic *VIF, serverAddr net.IP, bridgeInterfaceName string, iface *v1.Interface, filterByMAC bool) error {
+	ret := _m.ctrl.Call(_m, "StartDHCP", nic, serverAddr, bridgeInterfaceName, iface, filterByMAC)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
@@ -335,6 +335,26 @@ func (_mr *_MockNetworkHandlerRecorder) NftablesLoad(arg0 interface{}) *gomock.C
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesLoad", arg0)
 }
 
+func (_m *MockNetworkHandler) NftablesLoadRuleset(ruleset string) error {
+	ret := _m.ctrl.Call(_m, "NftablesLoadRuleset", ruleset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockNetworkHandlerRecorder) NftablesLoadRuleset(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesLoadRuleset", arg0)
+}
+
+func (_m *MockNetworkHandler) NftablesCheckRuleset(ruleset string) error {
+	ret := _m.ctrl.Call(_m, "NftablesCheckRuleset", ruleset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockNetworkHandlerRecorder) NftablesCheckRuleset(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesCheckRuleset", arg0)
+}
+
 func (_m *MockNetworkHandler) GetNFTIPString(proto iptables.Protocol) string {
 	ret := _m.ctrl.Call(_m, "GetNFTIPString", proto)
 	ret0, _ := ret[0].(string)
@@ -374,3 +394,13 @@ func (_m *MockNetworkHandler) DisableTXOffloadChecksum(ifaceName string) error {
 func (_mr *_MockNetworkHandlerRecorder) DisableTXOffloadChecksum(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "DisableTXOffloadChecksum", arg0)
 }
+
+func (_m *MockNetworkHandler) AnnounceAddress(ifaceName string, mac net.HardwareAddr, ip net.IP) error {
+	ret := _m.ctrl.Call(_m, "AnnounceAddress", ifaceName, mac, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockNetworkHandlerRecorder) AnnounceAddress(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AnnounceAddress", arg0, arg1, arg2)
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package network
+
+import (
+	"net"
+	"sort"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+// DHCPLeases holds the addresses the DHCP servers of the launcher leased to the guest
+var DHCPLeases = NewDHCPLeaseStore()
+
+// DHCPLeaseStore stores the leases acknowledged by the DHCP servers, and notifies
+// whenever a new address is leased
+type DHCPLeaseStore struct {
+	lock   sync.Mutex
+	leases map[string]api.DHCPLease
+	// Updated receives a notification when an address is leased for the first time
+	Updated chan struct{}
+}
+
+func NewDHCPLeaseStore() *DHCPLeaseStore {
+	return &DHCPLeaseStore{
+		leases:  map[string]api.DHCPLease{},
+		Updated: make(chan struct{}, 1),
+	}
+}
+
+// Acknowledge records that the address was leased on the interface of the VMI
+func (s *DHCPLeaseStore) Acknowledge(ifaceName string, ip net.IP) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := ifaceName + "/" + ip.String()
+	_, exists := s.leases[key]
+	s.leases[key] = api.DHCPLease{Name: ifaceName, IP: ip.String(), Time: metav1.Now()}
+	if exists {
+		return
+	}
+	// a pending notification already covers this lease
+	select {
+	case s.Updated <- struct{}{}:
+	default:
+	}
+}
+
+// List returns the leases ordered by interface and address
+func (s *DHCPLeaseStore) List() []api.DHCPLease {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	leases := make([]api.DHCPLease, 0, len(s.leases))
+	for _, lease := range s.leases {
+		leases = append(leases, lease)
+	}
+	sort.Slice(leases, func(i, j int) bool {
+		if leases[i].Name != leases[j].Name {
+			return leases[i].Name < leases[j].Name
+		}
+		return leases[i].IP < leases[j].IP
+	})
+	return leases
+}
@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package network
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DHCP leases", func() {
+	var store *DHCPLeaseStore
+
+	BeforeEach(func() {
+		store = NewDHCPLeaseStore()
+	})
+
+	It("should list the leases ordered by interface and address", func() {
+		store.Acknowledge("red", net.ParseIP("10.0.0.5"))
+		store.Acknowledge("blue", net.ParseIP("fd10::5"))
+		store.Acknowledge("blue", net.ParseIP("10.1.0.5"))
+
+		leases := store.List()
+		Expect(leases).To(HaveLen(3))
+		Expect(leases[0].Name).To(Equal("blue"))
+		Expect(leases[0].IP).To(Equal("10.1.0.5"))
+		Expect(leases[1].Name).To(Equal("blue"))
+		Expect(leases[1].IP).To(Equal("fd10::5"))
+		Expect(leases[2].Name).To(Equal("red"))
+		Expect(leases[2].IP).To(Equal("10.0.0.5"))
+	})
+
+	It("should only notify about new leases", func() {
+		store.Acknowledge("default", net.ParseIP("10.0.2.2"))
+		Expect(store.Updated).To(Receive())
+
+		store.Acknowledge("default", net.ParseIP("10.0.2.2"))
+		Expect(store.Updated).ToNot(Receive())
+		Expect(store.List()).To(HaveLen(1))
+	})
+
+	It("should not block when a notification is pending", func() {
+		store.Acknowledge("default", net.ParseIP("10.0.2.2"))
+		store.Acknowledge("default", net.ParseIP("fd10:0:2::2"))
+		Expect(store.Updated).To(Receive())
+		Expect(store.Updated).ToNot(Receive())
+		Expect(store.List()).To(HaveLen(2))
+	})
+})
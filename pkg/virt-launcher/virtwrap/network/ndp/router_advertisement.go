@@ -0,0 +1,289 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package ndp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/net/ipv6"
+
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	icmpv6RouterAdvertisement = 134
+
+	optionSourceLinkLayerAddress = 1
+	optionPrefixInformation      = 3
+	optionMTU                    = 5
+	optionRouteInformation       = 24
+	optionRecursiveDNSServer     = 25
+	optionDNSSearchList          = 31
+
+	managedAddressConfigurationFlag = 0x80
+	otherConfigurationFlag          = 0x40
+	onLinkFlag                      = 0x80
+	autonomousAddressConfigFlag     = 0x40
+
+	curHopLimit      = 64
+	infiniteLifetime = 0xffffffff
+
+	// unsolicited advertisements are sent well within the lifetime of the router
+	advertisementInterval    = 3 * time.Minute
+	routerLifetime           = 30 * time.Minute
+	minDelayBetweenAdverts   = 3 * time.Second
+	routerSolicitationBuffer = 1500
+)
+
+var ipv6AllRouters = net.ParseIP("ff02::2")
+
+// RouterAdvertisement is the configuration advertised to the guest
+type RouterAdvertisement struct {
+	// Prefix is the on-link IPv6 network of the guest
+	Prefix *net.IPNet
+	// Autonomous lets the guest configure its address from the prefix (SLAAC)
+	Autonomous bool
+	// Managed tells the guest to obtain its address with DHCPv6
+	Managed bool
+	MTU     uint32
+	// RouterMAC is the link-layer address of the advertising interface
+	RouterMAC     net.HardwareAddr
+	DNSServers    []net.IP
+	SearchDomains []string
+	// Routes are reachable through the advertising router, in addition to the default route
+	Routes []*net.IPNet
+}
+
+// SingleClientRouterAdvertisementServer announces the router to the guest connected to the interface, periodically
+// and in reply to router solicitations, so that the guest gets its default route and, when enabled, its address.
+func SingleClientRouterAdvertisementServer(serverIfaceName string, ra *RouterAdvertisement) error {
+	log.Log.Info("Starting SingleClientRouterAdvertisementServer")
+
+	iface, err := net.InterfaceByName(serverIfaceName)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertisement server, couldn't get the server interface: %v", err)
+	}
+
+	advertisement := *ra
+	advertisement.RouterMAC = iface.HardwareAddr
+	msg, err := advertisement.Marshal()
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertisement server: %v", err)
+	}
+
+	conn, err := newConnection(iface)
+	if err != nil {
+		return fmt.Errorf("couldn't create router advertisement server: %v", err)
+	}
+	defer conn.Close()
+
+	allNodes := &net.IPAddr{IP: net.IPv6linklocalallnodes, Zone: iface.Name}
+	var lastAdvertisement time.Time
+	advertise := func() {
+		// rate limit the advertisements sent in reply to solicitations
+		if time.Since(lastAdvertisement) < minDelayBetweenAdverts {
+			return
+		}
+		lastAdvertisement = time.Now()
+		if _, err := conn.WriteTo(msg, &ipv6.ControlMessage{IfIndex: iface.Index, HopLimit: 255}, allNodes); err != nil {
+			log.Log.V(4).Reason(err).Error("failed to send router advertisement")
+		}
+	}
+
+	solicitations := make(chan struct{})
+	go func() {
+		defer close(solicitations)
+		buf := make([]byte, routerSolicitationBuffer)
+		for {
+			n, cm, _, err := conn.ReadFrom(buf)
+			if err != nil {
+				log.Log.Reason(err).Error("failed to read router solicitation")
+				return
+			}
+			if n > 0 && cm != nil && cm.IfIndex == iface.Index && ipv6.ICMPType(buf[0]) == ipv6.ICMPTypeRouterSolicitation {
+				solicitations <- struct{}{}
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(advertisementInterval)
+	defer ticker.Stop()
+	advertise()
+	for {
+		select {
+		case _, ok := <-solicitations:
+			if !ok {
+				return fmt.Errorf("router advertisement server stopped receiving router solicitations")
+			}
+			advertise()
+		case <-ticker.C:
+			advertise()
+		}
+	}
+}
+
+func newConnection(iface *net.Interface) (*ipv6.PacketConn, error) {
+	c, err := net.ListenPacket("ip6:ipv6-icmp", "::")
+	if err != nil {
+		return nil, err
+	}
+	conn := ipv6.NewPacketConn(c)
+
+	// neighbor discovery messages with another hop limit are dropped by the receiver
+	if err := conn.SetMulticastHopLimit(255); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := conn.SetControlMessage(ipv6.FlagInterface, true); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	var filter ipv6.ICMPFilter
+	filter.SetAll(true)
+	filter.Accept(ipv6.ICMPTypeRouterSolicitation)
+	if err := conn.SetICMPFilter(&filter); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := conn.JoinGroup(iface, &net.IPAddr{IP: ipv6AllRouters}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Marshal returns the ICMPv6 router advertisement message (RFC 4861, section 4.2). The checksum is
+// left empty, the kernel computes it when the message is sent through an ICMPv6 socket.
+func (ra *RouterAdvertisement) Marshal() ([]byte, error) {
+	msg := make([]byte, 16)
+	msg[0] = icmpv6RouterAdvertisement
+	msg[4] = curHopLimit
+	if ra.Managed {
+		msg[5] |= managedAddressConfigurationFlag | otherConfigurationFlag
+	}
+	binary.BigEndian.PutUint16(msg[6:8], uint16(routerLifetime.Seconds()))
+
+	if ra.RouterMAC != nil {
+		msg = append(msg, newOption(optionSourceLinkLayerAddress, ra.RouterMAC)...)
+	}
+	if ra.MTU > 0 {
+		mtu := make([]byte, 6)
+		binary.BigEndian.PutUint32(mtu[2:6], ra.MTU)
+		msg = append(msg, newOption(optionMTU, mtu)...)
+	}
+	if ra.Prefix != nil {
+		prefix, err := marshalPrefixInformation(ra.Prefix, ra.Autonomous)
+		if err != nil {
+			return nil, err
+		}
+		msg = append(msg, prefix...)
+	}
+	for _, route := range ra.Routes {
+		routeInformation, err := marshalRouteInformation(route)
+		if err != nil {
+			return nil, err
+		}
+		msg = append(msg, routeInformation...)
+	}
+	if len(ra.DNSServers) > 0 {
+		servers := make([]byte, 6, 6+16*len(ra.DNSServers))
+		binary.BigEndian.PutUint32(servers[2:6], uint32(routerLifetime.Seconds()))
+		for _, server := range ra.DNSServers {
+			if server.To4() != nil || server.To16() == nil {
+				return nil, fmt.Errorf("DNS server %s is not an IPv6 address", server)
+			}
+			servers = append(servers, server.To16()...)
+		}
+		msg = append(msg, newOption(optionRecursiveDNSServer, servers)...)
+	}
+	if len(ra.SearchDomains) > 0 {
+		domains := make([]byte, 6)
+		binary.BigEndian.PutUint32(domains[2:6], uint32(routerLifetime.Seconds()))
+		for _, domain := range ra.SearchDomains {
+			domains = append(domains, encodeDomainName(domain)...)
+		}
+		msg = append(msg, newOption(optionDNSSearchList, domains)...)
+	}
+	return msg, nil
+}
+
+func marshalPrefixInformation(prefix *net.IPNet, autonomous bool) ([]byte, error) {
+	ones, bits := prefix.Mask.Size()
+	if bits != 8*net.IPv6len || prefix.IP.To4() != nil {
+		return nil, fmt.Errorf("prefix %s is not an IPv6 network", prefix)
+	}
+	if autonomous && ones != 64 {
+		return nil, fmt.Errorf("prefix %s can not be used for stateless address autoconfiguration, its length is not 64", prefix)
+	}
+	data := make([]byte, 30)
+	data[0] = byte(ones)
+	data[1] = onLinkFlag
+	if autonomous {
+		data[1] |= autonomousAddressConfigFlag
+	}
+	binary.BigEndian.PutUint32(data[2:6], infiniteLifetime)
+	binary.BigEndian.PutUint32(data[6:10], infiniteLifetime)
+	copy(data[14:30], prefix.IP.Mask(prefix.Mask).To16())
+	return newOption(optionPrefixInformation, data), nil
+}
+
+// marshalRouteInformation returns the route information option (RFC 4191, section 2.3), which only
+// carries as many octets of the prefix as its length requires
+func marshalRouteInformation(route *net.IPNet) ([]byte, error) {
+	ones, bits := route.Mask.Size()
+	if bits != 8*net.IPv6len || route.IP.To4() != nil {
+		return nil, fmt.Errorf("route %s is not an IPv6 network", route)
+	}
+	prefixLen := 0
+	if ones > 64 {
+		prefixLen = 16
+	} else if ones > 0 {
+		prefixLen = 8
+	}
+	data := make([]byte, 6, 6+prefixLen)
+	data[0] = byte(ones)
+	binary.BigEndian.PutUint32(data[2:6], uint32(routerLifetime.Seconds()))
+	data = append(data, route.IP.Mask(route.Mask).To16()[:prefixLen]...)
+	return newOption(optionRouteInformation, data), nil
+}
+
+// newOption prepends the type and the length to the data of an option and pads it to a multiple of 8 octets
+func newOption(optionType byte, data []byte) []byte {
+	length := (len(data) + 2 + 7) / 8
+	option := make([]byte, 8*length)
+	option[0] = optionType
+	option[1] = byte(length)
+	copy(option[2:], data)
+	return option
+}
+
+// encodeDomainName encodes the domain as a sequence of labels prefixed with their length (RFC 1035, section 3.1)
+func encodeDomainName(domain string) []byte {
+	var encoded []byte
+	for _, label := range strings.Split(strings.TrimSuffix(domain, "."), ".") {
+		encoded = append(encoded, byte(len(label)))
+		encoded = append(encoded, label...)
+	}
+	return append(encoded, 0)
+}
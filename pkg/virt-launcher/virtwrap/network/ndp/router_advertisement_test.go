@@ -0,0 +1,119 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package ndp
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router advertisement", func() {
+	mustParseCIDR := func(cidr string) *net.IPNet {
+		_, network, err := net.ParseCIDR(cidr)
+		Expect(err).ToNot(HaveOccurred())
+		return network
+	}
+
+	It("should advertise the default route and the managed configuration", func() {
+		ra := &RouterAdvertisement{Managed: true}
+		msg, err := ra.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg).To(Equal([]byte{
+			134, 0, 0, 0,
+			64, 0xc0, 0x07, 0x08,
+			0, 0, 0, 0,
+			0, 0, 0, 0,
+		}))
+	})
+
+	It("should contain the link-layer address and the MTU", func() {
+		mac, _ := net.ParseMAC("02:00:00:00:00:01")
+		ra := &RouterAdvertisement{RouterMAC: mac, MTU: 1450}
+		msg, err := ra.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg[5]).To(BeZero())
+		Expect(msg[16:]).To(Equal([]byte{
+			1, 1, 0x02, 0, 0, 0, 0, 0x01,
+			5, 1, 0, 0, 0, 0, 0x05, 0xaa,
+		}))
+	})
+
+	It("should advertise the prefix as on-link", func() {
+		ra := &RouterAdvertisement{Prefix: mustParseCIDR("fd10:0:2::/120")}
+		msg, err := ra.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg[16:]).To(Equal([]byte{
+			3, 4, 120, 0x80,
+			0xff, 0xff, 0xff, 0xff,
+			0xff, 0xff, 0xff, 0xff,
+			0, 0, 0, 0,
+			0xfd, 0x10, 0, 0, 0, 0x02, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+		}))
+	})
+
+	It("should advertise a prefix of length 64 for stateless address autoconfiguration", func() {
+		ra := &RouterAdvertisement{Prefix: mustParseCIDR("fd10:0:2::/64"), Autonomous: true}
+		msg, err := ra.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg[16:20]).To(Equal([]byte{3, 4, 64, 0xc0}))
+	})
+
+	It("should refuse stateless address autoconfiguration with another prefix length", func() {
+		ra := &RouterAdvertisement{Prefix: mustParseCIDR("fd10:0:2::/120"), Autonomous: true}
+		_, err := ra.Marshal()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should only carry the octets of the routes their length requires", func() {
+		ra := &RouterAdvertisement{Routes: []*net.IPNet{mustParseCIDR("::/0"), mustParseCIDR("fd20::/48"), mustParseCIDR("fd30::1:0/112")}}
+		msg, err := ra.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg[16:]).To(Equal([]byte{
+			24, 1, 0, 0, 0, 0, 0x07, 0x08,
+			24, 2, 48, 0, 0, 0, 0x07, 0x08,
+			0xfd, 0x20, 0, 0, 0, 0, 0, 0,
+			24, 3, 112, 0, 0, 0, 0x07, 0x08,
+			0xfd, 0x30, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x01, 0, 0,
+		}))
+	})
+
+	It("should contain the DNS servers and the search domains", func() {
+		ra := &RouterAdvertisement{
+			DNSServers:    []net.IP{net.ParseIP("fd00::a")},
+			SearchDomains: []string{"example.com"},
+		}
+		msg, err := ra.Marshal()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(msg[16:]).To(Equal([]byte{
+			25, 3, 0, 0, 0, 0, 0x07, 0x08,
+			0xfd, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x0a,
+			31, 3, 0, 0, 0, 0, 0x07, 0x08,
+			7, 'e', 'x', 'a', 'm', 'p', 'l', 'e', 3, 'c', 'o', 'm', 0, 0, 0, 0,
+		}))
+	})
+
+	It("should refuse IPv4 DNS servers", func() {
+		ra := &RouterAdvertisement{DNSServers: []net.IP{net.ParseIP("10.96.0.10")}}
+		_, err := ra.Marshal()
+		Expect(err).To(HaveOccurred())
+	})
+})
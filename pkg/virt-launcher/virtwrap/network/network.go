@@ -27,6 +27,9 @@ package network
 
 import (
 	"fmt"
+	"net"
+
+	netutils "k8s.io/utils/net"
 
 	v1 "kubevirt.io/client-go/api/v1"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -84,7 +87,37 @@ func invokePodNICFactory(networks map[string]*v1.Network, ifaceName string) (pod
 	return podNICFactory(network)
 }
 
-func getPodInterfaceName(networks map[string]*v1.Network, cniNetworks map[string]int, ifaceName string) string {
+func getHotplugInterfaceStatus(vmi *v1.VirtualMachineInstance, ifaceName string) *v1.HotplugInterfaceStatus {
+	for i, status := range vmi.Status.HotplugInterfaces {
+		if status.Name == ifaceName {
+			return &vmi.Status.HotplugInterfaces[i]
+		}
+	}
+	return nil
+}
+
+// isInterfacePluggable returns false for hotplugged interfaces whose network is not attached to the pod
+func isInterfacePluggable(vmi *v1.VirtualMachineInstance, ifaceName string) bool {
+	status := getHotplugInterfaceStatus(vmi, ifaceName)
+	return status == nil || status.Phase == v1.InterfaceHotplugAttachedToPod || status.Phase == v1.InterfaceHotplugReady
+}
+
+// HasHotplugInterfacesToPlug returns true if the network of a hotplugged interface
+// is attached to the pod but the interface is not yet plugged into the domain.
+func HasHotplugInterfacesToPlug(vmi *v1.VirtualMachineInstance) bool {
+	for _, status := range vmi.Status.HotplugInterfaces {
+		if status.Phase == v1.InterfaceHotplugAttachedToPod {
+			return true
+		}
+	}
+	return false
+}
+
+func getPodInterfaceName(vmi *v1.VirtualMachineInstance, networks map[string]*v1.Network, cniNetworks map[string]int, ifaceName string) string {
+	if status := getHotplugInterfaceStatus(vmi, ifaceName); status != nil {
+		// hotplugged pod interfaces are named by the controller
+		return status.PodInterfaceName
+	}
 	if networks[ifaceName].Multus != nil && !networks[ifaceName].Multus.Default {
 		// multus pod interfaces named netX
 		return fmt.Sprintf("net%d", cniNetworks[ifaceName])
@@ -100,11 +133,14 @@ func SetupPodNetworkPhase1(vmi *v1.VirtualMachineInstance, pid int) error {
 	}
 	networks, cniNetworks := getNetworksAndCniNetworks(vmi)
 	for i, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if !isInterfacePluggable(vmi, iface.Name) {
+			continue
+		}
 		podnic, err := invokePodNICFactory(networks, iface.Name)
 		if err != nil {
 			return err
 		}
-		podInterfaceName := getPodInterfaceName(networks, cniNetworks, iface.Name)
+		podInterfaceName := getPodInterfaceName(vmi, networks, cniNetworks, iface.Name)
 		err = podNIC.PlugPhase1(podnic, vmi, &vmi.Spec.Domain.Devices.Interfaces[i], networks[iface.Name], podInterfaceName, pid)
 		if err != nil {
 			return err
@@ -116,11 +152,14 @@ func SetupPodNetworkPhase1(vmi *v1.VirtualMachineInstance, pid int) error {
 func SetupPodNetworkPhase2(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 	networks, cniNetworks := getNetworksAndCniNetworks(vmi)
 	for i, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if !isInterfacePluggable(vmi, iface.Name) {
+			continue
+		}
 		podnic, err := invokePodNICFactory(networks, iface.Name)
 		if err != nil {
 			return err
 		}
-		podInterfaceName := getPodInterfaceName(networks, cniNetworks, iface.Name)
+		podInterfaceName := getPodInterfaceName(vmi, networks, cniNetworks, iface.Name)
 		err = podNIC.PlugPhase2(podnic, vmi, &vmi.Spec.Domain.Devices.Interfaces[i], networks[iface.Name], domain, podInterfaceName)
 		if err != nil {
 			return err
@@ -129,6 +168,112 @@ func SetupPodNetworkPhase2(vmi *v1.VirtualMachineInstance, domain *api.Domain) e
 	return nil
 }
 
+// AnnounceMigratedInterfaces sends gratuitous ARPs and unsolicited neighbor advertisements
+// for the guest addresses of bridge bound interfaces, so that the network learns the new
+// location of the guest once it was migrated into this pod.
+func AnnounceMigratedInterfaces(vmi *v1.VirtualMachineInstance) error {
+	initHandler()
+	networks, cniNetworks := getNetworksAndCniNetworks(vmi)
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Bridge == nil || !isInterfacePluggable(vmi, iface.Name) {
+			continue
+		}
+		ifaceStatus := getInterfaceStatus(vmi, iface.Name)
+		if ifaceStatus == nil || ifaceStatus.MAC == "" {
+			continue
+		}
+		mac, err := net.ParseMAC(ifaceStatus.MAC)
+		if err != nil {
+			return err
+		}
+		bridgeInterfaceName := fmt.Sprintf("k6t-%s", getPodInterfaceName(vmi, networks, cniNetworks, iface.Name))
+		for _, ip := range getInterfaceStatusIPs(ifaceStatus) {
+			if err := Handler.AnnounceAddress(bridgeInterfaceName, mac, ip); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// GetChangedMigrationAddresses describes every address a guest holds on a bridge bound interface
+// which the pod of the migration target did not receive. loadPodInterface returns the cached
+// pod interface of the target pod.
+func GetChangedMigrationAddresses(vmi *v1.VirtualMachineInstance, loadPodInterface func(ifaceName string) (*PodCacheInterface, error)) ([]string, error) {
+	changes := []string{}
+	for _, iface := range vmi.Spec.Domain.Devices.Interfaces {
+		if iface.Bridge == nil {
+			continue
+		}
+		ifaceStatus := getInterfaceStatus(vmi, iface.Name)
+		if ifaceStatus == nil {
+			continue
+		}
+		podIface, err := loadPodInterface(iface.Name)
+		if err != nil {
+			return nil, err
+		}
+		if podIface == nil || podIface.PodIP == "" {
+			// the pod interface has no IPAM, the guest addresses are not managed by the CNI
+			continue
+		}
+		podIPs := podIface.PodIPs
+		if len(podIPs) == 0 {
+			podIPs = []string{podIface.PodIP}
+		}
+		for _, ip := range getInterfaceStatusIPs(ifaceStatus) {
+			if ip.IsLinkLocalUnicast() {
+				continue
+			}
+			if podIP := getChangedPodIP(ip, podIPs); podIP != "" {
+				changes = append(changes, fmt.Sprintf("interface %s: %s is replaced by %s", iface.Name, ip, podIP))
+			}
+		}
+	}
+	return changes, nil
+}
+
+// getChangedPodIP returns the pod IP of the same family as the guest IP when the pod did not
+// receive the guest IP, or an empty string when it did or the pod has no IP of that family
+func getChangedPodIP(guestIP net.IP, podIPs []string) string {
+	changedPodIP := ""
+	for _, podIP := range podIPs {
+		if netutils.IsIPv6String(podIP) != netutils.IsIPv6(guestIP) {
+			continue
+		}
+		if guestIP.Equal(net.ParseIP(podIP)) {
+			return ""
+		}
+		if changedPodIP == "" {
+			changedPodIP = podIP
+		}
+	}
+	return changedPodIP
+}
+
+func getInterfaceStatus(vmi *v1.VirtualMachineInstance, ifaceName string) *v1.VirtualMachineInstanceNetworkInterface {
+	for i, ifaceStatus := range vmi.Status.Interfaces {
+		if ifaceStatus.Name == ifaceName {
+			return &vmi.Status.Interfaces[i]
+		}
+	}
+	return nil
+}
+
+func getInterfaceStatusIPs(ifaceStatus *v1.VirtualMachineInstanceNetworkInterface) []net.IP {
+	ips := ifaceStatus.IPs
+	if len(ips) == 0 && ifaceStatus.IP != "" {
+		ips = []string{ifaceStatus.IP}
+	}
+	parsedIPs := []net.IP{}
+	for _, ip := range ips {
+		if parsedIP := net.ParseIP(ip); parsedIP != nil {
+			parsedIPs = append(parsedIPs, parsedIP)
+		}
+	}
+	return parsedIPs
+}
+
 func newpodNIC(network *v1.Network) (podNIC, error) {
 	if network.Pod != nil || network.Multus != nil {
 		return new(podNICImpl), nil
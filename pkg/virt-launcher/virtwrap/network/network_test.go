@@ -20,6 +20,7 @@
 package network
 
 import (
+	"net"
 	"os"
 
 	"github.com/golang/mock/gomock"
@@ -137,5 +138,96 @@ var _ = Describe("Network", func() {
 			err := SetupPodNetworkPhase1(vm, pid)
 			Expect(err).To(BeNil())
 		})
+		It("should configure hotplugged interfaces once their network is attached to the pod", func() {
+			podNICFactory = func(network *v1.Network) (podNIC, error) {
+				return mockpodNIC, nil
+			}
+
+			vm := newVMIBridgeInterface("testnamespace", "testVmName")
+			vm.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				v1.Interface{
+					Name: "attached",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{
+						Bridge: &v1.InterfaceBridge{},
+					},
+				},
+				v1.Interface{
+					Name: "pending",
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{
+						Bridge: &v1.InterfaceBridge{},
+					},
+				},
+			}
+			attachedNet := &v1.Network{
+				Name: "attached",
+				NetworkSource: v1.NetworkSource{
+					Multus: &v1.MultusNetwork{NetworkName: "attached"},
+				},
+			}
+			pendingNet := &v1.Network{
+				Name: "pending",
+				NetworkSource: v1.NetworkSource{
+					Multus: &v1.MultusNetwork{NetworkName: "pending"},
+				},
+			}
+			vm.Spec.Networks = []v1.Network{*attachedNet, *pendingNet}
+			vm.Status.HotplugInterfaces = []v1.HotplugInterfaceStatus{
+				{Name: "attached", PodInterfaceName: "net3", Phase: v1.InterfaceHotplugAttachedToPod},
+				{Name: "pending", PodInterfaceName: "net4", Phase: v1.InterfaceHotplugPending},
+			}
+			Expect(HasHotplugInterfacesToPlug(vm)).To(BeTrue())
+
+			mockpodNIC.EXPECT().PlugPhase1(vm, &vm.Spec.Domain.Devices.Interfaces[0], attachedNet, "net3", pid)
+			err := SetupPodNetworkPhase1(vm, pid)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("migration", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = newVMIBridgeInterface("testnamespace", "testVmName")
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{}
+			vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+				{Name: "default", MAC: "de:ad:00:00:be:af", IP: "10.244.1.5", IPs: []string{"10.244.1.5", "fd10:244::5", "fe80::1"}},
+			}
+		})
+
+		It("should announce the guest addresses on the bridge of the target pod", func() {
+			mockNetwork := NewMockNetworkHandler(ctrl)
+			Handler = mockNetwork
+			defer func() { Handler = nil }()
+
+			mac, _ := net.ParseMAC("de:ad:00:00:be:af")
+			for _, ip := range []string{"10.244.1.5", "fd10:244::5", "fe80::1"} {
+				mockNetwork.EXPECT().AnnounceAddress("k6t-eth0", mac, net.ParseIP(ip)).Return(nil)
+			}
+			Expect(AnnounceMigratedInterfaces(vmi)).To(Succeed())
+		})
+
+		It("should not report addresses the target pod received", func() {
+			changes, err := GetChangedMigrationAddresses(vmi, func(string) (*PodCacheInterface, error) {
+				return &PodCacheInterface{PodIP: "10.244.1.5", PodIPs: []string{"10.244.1.5", "fd10:244::5"}}, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+
+		It("should report addresses the target pod did not receive", func() {
+			changes, err := GetChangedMigrationAddresses(vmi, func(string) (*PodCacheInterface, error) {
+				return &PodCacheInterface{PodIP: "10.244.2.7", PodIPs: []string{"10.244.2.7"}}, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(ConsistOf("interface default: 10.244.1.5 is replaced by 10.244.2.7"))
+		})
+
+		It("should ignore pod interfaces without addresses", func() {
+			changes, err := GetChangedMigrationAddresses(vmi, func(string) (*PodCacheInterface, error) {
+				return &PodCacheInterface{}, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
 	})
 })
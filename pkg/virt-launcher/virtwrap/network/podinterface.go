@@ -166,15 +166,14 @@ func sortIPsBasedOnPrimaryIP(ipv4, ipv6 string) ([]string, error) {
 func (l *podNICImpl) PlugPhase1(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, podInterfaceName string, pid int) error {
 	initHandler()
 
-	// There is nothing to plug for SR-IOV devices
-	if iface.SRIOV != nil {
-		return nil
-	}
-
 	bindMechanism, err := getPhase1Binding(vmi, iface, network, podInterfaceName)
 	if err != nil {
 		return err
 	}
+	// There is nothing to plug for bindings without a mechanism, e.g. SR-IOV
+	if bindMechanism == nil {
+		return nil
+	}
 
 	pidStr := fmt.Sprintf("%d", pid)
 	isExist, err := bindMechanism.loadCachedInterface(pidStr, iface.Name)
@@ -182,14 +181,12 @@ func (l *podNICImpl) PlugPhase1(vmi *v1.VirtualMachineInstance, iface *v1.Interf
 		return err
 	}
 
-	// ignore the bindMechanism.loadCachedInterface for slirp and set the Pod interface cache
-	if !isExist || iface.Slirp != nil {
+	if !isExist {
 		err := setPodInterfaceCache(iface, podInterfaceName, string(vmi.ObjectMeta.UID))
 		if err != nil {
 			return err
 		}
-	}
-	if !isExist {
+
 		err = bindMechanism.discoverPodNetworkInterface()
 		if err != nil {
 			return err
@@ -245,15 +242,14 @@ func (l *podNICImpl) PlugPhase2(vmi *v1.VirtualMachineInstance, iface *v1.Interf
 	precond.MustNotBeNil(domain)
 	initHandler()
 
-	// There is nothing to plug for SR-IOV devices
-	if iface.SRIOV != nil {
-		return nil
-	}
-
 	bindMechanism, err := getPhase2Binding(vmi, iface, network, domain, podInterfaceName)
 	if err != nil {
 		return err
 	}
+	// There is nothing to plug for bindings without a mechanism, e.g. SR-IOV
+	if bindMechanism == nil {
+		return nil
+	}
 
 	pid := "self"
 
@@ -296,74 +292,7 @@ func getPhase1Binding(vmi *v1.VirtualMachineInstance, iface *v1.Interface, netwo
 }
 
 func getPhase2Binding(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
-	retrieveMacAddress := func(iface *v1.Interface) (*net.HardwareAddr, error) {
-		if iface.MacAddress != "" {
-			macAddress, err := net.ParseMAC(iface.MacAddress)
-			if err != nil {
-				return nil, err
-			}
-			return &macAddress, nil
-		}
-		return nil, nil
-	}
-
-	if iface.Bridge != nil {
-		mac, err := retrieveMacAddress(iface)
-		if err != nil {
-			return nil, err
-		}
-		vif := &VIF{Name: podInterfaceName}
-		if mac != nil {
-			vif.MAC = *mac
-		}
-		return &BridgeBindMechanism{iface: iface,
-			virtIface:           &api.Interface{},
-			vmi:                 vmi,
-			vif:                 vif,
-			domain:              domain,
-			podInterfaceName:    podInterfaceName,
-			bridgeInterfaceName: fmt.Sprintf("k6t-%s", podInterfaceName)}, nil
-	}
-	if iface.Masquerade != nil {
-		mac, err := retrieveMacAddress(iface)
-		if err != nil {
-			return nil, err
-		}
-		vif := &VIF{Name: podInterfaceName}
-		if mac != nil {
-			vif.MAC = *mac
-		}
-		return &MasqueradeBindMechanism{iface: iface,
-			virtIface:           &api.Interface{},
-			vmi:                 vmi,
-			vif:                 vif,
-			domain:              domain,
-			podInterfaceName:    podInterfaceName,
-			vmNetworkCIDR:       network.Pod.VMNetworkCIDR,
-			vmIpv6NetworkCIDR:   "", // TODO add ipv6 cidr to PodNetwork schema
-			bridgeInterfaceName: fmt.Sprintf("k6t-%s", podInterfaceName)}, nil
-	}
-	if iface.Slirp != nil {
-		return &SlirpBindMechanism{vmi: vmi, iface: iface, domain: domain}, nil
-	}
-	if iface.Macvtap != nil {
-		mac, err := retrieveMacAddress(iface)
-		if err != nil {
-			return nil, err
-		}
-		virtIface := &api.Interface{}
-		if mac != nil {
-			virtIface.MAC = &api.MAC{MAC: mac.String()}
-		}
-		return &MacvtapBindMechanism{
-			vmi:              vmi,
-			iface:            iface,
-			virtIface:        virtIface,
-			domain:           domain,
-			podInterfaceName: podInterfaceName,
-		}, nil
-	}
-	return nil, fmt.Errorf("Not implemented")
+	return newBindMechanism(vmi, iface, network, domain, podInterfaceName)
 }
 
 type BridgeBindMechanism struct {
@@ -436,19 +365,51 @@ func (b *BridgeBindMechanism) getFakeBridgeIP() (string, error) {
 }
 
 func (b *BridgeBindMechanism) startDHCP(vmi *v1.VirtualMachineInstance) error {
-	if !b.vif.IPAMDisabled {
-		addr, err := b.getFakeBridgeIP()
+	vif := b.vif
+	if b.iface.DHCPOptions != nil && b.iface.DHCPOptions.StaticLease != nil {
+		var err error
+		vif, err = newStaticLeaseVIF(b.vif, b.iface.DHCPOptions.StaticLease)
 		if err != nil {
 			return err
 		}
-		fakeServerAddr, err := netlink.ParseAddr(addr)
-		if err != nil {
-			return fmt.Errorf("failed to parse address while starting DHCP server: %s", addr)
+	} else if b.vif.IPAMDisabled {
+		return nil
+	}
+
+	addr, err := b.getFakeBridgeIP()
+	if err != nil {
+		return err
+	}
+	fakeServerAddr, err := netlink.ParseAddr(addr)
+	if err != nil {
+		return fmt.Errorf("failed to parse address while starting DHCP server: %s", addr)
+	}
+	log.Log.Object(b.vmi).Infof("bridge pod interface: %+v %+v", vif, b)
+	return Handler.StartDHCP(vif, fakeServerAddr.IP, b.bridgeInterfaceName, b.iface, true)
+}
+
+// newStaticLeaseVIF returns the vif serving the static lease instead of the address of the pod interface
+func newStaticLeaseVIF(podVIF *VIF, lease *v1.DHCPStaticLease) (*VIF, error) {
+	addr, err := netlink.ParseAddr(lease.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the address of the static lease %s: %v", lease.Address, err)
+	}
+	if addr.IP.To4() == nil {
+		return nil, fmt.Errorf("the address of the static lease %s is not an IPv4 address", lease.Address)
+	}
+	vif := &VIF{
+		Name: podVIF.Name,
+		IP:   *addr,
+		MAC:  podVIF.MAC,
+		Mtu:  podVIF.Mtu,
+	}
+	if lease.Gateway != "" {
+		vif.Gateway = net.ParseIP(lease.Gateway).To4()
+		if vif.Gateway == nil {
+			return nil, fmt.Errorf("the gateway of the static lease %s is not an IPv4 address", lease.Gateway)
 		}
-		log.Log.Object(b.vmi).Infof("bridge pod interface: %+v %+v", b.vif, b)
-		return Handler.StartDHCP(b.vif, fakeServerAddr.IP, b.bridgeInterfaceName, b.iface.DHCPOptions, true)
 	}
-	return nil
+	return vif, nil
 }
 
 func (b *BridgeBindMechanism) preparePodNetworkInterfaces(queueNumber uint32, launcherPID int) error {
@@ -783,7 +744,7 @@ func configureVifV6Addresses(b *MasqueradeBindMechanism, err error) error {
 }
 
 func (b *MasqueradeBindMechanism) startDHCP(vmi *v1.VirtualMachineInstance) error {
-	return Handler.StartDHCP(b.vif, b.vif.Gateway, b.bridgeInterfaceName, b.iface.DHCPOptions, false)
+	return Handler.StartDHCP(b.vif, b.vif.Gateway, b.bridgeInterfaceName, b.iface, false)
 }
 
 func (b *MasqueradeBindMechanism) preparePodNetworkInterfaces(queueNumber uint32, launcherPID int) error {
@@ -990,7 +951,7 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingIptables(protocol iptables.
 		return err
 	}
 
-	err = Handler.IptablesAppendRule(protocol, "nat", "POSTROUTING", "-s", b.getVifIpByProtocol(protocol), "-j", "MASQUERADE")
+	err = Handler.IptablesAppendRule(protocol, "nat", "POSTROUTING", "-s", b.getMasqueradeSourceByProtocol(protocol), "-j", "MASQUERADE")
 	if err != nil {
 		return err
 	}
@@ -1005,7 +966,13 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingIptables(protocol iptables.
 		return err
 	}
 
-	if len(b.iface.Ports) == 0 {
+	err = b.createOutboundRulesUsingIptables(protocol)
+	if err != nil {
+		return err
+	}
+
+	portForwards := b.getPortForwards()
+	if len(portForwards) == 0 {
 		err = Handler.IptablesAppendRule(protocol, "nat", "KUBEVIRT_PREINBOUND",
 			"-j",
 			"DNAT",
@@ -1014,16 +981,12 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingIptables(protocol iptables.
 		return err
 	}
 
-	for _, port := range b.iface.Ports {
-		if port.Protocol == "" {
-			port.Protocol = "tcp"
-		}
-
+	for _, forward := range portForwards {
 		err = Handler.IptablesAppendRule(protocol, "nat", "KUBEVIRT_POSTINBOUND",
 			"-p",
-			strings.ToLower(port.Protocol),
+			forward.protocol,
 			"--dport",
-			strconv.Itoa(int(port.Port)),
+			forward.guestPorts(":"),
 			"--source", getLoopbackAdrress(protocol),
 			"-j",
 			"SNAT",
@@ -1034,25 +997,25 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingIptables(protocol iptables.
 
 		err = Handler.IptablesAppendRule(protocol, "nat", "KUBEVIRT_PREINBOUND",
 			"-p",
-			strings.ToLower(port.Protocol),
+			forward.protocol,
 			"--dport",
-			strconv.Itoa(int(port.Port)),
+			forward.podPorts(":"),
 			"-j",
 			"DNAT",
-			"--to-destination", b.getVifIpByProtocol(protocol))
+			"--to-destination", forward.destination(b.getVifIpByProtocol(protocol), protocol))
 		if err != nil {
 			return err
 		}
 
 		err = Handler.IptablesAppendRule(protocol, "nat", "OUTPUT",
 			"-p",
-			strings.ToLower(port.Protocol),
+			forward.protocol,
 			"--dport",
-			strconv.Itoa(int(port.Port)),
+			forward.podPorts(":"),
 			"--destination", getLoopbackAdrress(protocol),
 			"-j",
 			"DNAT",
-			"--to-destination", b.getVifIpByProtocol(protocol))
+			"--to-destination", forward.destination(b.getVifIpByProtocol(protocol), protocol))
 		if err != nil {
 			return err
 		}
@@ -1061,6 +1024,103 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingIptables(protocol iptables.
 	return nil
 }
 
+// createOutboundRulesUsingIptables drops the traffic of the guest towards blocked destinations
+func (b *MasqueradeBindMechanism) createOutboundRulesUsingIptables(protocol iptables.Protocol) error {
+	blockedDestinations := b.getBlockedDestinationsByProtocol(protocol)
+	if len(blockedDestinations) == 0 {
+		return nil
+	}
+
+	err := Handler.IptablesNewChain(protocol, "filter", "KUBEVIRT_OUTBOUND")
+	if err != nil {
+		return err
+	}
+
+	err = Handler.IptablesAppendRule(protocol, "filter", "FORWARD", "-i", b.bridgeInterfaceName, "-j", "KUBEVIRT_OUTBOUND")
+	if err != nil {
+		return err
+	}
+
+	for _, destination := range blockedDestinations {
+		err = Handler.IptablesAppendRule(protocol, "filter", "KUBEVIRT_OUTBOUND", "--destination", destination, "-j", "DROP")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// portForward is a port, or a range of ports, of the pod which is forwarded to the guest
+type portForward struct {
+	protocol  string
+	port      int32
+	endPort   int32
+	guestPort int32
+}
+
+// getPortForwards returns the forwarded ports of the interface, or none when all ports are forwarded
+func (b *MasqueradeBindMechanism) getPortForwards() []portForward {
+	portForwards := []portForward{}
+	for _, port := range b.iface.Ports {
+		portForwards = append(portForwards, newPortForward(port.Protocol, port.Port, 0, 0))
+	}
+	if b.iface.Masquerade != nil {
+		for _, mapping := range b.iface.Masquerade.PortMappings {
+			portForwards = append(portForwards, newPortForward(mapping.Protocol, mapping.Port, mapping.EndPort, mapping.GuestPort))
+		}
+	}
+	return portForwards
+}
+
+func newPortForward(protocol string, port, endPort, guestPort int32) portForward {
+	if protocol == "" {
+		protocol = "tcp"
+	}
+	if guestPort == 0 {
+		guestPort = port
+	}
+	return portForward{protocol: strings.ToLower(protocol), port: port, endPort: endPort, guestPort: guestPort}
+}
+
+func (f portForward) podPorts(rangeSeparator string) string {
+	if f.endPort > f.port {
+		return fmt.Sprintf("%d%s%d", f.port, rangeSeparator, f.endPort)
+	}
+	return strconv.Itoa(int(f.port))
+}
+
+// guestPorts returns the ports the forwarded traffic reaches the guest on
+func (f portForward) guestPorts(rangeSeparator string) string {
+	if f.endPort > f.port {
+		return f.podPorts(rangeSeparator)
+	}
+	return strconv.Itoa(int(f.guestPort))
+}
+
+// destination returns the NAT destination, it only names a port when the guest port differs
+func (f portForward) destination(vifIP string, proto iptables.Protocol) string {
+	if f.guestPort == f.port {
+		return vifIP
+	}
+	if proto == iptables.ProtocolIPv6 {
+		vifIP = fmt.Sprintf("[%s]", vifIP)
+	}
+	return fmt.Sprintf("%s:%d", vifIP, f.guestPort)
+}
+
+func (b *MasqueradeBindMechanism) getBlockedDestinationsByProtocol(proto iptables.Protocol) []string {
+	destinations := []string{}
+	if b.iface.Masquerade == nil {
+		return destinations
+	}
+	for _, destination := range b.iface.Masquerade.BlockedDestinations {
+		if netutils.IsIPv6CIDRString(destination) == (proto == iptables.ProtocolIPv6) {
+			destinations = append(destinations, destination)
+		}
+	}
+	return destinations
+}
+
 func (b *MasqueradeBindMechanism) getGatewayByProtocol(proto iptables.Protocol) string {
 	if proto == iptables.ProtocolIPv4 {
 		return b.gatewayAddr.IP.String()
@@ -1077,6 +1137,15 @@ func (b *MasqueradeBindMechanism) getVifIpByProtocol(proto iptables.Protocol) st
 	}
 }
 
+// getMasqueradeSourceByProtocol returns the source of the traffic which is masqueraded. Guests
+// configuring their IPv6 address with SLAAC may use any address of the network.
+func (b *MasqueradeBindMechanism) getMasqueradeSourceByProtocol(proto iptables.Protocol) string {
+	if proto == iptables.ProtocolIPv6 && b.iface.DHCPOptions != nil && b.iface.DHCPOptions.SLAAC {
+		return b.vmIpv6NetworkCIDR
+	}
+	return b.getVifIpByProtocol(proto)
+}
+
 func getLoopbackAdrress(proto iptables.Protocol) string {
 	if proto == iptables.ProtocolIPv4 {
 		return "127.0.0.1"
@@ -1096,7 +1165,7 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingNftables(proto iptables.Pro
 		return err
 	}
 
-	err = Handler.NftablesAppendRule(proto, "nat", "postrouting", Handler.GetNFTIPString(proto), "saddr", b.getVifIpByProtocol(proto), "counter", "masquerade")
+	err = Handler.NftablesAppendRule(proto, "nat", "postrouting", Handler.GetNFTIPString(proto), "saddr", b.getMasqueradeSourceByProtocol(proto), "counter", "masquerade")
 	if err != nil {
 		return err
 	}
@@ -1111,22 +1180,24 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingNftables(proto iptables.Pro
 		return err
 	}
 
-	if len(b.iface.Ports) == 0 {
+	err = b.createOutboundRulesUsingNftables(proto)
+	if err != nil {
+		return err
+	}
+
+	portForwards := b.getPortForwards()
+	if len(portForwards) == 0 {
 		err = Handler.NftablesAppendRule(proto, "nat", "KUBEVIRT_PREINBOUND",
 			"counter", "dnat", "to", b.getVifIpByProtocol(proto))
 
 		return err
 	}
 
-	for _, port := range b.iface.Ports {
-		if port.Protocol == "" {
-			port.Protocol = "tcp"
-		}
-
+	for _, forward := range portForwards {
 		err = Handler.NftablesAppendRule(proto, "nat", "KUBEVIRT_POSTINBOUND",
-			strings.ToLower(port.Protocol),
+			forward.protocol,
 			"dport",
-			strconv.Itoa(int(port.Port)),
+			forward.guestPorts("-"),
 			Handler.GetNFTIPString(proto), "saddr", getLoopbackAdrress(proto),
 			"counter", "snat", "to", b.getGatewayByProtocol(proto))
 		if err != nil {
@@ -1134,20 +1205,20 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingNftables(proto iptables.Pro
 		}
 
 		err = Handler.NftablesAppendRule(proto, "nat", "KUBEVIRT_PREINBOUND",
-			strings.ToLower(port.Protocol),
+			forward.protocol,
 			"dport",
-			strconv.Itoa(int(port.Port)),
-			"counter", "dnat", "to", b.getVifIpByProtocol(proto))
+			forward.podPorts("-"),
+			"counter", "dnat", "to", forward.destination(b.getVifIpByProtocol(proto), proto))
 		if err != nil {
 			return err
 		}
 
 		err = Handler.NftablesAppendRule(proto, "nat", "output",
 			Handler.GetNFTIPString(proto), "daddr", getLoopbackAdrress(proto),
-			strings.ToLower(port.Protocol),
+			forward.protocol,
 			"dport",
-			strconv.Itoa(int(port.Port)),
-			"counter", "dnat", "to", b.getVifIpByProtocol(proto))
+			forward.podPorts("-"),
+			"counter", "dnat", "to", forward.destination(b.getVifIpByProtocol(proto), proto))
 		if err != nil {
 			return err
 		}
@@ -1156,6 +1227,33 @@ func (b *MasqueradeBindMechanism) createNatRulesUsingNftables(proto iptables.Pro
 	return nil
 }
 
+// createOutboundRulesUsingNftables drops the traffic of the guest towards blocked destinations.
+// The nat chains see the first packet of every connection, dropping it prevents the connection.
+func (b *MasqueradeBindMechanism) createOutboundRulesUsingNftables(proto iptables.Protocol) error {
+	blockedDestinations := b.getBlockedDestinationsByProtocol(proto)
+	if len(blockedDestinations) == 0 {
+		return nil
+	}
+
+	err := Handler.NftablesNewChain(proto, "nat", "KUBEVIRT_OUTBOUND")
+	if err != nil {
+		return err
+	}
+
+	err = Handler.NftablesAppendRule(proto, "nat", "prerouting", "iifname", b.bridgeInterfaceName, "counter", "jump", "KUBEVIRT_OUTBOUND")
+	if err != nil {
+		return err
+	}
+
+	for _, destination := range blockedDestinations {
+		err = Handler.NftablesAppendRule(proto, "nat", "KUBEVIRT_OUTBOUND", Handler.GetNFTIPString(proto), "daddr", destination, "counter", "drop")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type SlirpBindMechanism struct {
 	vmi       *v1.VirtualMachineInstance
 	iface     *v1.Interface
@@ -1203,7 +1301,20 @@ func (s *SlirpBindMechanism) decorateConfig() error {
 	return nil
 }
 
+// Slirp leaves the pod interface untouched, the VMI interface is cached only
+// to record that the pod network has been set up
 func (s *SlirpBindMechanism) loadCachedInterface(pid, name string) (bool, error) {
+	var ifaceConfig v1.Interface
+
+	err := readFromVirtLauncherCachedFile(&ifaceConfig, pid, name)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -1216,7 +1327,7 @@ func (b *SlirpBindMechanism) setCachedVIF(pid, name string) error {
 }
 
 func (s *SlirpBindMechanism) setCachedInterface(pid, name string) error {
-	return nil
+	return writeToVirtLauncherCachedFile(s.iface, pid, name)
 }
 
 type MacvtapBindMechanism struct {
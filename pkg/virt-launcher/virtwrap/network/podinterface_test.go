@@ -204,7 +204,7 @@ var _ = Describe("Pod Network", func() {
 		mockNetwork.EXPECT().ParseAddr(fmt.Sprintf(bridgeFakeIP, 0)).Return(bridgeAddr, nil)
 		mockNetwork.EXPECT().LinkSetMaster(primaryPodInterfaceAfterNameChange, bridgeTest).Return(nil)
 		mockNetwork.EXPECT().AddrAdd(bridgeTest, bridgeAddr).Return(nil)
-		mockNetwork.EXPECT().StartDHCP(testNic, bridgeAddr, api.DefaultBridgeName, nil, true)
+		mockNetwork.EXPECT().StartDHCP(testNic, bridgeAddr, api.DefaultBridgeName, gomock.Any(), true)
 		mockNetwork.EXPECT().CreateTapDevice(tapDeviceName, queueNumber, pid, mtu).Return(nil)
 		mockNetwork.EXPECT().BindTapDeviceToBridge(tapDeviceName, "k6t-eth0").Return(nil)
 		mockNetwork.EXPECT().DisableTXOffloadChecksum(bridgeTest.Name).Return(nil)
@@ -226,7 +226,7 @@ var _ = Describe("Pod Network", func() {
 		mockNetwork.EXPECT().LinkSetMaster(masqueradeDummy, masqueradeBridgeTest).Return(nil)
 		mockNetwork.EXPECT().AddrAdd(masqueradeBridgeTest, masqueradeGwAddr).Return(nil)
 		mockNetwork.EXPECT().AddrAdd(masqueradeBridgeTest, masqueradeIpv6GwAddr).Return(nil)
-		mockNetwork.EXPECT().StartDHCP(masqueradeTestNic, masqueradeGwAddr, api.DefaultBridgeName, nil, false)
+		mockNetwork.EXPECT().StartDHCP(masqueradeTestNic, masqueradeGwAddr, api.DefaultBridgeName, gomock.Any(), false)
 		mockNetwork.EXPECT().GetHostAndGwAddressesFromCIDR(api.DefaultVMCIDR).Return(masqueradeGwStr, masqueradeVmStr, nil)
 		mockNetwork.EXPECT().GetHostAndGwAddressesFromCIDR(api.DefaultVMIpv6CIDR).Return(masqueradeIpv6GwStr, masqueradeIpv6VmStr, nil)
 		mockNetwork.EXPECT().CreateTapDevice(tapDeviceName, queueNumber, pid, mtu).Return(nil)
@@ -397,6 +397,31 @@ var _ = Describe("Pod Network", func() {
 					Expect(ok).To(BeTrue())
 					Expect(bridge.vif.MAC.String()).To(Equal("de:ad:00:00:be:af"))
 				})
+				It("should keep the MAC address of the guest on a migration target", func() {
+					vmi := newVMIBridgeInterface("testnamespace", "testVmName")
+					vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{}
+					vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{
+						{Name: "default", MAC: "de:ad:00:00:be:af"},
+					}
+					driver, err := getPhase1Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], primaryPodInterfaceName)
+					Expect(err).ToNot(HaveOccurred())
+					bridge, ok := driver.(*BridgeBindMechanism)
+					Expect(ok).To(BeTrue())
+					Expect(bridge.vif.MAC.String()).To(Equal("de:ad:00:00:be:af"))
+				})
+			})
+			Context("for Masquerade", func() {
+				It("should use the VM network CIDRs of the pod network", func() {
+					vmi := newVMIMasqueradeInterface("testnamespace", "testVmName")
+					vmi.Spec.Networks[0].Pod.VMNetworkCIDR = "10.11.12.0/24"
+					vmi.Spec.Networks[0].Pod.VMIPv6NetworkCIDR = "fd10:1:2::/120"
+					driver, err := getPhase1Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], primaryPodInterfaceName)
+					Expect(err).ToNot(HaveOccurred())
+					masquerade, ok := driver.(*MasqueradeBindMechanism)
+					Expect(ok).To(BeTrue())
+					Expect(masquerade.vmNetworkCIDR).To(Equal("10.11.12.0/24"))
+					Expect(masquerade.vmIpv6NetworkCIDR).To(Equal("fd10:1:2::/120"))
+				})
 			})
 		})
 		Context("SRIOV Plug", func() {
@@ -421,6 +446,47 @@ var _ = Describe("Pod Network", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
+		Context("Binding plugins", func() {
+			It("should not plug interfaces of plugins provided by a sidecar", func() {
+				domain := &api.Domain{}
+				net := &v1.Network{}
+				iface := &v1.Interface{
+					Name:    "external",
+					Binding: &v1.PluginBinding{Name: "external"},
+				}
+				vmi := newVMI("testnamespace", "testVmName")
+				podnic := podNICImpl{}
+				Expect(podnic.PlugPhase1(vmi, iface, net, "fakeiface", pid)).To(Succeed())
+				Expect(podnic.PlugPhase2(vmi, iface, net, domain, "fakeiface")).To(Succeed())
+			})
+			It("should use the registered plugin selected by the binding", func() {
+				var pluggedIface string
+				RegisterBindingPlugin("test-binding", func(vmi *v1.VirtualMachineInstance, iface *v1.Interface, network *v1.Network, domain *api.Domain, podInterfaceName string) (BindMechanism, error) {
+					pluggedIface = iface.Name
+					return newSlirpBindMechanism(vmi, iface, network, domain, podInterfaceName)
+				})
+				defer func() {
+					bindingPluginsLock.Lock()
+					delete(bindingPlugins, "test-binding")
+					bindingPluginsLock.Unlock()
+				}()
+
+				vmi := newVMI("testnamespace", "testVmName")
+				iface := &v1.Interface{
+					Name:    "test",
+					Binding: &v1.PluginBinding{Name: "test-binding"},
+				}
+				driver, err := getPhase2Binding(vmi, iface, &v1.Network{}, &api.Domain{}, primaryPodInterfaceName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(driver).To(BeAssignableToTypeOf(&SlirpBindMechanism{}))
+				Expect(pluggedIface).To(Equal("test"))
+			})
+			It("should fail for interfaces without a binding", func() {
+				vmi := newVMI("testnamespace", "testVmName")
+				_, err := getPhase2Binding(vmi, &v1.Interface{Name: "test"}, &v1.Network{}, &api.Domain{}, primaryPodInterfaceName)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 		Context("Masquerade Plug", func() {
 			It("should define a new VIF bind to a bridge and create a default nat rule using iptables", func() {
 
@@ -476,6 +542,89 @@ var _ = Describe("Pod Network", func() {
 				api.NewDefaulter(runtime.GOARCH).SetObjectDefaults_Domain(domain)
 				TestPodInterfaceIPBinding(vm, domain)
 			})
+			It("should define a new VIF bind to a bridge and create port mapping and outbound rules using iptables", func() {
+				mockNetwork.EXPECT().IsIpv6Enabled(primaryPodInterfaceName).Return(true, nil).Times(3)
+				mockNetwork.EXPECT().IsIpv4Primary().Return(true, nil).Times(1)
+
+				blockedDestinations := map[iptables.Protocol]string{
+					iptables.ProtocolIPv4: "10.96.0.0/12",
+					iptables.ProtocolIPv6: "fd00:10:96::/112",
+				}
+				for _, proto := range ipProtocols() {
+					mockNetwork.EXPECT().NftablesLoad(proto).Return(fmt.Errorf("no nft"))
+					mockNetwork.EXPECT().HasNatIptables(proto).Return(true).Times(2)
+
+					// Block traffic towards the blocked destinations
+					mockNetwork.EXPECT().IptablesNewChain(proto, "filter", "KUBEVIRT_OUTBOUND").Return(nil).AnyTimes()
+					mockNetwork.EXPECT().IptablesAppendRule(proto, "filter",
+						"FORWARD", "-i", "k6t-eth0", "-j", "KUBEVIRT_OUTBOUND").Return(nil).AnyTimes()
+					mockNetwork.EXPECT().IptablesAppendRule(proto, "filter",
+						"KUBEVIRT_OUTBOUND", "--destination", blockedDestinations[proto], "-j", "DROP").Return(nil).AnyTimes()
+
+					// Forward the pod port 2222 to the guest port 22
+					guestDestination := fmt.Sprintf("%s:22", GetMasqueradeVmIp(proto))
+					if proto == iptables.ProtocolIPv6 {
+						guestDestination = fmt.Sprintf("[%s]:22", GetMasqueradeVmIp(proto))
+					}
+					mockNetwork.EXPECT().IptablesAppendRule(proto, "nat",
+						"KUBEVIRT_POSTINBOUND",
+						"-p",
+						"tcp",
+						"--dport",
+						"22",
+						"--source", getLoopbackAdrress(proto),
+						"-j", "SNAT", "--to-source", GetMasqueradeGwIp(proto)).Return(nil).AnyTimes()
+					mockNetwork.EXPECT().IptablesAppendRule(proto, "nat",
+						"KUBEVIRT_PREINBOUND",
+						"-p",
+						"tcp",
+						"--dport",
+						"2222", "-j", "DNAT", "--to-destination", guestDestination).Return(nil).AnyTimes()
+					mockNetwork.EXPECT().IptablesAppendRule(proto, "nat",
+						"OUTPUT",
+						"-p",
+						"tcp",
+						"--dport",
+						"2222", "--destination", getLoopbackAdrress(proto),
+						"-j", "DNAT", "--to-destination", guestDestination).Return(nil).AnyTimes()
+
+					// Forward the udp port range 5000-5010
+					mockNetwork.EXPECT().IptablesAppendRule(proto, "nat",
+						"KUBEVIRT_POSTINBOUND",
+						"-p",
+						"udp",
+						"--dport",
+						"5000:5010",
+						"--source", getLoopbackAdrress(proto),
+						"-j", "SNAT", "--to-source", GetMasqueradeGwIp(proto)).Return(nil).AnyTimes()
+					mockNetwork.EXPECT().IptablesAppendRule(proto, "nat",
+						"KUBEVIRT_PREINBOUND",
+						"-p",
+						"udp",
+						"--dport",
+						"5000:5010", "-j", "DNAT", "--to-destination", GetMasqueradeVmIp(proto)).Return(nil).AnyTimes()
+					mockNetwork.EXPECT().IptablesAppendRule(proto, "nat",
+						"OUTPUT",
+						"-p",
+						"udp",
+						"--dport",
+						"5000:5010", "--destination", getLoopbackAdrress(proto),
+						"-j", "DNAT", "--to-destination", GetMasqueradeVmIp(proto)).Return(nil).AnyTimes()
+				}
+
+				domain := NewDomainWithBridgeInterface()
+				vm := newVMIMasqueradeInterface("testnamespace", "testVmName")
+				vm.Spec.Domain.Devices.Interfaces[0].Masquerade = &v1.InterfaceMasquerade{
+					PortMappings: []v1.MasqueradePortMapping{
+						{Name: "ssh", Port: 2222, GuestPort: 22},
+						{Name: "media", Protocol: "UDP", Port: 5000, EndPort: 5010},
+					},
+					BlockedDestinations: []string{blockedDestinations[iptables.ProtocolIPv4], blockedDestinations[iptables.ProtocolIPv6]},
+				}
+
+				api.NewDefaulter(runtime.GOARCH).SetObjectDefaults_Domain(domain)
+				TestPodInterfaceIPBinding(vm, domain)
+			})
 			It("should define a new VIF bind to a bridge and create a default nat rule using nftables", func() {
 				// forward all the traffic
 				for _, proto := range ipProtocols() {
@@ -527,6 +676,86 @@ var _ = Describe("Pod Network", func() {
 				api.NewDefaulter(runtime.GOARCH).SetObjectDefaults_Domain(domain)
 				TestPodInterfaceIPBinding(vm, domain)
 			})
+			It("should define a new VIF bind to a bridge and create port mapping and outbound rules using nftables", func() {
+				mockNetwork.EXPECT().IsIpv6Enabled(primaryPodInterfaceName).Return(true, nil).Times(3)
+				mockNetwork.EXPECT().IsIpv4Primary().Return(true, nil).Times(1)
+
+				blockedDestinations := map[iptables.Protocol]string{
+					iptables.ProtocolIPv4: "10.96.0.0/12",
+					iptables.ProtocolIPv6: "fd00:10:96::/112",
+				}
+				for _, proto := range ipProtocols() {
+					mockNetwork.EXPECT().NftablesLoad(proto).Return(nil)
+
+					// Block traffic towards the blocked destinations
+					mockNetwork.EXPECT().NftablesNewChain(proto, "nat", "KUBEVIRT_OUTBOUND").Return(nil).AnyTimes()
+					mockNetwork.EXPECT().NftablesAppendRule(proto, "nat",
+						"prerouting", "iifname", "k6t-eth0", "counter", "jump", "KUBEVIRT_OUTBOUND").Return(nil).AnyTimes()
+					mockNetwork.EXPECT().NftablesAppendRule(proto, "nat",
+						"KUBEVIRT_OUTBOUND", GetNFTIPString(proto), "daddr", blockedDestinations[proto], "counter", "drop").Return(nil).AnyTimes()
+
+					// Forward the pod port 2222 to the guest port 22
+					guestDestination := fmt.Sprintf("%s:22", GetMasqueradeVmIp(proto))
+					if proto == iptables.ProtocolIPv6 {
+						guestDestination = fmt.Sprintf("[%s]:22", GetMasqueradeVmIp(proto))
+					}
+					mockNetwork.EXPECT().NftablesAppendRule(proto, "nat",
+						"KUBEVIRT_POSTINBOUND",
+						"tcp",
+						"dport",
+						"22",
+						GetNFTIPString(proto), "saddr", getLoopbackAdrress(proto),
+						"counter", "snat", "to", GetMasqueradeGwIp(proto)).Return(nil).AnyTimes()
+					mockNetwork.EXPECT().NftablesAppendRule(proto, "nat",
+						"KUBEVIRT_PREINBOUND",
+						"tcp",
+						"dport",
+						"2222",
+						"counter", "dnat", "to", guestDestination).Return(nil).AnyTimes()
+					mockNetwork.EXPECT().NftablesAppendRule(proto, "nat",
+						"output",
+						GetNFTIPString(proto), "daddr", getLoopbackAdrress(proto),
+						"tcp",
+						"dport",
+						"2222",
+						"counter", "dnat", "to", guestDestination).Return(nil).AnyTimes()
+
+					// Forward the udp port range 5000-5010
+					mockNetwork.EXPECT().NftablesAppendRule(proto, "nat",
+						"KUBEVIRT_POSTINBOUND",
+						"udp",
+						"dport",
+						"5000-5010",
+						GetNFTIPString(proto), "saddr", getLoopbackAdrress(proto),
+						"counter", "snat", "to", GetMasqueradeGwIp(proto)).Return(nil).AnyTimes()
+					mockNetwork.EXPECT().NftablesAppendRule(proto, "nat",
+						"KUBEVIRT_PREINBOUND",
+						"udp",
+						"dport",
+						"5000-5010",
+						"counter", "dnat", "to", GetMasqueradeVmIp(proto)).Return(nil).AnyTimes()
+					mockNetwork.EXPECT().NftablesAppendRule(proto, "nat",
+						"output",
+						GetNFTIPString(proto), "daddr", getLoopbackAdrress(proto),
+						"udp",
+						"dport",
+						"5000-5010",
+						"counter", "dnat", "to", GetMasqueradeVmIp(proto)).Return(nil).AnyTimes()
+				}
+
+				domain := NewDomainWithBridgeInterface()
+				vm := newVMIMasqueradeInterface("testnamespace", "testVmName")
+				vm.Spec.Domain.Devices.Interfaces[0].Masquerade = &v1.InterfaceMasquerade{
+					PortMappings: []v1.MasqueradePortMapping{
+						{Name: "ssh", Port: 2222, GuestPort: 22},
+						{Name: "media", Protocol: "UDP", Port: 5000, EndPort: 5010},
+					},
+					BlockedDestinations: []string{blockedDestinations[iptables.ProtocolIPv4], blockedDestinations[iptables.ProtocolIPv6]},
+				}
+
+				api.NewDefaulter(runtime.GOARCH).SetObjectDefaults_Domain(domain)
+				TestPodInterfaceIPBinding(vm, domain)
+			})
 
 		})
 		Context("Slirp Plug", func() {
@@ -619,7 +848,7 @@ var _ = Describe("Pod Network", func() {
 
 			masq.vif.Gateway = masqueradeGwAddr.IP.To4()
 			masq.vif.GatewayIpv6 = masqueradeIpv6GwAddr.IP.To16()
-			mockNetwork.EXPECT().StartDHCP(masq.vif, gomock.Any(), masq.bridgeInterfaceName, nil, false).Return(nil)
+			mockNetwork.EXPECT().StartDHCP(masq.vif, gomock.Any(), masq.bridgeInterfaceName, masq.iface, false).Return(nil)
 
 			err = masq.startDHCP(vmi)
 			Expect(err).ToNot(HaveOccurred())
@@ -638,12 +867,37 @@ var _ = Describe("Pod Network", func() {
 			masq.vif.GatewayIpv6 = masqueradeIpv6GwAddr.IP.To16()
 
 			err = fmt.Errorf("failed to start DHCP server")
-			mockNetwork.EXPECT().StartDHCP(masq.vif, gomock.Any(), masq.bridgeInterfaceName, nil, false).Return(err)
+			mockNetwork.EXPECT().StartDHCP(masq.vif, gomock.Any(), masq.bridgeInterfaceName, masq.iface, false).Return(err)
 
 			err = masq.startDHCP(vmi)
 			Expect(err).To(HaveOccurred())
 		})
 	})
+	Context("Masquerade source", func() {
+		newMasquerade := func(dhcpOptions *v1.DHCPOptions) *MasqueradeBindMechanism {
+			domain := NewDomainWithBridgeInterface()
+			vmi := newVMIMasqueradeInterface("testnamespace", "testVmName")
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = dhcpOptions
+			driver, err := getPhase2Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], domain, primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+			masq, ok := driver.(*MasqueradeBindMechanism)
+			Expect(ok).To(BeTrue())
+			masq.vif.IP = *masqueradeVmAddr
+			masq.vif.IPv6 = *masqueradeIpv6VmAddr
+			masq.vmIpv6NetworkCIDR = api.DefaultVMIpv6CIDR
+			return masq
+		}
+		It("should masquerade the address of the guest", func() {
+			masq := newMasquerade(nil)
+			Expect(masq.getMasqueradeSourceByProtocol(iptables.ProtocolIPv4)).To(Equal(masqueradeVmAddr.IP.String()))
+			Expect(masq.getMasqueradeSourceByProtocol(iptables.ProtocolIPv6)).To(Equal(masqueradeIpv6VmAddr.IP.String()))
+		})
+		It("should masquerade the whole IPv6 network of the guest with SLAAC", func() {
+			masq := newMasquerade(&v1.DHCPOptions{SLAAC: true})
+			Expect(masq.getMasqueradeSourceByProtocol(iptables.ProtocolIPv4)).To(Equal(masqueradeVmAddr.IP.String()))
+			Expect(masq.getMasqueradeSourceByProtocol(iptables.ProtocolIPv6)).To(Equal(api.DefaultVMIpv6CIDR))
+		})
+	})
 	Context("Bridge startDHCP", func() {
 		It("should succeed when DHCP server started", func() {
 			domain := NewDomainWithBridgeInterface()
@@ -655,7 +909,7 @@ var _ = Describe("Pod Network", func() {
 			bridge, ok := driver.(*BridgeBindMechanism)
 			Expect(ok).To(BeTrue())
 
-			mockNetwork.EXPECT().StartDHCP(bridge.vif, gomock.Any(), api.DefaultBridgeName, nil, true).Return(nil)
+			mockNetwork.EXPECT().StartDHCP(bridge.vif, gomock.Any(), api.DefaultBridgeName, bridge.iface, true).Return(nil)
 
 			err = bridge.startDHCP(vmi)
 			Expect(err).ToNot(HaveOccurred())
@@ -671,7 +925,7 @@ var _ = Describe("Pod Network", func() {
 			Expect(ok).To(BeTrue())
 
 			err = fmt.Errorf("failed to start DHCP server")
-			mockNetwork.EXPECT().StartDHCP(bridge.vif, gomock.Any(), api.DefaultBridgeName, nil, true).Return(err)
+			mockNetwork.EXPECT().StartDHCP(bridge.vif, gomock.Any(), api.DefaultBridgeName, bridge.iface, true).Return(err)
 
 			err = bridge.startDHCP(vmi)
 			Expect(err).To(HaveOccurred())
@@ -688,11 +942,55 @@ var _ = Describe("Pod Network", func() {
 
 			bridge.vif.IPAMDisabled = true
 			err = fmt.Errorf("failed to start DHCP server")
-			mockNetwork.EXPECT().StartDHCP(bridge.vif, gomock.Any(), api.DefaultBridgeName, nil, true).Return(err)
+			mockNetwork.EXPECT().StartDHCP(bridge.vif, gomock.Any(), api.DefaultBridgeName, bridge.iface, true).Return(err)
 
 			err = bridge.startDHCP(vmi)
 			Expect(err).ToNot(HaveOccurred())
 		})
+		It("should serve the static lease when one is set", func() {
+			domain := NewDomainWithBridgeInterface()
+			vmi := newVMIBridgeInterface("testnamespace", "testVmName")
+			api.NewDefaulter(runtime.GOARCH).SetObjectDefaults_Domain(domain)
+			vmi.Spec.Domain.Devices.Interfaces[0].MacAddress = "de-ad-00-00-be-af"
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				StaticLease: &v1.DHCPStaticLease{Address: "192.168.10.5/24", Gateway: "192.168.10.1"},
+			}
+			driver, err := getPhase2Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], domain, primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+			bridge, ok := driver.(*BridgeBindMechanism)
+			Expect(ok).To(BeTrue())
+
+			bridge.vif.IPAMDisabled = true
+			bridge.vif.MAC = fakeMac
+			leaseAddr, _ := netlink.ParseAddr("192.168.10.5/24")
+			expectedVIF := &VIF{
+				Name:    bridge.vif.Name,
+				IP:      *leaseAddr,
+				MAC:     fakeMac,
+				Mtu:     bridge.vif.Mtu,
+				Gateway: net.ParseIP("192.168.10.1").To4(),
+			}
+			mockNetwork.EXPECT().StartDHCP(expectedVIF, gomock.Any(), api.DefaultBridgeName, bridge.iface, true).Return(nil)
+
+			err = bridge.startDHCP(vmi)
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should fail when the static lease is not an IPv4 address", func() {
+			domain := NewDomainWithBridgeInterface()
+			vmi := newVMIBridgeInterface("testnamespace", "testVmName")
+			api.NewDefaulter(runtime.GOARCH).SetObjectDefaults_Domain(domain)
+			vmi.Spec.Domain.Devices.Interfaces[0].MacAddress = "de-ad-00-00-be-af"
+			vmi.Spec.Domain.Devices.Interfaces[0].DHCPOptions = &v1.DHCPOptions{
+				StaticLease: &v1.DHCPStaticLease{Address: "fd10::5/64"},
+			}
+			driver, err := getPhase2Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], domain, primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+			bridge, ok := driver.(*BridgeBindMechanism)
+			Expect(ok).To(BeTrue())
+
+			err = bridge.startDHCP(vmi)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 	Context("Slirp startDHCP", func() {
 		It("should succeed when DHCP server started", func() {
@@ -741,6 +1039,28 @@ var _ = Describe("Pod Network", func() {
 		})
 	})
 
+	Context("Slirp loadCachedInterface", func() {
+		It("should only succeed after setCachedInterface", func() {
+			vmi := newVMISlirpInterface("testnamespace", "testVmName")
+
+			driver, err := getPhase1Binding(vmi, &vmi.Spec.Domain.Devices.Interfaces[0], &vmi.Spec.Networks[0], primaryPodInterfaceName)
+			Expect(err).ToNot(HaveOccurred())
+			slirp, ok := driver.(*SlirpBindMechanism)
+			Expect(ok).To(BeTrue())
+
+			succ, err := slirp.loadCachedInterface(fmt.Sprintf("%d", pid), "fakename")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(succ).To(BeFalse())
+
+			err = slirp.setCachedInterface(fmt.Sprintf("%d", pid), "fakename")
+			Expect(err).ToNot(HaveOccurred())
+
+			succ, err = slirp.loadCachedInterface(fmt.Sprintf("%d", pid), "fakename")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(succ).To(BeTrue())
+		})
+	})
+
 	Context("Slirp loadCachedVIF", func() {
 		It("should succeed", func() {
 			vmi := newVMISlirpInterface("testnamespace", "testVmName")
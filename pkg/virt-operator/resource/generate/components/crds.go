@@ -32,6 +32,14 @@ import (
 	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
 
 	virtv1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	"kubevirt.io/client-go/apis/instancetype"
+	instancetypev1 "kubevirt.io/client-go/apis/instancetype/v1alpha1"
+	migrationsv1 "kubevirt.io/client-go/apis/migrations/v1alpha1"
+	networkpolicyv1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	poolv1 "kubevirt.io/client-go/apis/pool/v1alpha1"
 	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
 )
 
@@ -40,15 +48,26 @@ const (
 )
 
 var (
-	VIRTUALMACHINE                   = "virtualmachines." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCE           = "virtualmachineinstances." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEPRESET     = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEREPLICASET = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
-	VIRTUALMACHINEINSTANCEMIGRATION  = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
-	KUBEVIRT                         = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
-	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1.SchemeGroupVersion.Group
-	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1.SchemeGroupVersion.Group
-	PreserveUnknownFieldsFalse       = false
+	VIRTUALMACHINE                    = "virtualmachines." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCE            = "virtualmachineinstances." + virtv1.VirtualMachineInstanceGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEPRESET      = "virtualmachineinstancepresets." + virtv1.VirtualMachineInstancePresetGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEREPLICASET  = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
+	VIRTUALMACHINEINSTANCEMIGRATION   = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
+	KUBEVIRT                          = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
+	VIRTUALMACHINESNAPSHOT            = "virtualmachinesnapshots." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINESNAPSHOTCONTENT     = "virtualmachinesnapshotcontents." + snapshotv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEPOOL                = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
+	VIRTUALMACHINECLONE               = "virtualmachineclones." + clonev1.SchemeGroupVersion.Group
+	VIRTUALMACHINEEXPORT              = "virtualmachineexports." + exportv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEBACKUP              = "virtualmachinebackups." + backupv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEBACKUPRESTORE       = "virtualmachinebackuprestores." + backupv1.SchemeGroupVersion.Group
+	MIGRATIONPOLICY                   = "migrationpolicies." + migrationsv1.SchemeGroupVersion.Group
+	VIRTUALMACHINEINSTANCETYPE        = "virtualmachineinstancetypes." + instancetypev1.SchemeGroupVersion.Group
+	VIRTUALMACHINECLUSTERINSTANCETYPE = "virtualmachineclusterinstancetypes." + instancetypev1.SchemeGroupVersion.Group
+	VIRTUALMACHINEPREFERENCE          = "virtualmachinepreferences." + instancetypev1.SchemeGroupVersion.Group
+	VIRTUALMACHINECLUSTERPREFERENCE   = "virtualmachineclusterpreferences." + instancetypev1.SchemeGroupVersion.Group
+	VIRTUALMACHINENETWORKPOLICY       = "virtualmachinenetworkpolicies." + networkpolicyv1.SchemeGroupVersion.Group
+	PreserveUnknownFieldsFalse        = false
 )
 
 func patchValidation(crd *extv1beta1.CustomResourceDefinition) error {
@@ -152,6 +171,351 @@ func NewVirtualMachineCrd() (*extv1beta1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewVirtualMachineCloneCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINECLONE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   clonev1.SchemeGroupVersion.Group,
+		Version: clonev1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    clonev1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachineclones",
+			Singular:   "virtualmachineclone",
+			Kind:       "VirtualMachineClone",
+			ShortNames: []string{"vmclone", "vmclones"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+			{Name: "SourceVirtualMachine", Type: "string", JSONPath: ".spec.source.name"},
+			{Name: "TargetVirtualMachine", Type: "string", JSONPath: ".status.targetName"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineExportCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEEXPORT
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   exportv1.SchemeGroupVersion.Group,
+		Version: exportv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    exportv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachineexports",
+			Singular:   "virtualmachineexport",
+			Kind:       "VirtualMachineExport",
+			ShortNames: []string{"vmexport", "vmexports"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "SourceKind", Type: "string", JSONPath: ".spec.source.kind"},
+			{Name: "SourceName", Type: "string", JSONPath: ".spec.source.name"},
+			{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineBackupCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEBACKUP
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   backupv1.SchemeGroupVersion.Group,
+		Version: backupv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    backupv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinebackups",
+			Singular:   "virtualmachinebackup",
+			Kind:       "VirtualMachineBackup",
+			ShortNames: []string{"vmbackup", "vmbackups"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "SourceName", Type: "string", JSONPath: ".spec.source.name"},
+			{Name: "Mode", Type: "string", JSONPath: ".spec.mode"},
+			{Name: "Type", Type: "string", JSONPath: ".status.type"},
+			{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+			{Name: "CompletionTime", Type: "date", JSONPath: ".status.completionTime"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineBackupRestoreCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEBACKUPRESTORE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   backupv1.SchemeGroupVersion.Group,
+		Version: backupv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    backupv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinebackuprestores",
+			Singular:   "virtualmachinebackuprestore",
+			Kind:       "VirtualMachineBackupRestore",
+			ShortNames: []string{"vmbackuprestore", "vmbackuprestores"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "BackupName", Type: "string", JSONPath: ".spec.backupName"},
+			{Name: "Phase", Type: "string", JSONPath: ".status.phase"},
+			{Name: "CompletionTime", Type: "date", JSONPath: ".status.completionTime"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewMigrationPolicyCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = MIGRATIONPOLICY
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   migrationsv1.SchemeGroupVersion.Group,
+		Version: migrationsv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    migrationsv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Cluster",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "migrationpolicies",
+			Singular:   "migrationpolicy",
+			Kind:       "MigrationPolicy",
+			ShortNames: []string{"mp", "mps"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineInstancetypeCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEINSTANCETYPE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   instancetypev1.SchemeGroupVersion.Group,
+		Version: instancetypev1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    instancetypev1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     instancetype.PluralResourceName,
+			Singular:   instancetype.SingularResourceName,
+			Kind:       instancetype.VirtualMachineInstancetypeKind,
+			ShortNames: []string{"vminstancetype", "vminstancetypes", "vmf", "vmfs"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineClusterInstancetypeCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINECLUSTERINSTANCETYPE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   instancetypev1.SchemeGroupVersion.Group,
+		Version: instancetypev1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    instancetypev1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Cluster",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     instancetype.ClusterPluralResourceName,
+			Singular:   instancetype.ClusterSingularResourceName,
+			Kind:       instancetype.VirtualMachineClusterInstancetypeKind,
+			ShortNames: []string{"vmclusterinstancetype", "vmclusterinstancetypes", "vmcf", "vmcfs"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachinePreferenceCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEPREFERENCE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   instancetypev1.SchemeGroupVersion.Group,
+		Version: instancetypev1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    instancetypev1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     instancetype.PluralPreferenceResourceName,
+			Singular:   instancetype.SingularPreferenceResourceName,
+			Kind:       instancetype.VirtualMachinePreferenceKind,
+			ShortNames: []string{"vmpref", "vmprefs", "vmp", "vmps"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineClusterPreferenceCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINECLUSTERPREFERENCE
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   instancetypev1.SchemeGroupVersion.Group,
+		Version: instancetypev1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    instancetypev1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Cluster",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     instancetype.ClusterPluralPreferenceResourceName,
+			Singular:   instancetype.ClusterSingularPreferenceResourceName,
+			Kind:       instancetype.VirtualMachineClusterPreferenceKind,
+			ShortNames: []string{"vmcp", "vmcps"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineNetworkPolicyCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINENETWORKPOLICY
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   networkpolicyv1.SchemeGroupVersion.Group,
+		Version: networkpolicyv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    networkpolicyv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinenetworkpolicies",
+			Singular:   "virtualmachinenetworkpolicy",
+			Kind:       "VirtualMachineNetworkPolicy",
+			ShortNames: []string{"vmnetpol", "vmnetpols"},
+			Categories: []string{
+				"all",
+			},
+		},
+		Subresources: &extv1beta1.CustomResourceSubresources{
+			Status: &extv1beta1.CustomResourceSubresourceStatus{},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewPresetCrd() (*extv1beta1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 
@@ -418,6 +782,129 @@ func NewVirtualMachineRestoreCrd() (*extv1beta1.CustomResourceDefinition, error)
 	return crd, nil
 }
 
+func NewVirtualMachineRestoreGrantCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = "virtualmachinerestoregrants." + snapshotv1.SchemeGroupVersion.Group
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   snapshotv1.SchemeGroupVersion.Group,
+		Version: snapshotv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinerestoregrants",
+			Singular:   "virtualmachinerestoregrant",
+			Kind:       "VirtualMachineRestoreGrant",
+			ShortNames: []string{"vmrestoregrant", "vmrestoregrants"},
+			Categories: []string{
+				"all",
+			},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineSnapshotScheduleCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = "virtualmachinesnapshotschedules." + snapshotv1.SchemeGroupVersion.Group
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   snapshotv1.SchemeGroupVersion.Group,
+		Version: snapshotv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    snapshotv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinesnapshotschedules",
+			Singular:   "virtualmachinesnapshotschedule",
+			Kind:       "VirtualMachineSnapshotSchedule",
+			ShortNames: []string{"vmsnapshotschedule", "vmsnapshotschedules", "vmss"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "Schedule", Type: "string", JSONPath: ".spec.schedule"},
+			{Name: "Disabled", Type: "boolean", JSONPath: ".spec.disabled"},
+			{Name: "LastSuccess", Type: "date", JSONPath: ".status.lastSuccessTime"},
+			{Name: "NextRun", Type: "date", JSONPath: ".status.nextRunTime"},
+			{Name: "Error", Type: "string", JSONPath: ".status.lastFailure.message"},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachinePoolCrd() (*extv1beta1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+	labelSelector := ".status.labelSelector"
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEPOOL
+	crd.Spec = extv1beta1.CustomResourceDefinitionSpec{
+		Group:   poolv1.SchemeGroupVersion.Group,
+		Version: poolv1.SchemeGroupVersion.Version,
+		Versions: []extv1beta1.CustomResourceDefinitionVersion{
+			{
+				Name:    poolv1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: "Namespaced",
+		Names: extv1beta1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinepools",
+			Singular:   "virtualmachinepool",
+			Kind:       "VirtualMachinePool",
+			ShortNames: []string{"vmpool", "vmpools"},
+			Categories: []string{
+				"all",
+			},
+		},
+		AdditionalPrinterColumns: []extv1beta1.CustomResourceColumnDefinition{
+			{Name: "Desired", Type: "integer", JSONPath: ".spec.replicas",
+				Description: "Number of desired VirtualMachines"},
+			{Name: "Current", Type: "integer", JSONPath: ".status.replicas",
+				Description: "Number of managed VirtualMachines"},
+			{Name: "Ready", Type: "integer", JSONPath: ".status.readyReplicas",
+				Description: "Number of managed VirtualMachines with a ready VirtualMachineInstance"},
+			{Name: "Updated", Type: "integer", JSONPath: ".status.updatedReplicas",
+				Description: "Number of managed VirtualMachines running the latest template"},
+			{Name: "Age", Type: "date", JSONPath: ".metadata.creationTimestamp"},
+		},
+		Subresources: &extv1beta1.CustomResourceSubresources{
+			Scale: &extv1beta1.CustomResourceSubresourceScale{
+				SpecReplicasPath:   ".spec.replicas",
+				StatusReplicasPath: ".status.replicas",
+				LabelSelectorPath:  &labelSelector,
+			},
+			Status: &extv1beta1.CustomResourceSubresourceStatus{},
+		},
+	}
+
+	if err := patchValidation(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewServiceMonitorCR(namespace string, monitorNamespace string, insecureSkipVerify bool) *promv1.ServiceMonitor {
 	return &promv1.ServiceMonitor{
 		TypeMeta: metav1.TypeMeta{
@@ -357,7 +357,7 @@ func NewControllerDeployment(namespace string, repository string, imagePrefix st
 	return deployment, nil
 }
 
-func NewHandlerDaemonSet(namespace string, repository string, imagePrefix string, version string, productName string, productVersion string, pullPolicy corev1.PullPolicy, verbosity string, extraEnv map[string]string) (*appsv1.DaemonSet, error) {
+func NewHandlerDaemonSet(namespace string, repository string, imagePrefix string, version string, launcherVersion string, productName string, productVersion string, pullPolicy corev1.PullPolicy, verbosity string, extraEnv map[string]string) (*appsv1.DaemonSet, error) {
 
 	deploymentName := "virt-handler"
 	imageName := fmt.Sprintf("%s%s", imagePrefix, deploymentName)
@@ -404,6 +404,33 @@ func NewHandlerDaemonSet(namespace string, repository string, imagePrefix string
 	pod.ServiceAccountName = rbac.HandlerServiceAccountName
 	pod.HostPID = true
 
+	// The node-labeller init container starts libvirt from the virt-launcher
+	// image and stores the capabilities of the node for virt-handler
+	launcherVersion = AddVersionSeparatorPrefix(launcherVersion)
+	pod.InitContainers = []corev1.Container{
+		{
+			Name:            "virt-launcher",
+			Image:           fmt.Sprintf("%s/%s%s%s", repository, imagePrefix, "virt-launcher", launcherVersion),
+			ImagePullPolicy: pullPolicy,
+			Command: []string{
+				"/bin/sh",
+				"-c",
+			},
+			Args: []string{
+				"node-labeller.sh",
+			},
+			SecurityContext: &corev1.SecurityContext{
+				Privileged: boolPtr(true),
+			},
+			VolumeMounts: []corev1.VolumeMount{
+				{
+					Name:      "node-labeller",
+					MountPath: "/var/lib/kubevirt-node-labeller",
+				},
+			},
+		},
+	}
+
 	container := &pod.Containers[0]
 	container.Command = []string{
 		"virt-handler",
@@ -528,6 +555,17 @@ func NewHandlerDaemonSet(namespace string, repository string, imagePrefix string
 		})
 	}
 
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      "node-labeller",
+		MountPath: "/var/lib/kubevirt-node-labeller",
+	})
+	pod.Volumes = append(pod.Volumes, corev1.Volume{
+		Name: "node-labeller",
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+
 	return daemonset, nil
 
 }
@@ -344,12 +344,37 @@ var CRDsValidation map[string]string = map[string]string{
             network:
               description: NetworkConfiguration holds network options
               properties:
+                binding:
+                  additionalProperties:
+                    description: InterfaceBindingPlugin describes a network binding plugin
+                    properties:
+                      networkAttachmentDefinition:
+                        description: 'NetworkAttachmentDefinition references a NetworkAttachmentDefinition which sets up the pod network for the binding. It is invoked by Multus with the name of the network of the interface in the "logicNetworkName" CNI argument. Format: <name>, <namespace>/<name>. If namespace is not specified, the VMI namespace is assumed.'
+                        type: string
+                      sidecarImage:
+                        description: SidecarImage references a container image which runs in the virt-launcher pod. The sidecar implements the hooks API and contributes the libvirt interface definition of the interfaces using the binding on OnDefineDomain.
+                        type: string
+                    type: object
+                  description: Binding registers the network binding plugins which interfaces may select by name.
+                  type: object
                 defaultNetworkInterface:
                   type: string
                 permitBridgeInterfaceOnPodNetwork:
                   type: boolean
                 permitSlirpInterface:
                   type: boolean
+                secondaryNetworkDNS:
+                  description: SecondaryNetworkDNS configures the DNS records published for the interfaces of VMIs on secondary networks. The records are only published when the SecondaryNetworkDNS feature gate is enabled.
+                  properties:
+                    namePattern:
+                      description: NamePattern is the name of the record published for each address of an interface. The placeholders {interface}, {vmi}, {namespace} and {network} are replaced by the name of the interface, the VMI, its namespace and the NetworkAttachmentDefinition of the network. Defaults to {interface}.{vmi}.{namespace}.{network}
+                      type: string
+                  type: object
+              type: object
+            obsoleteCPUModels:
+              additionalProperties:
+                type: boolean
+              description: ObsoleteCPUModels lists the CPU models which are not labelled on nodes. Set a model to false to stop treating it as obsolete.
               type: object
             ovmfPath:
               type: string
@@ -1281,6 +1306,110 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"migrationpolicy": `openAPIV3Schema:
+  description: MigrationPolicy overrides the cluster wide migration configuration for the VirtualMachineInstances it selects
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: MigrationPolicySpec is the spec for a MigrationPolicy resource. Unset fields keep the value of the cluster wide migration configuration.
+      properties:
+        allowAutoConverge:
+          type: boolean
+        allowPostCopy:
+          type: boolean
+        bandwidthPerMigration:
+          anyOf:
+          - type: integer
+          - type: string
+          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+          x-kubernetes-int-or-string: true
+        completionTimeoutPerGiB:
+          format: int64
+          type: integer
+        progressTimeout:
+          format: int64
+          type: integer
+        selectors:
+          description: Selectors select the VirtualMachineInstances the policy applies to
+          properties:
+            namespaceSelector:
+              description: A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. An empty label selector matches all objects. A null label selector matches no objects.
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                  items:
+                    description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies to.
+                        type: string
+                      operator:
+                        description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                        type: string
+                      values:
+                        description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                        items:
+                          type: string
+                        type: array
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                  type: object
+              type: object
+            virtualMachineInstanceSelector:
+              description: A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. An empty label selector matches all objects. A null label selector matches no objects.
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                  items:
+                    description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies to.
+                        type: string
+                      operator:
+                        description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                        type: string
+                      values:
+                        description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                        items:
+                          type: string
+                        type: array
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                  type: object
+              type: object
+          type: object
+      required:
+      - selectors
+      type: object
+    status:
+      description: MigrationPolicyStatus is the status for a MigrationPolicy resource
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachine": `openAPIV3Schema:
   description: VirtualMachine handles the VirtualMachines that are not running or are in a stopped state The VirtualMachine contains the template to create the VirtualMachineInstance. It also mirrors the running state of the created VirtualMachineInstance in its status.
@@ -1521,6 +1650,36 @@ var CRDsValidation map[string]string = map[string]string{
             - spec
             type: object
           type: array
+        instancetype:
+          description: InstancetypeMatcher references a instancetype that is used to fill fields in Template
+          properties:
+            kind:
+              description: 'Kind specifies which instancetype resource is referenced. Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype". If not specified, "VirtualMachineClusterInstancetype" is used by default.'
+              type: string
+            name:
+              description: Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype
+              type: string
+            revisionName:
+              description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+              type: string
+          required:
+          - name
+          type: object
+        preference:
+          description: PreferenceMatcher references a set of preference that is used to fill fields in Template
+          properties:
+            kind:
+              description: 'Kind specifies which preference resource is referenced. Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference". If not specified, "VirtualMachineClusterPreference" is used by default.'
+              type: string
+            name:
+              description: Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference
+              type: string
+            revisionName:
+              description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+              type: string
+          required:
+          - name
+          type: object
         runStrategy:
           description: Running state indicates the requested running state of the VirtualMachineInstance mutually exclusive with Running
           type: string
@@ -2147,6 +2306,9 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
                                     type: string
                                 type: object
+                              changedBlockTracking:
+                                description: ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup, in a persistent dirty bitmap. Only disks with changed block tracking are backed up. Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks. Defaults to false.
+                                type: boolean
                               dedicatedIOThread:
                                 description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
                                 type: boolean
@@ -2176,6 +2338,110 @@ var CRDsValidation map[string]string = map[string]string{
                               io:
                                 description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
                                 type: string
+                              ioTune:
+                                description: IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs through the setblockiotune subresource, the limits in effect are reported in the volume status.
+                                properties:
+                                  groupName:
+                                    description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
+                                    type: string
+                                  readBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readBytesSecMax:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readBytesSecMaxLength:
+                                    description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec is the limit of read operations per second.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMax:
+                                    description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMaxLength:
+                                    description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  sizeIOPSSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalBytesSecMax:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalBytesSecMaxLength:
+                                    description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec is the limit of read and write operations per second.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMax:
+                                    description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMaxLength:
+                                    description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeBytesSecMax:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeBytesSecMaxLength:
+                                    description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec is the limit of write operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMax:
+                                    description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMaxLength:
+                                    description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -2267,6 +2533,73 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Interfaces describe network interfaces which are added to the vmi.
                           items:
                             properties:
+                              bandwidth:
+                                description: If specified, the traffic of the interface is limited. Only interfaces backed by a tap device, bridge, masquerade and macvtap, can be limited.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes which can be sent at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes which can be sent at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
+                              binding:
+                                description: Binding specifies the binding plugin which will be used to connect the interface to the guest. The plugin must be registered in the network configuration of KubeVirt. It is mutually exclusive with the BindingMethod.
+                                properties:
+                                  name:
+                                    description: Name references a binding plugin registered in the network configuration of KubeVirt.
+                                    type: string
+                                required:
+                                - name
+                                type: object
                               bootOrder:
                                 description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
                                 type: integer
@@ -2299,6 +2632,44 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  routerAdvertisements:
+                                    description: If set the IPv6 network of the interface is announced with router advertisements, so that the VM learns its default route and the prefix of the network. The advertisements need the NET_RAW capability, which is then granted to the virt-launcher pod. Implied by slaac.
+                                    type: boolean
+                                  routes:
+                                    description: If specified will pass the routes to the VM in addition to the routes of the pod network. IPv4 routes are passed via DHCP option 121, IPv6 routes via the route information of IPv6 router advertisements.
+                                    items:
+                                      description: DHCPRoute is a route passed to the VM.
+                                      properties:
+                                        destination:
+                                          description: Destination of the route in CIDR notation, e.g. 192.168.20.0/24.
+                                          type: string
+                                        gateway:
+                                          description: Gateway of an IPv4 route, the destination is on link when not set. IPv6 routes always go through the router of the interface.
+                                          type: string
+                                      required:
+                                      - destination
+                                      type: object
+                                    type: array
+                                  searchDomains:
+                                    description: If specified will pass the search domains to the VM instead of the search domains of the pod, via DHCP option 119, DHCPv6 option 24 and IPv6 router advertisements.
+                                    items:
+                                      type: string
+                                    type: array
+                                  slaac:
+                                    description: If set the IPv6 network of the interface is advertised for stateless address autoconfiguration (SLAAC), for guests which can not use DHCPv6. Only supported on interfaces with the masquerade binding whose vmIPv6NetworkCIDR has a prefix length of 64.
+                                    type: boolean
+                                  staticLease:
+                                    description: If specified the address is leased to the VM instead of the address of the pod interface. Only supported on interfaces with the bridge binding.
+                                    properties:
+                                      address:
+                                        description: Address with its prefix length in CIDR notation, e.g. 192.168.10.5/24.
+                                        type: string
+                                      gateway:
+                                        description: Gateway passed to the VM as its default route.
+                                        type: string
+                                    required:
+                                    - address
+                                    type: object
                                   tftpServerName:
                                     description: If specified will pass option 66 to interface's DHCP server
                                     type: string
@@ -2309,6 +2680,41 @@ var CRDsValidation map[string]string = map[string]string{
                               macvtap:
                                 type: object
                               masquerade:
+                                properties:
+                                  blockedDestinations:
+                                    description: BlockedDestinations lists networks, in CIDR notation, which the guest must not reach through the masquerade.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  portMappings:
+                                    description: PortMappings forward ports, or ranges of ports, of the pod to the guest. Unless ports or port mappings are listed, all ports are forwarded.
+                                    items:
+                                      description: MasqueradePortMapping forwards a port, or a range of ports, of the pod to the guest.
+                                      properties:
+                                        endPort:
+                                          description: EndPort is the last port of a range of ports, which are forwarded to the same ports of the guest.
+                                          format: int32
+                                          type: integer
+                                        guestPort:
+                                          description: GuestPort is the port of the guest the pod port is forwarded to. Defaults to Port. Can not be combined with EndPort.
+                                          format: int32
+                                          type: integer
+                                        name:
+                                          description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Only mappings of a single port are named.
+                                          type: string
+                                        port:
+                                          description: Port of the pod, the first port of the range when EndPort is set. This must be a valid port number, 0 < x < 65536.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: Protocol of the mapping. Must be TCP, UDP or SCTP. Defaults to "TCP".
+                                          type: string
+                                      required:
+                                      - port
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                 type: object
                               model:
                                 description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
@@ -2341,6 +2747,10 @@ var CRDsValidation map[string]string = map[string]string{
                               slirp:
                                 type: object
                               sriov:
+                                properties:
+                                  standbyInterface:
+                                    description: StandbyInterface is the name of a virtio interface with the bridge binding, connected to the same network, which takes over the traffic of the guest while the VF is unplugged during a live migration. Both interfaces must have the same MAC address.
+                                    type: string
                                 type: object
                               tag:
                                 description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
@@ -2349,6 +2759,10 @@ var CRDsValidation map[string]string = map[string]string{
                             - name
                             type: object
                           type: array
+                        maxHotpluggedDisks:
+                          description: MaxHotpluggedDisks is the number of disks which can be hotplugged on the virtio bus. A PCIe root port is reserved for each of them when the VirtualMachineInstance starts. Defaults to 0, which only allows to hotplug disks on the scsi bus.
+                          format: int32
+                          type: integer
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                           type: boolean
@@ -2717,6 +3131,9 @@ var CRDsValidation map[string]string = map[string]string{
                       pod:
                         description: Represents the stock pod network interface.
                         properties:
+                          vmIPv6NetworkCIDR:
+                            description: IPv6 CIDR for the vm network. Default fd10:0:2::/120 if not specified.
+                            type: string
                           vmNetworkCIDR:
                             description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
                             type: string
@@ -3149,6 +3566,35 @@ var CRDsValidation map[string]string = map[string]string{
         created:
           description: Created indicates if the virtual machine is created in the cluster
           type: boolean
+        interfaceRequests:
+          description: InterfaceRequests indicates a list of network interfaces to add or remove from the VMI template and hotplug on an active running VMI.
+          items:
+            properties:
+              addInterfaceOptions:
+                description: AddInterfaceOptions when set indicates a network interface should be added. The details within this field specify how to add the interface
+                properties:
+                  name:
+                    description: Name indicates the logical name of the interface, which is also used as the name of the network it is connected to.
+                    type: string
+                  networkAttachmentDefinitionName:
+                    description: 'NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition CRD object. Format: <networkAttachmentDefinitionName>, <namespace>/<networkAttachmentDefinitionName>. If namespace is not specified, VMI namespace is assumed.'
+                    type: string
+                required:
+                - name
+                - networkAttachmentDefinitionName
+                type: object
+              removeInterfaceOptions:
+                description: RemoveInterfaceOptions when set indicates a network interface should be removed. The details within this field specify how to remove the interface
+                properties:
+                  name:
+                    description: Name indicates the logical name of the interface, which maps to both the interface and the network that should be removed
+                    type: string
+                required:
+                - name
+                type: object
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         ready:
           description: Ready indicates if the virtual machine is running and ready
           type: boolean
@@ -3203,6 +3649,9 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
                             type: string
                         type: object
+                      changedBlockTracking:
+                        description: ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup, in a persistent dirty bitmap. Only disks with changed block tracking are backed up. Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks. Defaults to false.
+                        type: boolean
                       dedicatedIOThread:
                         description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
                         type: boolean
@@ -3232,23 +3681,127 @@ var CRDsValidation map[string]string = map[string]string{
                       io:
                         description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
                         type: string
-                      lun:
-                        description: Attach a volume as a LUN to the vmi.
+                      ioTune:
+                        description: IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs through the setblockiotune subresource, the limits in effect are reported in the volume status.
                         properties:
-                          bus:
-                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                          groupName:
+                            description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
                             type: string
-                          readonly:
-                            description: ReadOnly. Defaults to false.
-                            type: boolean
-                        type: object
-                      name:
-                        description: Name is the device name
-                        type: string
-                      serial:
-                        description: Serial provides the ability to specify a serial number for the disk device.
-                        type: string
-                      tag:
+                          readBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readBytesSecMaxLength:
+                            description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the limit of read operations per second.
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          readIOPSSecMaxLength:
+                            description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          sizeIOPSSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSecMaxLength:
+                            description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the limit of read and write operations per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMaxLength:
+                            description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeBytesSecMaxLength:
+                            description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the limit of write operations per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMaxLength:
+                            description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                        type: object
+                      lun:
+                        description: Attach a volume as a LUN to the vmi.
+                        properties:
+                          bus:
+                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                            type: string
+                          readonly:
+                            description: ReadOnly. Defaults to false.
+                            type: boolean
+                        type: object
+                      name:
+                        description: Name is the device name
+                        type: string
+                      serial:
+                        description: Serial provides the ability to specify a serial number for the disk device.
+                        type: string
+                      tag:
                         description: If specified, disk address and its tag will be provided to the guest via config drive metadata
                         type: string
                     required:
@@ -3287,6 +3840,50 @@ var CRDsValidation map[string]string = map[string]string{
                 - name
                 - volumeSource
                 type: object
+              ejectCDRomOptions:
+                description: EjectCDRomOptions when set indicates the media of a CD-ROM should be ejected. The details within this field specify the CD-ROM
+                properties:
+                  name:
+                    description: Name represents the name of the CD-ROM disk, and of the volume of its media
+                    type: string
+                required:
+                - name
+                type: object
+              insertCDRomOptions:
+                description: InsertCDRomOptions when set indicates a media should be inserted into a CD-ROM. The details within this field specify the CD-ROM and the media
+                properties:
+                  name:
+                    description: Name represents the name of the CD-ROM disk. It is also the name of the volume of the media
+                    type: string
+                  volumeSource:
+                    description: VolumeSource represents the source of the media
+                    properties:
+                      dataVolume:
+                        description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
+                        properties:
+                          name:
+                            description: Name represents the name of the DataVolume in the same namespace
+                            type: string
+                        required:
+                        - name
+                        type: object
+                      persistentVolumeClaim:
+                        description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                        properties:
+                          claimName:
+                            description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            type: string
+                          readOnly:
+                            description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
+                    type: object
+                required:
+                - name
+                - volumeSource
+                type: object
               removeVolumeOptions:
                 description: RemoveVolumeOptions when set indicates a volume should be removed. The details within this field specify how to add the volume
                 properties:
@@ -3322,8 +3919,8 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"virtualmachineinstance": `openAPIV3Schema:
-  description: VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.
+	"virtualmachinebackup": `openAPIV3Schema:
+  description: VirtualMachineBackup backs up the disks with changed block tracking of a running VirtualMachine, either fully or incrementally to a previous backup
   properties:
     apiVersion:
       description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
@@ -3334,140 +3931,1041 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
-      description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
+      description: VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource
       properties:
-        accessCredentials:
-          description: Specifies a set of public keys to inject into the vm guest
+        incrementalFrom:
+          description: IncrementalFrom is the name of a succeeded VirtualMachineBackup of the same source. Only the blocks which changed since its checkpoint are backed up. A full backup is taken if it is not set.
+          type: string
+        mode:
+          description: Mode is either Push, the default, or Pull. A push backup is written by libvirt to the PersistentVolumeClaim PVCName, the disks of a pull backup are exported through an NBD server until TTLDuration passed.
+          type: string
+        pvcName:
+          description: PVCName is the name of the filesystem PersistentVolumeClaim a push backup is written to. Every backup is written to a directory of its own, so a claim can hold many backups.
+          type: string
+        source:
+          description: Source is the VirtualMachine of the kubevirt.io group which is backed up. Only the disks with changedBlockTracking are part of the backup.
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        ttlDuration:
+          description: TTLDuration is how long the disks of a pull backup are exported. Defaults to 1h.
+          type: string
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource
+      properties:
+        checkpoint:
+          description: Checkpoint is the name of the checkpoint created by the backup. Later backups can be incremental to it.
+          type: string
+        checkpointChain:
+          description: CheckpointChain is the chain of checkpoints the state of the disks at this backup is restored from. It starts with the checkpoint of the full backup, and ends with the one of this backup.
           items:
-            description: AccessCredential represents a credential source that can be used to authorize remote access to the vm guest Only one of its members may be specified.
+            description: VirtualMachineBackupCheckpoint is a checkpoint in the chain of a VirtualMachineBackup
             properties:
-              sshPublicKey:
-                description: SSHPublicKey represents the source and method of applying a ssh public key into a guest virtual machine.
-                properties:
-                  propagationMethod:
-                    description: PropagationMethod represents how the public key is injected into the vm guest.
-                    properties:
-                      configDrive:
-                        description: ConfigDrivePropagation means that the ssh public keys are injected into the VM using metadata using the configDrive cloud-init provider
-                        type: object
-                      qemuGuestAgent:
-                        description: QemuGuestAgentAccessCredentailPropagation means ssh public keys are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
-                        properties:
-                          users:
-                            description: Users represents a list of guest users that should have the ssh public keys added to their authorized_keys file.
-                            items:
-                              type: string
-                            type: array
-                            x-kubernetes-list-type: set
-                        required:
-                        - users
-                        type: object
-                    type: object
-                  source:
-                    description: Source represents where the public keys are pulled from
-                    properties:
-                      secret:
-                        description: Secret means that the access credential is pulled from a kubernetes secret
-                        properties:
-                          secretName:
-                            description: SecretName represents the name of the secret in the VMI's namespace
-                            type: string
-                        required:
-                        - secretName
-                        type: object
-                    type: object
-                required:
-                - propagationMethod
-                - source
-                type: object
-              userPassword:
-                description: UserPassword represents the source and method for applying a guest user's password
-                properties:
-                  propagationMethod:
-                    description: propagationMethod represents how the user passwords are injected into the vm guest.
-                    properties:
-                      qemuGuestAgent:
-                        description: QemuGuestAgentAccessCredentailPropagation means passwords are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
-                        type: object
-                    type: object
-                  source:
-                    description: Source represents where the user passwords are pulled from
-                    properties:
-                      secret:
-                        description: Secret means that the access credential is pulled from a kubernetes secret
-                        properties:
-                          secretName:
-                            description: SecretName represents the name of the secret in the VMI's namespace
-                            type: string
-                        required:
-                        - secretName
-                        type: object
-                    type: object
-                required:
-                - propagationMethod
-                - source
-                type: object
+              backupName:
+                description: BackupName is the name of the VirtualMachineBackup which created the checkpoint
+                type: string
+              creationTimestamp:
+                description: CreationTimestamp is the time the checkpoint was created
+                format: date-time
+                type: string
+              name:
+                description: Name is the name of the checkpoint
+                type: string
+              pvcName:
+                description: PVCName is the claim the backup was pushed to. It is not set for pull backups.
+                type: string
+              volumes:
+                description: Volumes are the volumes whose changed blocks are tracked since the checkpoint
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+            required:
+            - backupName
+            - creationTimestamp
+            - name
+            - volumes
             type: object
           type: array
           x-kubernetes-list-type: atomic
-        affinity:
-          description: If affinity is specifies, obey all the affinity rules
-          properties:
-            nodeAffinity:
-              description: Describes node affinity scheduling rules for the pod.
-              properties:
-                preferredDuringSchedulingIgnoredDuringExecution:
-                  description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.
-                  items:
-                    description: An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
-                    properties:
-                      preference:
-                        description: A node selector term, associated with the corresponding weight.
-                        properties:
-                          matchExpressions:
-                            description: A list of node selector requirements by node's labels.
-                            items:
-                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                              properties:
-                                key:
-                                  description: The label key that the selector applies to.
-                                  type: string
-                                operator:
-                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
-                                  type: string
-                                values:
-                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
-                                  items:
-                                    type: string
-                                  type: array
-                              required:
-                              - key
-                              - operator
-                              type: object
-                            type: array
-                          matchFields:
-                            description: A list of node selector requirements by node's fields.
-                            items:
-                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
-                              properties:
-                                key:
-                                  description: The label key that the selector applies to.
-                                  type: string
-                                operator:
-                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
-                                  type: string
-                                values:
-                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
-                                  items:
-                                    type: string
-                                  type: array
-                              required:
-                              - key
-                              - operator
-                              type: object
-                            type: array
-                        type: object
-                      weight:
+        completionTime:
+          format: date-time
+          nullable: true
+          type: string
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        phase:
+          description: BackupPhase is the phase of a VirtualMachineBackup or VirtualMachineBackupRestore
+          type: string
+        startTime:
+          format: date-time
+          nullable: true
+          type: string
+        type:
+          description: BackupType is the type of a VirtualMachineBackup
+          type: string
+        volumes:
+          description: Volumes are the backed up volumes
+          items:
+            description: VirtualMachineBackupVolume is a backed up volume
+            properties:
+              diskTarget:
+                description: 'DiskTarget is the target of the disk of the volume in the domain, eg: vda'
+                type: string
+              exportName:
+                description: ExportName is the name of the NBD export of the volume of a pull backup
+                type: string
+              path:
+                description: Path is the qcow2 image a push backup of the volume is written to, relative to the root of the PersistentVolumeClaim
+                type: string
+              volumeName:
+                description: VolumeName is the name of the volume in the VirtualMachine
+                type: string
+            required:
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - volumeName
+          x-kubernetes-list-type: map
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinebackuprestore": `openAPIV3Schema:
+  description: VirtualMachineBackupRestore restores the volumes of a push VirtualMachineBackup to PersistentVolumeClaims, by replaying the backups of its checkpoint chain onto the full backup
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineBackupRestoreSpec is the spec for a VirtualMachineBackupRestore resource
+      properties:
+        backupName:
+          description: BackupName is the name of the VirtualMachineBackup whose state of the disks is restored. The VirtualMachineBackups of its checkpoint chain do not have to exist anymore, but their files have to.
+          type: string
+        volumes:
+          description: Volumes are the backed up volumes which are restored, and the claims they are restored to
+          items:
+            description: VirtualMachineBackupRestoreVolume maps a backed up volume to the claim it is restored to
+            properties:
+              pvcName:
+                description: PVCName is the name of the PersistentVolumeClaim the volume is restored to. Its content is overwritten with a raw disk image.
+                type: string
+              volumeName:
+                description: VolumeName is the name of the backed up volume
+                type: string
+            required:
+            - pvcName
+            - volumeName
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - volumeName
+          x-kubernetes-list-type: map
+      required:
+      - backupName
+      - volumes
+      type: object
+    status:
+      description: VirtualMachineBackupRestoreStatus is the status for a VirtualMachineBackupRestore resource
+      properties:
+        completionTime:
+          format: date-time
+          nullable: true
+          type: string
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        phase:
+          description: BackupPhase is the phase of a VirtualMachineBackup or VirtualMachineBackupRestore
+          type: string
+        startTime:
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclone": `openAPIV3Schema:
+  description: VirtualMachineClone creates a new VirtualMachine from an existing VirtualMachine or VirtualMachineSnapshot
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineCloneSpec is the spec for a VirtualMachineClone resource
+      properties:
+        annotationFilters:
+          description: AnnotationFilters select which annotations of the source are copied to the target. A filter is a key which may contain "*" wildcards, a filter prefixed with "!" excludes the matching keys. By default all annotations are copied.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        labelFilters:
+          description: LabelFilters select which labels of the source are copied to the target. The syntax is the same as for AnnotationFilters.
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: atomic
+        nameTemplate:
+          description: NameTemplate is a Go template which generates the name of the target when no target is given. The template may reference {{.SourceName}}, {{.CloneName}} and {{.UniqueSuffix}}. Defaults to "{{.SourceName}}-clone-{{.UniqueSuffix}}".
+          type: string
+        newMacAddresses:
+          additionalProperties:
+            type: string
+          description: NewMacAddresses maps interface names to the MAC addresses they get in the target. Interfaces which are not listed get a new MAC address assigned.
+          type: object
+        newSMBiosSerial:
+          description: NewSMBiosSerial is the SMBIOS serial of the target. If it is not set, the serial of the source is dropped.
+          type: string
+        source:
+          description: Source is the object that is cloned. Supported kinds are VirtualMachine of the kubevirt.io group and VirtualMachineSnapshot of the snapshot.kubevirt.io group.
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        target:
+          description: Target is the VirtualMachine that is created by the clone. If the target is not set, its name is generated from NameTemplate.
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        template:
+          description: Template holds the filters applied to the metadata of the VirtualMachineInstance template.
+          properties:
+            annotationFilters:
+              description: AnnotationFilters select which annotations of the source template are copied.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            labelFilters:
+              description: LabelFilters select which labels of the source template are copied.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+      required:
+      - source
+      type: object
+    status:
+      description: VirtualMachineCloneStatus is the status for a VirtualMachineClone resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        creationTime:
+          format: date-time
+          nullable: true
+          type: string
+        phase:
+          description: VirtualMachineClonePhase is the phase of a VirtualMachineClone
+          type: string
+        snapshotName:
+          description: SnapshotName is the VirtualMachineSnapshot the target is restored from
+          type: string
+        targetName:
+          description: TargetName is the name of the target VirtualMachine
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclusterinstancetype": `openAPIV3Schema:
+  description: VirtualMachineClusterInstancetype is a cluster scoped version of VirtualMachineInstancetype resource.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the instancetype
+      properties:
+        cpu:
+          description: Required CPU related attributes of the instancetype.
+          properties:
+            dedicatedCPUPlacement:
+              description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
+              type: boolean
+            guest:
+              description: Required number of vCPUs to expose to the guest. The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+              format: int32
+              type: integer
+            isolateEmulatorThread:
+              description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
+              type: boolean
+            model:
+              description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
+              type: string
+          required:
+          - guest
+          type: object
+        gpus:
+          description: Optionally defines any GPU devices associated with the instancetype.
+          items:
+            properties:
+              deviceName:
+                type: string
+              name:
+                description: Name of the GPU device as exposed by a device plugin
+                type: string
+            required:
+            - deviceName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        hostDevices:
+          description: Optionally defines any HostDevices associated with the instancetype.
+          items:
+            properties:
+              deviceName:
+                description: DeviceName is the resource name of the host device exposed by a device plugin
+                type: string
+              name:
+                type: string
+            required:
+            - deviceName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        ioThreadsPolicy:
+          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
+          type: string
+        memory:
+          description: Required Memory related attributes of the instancetype.
+          properties:
+            guest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Required amount of memory which is visible inside the guest OS.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            hugepages:
+              description: Optionally enables the use of hugepages for the VirtualMachineInstance instead of regular memory.
+              properties:
+                pageSize:
+                  description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
+                  type: string
+              type: object
+          required:
+          - guest
+          type: object
+      required:
+      - cpu
+      - memory
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineclusterpreference": `openAPIV3Schema:
+  description: VirtualMachineClusterPreference is a cluster scoped version of the VirtualMachinePreference resource.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the preferences
+      properties:
+        clock:
+          description: Clock optionally defines preferences associated with the Clock attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredClockOffset:
+              description: ClockOffset allows specifying the UTC offset or the timezone of the guest clock.
+              properties:
+                timezone:
+                  description: Timezone sets the guest clock to the specified timezone. Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                  type: string
+                utc:
+                  description: UTC sets the guest clock to UTC on each boot. If an offset is specified, guest changes to the clock will be kept during reboots and are not reset.
+                  properties:
+                    offsetSeconds:
+                      description: OffsetSeconds specifies an offset in seconds, relative to UTC. If set, guest changes to the clock will be kept during reboots and not reset.
+                      type: integer
+                  type: object
+              type: object
+            preferredTimer:
+              description: Timer specifies which timers are attached to the vmi.
+              properties:
+                hpet:
+                  description: HPET (High Precision Event Timer) - multiple timers with periodic interrupts.
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                    tickPolicy:
+                      description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "merge", "discard".
+                      type: string
+                  type: object
+                hyperv:
+                  description: Hyperv (Hypervclock) - lets guests read the host’s wall clock time (paravirtualized). For windows guests.
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                  type: object
+                kvm:
+                  description: "KVM \t(KVM clock) - lets guests read the host’s wall clock time (paravirtualized). For linux guests."
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                  type: object
+                pit:
+                  description: PIT (Programmable Interval Timer) - a timer with periodic interrupts.
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                    tickPolicy:
+                      description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "discard".
+                      type: string
+                  type: object
+                rtc:
+                  description: RTC (Real Time Clock) - a continuously running timer with periodic interrupts.
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                    tickPolicy:
+                      description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup".
+                      type: string
+                    track:
+                      description: Track the guest or the wall clock.
+                      type: string
+                  type: object
+              type: object
+          type: object
+        cpu:
+          description: CPU optionally defines preferences associated with the CPU attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredCPUTopology:
+              description: PreferredCPUTopology optionally defines the preferred guest visible CPU topology, defaults to PreferSockets.
+              type: string
+          type: object
+        devices:
+          description: Devices optionally defines preferences associated with the Devices attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredAutoattachGraphicsDevice:
+              description: PreferredAutoattachGraphicsDevice optionally defines the preferred value of AutoattachGraphicsDevice
+              type: boolean
+            preferredAutoattachMemBalloon:
+              description: PreferredAutoattachMemBalloon optionally defines the preferred value of AutoattachMemBalloon
+              type: boolean
+            preferredAutoattachPodInterface:
+              description: PreferredAutoattachPodInterface optionally defines the preferred value of AutoattachPodInterface
+              type: boolean
+            preferredAutoattachSerialConsole:
+              description: PreferredAutoattachSerialConsole optionally defines the preferred value of AutoattachSerialConsole
+              type: boolean
+            preferredBlockMultiQueue:
+              description: PreferredBlockMultiQueue optionally enables the vhost multiqueue feature for virtio disks.
+              type: boolean
+            preferredCdromBus:
+              description: PreferredCdromBus optionally defines the preferred bus for Cdrom Disk devices.
+              type: string
+            preferredDiskBus:
+              description: PreferredDiskBus optionally defines the preferred bus for Disk Disk devices.
+              type: string
+            preferredInterfaceModel:
+              description: PreferredInterfaceModel optionally defines the preferred model to be used by Interface devices.
+              type: string
+            preferredLunBus:
+              description: PreferredLunBus optionally defines the preferred bus for Lun Disk devices.
+              type: string
+            preferredNetworkInterfaceMultiQueue:
+              description: PreferredNetworkInterfaceMultiQueue optionally enables the vhost multiqueue feature for virtio interfaces.
+              type: boolean
+            preferredRng:
+              description: PreferredRng optionally defines the preferred rng device to be used.
+              type: object
+            preferredUseVirtioTransitional:
+              description: PreferredUseVirtioTransitional optionally defines the preferred value of UseVirtioTransitional
+              type: boolean
+          type: object
+        features:
+          description: Features optionally defines preferences associated with the Features attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredAcpi:
+              description: PreferredAcpi optionally enables the ACPI feature
+              properties:
+                enabled:
+                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                  type: boolean
+              type: object
+            preferredApic:
+              description: PreferredApic optionally enables and configures the APIC feature
+              properties:
+                enabled:
+                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                  type: boolean
+                endOfInterrupt:
+                  description: EndOfInterrupt enables the end of interrupt notification in the guest. Defaults to false.
+                  type: boolean
+              type: object
+            preferredHyperv:
+              description: PreferredHyperv optionally enables and configures HyperV features
+              properties:
+                evmcs:
+                  description: EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                frequencies:
+                  description: Frequencies improves the TSC clock source handling for Hyper-V on KVM. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                ipi:
+                  description: IPI improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                reenlightenment:
+                  description: Reenlightenment enables the notifications on TSC frequency changes. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                relaxed:
+                  description: Relaxed instructs the guest OS to disable watchdog timeouts. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                reset:
+                  description: Reset enables Hyperv reboot/reset for the vmi. Requires synic. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                runtime:
+                  description: Runtime improves the time accounting to improve scheduling in the guest. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                spinlocks:
+                  description: Spinlocks allows to configure the spinlock retry attempts.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                    spinlocks:
+                      description: Retries indicates the number of retries. Must be a value greater or equal 4096. Defaults to 4096.
+                      format: int32
+                      type: integer
+                  type: object
+                synic:
+                  description: SyNIC enables the Synthetic Interrupt Controller. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                synictimer:
+                  description: SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                tlbflush:
+                  description: TLBFlush improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                vapic:
+                  description: VAPIC improves the paravirtualized handling of interrupts. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                vendorid:
+                  description: VendorID allows setting the hypervisor vendor id. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                    vendorid:
+                      description: VendorID sets the hypervisor vendor id, visible to the vmi. String up to twelve characters.
+                      type: string
+                  type: object
+                vpindex:
+                  description: VPIndex enables the Virtual Processor Index to help windows identifying virtual processors. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+              type: object
+            preferredSmm:
+              description: PreferredSmm optionally enables the SMM feature
+              properties:
+                enabled:
+                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                  type: boolean
+              type: object
+          type: object
+        firmware:
+          description: Firmware optionally defines preferences associated with the Firmware attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredUseBios:
+              description: PreferredUseBios optionally enables BIOS
+              type: boolean
+            preferredUseBiosSerial:
+              description: "PreferredUseBiosSerial optionally transmits BIOS output over the serial. \n Requires PreferredUseBios to be enabled."
+              type: boolean
+            preferredUseEfi:
+              description: PreferredUseEfi optionally enables EFI
+              type: boolean
+            preferredUseSecureBoot:
+              description: "PreferredUseSecureBoot optionally enables SecureBoot and the OVMF roms will be swapped for SecureBoot-enabled ones. \n Requires PreferredUseEfi and PreferredSmm to be enabled."
+              type: boolean
+          type: object
+        machine:
+          description: Machine optionally defines preferences associated with the Machine attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredMachineType:
+              description: PreferredMachineType optionally defines the preferred machine type to use.
+              type: string
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineexport": `openAPIV3Schema:
+  description: VirtualMachineExport exports the volumes and the definition of a VirtualMachine, a VirtualMachineSnapshot or a PersistentVolumeClaim over HTTPS
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineExportSpec is the spec for a VirtualMachineExport resource
+      properties:
+        source:
+          description: Source is the object that is exported. Supported kinds are VirtualMachine of the kubevirt.io group, VirtualMachineSnapshot of the snapshot.kubevirt.io group and PersistentVolumeClaim of the core group. A VirtualMachine is only exported while it is stopped.
+          properties:
+            apiGroup:
+              description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+              type: string
+            kind:
+              description: Kind is the type of resource being referenced
+              type: string
+            name:
+              description: Name is the name of resource being referenced
+              type: string
+          required:
+          - kind
+          - name
+          type: object
+        tokenSecretRef:
+          description: TokenSecretRef is the name of the Secret holding the token in its "token" key. Clients have to present the token in the x-kubevirt-export-token header or query parameter.
+          type: string
+      required:
+      - source
+      - tokenSecretRef
+      type: object
+    status:
+      description: VirtualMachineExportStatus is the status for a VirtualMachineExport resource
+      properties:
+        conditions:
+          items:
+            description: Condition defines conditions
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                description: ConditionType is the const type for Conditions
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        links:
+          description: VirtualMachineExportLinks contains the links to the exported data
+          properties:
+            internal:
+              description: Internal links are reachable from within the cluster
+              properties:
+                cert:
+                  description: Cert is the PEM encoded CA certificate of the export server
+                  type: string
+                manifests:
+                  description: Manifests are the exported definitions of the source
+                  items:
+                    description: VirtualMachineExportManifest is the link to an exported definition
+                    properties:
+                      type:
+                        description: ExportManifestType is the type of an exported definition
+                        type: string
+                      url:
+                        type: string
+                    required:
+                    - type
+                    - url
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - type
+                  x-kubernetes-list-type: map
+                volumes:
+                  description: Volumes are the exported volumes
+                  items:
+                    description: VirtualMachineExportVolume contains the links of an exported volume
+                    properties:
+                      formats:
+                        items:
+                          description: VirtualMachineExportVolumeFormat is the link to an exported volume in a format
+                          properties:
+                            format:
+                              description: ExportVolumeFormat is the format an exported volume is served in
+                              type: string
+                            url:
+                              type: string
+                          required:
+                          - format
+                          - url
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - format
+                        x-kubernetes-list-type: map
+                      name:
+                        description: Name is the name of the exported PersistentVolumeClaim
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - name
+                  x-kubernetes-list-type: map
+              required:
+              - cert
+              type: object
+          type: object
+        phase:
+          description: VirtualMachineExportPhase is the phase of a VirtualMachineExport
+          type: string
+        serviceName:
+          description: ServiceName is the name of the Service of the export server
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineinstance": `openAPIV3Schema:
+  description: VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
+      properties:
+        accessCredentials:
+          description: Specifies a set of public keys to inject into the vm guest
+          items:
+            description: AccessCredential represents a credential source that can be used to authorize remote access to the vm guest Only one of its members may be specified.
+            properties:
+              sshPublicKey:
+                description: SSHPublicKey represents the source and method of applying a ssh public key into a guest virtual machine.
+                properties:
+                  propagationMethod:
+                    description: PropagationMethod represents how the public key is injected into the vm guest.
+                    properties:
+                      configDrive:
+                        description: ConfigDrivePropagation means that the ssh public keys are injected into the VM using metadata using the configDrive cloud-init provider
+                        type: object
+                      qemuGuestAgent:
+                        description: QemuGuestAgentAccessCredentailPropagation means ssh public keys are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                        properties:
+                          users:
+                            description: Users represents a list of guest users that should have the ssh public keys added to their authorized_keys file.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: set
+                        required:
+                        - users
+                        type: object
+                    type: object
+                  source:
+                    description: Source represents where the public keys are pulled from
+                    properties:
+                      secret:
+                        description: Secret means that the access credential is pulled from a kubernetes secret
+                        properties:
+                          secretName:
+                            description: SecretName represents the name of the secret in the VMI's namespace
+                            type: string
+                        required:
+                        - secretName
+                        type: object
+                    type: object
+                required:
+                - propagationMethod
+                - source
+                type: object
+              userPassword:
+                description: UserPassword represents the source and method for applying a guest user's password
+                properties:
+                  propagationMethod:
+                    description: propagationMethod represents how the user passwords are injected into the vm guest.
+                    properties:
+                      qemuGuestAgent:
+                        description: QemuGuestAgentAccessCredentailPropagation means passwords are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                        type: object
+                    type: object
+                  source:
+                    description: Source represents where the user passwords are pulled from
+                    properties:
+                      secret:
+                        description: Secret means that the access credential is pulled from a kubernetes secret
+                        properties:
+                          secretName:
+                            description: SecretName represents the name of the secret in the VMI's namespace
+                            type: string
+                        required:
+                        - secretName
+                        type: object
+                    type: object
+                required:
+                - propagationMethod
+                - source
+                type: object
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        affinity:
+          description: If affinity is specifies, obey all the affinity rules
+          properties:
+            nodeAffinity:
+              description: Describes node affinity scheduling rules for the pod.
+              properties:
+                preferredDuringSchedulingIgnoredDuringExecution:
+                  description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.
+                  items:
+                    description: An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+                    properties:
+                      preference:
+                        description: A node selector term, associated with the corresponding weight.
+                        properties:
+                          matchExpressions:
+                            description: A list of node selector requirements by node's labels.
+                            items:
+                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies to.
+                                  type: string
+                                operator:
+                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                          matchFields:
+                            description: A list of node selector requirements by node's fields.
+                            items:
+                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                              properties:
+                                key:
+                                  description: The label key that the selector applies to.
+                                  type: string
+                                operator:
+                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                  type: string
+                                values:
+                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                  items:
+                                    type: string
+                                  type: array
+                              required:
+                              - key
+                              - operator
+                              type: object
+                            type: array
+                        type: object
+                      weight:
                         description: Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.
                         format: int32
                         type: integer
@@ -3946,6 +5444,9 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
                             type: string
                         type: object
+                      changedBlockTracking:
+                        description: ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup, in a persistent dirty bitmap. Only disks with changed block tracking are backed up. Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks. Defaults to false.
+                        type: boolean
                       dedicatedIOThread:
                         description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
                         type: boolean
@@ -3975,6 +5476,110 @@ var CRDsValidation map[string]string = map[string]string{
                       io:
                         description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs through the setblockiotune subresource, the limits in effect are reported in the volume status.
+                        properties:
+                          groupName:
+                            description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
+                            type: string
+                          readBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readBytesSecMaxLength:
+                            description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the limit of read operations per second.
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          readIOPSSecMaxLength:
+                            description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          sizeIOPSSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSecMaxLength:
+                            description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the limit of read and write operations per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMaxLength:
+                            description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeBytesSecMaxLength:
+                            description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the limit of write operations per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMaxLength:
+                            description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -4066,6 +5671,73 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Interfaces describe network interfaces which are added to the vmi.
                   items:
                     properties:
+                      bandwidth:
+                        description: If specified, the traffic of the interface is limited. Only interfaces backed by a tap device, bridge, masquerade and macvtap, can be limited.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can be sent at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can be sent at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
+                      binding:
+                        description: Binding specifies the binding plugin which will be used to connect the interface to the guest. The plugin must be registered in the network configuration of KubeVirt. It is mutually exclusive with the BindingMethod.
+                        properties:
+                          name:
+                            description: Name references a binding plugin registered in the network configuration of KubeVirt.
+                            type: string
+                        required:
+                        - name
+                        type: object
                       bootOrder:
                         description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
                         type: integer
@@ -4098,6 +5770,44 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          routerAdvertisements:
+                            description: If set the IPv6 network of the interface is announced with router advertisements, so that the VM learns its default route and the prefix of the network. The advertisements need the NET_RAW capability, which is then granted to the virt-launcher pod. Implied by slaac.
+                            type: boolean
+                          routes:
+                            description: If specified will pass the routes to the VM in addition to the routes of the pod network. IPv4 routes are passed via DHCP option 121, IPv6 routes via the route information of IPv6 router advertisements.
+                            items:
+                              description: DHCPRoute is a route passed to the VM.
+                              properties:
+                                destination:
+                                  description: Destination of the route in CIDR notation, e.g. 192.168.20.0/24.
+                                  type: string
+                                gateway:
+                                  description: Gateway of an IPv4 route, the destination is on link when not set. IPv6 routes always go through the router of the interface.
+                                  type: string
+                              required:
+                              - destination
+                              type: object
+                            type: array
+                          searchDomains:
+                            description: If specified will pass the search domains to the VM instead of the search domains of the pod, via DHCP option 119, DHCPv6 option 24 and IPv6 router advertisements.
+                            items:
+                              type: string
+                            type: array
+                          slaac:
+                            description: If set the IPv6 network of the interface is advertised for stateless address autoconfiguration (SLAAC), for guests which can not use DHCPv6. Only supported on interfaces with the masquerade binding whose vmIPv6NetworkCIDR has a prefix length of 64.
+                            type: boolean
+                          staticLease:
+                            description: If specified the address is leased to the VM instead of the address of the pod interface. Only supported on interfaces with the bridge binding.
+                            properties:
+                              address:
+                                description: Address with its prefix length in CIDR notation, e.g. 192.168.10.5/24.
+                                type: string
+                              gateway:
+                                description: Gateway passed to the VM as its default route.
+                                type: string
+                            required:
+                            - address
+                            type: object
                           tftpServerName:
                             description: If specified will pass option 66 to interface's DHCP server
                             type: string
@@ -4108,6 +5818,41 @@ var CRDsValidation map[string]string = map[string]string{
                       macvtap:
                         type: object
                       masquerade:
+                        properties:
+                          blockedDestinations:
+                            description: BlockedDestinations lists networks, in CIDR notation, which the guest must not reach through the masquerade.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          portMappings:
+                            description: PortMappings forward ports, or ranges of ports, of the pod to the guest. Unless ports or port mappings are listed, all ports are forwarded.
+                            items:
+                              description: MasqueradePortMapping forwards a port, or a range of ports, of the pod to the guest.
+                              properties:
+                                endPort:
+                                  description: EndPort is the last port of a range of ports, which are forwarded to the same ports of the guest.
+                                  format: int32
+                                  type: integer
+                                guestPort:
+                                  description: GuestPort is the port of the guest the pod port is forwarded to. Defaults to Port. Can not be combined with EndPort.
+                                  format: int32
+                                  type: integer
+                                name:
+                                  description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Only mappings of a single port are named.
+                                  type: string
+                                port:
+                                  description: Port of the pod, the first port of the range when EndPort is set. This must be a valid port number, 0 < x < 65536.
+                                  format: int32
+                                  type: integer
+                                protocol:
+                                  description: Protocol of the mapping. Must be TCP, UDP or SCTP. Defaults to "TCP".
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       model:
                         description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
@@ -4140,6 +5885,10 @@ var CRDsValidation map[string]string = map[string]string{
                       slirp:
                         type: object
                       sriov:
+                        properties:
+                          standbyInterface:
+                            description: StandbyInterface is the name of a virtio interface with the bridge binding, connected to the same network, which takes over the traffic of the guest while the VF is unplugged during a live migration. Both interfaces must have the same MAC address.
+                            type: string
                         type: object
                       tag:
                         description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
@@ -4148,6 +5897,10 @@ var CRDsValidation map[string]string = map[string]string{
                     - name
                     type: object
                   type: array
+                maxHotpluggedDisks:
+                  description: MaxHotpluggedDisks is the number of disks which can be hotplugged on the virtio bus. A PCIe root port is reserved for each of them when the VirtualMachineInstance starts. Defaults to 0, which only allows to hotplug disks on the scsi bus.
+                  format: int32
+                  type: integer
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                   type: boolean
@@ -4516,6 +6269,9 @@ var CRDsValidation map[string]string = map[string]string{
               pod:
                 description: Represents the stock pod network interface.
                 properties:
+                  vmIPv6NetworkCIDR:
+                    description: IPv6 CIDR for the vm network. Default fd10:0:2::/120 if not specified.
+                    type: string
                   vmNetworkCIDR:
                     description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
                     type: string
@@ -4920,6 +6676,35 @@ var CRDsValidation map[string]string = map[string]string{
             type: string
           description: ActivePods is a mapping of pod UID to node name. It is possible for multiple pods to be running for a single VMI during migration.
           type: object
+        backupState:
+          description: BackupState represents the state of the latest backup of the disks
+          properties:
+            backupName:
+              description: BackupName is the name of the VirtualMachineBackup
+              type: string
+            checkpoint:
+              description: Checkpoint is the name of the checkpoint created by the backup
+              type: string
+            completed:
+              description: Indicates the backup ended
+              type: boolean
+            endTimestamp:
+              description: The time the backup ended
+              format: date-time
+              type: string
+            failed:
+              description: Indicates the backup failed
+              type: boolean
+            message:
+              description: Message describes why the backup failed
+              type: string
+            startTimestamp:
+              description: The time the backup started
+              format: date-time
+              type: string
+          required:
+          - backupName
+          type: object
         conditions:
           description: Conditions are specific points in VirtualMachineInstance's pod runtime.
           items:
@@ -4976,10 +6761,118 @@ var CRDsValidation map[string]string = map[string]string{
               description: Version ID of the Guest OS
               type: string
           type: object
+        hotplugInterfaces:
+          description: HotplugInterfaces contains the statuses of the network interfaces which are being hotplugged into or unplugged from the running VirtualMachineInstance
+          items:
+            description: HotplugInterfaceStatus represents the hotplug status of a network interface
+            properties:
+              message:
+                description: Message is a detailed message about the current hotplug interface phase
+                type: string
+              name:
+                description: Name is the name of the interface and of the network it is connected to
+                type: string
+              phase:
+                description: Phase is the phase
+                type: string
+              podInterfaceName:
+                description: 'PodInterfaceName is the name of the virt-launcher pod interface backing the interface, eg: net2'
+                type: string
+              reason:
+                description: Reason is a brief description of why we are in the current hotplug interface phase
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         interfaces:
           description: Interfaces represent the details of available network interfaces.
           items:
             properties:
+              bandwidth:
+                description: The bandwidth limits applied to the interface
+                properties:
+                  inbound:
+                    description: Inbound limits the traffic received by the guest.
+                    properties:
+                      average:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      burst:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Burst is the amount of bytes which can be sent at the peak rate.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      peak:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - average
+                    type: object
+                  outbound:
+                    description: Outbound limits the traffic sent by the guest.
+                    properties:
+                      average:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      burst:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Burst is the amount of bytes which can be sent at the peak rate.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                      peak:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - average
+                    type: object
+                type: object
+              conditions:
+                description: Conditions of the interface
+                items:
+                  properties:
+                    lastProbeTime:
+                      format: date-time
+                      nullable: true
+                      type: string
+                    lastTransitionTime:
+                      format: date-time
+                      nullable: true
+                      type: string
+                    message:
+                      type: string
+                    reason:
+                      type: string
+                    status:
+                      type: string
+                    type:
+                      type: string
+                  required:
+                  - status
+                  - type
+                  type: object
+                type: array
               interfaceName:
                 description: The interface name inside the Virtual Machine
                 type: string
@@ -5025,6 +6918,39 @@ var CRDsValidation map[string]string = map[string]string{
             failed:
               description: Indicates that the migration failed
               type: boolean
+            migrationConfiguration:
+              description: The migration configuration applied to the migration, the cluster wide configuration overridden by the MigrationPolicy
+              properties:
+                allowAutoConverge:
+                  type: boolean
+                allowPostCopy:
+                  type: boolean
+                bandwidthPerMigration:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                  x-kubernetes-int-or-string: true
+                completionTimeoutPerGiB:
+                  format: int64
+                  type: integer
+                nodeDrainTaintKey:
+                  type: string
+                parallelMigrationsPerCluster:
+                  format: int32
+                  type: integer
+                parallelOutboundMigrationsPerNode:
+                  format: int32
+                  type: integer
+                progressTimeout:
+                  format: int64
+                  type: integer
+                unsafeMigrationOverride:
+                  type: boolean
+              type: object
+            migrationPolicyName:
+              description: Name of the MigrationPolicy applied to the migration, if any
+              type: string
             migrationUid:
               description: The VirtualMachineInstanceMigration object associated with this migration
               type: string
@@ -5034,6 +6960,9 @@ var CRDsValidation map[string]string = map[string]string{
             sourceNode:
               description: The source node that the VMI originated on
               type: string
+            sriovPhase:
+              description: The step of the unplug and replug of the SR-IOV interfaces, if any
+              type: string
             startTimestamp:
               description: The time the migration action began
               format: date-time
@@ -5056,6 +6985,25 @@ var CRDsValidation map[string]string = map[string]string{
             targetPod:
               description: The target pod that the VMI is moving to
               type: string
+            volumeMigrations:
+              description: The volumes moved to new claims by the migration
+              items:
+                description: VolumeMigration maps a volume of the VMI to the claim its data is copied to. Exactly one destination must be set.
+                properties:
+                  destinationDataVolume:
+                    description: DestinationDataVolume is the name of the DataVolume the volume is moved to
+                    type: string
+                  destinationPVC:
+                    description: DestinationPVC is the name of the PersistentVolumeClaim the volume is moved to
+                    type: string
+                  sourceVolume:
+                    description: SourceVolume is the name of a PersistentVolumeClaim or DataVolume volume of the VMI
+                    type: string
+                required:
+                - sourceVolume
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
           type: object
         nodeName:
           description: NodeName is the name where the VirtualMachineInstance is currently running.
@@ -5084,12 +7032,132 @@ var CRDsValidation map[string]string = map[string]string{
                     description: AttachPodUID is the UID of the pod used to attach the volume to the node.
                     type: string
                 type: object
+              ioTune:
+                description: IOTune are the I/O limits in effect for the disk of the volume
+                properties:
+                  groupName:
+                    description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
+                    type: string
+                  readBytesSec:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  readBytesSecMax:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  readBytesSecMaxLength:
+                    description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                    format: int64
+                    type: integer
+                  readIOPSSec:
+                    description: ReadIOPSSec is the limit of read operations per second.
+                    format: int64
+                    type: integer
+                  readIOPSSecMax:
+                    description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                    format: int64
+                    type: integer
+                  readIOPSSecMaxLength:
+                    description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                    format: int64
+                    type: integer
+                  sizeIOPSSec:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  totalBytesSec:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  totalBytesSecMax:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  totalBytesSecMaxLength:
+                    description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                    format: int64
+                    type: integer
+                  totalIOPSSec:
+                    description: TotalIOPSSec is the limit of read and write operations per second.
+                    format: int64
+                    type: integer
+                  totalIOPSSecMax:
+                    description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                    format: int64
+                    type: integer
+                  totalIOPSSecMaxLength:
+                    description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                    format: int64
+                    type: integer
+                  writeBytesSec:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  writeBytesSecMax:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                  writeBytesSecMaxLength:
+                    description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                    format: int64
+                    type: integer
+                  writeIOPSSec:
+                    description: WriteIOPSSec is the limit of write operations per second.
+                    format: int64
+                    type: integer
+                  writeIOPSSecMax:
+                    description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                    format: int64
+                    type: integer
+                  writeIOPSSecMaxLength:
+                    description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                    format: int64
+                    type: integer
+                type: object
               message:
                 description: Message is a detailed message about the current hotplug volume phase
                 type: string
               name:
                 description: Name is the name of the volume
                 type: string
+              persistentVolumeClaimInfo:
+                description: If the volume is backed by a PersistentVolumeClaim, this will contain the information of the claim.
+                properties:
+                  capacity:
+                    additionalProperties:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    description: Capacity is the capacity reported in the status of the claim
+                    type: object
+                  volumeMode:
+                    description: VolumeMode is the volume mode of the claim
+                    type: string
+                type: object
               phase:
                 description: Phase is the phase
                 type: string
@@ -5126,6 +7194,25 @@ var CRDsValidation map[string]string = map[string]string{
         vmiName:
           description: The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
           type: string
+        volumeMigrations:
+          description: VolumeMigrations moves volumes of the VMI to new claims while it is migrated. Once the migration succeeded, the VMI and its VirtualMachine use the new claims.
+          items:
+            description: VolumeMigration maps a volume of the VMI to the claim its data is copied to. Exactly one destination must be set.
+            properties:
+              destinationDataVolume:
+                description: DestinationDataVolume is the name of the DataVolume the volume is moved to
+                type: string
+              destinationPVC:
+                description: DestinationPVC is the name of the PersistentVolumeClaim the volume is moved to
+                type: string
+              sourceVolume:
+                description: SourceVolume is the name of a PersistentVolumeClaim or DataVolume volume of the VMI
+                type: string
+            required:
+            - sourceVolume
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
       type: object
     status:
       description: VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.
@@ -5341,6 +7428,9 @@ var CRDsValidation map[string]string = map[string]string{
                             description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
                             type: string
                         type: object
+                      changedBlockTracking:
+                        description: ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup, in a persistent dirty bitmap. Only disks with changed block tracking are backed up. Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks. Defaults to false.
+                        type: boolean
                       dedicatedIOThread:
                         description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
                         type: boolean
@@ -5370,6 +7460,110 @@ var CRDsValidation map[string]string = map[string]string{
                       io:
                         description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs through the setblockiotune subresource, the limits in effect are reported in the volume status.
+                        properties:
+                          groupName:
+                            description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
+                            type: string
+                          readBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readBytesSecMaxLength:
+                            description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec is the limit of read operations per second.
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          readIOPSSecMaxLength:
+                            description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          sizeIOPSSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalBytesSecMaxLength:
+                            description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec is the limit of read and write operations per second.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMaxLength:
+                            description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeBytesSecMax:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeBytesSecMaxLength:
+                            description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec is the limit of write operations per second.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMaxLength:
+                            description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -5461,6 +7655,73 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Interfaces describe network interfaces which are added to the vmi.
                   items:
                     properties:
+                      bandwidth:
+                        description: If specified, the traffic of the interface is limited. Only interfaces backed by a tap device, bridge, masquerade and macvtap, can be limited.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can be sent at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes which can be sent at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
+                      binding:
+                        description: Binding specifies the binding plugin which will be used to connect the interface to the guest. The plugin must be registered in the network configuration of KubeVirt. It is mutually exclusive with the BindingMethod.
+                        properties:
+                          name:
+                            description: Name references a binding plugin registered in the network configuration of KubeVirt.
+                            type: string
+                        required:
+                        - name
+                        type: object
                       bootOrder:
                         description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
                         type: integer
@@ -5493,6 +7754,44 @@ var CRDsValidation map[string]string = map[string]string{
                               - value
                               type: object
                             type: array
+                          routerAdvertisements:
+                            description: If set the IPv6 network of the interface is announced with router advertisements, so that the VM learns its default route and the prefix of the network. The advertisements need the NET_RAW capability, which is then granted to the virt-launcher pod. Implied by slaac.
+                            type: boolean
+                          routes:
+                            description: If specified will pass the routes to the VM in addition to the routes of the pod network. IPv4 routes are passed via DHCP option 121, IPv6 routes via the route information of IPv6 router advertisements.
+                            items:
+                              description: DHCPRoute is a route passed to the VM.
+                              properties:
+                                destination:
+                                  description: Destination of the route in CIDR notation, e.g. 192.168.20.0/24.
+                                  type: string
+                                gateway:
+                                  description: Gateway of an IPv4 route, the destination is on link when not set. IPv6 routes always go through the router of the interface.
+                                  type: string
+                              required:
+                              - destination
+                              type: object
+                            type: array
+                          searchDomains:
+                            description: If specified will pass the search domains to the VM instead of the search domains of the pod, via DHCP option 119, DHCPv6 option 24 and IPv6 router advertisements.
+                            items:
+                              type: string
+                            type: array
+                          slaac:
+                            description: If set the IPv6 network of the interface is advertised for stateless address autoconfiguration (SLAAC), for guests which can not use DHCPv6. Only supported on interfaces with the masquerade binding whose vmIPv6NetworkCIDR has a prefix length of 64.
+                            type: boolean
+                          staticLease:
+                            description: If specified the address is leased to the VM instead of the address of the pod interface. Only supported on interfaces with the bridge binding.
+                            properties:
+                              address:
+                                description: Address with its prefix length in CIDR notation, e.g. 192.168.10.5/24.
+                                type: string
+                              gateway:
+                                description: Gateway passed to the VM as its default route.
+                                type: string
+                            required:
+                            - address
+                            type: object
                           tftpServerName:
                             description: If specified will pass option 66 to interface's DHCP server
                             type: string
@@ -5503,6 +7802,41 @@ var CRDsValidation map[string]string = map[string]string{
                       macvtap:
                         type: object
                       masquerade:
+                        properties:
+                          blockedDestinations:
+                            description: BlockedDestinations lists networks, in CIDR notation, which the guest must not reach through the masquerade.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          portMappings:
+                            description: PortMappings forward ports, or ranges of ports, of the pod to the guest. Unless ports or port mappings are listed, all ports are forwarded.
+                            items:
+                              description: MasqueradePortMapping forwards a port, or a range of ports, of the pod to the guest.
+                              properties:
+                                endPort:
+                                  description: EndPort is the last port of a range of ports, which are forwarded to the same ports of the guest.
+                                  format: int32
+                                  type: integer
+                                guestPort:
+                                  description: GuestPort is the port of the guest the pod port is forwarded to. Defaults to Port. Can not be combined with EndPort.
+                                  format: int32
+                                  type: integer
+                                name:
+                                  description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Only mappings of a single port are named.
+                                  type: string
+                                port:
+                                  description: Port of the pod, the first port of the range when EndPort is set. This must be a valid port number, 0 < x < 65536.
+                                  format: int32
+                                  type: integer
+                                protocol:
+                                  description: Protocol of the mapping. Must be TCP, UDP or SCTP. Defaults to "TCP".
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
                         type: object
                       model:
                         description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
@@ -5535,6 +7869,10 @@ var CRDsValidation map[string]string = map[string]string{
                       slirp:
                         type: object
                       sriov:
+                        properties:
+                          standbyInterface:
+                            description: StandbyInterface is the name of a virtio interface with the bridge binding, connected to the same network, which takes over the traffic of the guest while the VF is unplugged during a live migration. Both interfaces must have the same MAC address.
+                            type: string
                         type: object
                       tag:
                         description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
@@ -5543,6 +7881,10 @@ var CRDsValidation map[string]string = map[string]string{
                     - name
                     type: object
                   type: array
+                maxHotpluggedDisks:
+                  description: MaxHotpluggedDisks is the number of disks which can be hotplugged on the virtio bus. A PCIe root port is reserved for each of them when the VirtualMachineInstance starts. Defaults to 0, which only allows to hotplug disks on the scsi bus.
+                  format: int32
+                  type: integer
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                   type: boolean
@@ -6512,6 +8854,9 @@ var CRDsValidation map[string]string = map[string]string{
                                     description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
                                     type: string
                                 type: object
+                              changedBlockTracking:
+                                description: ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup, in a persistent dirty bitmap. Only disks with changed block tracking are backed up. Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks. Defaults to false.
+                                type: boolean
                               dedicatedIOThread:
                                 description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
                                 type: boolean
@@ -6541,6 +8886,110 @@ var CRDsValidation map[string]string = map[string]string{
                               io:
                                 description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
                                 type: string
+                              ioTune:
+                                description: IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs through the setblockiotune subresource, the limits in effect are reported in the volume status.
+                                properties:
+                                  groupName:
+                                    description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
+                                    type: string
+                                  readBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readBytesSecMax:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readBytesSecMaxLength:
+                                    description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec is the limit of read operations per second.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMax:
+                                    description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMaxLength:
+                                    description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  sizeIOPSSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalBytesSecMax:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalBytesSecMaxLength:
+                                    description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec is the limit of read and write operations per second.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMax:
+                                    description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMaxLength:
+                                    description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeBytesSecMax:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeBytesSecMaxLength:
+                                    description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec is the limit of write operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMax:
+                                    description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMaxLength:
+                                    description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -6632,6 +9081,73 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Interfaces describe network interfaces which are added to the vmi.
                           items:
                             properties:
+                              bandwidth:
+                                description: If specified, the traffic of the interface is limited. Only interfaces backed by a tap device, bridge, masquerade and macvtap, can be limited.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes which can be sent at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes which can be sent at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
+                              binding:
+                                description: Binding specifies the binding plugin which will be used to connect the interface to the guest. The plugin must be registered in the network configuration of KubeVirt. It is mutually exclusive with the BindingMethod.
+                                properties:
+                                  name:
+                                    description: Name references a binding plugin registered in the network configuration of KubeVirt.
+                                    type: string
+                                required:
+                                - name
+                                type: object
                               bootOrder:
                                 description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
                                 type: integer
@@ -6664,6 +9180,44 @@ var CRDsValidation map[string]string = map[string]string{
                                       - value
                                       type: object
                                     type: array
+                                  routerAdvertisements:
+                                    description: If set the IPv6 network of the interface is announced with router advertisements, so that the VM learns its default route and the prefix of the network. The advertisements need the NET_RAW capability, which is then granted to the virt-launcher pod. Implied by slaac.
+                                    type: boolean
+                                  routes:
+                                    description: If specified will pass the routes to the VM in addition to the routes of the pod network. IPv4 routes are passed via DHCP option 121, IPv6 routes via the route information of IPv6 router advertisements.
+                                    items:
+                                      description: DHCPRoute is a route passed to the VM.
+                                      properties:
+                                        destination:
+                                          description: Destination of the route in CIDR notation, e.g. 192.168.20.0/24.
+                                          type: string
+                                        gateway:
+                                          description: Gateway of an IPv4 route, the destination is on link when not set. IPv6 routes always go through the router of the interface.
+                                          type: string
+                                      required:
+                                      - destination
+                                      type: object
+                                    type: array
+                                  searchDomains:
+                                    description: If specified will pass the search domains to the VM instead of the search domains of the pod, via DHCP option 119, DHCPv6 option 24 and IPv6 router advertisements.
+                                    items:
+                                      type: string
+                                    type: array
+                                  slaac:
+                                    description: If set the IPv6 network of the interface is advertised for stateless address autoconfiguration (SLAAC), for guests which can not use DHCPv6. Only supported on interfaces with the masquerade binding whose vmIPv6NetworkCIDR has a prefix length of 64.
+                                    type: boolean
+                                  staticLease:
+                                    description: If specified the address is leased to the VM instead of the address of the pod interface. Only supported on interfaces with the bridge binding.
+                                    properties:
+                                      address:
+                                        description: Address with its prefix length in CIDR notation, e.g. 192.168.10.5/24.
+                                        type: string
+                                      gateway:
+                                        description: Gateway passed to the VM as its default route.
+                                        type: string
+                                    required:
+                                    - address
+                                    type: object
                                   tftpServerName:
                                     description: If specified will pass option 66 to interface's DHCP server
                                     type: string
@@ -6674,6 +9228,41 @@ var CRDsValidation map[string]string = map[string]string{
                               macvtap:
                                 type: object
                               masquerade:
+                                properties:
+                                  blockedDestinations:
+                                    description: BlockedDestinations lists networks, in CIDR notation, which the guest must not reach through the masquerade.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  portMappings:
+                                    description: PortMappings forward ports, or ranges of ports, of the pod to the guest. Unless ports or port mappings are listed, all ports are forwarded.
+                                    items:
+                                      description: MasqueradePortMapping forwards a port, or a range of ports, of the pod to the guest.
+                                      properties:
+                                        endPort:
+                                          description: EndPort is the last port of a range of ports, which are forwarded to the same ports of the guest.
+                                          format: int32
+                                          type: integer
+                                        guestPort:
+                                          description: GuestPort is the port of the guest the pod port is forwarded to. Defaults to Port. Can not be combined with EndPort.
+                                          format: int32
+                                          type: integer
+                                        name:
+                                          description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Only mappings of a single port are named.
+                                          type: string
+                                        port:
+                                          description: Port of the pod, the first port of the range when EndPort is set. This must be a valid port number, 0 < x < 65536.
+                                          format: int32
+                                          type: integer
+                                        protocol:
+                                          description: Protocol of the mapping. Must be TCP, UDP or SCTP. Defaults to "TCP".
+                                          type: string
+                                      required:
+                                      - port
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
                                 type: object
                               model:
                                 description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
@@ -6706,6 +9295,10 @@ var CRDsValidation map[string]string = map[string]string{
                               slirp:
                                 type: object
                               sriov:
+                                properties:
+                                  standbyInterface:
+                                    description: StandbyInterface is the name of a virtio interface with the bridge binding, connected to the same network, which takes over the traffic of the guest while the VF is unplugged during a live migration. Both interfaces must have the same MAC address.
+                                    type: string
                                 type: object
                               tag:
                                 description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
@@ -6714,6 +9307,10 @@ var CRDsValidation map[string]string = map[string]string{
                             - name
                             type: object
                           type: array
+                        maxHotpluggedDisks:
+                          description: MaxHotpluggedDisks is the number of disks which can be hotplugged on the virtio bus. A PCIe root port is reserved for each of them when the VirtualMachineInstance starts. Defaults to 0, which only allows to hotplug disks on the scsi bus.
+                          format: int32
+                          type: integer
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                           type: boolean
@@ -6894,601 +9491,3202 @@ var CRDsValidation map[string]string = map[string]string{
                     firmware:
                       description: Firmware.
                       properties:
-                        bootloader:
-                          description: Settings to control the bootloader that is used.
-                          properties:
-                            bios:
-                              description: If set (default), BIOS will be used.
-                              properties:
-                                useSerial:
-                                  description: If set, the BIOS output will be transmitted over serial
-                                  type: boolean
-                              type: object
-                            efi:
-                              description: If set, EFI will be used instead of BIOS.
-                              properties:
-                                secureBoot:
-                                  description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
-                                  type: boolean
-                              type: object
-                          type: object
-                        serial:
-                          description: The system-serial-number in SMBIOS
+                        bootloader:
+                          description: Settings to control the bootloader that is used.
+                          properties:
+                            bios:
+                              description: If set (default), BIOS will be used.
+                              properties:
+                                useSerial:
+                                  description: If set, the BIOS output will be transmitted over serial
+                                  type: boolean
+                              type: object
+                            efi:
+                              description: If set, EFI will be used instead of BIOS.
+                              properties:
+                                secureBoot:
+                                  description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
+                                  type: boolean
+                              type: object
+                          type: object
+                        serial:
+                          description: The system-serial-number in SMBIOS
+                          type: string
+                        uuid:
+                          description: UUID reported by the vmi bios. Defaults to a random generated uid.
+                          type: string
+                      type: object
+                    ioThreadsPolicy:
+                      description: 'Controls whether or not disks will share IOThreads. Omitting IOThreadsPolicy disables use of IOThreads. One of: shared, auto'
+                      type: string
+                    machine:
+                      description: Machine type.
+                      properties:
+                        type:
+                          description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                          type: string
+                      required:
+                      - type
+                      type: object
+                    memory:
+                      description: Memory allow specifying the VMI memory features.
+                      properties:
+                        guest:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        hugepages:
+                          description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
+                          properties:
+                            pageSize:
+                              description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
+                              type: string
+                          type: object
+                      type: object
+                    resources:
+                      description: Resources describes the Compute Resources required by this vmi.
+                      properties:
+                        limits:
+                          additionalProperties:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
+                          type: object
+                        overcommitGuestOverhead:
+                          description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
+                          type: boolean
+                        requests:
+                          additionalProperties:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
+                          type: object
+                      type: object
+                  required:
+                  - devices
+                  type: object
+                evictionStrategy:
+                  description: EvictionStrategy can be set to "LiveMigrate" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.
+                  type: string
+                hostname:
+                  description: Specifies the hostname of the vmi If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
+                  type: string
+                livenessProbe:
+                  description: 'Periodic probe of VirtualMachineInstance liveness. VirtualmachineInstances will be stopped if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                  properties:
+                    failureThreshold:
+                      description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
+                          description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                          type: string
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be used in HTTP probes
+                            properties:
+                              name:
+                                description: The header field name
+                                type: string
+                              value:
+                                description: The header field value
+                                type: string
+                            required:
+                            - name
+                            - value
+                            type: object
+                          type: array
+                        path:
+                          description: Path to access on the HTTP server.
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
+                          description: Scheme to use for connecting to the host. Defaults to HTTP.
+                          type: string
+                      required:
+                      - port
+                      type: object
+                    initialDelaySeconds:
+                      description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                      properties:
+                        host:
+                          description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      format: int32
+                      type: integer
+                  type: object
+                networks:
+                  description: List of networks that can be attached to a vm's virtual interface.
+                  items:
+                    description: Network represents a network type and a resource that should be connected to the vm.
+                    properties:
+                      multus:
+                        description: Represents the multus cni network.
+                        properties:
+                          default:
+                            description: Select the default network and add it to the multus-cni.io/default-network annotation.
+                            type: boolean
+                          networkName:
+                            description: 'References to a NetworkAttachmentDefinition CRD object. Format: <networkName>, <namespace>/<networkName>. If namespace is not specified, VMI namespace is assumed.'
+                            type: string
+                        required:
+                        - networkName
+                        type: object
+                      name:
+                        description: 'Network name. Must be a DNS_LABEL and unique within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                        type: string
+                      pod:
+                        description: Represents the stock pod network interface.
+                        properties:
+                          vmIPv6NetworkCIDR:
+                            description: IPv6 CIDR for the vm network. Default fd10:0:2::/120 if not specified.
+                            type: string
+                          vmNetworkCIDR:
+                            description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
+                            type: string
+                        type: object
+                    required:
+                    - name
+                    type: object
+                  type: array
+                nodeSelector:
+                  additionalProperties:
+                    type: string
+                  description: 'NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node''s labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
+                  type: object
+                priorityClassName:
+                  description: If specified, indicates the pod's priority. If not specified, the pod priority will be default or zero if there is no default.
+                  type: string
+                readinessProbe:
+                  description: 'Periodic probe of VirtualMachineInstance service readiness. VirtualmachineInstances will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                  properties:
+                    failureThreshold:
+                      description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
+                          description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
                           type: string
-                        uuid:
-                          description: UUID reported by the vmi bios. Defaults to a random generated uid.
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be used in HTTP probes
+                            properties:
+                              name:
+                                description: The header field name
+                                type: string
+                              value:
+                                description: The header field value
+                                type: string
+                            required:
+                            - name
+                            - value
+                            type: object
+                          type: array
+                        path:
+                          description: Path to access on the HTTP server.
                           type: string
-                      type: object
-                    ioThreadsPolicy:
-                      description: 'Controls whether or not disks will share IOThreads. Omitting IOThreadsPolicy disables use of IOThreads. One of: shared, auto'
-                      type: string
-                    machine:
-                      description: Machine type.
-                      properties:
-                        type:
-                          description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
+                          description: Scheme to use for connecting to the host. Defaults to HTTP.
                           type: string
                       required:
-                      - type
+                      - port
                       type: object
-                    memory:
-                      description: Memory allow specifying the VMI memory features.
+                    initialDelaySeconds:
+                      description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
                       properties:
-                        guest:
+                        host:
+                          description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                          type: string
+                        port:
                           anyOf:
                           - type: integer
                           - type: string
-                          description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
-                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
                           x-kubernetes-int-or-string: true
-                        hugepages:
-                          description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
-                          properties:
-                            pageSize:
-                              description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
-                              type: string
-                          type: object
+                      required:
+                      - port
                       type: object
-                    resources:
-                      description: Resources describes the Compute Resources required by this vmi.
-                      properties:
-                        limits:
-                          additionalProperties:
+                    timeoutSeconds:
+                      description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                      format: int32
+                      type: integer
+                  type: object
+                schedulerName:
+                  description: If specified, the VMI will be dispatched by specified scheduler. If not specified, the VMI will be dispatched by default scheduler.
+                  type: string
+                subdomain:
+                  description: If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>". If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi, no matter if the vmi itself can pick up a hostname.
+                  type: string
+                terminationGracePeriodSeconds:
+                  description: Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
+                  format: int64
+                  type: integer
+                tolerations:
+                  description: If toleration is specified, obey all the toleration rules.
+                  items:
+                    description: The pod this Toleration is attached to tolerates any taint that matches the triple <key,value,effect> using the matching operator <operator>.
+                    properties:
+                      effect:
+                        description: Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                        type: string
+                      key:
+                        description: Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                        type: string
+                      operator:
+                        description: Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.
+                        type: string
+                      tolerationSeconds:
+                        description: TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.
+                        format: int64
+                        type: integer
+                      value:
+                        description: Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.
+                        type: string
+                    type: object
+                  type: array
+                volumes:
+                  description: List of volumes that can be mounted by disks belonging to the vmi.
+                  items:
+                    description: Volume represents a named volume in a vmi.
+                    properties:
+                      cloudInitConfigDrive:
+                        description: 'CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
+                        properties:
+                          networkData:
+                            description: NetworkData contains config drive inline cloud-init networkdata.
+                            type: string
+                          networkDataBase64:
+                            description: NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.
+                            type: string
+                          networkDataSecretRef:
+                            description: NetworkDataSecretRef references a k8s secret that contains config drive networkdata.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          secretRef:
+                            description: UserDataSecretRef references a k8s secret that contains config drive userdata.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          userData:
+                            description: UserData contains config drive inline cloud-init userdata.
+                            type: string
+                          userDataBase64:
+                            description: UserDataBase64 contains config drive cloud-init userdata as a base64 encoded string.
+                            type: string
+                        type: object
+                      cloudInitNoCloud:
+                        description: 'CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
+                        properties:
+                          networkData:
+                            description: NetworkData contains NoCloud inline cloud-init networkdata.
+                            type: string
+                          networkDataBase64:
+                            description: NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.
+                            type: string
+                          networkDataSecretRef:
+                            description: NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          secretRef:
+                            description: UserDataSecretRef references a k8s secret that contains NoCloud userdata.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          userData:
+                            description: UserData contains NoCloud inline cloud-init userdata.
+                            type: string
+                          userDataBase64:
+                            description: UserDataBase64 contains NoCloud cloud-init userdata as a base64 encoded string.
+                            type: string
+                        type: object
+                      configMap:
+                        description: 'ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
+                        properties:
+                          name:
+                            description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                            type: string
+                          optional:
+                            description: Specify whether the ConfigMap or it's keys must be defined
+                            type: boolean
+                          volumeLabel:
+                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      containerDisk:
+                        description: 'ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
+                        properties:
+                          image:
+                            description: Image is the name of the image with the embedded disk.
+                            type: string
+                          imagePullPolicy:
+                            description: 'Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
+                            type: string
+                          imagePullSecret:
+                            description: ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.
+                            type: string
+                          path:
+                            description: Path defines the path to disk file in the container
+                            type: string
+                        required:
+                        - image
+                        type: object
+                      dataVolume:
+                        description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
+                        properties:
+                          name:
+                            description: Name represents the name of the DataVolume in the same namespace
+                            type: string
+                        required:
+                        - name
+                        type: object
+                      downwardAPI:
+                        description: DownwardAPI represents downward API about the pod that should populate this volume
+                        properties:
+                          fields:
+                            description: Fields is a list of downward API volume file
+                            items:
+                              description: DownwardAPIVolumeFile represents information to create the file containing the pod field
+                              properties:
+                                fieldRef:
+                                  description: 'Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.'
+                                  properties:
+                                    apiVersion:
+                                      description: Version of the schema the FieldPath is written in terms of, defaults to "v1".
+                                      type: string
+                                    fieldPath:
+                                      description: Path of the field to select in the specified API version.
+                                      type: string
+                                  required:
+                                  - fieldPath
+                                  type: object
+                                mode:
+                                  description: 'Optional: mode bits used to set permissions on this file, must be an octal value between 0000 and 0777 or a decimal value between 0 and 511. YAML accepts both octal and decimal values, JSON requires decimal values for mode bits. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.'
+                                  format: int32
+                                  type: integer
+                                path:
+                                  description: 'Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the ''..'' path. Must be utf-8 encoded. The first item of the relative path must not start with ''..'''
+                                  type: string
+                                resourceFieldRef:
+                                  description: 'Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.'
+                                  properties:
+                                    containerName:
+                                      description: 'Container name: required for volumes, optional for env vars'
+                                      type: string
+                                    divisor:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: Specifies the output format of the exposed resources, defaults to "1"
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    resource:
+                                      description: 'Required: resource to select'
+                                      type: string
+                                  required:
+                                  - resource
+                                  type: object
+                              required:
+                              - path
+                              type: object
+                            type: array
+                          volumeLabel:
+                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      emptyDisk:
+                        description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
+                        properties:
+                          capacity:
                             anyOf:
                             - type: integer
                             - type: string
+                            description: Capacity of the sparse disk.
                             pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                             x-kubernetes-int-or-string: true
-                          description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
-                          type: object
-                        overcommitGuestOverhead:
-                          description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
-                          type: boolean
-                        requests:
-                          additionalProperties:
+                        required:
+                        - capacity
+                        type: object
+                      ephemeral:
+                        description: Ephemeral is a special volume source that "wraps" specified source and provides copy-on-write image on top of it.
+                        properties:
+                          persistentVolumeClaim:
+                            description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            properties:
+                              claimName:
+                                description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                type: string
+                              readOnly:
+                                description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                type: boolean
+                            required:
+                            - claimName
+                            type: object
+                        type: object
+                      hostDisk:
+                        description: HostDisk represents a disk created on the cluster level
+                        properties:
+                          capacity:
                             anyOf:
                             - type: integer
                             - type: string
+                            description: Capacity of the sparse disk
                             pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
                             x-kubernetes-int-or-string: true
-                          description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
-                          type: object
-                      type: object
-                  required:
-                  - devices
-                  type: object
-                evictionStrategy:
-                  description: EvictionStrategy can be set to "LiveMigrate" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.
-                  type: string
-                hostname:
-                  description: Specifies the hostname of the vmi If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
+                          path:
+                            description: The path to HostDisk image located on the cluster
+                            type: string
+                          shared:
+                            description: Shared indicate whether the path is shared between nodes
+                            type: boolean
+                          type:
+                            description: Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'
+                            type: string
+                        required:
+                        - path
+                        - type
+                        type: object
+                      name:
+                        description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
+                        type: string
+                      persistentVolumeClaim:
+                        description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                        properties:
+                          claimName:
+                            description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            type: string
+                          readOnly:
+                            description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                            type: boolean
+                        required:
+                        - claimName
+                        type: object
+                      secret:
+                        description: 'SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
+                        properties:
+                          optional:
+                            description: Specify whether the Secret or it's keys must be defined
+                            type: boolean
+                          secretName:
+                            description: 'Name of the secret in the pod''s namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
+                            type: string
+                          volumeLabel:
+                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                            type: string
+                        type: object
+                      serviceAccount:
+                        description: 'ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                        properties:
+                          serviceAccountName:
+                            description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                            type: string
+                        type: object
+                      sysprep:
+                        description: Represents a Sysprep volume source.
+                        properties:
+                          configMap:
+                            description: ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                          secret:
+                            description: Secret references a k8s Secret that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
+                            properties:
+                              name:
+                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                type: string
+                            type: object
+                        type: object
+                    required:
+                    - name
+                    type: object
+                  type: array
+              required:
+              - domain
+              type: object
+          type: object
+      required:
+      - selector
+      - template
+      type: object
+    status:
+      description: Status is the high level overview of how the VirtualMachineInstance is doing. It contains information available to controllers and users.
+      nullable: true
+      properties:
+        conditions:
+          items:
+            properties:
+              lastProbeTime:
+                format: date-time
+                nullable: true
+                type: string
+              lastTransitionTime:
+                format: date-time
+                nullable: true
+                type: string
+              message:
+                type: string
+              reason:
+                type: string
+              status:
+                type: string
+              type:
+                type: string
+            required:
+            - status
+            - type
+            type: object
+          type: array
+        labelSelector:
+          description: Canonical form of the label selector for HPA which consumes it through the scale subresource.
+          type: string
+        readyReplicas:
+          description: The number of ready replicas for this replica set.
+          format: int32
+          type: integer
+        replicas:
+          description: Total number of non-terminated pods targeted by this deployment (their labels match the selector).
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachineinstancetype": `openAPIV3Schema:
+  description: VirtualMachineInstancetype resource contains quantitative and resource related VirtualMachine configuration that can be used by multiple VirtualMachine resources.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the instancetype
+      properties:
+        cpu:
+          description: Required CPU related attributes of the instancetype.
+          properties:
+            dedicatedCPUPlacement:
+              description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
+              type: boolean
+            guest:
+              description: Required number of vCPUs to expose to the guest. The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+              format: int32
+              type: integer
+            isolateEmulatorThread:
+              description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
+              type: boolean
+            model:
+              description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
+              type: string
+          required:
+          - guest
+          type: object
+        gpus:
+          description: Optionally defines any GPU devices associated with the instancetype.
+          items:
+            properties:
+              deviceName:
+                type: string
+              name:
+                description: Name of the GPU device as exposed by a device plugin
+                type: string
+            required:
+            - deviceName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        hostDevices:
+          description: Optionally defines any HostDevices associated with the instancetype.
+          items:
+            properties:
+              deviceName:
+                description: DeviceName is the resource name of the host device exposed by a device plugin
+                type: string
+              name:
+                type: string
+            required:
+            - deviceName
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        ioThreadsPolicy:
+          description: Optionally defines the IOThreadsPolicy to be used by the instancetype.
+          type: string
+        memory:
+          description: Required Memory related attributes of the instancetype.
+          properties:
+            guest:
+              anyOf:
+              - type: integer
+              - type: string
+              description: Required amount of memory which is visible inside the guest OS.
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            hugepages:
+              description: Optionally enables the use of hugepages for the VirtualMachineInstance instead of regular memory.
+              properties:
+                pageSize:
+                  description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
                   type: string
-                livenessProbe:
-                  description: 'Periodic probe of VirtualMachineInstance liveness. VirtualmachineInstances will be stopped if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+              type: object
+          required:
+          - guest
+          type: object
+      required:
+      - cpu
+      - memory
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinenetworkpolicy": `openAPIV3Schema:
+  description: VirtualMachineNetworkPolicy restricts the traffic of the bridge and macvtap interfaces of the VirtualMachineInstances it selects
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineNetworkPolicySpec is the spec for a VirtualMachineNetworkPolicy resource. Like a NetworkPolicy, a VirtualMachineInstance selected by a policy only accepts the traffic allowed by the rules of the policies selecting it, in the directions listed in policyTypes.
+      properties:
+        egress:
+          description: Egress lists the rules for the traffic sent by the guest
+          items:
+            description: VirtualMachineNetworkPolicyEgressRule allows traffic matching both its ports and its peers to leave the guest
+            properties:
+              ports:
+                description: Ports lists the ports of the peers the rule allows. All ports are allowed when empty.
+                items:
+                  description: VirtualMachineNetworkPolicyPort is a port, or a range of ports, of a protocol
                   properties:
-                    failureThreshold:
-                      description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                    endPort:
+                      description: EndPort is the last port of the range starting at port
                       format: int32
                       type: integer
-                    httpGet:
-                      description: HTTPGet specifies the http request to perform.
+                    port:
+                      description: Port is the port, or the first port of the range. All the ports of the protocol are allowed when unset.
+                      format: int32
+                      type: integer
+                    protocol:
+                      default: TCP
+                      description: Protocol is one of TCP, UDP or SCTP. Defaults to TCP.
+                      type: string
+                  type: object
+                type: array
+              to:
+                description: To lists the peers the rule allows. All peers are allowed when empty.
+                items:
+                  description: VirtualMachineNetworkPolicyPeer is a set of addresses. Exactly one of its fields has to be set.
+                  properties:
+                    ipBlock:
+                      description: IPBlock selects addresses by CIDR
                       properties:
-                        host:
-                          description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                        cidr:
                           type: string
-                        httpHeaders:
-                          description: Custom headers to set in the request. HTTP allows repeated headers.
+                        except:
+                          description: Except lists CIDRs within cidr which are not part of the block
                           items:
-                            description: HTTPHeader describes a custom header to be used in HTTP probes
+                            type: string
+                          type: array
+                      required:
+                      - cidr
+                      type: object
+                    virtualMachineInstanceSelector:
+                      description: VirtualMachineInstanceSelector selects the addresses reported in the status of the VirtualMachineInstances in the namespace of the policy.
+                      properties:
+                        matchExpressions:
+                          description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                          items:
+                            description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
                             properties:
-                              name:
-                                description: The header field name
+                              key:
+                                description: key is the label key that the selector applies to.
                                 type: string
-                              value:
-                                description: The header field value
+                              operator:
+                                description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
                                 type: string
+                              values:
+                                description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                items:
+                                  type: string
+                                type: array
                             required:
-                            - name
-                            - value
+                            - key
+                            - operator
                             type: object
                           type: array
-                        path:
-                          description: Path to access on the HTTP server.
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                        scheme:
-                          description: Scheme to use for connecting to the host. Defaults to HTTP.
-                          type: string
-                      required:
-                      - port
+                        matchLabels:
+                          additionalProperties:
+                            type: string
+                          description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                          type: object
                       type: object
-                    initialDelaySeconds:
-                      description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                      format: int32
-                      type: integer
-                    periodSeconds:
-                      description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    successThreshold:
-                      description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    tcpSocket:
-                      description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                  type: object
+                type: array
+            type: object
+          type: array
+        ingress:
+          description: Ingress lists the rules for the traffic received by the guest
+          items:
+            description: VirtualMachineNetworkPolicyIngressRule allows traffic matching both its ports and its peers to reach the guest
+            properties:
+              from:
+                description: From lists the peers the rule allows. All peers are allowed when empty.
+                items:
+                  description: VirtualMachineNetworkPolicyPeer is a set of addresses. Exactly one of its fields has to be set.
+                  properties:
+                    ipBlock:
+                      description: IPBlock selects addresses by CIDR
                       properties:
-                        host:
-                          description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                        cidr:
                           type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
+                        except:
+                          description: Except lists CIDRs within cidr which are not part of the block
+                          items:
+                            type: string
+                          type: array
                       required:
-                      - port
+                      - cidr
                       type: object
-                    timeoutSeconds:
-                      description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                      format: int32
-                      type: integer
-                  type: object
-                networks:
-                  description: List of networks that can be attached to a vm's virtual interface.
-                  items:
-                    description: Network represents a network type and a resource that should be connected to the vm.
-                    properties:
-                      multus:
-                        description: Represents the multus cni network.
-                        properties:
-                          default:
-                            description: Select the default network and add it to the multus-cni.io/default-network annotation.
-                            type: boolean
-                          networkName:
-                            description: 'References to a NetworkAttachmentDefinition CRD object. Format: <networkName>, <namespace>/<networkName>. If namespace is not specified, VMI namespace is assumed.'
-                            type: string
-                        required:
-                        - networkName
-                        type: object
-                      name:
-                        description: 'Network name. Must be a DNS_LABEL and unique within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
-                        type: string
-                      pod:
-                        description: Represents the stock pod network interface.
-                        properties:
-                          vmNetworkCIDR:
-                            description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
-                            type: string
-                        type: object
-                    required:
-                    - name
-                    type: object
-                  type: array
-                nodeSelector:
-                  additionalProperties:
-                    type: string
-                  description: 'NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node''s labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
-                  type: object
-                priorityClassName:
-                  description: If specified, indicates the pod's priority. If not specified, the pod priority will be default or zero if there is no default.
-                  type: string
-                readinessProbe:
-                  description: 'Periodic probe of VirtualMachineInstance service readiness. VirtualmachineInstances will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                  properties:
-                    failureThreshold:
-                      description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    httpGet:
-                      description: HTTPGet specifies the http request to perform.
+                    virtualMachineInstanceSelector:
+                      description: VirtualMachineInstanceSelector selects the addresses reported in the status of the VirtualMachineInstances in the namespace of the policy.
                       properties:
-                        host:
-                          description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
-                          type: string
-                        httpHeaders:
-                          description: Custom headers to set in the request. HTTP allows repeated headers.
+                        matchExpressions:
+                          description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
                           items:
-                            description: HTTPHeader describes a custom header to be used in HTTP probes
+                            description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
                             properties:
-                              name:
-                                description: The header field name
+                              key:
+                                description: key is the label key that the selector applies to.
                                 type: string
-                              value:
-                                description: The header field value
+                              operator:
+                                description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
                                 type: string
+                              values:
+                                description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                items:
+                                  type: string
+                                type: array
                             required:
-                            - name
-                            - value
+                            - key
+                            - operator
                             type: object
                           type: array
-                        path:
-                          description: Path to access on the HTTP server.
-                          type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
-                        scheme:
-                          description: Scheme to use for connecting to the host. Defaults to HTTP.
-                          type: string
-                      required:
-                      - port
+                        matchLabels:
+                          additionalProperties:
+                            type: string
+                          description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                          type: object
                       type: object
-                    initialDelaySeconds:
-                      description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                      format: int32
-                      type: integer
-                    periodSeconds:
-                      description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                  type: object
+                type: array
+              ports:
+                description: Ports lists the ports of the guest the rule allows. All ports are allowed when empty.
+                items:
+                  description: VirtualMachineNetworkPolicyPort is a port, or a range of ports, of a protocol
+                  properties:
+                    endPort:
+                      description: EndPort is the last port of the range starting at port
                       format: int32
                       type: integer
-                    successThreshold:
-                      description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                    port:
+                      description: Port is the port, or the first port of the range. All the ports of the protocol are allowed when unset.
                       format: int32
                       type: integer
-                    tcpSocket:
-                      description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                    protocol:
+                      default: TCP
+                      description: Protocol is one of TCP, UDP or SCTP. Defaults to TCP.
+                      type: string
+                  type: object
+                type: array
+            type: object
+          type: array
+        networks:
+          description: Networks restricts the policy to the interfaces connected to the listed networks. All the bridge and macvtap interfaces are selected when empty.
+          items:
+            type: string
+          type: array
+        policyTypes:
+          description: PolicyTypes lists the directions the policy isolates. It defaults to Ingress, and to Egress as well when egress rules are set.
+          items:
+            description: PolicyType is a direction of the traffic isolated by a policy
+            type: string
+          type: array
+        virtualMachineInstanceSelector:
+          description: VirtualMachineInstanceSelector selects the VirtualMachineInstances in the namespace of the policy. An empty selector selects all of them.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+      required:
+      - virtualMachineInstanceSelector
+      type: object
+    status:
+      description: VirtualMachineNetworkPolicyStatus is maintained by virt-controller, virt-handler reads the addresses of the peers from it instead of watching all the VirtualMachineInstances of the cluster.
+      properties:
+        peers:
+          description: Peers lists the addresses of the VirtualMachineInstances selected by the peer selectors of the rules
+          items:
+            description: VirtualMachineNetworkPolicyPeerStatus holds the addresses of the VirtualMachineInstances matching a peer selector of the policy
+            properties:
+              addresses:
+                description: Addresses lists the addresses reported by the selected VirtualMachineInstances as host CIDRs
+                items:
+                  type: string
+                type: array
+              virtualMachineInstanceSelector:
+                description: VirtualMachineInstanceSelector is the peer selector of the rules
+                properties:
+                  matchExpressions:
+                    description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                    items:
+                      description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
                       properties:
-                        host:
-                          description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                        key:
+                          description: key is the label key that the selector applies to.
                           type: string
-                        port:
-                          anyOf:
-                          - type: integer
-                          - type: string
-                          description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
-                          x-kubernetes-int-or-string: true
+                        operator:
+                          description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                          type: string
+                        values:
+                          description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                          items:
+                            type: string
+                          type: array
                       required:
-                      - port
+                      - key
+                      - operator
                       type: object
-                    timeoutSeconds:
-                      description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
-                      format: int32
-                      type: integer
-                  type: object
-                schedulerName:
-                  description: If specified, the VMI will be dispatched by specified scheduler. If not specified, the VMI will be dispatched by default scheduler.
-                  type: string
-                subdomain:
-                  description: If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>". If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi, no matter if the vmi itself can pick up a hostname.
-                  type: string
-                terminationGracePeriodSeconds:
-                  description: Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
-                  format: int64
-                  type: integer
-                tolerations:
-                  description: If toleration is specified, obey all the toleration rules.
+                    type: array
+                  matchLabels:
+                    additionalProperties:
+                      type: string
+                    description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                    type: object
+                type: object
+            required:
+            - virtualMachineInstanceSelector
+            type: object
+          type: array
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinepool": `openAPIV3Schema:
+  description: VirtualMachinePool manages a set of VirtualMachines created from a common template. Every VirtualMachine of the pool gets its own DataVolumes from the dataVolumeTemplates of the template.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachinePoolSpec is the spec for a VirtualMachinePool resource
+      properties:
+        paused:
+          description: Indicates that the pool is paused.
+          type: boolean
+        replicas:
+          description: Number of desired VirtualMachines. This is a pointer to distinguish between explicit zero and not specified. Defaults to 1.
+          format: int32
+          type: integer
+        scaleDownVolumeClaimPolicy:
+          description: ScaleDownVolumeClaimPolicy defines what happens to the DataVolumes of removed VirtualMachines when the pool is scaled down. Defaults to Delete.
+          type: string
+        selector:
+          description: Label selector for VirtualMachines. It has to match the labels of the template.
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+        updateStrategy:
+          description: UpdateStrategy defines how existing VirtualMachines are updated when the template changes.
+          properties:
+            rollingUpdate:
+              description: RollingUpdate configures the RollingUpdate strategy.
+              properties:
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: The maximum number of VirtualMachines which may be restarted at the same time during an update. Value can be an absolute number or a percentage of the desired replicas. Defaults to 1.
+                  x-kubernetes-int-or-string: true
+              type: object
+            type:
+              description: Type of the update strategy. Defaults to RollingUpdate.
+              type: string
+          type: object
+        virtualMachineTemplate:
+          description: Template describes the VirtualMachines that will be created.
+          properties:
+            metadata:
+              nullable: true
+              type: object
+              x-kubernetes-preserve-unknown-fields: true
+            spec:
+              description: VirtualMachineSpec contains the VirtualMachine specification.
+              properties:
+                dataVolumeTemplates:
+                  description: dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference. DataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.
                   items:
-                    description: The pod this Toleration is attached to tolerates any taint that matches the triple <key,value,effect> using the matching operator <operator>.
+                    nullable: true
                     properties:
-                      effect:
-                        description: Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                      apiVersion:
+                        description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
                         type: string
-                      key:
-                        description: Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.
-                        type: string
-                      operator:
-                        description: Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.
-                        type: string
-                      tolerationSeconds:
-                        description: TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.
-                        format: int64
-                        type: integer
-                      value:
-                        description: Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.
+                      kind:
+                        description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
                         type: string
-                    type: object
-                  type: array
-                volumes:
-                  description: List of volumes that can be mounted by disks belonging to the vmi.
-                  items:
-                    description: Volume represents a named volume in a vmi.
-                    properties:
-                      cloudInitConfigDrive:
-                        description: 'CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
+                      metadata:
+                        nullable: true
+                        type: object
+                        x-kubernetes-preserve-unknown-fields: true
+                      spec:
+                        description: DataVolumeSpec contains the DataVolume specification.
                         properties:
-                          networkData:
-                            description: NetworkData contains config drive inline cloud-init networkdata.
-                            type: string
-                          networkDataBase64:
-                            description: NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.
+                          contentType:
+                            description: 'DataVolumeContentType options: "kubevirt", "archive"'
+                            enum:
+                            - kubevirt
+                            - archive
                             type: string
-                          networkDataSecretRef:
-                            description: NetworkDataSecretRef references a k8s secret that contains config drive networkdata.
+                          pvc:
+                            description: PVC is the PVC specification
                             properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                              accessModes:
+                                description: 'AccessModes contains the desired access modes the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#access-modes-1'
+                                items:
+                                  type: string
+                                type: array
+                              dataSource:
+                                description: 'This field can be used to specify either: * An existing VolumeSnapshot object (snapshot.storage.k8s.io/VolumeSnapshot) * An existing PVC (PersistentVolumeClaim) * An existing custom resource that implements data population (Alpha) In order to use custom resource types that implement data population, the AnyVolumeDataSource feature gate must be enabled. If the provisioner or an external controller can support the specified data source, it will create a new volume based on the contents of the specified data source.'
+                                properties:
+                                  apiGroup:
+                                    description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
+                                    type: string
+                                  kind:
+                                    description: Kind is the type of resource being referenced
+                                    type: string
+                                  name:
+                                    description: Name is the name of resource being referenced
+                                    type: string
+                                required:
+                                - kind
+                                - name
+                                type: object
+                              resources:
+                                description: 'Resources represents the minimum resources the volume should have. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#resources'
+                                properties:
+                                  limits:
+                                    additionalProperties:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    description: 'Limits describes the maximum amount of compute resources allowed. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/'
+                                    type: object
+                                  requests:
+                                    additionalProperties:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                      x-kubernetes-int-or-string: true
+                                    description: 'Requests describes the minimum amount of compute resources required. If Requests is omitted for a container, it defaults to Limits if that is explicitly specified, otherwise to an implementation-defined value. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/'
+                                    type: object
+                                type: object
+                              selector:
+                                description: A label query over volumes to consider for binding.
+                                properties:
+                                  matchExpressions:
+                                    description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                    items:
+                                      description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                      properties:
+                                        key:
+                                          description: key is the label key that the selector applies to.
+                                          type: string
+                                        operator:
+                                          description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                          type: string
+                                        values:
+                                          description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                          items:
+                                            type: string
+                                          type: array
+                                      required:
+                                      - key
+                                      - operator
+                                      type: object
+                                    type: array
+                                  matchLabels:
+                                    additionalProperties:
+                                      type: string
+                                    description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                    type: object
+                                type: object
+                              storageClassName:
+                                description: 'Name of the StorageClass required by the claim. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#class-1'
+                                type: string
+                              volumeMode:
+                                description: volumeMode defines what type of volume is required by the claim. Value of Filesystem is implied when not included in claim spec.
+                                type: string
+                              volumeName:
+                                description: VolumeName is the binding reference to the PersistentVolume backing this claim.
                                 type: string
                             type: object
-                          secretRef:
-                            description: UserDataSecretRef references a k8s secret that contains config drive userdata.
+                          source:
+                            description: Source is the src of the data for the requested DataVolume
                             properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
-                                type: string
+                              blank:
+                                description: DataVolumeBlankImage provides the parameters to create a new raw blank image for the PVC
+                                type: object
+                              http:
+                                description: DataVolumeSourceHTTP can be either an http or https endpoint, with an optional basic auth user name and password, and an optional configmap containing additional CAs
+                                properties:
+                                  certConfigMap:
+                                    description: CertConfigMap is a configmap reference, containing a Certificate Authority(CA) public key, and a base64 encoded pem certificate
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef A Secret reference, the secret should contain accessKeyId (user name) base64 encoded, and secretKey (password) also base64 encoded
+                                    type: string
+                                  url:
+                                    description: URL is the URL of the http(s) endpoint
+                                    type: string
+                                required:
+                                - url
+                                type: object
+                              imageio:
+                                description: DataVolumeSourceImageIO provides the parameters to create a Data Volume from an imageio source
+                                properties:
+                                  certConfigMap:
+                                    description: CertConfigMap provides a reference to the CA cert
+                                    type: string
+                                  diskId:
+                                    description: DiskID provides id of a disk to be imported
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef provides the secret reference needed to access the ovirt-engine
+                                    type: string
+                                  url:
+                                    description: URL is the URL of the ovirt-engine
+                                    type: string
+                                required:
+                                - diskId
+                                - url
+                                type: object
+                              pvc:
+                                description: DataVolumeSourcePVC provides the parameters to create a Data Volume from an existing PVC
+                                properties:
+                                  name:
+                                    description: The name of the source PVC
+                                    type: string
+                                  namespace:
+                                    description: The namespace of the source PVC
+                                    type: string
+                                required:
+                                - name
+                                - namespace
+                                type: object
+                              registry:
+                                description: DataVolumeSourceRegistry provides the parameters to create a Data Volume from an registry source
+                                properties:
+                                  certConfigMap:
+                                    description: CertConfigMap provides a reference to the Registry certs
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef provides the secret reference needed to access the Registry source
+                                    type: string
+                                  url:
+                                    description: URL is the url of the Docker registry source
+                                    type: string
+                                required:
+                                - url
+                                type: object
+                              s3:
+                                description: DataVolumeSourceS3 provides the parameters to create a Data Volume from an S3 source
+                                properties:
+                                  secretRef:
+                                    description: SecretRef provides the secret reference needed to access the S3 source
+                                    type: string
+                                  url:
+                                    description: URL is the url of the S3 source
+                                    type: string
+                                required:
+                                - url
+                                type: object
+                              upload:
+                                description: DataVolumeSourceUpload provides the parameters to create a Data Volume by uploading the source
+                                type: object
+                              vddk:
+                                description: DataVolumeSourceVDDK provides the parameters to create a Data Volume from a Vmware source
+                                properties:
+                                  backingFile:
+                                    description: BackingFile is the path to the virtual hard disk to migrate from vCenter/ESXi
+                                    type: string
+                                  secretRef:
+                                    description: SecretRef provides a reference to a secret containing the username and password needed to access the vCenter or ESXi host
+                                    type: string
+                                  thumbprint:
+                                    description: Thumbprint is the certificate thumbprint of the vCenter or ESXi host
+                                    type: string
+                                  url:
+                                    description: URL is the URL of the vCenter or ESXi host with the VM to migrate
+                                    type: string
+                                  uuid:
+                                    description: UUID is the UUID of the virtual machine that the backing file is attached to in vCenter/ESXi
+                                    type: string
+                                type: object
                             type: object
-                          userData:
-                            description: UserData contains config drive inline cloud-init userdata.
-                            type: string
-                          userDataBase64:
-                            description: UserDataBase64 contains config drive cloud-init userdata as a base64 encoded string.
-                            type: string
+                        required:
+                        - pvc
+                        - source
                         type: object
-                      cloudInitNoCloud:
-                        description: 'CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
-                        properties:
-                          networkData:
-                            description: NetworkData contains NoCloud inline cloud-init networkdata.
-                            type: string
-                          networkDataBase64:
-                            description: NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.
-                            type: string
-                          networkDataSecretRef:
-                            description: NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.
+                      status:
+                        description: DataVolumeTemplateDummyStatus is here simply for backwards compatibility with a previous API.
+                        nullable: true
+                        type: object
+                    required:
+                    - spec
+                    type: object
+                  type: array
+                instancetype:
+                  description: InstancetypeMatcher references a instancetype that is used to fill fields in Template
+                  properties:
+                    kind:
+                      description: 'Kind specifies which instancetype resource is referenced. Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype". If not specified, "VirtualMachineClusterInstancetype" is used by default.'
+                      type: string
+                    name:
+                      description: Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype
+                      type: string
+                    revisionName:
+                      description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+                      type: string
+                  required:
+                  - name
+                  type: object
+                preference:
+                  description: PreferenceMatcher references a set of preference that is used to fill fields in Template
+                  properties:
+                    kind:
+                      description: 'Kind specifies which preference resource is referenced. Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference". If not specified, "VirtualMachineClusterPreference" is used by default.'
+                      type: string
+                    name:
+                      description: Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference
+                      type: string
+                    revisionName:
+                      description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+                      type: string
+                  required:
+                  - name
+                  type: object
+                runStrategy:
+                  description: Running state indicates the requested running state of the VirtualMachineInstance mutually exclusive with Running
+                  type: string
+                running:
+                  description: Running controls whether the associatied VirtualMachineInstance is created or not Mutually exclusive with RunStrategy
+                  type: boolean
+                template:
+                  description: Template is the direct specification of VirtualMachineInstance
+                  properties:
+                    metadata:
+                      nullable: true
+                      type: object
+                      x-kubernetes-preserve-unknown-fields: true
+                    spec:
+                      description: VirtualMachineInstance Spec contains the VirtualMachineInstance specification.
+                      properties:
+                        accessCredentials:
+                          description: Specifies a set of public keys to inject into the vm guest
+                          items:
+                            description: AccessCredential represents a credential source that can be used to authorize remote access to the vm guest Only one of its members may be specified.
                             properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                              sshPublicKey:
+                                description: SSHPublicKey represents the source and method of applying a ssh public key into a guest virtual machine.
+                                properties:
+                                  propagationMethod:
+                                    description: PropagationMethod represents how the public key is injected into the vm guest.
+                                    properties:
+                                      configDrive:
+                                        description: ConfigDrivePropagation means that the ssh public keys are injected into the VM using metadata using the configDrive cloud-init provider
+                                        type: object
+                                      qemuGuestAgent:
+                                        description: QemuGuestAgentAccessCredentailPropagation means ssh public keys are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                                        properties:
+                                          users:
+                                            description: Users represents a list of guest users that should have the ssh public keys added to their authorized_keys file.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: set
+                                        required:
+                                        - users
+                                        type: object
+                                    type: object
+                                  source:
+                                    description: Source represents where the public keys are pulled from
+                                    properties:
+                                      secret:
+                                        description: Secret means that the access credential is pulled from a kubernetes secret
+                                        properties:
+                                          secretName:
+                                            description: SecretName represents the name of the secret in the VMI's namespace
+                                            type: string
+                                        required:
+                                        - secretName
+                                        type: object
+                                    type: object
+                                required:
+                                - propagationMethod
+                                - source
+                                type: object
+                              userPassword:
+                                description: UserPassword represents the source and method for applying a guest user's password
+                                properties:
+                                  propagationMethod:
+                                    description: propagationMethod represents how the user passwords are injected into the vm guest.
+                                    properties:
+                                      qemuGuestAgent:
+                                        description: QemuGuestAgentAccessCredentailPropagation means passwords are dynamically injected into the vm at runtime via the qemu guest agent. This feature requires the qemu guest agent to be running within the guest.
+                                        type: object
+                                    type: object
+                                  source:
+                                    description: Source represents where the user passwords are pulled from
+                                    properties:
+                                      secret:
+                                        description: Secret means that the access credential is pulled from a kubernetes secret
+                                        properties:
+                                          secretName:
+                                            description: SecretName represents the name of the secret in the VMI's namespace
+                                            type: string
+                                        required:
+                                        - secretName
+                                        type: object
+                                    type: object
+                                required:
+                                - propagationMethod
+                                - source
+                                type: object
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        affinity:
+                          description: If affinity is specifies, obey all the affinity rules
+                          properties:
+                            nodeAffinity:
+                              description: Describes node affinity scheduling rules for the pod.
+                              properties:
+                                preferredDuringSchedulingIgnoredDuringExecution:
+                                  description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node matches the corresponding matchExpressions; the node(s) with the highest sum are the most preferred.
+                                  items:
+                                    description: An empty preferred scheduling term matches all objects with implicit weight 0 (i.e. it's a no-op). A null preferred scheduling term matches no objects (i.e. is also a no-op).
+                                    properties:
+                                      preference:
+                                        description: A node selector term, associated with the corresponding weight.
+                                        properties:
+                                          matchExpressions:
+                                            description: A list of node selector requirements by node's labels.
+                                            items:
+                                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: The label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                                  type: string
+                                                values:
+                                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchFields:
+                                            description: A list of node selector requirements by node's fields.
+                                            items:
+                                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: The label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                                  type: string
+                                                values:
+                                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                        type: object
+                                      weight:
+                                        description: Weight associated with matching the corresponding nodeSelectorTerm, in the range 1-100.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - preference
+                                    - weight
+                                    type: object
+                                  type: array
+                                requiredDuringSchedulingIgnoredDuringExecution:
+                                  description: If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to an update), the system may or may not try to eventually evict the pod from its node.
+                                  properties:
+                                    nodeSelectorTerms:
+                                      description: Required. A list of node selector terms. The terms are ORed.
+                                      items:
+                                        description: A null or empty node selector term matches no objects. The requirements of them are ANDed. The TopologySelectorTerm type implements a subset of the NodeSelectorTerm.
+                                        properties:
+                                          matchExpressions:
+                                            description: A list of node selector requirements by node's labels.
+                                            items:
+                                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: The label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                                  type: string
+                                                values:
+                                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchFields:
+                                            description: A list of node selector requirements by node's fields.
+                                            items:
+                                              description: A node selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: The label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: Represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists, DoesNotExist. Gt, and Lt.
+                                                  type: string
+                                                values:
+                                                  description: An array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. If the operator is Gt or Lt, the values array must have a single element, which will be interpreted as an integer. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                        type: object
+                                      type: array
+                                  required:
+                                  - nodeSelectorTerms
+                                  type: object
+                              type: object
+                            podAffinity:
+                              description: Describes pod affinity scheduling rules (e.g. co-locate this pod in the same node, zone, etc. as some other pod(s)).
+                              properties:
+                                preferredDuringSchedulingIgnoredDuringExecution:
+                                  description: The scheduler will prefer to schedule pods to nodes that satisfy the affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.
+                                  items:
+                                    description: The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+                                    properties:
+                                      podAffinityTerm:
+                                        description: Required. A pod affinity term, associated with the corresponding weight.
+                                        properties:
+                                          labelSelector:
+                                            description: A label query over a set of resources, in this case pods.
+                                            properties:
+                                              matchExpressions:
+                                                description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                                items:
+                                                  description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                                  properties:
+                                                    key:
+                                                      description: key is the label key that the selector applies to.
+                                                      type: string
+                                                    operator:
+                                                      description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                      type: string
+                                                    values:
+                                                      description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                  required:
+                                                  - key
+                                                  - operator
+                                                  type: object
+                                                type: array
+                                              matchLabels:
+                                                additionalProperties:
+                                                  type: string
+                                                description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                type: object
+                                            type: object
+                                          namespaces:
+                                            description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                            items:
+                                              type: string
+                                            type: array
+                                          topologyKey:
+                                            description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                            type: string
+                                        required:
+                                        - topologyKey
+                                        type: object
+                                      weight:
+                                        description: weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - podAffinityTerm
+                                    - weight
+                                    type: object
+                                  type: array
+                                requiredDuringSchedulingIgnoredDuringExecution:
+                                  description: If the affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                                  items:
+                                    description: Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a pod of the set of pods is running
+                                    properties:
+                                      labelSelector:
+                                        description: A label query over a set of resources, in this case pods.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                            items:
+                                              description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                      namespaces:
+                                        description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                        items:
+                                          type: string
+                                        type: array
+                                      topologyKey:
+                                        description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                        type: string
+                                    required:
+                                    - topologyKey
+                                    type: object
+                                  type: array
+                              type: object
+                            podAntiAffinity:
+                              description: Describes pod anti-affinity scheduling rules (e.g. avoid putting this pod in the same node, zone, etc. as some other pod(s)).
+                              properties:
+                                preferredDuringSchedulingIgnoredDuringExecution:
+                                  description: The scheduler will prefer to schedule pods to nodes that satisfy the anti-affinity expressions specified by this field, but it may choose a node that violates one or more of the expressions. The node that is most preferred is the one with the greatest sum of weights, i.e. for each node that meets all of the scheduling requirements (resource request, requiredDuringScheduling anti-affinity expressions, etc.), compute a sum by iterating through the elements of this field and adding "weight" to the sum if the node has pods which matches the corresponding podAffinityTerm; the node(s) with the highest sum are the most preferred.
+                                  items:
+                                    description: The weights of all of the matched WeightedPodAffinityTerm fields are added per-node to find the most preferred node(s)
+                                    properties:
+                                      podAffinityTerm:
+                                        description: Required. A pod affinity term, associated with the corresponding weight.
+                                        properties:
+                                          labelSelector:
+                                            description: A label query over a set of resources, in this case pods.
+                                            properties:
+                                              matchExpressions:
+                                                description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                                items:
+                                                  description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                                  properties:
+                                                    key:
+                                                      description: key is the label key that the selector applies to.
+                                                      type: string
+                                                    operator:
+                                                      description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                      type: string
+                                                    values:
+                                                      description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                                      items:
+                                                        type: string
+                                                      type: array
+                                                  required:
+                                                  - key
+                                                  - operator
+                                                  type: object
+                                                type: array
+                                              matchLabels:
+                                                additionalProperties:
+                                                  type: string
+                                                description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                                type: object
+                                            type: object
+                                          namespaces:
+                                            description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                            items:
+                                              type: string
+                                            type: array
+                                          topologyKey:
+                                            description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                            type: string
+                                        required:
+                                        - topologyKey
+                                        type: object
+                                      weight:
+                                        description: weight associated with matching the corresponding podAffinityTerm, in the range 1-100.
+                                        format: int32
+                                        type: integer
+                                    required:
+                                    - podAffinityTerm
+                                    - weight
+                                    type: object
+                                  type: array
+                                requiredDuringSchedulingIgnoredDuringExecution:
+                                  description: If the anti-affinity requirements specified by this field are not met at scheduling time, the pod will not be scheduled onto the node. If the anti-affinity requirements specified by this field cease to be met at some point during pod execution (e.g. due to a pod label update), the system may or may not try to eventually evict the pod from its node. When there are multiple elements, the lists of nodes corresponding to each podAffinityTerm are intersected, i.e. all terms must be satisfied.
+                                  items:
+                                    description: Defines a set of pods (namely those matching the labelSelector relative to the given namespace(s)) that this pod should be co-located (affinity) or not co-located (anti-affinity) with, where co-located is defined as running on a node whose value of the label with key <topologyKey> matches that of any node on which a pod of the set of pods is running
+                                    properties:
+                                      labelSelector:
+                                        description: A label query over a set of resources, in this case pods.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+                                            items:
+                                              description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                      namespaces:
+                                        description: namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means "this pod's namespace"
+                                        items:
+                                          type: string
+                                        type: array
+                                      topologyKey:
+                                        description: This pod should be co-located (affinity) or not co-located (anti-affinity) with the pods matching the labelSelector in the specified namespaces, where co-located is defined as running on a node whose value of the label with key topologyKey matches that of any node on which any of the selected pods is running. Empty topologyKey is not allowed.
+                                        type: string
+                                    required:
+                                    - topologyKey
+                                    type: object
+                                  type: array
+                              type: object
+                          type: object
+                        dnsConfig:
+                          description: Specifies the DNS parameters of a pod. Parameters specified here will be merged to the generated DNS configuration based on DNSPolicy.
+                          properties:
+                            nameservers:
+                              description: A list of DNS name server IP addresses. This will be appended to the base nameservers generated from DNSPolicy. Duplicated nameservers will be removed.
+                              items:
                                 type: string
-                            type: object
-                          secretRef:
-                            description: UserDataSecretRef references a k8s secret that contains NoCloud userdata.
-                            properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                              type: array
+                            options:
+                              description: A list of DNS resolver options. This will be merged with the base options generated from DNSPolicy. Duplicated entries will be removed. Resolution options given in Options will override those that appear in the base DNSPolicy.
+                              items:
+                                description: PodDNSConfigOption defines DNS resolver options of a pod.
+                                properties:
+                                  name:
+                                    description: Required.
+                                    type: string
+                                  value:
+                                    type: string
+                                type: object
+                              type: array
+                            searches:
+                              description: A list of DNS search domains for host-name lookup. This will be appended to the base search paths generated from DNSPolicy. Duplicated search paths will be removed.
+                              items:
                                 type: string
-                            type: object
-                          userData:
-                            description: UserData contains NoCloud inline cloud-init userdata.
-                            type: string
-                          userDataBase64:
-                            description: UserDataBase64 contains NoCloud cloud-init userdata as a base64 encoded string.
-                            type: string
-                        type: object
-                      configMap:
-                        description: 'ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
-                        properties:
-                          name:
-                            description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
-                            type: string
-                          optional:
-                            description: Specify whether the ConfigMap or it's keys must be defined
-                            type: boolean
-                          volumeLabel:
-                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
-                        type: object
-                      containerDisk:
-                        description: 'ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
-                        properties:
-                          image:
-                            description: Image is the name of the image with the embedded disk.
-                            type: string
-                          imagePullPolicy:
-                            description: 'Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
-                            type: string
-                          imagePullSecret:
-                            description: ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.
-                            type: string
-                          path:
-                            description: Path defines the path to disk file in the container
-                            type: string
-                        required:
-                        - image
-                        type: object
-                      dataVolume:
-                        description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
-                        properties:
-                          name:
-                            description: Name represents the name of the DataVolume in the same namespace
-                            type: string
-                        required:
-                        - name
-                        type: object
-                      downwardAPI:
-                        description: DownwardAPI represents downward API about the pod that should populate this volume
-                        properties:
-                          fields:
-                            description: Fields is a list of downward API volume file
-                            items:
-                              description: DownwardAPIVolumeFile represents information to create the file containing the pod field
+                              type: array
+                          type: object
+                        dnsPolicy:
+                          description: Set DNS policy for the pod. Defaults to "ClusterFirst". Valid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'. DNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy. To have DNS options set along with hostNetwork, you have to specify DNS policy explicitly to 'ClusterFirstWithHostNet'.
+                          type: string
+                        domain:
+                          description: Specification of the desired behavior of the VirtualMachineInstance on the host.
+                          properties:
+                            chassis:
+                              description: Chassis specifies the chassis info passed to the domain.
                               properties:
-                                fieldRef:
-                                  description: 'Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.'
+                                asset:
+                                  type: string
+                                manufacturer:
+                                  type: string
+                                serial:
+                                  type: string
+                                sku:
+                                  type: string
+                                version:
+                                  type: string
+                              type: object
+                            clock:
+                              description: Clock sets the clock and timers of the vmi.
+                              properties:
+                                timer:
+                                  description: Timer specifies whih timers are attached to the vmi.
+                                  properties:
+                                    hpet:
+                                      description: HPET (High Precision Event Timer) - multiple timers with periodic interrupts.
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                        tickPolicy:
+                                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "merge", "discard".
+                                          type: string
+                                      type: object
+                                    hyperv:
+                                      description: Hyperv (Hypervclock) - lets guests read the host’s wall clock time (paravirtualized). For windows guests.
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    kvm:
+                                      description: "KVM \t(KVM clock) - lets guests read the host’s wall clock time (paravirtualized). For linux guests."
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    pit:
+                                      description: PIT (Programmable Interval Timer) - a timer with periodic interrupts.
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                        tickPolicy:
+                                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "discard".
+                                          type: string
+                                      type: object
+                                    rtc:
+                                      description: RTC (Real Time Clock) - a continuously running timer with periodic interrupts.
+                                      properties:
+                                        present:
+                                          description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                                          type: boolean
+                                        tickPolicy:
+                                          description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup".
+                                          type: string
+                                        track:
+                                          description: Track the guest or the wall clock.
+                                          type: string
+                                      type: object
+                                  type: object
+                                timezone:
+                                  description: Timezone sets the guest clock to the specified timezone. Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                                  type: string
+                                utc:
+                                  description: UTC sets the guest clock to UTC on each boot. If an offset is specified, guest changes to the clock will be kept during reboots and are not reset.
+                                  properties:
+                                    offsetSeconds:
+                                      description: OffsetSeconds specifies an offset in seconds, relative to UTC. If set, guest changes to the clock will be kept during reboots and not reset.
+                                      type: integer
+                                  type: object
+                              type: object
+                              x-kubernetes-preserve-unknown-fields: true
+                            cpu:
+                              description: CPU allow specified the detailed CPU topology inside the vmi.
+                              properties:
+                                cores:
+                                  description: Cores specifies the number of cores inside the vmi. Must be a value greater or equal 1.
+                                  format: int32
+                                  type: integer
+                                dedicatedCpuPlacement:
+                                  description: DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node with enough dedicated pCPUs and pin the vCPUs to it.
+                                  type: boolean
+                                features:
+                                  description: Features specifies the CPU features list inside the VMI.
+                                  items:
+                                    description: CPUFeature allows specifying a CPU feature.
+                                    properties:
+                                      name:
+                                        description: Name of the CPU feature
+                                        type: string
+                                      policy:
+                                        description: 'Policy is the CPU feature attribute which can have the following attributes: force    - The virtual CPU will claim the feature is supported regardless of it being supported by host CPU. require  - Guest creation will fail unless the feature is supported by the host CPU or the hypervisor is able to emulate it. optional - The feature will be supported by virtual CPU if and only if it is supported by host CPU. disable  - The feature will not be supported by virtual CPU. forbid   - Guest creation will fail if the feature is supported by host CPU. Defaults to require'
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                isolateEmulatorThread:
+                                  description: IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place the emulator thread on it.
+                                  type: boolean
+                                model:
+                                  description: Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like "host-passthrough" to get the same CPU as the node and "host-model" to get CPU closest to the node one. Defaults to host-model.
+                                  type: string
+                                sockets:
+                                  description: Sockets specifies the number of sockets inside the vmi. Must be a value greater or equal 1.
+                                  format: int32
+                                  type: integer
+                                threads:
+                                  description: Threads specifies the number of threads inside the vmi. Must be a value greater or equal 1.
+                                  format: int32
+                                  type: integer
+                              type: object
+                            devices:
+                              description: Devices allows adding disks, network interfaces, and others
+                              properties:
+                                autoattachGraphicsDevice:
+                                  description: Whether to attach the default graphics device or not. VNC will not be available if set to false. Defaults to true.
+                                  type: boolean
+                                autoattachMemBalloon:
+                                  description: Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.
+                                  type: boolean
+                                autoattachPodInterface:
+                                  description: Whether to attach a pod network interface. Defaults to true.
+                                  type: boolean
+                                autoattachSerialConsole:
+                                  description: Whether to attach the default serial console or not. Serial console access will not be available if set to false. Defaults to true.
+                                  type: boolean
+                                blockMultiQueue:
+                                  description: Whether or not to enable virtio multi-queue for block devices
+                                  type: boolean
+                                disableHotplug:
+                                  description: DisableHotplug disabled the ability to hotplug disks.
+                                  type: boolean
+                                disks:
+                                  description: Disks describes disks, cdroms, floppy and luns which are connected to the vmi.
+                                  items:
+                                    properties:
+                                      bootOrder:
+                                        description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each disk or interface that has a boot order must have a unique value. Disks without a boot order are not tried if a disk with a boot order exists.
+                                        type: integer
+                                      cache:
+                                        description: Cache specifies which kvm disk cache mode should be used.
+                                        type: string
+                                      cdrom:
+                                        description: Attach a volume as a cdrom to the vmi.
+                                        properties:
+                                          bus:
+                                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                            type: string
+                                          readonly:
+                                            description: ReadOnly. Defaults to true.
+                                            type: boolean
+                                          tray:
+                                            description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                                            type: string
+                                        type: object
+                                      changedBlockTracking:
+                                        description: ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup, in a persistent dirty bitmap. Only disks with changed block tracking are backed up. Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks. Defaults to false.
+                                        type: boolean
+                                      dedicatedIOThread:
+                                        description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
+                                        type: boolean
+                                      disk:
+                                        description: Attach a volume as a disk to the vmi.
+                                        properties:
+                                          bus:
+                                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                            type: string
+                                          pciAddress:
+                                            description: 'If specified, the virtual disk will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
+                                            type: string
+                                          readonly:
+                                            description: ReadOnly. Defaults to false.
+                                            type: boolean
+                                        type: object
+                                      floppy:
+                                        description: Attach a volume as a floppy to the vmi.
+                                        properties:
+                                          readonly:
+                                            description: ReadOnly. Defaults to false.
+                                            type: boolean
+                                          tray:
+                                            description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
+                                            type: string
+                                        type: object
+                                      io:
+                                        description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
+                                        type: string
+                                      ioTune:
+                                        description: IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs through the setblockiotune subresource, the limits in effect are reported in the volume status.
+                                        properties:
+                                          groupName:
+                                            description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
+                                            type: string
+                                          readBytesSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          readBytesSecMax:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          readBytesSecMaxLength:
+                                            description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSec:
+                                            description: ReadIOPSSec is the limit of read operations per second.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSecMax:
+                                            description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSecMaxLength:
+                                            description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          sizeIOPSSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          totalBytesSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          totalBytesSecMax:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          totalBytesSecMaxLength:
+                                            description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSec:
+                                            description: TotalIOPSSec is the limit of read and write operations per second.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSecMax:
+                                            description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSecMaxLength:
+                                            description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          writeBytesSecMax:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          writeBytesSecMaxLength:
+                                            description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSec:
+                                            description: WriteIOPSSec is the limit of write operations per second.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSecMax:
+                                            description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSecMaxLength:
+                                            description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                                            format: int64
+                                            type: integer
+                                        type: object
+                                      lun:
+                                        description: Attach a volume as a LUN to the vmi.
+                                        properties:
+                                          bus:
+                                            description: 'Bus indicates the type of disk device to emulate. supported values: virtio, sata, scsi.'
+                                            type: string
+                                          readonly:
+                                            description: ReadOnly. Defaults to false.
+                                            type: boolean
+                                        type: object
+                                      name:
+                                        description: Name is the device name
+                                        type: string
+                                      serial:
+                                        description: Serial provides the ability to specify a serial number for the disk device.
+                                        type: string
+                                      tag:
+                                        description: If specified, disk address and its tag will be provided to the guest via config drive metadata
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                filesystems:
+                                  description: Filesystems describes filesystem which is connected to the vmi.
+                                  items:
+                                    properties:
+                                      name:
+                                        description: Name is the device name
+                                        type: string
+                                      virtiofs:
+                                        description: Virtiofs is supported
+                                        type: object
+                                    required:
+                                    - name
+                                    - virtiofs
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                gpus:
+                                  description: Whether to attach a GPU device to the vmi.
+                                  items:
+                                    properties:
+                                      deviceName:
+                                        type: string
+                                      name:
+                                        description: Name of the GPU device as exposed by a device plugin
+                                        type: string
+                                    required:
+                                    - deviceName
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                hostDevices:
+                                  description: Whether to attach a host device to the vmi.
+                                  items:
+                                    properties:
+                                      deviceName:
+                                        description: DeviceName is the resource name of the host device exposed by a device plugin
+                                        type: string
+                                      name:
+                                        type: string
+                                    required:
+                                    - deviceName
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                inputs:
+                                  description: Inputs describe input devices
+                                  items:
+                                    properties:
+                                      bus:
+                                        description: 'Bus indicates the bus of input device to emulate. Supported values: virtio, usb.'
+                                        type: string
+                                      name:
+                                        description: Name is the device name
+                                        type: string
+                                      type:
+                                        description: 'Type indicated the type of input device. Supported values: tablet.'
+                                        type: string
+                                    required:
+                                    - name
+                                    - type
+                                    type: object
+                                  type: array
+                                interfaces:
+                                  description: Interfaces describe network interfaces which are added to the vmi.
+                                  items:
+                                    properties:
+                                      bandwidth:
+                                        description: If specified, the traffic of the interface is limited. Only interfaces backed by a tap device, bridge, masquerade and macvtap, can be limited.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic received by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of bytes which can be sent at the peak rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic sent by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of bytes which can be sent at the peak rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
+                                      binding:
+                                        description: Binding specifies the binding plugin which will be used to connect the interface to the guest. The plugin must be registered in the network configuration of KubeVirt. It is mutually exclusive with the BindingMethod.
+                                        properties:
+                                          name:
+                                            description: Name references a binding plugin registered in the network configuration of KubeVirt.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      bootOrder:
+                                        description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
+                                        type: integer
+                                      bridge:
+                                        type: object
+                                      dhcpOptions:
+                                        description: If specified the network interface will pass additional DHCP options to the VMI
+                                        properties:
+                                          bootFileName:
+                                            description: If specified will pass option 67 to interface's DHCP server
+                                            type: string
+                                          ntpServers:
+                                            description: If specified will pass the configured NTP server to the VM via DHCP option 042.
+                                            items:
+                                              type: string
+                                            type: array
+                                          privateOptions:
+                                            description: 'If specified will pass extra DHCP options for private use, range: 224-254'
+                                            items:
+                                              description: DHCPExtraOptions defines Extra DHCP options for a VM.
+                                              properties:
+                                                option:
+                                                  description: Option is an Integer value from 224-254 Required.
+                                                  type: integer
+                                                value:
+                                                  description: Value is a String value for the Option provided Required.
+                                                  type: string
+                                              required:
+                                              - option
+                                              - value
+                                              type: object
+                                            type: array
+                                          routerAdvertisements:
+                                            description: If set the IPv6 network of the interface is announced with router advertisements, so that the VM learns its default route and the prefix of the network. The advertisements need the NET_RAW capability, which is then granted to the virt-launcher pod. Implied by slaac.
+                                            type: boolean
+                                          routes:
+                                            description: If specified will pass the routes to the VM in addition to the routes of the pod network. IPv4 routes are passed via DHCP option 121, IPv6 routes via the route information of IPv6 router advertisements.
+                                            items:
+                                              description: DHCPRoute is a route passed to the VM.
+                                              properties:
+                                                destination:
+                                                  description: Destination of the route in CIDR notation, e.g. 192.168.20.0/24.
+                                                  type: string
+                                                gateway:
+                                                  description: Gateway of an IPv4 route, the destination is on link when not set. IPv6 routes always go through the router of the interface.
+                                                  type: string
+                                              required:
+                                              - destination
+                                              type: object
+                                            type: array
+                                          searchDomains:
+                                            description: If specified will pass the search domains to the VM instead of the search domains of the pod, via DHCP option 119, DHCPv6 option 24 and IPv6 router advertisements.
+                                            items:
+                                              type: string
+                                            type: array
+                                          slaac:
+                                            description: If set the IPv6 network of the interface is advertised for stateless address autoconfiguration (SLAAC), for guests which can not use DHCPv6. Only supported on interfaces with the masquerade binding whose vmIPv6NetworkCIDR has a prefix length of 64.
+                                            type: boolean
+                                          staticLease:
+                                            description: If specified the address is leased to the VM instead of the address of the pod interface. Only supported on interfaces with the bridge binding.
+                                            properties:
+                                              address:
+                                                description: Address with its prefix length in CIDR notation, e.g. 192.168.10.5/24.
+                                                type: string
+                                              gateway:
+                                                description: Gateway passed to the VM as its default route.
+                                                type: string
+                                            required:
+                                            - address
+                                            type: object
+                                          tftpServerName:
+                                            description: If specified will pass option 66 to interface's DHCP server
+                                            type: string
+                                        type: object
+                                      macAddress:
+                                        description: 'Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.'
+                                        type: string
+                                      macvtap:
+                                        type: object
+                                      masquerade:
+                                        properties:
+                                          blockedDestinations:
+                                            description: BlockedDestinations lists networks, in CIDR notation, which the guest must not reach through the masquerade.
+                                            items:
+                                              type: string
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          portMappings:
+                                            description: PortMappings forward ports, or ranges of ports, of the pod to the guest. Unless ports or port mappings are listed, all ports are forwarded.
+                                            items:
+                                              description: MasqueradePortMapping forwards a port, or a range of ports, of the pod to the guest.
+                                              properties:
+                                                endPort:
+                                                  description: EndPort is the last port of a range of ports, which are forwarded to the same ports of the guest.
+                                                  format: int32
+                                                  type: integer
+                                                guestPort:
+                                                  description: GuestPort is the port of the guest the pod port is forwarded to. Defaults to Port. Can not be combined with EndPort.
+                                                  format: int32
+                                                  type: integer
+                                                name:
+                                                  description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Only mappings of a single port are named.
+                                                  type: string
+                                                port:
+                                                  description: Port of the pod, the first port of the range when EndPort is set. This must be a valid port number, 0 < x < 65536.
+                                                  format: int32
+                                                  type: integer
+                                                protocol:
+                                                  description: Protocol of the mapping. Must be TCP, UDP or SCTP. Defaults to "TCP".
+                                                  type: string
+                                              required:
+                                              - port
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                        type: object
+                                      model:
+                                        description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
+                                        type: string
+                                      name:
+                                        description: Logical name of the interface as well as a reference to the associated networks. Must match the Name of a Network.
+                                        type: string
+                                      pciAddress:
+                                        description: 'If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10'
+                                        type: string
+                                      ports:
+                                        description: List of ports to be forwarded to the virtual machine.
+                                        items:
+                                          description: Port repesents a port to expose from the virtual machine. Default protocol TCP. The port field is mandatory
+                                          properties:
+                                            name:
+                                              description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Each named port in a pod must have a unique name. Name for the port that can be referred to by services.
+                                              type: string
+                                            port:
+                                              description: Number of port to expose for the virtual machine. This must be a valid port number, 0 < x < 65536.
+                                              format: int32
+                                              type: integer
+                                            protocol:
+                                              description: Protocol for port. Must be UDP or TCP. Defaults to "TCP".
+                                              type: string
+                                          required:
+                                          - port
+                                          type: object
+                                        type: array
+                                      slirp:
+                                        type: object
+                                      sriov:
+                                        properties:
+                                          standbyInterface:
+                                            description: StandbyInterface is the name of a virtio interface with the bridge binding, connected to the same network, which takes over the traffic of the guest while the VF is unplugged during a live migration. Both interfaces must have the same MAC address.
+                                            type: string
+                                        type: object
+                                      tag:
+                                        description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                maxHotpluggedDisks:
+                                  description: MaxHotpluggedDisks is the number of disks which can be hotplugged on the virtio bus. A PCIe root port is reserved for each of them when the VirtualMachineInstance starts. Defaults to 0, which only allows to hotplug disks on the scsi bus.
+                                  format: int32
+                                  type: integer
+                                networkInterfaceMultiqueue:
+                                  description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
+                                  type: boolean
+                                rng:
+                                  description: Whether to have random number generator from host
+                                  type: object
+                                useVirtioTransitional:
+                                  description: Fall back to legacy virtio 0.9 support if virtio bus is selected on devices. This is helpful for old machines like CentOS6 or RHEL6 which do not understand virtio_non_transitional (virtio 1.0).
+                                  type: boolean
+                                watchdog:
+                                  description: Watchdog describes a watchdog device which can be added to the vmi.
                                   properties:
-                                    apiVersion:
-                                      description: Version of the schema the FieldPath is written in terms of, defaults to "v1".
-                                      type: string
-                                    fieldPath:
-                                      description: Path of the field to select in the specified API version.
+                                    i6300esb:
+                                      description: i6300esb watchdog device.
+                                      properties:
+                                        action:
+                                          description: The action to take. Valid values are poweroff, reset, shutdown. Defaults to reset.
+                                          type: string
+                                      type: object
+                                    name:
+                                      description: Name of the watchdog.
                                       type: string
                                   required:
-                                  - fieldPath
+                                  - name
                                   type: object
-                                mode:
-                                  description: 'Optional: mode bits used to set permissions on this file, must be an octal value between 0000 and 0777 or a decimal value between 0 and 511. YAML accepts both octal and decimal values, JSON requires decimal values for mode bits. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.'
-                                  format: int32
-                                  type: integer
-                                path:
-                                  description: 'Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the ''..'' path. Must be utf-8 encoded. The first item of the relative path must not start with ''..'''
+                              type: object
+                            features:
+                              description: Features like acpi, apic, hyperv, smm.
+                              properties:
+                                acpi:
+                                  description: ACPI enables/disables ACPI inside the guest. Defaults to enabled.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                      type: boolean
+                                  type: object
+                                apic:
+                                  description: Defaults to the machine type setting.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                      type: boolean
+                                    endOfInterrupt:
+                                      description: EndOfInterrupt enables the end of interrupt notification in the guest. Defaults to false.
+                                      type: boolean
+                                  type: object
+                                hyperv:
+                                  description: Defaults to the machine type setting.
+                                  properties:
+                                    evmcs:
+                                      description: EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    frequencies:
+                                      description: Frequencies improves the TSC clock source handling for Hyper-V on KVM. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    ipi:
+                                      description: IPI improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    reenlightenment:
+                                      description: Reenlightenment enables the notifications on TSC frequency changes. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    relaxed:
+                                      description: Relaxed instructs the guest OS to disable watchdog timeouts. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    reset:
+                                      description: Reset enables Hyperv reboot/reset for the vmi. Requires synic. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    runtime:
+                                      description: Runtime improves the time accounting to improve scheduling in the guest. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    spinlocks:
+                                      description: Spinlocks allows to configure the spinlock retry attempts.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                        spinlocks:
+                                          description: Retries indicates the number of retries. Must be a value greater or equal 4096. Defaults to 4096.
+                                          format: int32
+                                          type: integer
+                                      type: object
+                                    synic:
+                                      description: SyNIC enables the Synthetic Interrupt Controller. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    synictimer:
+                                      description: SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    tlbflush:
+                                      description: TLBFlush improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    vapic:
+                                      description: VAPIC improves the paravirtualized handling of interrupts. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                    vendorid:
+                                      description: VendorID allows setting the hypervisor vendor id. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                        vendorid:
+                                          description: VendorID sets the hypervisor vendor id, visible to the vmi. String up to twelve characters.
+                                          type: string
+                                      type: object
+                                    vpindex:
+                                      description: VPIndex enables the Virtual Processor Index to help windows identifying virtual processors. Defaults to the machine type setting.
+                                      properties:
+                                        enabled:
+                                          description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                          type: boolean
+                                      type: object
+                                  type: object
+                                kvm:
+                                  description: Configure how KVM presence is exposed to the guest.
+                                  properties:
+                                    hidden:
+                                      description: Hide the KVM hypervisor from standard MSR based discovery. Defaults to false
+                                      type: boolean
+                                  type: object
+                                pvspinlock:
+                                  description: Notify the guest that the host supports paravirtual spinlocks. For older kernels this feature should be explicitly disabled.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                      type: boolean
+                                  type: object
+                                smm:
+                                  description: SMM enables/disables System Management Mode. TSEG not yet implemented.
+                                  properties:
+                                    enabled:
+                                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                                      type: boolean
+                                  type: object
+                              type: object
+                            firmware:
+                              description: Firmware.
+                              properties:
+                                bootloader:
+                                  description: Settings to control the bootloader that is used.
+                                  properties:
+                                    bios:
+                                      description: If set (default), BIOS will be used.
+                                      properties:
+                                        useSerial:
+                                          description: If set, the BIOS output will be transmitted over serial
+                                          type: boolean
+                                      type: object
+                                    efi:
+                                      description: If set, EFI will be used instead of BIOS.
+                                      properties:
+                                        secureBoot:
+                                          description: If set, SecureBoot will be enabled and the OVMF roms will be swapped for SecureBoot-enabled ones. Requires SMM to be enabled. Defaults to true
+                                          type: boolean
+                                      type: object
+                                  type: object
+                                serial:
+                                  description: The system-serial-number in SMBIOS
                                   type: string
-                                resourceFieldRef:
-                                  description: 'Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.'
+                                uuid:
+                                  description: UUID reported by the vmi bios. Defaults to a random generated uid.
+                                  type: string
+                              type: object
+                            ioThreadsPolicy:
+                              description: 'Controls whether or not disks will share IOThreads. Omitting IOThreadsPolicy disables use of IOThreads. One of: shared, auto'
+                              type: string
+                            machine:
+                              description: Machine type.
+                              properties:
+                                type:
+                                  description: QEMU machine type is the actual chipset of the VirtualMachineInstance.
+                                  type: string
+                              required:
+                              - type
+                              type: object
+                            memory:
+                              description: Memory allow specifying the VMI memory features.
+                              properties:
+                                guest:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Guest allows to specifying the amount of memory which is visible inside the Guest OS. The Guest must lie between Requests and Limits from the resources section. Defaults to the requested memory in the resources section if not specified.
+                                  pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                  x-kubernetes-int-or-string: true
+                                hugepages:
+                                  description: Hugepages allow to use hugepages for the VirtualMachineInstance instead of regular memory.
                                   properties:
-                                    containerName:
-                                      description: 'Container name: required for volumes, optional for env vars'
-                                      type: string
-                                    divisor:
-                                      anyOf:
-                                      - type: integer
-                                      - type: string
-                                      description: Specifies the output format of the exposed resources, defaults to "1"
-                                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                                      x-kubernetes-int-or-string: true
-                                    resource:
-                                      description: 'Required: resource to select'
+                                    pageSize:
+                                      description: PageSize specifies the hugepage size, for x86_64 architecture valid values are 1Gi and 2Mi.
                                       type: string
-                                  required:
-                                  - resource
                                   type: object
+                              type: object
+                            resources:
+                              description: Resources describes the Compute Resources required by this vmi.
+                              properties:
+                                limits:
+                                  additionalProperties:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  description: Limits describes the maximum amount of compute resources allowed. Valid resource keys are "memory" and "cpu".
+                                  type: object
+                                overcommitGuestOverhead:
+                                  description: Don't ask the scheduler to take the guest-management overhead into account. Instead put the overhead only into the container's memory limit. This can lead to crashes if all memory is in use on a node. Defaults to false.
+                                  type: boolean
+                                requests:
+                                  additionalProperties:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  description: Requests is a description of the initial vmi resources. Valid resource keys are "memory" and "cpu".
+                                  type: object
+                              type: object
+                          required:
+                          - devices
+                          type: object
+                        evictionStrategy:
+                          description: EvictionStrategy can be set to "LiveMigrate" if the VirtualMachineInstance should be migrated instead of shut-off in case of a node drain.
+                          type: string
+                        hostname:
+                          description: Specifies the hostname of the vmi If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
+                          type: string
+                        livenessProbe:
+                          description: 'Periodic probe of VirtualMachineInstance liveness. VirtualmachineInstances will be stopped if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                          properties:
+                            failureThreshold:
+                              description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            httpGet:
+                              description: HTTPGet specifies the http request to perform.
+                              properties:
+                                host:
+                                  description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                                  type: string
+                                httpHeaders:
+                                  description: Custom headers to set in the request. HTTP allows repeated headers.
+                                  items:
+                                    description: HTTPHeader describes a custom header to be used in HTTP probes
+                                    properties:
+                                      name:
+                                        description: The header field name
+                                        type: string
+                                      value:
+                                        description: The header field value
+                                        type: string
+                                    required:
+                                    - name
+                                    - value
+                                    type: object
+                                  type: array
+                                path:
+                                  description: Path to access on the HTTP server.
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                                scheme:
+                                  description: Scheme to use for connecting to the host. Defaults to HTTP.
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            initialDelaySeconds:
+                              description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                              format: int32
+                              type: integer
+                            periodSeconds:
+                              description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            successThreshold:
+                              description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            tcpSocket:
+                              description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                              properties:
+                                host:
+                                  description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
                               required:
-                              - path
+                              - port
                               type: object
-                            type: array
-                          volumeLabel:
-                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
-                        type: object
-                      emptyDisk:
-                        description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
-                        properties:
-                          capacity:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            description: Capacity of the sparse disk.
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                        required:
-                        - capacity
-                        type: object
-                      ephemeral:
-                        description: Ephemeral is a special volume source that "wraps" specified source and provides copy-on-write image on top of it.
-                        properties:
-                          persistentVolumeClaim:
-                            description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                            timeoutSeconds:
+                              description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                              format: int32
+                              type: integer
+                          type: object
+                        networks:
+                          description: List of networks that can be attached to a vm's virtual interface.
+                          items:
+                            description: Network represents a network type and a resource that should be connected to the vm.
                             properties:
-                              claimName:
-                                description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                              multus:
+                                description: Represents the multus cni network.
+                                properties:
+                                  default:
+                                    description: Select the default network and add it to the multus-cni.io/default-network annotation.
+                                    type: boolean
+                                  networkName:
+                                    description: 'References to a NetworkAttachmentDefinition CRD object. Format: <networkName>, <namespace>/<networkName>. If namespace is not specified, VMI namespace is assumed.'
+                                    type: string
+                                required:
+                                - networkName
+                                type: object
+                              name:
+                                description: 'Network name. Must be a DNS_LABEL and unique within the vm. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
                                 type: string
-                              readOnly:
-                                description: Will force the ReadOnly setting in VolumeMounts. Default false.
-                                type: boolean
+                              pod:
+                                description: Represents the stock pod network interface.
+                                properties:
+                                  vmIPv6NetworkCIDR:
+                                    description: IPv6 CIDR for the vm network. Default fd10:0:2::/120 if not specified.
+                                    type: string
+                                  vmNetworkCIDR:
+                                    description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
+                                    type: string
+                                type: object
                             required:
-                            - claimName
+                            - name
                             type: object
-                        type: object
-                      hostDisk:
-                        description: HostDisk represents a disk created on the cluster level
-                        properties:
-                          capacity:
-                            anyOf:
-                            - type: integer
-                            - type: string
-                            description: Capacity of the sparse disk
-                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-                            x-kubernetes-int-or-string: true
-                          path:
-                            description: The path to HostDisk image located on the cluster
-                            type: string
-                          shared:
-                            description: Shared indicate whether the path is shared between nodes
-                            type: boolean
-                          type:
-                            description: Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'
-                            type: string
-                        required:
-                        - path
-                        - type
-                        type: object
-                      name:
-                        description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
-                        type: string
-                      persistentVolumeClaim:
-                        description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
-                        properties:
-                          claimName:
-                            description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
-                            type: string
-                          readOnly:
-                            description: Will force the ReadOnly setting in VolumeMounts. Default false.
-                            type: boolean
-                        required:
-                        - claimName
-                        type: object
-                      secret:
-                        description: 'SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
-                        properties:
-                          optional:
-                            description: Specify whether the Secret or it's keys must be defined
-                            type: boolean
-                          secretName:
-                            description: 'Name of the secret in the pod''s namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
-                            type: string
-                          volumeLabel:
-                            description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
-                            type: string
-                        type: object
-                      serviceAccount:
-                        description: 'ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
-                        properties:
-                          serviceAccountName:
-                            description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                          type: array
+                        nodeSelector:
+                          additionalProperties:
                             type: string
-                        type: object
-                      sysprep:
-                        description: Represents a Sysprep volume source.
-                        properties:
-                          configMap:
-                            description: ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
+                          description: 'NodeSelector is a selector which must be true for the vmi to fit on a node. Selector which must match a node''s labels for the vmi to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/'
+                          type: object
+                        priorityClassName:
+                          description: If specified, indicates the pod's priority. If not specified, the pod priority will be default or zero if there is no default.
+                          type: string
+                        readinessProbe:
+                          description: 'Periodic probe of VirtualMachineInstance service readiness. VirtualmachineInstances will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                          properties:
+                            failureThreshold:
+                              description: Minimum consecutive failures for the probe to be considered failed after having succeeded. Defaults to 3. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            httpGet:
+                              description: HTTPGet specifies the http request to perform.
+                              properties:
+                                host:
+                                  description: Host name to connect to, defaults to the pod IP. You probably want to set "Host" in httpHeaders instead.
+                                  type: string
+                                httpHeaders:
+                                  description: Custom headers to set in the request. HTTP allows repeated headers.
+                                  items:
+                                    description: HTTPHeader describes a custom header to be used in HTTP probes
+                                    properties:
+                                      name:
+                                        description: The header field name
+                                        type: string
+                                      value:
+                                        description: The header field value
+                                        type: string
+                                    required:
+                                    - name
+                                    - value
+                                    type: object
+                                  type: array
+                                path:
+                                  description: Path to access on the HTTP server.
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Name or number of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                                scheme:
+                                  description: Scheme to use for connecting to the host. Defaults to HTTP.
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            initialDelaySeconds:
+                              description: 'Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                              format: int32
+                              type: integer
+                            periodSeconds:
+                              description: How often (in seconds) to perform the probe. Default to 10 seconds. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            successThreshold:
+                              description: Minimum consecutive successes for the probe to be considered successful after having failed. Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            tcpSocket:
+                              description: 'TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported TODO: implement a realistic TCP lifecycle hook'
+                              properties:
+                                host:
+                                  description: 'Optional: Host name to connect to, defaults to the pod IP.'
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: Number or name of the port to access on the container. Number must be in the range 1 to 65535. Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                              required:
+                              - port
+                              type: object
+                            timeoutSeconds:
+                              description: 'Number of seconds after which the probe times out. Defaults to 1 second. Minimum value is 1. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes'
+                              format: int32
+                              type: integer
+                          type: object
+                        schedulerName:
+                          description: If specified, the VMI will be dispatched by specified scheduler. If not specified, the VMI will be dispatched by default scheduler.
+                          type: string
+                        subdomain:
+                          description: If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>". If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi, no matter if the vmi itself can pick up a hostname.
+                          type: string
+                        terminationGracePeriodSeconds:
+                          description: Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
+                          format: int64
+                          type: integer
+                        tolerations:
+                          description: If toleration is specified, obey all the toleration rules.
+                          items:
+                            description: The pod this Toleration is attached to tolerates any taint that matches the triple <key,value,effect> using the matching operator <operator>.
                             properties:
-                              name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                              effect:
+                                description: Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                                type: string
+                              key:
+                                description: Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                                type: string
+                              operator:
+                                description: Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.
+                                type: string
+                              tolerationSeconds:
+                                description: TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.
+                                format: int64
+                                type: integer
+                              value:
+                                description: Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.
                                 type: string
                             type: object
-                          secret:
-                            description: Secret references a k8s Secret that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
+                          type: array
+                        volumes:
+                          description: List of volumes that can be mounted by disks belonging to the vmi.
+                          items:
+                            description: Volume represents a named volume in a vmi.
                             properties:
+                              cloudInitConfigDrive:
+                                description: 'CloudInitConfigDrive represents a cloud-init Config Drive user-data source. The Config Drive data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: https://cloudinit.readthedocs.io/en/latest/topics/datasources/configdrive.html'
+                                properties:
+                                  networkData:
+                                    description: NetworkData contains config drive inline cloud-init networkdata.
+                                    type: string
+                                  networkDataBase64:
+                                    description: NetworkDataBase64 contains config drive cloud-init networkdata as a base64 encoded string.
+                                    type: string
+                                  networkDataSecretRef:
+                                    description: NetworkDataSecretRef references a k8s secret that contains config drive networkdata.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  secretRef:
+                                    description: UserDataSecretRef references a k8s secret that contains config drive userdata.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  userData:
+                                    description: UserData contains config drive inline cloud-init userdata.
+                                    type: string
+                                  userDataBase64:
+                                    description: UserDataBase64 contains config drive cloud-init userdata as a base64 encoded string.
+                                    type: string
+                                type: object
+                              cloudInitNoCloud:
+                                description: 'CloudInitNoCloud represents a cloud-init NoCloud user-data source. The NoCloud data will be added as a disk to the vmi. A proper cloud-init installation is required inside the guest. More info: http://cloudinit.readthedocs.io/en/latest/topics/datasources/nocloud.html'
+                                properties:
+                                  networkData:
+                                    description: NetworkData contains NoCloud inline cloud-init networkdata.
+                                    type: string
+                                  networkDataBase64:
+                                    description: NetworkDataBase64 contains NoCloud cloud-init networkdata as a base64 encoded string.
+                                    type: string
+                                  networkDataSecretRef:
+                                    description: NetworkDataSecretRef references a k8s secret that contains NoCloud networkdata.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  secretRef:
+                                    description: UserDataSecretRef references a k8s secret that contains NoCloud userdata.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  userData:
+                                    description: UserData contains NoCloud inline cloud-init userdata.
+                                    type: string
+                                  userDataBase64:
+                                    description: UserDataBase64 contains NoCloud cloud-init userdata as a base64 encoded string.
+                                    type: string
+                                type: object
+                              configMap:
+                                description: 'ConfigMapSource represents a reference to a ConfigMap in the same namespace. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-pod-configmap/'
+                                properties:
+                                  name:
+                                    description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                    type: string
+                                  optional:
+                                    description: Specify whether the ConfigMap or it's keys must be defined
+                                    type: boolean
+                                  volumeLabel:
+                                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                                    type: string
+                                type: object
+                              containerDisk:
+                                description: 'ContainerDisk references a docker image, embedding a qcow or raw disk. More info: https://kubevirt.gitbooks.io/user-guide/registry-disk.html'
+                                properties:
+                                  image:
+                                    description: Image is the name of the image with the embedded disk.
+                                    type: string
+                                  imagePullPolicy:
+                                    description: 'Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images'
+                                    type: string
+                                  imagePullSecret:
+                                    description: ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.
+                                    type: string
+                                  path:
+                                    description: Path defines the path to disk file in the container
+                                    type: string
+                                required:
+                                - image
+                                type: object
+                              dataVolume:
+                                description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
+                                properties:
+                                  name:
+                                    description: Name represents the name of the DataVolume in the same namespace
+                                    type: string
+                                required:
+                                - name
+                                type: object
+                              downwardAPI:
+                                description: DownwardAPI represents downward API about the pod that should populate this volume
+                                properties:
+                                  fields:
+                                    description: Fields is a list of downward API volume file
+                                    items:
+                                      description: DownwardAPIVolumeFile represents information to create the file containing the pod field
+                                      properties:
+                                        fieldRef:
+                                          description: 'Required: Selects a field of the pod: only annotations, labels, name and namespace are supported.'
+                                          properties:
+                                            apiVersion:
+                                              description: Version of the schema the FieldPath is written in terms of, defaults to "v1".
+                                              type: string
+                                            fieldPath:
+                                              description: Path of the field to select in the specified API version.
+                                              type: string
+                                          required:
+                                          - fieldPath
+                                          type: object
+                                        mode:
+                                          description: 'Optional: mode bits used to set permissions on this file, must be an octal value between 0000 and 0777 or a decimal value between 0 and 511. YAML accepts both octal and decimal values, JSON requires decimal values for mode bits. If not specified, the volume defaultMode will be used. This might be in conflict with other options that affect the file mode, like fsGroup, and the result can be other mode bits set.'
+                                          format: int32
+                                          type: integer
+                                        path:
+                                          description: 'Required: Path is  the relative path name of the file to be created. Must not be absolute or contain the ''..'' path. Must be utf-8 encoded. The first item of the relative path must not start with ''..'''
+                                          type: string
+                                        resourceFieldRef:
+                                          description: 'Selects a resource of the container: only resources limits and requests (limits.cpu, limits.memory, requests.cpu and requests.memory) are currently supported.'
+                                          properties:
+                                            containerName:
+                                              description: 'Container name: required for volumes, optional for env vars'
+                                              type: string
+                                            divisor:
+                                              anyOf:
+                                              - type: integer
+                                              - type: string
+                                              description: Specifies the output format of the exposed resources, defaults to "1"
+                                              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                              x-kubernetes-int-or-string: true
+                                            resource:
+                                              description: 'Required: resource to select'
+                                              type: string
+                                          required:
+                                          - resource
+                                          type: object
+                                      required:
+                                      - path
+                                      type: object
+                                    type: array
+                                  volumeLabel:
+                                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                                    type: string
+                                type: object
+                              emptyDisk:
+                                description: 'EmptyDisk represents a temporary disk which shares the vmis lifecycle. More info: https://kubevirt.gitbooks.io/user-guide/disks-and-volumes.html'
+                                properties:
+                                  capacity:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Capacity of the sparse disk.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                required:
+                                - capacity
+                                type: object
+                              ephemeral:
+                                description: Ephemeral is a special volume source that "wraps" specified source and provides copy-on-write image on top of it.
+                                properties:
+                                  persistentVolumeClaim:
+                                    description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                    properties:
+                                      claimName:
+                                        description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                        type: string
+                                      readOnly:
+                                        description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                        type: boolean
+                                    required:
+                                    - claimName
+                                    type: object
+                                type: object
+                              hostDisk:
+                                description: HostDisk represents a disk created on the cluster level
+                                properties:
+                                  capacity:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Capacity of the sparse disk
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  path:
+                                    description: The path to HostDisk image located on the cluster
+                                    type: string
+                                  shared:
+                                    description: Shared indicate whether the path is shared between nodes
+                                    type: boolean
+                                  type:
+                                    description: Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'
+                                    type: string
+                                required:
+                                - path
+                                - type
+                                type: object
                               name:
-                                description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                description: 'Volume''s name. Must be a DNS_LABEL and unique within the vmi. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names'
                                 type: string
+                              persistentVolumeClaim:
+                                description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                properties:
+                                  claimName:
+                                    description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                    type: string
+                                  readOnly:
+                                    description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                    type: boolean
+                                required:
+                                - claimName
+                                type: object
+                              secret:
+                                description: 'SecretVolumeSource represents a reference to a secret data in the same namespace. More info: https://kubernetes.io/docs/concepts/configuration/secret/'
+                                properties:
+                                  optional:
+                                    description: Specify whether the Secret or it's keys must be defined
+                                    type: boolean
+                                  secretName:
+                                    description: 'Name of the secret in the pod''s namespace to use. More info: https://kubernetes.io/docs/concepts/storage/volumes#secret'
+                                    type: string
+                                  volumeLabel:
+                                    description: The volume label of the resulting disk inside the VMI. Different bootstrapping mechanisms require different values. Typical values are "cidata" (cloud-init), "config-2" (cloud-init) or "OEMDRV" (kickstart).
+                                    type: string
+                                type: object
+                              serviceAccount:
+                                description: 'ServiceAccountVolumeSource represents a reference to a service account. There can only be one volume of this type! More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                                properties:
+                                  serviceAccountName:
+                                    description: 'Name of the service account in the pod''s namespace to use. More info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/'
+                                    type: string
+                                type: object
+                              sysprep:
+                                description: Represents a Sysprep volume source.
+                                properties:
+                                  configMap:
+                                    description: ConfigMap references a ConfigMap that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                  secret:
+                                    description: Secret references a k8s Secret that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
+                                    properties:
+                                      name:
+                                        description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names TODO: Add other useful fields. apiVersion, kind, uid?'
+                                        type: string
+                                    type: object
+                                type: object
+                            required:
+                            - name
                             type: object
-                        type: object
-                    required:
-                    - name
-                    type: object
-                  type: array
+                          type: array
+                      required:
+                      - domain
+                      type: object
+                  type: object
               required:
-              - domain
+              - template
               type: object
+          required:
+          - spec
           type: object
       required:
       - selector
-      - template
+      - virtualMachineTemplate
       type: object
     status:
-      description: Status is the high level overview of how the VirtualMachineInstance is doing. It contains information available to controllers and users.
-      nullable: true
+      description: VirtualMachinePoolStatus is the status for a VirtualMachinePool resource
       properties:
         conditions:
           items:
+            description: VirtualMachinePoolCondition represents the state of a VirtualMachinePool
             properties:
               lastProbeTime:
                 format: date-time
@@ -7505,6 +12703,7 @@ var CRDsValidation map[string]string = map[string]string{
               status:
                 type: string
               type:
+                description: VirtualMachinePoolConditionType represents the type of a VirtualMachinePool condition
                 type: string
             required:
             - status
@@ -7515,13 +12714,312 @@ var CRDsValidation map[string]string = map[string]string{
           description: Canonical form of the label selector for HPA which consumes it through the scale subresource.
           type: string
         readyReplicas:
-          description: The number of ready replicas for this replica set.
+          description: The number of VirtualMachines of the pool with a ready VirtualMachineInstance.
           format: int32
           type: integer
         replicas:
-          description: Total number of non-terminated pods targeted by this deployment (their labels match the selector).
+          description: Total number of VirtualMachines of the pool.
           format: int32
           type: integer
+        updatedReplicas:
+          description: The number of VirtualMachines which run the latest template of the pool.
+          format: int32
+          type: integer
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinepreference": `openAPIV3Schema:
+  description: VirtualMachinePreference resource contains optional preferences related to the VirtualMachine.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: Required spec describing the preferences
+      properties:
+        clock:
+          description: Clock optionally defines preferences associated with the Clock attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredClockOffset:
+              description: ClockOffset allows specifying the UTC offset or the timezone of the guest clock.
+              properties:
+                timezone:
+                  description: Timezone sets the guest clock to the specified timezone. Zone name follows the TZ environment variable format (e.g. 'America/New_York').
+                  type: string
+                utc:
+                  description: UTC sets the guest clock to UTC on each boot. If an offset is specified, guest changes to the clock will be kept during reboots and are not reset.
+                  properties:
+                    offsetSeconds:
+                      description: OffsetSeconds specifies an offset in seconds, relative to UTC. If set, guest changes to the clock will be kept during reboots and not reset.
+                      type: integer
+                  type: object
+              type: object
+            preferredTimer:
+              description: Timer specifies which timers are attached to the vmi.
+              properties:
+                hpet:
+                  description: HPET (High Precision Event Timer) - multiple timers with periodic interrupts.
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                    tickPolicy:
+                      description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "merge", "discard".
+                      type: string
+                  type: object
+                hyperv:
+                  description: Hyperv (Hypervclock) - lets guests read the host’s wall clock time (paravirtualized). For windows guests.
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                  type: object
+                kvm:
+                  description: "KVM \t(KVM clock) - lets guests read the host’s wall clock time (paravirtualized). For linux guests."
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                  type: object
+                pit:
+                  description: PIT (Programmable Interval Timer) - a timer with periodic interrupts.
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                    tickPolicy:
+                      description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup", "discard".
+                      type: string
+                  type: object
+                rtc:
+                  description: RTC (Real Time Clock) - a continuously running timer with periodic interrupts.
+                  properties:
+                    present:
+                      description: Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.
+                      type: boolean
+                    tickPolicy:
+                      description: TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of "delay", "catchup".
+                      type: string
+                    track:
+                      description: Track the guest or the wall clock.
+                      type: string
+                  type: object
+              type: object
+          type: object
+        cpu:
+          description: CPU optionally defines preferences associated with the CPU attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredCPUTopology:
+              description: PreferredCPUTopology optionally defines the preferred guest visible CPU topology, defaults to PreferSockets.
+              type: string
+          type: object
+        devices:
+          description: Devices optionally defines preferences associated with the Devices attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredAutoattachGraphicsDevice:
+              description: PreferredAutoattachGraphicsDevice optionally defines the preferred value of AutoattachGraphicsDevice
+              type: boolean
+            preferredAutoattachMemBalloon:
+              description: PreferredAutoattachMemBalloon optionally defines the preferred value of AutoattachMemBalloon
+              type: boolean
+            preferredAutoattachPodInterface:
+              description: PreferredAutoattachPodInterface optionally defines the preferred value of AutoattachPodInterface
+              type: boolean
+            preferredAutoattachSerialConsole:
+              description: PreferredAutoattachSerialConsole optionally defines the preferred value of AutoattachSerialConsole
+              type: boolean
+            preferredBlockMultiQueue:
+              description: PreferredBlockMultiQueue optionally enables the vhost multiqueue feature for virtio disks.
+              type: boolean
+            preferredCdromBus:
+              description: PreferredCdromBus optionally defines the preferred bus for Cdrom Disk devices.
+              type: string
+            preferredDiskBus:
+              description: PreferredDiskBus optionally defines the preferred bus for Disk Disk devices.
+              type: string
+            preferredInterfaceModel:
+              description: PreferredInterfaceModel optionally defines the preferred model to be used by Interface devices.
+              type: string
+            preferredLunBus:
+              description: PreferredLunBus optionally defines the preferred bus for Lun Disk devices.
+              type: string
+            preferredNetworkInterfaceMultiQueue:
+              description: PreferredNetworkInterfaceMultiQueue optionally enables the vhost multiqueue feature for virtio interfaces.
+              type: boolean
+            preferredRng:
+              description: PreferredRng optionally defines the preferred rng device to be used.
+              type: object
+            preferredUseVirtioTransitional:
+              description: PreferredUseVirtioTransitional optionally defines the preferred value of UseVirtioTransitional
+              type: boolean
+          type: object
+        features:
+          description: Features optionally defines preferences associated with the Features attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredAcpi:
+              description: PreferredAcpi optionally enables the ACPI feature
+              properties:
+                enabled:
+                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                  type: boolean
+              type: object
+            preferredApic:
+              description: PreferredApic optionally enables and configures the APIC feature
+              properties:
+                enabled:
+                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                  type: boolean
+                endOfInterrupt:
+                  description: EndOfInterrupt enables the end of interrupt notification in the guest. Defaults to false.
+                  type: boolean
+              type: object
+            preferredHyperv:
+              description: PreferredHyperv optionally enables and configures HyperV features
+              properties:
+                evmcs:
+                  description: EVMCS Speeds up L2 vmexits, but disables other virtualization features. Requires vapic. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                frequencies:
+                  description: Frequencies improves the TSC clock source handling for Hyper-V on KVM. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                ipi:
+                  description: IPI improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                reenlightenment:
+                  description: Reenlightenment enables the notifications on TSC frequency changes. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                relaxed:
+                  description: Relaxed instructs the guest OS to disable watchdog timeouts. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                reset:
+                  description: Reset enables Hyperv reboot/reset for the vmi. Requires synic. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                runtime:
+                  description: Runtime improves the time accounting to improve scheduling in the guest. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                spinlocks:
+                  description: Spinlocks allows to configure the spinlock retry attempts.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                    spinlocks:
+                      description: Retries indicates the number of retries. Must be a value greater or equal 4096. Defaults to 4096.
+                      format: int32
+                      type: integer
+                  type: object
+                synic:
+                  description: SyNIC enables the Synthetic Interrupt Controller. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                synictimer:
+                  description: SyNICTimer enables Synthetic Interrupt Controller Timers, reducing CPU load. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                tlbflush:
+                  description: TLBFlush improves performances in overcommited environments. Requires vpindex. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                vapic:
+                  description: VAPIC improves the paravirtualized handling of interrupts. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+                vendorid:
+                  description: VendorID allows setting the hypervisor vendor id. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                    vendorid:
+                      description: VendorID sets the hypervisor vendor id, visible to the vmi. String up to twelve characters.
+                      type: string
+                  type: object
+                vpindex:
+                  description: VPIndex enables the Virtual Processor Index to help windows identifying virtual processors. Defaults to the machine type setting.
+                  properties:
+                    enabled:
+                      description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                      type: boolean
+                  type: object
+              type: object
+            preferredSmm:
+              description: PreferredSmm optionally enables the SMM feature
+              properties:
+                enabled:
+                  description: Enabled determines if the feature should be enabled or disabled on the guest. Defaults to true.
+                  type: boolean
+              type: object
+          type: object
+        firmware:
+          description: Firmware optionally defines preferences associated with the Firmware attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredUseBios:
+              description: PreferredUseBios optionally enables BIOS
+              type: boolean
+            preferredUseBiosSerial:
+              description: "PreferredUseBiosSerial optionally transmits BIOS output over the serial. \n Requires PreferredUseBios to be enabled."
+              type: boolean
+            preferredUseEfi:
+              description: PreferredUseEfi optionally enables EFI
+              type: boolean
+            preferredUseSecureBoot:
+              description: "PreferredUseSecureBoot optionally enables SecureBoot and the OVMF roms will be swapped for SecureBoot-enabled ones. \n Requires PreferredUseEfi and PreferredSmm to be enabled."
+              type: boolean
+          type: object
+        machine:
+          description: Machine optionally defines preferences associated with the Machine attribute of a VirtualMachineInstance DomainSpec
+          properties:
+            preferredMachineType:
+              description: PreferredMachineType optionally defines the preferred machine type to use.
+              type: string
+          type: object
       type: object
   required:
   - spec
@@ -7542,7 +13040,7 @@ var CRDsValidation map[string]string = map[string]string{
       description: VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
       properties:
         target:
-          description: initially only VirtualMachine type supported
+          description: initially only VirtualMachine type supported. If the target VirtualMachine does not exist, it is created from the snapshot with new MAC addresses and firmware UUID.
           properties:
             apiGroup:
               description: APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.
@@ -7559,6 +13057,9 @@ var CRDsValidation map[string]string = map[string]string{
           type: object
         virtualMachineSnapshotName:
           type: string
+        virtualMachineSnapshotNamespace:
+          description: VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot, defaults to the namespace of the restore. Restoring a snapshot of another namespace requires a VirtualMachineRestoreGrant in the namespace of the snapshot.
+          type: string
       required:
       - target
       - virtualMachineSnapshotName
@@ -7603,22 +13104,55 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         restores:
           items:
-            description: VolumeRestore contains the data neeed to restore a PVC
-            properties:
-              dataVolumeName:
-                type: string
-              persistentVolumeClaim:
-                type: string
-              volumeName:
-                type: string
-              volumeSnapshotName:
-                type: string
-            required:
-            - persistentVolumeClaim
-            - volumeName
-            - volumeSnapshotName
-            type: object
+            description: VolumeRestore contains the data neeed to restore a PVC
+            properties:
+              dataVolumeName:
+                type: string
+              persistentVolumeClaim:
+                type: string
+              volumeName:
+                type: string
+              volumeSnapshotName:
+                type: string
+            required:
+            - persistentVolumeClaim
+            - volumeName
+            - volumeSnapshotName
+            type: object
+          type: array
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"virtualmachinerestoregrant": `openAPIV3Schema:
+  description: VirtualMachineRestoreGrant allows VirtualMachineRestores of other namespaces to restore the VirtualMachineSnapshots of its namespace
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineRestoreGrantSpec is the spec for a VirtualMachineRestoreGrant resource
+      properties:
+        namespaces:
+          description: Namespaces whose VirtualMachineRestores may use the snapshots
+          items:
+            type: string
           type: array
+          x-kubernetes-list-type: set
+        virtualMachineSnapshotNames:
+          description: VirtualMachineSnapshotNames limits the grant to the listed snapshots, all snapshots of the namespace are granted if it is empty
+          items:
+            type: string
+          type: array
+          x-kubernetes-list-type: set
+      required:
+      - namespaces
       type: object
   required:
   - spec
@@ -7641,6 +13175,9 @@ var CRDsValidation map[string]string = map[string]string{
         deletionPolicy:
           description: DeletionPolicy defines that to do with VirtualMachineSnapshot when VirtualMachineSnapshot is deleted
           type: string
+        freezeTimeout:
+          description: FreezeTimeout bounds how long the guest filesystems of a running VM stay frozen while its volumes are snapshotted, they are thawed automatically once it passed. Defaults to 5m.
+          type: string
         source:
           description: TypedLocalObjectReference contains enough information to let you locate the typed referenced object inside the same namespace.
           properties:
@@ -7702,6 +13239,12 @@ var CRDsValidation map[string]string = map[string]string{
               format: date-time
               type: string
           type: object
+        indications:
+          items:
+            description: Indication is a way to indicate the state of the vm when taking the snapshot
+            type: string
+          type: array
+          x-kubernetes-list-type: set
         readyToUse:
           type: boolean
         sourceUID:
@@ -7970,6 +13513,36 @@ var CRDsValidation map[string]string = map[string]string{
                         - spec
                         type: object
                       type: array
+                    instancetype:
+                      description: InstancetypeMatcher references a instancetype that is used to fill fields in Template
+                      properties:
+                        kind:
+                          description: 'Kind specifies which instancetype resource is referenced. Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype". If not specified, "VirtualMachineClusterInstancetype" is used by default.'
+                          type: string
+                        name:
+                          description: Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype
+                          type: string
+                        revisionName:
+                          description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+                          type: string
+                      required:
+                      - name
+                      type: object
+                    preference:
+                      description: PreferenceMatcher references a set of preference that is used to fill fields in Template
+                      properties:
+                        kind:
+                          description: 'Kind specifies which preference resource is referenced. Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference". If not specified, "VirtualMachineClusterPreference" is used by default.'
+                          type: string
+                        name:
+                          description: Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference
+                          type: string
+                        revisionName:
+                          description: RevisionName specifies a ControllerRevision containing a specific copy of the VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+                          type: string
+                      required:
+                      - name
+                      type: object
                     runStrategy:
                       description: Running state indicates the requested running state of the VirtualMachineInstance mutually exclusive with Running
                       type: string
@@ -8596,6 +14169,9 @@ var CRDsValidation map[string]string = map[string]string{
                                                 description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
                                                 type: string
                                             type: object
+                                          changedBlockTracking:
+                                            description: ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup, in a persistent dirty bitmap. Only disks with changed block tracking are backed up. Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks. Defaults to false.
+                                            type: boolean
                                           dedicatedIOThread:
                                             description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
                                             type: boolean
@@ -8625,6 +14201,110 @@ var CRDsValidation map[string]string = map[string]string{
                                           io:
                                             description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
                                             type: string
+                                          ioTune:
+                                            description: IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs through the setblockiotune subresource, the limits in effect are reported in the volume status.
+                                            properties:
+                                              groupName:
+                                                description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
+                                                type: string
+                                              readBytesSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              readBytesSecMax:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              readBytesSecMaxLength:
+                                                description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSec:
+                                                description: ReadIOPSSec is the limit of read operations per second.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSecMax:
+                                                description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSecMaxLength:
+                                                description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              sizeIOPSSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              totalBytesSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              totalBytesSecMax:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              totalBytesSecMaxLength:
+                                                description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSec:
+                                                description: TotalIOPSSec is the limit of read and write operations per second.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSecMax:
+                                                description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSecMaxLength:
+                                                description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              writeBytesSecMax:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              writeBytesSecMaxLength:
+                                                description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSec:
+                                                description: WriteIOPSSec is the limit of write operations per second.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSecMax:
+                                                description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSecMaxLength:
+                                                description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN to the vmi.
                                             properties:
@@ -8716,6 +14396,73 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Interfaces describe network interfaces which are added to the vmi.
                                       items:
                                         properties:
+                                          bandwidth:
+                                            description: If specified, the traffic of the interface is limited. Only interfaces backed by a tap device, bridge, masquerade and macvtap, can be limited.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic received by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount of bytes which can be sent at the peak rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic sent by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount of bytes which can be sent at the peak rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
+                                          binding:
+                                            description: Binding specifies the binding plugin which will be used to connect the interface to the guest. The plugin must be registered in the network configuration of KubeVirt. It is mutually exclusive with the BindingMethod.
+                                            properties:
+                                              name:
+                                                description: Name references a binding plugin registered in the network configuration of KubeVirt.
+                                                type: string
+                                            required:
+                                            - name
+                                            type: object
                                           bootOrder:
                                             description: BootOrder is an integer value > 0, used to determine ordering of boot devices. Lower values take precedence. Each interface or disk that has a boot order must have a unique value. Interfaces without a boot order are not tried.
                                             type: integer
@@ -8748,6 +14495,44 @@ var CRDsValidation map[string]string = map[string]string{
                                                   - value
                                                   type: object
                                                 type: array
+                                              routerAdvertisements:
+                                                description: If set the IPv6 network of the interface is announced with router advertisements, so that the VM learns its default route and the prefix of the network. The advertisements need the NET_RAW capability, which is then granted to the virt-launcher pod. Implied by slaac.
+                                                type: boolean
+                                              routes:
+                                                description: If specified will pass the routes to the VM in addition to the routes of the pod network. IPv4 routes are passed via DHCP option 121, IPv6 routes via the route information of IPv6 router advertisements.
+                                                items:
+                                                  description: DHCPRoute is a route passed to the VM.
+                                                  properties:
+                                                    destination:
+                                                      description: Destination of the route in CIDR notation, e.g. 192.168.20.0/24.
+                                                      type: string
+                                                    gateway:
+                                                      description: Gateway of an IPv4 route, the destination is on link when not set. IPv6 routes always go through the router of the interface.
+                                                      type: string
+                                                  required:
+                                                  - destination
+                                                  type: object
+                                                type: array
+                                              searchDomains:
+                                                description: If specified will pass the search domains to the VM instead of the search domains of the pod, via DHCP option 119, DHCPv6 option 24 and IPv6 router advertisements.
+                                                items:
+                                                  type: string
+                                                type: array
+                                              slaac:
+                                                description: If set the IPv6 network of the interface is advertised for stateless address autoconfiguration (SLAAC), for guests which can not use DHCPv6. Only supported on interfaces with the masquerade binding whose vmIPv6NetworkCIDR has a prefix length of 64.
+                                                type: boolean
+                                              staticLease:
+                                                description: If specified the address is leased to the VM instead of the address of the pod interface. Only supported on interfaces with the bridge binding.
+                                                properties:
+                                                  address:
+                                                    description: Address with its prefix length in CIDR notation, e.g. 192.168.10.5/24.
+                                                    type: string
+                                                  gateway:
+                                                    description: Gateway passed to the VM as its default route.
+                                                    type: string
+                                                required:
+                                                - address
+                                                type: object
                                               tftpServerName:
                                                 description: If specified will pass option 66 to interface's DHCP server
                                                 type: string
@@ -8758,6 +14543,41 @@ var CRDsValidation map[string]string = map[string]string{
                                           macvtap:
                                             type: object
                                           masquerade:
+                                            properties:
+                                              blockedDestinations:
+                                                description: BlockedDestinations lists networks, in CIDR notation, which the guest must not reach through the masquerade.
+                                                items:
+                                                  type: string
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              portMappings:
+                                                description: PortMappings forward ports, or ranges of ports, of the pod to the guest. Unless ports or port mappings are listed, all ports are forwarded.
+                                                items:
+                                                  description: MasqueradePortMapping forwards a port, or a range of ports, of the pod to the guest.
+                                                  properties:
+                                                    endPort:
+                                                      description: EndPort is the last port of a range of ports, which are forwarded to the same ports of the guest.
+                                                      format: int32
+                                                      type: integer
+                                                    guestPort:
+                                                      description: GuestPort is the port of the guest the pod port is forwarded to. Defaults to Port. Can not be combined with EndPort.
+                                                      format: int32
+                                                      type: integer
+                                                    name:
+                                                      description: If specified, this must be an IANA_SVC_NAME and unique within the pod. Only mappings of a single port are named.
+                                                      type: string
+                                                    port:
+                                                      description: Port of the pod, the first port of the range when EndPort is set. This must be a valid port number, 0 < x < 65536.
+                                                      format: int32
+                                                      type: integer
+                                                    protocol:
+                                                      description: Protocol of the mapping. Must be TCP, UDP or SCTP. Defaults to "TCP".
+                                                      type: string
+                                                  required:
+                                                  - port
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
                                             type: object
                                           model:
                                             description: 'Interface model. One of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio. Defaults to virtio. TODO:(ihar) switch to enums once opengen-api supports them. See: https://github.com/kubernetes/kube-openapi/issues/51'
@@ -8790,6 +14610,10 @@ var CRDsValidation map[string]string = map[string]string{
                                           slirp:
                                             type: object
                                           sriov:
+                                            properties:
+                                              standbyInterface:
+                                                description: StandbyInterface is the name of a virtio interface with the bridge binding, connected to the same network, which takes over the traffic of the guest while the VF is unplugged during a live migration. Both interfaces must have the same MAC address.
+                                                type: string
                                             type: object
                                           tag:
                                             description: If specified, the virtual network interface address and its tag will be provided to the guest via config drive
@@ -8798,6 +14622,10 @@ var CRDsValidation map[string]string = map[string]string{
                                         - name
                                         type: object
                                       type: array
+                                    maxHotpluggedDisks:
+                                      description: MaxHotpluggedDisks is the number of disks which can be hotplugged on the virtio bus. A PCIe root port is reserved for each of them when the VirtualMachineInstance starts. Defaults to 0, which only allows to hotplug disks on the scsi bus.
+                                      format: int32
+                                      type: integer
                                     networkInterfaceMultiqueue:
                                       description: If specified, virtual network interfaces configured with a virtio bus will also enable the vhost multiqueue feature for network devices. The number of queues created depends on additional factors of the VirtualMachineInstance, like the number of guest CPUs.
                                       type: boolean
@@ -9166,6 +14994,9 @@ var CRDsValidation map[string]string = map[string]string{
                                   pod:
                                     description: Represents the stock pod network interface.
                                     properties:
+                                      vmIPv6NetworkCIDR:
+                                        description: IPv6 CIDR for the vm network. Default fd10:0:2::/120 if not specified.
+                                        type: string
                                       vmNetworkCIDR:
                                         description: CIDR for vm network. Default 10.0.2.0/24 if not specified.
                                         type: string
@@ -9598,6 +15429,35 @@ var CRDsValidation map[string]string = map[string]string{
                     created:
                       description: Created indicates if the virtual machine is created in the cluster
                       type: boolean
+                    interfaceRequests:
+                      description: InterfaceRequests indicates a list of network interfaces to add or remove from the VMI template and hotplug on an active running VMI.
+                      items:
+                        properties:
+                          addInterfaceOptions:
+                            description: AddInterfaceOptions when set indicates a network interface should be added. The details within this field specify how to add the interface
+                            properties:
+                              name:
+                                description: Name indicates the logical name of the interface, which is also used as the name of the network it is connected to.
+                                type: string
+                              networkAttachmentDefinitionName:
+                                description: 'NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition CRD object. Format: <networkAttachmentDefinitionName>, <namespace>/<networkAttachmentDefinitionName>. If namespace is not specified, VMI namespace is assumed.'
+                                type: string
+                            required:
+                            - name
+                            - networkAttachmentDefinitionName
+                            type: object
+                          removeInterfaceOptions:
+                            description: RemoveInterfaceOptions when set indicates a network interface should be removed. The details within this field specify how to remove the interface
+                            properties:
+                              name:
+                                description: Name indicates the logical name of the interface, which maps to both the interface and the network that should be removed
+                                type: string
+                            required:
+                            - name
+                            type: object
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     ready:
                       description: Ready indicates if the virtual machine is running and ready
                       type: boolean
@@ -9652,6 +15512,9 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: Tray indicates if the tray of the device is open or closed. Allowed values are "open" and "closed". Defaults to closed.
                                         type: string
                                     type: object
+                                  changedBlockTracking:
+                                    description: ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup, in a persistent dirty bitmap. Only disks with changed block tracking are backed up. Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks. Defaults to false.
+                                    type: boolean
                                   dedicatedIOThread:
                                     description: dedicatedIOThread indicates this disk should have an exclusive IO Thread. Enabling this implies useIOThreads = true. Defaults to false.
                                     type: boolean
@@ -9681,6 +15544,110 @@ var CRDsValidation map[string]string = map[string]string{
                                   io:
                                     description: 'IO specifies which QEMU disk IO mode should be used. Supported values are: native, default, threads.'
                                     type: string
+                                  ioTune:
+                                    description: IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs through the setblockiotune subresource, the limits in effect are reported in the volume status.
+                                    properties:
+                                      groupName:
+                                        description: GroupName shares the limits between all disks with the same group name. The disks of a group must have the same limits.
+                                        type: string
+                                      readBytesSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBytesSec is the throughput limit of reads, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readBytesSecMax:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second. It requires ReadBytesSec and must not be lower.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readBytesSecMaxLength:
+                                        description: ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec is the limit of read operations per second.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSecMax:
+                                        description: ReadIOPSSecMax is the rate of read operations allowed in bursts. It requires ReadIOPSSec and must not be lower.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSecMaxLength:
+                                        description: ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      sizeIOPSSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits. Bigger operations count as several ones. By default every operation counts as one.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      totalBytesSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      totalBytesSecMax:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second. It requires TotalBytesSec and must not be lower.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      totalBytesSecMaxLength:
+                                        description: TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec is the limit of read and write operations per second.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSecMax:
+                                        description: TotalIOPSSecMax is the rate of read and write operations allowed in bursts. It requires TotalIOPSSec and must not be lower.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSecMaxLength:
+                                        description: TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBytesSec is the throughput limit of writes, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeBytesSecMax:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second. It requires WriteBytesSec and must not be lower.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeBytesSecMaxLength:
+                                        description: WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec is the limit of write operations per second.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSecMax:
+                                        description: WriteIOPSSecMax is the rate of write operations allowed in bursts. It requires WriteIOPSSec and must not be lower.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSecMaxLength:
+                                        description: WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -9736,6 +15703,50 @@ var CRDsValidation map[string]string = map[string]string{
                             - name
                             - volumeSource
                             type: object
+                          ejectCDRomOptions:
+                            description: EjectCDRomOptions when set indicates the media of a CD-ROM should be ejected. The details within this field specify the CD-ROM
+                            properties:
+                              name:
+                                description: Name represents the name of the CD-ROM disk, and of the volume of its media
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          insertCDRomOptions:
+                            description: InsertCDRomOptions when set indicates a media should be inserted into a CD-ROM. The details within this field specify the CD-ROM and the media
+                            properties:
+                              name:
+                                description: Name represents the name of the CD-ROM disk. It is also the name of the volume of the media
+                                type: string
+                              volumeSource:
+                                description: VolumeSource represents the source of the media
+                                properties:
+                                  dataVolume:
+                                    description: DataVolume represents the dynamic creation a PVC for this volume as well as the process of populating that PVC with a disk image.
+                                    properties:
+                                      name:
+                                        description: Name represents the name of the DataVolume in the same namespace
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  persistentVolumeClaim:
+                                    description: 'PersistentVolumeClaimVolumeSource represents a reference to a PersistentVolumeClaim in the same namespace. Directly attached to the vmi via qemu. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                    properties:
+                                      claimName:
+                                        description: 'ClaimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims'
+                                        type: string
+                                      readOnly:
+                                        description: Will force the ReadOnly setting in VolumeMounts. Default false.
+                                        type: boolean
+                                    required:
+                                    - claimName
+                                    type: object
+                                type: object
+                            required:
+                            - name
+                            - volumeSource
+                            type: object
                           removeVolumeOptions:
                             description: RemoveVolumeOptions when set indicates a volume should be removed. The details within this field specify how to add the volume
                             properties:
@@ -9929,5 +15940,113 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinesnapshotschedule": `openAPIV3Schema:
+  description: VirtualMachineSnapshotSchedule periodically creates VirtualMachineSnapshots of the selected VirtualMachines
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+      properties:
+        deletionPolicy:
+          description: DeletionPolicy of the created VirtualMachineSnapshots
+          type: string
+        disabled:
+          description: Disabled suspends the creation of new VirtualMachineSnapshots, the retention policy is still applied
+          type: boolean
+        retention:
+          description: VirtualMachineSnapshotScheduleRetention defines which VirtualMachineSnapshots of a schedule are kept per VirtualMachine. A snapshot is kept if any of Last, Daily or Weekly selects it, or if none of them is set.
+          properties:
+            daily:
+              description: Daily is the number of days to keep the most recent snapshot of
+              format: int32
+              type: integer
+            expires:
+              description: Expires deletes snapshots older than the duration, even if they are selected by Last, Daily or Weekly
+              type: string
+            last:
+              description: Last is the number of most recent snapshots to keep
+              format: int32
+              type: integer
+            weekly:
+              description: Weekly is the number of weeks to keep the most recent snapshot of
+              format: int32
+              type: integer
+          type: object
+        schedule:
+          description: Schedule in cron format, for example "0 2 * * *"
+          type: string
+        selector:
+          description: Selector selects the VirtualMachines in the namespace of the schedule
+          properties:
+            matchExpressions:
+              description: matchExpressions is a list of label selector requirements. The requirements are ANDed.
+              items:
+                description: A label selector requirement is a selector that contains values, a key, and an operator that relates the key and values.
+                properties:
+                  key:
+                    description: key is the label key that the selector applies to.
+                    type: string
+                  operator:
+                    description: operator represents a key's relationship to a set of values. Valid operators are In, NotIn, Exists and DoesNotExist.
+                    type: string
+                  values:
+                    description: values is an array of string values. If the operator is In or NotIn, the values array must be non-empty. If the operator is Exists or DoesNotExist, the values array must be empty. This array is replaced during a strategic merge patch.
+                    items:
+                      type: string
+                    type: array
+                required:
+                - key
+                - operator
+                type: object
+              type: array
+            matchLabels:
+              additionalProperties:
+                type: string
+              description: matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels map is equivalent to an element of matchExpressions, whose key field is "key", the operator is "In", and the values array contains only "value". The requirements are ANDed.
+              type: object
+          type: object
+      required:
+      - schedule
+      - selector
+      type: object
+    status:
+      description: VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+      properties:
+        lastFailure:
+          description: LastFailure is the time and reason of the last failed run
+          properties:
+            message:
+              type: string
+            time:
+              format: date-time
+              type: string
+          type: object
+        lastRunTime:
+          description: LastRunTime is the time the VirtualMachineSnapshots were last scheduled for
+          format: date-time
+          nullable: true
+          type: string
+        lastSuccessTime:
+          description: LastSuccessTime is the time of the last run whose snapshots all became ready
+          format: date-time
+          nullable: true
+          type: string
+        nextRunTime:
+          description: NextRunTime is the time the next VirtualMachineSnapshots are scheduled for
+          format: date-time
+          nullable: true
+          type: string
+      type: object
+  required:
+  - spec
+  type: object
 `,
 }
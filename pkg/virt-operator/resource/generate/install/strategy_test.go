@@ -37,7 +37,9 @@ import (
 
 	v1 "kubevirt.io/client-go/api/v1"
 
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	//"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/rbac"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
 )
 
@@ -193,6 +195,37 @@ var _ = Describe("Install Strategy", func() {
 				Expect(reflect.DeepEqual(original, converted)).To(BeTrue())
 			}
 		})
+
+		It("the permission of virt-handler to watch network policies only with the feature gate", func() {
+			hasHandlerNetworkPolicyRole := func(config *util.KubeVirtDeploymentConfig) bool {
+				strategy, err := GenerateCurrentInstallStrategy(config, true, namespace)
+				Expect(err).ToNot(HaveOccurred())
+				for _, clusterRole := range strategy.clusterRoles {
+					if clusterRole.Name == rbac.HandlerNetworkPolicyName {
+						return true
+					}
+				}
+				return false
+			}
+			Expect(hasHandlerNetworkPolicyRole(config)).To(BeFalse())
+
+			gatedConfig := util.GetTargetConfigFromKV(&v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: namespace,
+				},
+				Spec: v1.KubeVirtSpec{
+					ImageRegistry: "fake-registry",
+					ImageTag:      "v9.9.9",
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{virtconfig.VirtualMachineNetworkPolicyGate},
+						},
+					},
+				},
+			})
+			Expect(gatedConfig.GetDeploymentID()).ToNot(Equal(config.GetDeploymentID()))
+			Expect(hasHandlerNetworkPolicyRole(gatedConfig)).To(BeTrue())
+		})
 	})
 
 	Context("should match", func() {
@@ -29,6 +29,10 @@ import (
 
 const HandlerServiceAccountName = "kubevirt-handler"
 
+// HandlerNetworkPolicyName names the ClusterRole and ClusterRoleBinding which let
+// virt-handler watch the VirtualMachineNetworkPolicies
+const HandlerNetworkPolicyName = "kubevirt-handler-networkpolicy"
+
 func GetAllHandler(namespace string) []interface{} {
 	return []interface{}{
 		newHandlerServiceAccount(namespace),
@@ -98,6 +102,7 @@ func newHandlerClusterRole() *rbacv1.ClusterRole {
 					"nodes",
 				},
 				Verbs: []string{
+					"get",
 					"patch",
 				},
 			},
@@ -169,6 +174,72 @@ func newHandlerClusterRoleBinding(namespace string) *rbacv1.ClusterRoleBinding {
 	}
 }
 
+// GetAllHandlerNetworkPolicy returns the permission of virt-handler to watch the
+// VirtualMachineNetworkPolicies. It is only installed while the VirtualMachineNetworkPolicy
+// feature gate is enabled, so that virt-handler can not watch them otherwise.
+func GetAllHandlerNetworkPolicy(namespace string) []interface{} {
+	return []interface{}{
+		newHandlerNetworkPolicyClusterRole(),
+		newHandlerNetworkPolicyClusterRoleBinding(namespace),
+	}
+}
+
+func newHandlerNetworkPolicyClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "ClusterRole",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: HandlerNetworkPolicyName,
+			Labels: map[string]string{
+				virtv1.AppLabel: "",
+			},
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{
+					"networkpolicy.kubevirt.io",
+				},
+				Resources: []string{
+					"virtualmachinenetworkpolicies",
+				},
+				Verbs: []string{
+					"list",
+					"watch",
+				},
+			},
+		},
+	}
+}
+
+func newHandlerNetworkPolicyClusterRoleBinding(namespace string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "rbac.authorization.k8s.io/v1",
+			Kind:       "ClusterRoleBinding",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: HandlerNetworkPolicyName,
+			Labels: map[string]string{
+				virtv1.AppLabel: "",
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     HandlerNetworkPolicyName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Namespace: namespace,
+				Name:      HandlerServiceAccountName,
+			},
+		},
+	}
+}
+
 func newHandlerRole(namespace string) *rbacv1.Role {
 	return &rbacv1.Role{
 		TypeMeta: metav1.TypeMeta{
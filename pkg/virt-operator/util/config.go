@@ -35,6 +35,7 @@ import (
 
 	v1 "kubevirt.io/client-go/api/v1"
 	clientutil "kubevirt.io/client-go/util"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
@@ -64,6 +65,9 @@ const (
 	// lookup key in AdditionalProperties
 	AdditionalPropertiesWorkloadUpdatesEnabled = "WorkloadUpdatesEnabled"
 
+	// lookup key in AdditionalProperties
+	AdditionalPropertiesVirtualMachineNetworkPolicyEnabled = "VirtualMachineNetworkPolicyEnabled"
+
 	// account to use if one is not explicitly named
 	DefaultMonitorNamespace = "openshift-monitoring"
 
@@ -142,6 +146,13 @@ func GetTargetConfigFromKV(kv *v1.KubeVirt) *KubeVirtDeploymentConfig {
 	if len(kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods) > 0 {
 		additionalProperties[AdditionalPropertiesWorkloadUpdatesEnabled] = ""
 	}
+	if kv.Spec.Configuration.DeveloperConfiguration != nil {
+		for _, featureGate := range kv.Spec.Configuration.DeveloperConfiguration.FeatureGates {
+			if featureGate == virtconfig.VirtualMachineNetworkPolicyGate {
+				additionalProperties[AdditionalPropertiesVirtualMachineNetworkPolicyEnabled] = ""
+			}
+		}
+	}
 	// don't use status.target* here, as that is always set, but we need to know if it was set by the spec and with that
 	// overriding shasums from env vars
 	return getConfig(kv.Spec.ImageRegistry,
@@ -401,6 +412,13 @@ func (c *KubeVirtDeploymentConfig) WorkloadUpdatesEnabled() bool {
 	return enabled
 }
 
+// VirtualMachineNetworkPolicyEnabled returns whether virt-handler gets the permission to
+// watch the VirtualMachineNetworkPolicies
+func (c *KubeVirtDeploymentConfig) VirtualMachineNetworkPolicyEnabled() bool {
+	_, enabled := c.AdditionalProperties[AdditionalPropertiesVirtualMachineNetworkPolicyEnabled]
+	return enabled
+}
+
 func (c *KubeVirtDeploymentConfig) GetMonitorNamespace() string {
 	p := c.AdditionalProperties[AdditionalPropertiesMonitorNamespace]
 	if p == "" {
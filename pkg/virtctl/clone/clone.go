@@ -0,0 +1,189 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	snapshotv1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_CLONE = "clone"
+
+	nameFlag                     = "name"
+	targetFlag                   = "target"
+	nameTemplateFlag             = "name-template"
+	labelFilterFlag              = "label-filter"
+	annotationFilterFlag         = "annotation-filter"
+	templateLabelFilterFlag      = "template-label-filter"
+	templateAnnotationFilterFlag = "template-annotation-filter"
+	newMacAddressFlag            = "new-mac-address"
+	newSMBiosSerialFlag          = "new-smbios-serial"
+)
+
+type Command struct {
+	clientConfig clientcmd.ClientConfig
+
+	name                      string
+	target                    string
+	nameTemplate              string
+	labelFilters              []string
+	annotationFilters         []string
+	templateLabelFilters      []string
+	templateAnnotationFilters []string
+	newMacAddresses           map[string]string
+	newSMBiosSerial           string
+}
+
+// NewCommand generates a new "clone" command
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := Command{clientConfig: clientConfig}
+
+	cmd := &cobra.Command{
+		Use:   "clone (TYPE NAME)",
+		Short: "Clone a virtual machine or virtual machine snapshot into a new virtual machine.",
+		Long: `Creates a VirtualMachineClone which makes a new virtual machine from a virtual machine or a virtual machine snapshot.
+The volumes of the new virtual machine are restored from a snapshot, a virtual machine is snapshotted first.
+The interfaces of the new virtual machine get new MAC addresses and the firmware UUID is regenerated.
+
+Possible types are (case insensitive, both single and plural forms):
+
+virtualmachine (vm), virtualmachinesnapshot (vmsnapshot)`,
+		Example: usage(),
+		Args:    templates.ExactArgs(COMMAND_CLONE, 2),
+		RunE:    c.Run,
+	}
+
+	cmd.Flags().StringVar(&c.name, nameFlag, "", "Name of the VirtualMachineClone. Generated from the source name if not set.")
+	cmd.Flags().StringVar(&c.target, targetFlag, "", "Name of the new virtual machine. Generated from the name template if not set.")
+	cmd.Flags().StringVar(&c.nameTemplate, nameTemplateFlag, "", "Go template generating the name of the new virtual machine, may reference {{.SourceName}}, {{.CloneName}} and {{.UniqueSuffix}}.")
+	cmd.Flags().StringArrayVar(&c.labelFilters, labelFilterFlag, nil, "Filter selecting the labels which are copied to the new virtual machine, '*' is a wildcard and '!' excludes keys. Can be given multiple times.")
+	cmd.Flags().StringArrayVar(&c.annotationFilters, annotationFilterFlag, nil, "Filter selecting the annotations which are copied to the new virtual machine. Can be given multiple times.")
+	cmd.Flags().StringArrayVar(&c.templateLabelFilters, templateLabelFilterFlag, nil, "Filter selecting the labels of the virtual machine instance template which are copied. Can be given multiple times.")
+	cmd.Flags().StringArrayVar(&c.templateAnnotationFilters, templateAnnotationFilterFlag, nil, "Filter selecting the annotations of the virtual machine instance template which are copied. Can be given multiple times.")
+	cmd.Flags().StringToStringVar(&c.newMacAddresses, newMacAddressFlag, nil, "MAC address of an interface of the new virtual machine, as interface=mac. Interfaces which are not given get a new MAC address assigned.")
+	cmd.Flags().StringVar(&c.newSMBiosSerial, newSMBiosSerialFlag, "", "SMBIOS serial of the new virtual machine. The serial of the source is dropped if not set.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	usage := `  # Clone the virtual machine 'myvm' into a virtual machine with a generated name:
+  {{ProgramName}} clone vm myvm
+
+  # Clone the virtual machine 'myvm' into the virtual machine 'myclone' and keep only the 'app' label:
+  {{ProgramName}} clone vm myvm --target=myclone --label-filter=app
+
+  # Create a virtual machine from the snapshot 'mysnapshot' with a fixed MAC address on the 'default' interface:
+  {{ProgramName}} clone vmsnapshot mysnapshot --new-mac-address=default=02:00:00:00:00:01`
+	return usage
+}
+
+// Run creates the VirtualMachineClone
+func (c *Command) Run(cmd *cobra.Command, args []string) error {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	vmClone, err := c.newVirtualMachineClone(args[0], args[1], namespace)
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vmClone, err = virtClient.VirtualMachineClone(namespace).Create(context.Background(), vmClone, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachineClone: %v", err)
+	}
+
+	cmd.Printf("VirtualMachineClone %s was created\n", vmClone.Name)
+	return nil
+}
+
+func (c *Command) newVirtualMachineClone(sourceType, sourceName, namespace string) (*clonev1.VirtualMachineClone, error) {
+	var source corev1.TypedLocalObjectReference
+	switch strings.ToLower(sourceType) {
+	case "vm", "vms", "virtualmachine", "virtualmachines":
+		apiGroup := v1.GroupName
+		source = corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachine", Name: sourceName}
+	case "vmsnapshot", "vmsnapshots", "virtualmachinesnapshot", "virtualmachinesnapshots":
+		apiGroup := snapshotv1.SchemeGroupVersion.Group
+		source = corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachineSnapshot", Name: sourceName}
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %s", sourceType)
+	}
+
+	vmClone := &clonev1.VirtualMachineClone{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.name,
+			Namespace: namespace,
+		},
+		Spec: clonev1.VirtualMachineCloneSpec{
+			Source:            source,
+			LabelFilters:      c.labelFilters,
+			AnnotationFilters: c.annotationFilters,
+			Template: clonev1.VirtualMachineCloneTemplateFilters{
+				LabelFilters:      c.templateLabelFilters,
+				AnnotationFilters: c.templateAnnotationFilters,
+			},
+		},
+	}
+
+	if c.name == "" {
+		vmClone.GenerateName = sourceName + "-clone-"
+	}
+
+	if c.target != "" {
+		apiGroup := v1.GroupName
+		vmClone.Spec.Target = &corev1.TypedLocalObjectReference{APIGroup: &apiGroup, Kind: "VirtualMachine", Name: c.target}
+	}
+
+	if c.nameTemplate != "" {
+		vmClone.Spec.NameTemplate = &c.nameTemplate
+	}
+
+	if len(c.newMacAddresses) > 0 {
+		vmClone.Spec.NewMacAddresses = c.newMacAddresses
+	}
+
+	if c.newSMBiosSerial != "" {
+		vmClone.Spec.NewSMBiosSerial = &c.newSMBiosSerial
+	}
+
+	return vmClone, nil
+}
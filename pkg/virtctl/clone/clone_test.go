@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package clone
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	clonev1 "kubevirt.io/client-go/apis/clone/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+)
+
+var _ = Describe("Clone", func() {
+	const namespace = "mynamespace"
+
+	var clientConfig clientcmd.ClientConfig
+	var kubevirtClient *kubevirtfake.Clientset
+
+	BeforeEach(func() {
+		clientConfig = clientcmd.NewDefaultClientConfig(*clientcmdapi.NewConfig(), &clientcmd.ConfigOverrides{
+			Context: clientcmdapi.Context{Namespace: namespace},
+		})
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineClone(namespace).
+			Return(kubevirtClient.CloneV1alpha1().VirtualMachineClones(namespace)).AnyTimes()
+	})
+
+	runCommand := func(args ...string) (string, error) {
+		cmd := NewCommand(clientConfig)
+		// the usage template depends on functions registered by the root command
+		cmd.SilenceUsage = true
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	getClone := func(name string) *clonev1.VirtualMachineClone {
+		vmClone, err := kubevirtClient.CloneV1alpha1().VirtualMachineClones(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmClone
+	}
+
+	table.DescribeTable("should create a clone of", func(sourceType, apiGroup, kind string) {
+		out, err := runCommand(sourceType, "source", "--name", "myclone")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(ContainSubstring("VirtualMachineClone myclone was created"))
+
+		vmClone := getClone("myclone")
+		Expect(*vmClone.Spec.Source.APIGroup).To(Equal(apiGroup))
+		Expect(vmClone.Spec.Source.Kind).To(Equal(kind))
+		Expect(vmClone.Spec.Source.Name).To(Equal("source"))
+		Expect(vmClone.Spec.Target).To(BeNil())
+		Expect(vmClone.Spec.NameTemplate).To(BeNil())
+		Expect(vmClone.Spec.NewSMBiosSerial).To(BeNil())
+	},
+		table.Entry("a virtual machine", "vm", "kubevirt.io", "VirtualMachine"),
+		table.Entry("a virtual machine in long form", "VirtualMachine", "kubevirt.io", "VirtualMachine"),
+		table.Entry("a virtual machine snapshot", "vmsnapshot", "snapshot.kubevirt.io", "VirtualMachineSnapshot"),
+	)
+
+	It("should pass all options to the clone", func() {
+		_, err := runCommand("vm", "source", "--name", "myclone",
+			"--target", "target",
+			"--name-template", "{{.SourceName}}-copy",
+			"--label-filter", "app", "--label-filter", "!kubevirt.io/*",
+			"--annotation-filter", "*",
+			"--template-label-filter", "!kubevirt.io/domain",
+			"--template-annotation-filter", "note",
+			"--new-mac-address", "default=02:00:00:00:00:01,secondary=02:00:00:00:00:02",
+			"--new-smbios-serial", "serial",
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		spec := getClone("myclone").Spec
+		Expect(spec.Target.Kind).To(Equal("VirtualMachine"))
+		Expect(spec.Target.Name).To(Equal("target"))
+		Expect(*spec.NameTemplate).To(Equal("{{.SourceName}}-copy"))
+		Expect(spec.LabelFilters).To(Equal([]string{"app", "!kubevirt.io/*"}))
+		Expect(spec.AnnotationFilters).To(Equal([]string{"*"}))
+		Expect(spec.Template.LabelFilters).To(Equal([]string{"!kubevirt.io/domain"}))
+		Expect(spec.Template.AnnotationFilters).To(Equal([]string{"note"}))
+		Expect(spec.NewMacAddresses).To(Equal(map[string]string{
+			"default":   "02:00:00:00:00:01",
+			"secondary": "02:00:00:00:00:02",
+		}))
+		Expect(*spec.NewSMBiosSerial).To(Equal("serial"))
+	})
+
+	It("should generate the clone name if none is given", func() {
+		c := &Command{}
+		vmClone, err := c.newVirtualMachineClone("vm", "source", namespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vmClone.Name).To(BeEmpty())
+		Expect(vmClone.GenerateName).To(Equal("source-clone-"))
+	})
+
+	It("should reject unsupported types", func() {
+		_, err := runCommand("vmi", "source")
+		Expect(err).To(MatchError("unsupported resource type: vmi"))
+	})
+
+	It("should require a type and a name", func() {
+		_, err := runCommand("vm")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,193 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package portforward
+
+import (
+	"io"
+	"net"
+	"sync"
+
+	"github.com/golang/glog"
+
+	"kubevirt.io/client-go/kubecli"
+)
+
+const udpBufferSize = 65535
+
+type portForwarder struct {
+	name     string
+	resource portforwardableResource
+}
+
+func (p *portForwarder) startForwarding(address net.IP, port forwardedPort) error {
+	glog.Infof("forwarding %s %s:%d to %d", port.protocol, address, port.local, port.remote)
+	if port.protocol == protocolUDP {
+		return p.startForwardingUDP(address, port)
+	}
+	return p.startForwardingTCP(address, port)
+}
+
+func (p *portForwarder) startForwardingTCP(address net.IP, port forwardedPort) error {
+	listener, err := net.ListenTCP(protocolTCP, &net.TCPAddr{
+		IP:   address,
+		Port: port.local,
+	})
+	if err != nil {
+		return err
+	}
+
+	go p.waitForConnection(listener, port)
+	return nil
+}
+
+func (p *portForwarder) waitForConnection(listener net.Listener, port forwardedPort) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			glog.Errorln("error accepting connection:", err)
+			return
+		}
+		glog.Infof("opening new tcp tunnel to %d", port.remote)
+		stream, err := p.resource.PortForward(p.name, port.remote, port.protocol)
+		if err != nil {
+			glog.Errorf("can't access VMI %s: %v", p.name, err)
+			conn.Close()
+			continue
+		}
+		go p.handleConnection(conn, stream, port)
+	}
+}
+
+// handleConnection copies data between the local connection and the stream
+// until one of the sides closes its connection
+func (p *portForwarder) handleConnection(conn net.Conn, stream kubecli.StreamInterface, port forwardedPort) {
+	defer conn.Close()
+
+	err := stream.Stream(kubecli.StreamOptions{
+		In:  conn,
+		Out: conn,
+	})
+	if err != nil {
+		glog.Errorf("error while forwarding to %d: %v", port.remote, err)
+	}
+	glog.Infof("closed tunnel to %d", port.remote)
+}
+
+func (p *portForwarder) startForwardingUDP(address net.IP, port forwardedPort) error {
+	listener, err := net.ListenUDP(protocolUDP, &net.UDPAddr{
+		IP:   address,
+		Port: port.local,
+	})
+	if err != nil {
+		return err
+	}
+
+	proxy := &udpProxy{
+		listener:  listener,
+		remote:    port,
+		forwarder: p,
+		clients:   make(map[string]*io.PipeWriter),
+	}
+	go proxy.handleDatagrams()
+	return nil
+}
+
+// udpProxy multiplexes the datagrams of a single local UDP listener over one
+// stream per client address
+type udpProxy struct {
+	listener  *net.UDPConn
+	remote    forwardedPort
+	forwarder *portForwarder
+
+	lock    sync.Mutex
+	clients map[string]*io.PipeWriter
+}
+
+// udpResponseWriter sends everything which is received over the stream back
+// to the client the stream was opened for
+type udpResponseWriter struct {
+	listener   *net.UDPConn
+	clientAddr *net.UDPAddr
+}
+
+func (w *udpResponseWriter) Write(p []byte) (int, error) {
+	return w.listener.WriteToUDP(p, w.clientAddr)
+}
+
+func (u *udpProxy) handleDatagrams() {
+	buf := make([]byte, udpBufferSize)
+	for {
+		n, clientAddr, err := u.listener.ReadFromUDP(buf)
+		if err != nil {
+			glog.Errorln("error reading udp datagram:", err)
+			return
+		}
+
+		in, err := u.clientStream(clientAddr)
+		if err != nil {
+			glog.Errorf("can't access VMI %s: %v", u.forwarder.name, err)
+			continue
+		}
+
+		if _, err := in.Write(buf[:n]); err != nil {
+			glog.Errorf("error forwarding udp datagram to %d: %v", u.remote.remote, err)
+			u.closeClient(clientAddr)
+		}
+	}
+}
+
+func (u *udpProxy) clientStream(clientAddr *net.UDPAddr) (*io.PipeWriter, error) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	if in, exists := u.clients[clientAddr.String()]; exists {
+		return in, nil
+	}
+
+	glog.Infof("opening new udp tunnel to %d for %s", u.remote.remote, clientAddr)
+	stream, err := u.forwarder.resource.PortForward(u.forwarder.name, u.remote.remote, u.remote.protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+	u.clients[clientAddr.String()] = writer
+	go func() {
+		defer u.closeClient(clientAddr)
+		err := stream.Stream(kubecli.StreamOptions{
+			In:  reader,
+			Out: &udpResponseWriter{listener: u.listener, clientAddr: clientAddr},
+		})
+		if err != nil {
+			glog.Errorf("error while forwarding udp from %s to %d: %v", clientAddr, u.remote.remote, err)
+		}
+	}()
+	return writer, nil
+}
+
+func (u *udpProxy) closeClient(clientAddr *net.UDPAddr) {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	if in, exists := u.clients[clientAddr.String()]; exists {
+		in.Close()
+		delete(u.clients, clientAddr.String())
+	}
+}
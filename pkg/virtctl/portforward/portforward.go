@@ -0,0 +1,265 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package portforward
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_PORTFORWARD = "port-forward"
+
+	protocolTCP = "tcp"
+	protocolUDP = "udp"
+)
+
+var (
+	address = "127.0.0.1"
+	stdio   = false
+)
+
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "port-forward [kind/]name[.namespace] [protocol/]localPort[:targetPort]...",
+		Short: "Forward local ports to a virtualmachine or virtualmachineinstance.",
+		Long: `Forward local ports to a virtualmachine or virtualmachineinstance.
+The target is given as [kind/]name[.namespace], where kind is either vmi (default) or vm.
+If the namespace is not part of the target, the namespace of the current context is used.
+Ports are given as [protocol/]localPort[:targetPort], where protocol is either tcp (default) or udp.
+If no targetPort is given, the localPort is used as targetPort.
+
+The traffic is forwarded to the localhost of the virt-launcher pod, the guest has to be reachable there,
+e.g. by using the masquerade binding and declaring the forwarded ports on the interface.`,
+		Example: usage(),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 {
+				return errors.New("argument validation failed")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := PortForward{clientConfig: clientConfig}
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.Flags().StringVar(&address, "address", address, "--address=127.0.0.1: Selects the IP address to listen on")
+	cmd.Flags().BoolVar(&stdio, "stdio", stdio, "--stdio=false: Forward a single port of the target to stdin and stdout instead of listening locally")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+type PortForward struct {
+	clientConfig clientcmd.ClientConfig
+}
+
+// portforwardableResource is the subset of the VMI client which is needed to open a forwarding stream
+type portforwardableResource interface {
+	PortForward(name string, port int, protocol string) (kubecli.StreamInterface, error)
+}
+
+// forwardedPort describes the mapping of a local port to a port of the VMI
+type forwardedPort struct {
+	local    int
+	remote   int
+	protocol string
+}
+
+func (o *PortForward) Run(cmd *cobra.Command, args []string) error {
+	kind, name, namespace, err := ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+
+	ports, err := parsePorts(args[1:])
+	if err != nil {
+		return err
+	}
+
+	if namespace == "" {
+		namespace, _, err = o.clientConfig.Namespace()
+		if err != nil {
+			return err
+		}
+	}
+
+	virtCli, err := kubecli.GetKubevirtClientFromClientConfig(o.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	// The VMI of a VM has the name of the VM, it only has to be running
+	if kind == "vm" {
+		vm, err := virtCli.VirtualMachine(namespace).Get(name, &metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("can't access VM %s: %v", name, err)
+		}
+		if !vm.Status.Created {
+			return fmt.Errorf("VM %s has no running VMI", name)
+		}
+	}
+	resource := virtCli.VirtualMachineInstance(namespace)
+
+	if stdio {
+		if len(ports) != 1 {
+			return errors.New("exactly one port has to be given when forwarding to stdio")
+		}
+		return forwardStdio(resource, name, ports[0])
+	}
+
+	listenAddress := net.ParseIP(address)
+	if listenAddress == nil {
+		return fmt.Errorf("could not parse address %q", address)
+	}
+
+	for _, port := range ports {
+		forwarder := portForwarder{
+			name:     name,
+			resource: resource,
+		}
+		if err := forwarder.startForwarding(listenAddress, port); err != nil {
+			return err
+		}
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	<-interrupt
+	return nil
+}
+
+// ParseTarget splits a target of the form [kind/]name[.namespace] into its parts
+func ParseTarget(target string) (kind string, name string, namespace string, err error) {
+	kind = "vmi"
+	if parts := strings.SplitN(target, "/", 2); len(parts) == 2 {
+		kind = strings.ToLower(parts[0])
+		target = parts[1]
+	}
+
+	switch kind {
+	case "vmi", "vmis", "virtualmachineinstance", "virtualmachineinstances":
+		kind = "vmi"
+	case "vm", "vms", "virtualmachine", "virtualmachines":
+		kind = "vm"
+	default:
+		return "", "", "", fmt.Errorf("unsupported resource type %q", kind)
+	}
+
+	if parts := strings.SplitN(target, ".", 2); len(parts) == 2 {
+		target = parts[0]
+		namespace = parts[1]
+	}
+
+	if target == "" {
+		return "", "", "", errors.New("expected name in target")
+	}
+
+	return kind, target, namespace, nil
+}
+
+func parsePorts(args []string) ([]forwardedPort, error) {
+	ports := make([]forwardedPort, len(args))
+	for i, arg := range args {
+		port, err := parsePort(arg)
+		if err != nil {
+			return nil, err
+		}
+		ports[i] = port
+	}
+	return ports, nil
+}
+
+func parsePort(arg string) (forwardedPort, error) {
+	port := forwardedPort{protocol: protocolTCP}
+
+	if parts := strings.SplitN(arg, "/", 2); len(parts) == 2 {
+		port.protocol = strings.ToLower(parts[0])
+		arg = parts[1]
+	}
+	if port.protocol != protocolTCP && port.protocol != protocolUDP {
+		return port, fmt.Errorf("unsupported protocol %q in %q", port.protocol, arg)
+	}
+
+	parts := strings.SplitN(arg, ":", 2)
+	local, err := parsePortNumber(parts[0])
+	if err != nil {
+		return port, err
+	}
+	port.local = local
+	port.remote = local
+
+	if len(parts) == 2 {
+		remote, err := parsePortNumber(parts[1])
+		if err != nil {
+			return port, err
+		}
+		port.remote = remote
+	}
+
+	return port, nil
+}
+
+func parsePortNumber(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid port %q", s)
+	}
+	return port, nil
+}
+
+func forwardStdio(resource portforwardableResource, name string, port forwardedPort) error {
+	stream, err := resource.PortForward(name, port.remote, port.protocol)
+	if err != nil {
+		return fmt.Errorf("can't access VMI %s: %v", name, err)
+	}
+
+	glog.V(3).Infof("forwarding stdio to %s/%s:%d", port.protocol, name, port.remote)
+	return stream.Stream(kubecli.StreamOptions{
+		In:  os.Stdin,
+		Out: os.Stdout,
+	})
+}
+
+func usage() string {
+	return `  # Forward the local port 8080 to the vmi port:
+  {{ProgramName}} port-forward vmi/testvmi 8080
+
+  # Forward the local port 8080 to the vmi port 80:
+  {{ProgramName}} port-forward vmi/testvmi 8080:80
+
+  # Forward the local port 5353 to the udp port 53 of the vm 'testvm' in the namespace 'mynamespace':
+  {{ProgramName}} port-forward vm/testvm.mynamespace udp/5353:53
+
+  # Use as SSH ProxyCommand to access a vmi:
+  ssh -o 'ProxyCommand={{ProgramName}} port-forward --stdio=true testvmi.mynamespace 22' user@testvmi.mynamespace`
+}
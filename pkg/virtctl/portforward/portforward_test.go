@@ -0,0 +1,121 @@
+package portforward_test
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/tests"
+)
+
+var _ = Describe("Port forward", func() {
+
+	const vmiName = "testvmi"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var stream *kubecli.MockStreamInterface
+	var ctrl *gomock.Controller
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		stream = kubecli.NewMockStreamInterface(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	table.DescribeTable("should fail with invalid input parameters", func(args ...string) {
+		cmd := tests.NewRepeatableVirtctlCommand(append([]string{portforward.COMMAND_PORTFORWARD}, args...)...)
+		Expect(cmd()).To(HaveOccurred())
+	},
+		table.Entry("without arguments"),
+		table.Entry("without port", vmiName),
+		table.Entry("with an unsupported kind", "pod/"+vmiName, "22"),
+		table.Entry("with a non numeric port", vmiName, "ssh"),
+		table.Entry("with a port out of range", vmiName, "22:65536"),
+		table.Entry("with the port 0", vmiName, "0"),
+		table.Entry("with the target port 0", vmiName, "8080:0"),
+		table.Entry("with an unsupported protocol", vmiName, "sctp/22"),
+	)
+
+	It("should forward stdio to the given port of the VMI", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance("mynamespace").Return(vmiInterface)
+		vmiInterface.EXPECT().PortForward(vmiName, 22, "tcp").Return(stream, nil)
+		stream.EXPECT().Stream(gomock.Any()).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(portforward.COMMAND_PORTFORWARD, "--stdio=true", "vmi/"+vmiName+".mynamespace", "22")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should forward stdio to the VMI of a running VM", func() {
+		vm := &v1.VirtualMachine{ObjectMeta: k8smetav1.ObjectMeta{Name: vmiName, Namespace: "mynamespace"}}
+		vm.Status.Created = true
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine("mynamespace").Return(vmInterface)
+		vmInterface.EXPECT().Get(vmiName, gomock.Any()).Return(vm, nil)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance("mynamespace").Return(vmiInterface)
+		vmiInterface.EXPECT().PortForward(vmiName, 22, "tcp").Return(stream, nil)
+		stream.EXPECT().Stream(gomock.Any()).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(portforward.COMMAND_PORTFORWARD, "--stdio=true", "vm/"+vmiName+".mynamespace", "22")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should fail to forward to a VM without a VMI", func() {
+		vm := &v1.VirtualMachine{ObjectMeta: k8smetav1.ObjectMeta{Name: vmiName, Namespace: "mynamespace"}}
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine("mynamespace").Return(vmInterface)
+		vmInterface.EXPECT().Get(vmiName, gomock.Any()).Return(vm, nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(portforward.COMMAND_PORTFORWARD, "--stdio=true", "vm/"+vmiName+".mynamespace", "22")
+		Expect(cmd()).To(HaveOccurred())
+	})
+
+	It("should forward stdio to an udp port of the VMI in the default namespace", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface)
+		vmiInterface.EXPECT().PortForward(vmiName, 53, "udp").Return(stream, nil)
+		stream.EXPECT().Stream(gomock.Any()).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(portforward.COMMAND_PORTFORWARD, "--stdio=true", vmiName, "udp/5353:53")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should fail when the port-forward subresource can't be reached", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface)
+		vmiInterface.EXPECT().PortForward(vmiName, 22, "tcp").Return(nil, fmt.Errorf("error"))
+
+		cmd := tests.NewRepeatableVirtctlCommand(portforward.COMMAND_PORTFORWARD, "--stdio=true", vmiName, "22")
+		Expect(cmd()).To(HaveOccurred())
+	})
+
+	It("should fail to forward more than one port to stdio", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface)
+
+		cmd := tests.NewRepeatableVirtctlCommand(portforward.COMMAND_PORTFORWARD, "--stdio=true", vmiName, "22", "80")
+		Expect(cmd()).To(HaveOccurred())
+	})
+
+	table.DescribeTable("should parse the target", func(arg, kind, name, namespace string) {
+		k, n, ns, err := portforward.ParseTarget(arg)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k).To(Equal(kind))
+		Expect(n).To(Equal(name))
+		Expect(ns).To(Equal(namespace))
+	},
+		table.Entry("with only a name", "testvmi", "vmi", "testvmi", ""),
+		table.Entry("with a namespace", "testvmi.mynamespace", "vmi", "testvmi", "mynamespace"),
+		table.Entry("with the vmi kind", "virtualmachineinstance/testvmi", "vmi", "testvmi", ""),
+		table.Entry("with the vm kind and a namespace", "vm/testvm.mynamespace", "vm", "testvm", "mynamespace"),
+	)
+})
@@ -0,0 +1,127 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_SCP = "scp"
+
+	SCP_BINARY = "scp"
+
+	recursiveFlag = "recursive"
+)
+
+func NewSCPCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := SCP{clientConfig: clientConfig}
+
+	cmd := &cobra.Command{
+		Use:   "scp [user@][kind/]name[.namespace]:path local-path | local-path [user@][kind/]name[.namespace]:path",
+		Short: "Copy files from or to a virtual machine instance.",
+		Long: `Copy files from or to a virtual machine instance.
+The connection is tunneled through the port-forward subresource of the virtual machine instance, the guest does not need to be reachable from outside of the cluster.
+The local scp client is used, it has to be available in $PATH.`,
+		Example: scpUsage(),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("argument validation failed")
+			}
+			return nil
+		},
+		RunE: c.Run,
+	}
+
+	addConnectionFlags(cmd, &c.options)
+	cmd.Flags().BoolVarP(&c.recursive, recursiveFlag, "r", false,
+		fmt.Sprintf("--%s=false: Recursively copy entire directories", recursiveFlag))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+type SCP struct {
+	clientConfig clientcmd.ClientConfig
+	options      connectionOptions
+	recursive    bool
+}
+
+func (o *SCP) Run(cmd *cobra.Command, args []string) error {
+	toRemote, remoteArg, localPath, err := splitSCPArgs(args[0], args[1])
+	if err != nil {
+		return err
+	}
+
+	parts := strings.SplitN(remoteArg, ":", 2)
+	target, err := parseTarget(parts[0], o.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	o.options.clientFlags = clientFlags(cmd)
+	scpArgs := buildSCPArgs(o.options, o.recursive, target, parts[1], localPath, toRemote)
+	return runLocalClient(SCP_BINARY, scpArgs)
+}
+
+// splitSCPArgs determines which of the two arguments points to the virtual
+// machine instance, exactly one of them has to be of the form target:path
+func splitSCPArgs(source, destination string) (toRemote bool, remote string, local string, err error) {
+	sourceIsRemote := strings.Contains(source, ":")
+	destinationIsRemote := strings.Contains(destination, ":")
+
+	switch {
+	case sourceIsRemote && destinationIsRemote:
+		return false, "", "", errors.New("copying between two remote locations is not supported")
+	case !sourceIsRemote && !destinationIsRemote:
+		return false, "", "", errors.New("either the source or the destination has to be a remote location")
+	case sourceIsRemote:
+		return false, source, destination, nil
+	default:
+		return true, destination, source, nil
+	}
+}
+
+func buildSCPArgs(options connectionOptions, recursive bool, t *target, remotePath, localPath string, toRemote bool) []string {
+	args := commonArgs(options, t)
+	if recursive {
+		args = append(args, "-r")
+	}
+
+	remote := fmt.Sprintf("%s:%s", remoteHost(options, t), remotePath)
+	if toRemote {
+		return append(args, localPath, remote)
+	}
+	return append(args, remote, localPath)
+}
+
+func scpUsage() string {
+	return `  # Copy a file to the home directory of cloud-user on 'testvmi':
+  {{ProgramName}} scp myfile.bin cloud-user@testvmi:myfile.bin
+
+  # Copy a directory from 'testvm' in 'mynamespace' to the local machine:
+  {{ProgramName}} scp -r cloud-user@vm/testvm.mynamespace:/var/log ./logs`
+}
@@ -0,0 +1,227 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package ssh
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_SSH = "ssh"
+
+	SSH_BINARY = "ssh"
+
+	loginNameFlag    = "login-name"
+	identityFileFlag = "identity-file"
+	portFlag         = "port"
+)
+
+// connectionOptions are shared between the ssh and the scp command
+type connectionOptions struct {
+	loginName    string
+	identityFile string
+	port         int
+	// clientFlags are the global flags, e.g. --kubeconfig and --context, passed on to
+	// the port-forward of the ProxyCommand so that it connects to the same cluster
+	clientFlags []string
+}
+
+// target is a virtual machine (instance) which is reachable via port-forward
+type target struct {
+	kind      string
+	name      string
+	namespace string
+	username  string
+}
+
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := SSH{clientConfig: clientConfig}
+
+	cmd := &cobra.Command{
+		Use:   "ssh [user@][kind/]name[.namespace] [-- command...]",
+		Short: "Open a SSH connection to a virtual machine instance.",
+		Long: `Open a SSH connection to a virtual machine instance.
+The connection is tunneled through the port-forward subresource of the virtual machine instance, the guest does not need to be reachable from outside of the cluster.
+The local ssh client is used, it has to be available in $PATH.`,
+		Example: usage(),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return errors.New("argument validation failed")
+			}
+			return nil
+		},
+		RunE: c.Run,
+	}
+
+	addConnectionFlags(cmd, &c.options)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func addConnectionFlags(cmd *cobra.Command, options *connectionOptions) {
+	cmd.Flags().StringVarP(&options.loginName, loginNameFlag, "l", "",
+		fmt.Sprintf("--%s=cloud-user: Set this to the user you want to log in with. Overrides the user given in the target", loginNameFlag))
+	cmd.Flags().StringVarP(&options.identityFile, identityFileFlag, "i", "",
+		fmt.Sprintf("--%s=~/.ssh/id_rsa: Set the path to the private key used to authenticate", identityFileFlag))
+	cmd.Flags().IntVarP(&options.port, portFlag, "p", 22,
+		fmt.Sprintf("--%s=22: Set the port of the SSH server inside of the virtual machine instance", portFlag))
+}
+
+type SSH struct {
+	clientConfig clientcmd.ClientConfig
+	options      connectionOptions
+}
+
+func (o *SSH) Run(cmd *cobra.Command, args []string) error {
+	target, err := parseTarget(args[0], o.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	o.options.clientFlags = clientFlags(cmd)
+	sshArgs := buildSSHArgs(o.options, target, args[1:])
+	return runLocalClient(SSH_BINARY, sshArgs)
+}
+
+// parseTarget splits [user@][kind/]name[.namespace] into its parts and falls
+// back to the namespace of the current context if none is given
+func parseTarget(arg string, clientConfig clientcmd.ClientConfig) (*target, error) {
+	t := &target{}
+	if parts := strings.SplitN(arg, "@", 2); len(parts) == 2 {
+		t.username = parts[0]
+		arg = parts[1]
+	}
+
+	kind, name, namespace, err := portforward.ParseTarget(arg)
+	if err != nil {
+		return nil, err
+	}
+	t.kind = kind
+	t.name = name
+	t.namespace = namespace
+
+	if t.namespace == "" {
+		t.namespace, _, err = clientConfig.Namespace()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// clientFlags returns the global flags which were set on the command line
+func clientFlags(cmd *cobra.Command) []string {
+	var flags []string
+	globalFlags := cmd.Root().PersistentFlags()
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		if globalFlags.Lookup(flag.Name) != nil {
+			flags = append(flags, fmt.Sprintf("--%s=%s", flag.Name, flag.Value.String()))
+		}
+	})
+	return flags
+}
+
+// commonArgs returns the arguments which make the local client tunnel its
+// connection through virtctl port-forward
+func commonArgs(options connectionOptions, t *target) []string {
+	proxyArgs := append([]string{os.Args[0]}, options.clientFlags...)
+	proxyArgs = append(proxyArgs, portforward.COMMAND_PORTFORWARD, "--stdio=true",
+		fmt.Sprintf("%s/%s.%s", t.kind, t.name, t.namespace), strconv.Itoa(options.port))
+
+	quotedArgs := make([]string, len(proxyArgs))
+	for i, arg := range proxyArgs {
+		quotedArgs[i] = quoteProxyArg(arg)
+	}
+
+	args := []string{
+		"-o", "ProxyCommand=" + strings.Join(quotedArgs, " "),
+		// the same VMI name can be reused with a different host key, keep them apart from real hosts
+		"-o", fmt.Sprintf("HostKeyAlias=%s/%s.%s", t.kind, t.name, t.namespace),
+	}
+	if options.identityFile != "" {
+		args = append(args, "-i", options.identityFile)
+	}
+	return args
+}
+
+// quoteProxyArg quotes an argument of the ProxyCommand, which ssh runs through the shell
+// after expanding tokens like %h, so a literal '%' has to be doubled as well
+func quoteProxyArg(arg string) string {
+	arg = strings.ReplaceAll(arg, "%", "%%")
+	return "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+}
+
+// remoteHost returns the host for the local client, prefixed by the user if one is known
+func remoteHost(options connectionOptions, t *target) string {
+	host := fmt.Sprintf("%s.%s", t.name, t.namespace)
+	username := t.username
+	if options.loginName != "" {
+		username = options.loginName
+	}
+	if username != "" {
+		host = username + "@" + host
+	}
+	return host
+}
+
+func buildSSHArgs(options connectionOptions, t *target, command []string) []string {
+	args := commonArgs(options, t)
+	args = append(args, remoteHost(options, t))
+	return append(args, command...)
+}
+
+func runLocalClient(binary string, args []string) error {
+	path, err := exec.LookPath(binary)
+	if err != nil {
+		return fmt.Errorf("could not find the %s binary in $PATH: %v", binary, err)
+	}
+
+	glog.V(3).Infof("Executing commandline: '%s %v'", path, args)
+	// #nosec No risk for attacker injection. The args are passed to the local client without a shell
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func usage() string {
+	return `  # Connect to 'testvmi':
+  {{ProgramName}} ssh cloud-user@testvmi
+
+  # Connect to 'testvm' in 'mynamespace' with a specific key:
+  {{ProgramName}} ssh -i ~/.ssh/id_rsa cloud-user@vm/testvm.mynamespace
+
+  # Run a single command on 'testvmi':
+  {{ProgramName}} ssh -l cloud-user testvmi -- uname -a`
+}
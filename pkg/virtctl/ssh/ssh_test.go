@@ -0,0 +1,129 @@
+package ssh
+
+import (
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var _ = Describe("SSH", func() {
+
+	var clientConfig clientcmd.ClientConfig
+
+	BeforeEach(func() {
+		clientConfig = clientcmd.NewDefaultClientConfig(*clientcmdapi.NewConfig(), &clientcmd.ConfigOverrides{
+			Context: clientcmdapi.Context{Namespace: "mynamespace"},
+		})
+	})
+
+	proxyCommand := func(target string, port int) string {
+		return fmt.Sprintf("ProxyCommand='%s' 'port-forward' '--stdio=true' '%s' '%d'", os.Args[0], target, port)
+	}
+
+	table.DescribeTable("should parse the target", func(arg string, expected *target) {
+		t, err := parseTarget(arg, clientConfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(t).To(Equal(expected))
+	},
+		table.Entry("with only a name", "testvmi",
+			&target{kind: "vmi", name: "testvmi", namespace: "mynamespace"}),
+		table.Entry("with a user", "cloud-user@testvmi",
+			&target{kind: "vmi", name: "testvmi", namespace: "mynamespace", username: "cloud-user"}),
+		table.Entry("with a user, kind and namespace", "cloud-user@vm/testvm.othernamespace",
+			&target{kind: "vm", name: "testvm", namespace: "othernamespace", username: "cloud-user"}),
+	)
+
+	It("should fail to parse a target with an unsupported kind", func() {
+		_, err := parseTarget("cloud-user@pod/testpod", clientConfig)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should tunnel ssh through port-forward", func() {
+		t := &target{kind: "vmi", name: "testvmi", namespace: "mynamespace", username: "cloud-user"}
+		options := connectionOptions{port: 2222, identityFile: "id_rsa"}
+
+		Expect(buildSSHArgs(options, t, []string{"uname", "-a"})).To(Equal([]string{
+			"-o", proxyCommand("vmi/testvmi.mynamespace", 2222),
+			"-o", "HostKeyAlias=vmi/testvmi.mynamespace",
+			"-i", "id_rsa",
+			"cloud-user@testvmi.mynamespace",
+			"uname", "-a",
+		}))
+	})
+
+	It("should prefer the login name over the user of the target", func() {
+		t := &target{kind: "vm", name: "testvm", namespace: "mynamespace", username: "cloud-user"}
+		options := connectionOptions{port: 22, loginName: "fedora"}
+
+		Expect(buildSSHArgs(options, t, nil)).To(Equal([]string{
+			"-o", proxyCommand("vm/testvm.mynamespace", 22),
+			"-o", "HostKeyAlias=vm/testvm.mynamespace",
+			"fedora@testvm.mynamespace",
+		}))
+	})
+
+	It("should pass the client flags on to port-forward and quote the ProxyCommand", func() {
+		t := &target{kind: "vmi", name: "testvmi", namespace: "mynamespace"}
+		options := connectionOptions{port: 22, clientFlags: []string{"--kubeconfig=/home/my user/it's 100%.yaml", "--context=prod"}}
+
+		Expect(buildSSHArgs(options, t, nil)[1]).To(Equal(fmt.Sprintf(
+			`ProxyCommand='%s' '--kubeconfig=/home/my user/it'\''s 100%%%%.yaml' '--context=prod' 'port-forward' '--stdio=true' 'vmi/testvmi.mynamespace' '22'`,
+			os.Args[0])))
+	})
+
+	It("should collect the global flags set on the command line", func() {
+		root := &cobra.Command{Use: "virtctl"}
+		root.PersistentFlags().String("kubeconfig", "", "")
+		root.PersistentFlags().String("context", "", "")
+		root.PersistentFlags().String("server", "", "")
+		var flags []string
+		root.AddCommand(&cobra.Command{
+			Use: "ssh",
+			Run: func(cmd *cobra.Command, args []string) {
+				flags = clientFlags(cmd)
+			},
+		})
+		root.SetArgs([]string{"ssh", "--kubeconfig=/tmp/kubeconfig", "--context=prod", "testvmi"})
+
+		Expect(root.Execute()).To(Succeed())
+		Expect(flags).To(ConsistOf("--kubeconfig=/tmp/kubeconfig", "--context=prod"))
+	})
+
+	table.DescribeTable("should split the scp arguments", func(source, destination string, toRemote bool, remote, local string) {
+		r, rem, loc, err := splitSCPArgs(source, destination)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r).To(Equal(toRemote))
+		Expect(rem).To(Equal(remote))
+		Expect(loc).To(Equal(local))
+	},
+		table.Entry("when copying to the VMI", "myfile", "testvmi:/tmp/myfile", true, "testvmi:/tmp/myfile", "myfile"),
+		table.Entry("when copying from the VMI", "testvmi:/tmp/myfile", "myfile", false, "testvmi:/tmp/myfile", "myfile"),
+	)
+
+	table.DescribeTable("should fail to split the scp arguments", func(source, destination string) {
+		_, _, _, err := splitSCPArgs(source, destination)
+		Expect(err).To(HaveOccurred())
+	},
+		table.Entry("when both are local", "myfile", "otherfile"),
+		table.Entry("when both are remote", "testvmi:myfile", "othervmi:myfile"),
+	)
+
+	It("should tunnel scp through port-forward", func() {
+		t := &target{kind: "vmi", name: "testvmi", namespace: "mynamespace"}
+		options := connectionOptions{port: 22}
+
+		Expect(buildSCPArgs(options, true, t, "/var/log", "logs", false)).To(Equal([]string{
+			"-o", proxyCommand("vmi/testvmi.mynamespace", 22),
+			"-o", "HostKeyAlias=vmi/testvmi.mynamespace",
+			"-r",
+			"testvmi.mynamespace:/var/log", "logs",
+		}))
+	})
+})
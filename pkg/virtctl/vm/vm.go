@@ -27,6 +27,9 @@ import (
 	v1 "kubevirt.io/client-go/api/v1"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"kubevirt.io/client-go/kubecli"
@@ -42,13 +45,59 @@ const (
 	COMMAND_GUESTOSINFO = "guestosinfo"
 	COMMAND_USERLIST    = "userlist"
 	COMMAND_FSLIST      = "fslist"
+
+	COMMAND_ADDINTERFACE    = "addinterface"
+	COMMAND_REMOVEINTERFACE = "removeinterface"
+
+	COMMAND_EJECT  = "eject"
+	COMMAND_INSERT = "insert"
+
+	COMMAND_SETBLOCKIOTUNE = "setblockiotune"
 )
 
 var (
-	forceRestart bool
-	gracePeriod  int = -1
+	forceRestart                    bool
+	gracePeriod                     int = -1
+	networkAttachmentDefinitionName string
+	interfaceName                   string
+	persist                         bool
+	diskName                        string
+	dataVolumeName                  string
+	claimName                       string
+	ioTuneGroupName                 string
 )
 
+type ioTuneFlag struct {
+	name  string
+	usage string
+}
+
+// ioTuneQuantityFlags set the I/O limits in bytes, ioTuneCountFlags the other ones
+var ioTuneQuantityFlags = []ioTuneFlag{
+	{"total-bytes-sec", "The throughput limit of reads and writes in bytes per second, e.g. 100Mi"},
+	{"read-bytes-sec", "The throughput limit of reads in bytes per second"},
+	{"write-bytes-sec", "The throughput limit of writes in bytes per second"},
+	{"total-bytes-sec-max", "The throughput of reads and writes allowed in bursts in bytes per second"},
+	{"read-bytes-sec-max", "The throughput of reads allowed in bursts in bytes per second"},
+	{"write-bytes-sec-max", "The throughput of writes allowed in bursts in bytes per second"},
+	{"size-iops-sec", "The size in bytes an operation counts as towards the IOPS limits"},
+}
+
+var ioTuneCountFlags = []ioTuneFlag{
+	{"total-iops-sec", "The limit of read and write operations per second"},
+	{"read-iops-sec", "The limit of read operations per second"},
+	{"write-iops-sec", "The limit of write operations per second"},
+	{"total-iops-sec-max", "The rate of read and write operations allowed in bursts"},
+	{"read-iops-sec-max", "The rate of read operations allowed in bursts"},
+	{"write-iops-sec-max", "The rate of write operations allowed in bursts"},
+	{"total-bytes-sec-max-length", "How many seconds a burst of --total-bytes-sec-max may last"},
+	{"read-bytes-sec-max-length", "How many seconds a burst of --read-bytes-sec-max may last"},
+	{"write-bytes-sec-max-length", "How many seconds a burst of --write-bytes-sec-max may last"},
+	{"total-iops-sec-max-length", "How many seconds a burst of --total-iops-sec-max may last"},
+	{"read-iops-sec-max-length", "How many seconds a burst of --read-iops-sec-max may last"},
+	{"write-iops-sec-max-length", "How many seconds a burst of --write-iops-sec-max may last"},
+}
+
 func NewStartCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "start (VM)",
@@ -171,6 +220,108 @@ func NewFSListCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	return cmd
 }
 
+func NewAddInterfaceCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "addinterface (VMI)",
+		Short:   "Add a network interface to a running VM.",
+		Example: usage(COMMAND_ADDINTERFACE),
+		Args:    templates.ExactArgs("addinterface", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_ADDINTERFACE, clientConfig: clientConfig}
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&networkAttachmentDefinitionName, "network-attachment-definition-name", "", "The network attachment definition the interface is connected to, in the form [namespace/]name")
+	cmd.MarkFlagRequired("network-attachment-definition-name")
+	cmd.Flags().StringVar(&interfaceName, "name", "", "The name of the interface and of its network in the VM spec")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().BoolVar(&persist, "persist", false, "if set, the added interface will be persisted in the VM spec (if it exists)")
+	return cmd
+}
+
+func NewRemoveInterfaceCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "removeinterface (VMI)",
+		Short:   "Remove a hotplugged network interface from a running VM.",
+		Example: usage(COMMAND_REMOVEINTERFACE),
+		Args:    templates.ExactArgs("removeinterface", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_REMOVEINTERFACE, clientConfig: clientConfig}
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&interfaceName, "name", "", "The name of the interface to remove")
+	cmd.MarkFlagRequired("name")
+	cmd.Flags().BoolVar(&persist, "persist", false, "if set, the interface will also be removed from the VM spec (if it exists)")
+	return cmd
+}
+
+func NewEjectCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "eject (VMI)",
+		Short:   "Eject the media of a CD-ROM of a running VM.",
+		Example: usage(COMMAND_EJECT),
+		Args:    templates.ExactArgs("eject", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_EJECT, clientConfig: clientConfig}
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&diskName, "disk", "", "The name of the CD-ROM disk")
+	cmd.MarkFlagRequired("disk")
+	cmd.Flags().BoolVar(&persist, "persist", false, "if set, the media will also be removed from the VM spec (if it exists)")
+	return cmd
+}
+
+func NewInsertCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "insert (VMI)",
+		Short:   "Insert a media into an empty CD-ROM of a running VM.",
+		Example: usage(COMMAND_INSERT),
+		Args:    templates.ExactArgs("insert", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_INSERT, clientConfig: clientConfig}
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&diskName, "disk", "", "The name of the CD-ROM disk")
+	cmd.MarkFlagRequired("disk")
+	cmd.Flags().StringVar(&dataVolumeName, "dv", "", "The name of the DataVolume holding the media")
+	cmd.Flags().StringVar(&claimName, "pvc", "", "The name of the PersistentVolumeClaim holding the media")
+	cmd.Flags().BoolVar(&persist, "persist", false, "if set, the media will also be persisted in the VM spec (if it exists)")
+	return cmd
+}
+
+func NewSetBlockIOTuneCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "setblockiotune (VMI)",
+		Short: "Replace the I/O limits of a disk of a running VM.",
+		Long: `Replaces the I/O limits of a disk of a running VM, the limits which are not given are removed.
+The limits are not persisted in the VM spec, they are reset to the limits of the spec when the VM restarts.`,
+		Example: usage(COMMAND_SETBLOCKIOTUNE),
+		Args:    templates.ExactArgs("setblockiotune", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_SETBLOCKIOTUNE, clientConfig: clientConfig}
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&diskName, "disk", "", "The name of the disk")
+	cmd.MarkFlagRequired("disk")
+	for _, flag := range ioTuneQuantityFlags {
+		cmd.Flags().String(flag.name, "", flag.usage)
+	}
+	for _, flag := range ioTuneCountFlags {
+		cmd.Flags().Int64(flag.name, 0, flag.usage)
+	}
+	cmd.Flags().StringVar(&ioTuneGroupName, "group-name", "", "The group of disks sharing the limits")
+	return cmd
+}
+
 type Command struct {
 	clientConfig clientcmd.ClientConfig
 	command      string
@@ -182,6 +333,39 @@ func usage(cmd string) string {
 		usage += fmt.Sprintf("	{{ProgramName}} %s myvm notmyvm", cmd)
 		return usage
 	}
+	if cmd == COMMAND_ADDINTERFACE {
+		usage := "  # add an interface connected to the network attachment definition 'mynad' to a running virtual machine called 'myvm':\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --network-attachment-definition-name=mynad --name=mynet\n", cmd)
+		usage += "  # add the interface and persist it in the virtual machine spec:\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --network-attachment-definition-name=mynad --name=mynet --persist", cmd)
+		return usage
+	}
+	if cmd == COMMAND_REMOVEINTERFACE {
+		usage := "  # remove the hotplugged interface 'mynet' from a running virtual machine called 'myvm':\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --name=mynet", cmd)
+		return usage
+	}
+	if cmd == COMMAND_EJECT {
+		usage := "  # eject the media of the CD-ROM 'cdrom' of a running virtual machine called 'myvm':\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --disk=cdrom", cmd)
+		return usage
+	}
+	if cmd == COMMAND_INSERT {
+		usage := "  # insert the DataVolume 'myiso' into the empty CD-ROM 'cdrom' of a running virtual machine called 'myvm':\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --disk=cdrom --dv=myiso\n", cmd)
+		usage += "  # insert the PersistentVolumeClaim 'myiso' and persist it in the virtual machine spec:\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --disk=cdrom --pvc=myiso --persist", cmd)
+		return usage
+	}
+	if cmd == COMMAND_SETBLOCKIOTUNE {
+		usage := "  # limit the disk 'rootdisk' of a running virtual machine called 'myvm' to 100MiB/s and 1000 operations per second:\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --disk=rootdisk --total-bytes-sec=100Mi --total-iops-sec=1000\n", cmd)
+		usage += "  # allow bursts of 2000 operations per second for up to 10 seconds:\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --disk=rootdisk --total-iops-sec=1000 --total-iops-sec-max=2000 --total-iops-sec-max-length=10\n", cmd)
+		usage += "  # remove all limits of the disk:\n"
+		usage += fmt.Sprintf("  {{ProgramName}} %s myvm --disk=rootdisk", cmd)
+		return usage
+	}
 
 	usage := fmt.Sprintf("  # %s a virtual machine called 'myvm':\n", strings.Title(cmd))
 	usage += fmt.Sprintf("  {{ProgramName}} %s myvm", cmd)
@@ -281,8 +465,158 @@ func (o *Command) Run(cmd *cobra.Command, args []string) error {
 
 		fmt.Printf("%s\n", string(data))
 		return nil
+	case COMMAND_ADDINTERFACE:
+		addInterfaceOptions := &v1.AddInterfaceOptions{
+			NetworkAttachmentDefinitionName: networkAttachmentDefinitionName,
+			Name:                            interfaceName,
+		}
+		if persist {
+			err = virtClient.VirtualMachine(namespace).AddInterface(vmiName, addInterfaceOptions)
+		} else {
+			err = virtClient.VirtualMachineInstance(namespace).AddInterface(vmiName, addInterfaceOptions)
+		}
+		if err != nil {
+			return fmt.Errorf("Error adding interface %s to VM %s, %v", interfaceName, vmiName, err)
+		}
+		fmt.Printf("Successfully submitted add interface request to VM %s for interface %s\n", vmiName, interfaceName)
+		return nil
+	case COMMAND_REMOVEINTERFACE:
+		removeInterfaceOptions := &v1.RemoveInterfaceOptions{
+			Name: interfaceName,
+		}
+		if persist {
+			err = virtClient.VirtualMachine(namespace).RemoveInterface(vmiName, removeInterfaceOptions)
+		} else {
+			err = virtClient.VirtualMachineInstance(namespace).RemoveInterface(vmiName, removeInterfaceOptions)
+		}
+		if err != nil {
+			return fmt.Errorf("Error removing interface %s from VM %s, %v", interfaceName, vmiName, err)
+		}
+		fmt.Printf("Successfully submitted remove interface request to VM %s for interface %s\n", vmiName, interfaceName)
+		return nil
+	case COMMAND_EJECT:
+		ejectCDRomOptions := &v1.EjectCDRomOptions{
+			Name: diskName,
+		}
+		if persist {
+			err = virtClient.VirtualMachine(namespace).EjectCDRom(vmiName, ejectCDRomOptions)
+		} else {
+			err = virtClient.VirtualMachineInstance(namespace).EjectCDRom(vmiName, ejectCDRomOptions)
+		}
+		if err != nil {
+			return fmt.Errorf("Error ejecting the media of CD-ROM %s of VM %s, %v", diskName, vmiName, err)
+		}
+		fmt.Printf("Successfully submitted eject request to VM %s for CD-ROM %s\n", vmiName, diskName)
+		return nil
+	case COMMAND_INSERT:
+		volumeSource, err := mediaVolumeSource()
+		if err != nil {
+			return err
+		}
+		insertCDRomOptions := &v1.InsertCDRomOptions{
+			Name:         diskName,
+			VolumeSource: volumeSource,
+		}
+		if persist {
+			err = virtClient.VirtualMachine(namespace).InsertCDRom(vmiName, insertCDRomOptions)
+		} else {
+			err = virtClient.VirtualMachineInstance(namespace).InsertCDRom(vmiName, insertCDRomOptions)
+		}
+		if err != nil {
+			return fmt.Errorf("Error inserting a media into CD-ROM %s of VM %s, %v", diskName, vmiName, err)
+		}
+		fmt.Printf("Successfully submitted insert request to VM %s for CD-ROM %s\n", vmiName, diskName)
+		return nil
+	case COMMAND_SETBLOCKIOTUNE:
+		ioTune, err := ioTuneFromFlags(cmd.Flags())
+		if err != nil {
+			return err
+		}
+		err = virtClient.VirtualMachineInstance(namespace).SetBlockIOTune(vmiName, &v1.SetBlockIOTuneOptions{
+			Name:   diskName,
+			IOTune: *ioTune,
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting the I/O limits of disk %s of VM %s, %v", diskName, vmiName, err)
+		}
+		fmt.Printf("Successfully set the I/O limits of disk %s of VM %s\n", diskName, vmiName)
+		return nil
 	}
 
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
 	return nil
 }
+
+func mediaVolumeSource() (*v1.HotplugVolumeSource, error) {
+	if dataVolumeName != "" && claimName != "" {
+		return nil, fmt.Errorf("only one of --dv and --pvc can be set")
+	}
+	if dataVolumeName != "" {
+		return &v1.HotplugVolumeSource{
+			DataVolume: &v1.DataVolumeSource{
+				Name: dataVolumeName,
+			},
+		}, nil
+	}
+	if claimName != "" {
+		return &v1.HotplugVolumeSource{
+			PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("one of --dv or --pvc is required")
+}
+
+func ioTuneFromFlags(flags *pflag.FlagSet) (*v1.DiskIOTune, error) {
+	ioTune := &v1.DiskIOTune{GroupName: ioTuneGroupName}
+	quantities := map[string]**resource.Quantity{
+		"total-bytes-sec":     &ioTune.TotalBytesSec,
+		"read-bytes-sec":      &ioTune.ReadBytesSec,
+		"write-bytes-sec":     &ioTune.WriteBytesSec,
+		"total-bytes-sec-max": &ioTune.TotalBytesSecMax,
+		"read-bytes-sec-max":  &ioTune.ReadBytesSecMax,
+		"write-bytes-sec-max": &ioTune.WriteBytesSecMax,
+		"size-iops-sec":       &ioTune.SizeIOPSSec,
+	}
+	counts := map[string]**int64{
+		"total-iops-sec":             &ioTune.TotalIOPSSec,
+		"read-iops-sec":              &ioTune.ReadIOPSSec,
+		"write-iops-sec":             &ioTune.WriteIOPSSec,
+		"total-iops-sec-max":         &ioTune.TotalIOPSSecMax,
+		"read-iops-sec-max":          &ioTune.ReadIOPSSecMax,
+		"write-iops-sec-max":         &ioTune.WriteIOPSSecMax,
+		"total-bytes-sec-max-length": &ioTune.TotalBytesSecMaxLength,
+		"read-bytes-sec-max-length":  &ioTune.ReadBytesSecMaxLength,
+		"write-bytes-sec-max-length": &ioTune.WriteBytesSecMaxLength,
+		"total-iops-sec-max-length":  &ioTune.TotalIOPSSecMaxLength,
+		"read-iops-sec-max-length":   &ioTune.ReadIOPSSecMaxLength,
+		"write-iops-sec-max-length":  &ioTune.WriteIOPSSecMaxLength,
+	}
+
+	for name, limit := range quantities {
+		if !flags.Changed(name) {
+			continue
+		}
+		value, err := flags.GetString(name)
+		if err != nil {
+			return nil, err
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q of --%s: %v", value, name, err)
+		}
+		*limit = &quantity
+	}
+	for name, limit := range counts {
+		if !flags.Changed(name) {
+			continue
+		}
+		value, err := flags.GetInt64(name)
+		if err != nil {
+			return nil, err
+		}
+		*limit = &value
+	}
+	return ioTune, nil
+}
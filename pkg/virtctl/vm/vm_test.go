@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	k8sv1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/client-go/api/v1"
@@ -274,6 +275,153 @@ var _ = Describe("VirtualMachine", func() {
 		})
 	})
 
+	Context("interface hotplug", func() {
+		It("should fail addinterface without the network attachment definition", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("addinterface", vmName, "--name=blue")
+			Expect(cmd()).NotTo(BeNil())
+		})
+
+		It("should fail removeinterface without the interface name", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("removeinterface", vmName)
+			Expect(cmd()).NotTo(BeNil())
+		})
+
+		It("should add an interface to the VMI", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().AddInterface(vmName, &v1.AddInterfaceOptions{
+				NetworkAttachmentDefinitionName: "blue-nad",
+				Name:                            "blue",
+			}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("addinterface", vmName, "--network-attachment-definition-name=blue-nad", "--name=blue")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+
+		It("should add an interface to the VM when persisted", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().AddInterface(vmName, &v1.AddInterfaceOptions{
+				NetworkAttachmentDefinitionName: "blue-nad",
+				Name:                            "blue",
+			}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("addinterface", vmName, "--network-attachment-definition-name=blue-nad", "--name=blue", "--persist")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+
+		It("should remove an interface from the VMI", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().RemoveInterface(vmName, &v1.RemoveInterfaceOptions{Name: "blue"}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("removeinterface", vmName, "--name=blue")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+
+		It("should remove an interface from the VM when persisted", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().RemoveInterface(vmName, &v1.RemoveInterfaceOptions{Name: "blue"}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("removeinterface", vmName, "--name=blue", "--persist")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+	})
+
+	Context("CD-ROM media", func() {
+		It("should fail eject without the disk name", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("eject", vmName)
+			Expect(cmd()).NotTo(BeNil())
+		})
+
+		It("should fail insert without a media", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("insert", vmName, "--disk=cdrom")
+			Expect(cmd()).NotTo(BeNil())
+		})
+
+		It("should fail insert with both a DataVolume and a PVC", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("insert", vmName, "--disk=cdrom", "--dv=iso", "--pvc=iso")
+			Expect(cmd()).NotTo(BeNil())
+		})
+
+		It("should eject the media of the VMI CD-ROM", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().EjectCDRom(vmName, &v1.EjectCDRomOptions{Name: "cdrom"}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("eject", vmName, "--disk=cdrom")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+
+		It("should eject the media of the VM CD-ROM when persisted", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().EjectCDRom(vmName, &v1.EjectCDRomOptions{Name: "cdrom"}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("eject", vmName, "--disk=cdrom", "--persist")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+
+		It("should insert a DataVolume into the VMI CD-ROM", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().InsertCDRom(vmName, &v1.InsertCDRomOptions{
+				Name: "cdrom",
+				VolumeSource: &v1.HotplugVolumeSource{
+					DataVolume: &v1.DataVolumeSource{Name: "iso"},
+				},
+			}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("insert", vmName, "--disk=cdrom", "--dv=iso")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+
+		It("should insert a PVC into the VM CD-ROM when persisted", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().InsertCDRom(vmName, &v1.InsertCDRomOptions{
+				Name: "cdrom",
+				VolumeSource: &v1.HotplugVolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "iso"},
+				},
+			}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("insert", vmName, "--disk=cdrom", "--pvc=iso", "--persist")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+	})
+
+	Context("I/O limits", func() {
+		It("should fail without the disk name", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("setblockiotune", vmName, "--total-iops-sec=1000")
+			Expect(cmd()).NotTo(BeNil())
+		})
+
+		It("should fail with an invalid quantity", func() {
+			cmd := tests.NewRepeatableVirtctlCommand("setblockiotune", vmName, "--disk=rootdisk", "--total-bytes-sec=fast")
+			Expect(cmd()).NotTo(BeNil())
+		})
+
+		It("should set the I/O limits of the VMI disk", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().SetBlockIOTune(vmName, gomock.Any()).DoAndReturn(func(_ string, opts *v1.SetBlockIOTuneOptions) error {
+				Expect(opts.Name).To(Equal("rootdisk"))
+				Expect(opts.IOTune.TotalBytesSec.String()).To(Equal("100Mi"))
+				Expect(*opts.IOTune.TotalIOPSSec).To(Equal(int64(1000)))
+				Expect(*opts.IOTune.TotalIOPSSecMax).To(Equal(int64(2000)))
+				Expect(opts.IOTune.ReadBytesSec).To(BeNil())
+				Expect(opts.IOTune.TotalIOPSSecMaxLength).To(BeNil())
+				Expect(opts.IOTune.GroupName).To(Equal("shared"))
+				return nil
+			}).Times(1)
+
+			cmd := tests.NewVirtctlCommand("setblockiotune", vmName, "--disk=rootdisk", "--total-bytes-sec=100Mi",
+				"--total-iops-sec=1000", "--total-iops-sec-max=2000", "--group-name=shared")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+
+		It("should remove the I/O limits of the VMI disk", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().SetBlockIOTune(vmName, &v1.SetBlockIOTuneOptions{Name: "rootdisk"}).Return(nil).Times(1)
+
+			cmd := tests.NewVirtctlCommand("setblockiotune", vmName, "--disk=rootdisk")
+			Expect(cmd.Execute()).To(BeNil())
+		})
+	})
+
 	AfterEach(func() {
 		ctrl.Finish()
 	})
@@ -0,0 +1,211 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2022 Red Hat, Inc.
+ *
+ */
+
+package vmbackup
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_VMBACKUP = "vmbackup"
+	COMMAND_NBD      = "nbd"
+
+	addressFlag = "address"
+	portFlag    = "port"
+	stdioFlag   = "stdio"
+
+	// defaultNBDPort is the port registered for NBD
+	defaultNBDPort = 10809
+)
+
+type nbdCommand struct {
+	clientConfig clientcmd.ClientConfig
+
+	address string
+	port    int
+	stdio   bool
+}
+
+// NewCommand generates a new "vmbackup" command
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_VMBACKUP,
+		Short: "Access the disks of a VirtualMachineBackup.",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newNBDCommand(clientConfig))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func newNBDCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := nbdCommand{clientConfig: clientConfig}
+
+	cmd := &cobra.Command{
+		Use:   "nbd (NAME)",
+		Short: "Forward a local port to the NBD server of a running pull VirtualMachineBackup.",
+		Long: `Forwards a local port to the NBD server which exports the disks of a running pull VirtualMachineBackup.
+Every disk is an export of its own, the export names are printed before the forwarding starts.
+The dirty blocks of an incremental backup are described by the "qemu:dirty-bitmap:<export>" metadata context.
+
+The disks are exported until the TTL of the backup passed or the backup is deleted.`,
+		Example: usage(),
+		Args:    templates.ExactArgs(COMMAND_NBD, 1),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.address, addressFlag, "127.0.0.1", "Address the local port is bound to.")
+	cmd.Flags().IntVar(&c.port, portFlag, defaultNBDPort, "Local port the NBD clients connect to.")
+	cmd.Flags().BoolVar(&c.stdio, stdioFlag, false, "Forward stdio to the NBD server instead of listening on a local port.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	return `  # Forward the local port 10809 to the NBD server of the pull backup 'mybackup':
+  {{ProgramName}} vmbackup nbd mybackup
+
+  # Read the exported volume 'rootdisk' with qemu-img:
+  qemu-img convert -O qcow2 nbd://127.0.0.1:10809/rootdisk rootdisk.qcow2
+
+  # Forward the local port 11000 on all addresses:
+  {{ProgramName}} vmbackup nbd mybackup --address=0.0.0.0 --port=11000`
+}
+
+func (c *nbdCommand) run(cmd *cobra.Command, args []string) error {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	backup, err := virtClient.VirtualMachineBackup(namespace).Get(context.Background(), args[0], metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if err := checkPullBackup(backup); err != nil {
+		return err
+	}
+
+	vmiName := backup.Spec.Source.Name
+	vmiInterface := virtClient.VirtualMachineInstance(namespace)
+
+	if c.stdio {
+		stream, err := vmiInterface.NBD(vmiName)
+		if err != nil {
+			return fmt.Errorf("can't access VMI %s: %v", vmiName, err)
+		}
+		return stream.Stream(kubecli.StreamOptions{
+			In:  os.Stdin,
+			Out: os.Stdout,
+		})
+	}
+
+	address := net.ParseIP(c.address)
+	if address == nil {
+		return fmt.Errorf("%s is not a valid IP address", c.address)
+	}
+	listener, err := net.ListenTCP("tcp", &net.TCPAddr{IP: address, Port: c.port})
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	for _, volume := range backup.Status.Volumes {
+		fmt.Fprintf(cmd.OutOrStdout(), "volume %s: nbd://%s/%s\n", volume.VolumeName, listener.Addr().String(), volume.ExportName)
+	}
+
+	go acceptConnections(listener, vmiInterface, vmiName)
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	<-interrupt
+
+	return nil
+}
+
+func checkPullBackup(backup *backupv1.VirtualMachineBackup) error {
+	if backup.Spec.Mode != v1.BackupModePull {
+		return fmt.Errorf("VirtualMachineBackup %s is not a pull backup", backup.Name)
+	}
+	if backupPhase(backup) != backupv1.Running {
+		return fmt.Errorf("the disks of VirtualMachineBackup %s are not exported, the backup is %s", backup.Name, backupPhase(backup))
+	}
+	return nil
+}
+
+func backupPhase(backup *backupv1.VirtualMachineBackup) backupv1.BackupPhase {
+	if backup.Status == nil || backup.Status.Phase == "" {
+		return backupv1.Pending
+	}
+	return backup.Status.Phase
+}
+
+func acceptConnections(listener net.Listener, vmiInterface kubecli.VirtualMachineInstanceInterface, vmiName string) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			glog.Errorln("error accepting connection:", err)
+			return
+		}
+		stream, err := vmiInterface.NBD(vmiName)
+		if err != nil {
+			glog.Errorf("can't access VMI %s: %v", vmiName, err)
+			conn.Close()
+			continue
+		}
+		go handleConnection(conn, stream)
+	}
+}
+
+// handleConnection copies data between the local connection and the NBD server
+// until one of the sides closes its connection
+func handleConnection(conn net.Conn, stream kubecli.StreamInterface) {
+	defer conn.Close()
+
+	err := stream.Stream(kubecli.StreamOptions{
+		In:  conn,
+		Out: conn,
+	})
+	if err != nil {
+		glog.Errorf("error while forwarding to the NBD server: %v", err)
+	}
+}
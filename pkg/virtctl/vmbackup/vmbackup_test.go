@@ -0,0 +1,111 @@
+package vmbackup_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+	backupv1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmbackup"
+	"kubevirt.io/kubevirt/tests"
+)
+
+var _ = Describe("VMBackup", func() {
+
+	const (
+		backupName = "mybackup"
+		vmName     = "testvm"
+	)
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var stream *kubecli.MockStreamInterface
+	var kubevirtClient *kubevirtfake.Clientset
+	var ctrl *gomock.Controller
+
+	createBackup := func(mode v1.BackupMode, phase backupv1.BackupPhase) {
+		apiGroup := "kubevirt.io"
+		backup := &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      backupName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: &apiGroup,
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+				Mode: mode,
+			},
+			Status: &backupv1.VirtualMachineBackupStatus{
+				Phase: phase,
+			},
+		}
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(metav1.NamespaceDefault).Create(context.Background(), backup, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		stream = kubecli.NewMockStreamInterface(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineBackup(metav1.NamespaceDefault).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackups(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should fail without a backup name", func() {
+		cmd := tests.NewRepeatableVirtctlCommand(vmbackup.COMMAND_VMBACKUP, vmbackup.COMMAND_NBD)
+		Expect(cmd()).To(HaveOccurred())
+	})
+
+	It("should fail if the backup does not exist", func() {
+		cmd := tests.NewRepeatableVirtctlCommand(vmbackup.COMMAND_VMBACKUP, vmbackup.COMMAND_NBD, backupName)
+		Expect(cmd()).To(MatchError(ContainSubstring("not found")))
+	})
+
+	table.DescribeTable("should refuse to forward to a backup whose disks are not exported", func(mode v1.BackupMode, phase backupv1.BackupPhase, expected string) {
+		createBackup(mode, phase)
+
+		cmd := tests.NewRepeatableVirtctlCommand(vmbackup.COMMAND_VMBACKUP, vmbackup.COMMAND_NBD, backupName)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		table.Entry("with a push backup", v1.BackupModePush, backupv1.Running, "is not a pull backup"),
+		table.Entry("with a pull backup which did not start", v1.BackupModePull, backupv1.BackupPhase(""), "the backup is Pending"),
+		table.Entry("with a completed pull backup", v1.BackupModePull, backupv1.Succeeded, "the backup is Succeeded"),
+	)
+
+	It("should forward stdio to the NBD server of the source VMI", func() {
+		createBackup(v1.BackupModePull, backupv1.Running)
+		vmiInterface.EXPECT().NBD(vmName).Return(stream, nil)
+		stream.EXPECT().Stream(gomock.Any()).Return(nil)
+
+		cmd := tests.NewRepeatableVirtctlCommand(vmbackup.COMMAND_VMBACKUP, vmbackup.COMMAND_NBD, "--stdio=true", backupName)
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should fail when the nbd subresource can't be reached", func() {
+		createBackup(v1.BackupModePull, backupv1.Running)
+		vmiInterface.EXPECT().NBD(vmName).Return(nil, fmt.Errorf("error"))
+
+		cmd := tests.NewRepeatableVirtctlCommand(vmbackup.COMMAND_VMBACKUP, vmbackup.COMMAND_NBD, "--stdio=true", backupName)
+		Expect(cmd()).To(MatchError(ContainSubstring("can't access VMI " + vmName)))
+	})
+})
@@ -0,0 +1,419 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package vmexport
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	pb "gopkg.in/cheggaaa/pb.v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/kubevirt/pkg/util"
+	exportserver "kubevirt.io/kubevirt/pkg/virt-exportserver"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_VMEXPORT = "vmexport"
+	COMMAND_DOWNLOAD = "download"
+
+	outputFlag   = "output"
+	volumeFlag   = "volume"
+	formatFlag   = "format"
+	manifestFlag = "manifest"
+	urlFlag      = "url"
+	insecureFlag = "insecure"
+	waitSecsFlag = "wait-secs"
+
+	exportReadyWaitInterval = 2 * time.Second
+)
+
+// HTTPClientCreator is a function that creates http clients using the given TLS configuration
+type HTTPClientCreator func(*tls.Config) *http.Client
+
+var httpClientCreatorFunc HTTPClientCreator
+
+// SetHTTPClientCreator allows overriding the default http client
+// useful for unit tests
+func SetHTTPClientCreator(f HTTPClientCreator) {
+	httpClientCreatorFunc = f
+}
+
+// SetDefaultHTTPClientCreator sets the http client creator back to default
+func SetDefaultHTTPClientCreator() {
+	httpClientCreatorFunc = getHTTPClient
+}
+
+func init() {
+	SetDefaultHTTPClientCreator()
+}
+
+type downloadCommand struct {
+	clientConfig clientcmd.ClientConfig
+
+	output   string
+	volume   string
+	format   string
+	manifest string
+	url      string
+	insecure bool
+	waitSecs uint
+}
+
+// NewCommand generates a new "vmexport" command
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_VMEXPORT,
+		Short: "Download the volumes and manifests of a VirtualMachineExport.",
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(newDownloadCommand(clientConfig))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func newDownloadCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := downloadCommand{clientConfig: clientConfig}
+
+	cmd := &cobra.Command{
+		Use:   "download (NAME)",
+		Short: "Download a volume or the manifests of a VirtualMachineExport.",
+		Long: `Waits for the VirtualMachineExport to be ready and downloads one of its volumes or its manifests.
+The export server is authenticated with the certificate in the status of the VirtualMachineExport
+and the download is verified against the checksum sent by the export server.
+
+The export server is only reachable inside the cluster, use --url to download through a port-forward,
+an ingress or a route.`,
+		Example: usage(),
+		Args:    templates.ExactArgs(COMMAND_DOWNLOAD, 1),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.output, outputFlag, "", "File the volume or the manifests are written to.")
+	cmd.MarkFlagRequired(outputFlag)
+	cmd.Flags().StringVar(&c.volume, volumeFlag, "", "Volume to download, required if the export has more than one volume.")
+	cmd.Flags().StringVar(&c.format, formatFlag, string(exportv1.FormatRaw), "Format of the downloaded volume, one of raw, gzip or qcow2.")
+	cmd.Flags().StringVar(&c.manifest, manifestFlag, "", "Download the manifests instead of a volume, 'vm' for the virtual machine or 'all' for an archive of all manifests.")
+	cmd.Flags().StringVar(&c.url, urlFlag, "", "Base URL of the export server, overrides the scheme and host of the in-cluster URL.")
+	cmd.Flags().BoolVar(&c.insecure, insecureFlag, false, "Do not verify the certificate of the export server.")
+	cmd.Flags().UintVar(&c.waitSecs, waitSecsFlag, 300, "Seconds to wait for the export to become ready.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	usage := `  # Download the only volume of the export 'myexport' as a raw disk image:
+  {{ProgramName}} vmexport download myexport --output=disk.img
+
+  # Download the volume 'rootdisk' compressed, through a port-forward to the export service:
+  {{ProgramName}} vmexport download myexport --volume=rootdisk --format=gzip --output=disk.img.gz --url=https://127.0.0.1:8443
+
+  # Download all manifests of the export as an archive:
+  {{ProgramName}} vmexport download myexport --manifest=all --output=manifests.tar.gz`
+	return usage
+}
+
+func (c *downloadCommand) run(cmd *cobra.Command, args []string) error {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vmExport, err := waitExportReady(virtClient, namespace, args[0], exportReadyWaitInterval, time.Duration(c.waitSecs)*time.Second)
+	if err != nil {
+		return err
+	}
+
+	link := vmExport.Status.Links.Internal
+	downloadURL, err := c.downloadURL(link)
+	if err != nil {
+		return err
+	}
+
+	token, err := getToken(virtClient, namespace, vmExport.Spec.TokenSecretRef)
+	if err != nil {
+		return err
+	}
+
+	tlsConfig, err := c.tlsConfig(link)
+	if err != nil {
+		return err
+	}
+
+	requestURL, err := c.requestURL(downloadURL)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("Downloading %s to %s\n", requestURL, c.output)
+	if err = download(httpClientCreatorFunc(tlsConfig), requestURL, token, c.output); err != nil {
+		return err
+	}
+
+	cmd.Printf("Downloading %s completed successfully\n", c.output)
+	return nil
+}
+
+// downloadURL returns the in-cluster URL of the requested volume or manifest
+func (c *downloadCommand) downloadURL(link *exportv1.VirtualMachineExportLink) (string, error) {
+	if c.manifest != "" {
+		for _, manifest := range link.Manifests {
+			if string(manifest.Type) == c.manifest {
+				return manifest.URL, nil
+			}
+		}
+		return "", fmt.Errorf("manifest %q is not exported", c.manifest)
+	}
+
+	var volume *exportv1.VirtualMachineExportVolume
+	var names []string
+	for i := range link.Volumes {
+		names = append(names, link.Volumes[i].Name)
+		if link.Volumes[i].Name == c.volume {
+			volume = &link.Volumes[i]
+		}
+	}
+
+	if c.volume == "" {
+		if len(link.Volumes) != 1 {
+			return "", fmt.Errorf("--%s is required, the export has the volumes %s", volumeFlag, strings.Join(names, ", "))
+		}
+		volume = &link.Volumes[0]
+	}
+
+	if volume == nil {
+		return "", fmt.Errorf("volume %q is not exported, the export has the volumes %s", c.volume, strings.Join(names, ", "))
+	}
+
+	for _, format := range volume.Formats {
+		if string(format.Format) == c.format {
+			return format.URL, nil
+		}
+	}
+
+	return "", fmt.Errorf("volume %s is not exported as %q", volume.Name, c.format)
+}
+
+// requestURL replaces the scheme and host of the in-cluster URL if --url is given
+func (c *downloadCommand) requestURL(downloadURL string) (string, error) {
+	if c.url == "" {
+		return downloadURL, nil
+	}
+
+	u, err := url.Parse(downloadURL)
+	if err != nil {
+		return "", err
+	}
+
+	base, err := url.Parse(c.url)
+	if err != nil {
+		return "", err
+	}
+
+	if base.Scheme == "" {
+		base, err = url.Parse("https://" + c.url)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	u.Scheme = base.Scheme
+	u.Host = base.Host
+	u.Path = strings.TrimSuffix(base.Path, "/") + u.Path
+
+	return u.String(), nil
+}
+
+// tlsConfig trusts the CA of the export server. The certificate is verified against
+// the in-cluster host name, even if the export server is reached through --url.
+func (c *downloadCommand) tlsConfig(link *exportv1.VirtualMachineExportLink) (*tls.Config, error) {
+	if c.insecure {
+		// #nosec cause: InsecureSkipVerify: true resolution: the user explicitly asked for an insecure connection
+		return &tls.Config{InsecureSkipVerify: true}, nil
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(link.Cert)) {
+		return nil, fmt.Errorf("the export has no valid certificate, use --%s to skip the verification", insecureFlag)
+	}
+
+	config := &tls.Config{RootCAs: pool}
+	if len(link.Volumes) > 0 && len(link.Volumes[0].Formats) > 0 {
+		u, err := url.Parse(link.Volumes[0].Formats[0].URL)
+		if err != nil {
+			return nil, err
+		}
+		config.ServerName = u.Hostname()
+	} else if len(link.Manifests) > 0 {
+		u, err := url.Parse(link.Manifests[0].URL)
+		if err != nil {
+			return nil, err
+		}
+		config.ServerName = u.Hostname()
+	}
+
+	return config, nil
+}
+
+func getHTTPClient(tlsConfig *tls.Config) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+}
+
+func waitExportReady(client kubecli.KubevirtClient, namespace, name string, interval, timeout time.Duration) (*exportv1.VirtualMachineExport, error) {
+	var vmExport *exportv1.VirtualMachineExport
+	loggedStatus := false
+
+	err := wait.PollImmediate(interval, timeout, func() (bool, error) {
+		var err error
+		vmExport, err = client.VirtualMachineExport(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		ready := vmExport.Status != nil && vmExport.Status.Phase == exportv1.Ready &&
+			vmExport.Status.Links != nil && vmExport.Status.Links.Internal != nil
+		if !ready && !loggedStatus {
+			fmt.Printf("Waiting for VirtualMachineExport %s/%s to be ready\n", namespace, name)
+			loggedStatus = true
+		}
+
+		return ready, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, fmt.Errorf("timed out waiting for VirtualMachineExport %s/%s to be ready", namespace, name)
+	}
+
+	return vmExport, err
+}
+
+func getToken(client kubecli.KubevirtClient, namespace, name string) (string, error) {
+	secret, err := client.CoreV1().Secrets(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	token, ok := secret.Data[exportv1.TokenKey]
+	if !ok {
+		return "", fmt.Errorf("secret %s/%s has no %q key", namespace, name, exportv1.TokenKey)
+	}
+
+	return string(token), nil
+}
+
+// download writes the response body to output and verifies it against the checksum
+// sent by the export server, output is removed if the download fails
+func download(client *http.Client, requestURL, token, output string) (err error) {
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Add(exportserver.TokenHeader, token)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("unexpected return value %d, %s", resp.StatusCode, string(body))
+	}
+
+	// #nosec G304 No risk for path injection as this function executes with
+	// the same privileges as those of virtctl user who supplies output
+	file, err := os.Create(output)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		util.CloseIOAndCheckErr(file, &err)
+		if err != nil {
+			os.Remove(output)
+		}
+	}()
+
+	var size int64
+	if s := resp.Header.Get(exportserver.SizeHeader); s != "" {
+		if size, err = strconv.ParseInt(s, 10, 64); err != nil {
+			return fmt.Errorf("invalid %s header %q", exportserver.SizeHeader, s)
+		}
+	}
+
+	bar := pb.New64(size).SetUnits(pb.U_BYTES)
+	hash := sha256.New()
+	reader := bar.NewProxyReader(io.TeeReader(resp.Body, hash))
+
+	fmt.Println()
+	bar.Start()
+	_, err = io.Copy(file, reader)
+	bar.Finish()
+	fmt.Println()
+
+	if err != nil {
+		return err
+	}
+
+	// trailers are only available after the body was read
+	expected := resp.Trailer.Get(exportserver.ChecksumTrailer)
+	if expected == "" {
+		return fmt.Errorf("the export server sent no checksum")
+	}
+
+	if actual := exportserver.ChecksumPrefix + hex.EncodeToString(hash.Sum(nil)); actual != expected {
+		return fmt.Errorf("checksum mismatch, expected %s but got %s", expected, actual)
+	}
+
+	return nil
+}
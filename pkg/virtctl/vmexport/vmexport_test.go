@@ -0,0 +1,290 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package vmexport
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	exportv1 "kubevirt.io/client-go/apis/export/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	exportserver "kubevirt.io/kubevirt/pkg/virt-exportserver"
+)
+
+var _ = Describe("VMExport", func() {
+	const (
+		namespace  = "mynamespace"
+		exportName = "myexport"
+		token      = "secret-token"
+		baseURL    = "https://virt-export-myexport.mynamespace.svc"
+	)
+
+	var clientConfig clientcmd.ClientConfig
+	var kubevirtClient *kubevirtfake.Clientset
+	var k8sClient *k8sfake.Clientset
+	var server *httptest.Server
+	var served map[string][]byte
+	var badChecksum bool
+	var tmpDir string
+
+	checksum := func(b []byte) string {
+		sum := sha256.Sum256(b)
+		return exportserver.ChecksumPrefix + hex.EncodeToString(sum[:])
+	}
+
+	createExport := func(phase exportv1.VirtualMachineExportPhase, volumes ...string) *exportv1.VirtualMachineExport {
+		link := &exportv1.VirtualMachineExportLink{
+			Cert: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})),
+			Manifests: []exportv1.VirtualMachineExportManifest{
+				{Type: exportv1.AllManifests, URL: baseURL + exportserver.ManifestPath(exportv1.AllManifests)},
+			},
+		}
+		for _, volume := range volumes {
+			link.Volumes = append(link.Volumes, exportv1.VirtualMachineExportVolume{
+				Name: volume,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{
+					{Format: exportv1.FormatRaw, URL: baseURL + exportserver.VolumePath(volume, exportv1.FormatRaw)},
+					{Format: exportv1.FormatGzip, URL: baseURL + exportserver.VolumePath(volume, exportv1.FormatGzip)},
+				},
+			})
+		}
+
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      exportName,
+				Namespace: namespace,
+			},
+			Spec: exportv1.VirtualMachineExportSpec{
+				TokenSecretRef: "token",
+			},
+			Status: &exportv1.VirtualMachineExportStatus{
+				Phase: phase,
+				Links: &exportv1.VirtualMachineExportLinks{Internal: link},
+			},
+		}
+	}
+
+	addExport := func(vmExport *exportv1.VirtualMachineExport) {
+		_, err := kubevirtClient.ExportV1alpha1().VirtualMachineExports(namespace).Create(context.Background(), vmExport, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		clientConfig = clientcmd.NewDefaultClientConfig(*clientcmdapi.NewConfig(), &clientcmd.ConfigOverrides{
+			Context: clientcmdapi.Context{Namespace: namespace},
+		})
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		k8sClient = k8sfake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: namespace},
+			Data:       map[string][]byte{exportv1.TokenKey: []byte(token)},
+		})
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineExport(namespace).
+			Return(kubevirtClient.ExportV1alpha1().VirtualMachineExports(namespace)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		served = map[string][]byte{
+			exportserver.VolumePath("disk", exportv1.FormatRaw):  []byte("raw disk image"),
+			exportserver.VolumePath("disk", exportv1.FormatGzip): []byte("gzipped disk image"),
+			exportserver.ManifestPath(exportv1.AllManifests):     []byte("manifests"),
+			exportserver.VolumePath("other", exportv1.FormatRaw): []byte("other disk image"),
+		}
+		badChecksum = false
+
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get(exportserver.TokenHeader) != token {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			body, ok := served[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			w.Header().Set("Trailer", exportserver.ChecksumTrailer)
+			w.Header().Set(exportserver.SizeHeader, strconv.Itoa(len(body)))
+			w.Write(body)
+			if badChecksum {
+				w.Header().Set(exportserver.ChecksumTrailer, checksum([]byte("something else")))
+			} else {
+				w.Header().Set(exportserver.ChecksumTrailer, checksum(body))
+			}
+		}))
+		SetHTTPClientCreator(func(*tls.Config) *http.Client {
+			return server.Client()
+		})
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "vmexport")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		SetDefaultHTTPClientCreator()
+		os.RemoveAll(tmpDir)
+	})
+
+	runCommand := func(args ...string) error {
+		cmd := NewCommand(clientConfig)
+		// the usage template depends on functions registered by the root command
+		cmd.SilenceUsage = true
+		cmd.SetOut(ioutil.Discard)
+		cmd.SetArgs(append([]string{COMMAND_DOWNLOAD, exportName, "--url", server.URL, "--wait-secs", "1"}, args...))
+		return cmd.Execute()
+	}
+
+	output := func() string {
+		return filepath.Join(tmpDir, "output")
+	}
+
+	readOutput := func() string {
+		b, err := ioutil.ReadFile(output())
+		Expect(err).ToNot(HaveOccurred())
+		return string(b)
+	}
+
+	It("should download the only volume as raw image", func() {
+		addExport(createExport(exportv1.Ready, "disk"))
+
+		Expect(runCommand("--output", output())).To(Succeed())
+		Expect(readOutput()).To(Equal("raw disk image"))
+	})
+
+	It("should download the requested volume and format", func() {
+		addExport(createExport(exportv1.Ready, "other", "disk"))
+
+		Expect(runCommand("--output", output(), "--volume", "disk", "--format", "gzip")).To(Succeed())
+		Expect(readOutput()).To(Equal("gzipped disk image"))
+	})
+
+	It("should download the manifests", func() {
+		addExport(createExport(exportv1.Ready, "disk"))
+
+		Expect(runCommand("--output", output(), "--manifest", "all")).To(Succeed())
+		Expect(readOutput()).To(Equal("manifests"))
+	})
+
+	It("should require the volume if there are multiple", func() {
+		addExport(createExport(exportv1.Ready, "other", "disk"))
+
+		err := runCommand("--output", output())
+		Expect(err).To(MatchError("--volume is required, the export has the volumes other, disk"))
+	})
+
+	It("should reject unknown volumes, formats and manifests", func() {
+		addExport(createExport(exportv1.Ready, "disk"))
+
+		Expect(runCommand("--output", output(), "--volume", "unknown")).To(MatchError(ContainSubstring(`volume "unknown" is not exported`)))
+		Expect(runCommand("--output", output(), "--format", "qcow2")).To(MatchError(`volume disk is not exported as "qcow2"`))
+		Expect(runCommand("--output", output(), "--manifest", "vm")).To(MatchError(`manifest "vm" is not exported`))
+	})
+
+	It("should fail if the export is not ready", func() {
+		addExport(createExport(exportv1.Pending, "disk"))
+
+		err := runCommand("--output", output())
+		Expect(err).To(MatchError("timed out waiting for VirtualMachineExport mynamespace/myexport to be ready"))
+	})
+
+	It("should remove the output if the checksum does not match", func() {
+		addExport(createExport(exportv1.Ready, "disk"))
+		badChecksum = true
+
+		err := runCommand("--output", output())
+		Expect(err).To(MatchError(ContainSubstring("checksum mismatch")))
+		_, err = os.Stat(output())
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("should fail with a wrong token", func() {
+		addExport(createExport(exportv1.Ready, "disk"))
+		_, err := k8sClient.CoreV1().Secrets(namespace).Update(context.Background(), &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "token", Namespace: namespace},
+			Data:       map[string][]byte{exportv1.TokenKey: []byte("wrong")},
+		}, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = runCommand("--output", output())
+		Expect(err).To(MatchError(ContainSubstring("unexpected return value 401")))
+	})
+
+	Context("urls", func() {
+		It("should replace the host of the in-cluster URL", func() {
+			c := downloadCommand{url: "https://127.0.0.1:8443/proxy/"}
+			u, err := c.requestURL(baseURL + "/volumes/disk/disk.img")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(u).To(Equal("https://127.0.0.1:8443/proxy/volumes/disk/disk.img"))
+		})
+
+		It("should default to https", func() {
+			c := downloadCommand{url: "export.example.com"}
+			u, err := c.requestURL(baseURL + "/volumes/disk/disk.img")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(u).To(Equal("https://export.example.com/volumes/disk/disk.img"))
+		})
+
+		It("should verify the certificate against the in-cluster host", func() {
+			c := downloadCommand{}
+			config, err := c.tlsConfig(createExport(exportv1.Ready, "disk").Status.Links.Internal)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.ServerName).To(Equal("virt-export-myexport.mynamespace.svc"))
+			Expect(config.InsecureSkipVerify).To(BeFalse())
+		})
+
+		It("should require a certificate unless insecure", func() {
+			link := createExport(exportv1.Ready, "disk").Status.Links.Internal
+			link.Cert = ""
+
+			c := downloadCommand{}
+			_, err := c.tlsConfig(link)
+			Expect(err).To(MatchError("the export has no valid certificate, use --insecure to skip the verification"))
+
+			c.insecure = true
+			config, err := c.tlsConfig(link)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.InsecureSkipVerify).To(BeTrue())
+		})
+	})
+})
@@ -69,6 +69,22 @@ func (in *AccessCredentialSecretSource) DeepCopy() *AccessCredentialSecretSource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddInterfaceOptions) DeepCopyInto(out *AddInterfaceOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddInterfaceOptions.
+func (in *AddInterfaceOptions) DeepCopy() *AddInterfaceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AddInterfaceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddVolumeOptions) DeepCopyInto(out *AddVolumeOptions) {
 	*out = *in
@@ -132,6 +148,71 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupCheckpoint) DeepCopyInto(out *BackupCheckpoint) {
+	*out = *in
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupCheckpoint.
+func (in *BackupCheckpoint) DeepCopy() *BackupCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupTarget) DeepCopyInto(out *BackupTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupTarget.
+func (in *BackupTarget) DeepCopy() *BackupTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimits) DeepCopyInto(out *BandwidthLimits) {
+	*out = *in
+	out.Average = in.Average.DeepCopy()
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimits.
+func (in *BandwidthLimits) DeepCopy() *BandwidthLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bootloader) DeepCopyInto(out *Bootloader) {
 	*out = *in
@@ -478,6 +559,21 @@ func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 		*out = make([]DHCPPrivateOptions, len(*in))
 		copy(*out, *in)
 	}
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]DHCPRoute, len(*in))
+		copy(*out, *in)
+	}
+	if in.StaticLease != nil {
+		in, out := &in.StaticLease, &out.StaticLease
+		*out = new(DHCPStaticLease)
+		**out = **in
+	}
 	return
 }
 
@@ -507,6 +603,38 @@ func (in *DHCPPrivateOptions) DeepCopy() *DHCPPrivateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPRoute) DeepCopyInto(out *DHCPRoute) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPRoute.
+func (in *DHCPRoute) DeepCopy() *DHCPRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPStaticLease) DeepCopyInto(out *DHCPStaticLease) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPStaticLease.
+func (in *DHCPStaticLease) DeepCopy() *DHCPStaticLease {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPStaticLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolumeSource) DeepCopyInto(out *DataVolumeSource) {
 	*out = *in
@@ -707,6 +835,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -756,6 +889,117 @@ func (in *DiskDevice) DeepCopy() *DiskDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.TotalBytesSec != nil {
+		in, out := &in.TotalBytesSec, &out.TotalBytesSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ReadBytesSec != nil {
+		in, out := &in.ReadBytesSec, &out.ReadBytesSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.WriteBytesSec != nil {
+		in, out := &in.WriteBytesSec, &out.WriteBytesSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalIOPSSec != nil {
+		in, out := &in.TotalIOPSSec, &out.TotalIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadIOPSSec != nil {
+		in, out := &in.ReadIOPSSec, &out.ReadIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPSSec != nil {
+		in, out := &in.WriteIOPSSec, &out.WriteIOPSSec
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TotalBytesSecMax != nil {
+		in, out := &in.TotalBytesSecMax, &out.TotalBytesSecMax
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ReadBytesSecMax != nil {
+		in, out := &in.ReadBytesSecMax, &out.ReadBytesSecMax
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.WriteBytesSecMax != nil {
+		in, out := &in.WriteBytesSecMax, &out.WriteBytesSecMax
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalIOPSSecMax != nil {
+		in, out := &in.TotalIOPSSecMax, &out.TotalIOPSSecMax
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadIOPSSecMax != nil {
+		in, out := &in.ReadIOPSSecMax, &out.ReadIOPSSecMax
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPSSecMax != nil {
+		in, out := &in.WriteIOPSSecMax, &out.WriteIOPSSecMax
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TotalBytesSecMaxLength != nil {
+		in, out := &in.TotalBytesSecMaxLength, &out.TotalBytesSecMaxLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadBytesSecMaxLength != nil {
+		in, out := &in.ReadBytesSecMaxLength, &out.ReadBytesSecMaxLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteBytesSecMaxLength != nil {
+		in, out := &in.WriteBytesSecMaxLength, &out.WriteBytesSecMaxLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TotalIOPSSecMaxLength != nil {
+		in, out := &in.TotalIOPSSecMaxLength, &out.TotalIOPSSecMaxLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadIOPSSecMaxLength != nil {
+		in, out := &in.ReadIOPSSecMaxLength, &out.ReadIOPSSecMaxLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPSSecMaxLength != nil {
+		in, out := &in.WriteIOPSSecMaxLength, &out.WriteIOPSSecMaxLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SizeIOPSSec != nil {
+		in, out := &in.SizeIOPSSec, &out.SizeIOPSSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -870,6 +1114,22 @@ func (in *EFI) DeepCopy() *EFI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EjectCDRomOptions) DeepCopyInto(out *EjectCDRomOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EjectCDRomOptions.
+func (in *EjectCDRomOptions) DeepCopy() *EjectCDRomOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EjectCDRomOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmptyDiskSource) DeepCopyInto(out *EmptyDiskSource) {
 	*out = *in
@@ -1215,6 +1475,27 @@ func (in *FloppyTarget) DeepCopy() *FloppyTarget {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeUnfreezeTimeout) DeepCopyInto(out *FreezeUnfreezeTimeout) {
+	*out = *in
+	if in.UnfreezeTimeout != nil {
+		in, out := &in.UnfreezeTimeout, &out.UnfreezeTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeUnfreezeTimeout.
+func (in *FreezeUnfreezeTimeout) DeepCopy() *FreezeUnfreezeTimeout {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeUnfreezeTimeout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GPU) DeepCopyInto(out *GPU) {
 	*out = *in
@@ -1316,6 +1597,22 @@ func (in *HostDisk) DeepCopy() *HostDisk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HotplugInterfaceStatus) DeepCopyInto(out *HotplugInterfaceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HotplugInterfaceStatus.
+func (in *HotplugInterfaceStatus) DeepCopy() *HotplugInterfaceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HotplugInterfaceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HotplugVolumeSource) DeepCopyInto(out *HotplugVolumeSource) {
 	*out = *in
@@ -1427,10 +1724,52 @@ func (in *Input) DeepCopy() *Input {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InsertCDRomOptions) DeepCopyInto(out *InsertCDRomOptions) {
+	*out = *in
+	if in.VolumeSource != nil {
+		in, out := &in.VolumeSource, &out.VolumeSource
+		*out = new(HotplugVolumeSource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsertCDRomOptions.
+func (in *InsertCDRomOptions) DeepCopy() *InsertCDRomOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InsertCDRomOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeMatcher) DeepCopyInto(out *InstancetypeMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeMatcher.
+func (in *InstancetypeMatcher) DeepCopy() *InstancetypeMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
 	in.InterfaceBindingMethod.DeepCopyInto(&out.InterfaceBindingMethod)
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(PluginBinding)
+		**out = **in
+	}
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]Port, len(*in))
@@ -1446,6 +1785,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1459,6 +1803,32 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandwidthLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandwidthLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -1475,7 +1845,7 @@ func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	if in.Masquerade != nil {
 		in, out := &in.Masquerade, &out.Masquerade
 		*out = new(InterfaceMasquerade)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.SRIOV != nil {
 		in, out := &in.SRIOV, &out.SRIOV
@@ -1500,6 +1870,22 @@ func (in *InterfaceBindingMethod) DeepCopy() *InterfaceBindingMethod {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBindingPlugin) DeepCopyInto(out *InterfaceBindingPlugin) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBindingPlugin.
+func (in *InterfaceBindingPlugin) DeepCopy() *InterfaceBindingPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBindingPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBridge) DeepCopyInto(out *InterfaceBridge) {
 	*out = *in
@@ -1535,6 +1921,16 @@ func (in *InterfaceMacvtap) DeepCopy() *InterfaceMacvtap {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceMasquerade) DeepCopyInto(out *InterfaceMasquerade) {
 	*out = *in
+	if in.PortMappings != nil {
+		in, out := &in.PortMappings, &out.PortMappings
+		*out = make([]MasqueradePortMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.BlockedDestinations != nil {
+		in, out := &in.BlockedDestinations, &out.BlockedDestinations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1716,6 +2112,13 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(PermittedHostDevices)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ObsoleteCPUModels != nil {
+		in, out := &in.ObsoleteCPUModels, &out.ObsoleteCPUModels
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1937,6 +2340,22 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MasqueradePortMapping) DeepCopyInto(out *MasqueradePortMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasqueradePortMapping.
+func (in *MasqueradePortMapping) DeepCopy() *MasqueradePortMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(MasqueradePortMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedHostDevice) DeepCopyInto(out *MediatedHostDevice) {
 	*out = *in
@@ -2086,6 +2505,18 @@ func (in *NetworkConfiguration) DeepCopyInto(out *NetworkConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = make(map[string]InterfaceBindingPlugin, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecondaryNetworkDNS != nil {
+		in, out := &in.SecondaryNetworkDNS, &out.SecondaryNetworkDNS
+		*out = new(SecondaryNetworkDNS)
+		**out = **in
+	}
 	return
 }
 
@@ -2223,6 +2654,50 @@ func (in *PermittedHostDevices) DeepCopy() *PermittedHostDevices {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimInfo) DeepCopyInto(out *PersistentVolumeClaimInfo) {
+	*out = *in
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.VolumeMode != nil {
+		in, out := &in.VolumeMode, &out.VolumeMode
+		*out = new(corev1.PersistentVolumeMode)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimInfo.
+func (in *PersistentVolumeClaimInfo) DeepCopy() *PersistentVolumeClaimInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginBinding) DeepCopyInto(out *PluginBinding) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginBinding.
+func (in *PluginBinding) DeepCopy() *PluginBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodNetwork) DeepCopyInto(out *PodNetwork) {
 	*out = *in
@@ -2255,6 +2730,22 @@ func (in *Port) DeepCopy() *Port {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferenceMatcher) DeepCopyInto(out *PreferenceMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferenceMatcher.
+func (in *PreferenceMatcher) DeepCopy() *PreferenceMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferenceMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Probe) DeepCopyInto(out *Probe) {
 	*out = *in
@@ -2330,6 +2821,22 @@ func (in *RTCTimer) DeepCopy() *RTCTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoveInterfaceOptions) DeepCopyInto(out *RemoveInterfaceOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoveInterfaceOptions.
+func (in *RemoveInterfaceOptions) DeepCopy() *RemoveInterfaceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoveInterfaceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoveVolumeOptions) DeepCopyInto(out *RemoveVolumeOptions) {
 	*out = *in
@@ -2517,6 +3024,22 @@ func (in *SSHPublicKeyAccessCredentialSource) DeepCopy() *SSHPublicKeyAccessCred
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryNetworkDNS) DeepCopyInto(out *SecondaryNetworkDNS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryNetworkDNS.
+func (in *SecondaryNetworkDNS) DeepCopy() *SecondaryNetworkDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryNetworkDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretVolumeSource) DeepCopyInto(out *SecretVolumeSource) {
 	*out = *in
@@ -2554,6 +3077,23 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetBlockIOTuneOptions) DeepCopyInto(out *SetBlockIOTuneOptions) {
+	*out = *in
+	in.IOTune.DeepCopyInto(&out.IOTune)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetBlockIOTuneOptions.
+func (in *SetBlockIOTuneOptions) DeepCopy() *SetBlockIOTuneOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SetBlockIOTuneOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SysprepSource) DeepCopyInto(out *SysprepSource) {
 	*out = *in
@@ -2771,6 +3311,74 @@ func (in *VirtualMachineInstance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceAbortBackupOptions) DeepCopyInto(out *VirtualMachineInstanceAbortBackupOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceAbortBackupOptions.
+func (in *VirtualMachineInstanceAbortBackupOptions) DeepCopy() *VirtualMachineInstanceAbortBackupOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceAbortBackupOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceBackupOptions) DeepCopyInto(out *VirtualMachineInstanceBackupOptions) {
+	*out = *in
+	if in.Checkpoints != nil {
+		in, out := &in.Checkpoints, &out.Checkpoints
+		*out = make([]BackupCheckpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(BackupTarget)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceBackupOptions.
+func (in *VirtualMachineInstanceBackupOptions) DeepCopy() *VirtualMachineInstanceBackupOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceBackupOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceBackupState) DeepCopyInto(out *VirtualMachineInstanceBackupState) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTimestamp != nil {
+		in, out := &in.EndTimestamp, &out.EndTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceBackupState.
+func (in *VirtualMachineInstanceBackupState) DeepCopy() *VirtualMachineInstanceBackupState {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceBackupState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceCondition) DeepCopyInto(out *VirtualMachineInstanceCondition) {
 	*out = *in
@@ -2990,7 +3598,7 @@ func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInsta
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -3067,6 +3675,11 @@ func (in *VirtualMachineInstanceMigrationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
+	if in.VolumeMigrations != nil {
+		in, out := &in.VolumeMigrations, &out.VolumeMigrations
+		*out = make([]VolumeMigration, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3098,6 +3711,21 @@ func (in *VirtualMachineInstanceMigrationState) DeepCopyInto(out *VirtualMachine
 			(*out)[key] = val
 		}
 	}
+	if in.MigrationPolicyName != nil {
+		in, out := &in.MigrationPolicyName, &out.MigrationPolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MigrationConfiguration != nil {
+		in, out := &in.MigrationConfiguration, &out.MigrationConfiguration
+		*out = new(MigrationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeMigrations != nil {
+		in, out := &in.VolumeMigrations, &out.VolumeMigrations
+		*out = make([]VolumeMigration, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3142,6 +3770,18 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopyInto(out *VirtualMachi
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]VirtualMachineInstanceNetworkInterfaceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3155,6 +3795,24 @@ func (in *VirtualMachineInstanceNetworkInterface) DeepCopy() *VirtualMachineInst
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceNetworkInterfaceCondition) DeepCopyInto(out *VirtualMachineInstanceNetworkInterfaceCondition) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceNetworkInterfaceCondition.
+func (in *VirtualMachineInstanceNetworkInterfaceCondition) DeepCopy() *VirtualMachineInstanceNetworkInterfaceCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceNetworkInterfaceCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstancePreset) DeepCopyInto(out *VirtualMachineInstancePreset) {
 	*out = *in
@@ -3494,6 +4152,16 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HotplugInterfaces != nil {
+		in, out := &in.HotplugInterfaces, &out.HotplugInterfaces
+		*out = make([]HotplugInterfaceStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.BackupState != nil {
+		in, out := &in.BackupState, &out.BackupState
+		*out = new(VirtualMachineInstanceBackupState)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3525,6 +4193,32 @@ func (in *VirtualMachineInstanceTemplateSpec) DeepCopy() *VirtualMachineInstance
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInterfaceRequest) DeepCopyInto(out *VirtualMachineInterfaceRequest) {
+	*out = *in
+	if in.AddInterfaceOptions != nil {
+		in, out := &in.AddInterfaceOptions, &out.AddInterfaceOptions
+		*out = new(AddInterfaceOptions)
+		**out = **in
+	}
+	if in.RemoveInterfaceOptions != nil {
+		in, out := &in.RemoveInterfaceOptions, &out.RemoveInterfaceOptions
+		*out = new(RemoveInterfaceOptions)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInterfaceRequest.
+func (in *VirtualMachineInterfaceRequest) DeepCopy() *VirtualMachineInterfaceRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInterfaceRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineList) DeepCopyInto(out *VirtualMachineList) {
 	*out = *in
@@ -3571,6 +4265,16 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(VirtualMachineRunStrategy)
 		**out = **in
 	}
+	if in.Instancetype != nil {
+		in, out := &in.Instancetype, &out.Instancetype
+		*out = new(InstancetypeMatcher)
+		**out = **in
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(PreferenceMatcher)
+		**out = **in
+	}
 	if in.Template != nil {
 		in, out := &in.Template, &out.Template
 		*out = new(VirtualMachineInstanceTemplateSpec)
@@ -3653,6 +4357,13 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.InterfaceRequests != nil {
+		in, out := &in.InterfaceRequests, &out.InterfaceRequests
+		*out = make([]VirtualMachineInterfaceRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.VolumeSnapshotStatuses != nil {
 		in, out := &in.VolumeSnapshotStatuses, &out.VolumeSnapshotStatuses
 		*out = make([]VolumeSnapshotStatus, len(*in))
@@ -3684,6 +4395,16 @@ func (in *VirtualMachineVolumeRequest) DeepCopyInto(out *VirtualMachineVolumeReq
 		*out = new(RemoveVolumeOptions)
 		**out = **in
 	}
+	if in.EjectCDRomOptions != nil {
+		in, out := &in.EjectCDRomOptions, &out.EjectCDRomOptions
+		*out = new(EjectCDRomOptions)
+		**out = **in
+	}
+	if in.InsertCDRomOptions != nil {
+		in, out := &in.InsertCDRomOptions, &out.InsertCDRomOptions
+		*out = new(InsertCDRomOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3714,6 +4435,22 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMigration) DeepCopyInto(out *VolumeMigration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMigration.
+func (in *VolumeMigration) DeepCopy() *VolumeMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeSnapshotStatus) DeepCopyInto(out *VolumeSnapshotStatus) {
 	*out = *in
@@ -3819,6 +4556,16 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 		*out = new(HotplugVolumeStatus)
 		**out = **in
 	}
+	if in.PersistentVolumeClaimInfo != nil {
+		in, out := &in.PersistentVolumeClaimInfo, &out.PersistentVolumeClaimInfo
+		*out = new(PersistentVolumeClaimInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -374,6 +374,11 @@ type Devices struct {
 	UseVirtioTransitional *bool `json:"useVirtioTransitional,omitempty"`
 	// DisableHotplug disabled the ability to hotplug disks.
 	DisableHotplug bool `json:"disableHotplug,omitempty"`
+	// MaxHotpluggedDisks is the number of disks which can be hotplugged on the virtio bus.
+	// A PCIe root port is reserved for each of them when the VirtualMachineInstance starts.
+	// Defaults to 0, which only allows to hotplug disks on the scsi bus.
+	// +optional
+	MaxHotpluggedDisks uint32 `json:"maxHotpluggedDisks,omitempty"`
 	// Disks describes disks, cdroms, floppy and luns which are connected to the vmi.
 	Disks []Disk `json:"disks,omitempty"`
 	// Watchdog describes a watchdog device which can be added to the vmi.
@@ -489,11 +494,97 @@ type Disk struct {
 	// Supported values are: native, default, threads.
 	// +optional
 	IO DriverIO `json:"io,omitempty"`
+	// IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs
+	// through the setblockiotune subresource, the limits in effect are reported in the volume status.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+	// ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup,
+	// in a persistent dirty bitmap. Only disks with changed block tracking are backed up.
+	// Supported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks.
+	// Defaults to false.
+	// +optional
+	ChangedBlockTracking bool `json:"changedBlockTracking,omitempty"`
 	// If specified, disk address and its tag will be provided to the guest via config drive metadata
 	// +optional
 	Tag string `json:"tag,omitempty"`
 }
 
+// DiskIOTune limits the throughput and the I/O operations per second of a disk.
+// Unset limits are unlimited. A total limit can not be combined with the read
+// and write limits of the same kind.
+//
+// +k8s:openapi-gen=true
+type DiskIOTune struct {
+	// TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.
+	// +optional
+	TotalBytesSec *resource.Quantity `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec is the throughput limit of reads, in bytes per second.
+	// +optional
+	ReadBytesSec *resource.Quantity `json:"readBytesSec,omitempty"`
+	// WriteBytesSec is the throughput limit of writes, in bytes per second.
+	// +optional
+	WriteBytesSec *resource.Quantity `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec is the limit of read and write operations per second.
+	// +optional
+	TotalIOPSSec *int64 `json:"totalIOPSSec,omitempty"`
+	// ReadIOPSSec is the limit of read operations per second.
+	// +optional
+	ReadIOPSSec *int64 `json:"readIOPSSec,omitempty"`
+	// WriteIOPSSec is the limit of write operations per second.
+	// +optional
+	WriteIOPSSec *int64 `json:"writeIOPSSec,omitempty"`
+	// TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second.
+	// It requires TotalBytesSec and must not be lower.
+	// +optional
+	TotalBytesSecMax *resource.Quantity `json:"totalBytesSecMax,omitempty"`
+	// ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second.
+	// It requires ReadBytesSec and must not be lower.
+	// +optional
+	ReadBytesSecMax *resource.Quantity `json:"readBytesSecMax,omitempty"`
+	// WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second.
+	// It requires WriteBytesSec and must not be lower.
+	// +optional
+	WriteBytesSecMax *resource.Quantity `json:"writeBytesSecMax,omitempty"`
+	// TotalIOPSSecMax is the rate of read and write operations allowed in bursts.
+	// It requires TotalIOPSSec and must not be lower.
+	// +optional
+	TotalIOPSSecMax *int64 `json:"totalIOPSSecMax,omitempty"`
+	// ReadIOPSSecMax is the rate of read operations allowed in bursts.
+	// It requires ReadIOPSSec and must not be lower.
+	// +optional
+	ReadIOPSSecMax *int64 `json:"readIOPSSecMax,omitempty"`
+	// WriteIOPSSecMax is the rate of write operations allowed in bursts.
+	// It requires WriteIOPSSec and must not be lower.
+	// +optional
+	WriteIOPSSecMax *int64 `json:"writeIOPSSecMax,omitempty"`
+	// TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.
+	// +optional
+	TotalBytesSecMaxLength *int64 `json:"totalBytesSecMaxLength,omitempty"`
+	// ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.
+	// +optional
+	ReadBytesSecMaxLength *int64 `json:"readBytesSecMaxLength,omitempty"`
+	// WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.
+	// +optional
+	WriteBytesSecMaxLength *int64 `json:"writeBytesSecMaxLength,omitempty"`
+	// TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.
+	// +optional
+	TotalIOPSSecMaxLength *int64 `json:"totalIOPSSecMaxLength,omitempty"`
+	// ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.
+	// +optional
+	ReadIOPSSecMaxLength *int64 `json:"readIOPSSecMaxLength,omitempty"`
+	// WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.
+	// +optional
+	WriteIOPSSecMaxLength *int64 `json:"writeIOPSSecMaxLength,omitempty"`
+	// SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits.
+	// Bigger operations count as several ones. By default every operation counts as one.
+	// +optional
+	SizeIOPSSec *resource.Quantity `json:"sizeIOPSSec,omitempty"`
+	// GroupName shares the limits between all disks with the same group name.
+	// The disks of a group must have the same limits.
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
+}
+
 // Represents the target of a volume to mount.
 // Only one of its members may be specified.
 //
@@ -1078,6 +1169,11 @@ type Interface struct {
 	// BindingMethod specifies the method which will be used to connect the interface to the guest.
 	// Defaults to Bridge.
 	InterfaceBindingMethod `json:",inline"`
+	// Binding specifies the binding plugin which will be used to connect the interface to the guest.
+	// The plugin must be registered in the network configuration of KubeVirt.
+	// It is mutually exclusive with the BindingMethod.
+	// +optional
+	Binding *PluginBinding `json:"binding,omitempty"`
 	// List of ports to be forwarded to the virtual machine.
 	Ports []Port `json:"ports,omitempty"`
 	// Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.
@@ -1097,6 +1193,36 @@ type Interface struct {
 	// If specified, the virtual network interface address and its tag will be provided to the guest via config drive
 	// +optional
 	Tag string `json:"tag,omitempty"`
+	// If specified, the traffic of the interface is limited.
+	// Only interfaces backed by a tap device, bridge, masquerade and macvtap, can be limited.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+}
+
+// InterfaceBandwidth limits the traffic of an interface.
+//
+// +k8s:openapi-gen=true
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimits `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimits `json:"outbound,omitempty"`
+}
+
+// BandwidthLimits shape the traffic of one direction of an interface.
+//
+// +k8s:openapi-gen=true
+type BandwidthLimits struct {
+	// Average is the average rate the traffic is shaped to, in bits per second. For example 10M.
+	Average resource.Quantity `json:"average"`
+	// Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.
+	// +optional
+	Peak *resource.Quantity `json:"peak,omitempty"`
+	// Burst is the amount of bytes which can be sent at the peak rate.
+	// +optional
+	Burst *resource.Quantity `json:"burst,omitempty"`
 }
 
 // Extra DHCP options to use in the interface.
@@ -1115,6 +1241,51 @@ type DHCPOptions struct {
 	// If specified will pass extra DHCP options for private use, range: 224-254
 	// +optional
 	PrivateOptions []DHCPPrivateOptions `json:"privateOptions,omitempty"`
+	// If specified will pass the search domains to the VM instead of the search domains of the pod,
+	// via DHCP option 119, DHCPv6 option 24 and IPv6 router advertisements.
+	// +optional
+	SearchDomains []string `json:"searchDomains,omitempty"`
+	// If specified will pass the routes to the VM in addition to the routes of the pod network.
+	// IPv4 routes are passed via DHCP option 121, IPv6 routes via the route information of IPv6 router advertisements.
+	// +optional
+	Routes []DHCPRoute `json:"routes,omitempty"`
+	// If specified the address is leased to the VM instead of the address of the pod interface.
+	// Only supported on interfaces with the bridge binding.
+	// +optional
+	StaticLease *DHCPStaticLease `json:"staticLease,omitempty"`
+	// If set the IPv6 network of the interface is advertised for stateless address autoconfiguration (SLAAC),
+	// for guests which can not use DHCPv6.
+	// Only supported on interfaces with the masquerade binding whose vmIPv6NetworkCIDR has a prefix length of 64.
+	// +optional
+	SLAAC bool `json:"slaac,omitempty"`
+	// If set the IPv6 network of the interface is announced with router advertisements, so that the VM learns
+	// its default route and the prefix of the network. The advertisements need the NET_RAW capability, which
+	// is then granted to the virt-launcher pod. Implied by slaac.
+	// +optional
+	RouterAdvertisements bool `json:"routerAdvertisements,omitempty"`
+}
+
+// DHCPRoute is a route passed to the VM.
+//
+// +k8s:openapi-gen=true
+type DHCPRoute struct {
+	// Destination of the route in CIDR notation, e.g. 192.168.20.0/24.
+	Destination string `json:"destination"`
+	// Gateway of an IPv4 route, the destination is on link when not set.
+	// IPv6 routes always go through the router of the interface.
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// DHCPStaticLease is an IPv4 address leased to the VM.
+//
+// +k8s:openapi-gen=true
+type DHCPStaticLease struct {
+	// Address with its prefix length in CIDR notation, e.g. 192.168.10.5/24.
+	Address string `json:"address"`
+	// Gateway passed to the VM as its default route.
+	// +optional
+	Gateway string `json:"gateway,omitempty"`
 }
 
 // DHCPExtraOptions defines Extra DHCP options for a VM.
@@ -1151,16 +1322,64 @@ type InterfaceSlirp struct{}
 
 //
 // +k8s:openapi-gen=true
-type InterfaceMasquerade struct{}
+type InterfaceMasquerade struct {
+	// PortMappings forward ports, or ranges of ports, of the pod to the guest.
+	// Unless ports or port mappings are listed, all ports are forwarded.
+	// +optional
+	// +listType=atomic
+	PortMappings []MasqueradePortMapping `json:"portMappings,omitempty"`
+	// BlockedDestinations lists networks, in CIDR notation, which the guest must not reach through the masquerade.
+	// +optional
+	// +listType=atomic
+	BlockedDestinations []string `json:"blockedDestinations,omitempty"`
+}
 
+// MasqueradePortMapping forwards a port, or a range of ports, of the pod to the guest.
 //
 // +k8s:openapi-gen=true
-type InterfaceSRIOV struct{}
+type MasqueradePortMapping struct {
+	// If specified, this must be an IANA_SVC_NAME and unique within the pod.
+	// Only mappings of a single port are named.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Protocol of the mapping. Must be TCP, UDP or SCTP.
+	// Defaults to "TCP".
+	// +optional
+	Protocol string `json:"protocol,omitempty"`
+	// Port of the pod, the first port of the range when EndPort is set.
+	// This must be a valid port number, 0 < x < 65536.
+	Port int32 `json:"port"`
+	// EndPort is the last port of a range of ports, which are forwarded to the same ports of the guest.
+	// +optional
+	EndPort int32 `json:"endPort,omitempty"`
+	// GuestPort is the port of the guest the pod port is forwarded to.
+	// Defaults to Port. Can not be combined with EndPort.
+	// +optional
+	GuestPort int32 `json:"guestPort,omitempty"`
+}
+
+//
+// +k8s:openapi-gen=true
+type InterfaceSRIOV struct {
+	// StandbyInterface is the name of a virtio interface with the bridge binding, connected to the same
+	// network, which takes over the traffic of the guest while the VF is unplugged during a live migration.
+	// Both interfaces must have the same MAC address.
+	// +optional
+	StandbyInterface string `json:"standbyInterface,omitempty"`
+}
 
 //
 // +k8s:openapi-gen=true
 type InterfaceMacvtap struct{}
 
+// PluginBinding represents a binding implemented by a plugin.
+//
+// +k8s:openapi-gen=true
+type PluginBinding struct {
+	// Name references a binding plugin registered in the network configuration of KubeVirt.
+	Name string `json:"name"`
+}
+
 // Port repesents a port to expose from the virtual machine.
 // Default protocol TCP.
 // The port field is mandatory
@@ -1339,6 +1558,10 @@ type PodNetwork struct {
 	// CIDR for vm network.
 	// Default 10.0.2.0/24 if not specified.
 	VMNetworkCIDR string `json:"vmNetworkCIDR,omitempty"`
+	// IPv6 CIDR for the vm network.
+	// Default fd10:0:2::/120 if not specified.
+	// +optional
+	VMIPv6NetworkCIDR string `json:"vmIPv6NetworkCIDR,omitempty"`
 }
 
 // Rng represents the random device passed from host
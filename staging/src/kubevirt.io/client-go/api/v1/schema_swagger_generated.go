@@ -186,6 +186,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"":                           "+k8s:openapi-gen=true",
 		"useVirtioTransitional":      "Fall back to legacy virtio 0.9 support if virtio bus is selected on devices.\nThis is helpful for old machines like CentOS6 or RHEL6 which\ndo not understand virtio_non_transitional (virtio 1.0).",
 		"disableHotplug":             "DisableHotplug disabled the ability to hotplug disks.",
+		"maxHotpluggedDisks":         "MaxHotpluggedDisks is the number of disks which can be hotplugged on the virtio bus.\nA PCIe root port is reserved for each of them when the VirtualMachineInstance starts.\nDefaults to 0, which only allows to hotplug disks on the scsi bus.\n+optional",
 		"disks":                      "Disks describes disks, cdroms, floppy and luns which are connected to the vmi.",
 		"watchdog":                   "Watchdog describes a watchdog device which can be added to the vmi.",
 		"interfaces":                 "Interfaces describe network interfaces which are added to the vmi.",
@@ -242,14 +243,42 @@ func (HostDevice) SwaggerDoc() map[string]string {
 
 func (Disk) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                  "+k8s:openapi-gen=true",
-		"name":              "Name is the device name",
-		"bootOrder":         "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach disk or interface that has a boot order must have a unique value.\nDisks without a boot order are not tried if a disk with a boot order exists.\n+optional",
-		"serial":            "Serial provides the ability to specify a serial number for the disk device.\n+optional",
-		"dedicatedIOThread": "dedicatedIOThread indicates this disk should have an exclusive IO Thread.\nEnabling this implies useIOThreads = true.\nDefaults to false.\n+optional",
-		"cache":             "Cache specifies which kvm disk cache mode should be used.\n+optional",
-		"io":                "IO specifies which QEMU disk IO mode should be used.\nSupported values are: native, default, threads.\n+optional",
-		"tag":               "If specified, disk address and its tag will be provided to the guest via config drive metadata\n+optional",
+		"":                     "+k8s:openapi-gen=true",
+		"name":                 "Name is the device name",
+		"bootOrder":            "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach disk or interface that has a boot order must have a unique value.\nDisks without a boot order are not tried if a disk with a boot order exists.\n+optional",
+		"serial":               "Serial provides the ability to specify a serial number for the disk device.\n+optional",
+		"dedicatedIOThread":    "dedicatedIOThread indicates this disk should have an exclusive IO Thread.\nEnabling this implies useIOThreads = true.\nDefaults to false.\n+optional",
+		"cache":                "Cache specifies which kvm disk cache mode should be used.\n+optional",
+		"io":                   "IO specifies which QEMU disk IO mode should be used.\nSupported values are: native, default, threads.\n+optional",
+		"ioTune":               "IOTune throttles the I/O of the disk. The limits can be changed while the VMI runs\nthrough the setblockiotune subresource, the limits in effect are reported in the volume status.\n+optional",
+		"changedBlockTracking": "ChangedBlockTracking keeps track of the blocks of the disk which changed since the last backup,\nin a persistent dirty bitmap. Only disks with changed block tracking are backed up.\nSupported on disks of filesystem PersistentVolumeClaims, DataVolumes and HostDisks.\nDefaults to false.\n+optional",
+		"tag":                  "If specified, disk address and its tag will be provided to the guest via config drive metadata\n+optional",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "DiskIOTune limits the throughput and the I/O operations per second of a disk.\nUnset limits are unlimited. A total limit can not be combined with the read\nand write limits of the same kind.\n\n+k8s:openapi-gen=true",
+		"totalBytesSec":          "TotalBytesSec is the throughput limit of reads and writes, in bytes per second. For example 100Mi.\n+optional",
+		"readBytesSec":           "ReadBytesSec is the throughput limit of reads, in bytes per second.\n+optional",
+		"writeBytesSec":          "WriteBytesSec is the throughput limit of writes, in bytes per second.\n+optional",
+		"totalIOPSSec":           "TotalIOPSSec is the limit of read and write operations per second.\n+optional",
+		"readIOPSSec":            "ReadIOPSSec is the limit of read operations per second.\n+optional",
+		"writeIOPSSec":           "WriteIOPSSec is the limit of write operations per second.\n+optional",
+		"totalBytesSecMax":       "TotalBytesSecMax is the throughput of reads and writes allowed in bursts, in bytes per second.\nIt requires TotalBytesSec and must not be lower.\n+optional",
+		"readBytesSecMax":        "ReadBytesSecMax is the throughput of reads allowed in bursts, in bytes per second.\nIt requires ReadBytesSec and must not be lower.\n+optional",
+		"writeBytesSecMax":       "WriteBytesSecMax is the throughput of writes allowed in bursts, in bytes per second.\nIt requires WriteBytesSec and must not be lower.\n+optional",
+		"totalIOPSSecMax":        "TotalIOPSSecMax is the rate of read and write operations allowed in bursts.\nIt requires TotalIOPSSec and must not be lower.\n+optional",
+		"readIOPSSecMax":         "ReadIOPSSecMax is the rate of read operations allowed in bursts.\nIt requires ReadIOPSSec and must not be lower.\n+optional",
+		"writeIOPSSecMax":        "WriteIOPSSecMax is the rate of write operations allowed in bursts.\nIt requires WriteIOPSSec and must not be lower.\n+optional",
+		"totalBytesSecMaxLength": "TotalBytesSecMaxLength is how many seconds a burst of TotalBytesSecMax may last. Defaults to 1.\n+optional",
+		"readBytesSecMaxLength":  "ReadBytesSecMaxLength is how many seconds a burst of ReadBytesSecMax may last. Defaults to 1.\n+optional",
+		"writeBytesSecMaxLength": "WriteBytesSecMaxLength is how many seconds a burst of WriteBytesSecMax may last. Defaults to 1.\n+optional",
+		"totalIOPSSecMaxLength":  "TotalIOPSSecMaxLength is how many seconds a burst of TotalIOPSSecMax may last. Defaults to 1.\n+optional",
+		"readIOPSSecMaxLength":   "ReadIOPSSecMaxLength is how many seconds a burst of ReadIOPSSecMax may last. Defaults to 1.\n+optional",
+		"writeIOPSSecMaxLength":  "WriteIOPSSecMaxLength is how many seconds a burst of WriteIOPSSecMax may last. Defaults to 1.\n+optional",
+		"sizeIOPSSec":            "SizeIOPSSec is the size in bytes an operation counts as towards the IOPS limits.\nBigger operations count as several ones. By default every operation counts as one.\n+optional",
+		"groupName":              "GroupName shares the limits between all disks with the same group name.\nThe disks of a group must have the same limits.\n+optional",
 	}
 }
 
@@ -530,22 +559,62 @@ func (Interface) SwaggerDoc() map[string]string {
 		"":            "+k8s:openapi-gen=true",
 		"name":        "Logical name of the interface as well as a reference to the associated networks.\nMust match the Name of a Network.",
 		"model":       "Interface model.\nOne of: e1000, e1000e, ne2k_pci, pcnet, rtl8139, virtio.\nDefaults to virtio.",
+		"binding":     "Binding specifies the binding plugin which will be used to connect the interface to the guest.\nThe plugin must be registered in the network configuration of KubeVirt.\nIt is mutually exclusive with the BindingMethod.\n+optional",
 		"ports":       "List of ports to be forwarded to the virtual machine.",
 		"macAddress":  "Interface MAC address. For example: de:ad:00:00:be:af or DE-AD-00-00-BE-AF.",
 		"bootOrder":   "BootOrder is an integer value > 0, used to determine ordering of boot devices.\nLower values take precedence.\nEach interface or disk that has a boot order must have a unique value.\nInterfaces without a boot order are not tried.\n+optional",
 		"pciAddress":  "If specified, the virtual network interface will be placed on the guests pci address with the specified PCI address. For example: 0000:81:01.10\n+optional",
 		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"bandwidth":   "If specified, the traffic of the interface is limited.\nOnly interfaces backed by a tap device, bridge, masquerade and macvtap, can be limited.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth limits the traffic of an interface.\n\n+k8s:openapi-gen=true",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimits) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimits shape the traffic of one direction of an interface.\n\n+k8s:openapi-gen=true",
+		"average": "Average is the average rate the traffic is shaped to, in bits per second. For example 10M.",
+		"peak":    "Peak is the rate bursts are sent at, in bits per second. It must not be lower than the average.\n+optional",
+		"burst":   "Burst is the amount of bytes which can be sent at the peak rate.\n+optional",
 	}
 }
 
 func (DHCPOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":               "Extra DHCP options to use in the interface.\n\n+k8s:openapi-gen=true",
-		"bootFileName":   "If specified will pass option 67 to interface's DHCP server\n+optional",
-		"tftpServerName": "If specified will pass option 66 to interface's DHCP server\n+optional",
-		"ntpServers":     "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
-		"privateOptions": "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"":                     "Extra DHCP options to use in the interface.\n\n+k8s:openapi-gen=true",
+		"bootFileName":         "If specified will pass option 67 to interface's DHCP server\n+optional",
+		"tftpServerName":       "If specified will pass option 66 to interface's DHCP server\n+optional",
+		"ntpServers":           "If specified will pass the configured NTP server to the VM via DHCP option 042.\n+optional",
+		"privateOptions":       "If specified will pass extra DHCP options for private use, range: 224-254\n+optional",
+		"searchDomains":        "If specified will pass the search domains to the VM instead of the search domains of the pod,\nvia DHCP option 119, DHCPv6 option 24 and IPv6 router advertisements.\n+optional",
+		"routes":               "If specified will pass the routes to the VM in addition to the routes of the pod network.\nIPv4 routes are passed via DHCP option 121, IPv6 routes via the route information of IPv6 router advertisements.\n+optional",
+		"staticLease":          "If specified the address is leased to the VM instead of the address of the pod interface.\nOnly supported on interfaces with the bridge binding.\n+optional",
+		"slaac":                "If set the IPv6 network of the interface is advertised for stateless address autoconfiguration (SLAAC),\nfor guests which can not use DHCPv6.\nOnly supported on interfaces with the masquerade binding whose vmIPv6NetworkCIDR has a prefix length of 64.\n+optional",
+		"routerAdvertisements": "If set the IPv6 network of the interface is announced with router advertisements, so that the VM learns\nits default route and the prefix of the network. The advertisements need the NET_RAW capability, which\nis then granted to the virt-launcher pod. Implied by slaac.\n+optional",
+	}
+}
+
+func (DHCPRoute) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "DHCPRoute is a route passed to the VM.\n\n+k8s:openapi-gen=true",
+		"destination": "Destination of the route in CIDR notation, e.g. 192.168.20.0/24.",
+		"gateway":     "Gateway of an IPv4 route, the destination is on link when not set.\nIPv6 routes always go through the router of the interface.\n+optional",
+	}
+}
+
+func (DHCPStaticLease) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "DHCPStaticLease is an IPv4 address leased to the VM.\n\n+k8s:openapi-gen=true",
+		"address": "Address with its prefix length in CIDR notation, e.g. 192.168.10.5/24.",
+		"gateway": "Gateway passed to the VM as its default route.\n+optional",
 	}
 }
 
@@ -577,13 +646,27 @@ func (InterfaceSlirp) SwaggerDoc() map[string]string {
 
 func (InterfaceMasquerade) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "+k8s:openapi-gen=true",
+		"":                    "+k8s:openapi-gen=true",
+		"portMappings":        "PortMappings forward ports, or ranges of ports, of the pod to the guest.\nUnless ports or port mappings are listed, all ports are forwarded.\n+optional\n+listType=atomic",
+		"blockedDestinations": "BlockedDestinations lists networks, in CIDR notation, which the guest must not reach through the masquerade.\n+optional\n+listType=atomic",
+	}
+}
+
+func (MasqueradePortMapping) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "MasqueradePortMapping forwards a port, or a range of ports, of the pod to the guest.\n\n+k8s:openapi-gen=true",
+		"name":      "If specified, this must be an IANA_SVC_NAME and unique within the pod.\nOnly mappings of a single port are named.\n+optional",
+		"protocol":  "Protocol of the mapping. Must be TCP, UDP or SCTP.\nDefaults to \"TCP\".\n+optional",
+		"port":      "Port of the pod, the first port of the range when EndPort is set.\nThis must be a valid port number, 0 < x < 65536.",
+		"endPort":   "EndPort is the last port of a range of ports, which are forwarded to the same ports of the guest.\n+optional",
+		"guestPort": "GuestPort is the port of the guest the pod port is forwarded to.\nDefaults to Port. Can not be combined with EndPort.\n+optional",
 	}
 }
 
 func (InterfaceSRIOV) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "+k8s:openapi-gen=true",
+		"":                 "+k8s:openapi-gen=true",
+		"standbyInterface": "StandbyInterface is the name of a virtio interface with the bridge binding, connected to the same\nnetwork, which takes over the traffic of the guest while the VF is unplugged during a live migration.\nBoth interfaces must have the same MAC address.\n+optional",
 	}
 }
 
@@ -593,6 +676,13 @@ func (InterfaceMacvtap) SwaggerDoc() map[string]string {
 	}
 }
 
+func (PluginBinding) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "PluginBinding represents a binding implemented by a plugin.\n\n+k8s:openapi-gen=true",
+		"name": "Name references a binding plugin registered in the network configuration of KubeVirt.",
+	}
+}
+
 func (Port) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":         "Port repesents a port to expose from the virtual machine.\nDefault protocol TCP.\nThe port field is mandatory\n\n+k8s:openapi-gen=true",
@@ -703,8 +793,9 @@ func (NetworkSource) SwaggerDoc() map[string]string {
 
 func (PodNetwork) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "Represents the stock pod network interface.\n\n+k8s:openapi-gen=true",
-		"vmNetworkCIDR": "CIDR for vm network.\nDefault 10.0.2.0/24 if not specified.",
+		"":                  "Represents the stock pod network interface.\n\n+k8s:openapi-gen=true",
+		"vmNetworkCIDR":     "CIDR for vm network.\nDefault 10.0.2.0/24 if not specified.",
+		"vmIPv6NetworkCIDR": "IPv6 CIDR for the vm network.\nDefault fd10:0:2::/120 if not specified.\n+optional",
 	}
 }
 
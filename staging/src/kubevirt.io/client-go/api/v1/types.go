@@ -200,6 +200,16 @@ type VirtualMachineInstanceStatus struct {
 	// +optional
 	// +listType=atomic
 	VolumeStatus []VolumeStatus `json:"volumeStatus,omitempty"`
+
+	// HotplugInterfaces contains the statuses of the network interfaces which are
+	// being hotplugged into or unplugged from the running VirtualMachineInstance
+	// +optional
+	// +listType=atomic
+	HotplugInterfaces []HotplugInterfaceStatus `json:"hotplugInterfaces,omitempty"`
+
+	// BackupState represents the state of the latest backup of the disks
+	// +optional
+	BackupState *VirtualMachineInstanceBackupState `json:"backupState,omitempty"`
 }
 
 // VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.
@@ -217,6 +227,19 @@ type VolumeStatus struct {
 	Message string `json:"message,omitempty"`
 	// If the volume is hotplug, this will contain the hotplug status.
 	HotplugVolume *HotplugVolumeStatus `json:"hotplugVolume,omitempty"`
+	// If the volume is backed by a PersistentVolumeClaim, this will contain the information of the claim.
+	PersistentVolumeClaimInfo *PersistentVolumeClaimInfo `json:"persistentVolumeClaimInfo,omitempty"`
+	// IOTune are the I/O limits in effect for the disk of the volume
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+}
+
+// PersistentVolumeClaimInfo contains the information of the claim of a volume
+// +k8s:openapi-gen=true
+type PersistentVolumeClaimInfo struct {
+	// Capacity is the capacity reported in the status of the claim
+	Capacity k8sv1.ResourceList `json:"capacity,omitempty"`
+	// VolumeMode is the volume mode of the claim
+	VolumeMode *k8sv1.PersistentVolumeMode `json:"volumeMode,omitempty"`
 }
 
 // HotplugVolumeStatus represents the hotplug status of the volume
@@ -249,6 +272,36 @@ const (
 	HotplugVolumeUnMounted VolumePhase = "UnMountedFromPod"
 )
 
+// HotplugInterfaceStatus represents the hotplug status of a network interface
+// +k8s:openapi-gen=true
+type HotplugInterfaceStatus struct {
+	// Name is the name of the interface and of the network it is connected to
+	Name string `json:"name"`
+	// PodInterfaceName is the name of the virt-launcher pod interface backing the interface, eg: net2
+	PodInterfaceName string `json:"podInterfaceName,omitempty"`
+	// Phase is the phase
+	Phase InterfaceHotplugPhase `json:"phase,omitempty"`
+	// Reason is a brief description of why we are in the current hotplug interface phase
+	Reason string `json:"reason,omitempty"`
+	// Message is a detailed message about the current hotplug interface phase
+	Message string `json:"message,omitempty"`
+}
+
+// InterfaceHotplugPhase indicates the current phase of the interface hotplug process.
+// +k8s:openapi-gen=true
+type InterfaceHotplugPhase string
+
+const (
+	// InterfaceHotplugPending means the interface was requested and the pod network is not attached yet.
+	InterfaceHotplugPending InterfaceHotplugPhase = "Pending"
+	// InterfaceHotplugAttachedToPod means the network has been attached to the virt-launcher pod.
+	InterfaceHotplugAttachedToPod InterfaceHotplugPhase = "AttachedToPod"
+	// InterfaceHotplugReady means the interface is plugged into the domain and ready to be used by the VirtualMachineInstance.
+	InterfaceHotplugReady InterfaceHotplugPhase = "Ready"
+	// InterfaceHotplugDetachedFromDomain means the interface has been unplugged from the domain, and the pod network is being removed.
+	InterfaceHotplugDetachedFromDomain InterfaceHotplugPhase = "DetachedFromDomain"
+)
+
 func (v *VirtualMachineInstance) IsScheduling() bool {
 	return v.Status.Phase == Scheduling
 }
@@ -341,6 +394,11 @@ const (
 	VirtualMachineInstanceReasonInterfaceNotMigratable = "InterfaceNotLiveMigratable"
 	// Reason means that VMI is not live migratioable because of it's network interfaces collection
 	VirtualMachineInstanceReasonHotplugNotMigratable = "HotplugNotLiveMigratable"
+
+	// Reflects whether the guest kept the addresses of its bridge bound interfaces after it was migrated
+	VirtualMachineInstancePodNetworkAddressPreserved VirtualMachineInstanceConditionType = "PodNetworkAddressPreserved"
+	// Reason means that the pod on the migration target received different addresses than the source pod
+	VirtualMachineInstanceReasonPodAddressChanged = "PodAddressChanged"
 )
 
 const (
@@ -425,6 +483,33 @@ type VirtualMachineInstanceNetworkInterface struct {
 	IPs []string `json:"ipAddresses,omitempty"`
 	// The interface name inside the Virtual Machine
 	InterfaceName string `json:"interfaceName,omitempty"`
+	// The bandwidth limits applied to the interface
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+	// Conditions of the interface
+	// +optional
+	Conditions []VirtualMachineInstanceNetworkInterfaceCondition `json:"conditions,omitempty"`
+}
+
+type VirtualMachineInstanceNetworkInterfaceConditionType string
+
+// These are valid conditions of network interfaces.
+const (
+	// InterfaceDHCPLeased is true when the VM obtained addresses from the DHCP servers of the interface.
+	// The leased addresses are listed in the message of the condition.
+	InterfaceDHCPLeased VirtualMachineInstanceNetworkInterfaceConditionType = "DHCPLeased"
+)
+
+// +k8s:openapi-gen=true
+type VirtualMachineInstanceNetworkInterfaceCondition struct {
+	Type   VirtualMachineInstanceNetworkInterfaceConditionType `json:"type"`
+	Status k8sv1.ConditionStatus                               `json:"status"`
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
 }
 
 // +k8s:openapi-gen=true
@@ -479,8 +564,36 @@ type VirtualMachineInstanceMigrationState struct {
 	MigrationUID types.UID `json:"migrationUid,omitempty"`
 	// Lets us know if the vmi is currently running pre or post copy migration
 	Mode MigrationMode `json:"mode,omitempty"`
+	// Name of the MigrationPolicy applied to the migration, if any
+	// +optional
+	MigrationPolicyName *string `json:"migrationPolicyName,omitempty"`
+	// The migration configuration applied to the migration, the cluster wide
+	// configuration overridden by the MigrationPolicy
+	// +optional
+	MigrationConfiguration *MigrationConfiguration `json:"migrationConfiguration,omitempty"`
+	// The step of the unplug and replug of the SR-IOV interfaces, if any
+	// +optional
+	SRIOVPhase MigrationSRIOVPhase `json:"sriovPhase,omitempty"`
+	// The volumes moved to new claims by the migration
+	// +optional
+	// +listType=atomic
+	VolumeMigrations []VolumeMigration `json:"volumeMigrations,omitempty"`
 }
 
+//
+// +k8s:openapi-gen=true
+type MigrationSRIOVPhase string
+
+const (
+	// MigrationSRIOVUnplugging means the VFs are being unplugged from the guest on the source node
+	MigrationSRIOVUnplugging MigrationSRIOVPhase = "Unplugging"
+	// MigrationSRIOVUnplugged means the guest released the VFs and the migration can proceed
+	MigrationSRIOVUnplugged MigrationSRIOVPhase = "Unplugged"
+	// MigrationSRIOVReplugged means VFs were plugged back into the guest, on the target node
+	// when the migration succeeded and on the source node when it failed
+	MigrationSRIOVReplugged MigrationSRIOVPhase = "Replugged"
+)
+
 //
 // +k8s:openapi-gen=true
 type MigrationAbortStatus string
@@ -579,6 +692,32 @@ const (
 	// This label declares whether a particular node is available for
 	// scheduling virtual machine instances on it. Used on Node.
 	NodeSchedulable string = "kubevirt.io/schedulable"
+	// This label is set by virt-handler for every usable, non-obsolete CPU
+	// model of the node. Used on Node.
+	CPUModelLabel string = "cpu-model.node.kubevirt.io/"
+	// This label is set by virt-handler for every CPU feature supported by
+	// the host-model CPU of the node. Used on Node.
+	CPUFeatureLabel string = "cpu-feature.node.kubevirt.io/"
+	// This label declares the CPU model libvirt uses for host-model on this
+	// node. Used on Node.
+	HostModelCPULabel string = "host-model-cpu.node.kubevirt.io/"
+	// This label is set for every feature libvirt requires on top of the
+	// host-model CPU of the node. Used on Node.
+	HostModelRequiredFeaturesLabel string = "host-model-required-features.node.kubevirt.io/"
+	// This label declares the host-model CPUs of other nodes from which
+	// virtual machine instances can be migrated to this node. Used on Node.
+	SupportedHostModelMigrationCPU string = "cpu-model-migration.node.kubevirt.io/"
+	// This label is set for every Hyper-V enlightenment supported by the node.
+	// Used on Node.
+	HypervLabel string = "hyperv.node.kubevirt.io/"
+	// This label is set for every machine type supported by the node. Used on Node.
+	SupportedMachineTypeLabel string = "machine-type.node.kubevirt.io/"
+	// This label indicates that the host-model CPU of the node is one of the
+	// obsolete CPU models. Used on Node.
+	NodeHostModelIsObsoleteLabel string = "node-labeller.kubevirt.io/obsolete-host-model"
+	// This annotation makes virt-handler leave the CPU labels of a node alone.
+	// Used on Node.
+	LabellerSkipNodeAnnotation string = "node-labeller.kubevirt.io/skip-node"
 	// This annotation is regularly updated by virt-handler to help determine
 	// if a particular node is alive and hence should be available for new
 	// virtual machine instance scheduling. Used on Node.
@@ -669,6 +808,9 @@ const (
 	Resumed                      SyncEvent = "Resumed"
 	AccessCredentialsSyncFailed  SyncEvent = "AccessCredentialsSyncFailed"
 	AccessCredentialsSyncSuccess SyncEvent = "AccessCredentialsSyncSuccess"
+	VolumeResized                SyncEvent = "VolumeResized"
+	BackupSucceeded              SyncEvent = "BackupSucceeded"
+	BackupFailed                 SyncEvent = "BackupFailed"
 )
 
 func (s SyncEvent) String() string {
@@ -899,6 +1041,26 @@ type VirtualMachineInstanceMigrationList struct {
 type VirtualMachineInstanceMigrationSpec struct {
 	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
 	VMIName string `json:"vmiName,omitempty" valid:"required"`
+	// VolumeMigrations moves volumes of the VMI to new claims while it is migrated.
+	// Once the migration succeeded, the VMI and its VirtualMachine use the new claims.
+	// +optional
+	// +listType=atomic
+	VolumeMigrations []VolumeMigration `json:"volumeMigrations,omitempty"`
+}
+
+// VolumeMigration maps a volume of the VMI to the claim its data is copied to.
+// Exactly one destination must be set.
+//
+// +k8s:openapi-gen=true
+type VolumeMigration struct {
+	// SourceVolume is the name of a PersistentVolumeClaim or DataVolume volume of the VMI
+	SourceVolume string `json:"sourceVolume"`
+	// DestinationPVC is the name of the PersistentVolumeClaim the volume is moved to
+	// +optional
+	DestinationPVC string `json:"destinationPVC,omitempty"`
+	// DestinationDataVolume is the name of the DataVolume the volume is moved to
+	// +optional
+	DestinationDataVolume string `json:"destinationDataVolume,omitempty"`
 }
 
 // VirtualMachineInstanceMigration reprents information pertaining to a VMI's migration.
@@ -1062,6 +1224,14 @@ type VirtualMachineSpec struct {
 	// mutually exclusive with Running
 	RunStrategy *VirtualMachineRunStrategy `json:"runStrategy,omitempty" optional:"true"`
 
+	// InstancetypeMatcher references a instancetype that is used to fill fields in Template
+	// +optional
+	Instancetype *InstancetypeMatcher `json:"instancetype,omitempty" optional:"true"`
+
+	// PreferenceMatcher references a set of preference that is used to fill fields in Template
+	// +optional
+	Preference *PreferenceMatcher `json:"preference,omitempty" optional:"true"`
+
 	// Template is the direct specification of VirtualMachineInstance
 	Template *VirtualMachineInstanceTemplateSpec `json:"template"`
 
@@ -1070,6 +1240,50 @@ type VirtualMachineSpec struct {
 	DataVolumeTemplates []DataVolumeTemplateSpec `json:"dataVolumeTemplates,omitempty"`
 }
 
+// InstancetypeMatcher references a instancetype that is used to fill fields in the VMI template.
+//
+// +k8s:openapi-gen=true
+type InstancetypeMatcher struct {
+	// Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype
+	Name string `json:"name"`
+
+	// Kind specifies which instancetype resource is referenced.
+	// Allowed values are: "VirtualMachineInstancetype" and "VirtualMachineClusterInstancetype".
+	// If not specified, "VirtualMachineClusterInstancetype" is used by default.
+	//
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// RevisionName specifies a ControllerRevision containing a specific copy of the
+	// VirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially
+	// captured the first time the instancetype is applied to the VirtualMachineInstance.
+	//
+	// +optional
+	RevisionName string `json:"revisionName,omitempty"`
+}
+
+// PreferenceMatcher references a set of preference that is used to fill fields in the VMI template.
+//
+// +k8s:openapi-gen=true
+type PreferenceMatcher struct {
+	// Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference
+	Name string `json:"name"`
+
+	// Kind specifies which preference resource is referenced.
+	// Allowed values are: "VirtualMachinePreference" and "VirtualMachineClusterPreference".
+	// If not specified, "VirtualMachineClusterPreference" is used by default.
+	//
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// RevisionName specifies a ControllerRevision containing a specific copy of the
+	// VirtualMachinePreference or VirtualMachineClusterPreference to be used. This is
+	// initially captured the first time the instancetype is applied to the VirtualMachineInstance.
+	//
+	// +optional
+	RevisionName string `json:"revisionName,omitempty"`
+}
+
 // StateChangeRequestType represents the existing state change requests that are possible
 //
 // +k8s:openapi-gen=true
@@ -1103,6 +1317,11 @@ type VirtualMachineStatus struct {
 	// +listType=atomic
 	VolumeRequests []VirtualMachineVolumeRequest `json:"volumeRequests,omitempty" optional:"true"`
 
+	// InterfaceRequests indicates a list of network interfaces to add or remove from the
+	// VMI template and hotplug on an active running VMI.
+	// +listType=atomic
+	InterfaceRequests []VirtualMachineInterfaceRequest `json:"interfaceRequests,omitempty" optional:"true"`
+
 	// VolumeSnapshotStatuses indicates a list of statuses whether snapshotting is
 	// supported by each volume.
 	VolumeSnapshotStatuses []VolumeSnapshotStatus `json:"volumeSnapshotStatuses,omitempty" optional:"true"`
@@ -1126,6 +1345,22 @@ type VirtualMachineVolumeRequest struct {
 	// RemoveVolumeOptions when set indicates a volume should be removed. The details
 	// within this field specify how to add the volume
 	RemoveVolumeOptions *RemoveVolumeOptions `json:"removeVolumeOptions,omitempty" optional:"true"`
+	// EjectCDRomOptions when set indicates the media of a CD-ROM should be ejected.
+	// The details within this field specify the CD-ROM
+	EjectCDRomOptions *EjectCDRomOptions `json:"ejectCDRomOptions,omitempty" optional:"true"`
+	// InsertCDRomOptions when set indicates a media should be inserted into a CD-ROM.
+	// The details within this field specify the CD-ROM and the media
+	InsertCDRomOptions *InsertCDRomOptions `json:"insertCDRomOptions,omitempty" optional:"true"`
+}
+
+// +k8s:openapi-gen=true
+type VirtualMachineInterfaceRequest struct {
+	// AddInterfaceOptions when set indicates a network interface should be added.
+	// The details within this field specify how to add the interface
+	AddInterfaceOptions *AddInterfaceOptions `json:"addInterfaceOptions,omitempty" optional:"true"`
+	// RemoveInterfaceOptions when set indicates a network interface should be removed.
+	// The details within this field specify how to remove the interface
+	RemoveInterfaceOptions *RemoveInterfaceOptions `json:"removeInterfaceOptions,omitempty" optional:"true"`
 }
 
 // +k8s:openapi-gen=true
@@ -1618,6 +1853,144 @@ type RemoveVolumeOptions struct {
 	Name string `json:"name"`
 }
 
+// EjectCDRomOptions is provided when ejecting the media of a CD-ROM
+// +k8s:openapi-gen=true
+type EjectCDRomOptions struct {
+	// Name represents the name of the CD-ROM disk, and of the volume of its media
+	Name string `json:"name"`
+}
+
+// InsertCDRomOptions is provided when inserting a media into an empty CD-ROM
+// +k8s:openapi-gen=true
+type InsertCDRomOptions struct {
+	// Name represents the name of the CD-ROM disk. It is also the name of the
+	// volume of the media
+	Name string `json:"name"`
+	// VolumeSource represents the source of the media
+	VolumeSource *HotplugVolumeSource `json:"volumeSource"`
+}
+
+// SetBlockIOTuneOptions is provided when changing the I/O limits of a disk of a running VMI
+// +k8s:openapi-gen=true
+type SetBlockIOTuneOptions struct {
+	// Name is the name of the disk
+	Name string `json:"name"`
+	// IOTune are the new I/O limits of the disk. They replace all the current limits,
+	// unset limits are removed.
+	IOTune DiskIOTune `json:"ioTune"`
+}
+
+// AddInterfaceOptions is provided when dynamically hot plugging a network interface
+// +k8s:openapi-gen=true
+type AddInterfaceOptions struct {
+	// NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition CRD object. Format:
+	// <networkAttachmentDefinitionName>, <namespace>/<networkAttachmentDefinitionName>. If namespace is not
+	// specified, VMI namespace is assumed.
+	NetworkAttachmentDefinitionName string `json:"networkAttachmentDefinitionName"`
+	// Name indicates the logical name of the interface, which is also used as the name
+	// of the network it is connected to.
+	Name string `json:"name"`
+}
+
+// RemoveInterfaceOptions is provided when dynamically hot unplugging a network interface
+// +k8s:openapi-gen=true
+type RemoveInterfaceOptions struct {
+	// Name indicates the logical name of the interface, which maps to both the
+	// interface and the network that should be removed
+	Name string `json:"name"`
+}
+
+// BackupMode is the way the data of a backup is transferred
+// +k8s:openapi-gen=true
+type BackupMode string
+
+const (
+	// BackupModePush writes the backup to a PersistentVolumeClaim
+	BackupModePush BackupMode = "Push"
+	// BackupModePull exports the disks through an NBD server, from which the backup is read
+	BackupModePull BackupMode = "Pull"
+)
+
+// VirtualMachineInstanceBackupOptions is provided when starting a backup of the disks with changed block tracking
+// +k8s:openapi-gen=true
+type VirtualMachineInstanceBackupOptions struct {
+	// BackupName is the name of the VirtualMachineBackup
+	BackupName string `json:"backupName"`
+	// Mode is either Push or Pull
+	Mode BackupMode `json:"mode"`
+	// Checkpoint is the name of the checkpoint created by the backup
+	Checkpoint string `json:"checkpoint"`
+	// Checkpoints is the chain of checkpoints the backup is incremental to, oldest first.
+	// Only the blocks which changed since the last one are backed up. A full backup is
+	// taken without checkpoints.
+	// +optional
+	// +listType=atomic
+	Checkpoints []BackupCheckpoint `json:"checkpoints,omitempty"`
+	// Target is the claim a push backup is written to
+	// +optional
+	Target *BackupTarget `json:"target,omitempty"`
+}
+
+// BackupCheckpoint is a checkpoint of the disks with changed block tracking
+// +k8s:openapi-gen=true
+type BackupCheckpoint struct {
+	// Name is the name of the checkpoint
+	Name string `json:"name"`
+	// CreationTimestamp is the time the checkpoint was created
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// Volumes are the volumes whose changed blocks are tracked since the checkpoint
+	// +listType=atomic
+	Volumes []string `json:"volumes"`
+}
+
+// BackupTarget is the PersistentVolumeClaim a push backup is written to
+// +k8s:openapi-gen=true
+type BackupTarget struct {
+	// ClaimName is the name of the PersistentVolumeClaim
+	ClaimName string `json:"claimName"`
+	// PodUID is the UID of the pod which mounts the claim on the node of the VirtualMachineInstance
+	PodUID types.UID `json:"podUID"`
+}
+
+// VirtualMachineInstanceAbortBackupOptions is provided when aborting a backup.
+// Aborting a pull backup ends the export of its disks.
+// +k8s:openapi-gen=true
+type VirtualMachineInstanceAbortBackupOptions struct {
+	// BackupName is the name of the VirtualMachineBackup
+	BackupName string `json:"backupName"`
+}
+
+// VirtualMachineInstanceBackupState represents the state of a backup of the disks
+// +k8s:openapi-gen=true
+type VirtualMachineInstanceBackupState struct {
+	// BackupName is the name of the VirtualMachineBackup
+	BackupName string `json:"backupName"`
+	// Checkpoint is the name of the checkpoint created by the backup
+	// +optional
+	Checkpoint string `json:"checkpoint,omitempty"`
+	// The time the backup started
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// The time the backup ended
+	// +optional
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+	// Indicates the backup ended
+	// +optional
+	Completed bool `json:"completed,omitempty"`
+	// Indicates the backup failed
+	// +optional
+	Failed bool `json:"failed,omitempty"`
+	// Message describes why the backup failed
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
+// +k8s:openapi-gen=true
+type FreezeUnfreezeTimeout struct {
+	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
+}
+
 // KubeVirtConfiguration holds all kubevirt configurations
 // +k8s:openapi-gen=true
 type KubeVirtConfiguration struct {
@@ -1635,6 +2008,9 @@ type KubeVirtConfiguration struct {
 	SupportedGuestAgentVersions []string                `json:"supportedGuestAgentVersions,omitempty"`
 	MemBalloonStatsPeriod       *uint32                 `json:"memBalloonStatsPeriod,omitempty"`
 	PermittedHostDevices        *PermittedHostDevices   `json:"permittedHostDevices,omitempty"`
+	// ObsoleteCPUModels lists the CPU models which are not labelled on nodes.
+	// Set a model to false to stop treating it as obsolete.
+	ObsoleteCPUModels map[string]bool `json:"obsoleteCPUModels,omitempty"`
 }
 
 //
@@ -1716,4 +2092,37 @@ type NetworkConfiguration struct {
 	NetworkInterface                  string `json:"defaultNetworkInterface,omitempty"`
 	PermitSlirpInterface              *bool  `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool  `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
+	// Binding registers the network binding plugins which interfaces may select by name.
+	Binding map[string]InterfaceBindingPlugin `json:"binding,omitempty"`
+	// SecondaryNetworkDNS configures the DNS records published for the interfaces of VMIs on secondary networks.
+	// The records are only published when the SecondaryNetworkDNS feature gate is enabled.
+	// +optional
+	SecondaryNetworkDNS *SecondaryNetworkDNS `json:"secondaryNetworkDNS,omitempty"`
+}
+
+// SecondaryNetworkDNS configures the DNS records of the VMIs on secondary networks
+// +k8s:openapi-gen=true
+type SecondaryNetworkDNS struct {
+	// NamePattern is the name of the record published for each address of an interface.
+	// The placeholders {interface}, {vmi}, {namespace} and {network} are replaced by the name of the interface,
+	// the VMI, its namespace and the NetworkAttachmentDefinition of the network.
+	// Defaults to {interface}.{vmi}.{namespace}.{network}
+	// +optional
+	NamePattern string `json:"namePattern,omitempty"`
+}
+
+// InterfaceBindingPlugin describes a network binding plugin
+// +k8s:openapi-gen=true
+type InterfaceBindingPlugin struct {
+	// SidecarImage references a container image which runs in the virt-launcher pod.
+	// The sidecar implements the hooks API and contributes the libvirt interface definition
+	// of the interfaces using the binding on OnDefineDomain.
+	// +optional
+	SidecarImage string `json:"sidecarImage,omitempty"`
+	// NetworkAttachmentDefinition references a NetworkAttachmentDefinition which sets up
+	// the pod network for the binding. It is invoked by Multus with the name of the
+	// network of the interface in the "logicNetworkName" CNI argument.
+	// Format: <name>, <namespace>/<name>. If namespace is not specified, the VMI namespace is assumed.
+	// +optional
+	NetworkAttachmentDefinition string `json:"networkAttachmentDefinition,omitempty"`
 }
@@ -55,18 +55,30 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"evacuationNodeName":            "EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want\nto evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.\n+optional",
 		"activePods":                    "ActivePods is a mapping of pod UID to node name.\nIt is possible for multiple pods to be running for a single VMI during migration.",
 		"volumeStatus":                  "VolumeStatus contains the statuses of all the volumes\n+optional\n+listType=atomic",
+		"hotplugInterfaces":             "HotplugInterfaces contains the statuses of the network interfaces which are\nbeing hotplugged into or unplugged from the running VirtualMachineInstance\n+optional\n+listType=atomic",
+		"backupState":                   "BackupState represents the state of the latest backup of the disks\n+optional",
 	}
 }
 
 func (VolumeStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":              "VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.\n+k8s:openapi-gen=true",
-		"name":          "Name is the name of the volume",
-		"target":        "Target is the target name used when adding the volume to the VM, eg: vda",
-		"phase":         "Phase is the phase",
-		"reason":        "Reason is a brief description of why we are in the current hotplug volume phase",
-		"message":       "Message is a detailed message about the current hotplug volume phase",
-		"hotplugVolume": "If the volume is hotplug, this will contain the hotplug status.",
+		"":                          "VolumeStatus represents information about the status of volumes attached to the VirtualMachineInstance.\n+k8s:openapi-gen=true",
+		"name":                      "Name is the name of the volume",
+		"target":                    "Target is the target name used when adding the volume to the VM, eg: vda",
+		"phase":                     "Phase is the phase",
+		"reason":                    "Reason is a brief description of why we are in the current hotplug volume phase",
+		"message":                   "Message is a detailed message about the current hotplug volume phase",
+		"hotplugVolume":             "If the volume is hotplug, this will contain the hotplug status.",
+		"persistentVolumeClaimInfo": "If the volume is backed by a PersistentVolumeClaim, this will contain the information of the claim.",
+		"ioTune":                    "IOTune are the I/O limits in effect for the disk of the volume",
+	}
+}
+
+func (PersistentVolumeClaimInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "PersistentVolumeClaimInfo contains the information of the claim of a volume\n+k8s:openapi-gen=true",
+		"capacity":   "Capacity is the capacity reported in the status of the claim",
+		"volumeMode": "VolumeMode is the volume mode of the claim",
 	}
 }
 
@@ -78,6 +90,17 @@ func (HotplugVolumeStatus) SwaggerDoc() map[string]string {
 	}
 }
 
+func (HotplugInterfaceStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "HotplugInterfaceStatus represents the hotplug status of a network interface\n+k8s:openapi-gen=true",
+		"name":             "Name is the name of the interface and of the network it is connected to",
+		"podInterfaceName": "PodInterfaceName is the name of the virt-launcher pod interface backing the interface, eg: net2",
+		"phase":            "Phase is the phase",
+		"reason":           "Reason is a brief description of why we are in the current hotplug interface phase",
+		"message":          "Message is a detailed message about the current hotplug interface phase",
+	}
+}
+
 func (VirtualMachineInstanceCondition) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                   "+k8s:openapi-gen=true",
@@ -102,6 +125,16 @@ func (VirtualMachineInstanceNetworkInterface) SwaggerDoc() map[string]string {
 		"name":          "Name of the interface, corresponds to name of the network assigned to the interface",
 		"ipAddresses":   "List of all IP addresses of a Virtual Machine interface",
 		"interfaceName": "The interface name inside the Virtual Machine",
+		"bandwidth":     "The bandwidth limits applied to the interface\n+optional",
+		"conditions":    "Conditions of the interface\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceNetworkInterfaceCondition) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "+k8s:openapi-gen=true",
+		"lastProbeTime":      "+nullable",
+		"lastTransitionTime": "+nullable",
 	}
 }
 
@@ -136,6 +169,10 @@ func (VirtualMachineInstanceMigrationState) SwaggerDoc() map[string]string {
 		"abortStatus":                    "Indicates the final status of the live migration abortion",
 		"migrationUid":                   "The VirtualMachineInstanceMigration object associated with this migration",
 		"mode":                           "Lets us know if the vmi is currently running pre or post copy migration",
+		"migrationPolicyName":            "Name of the MigrationPolicy applied to the migration, if any\n+optional",
+		"migrationConfiguration":         "The migration configuration applied to the migration, the cluster wide\nconfiguration overridden by the MigrationPolicy\n+optional",
+		"sriovPhase":                     "The step of the unplug and replug of the SR-IOV interfaces, if any\n+optional",
+		"volumeMigrations":               "The volumes moved to new claims by the migration\n+optional\n+listType=atomic",
 	}
 }
 
@@ -222,8 +259,18 @@ func (VirtualMachineInstanceMigrationList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":        "+k8s:openapi-gen=true",
-		"vmiName": "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"":                 "+k8s:openapi-gen=true",
+		"vmiName":          "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"volumeMigrations": "VolumeMigrations moves volumes of the VMI to new claims while it is migrated.\nOnce the migration succeeded, the VMI and its VirtualMachine use the new claims.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VolumeMigration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "VolumeMigration maps a volume of the VMI to the claim its data is copied to.\nExactly one destination must be set.\n\n+k8s:openapi-gen=true",
+		"sourceVolume":          "SourceVolume is the name of a PersistentVolumeClaim or DataVolume volume of the VMI",
+		"destinationPVC":        "DestinationPVC is the name of the PersistentVolumeClaim the volume is moved to\n+optional",
+		"destinationDataVolume": "DestinationDataVolume is the name of the DataVolume the volume is moved to\n+optional",
 	}
 }
 
@@ -273,11 +320,31 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"":                    "VirtualMachineSpec describes how the proper VirtualMachine\nshould look like\n\n+k8s:openapi-gen=true",
 		"running":             "Running controls whether the associatied VirtualMachineInstance is created or not\nMutually exclusive with RunStrategy",
 		"runStrategy":         "Running state indicates the requested running state of the VirtualMachineInstance\nmutually exclusive with Running",
+		"instancetype":        "InstancetypeMatcher references a instancetype that is used to fill fields in Template\n+optional",
+		"preference":          "PreferenceMatcher references a set of preference that is used to fill fields in Template\n+optional",
 		"template":            "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates": "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 	}
 }
 
+func (InstancetypeMatcher) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "InstancetypeMatcher references a instancetype that is used to fill fields in the VMI template.\n\n+k8s:openapi-gen=true",
+		"name":         "Name is the name of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype",
+		"kind":         "Kind specifies which instancetype resource is referenced.\nAllowed values are: \"VirtualMachineInstancetype\" and \"VirtualMachineClusterInstancetype\".\nIf not specified, \"VirtualMachineClusterInstancetype\" is used by default.\n\n+optional",
+		"revisionName": "RevisionName specifies a ControllerRevision containing a specific copy of the\nVirtualMachineInstancetype or VirtualMachineClusterInstancetype to be used. This is initially\ncaptured the first time the instancetype is applied to the VirtualMachineInstance.\n\n+optional",
+	}
+}
+
+func (PreferenceMatcher) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "PreferenceMatcher references a set of preference that is used to fill fields in the VMI template.\n\n+k8s:openapi-gen=true",
+		"name":         "Name is the name of the VirtualMachinePreference or VirtualMachineClusterPreference",
+		"kind":         "Kind specifies which preference resource is referenced.\nAllowed values are: \"VirtualMachinePreference\" and \"VirtualMachineClusterPreference\".\nIf not specified, \"VirtualMachineClusterPreference\" is used by default.\n\n+optional",
+		"revisionName": "RevisionName specifies a ControllerRevision containing a specific copy of the\nVirtualMachinePreference or VirtualMachineClusterPreference to be used. This is\ninitially captured the first time the instancetype is applied to the VirtualMachineInstance.\n\n+optional",
+	}
+}
+
 func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                       "VirtualMachineStatus represents the status returned by the\ncontroller to describe how the VirtualMachine is doing\n\n+k8s:openapi-gen=true",
@@ -287,6 +354,7 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"conditions":             "Hold the state information of the VirtualMachine and its VirtualMachineInstance",
 		"stateChangeRequests":    "StateChangeRequests indicates a list of actions that should be taken on a VMI\ne.g. stop a specific VMI then start a new one.",
 		"volumeRequests":         "VolumeRequests indicates a list of volumes add or remove from the VMI template and\nhotplug on an active running VMI.\n+listType=atomic",
+		"interfaceRequests":      "InterfaceRequests indicates a list of network interfaces to add or remove from the\nVMI template and hotplug on an active running VMI.\n+listType=atomic",
 		"volumeSnapshotStatuses": "VolumeSnapshotStatuses indicates a list of statuses whether snapshotting is\nsupported by each volume.",
 	}
 }
@@ -305,6 +373,16 @@ func (VirtualMachineVolumeRequest) SwaggerDoc() map[string]string {
 		"":                    "+k8s:openapi-gen=true",
 		"addVolumeOptions":    "AddVolumeOptions when set indicates a volume should be added. The details\nwithin this field specify how to add the volume",
 		"removeVolumeOptions": "RemoveVolumeOptions when set indicates a volume should be removed. The details\nwithin this field specify how to add the volume",
+		"ejectCDRomOptions":   "EjectCDRomOptions when set indicates the media of a CD-ROM should be ejected.\nThe details within this field specify the CD-ROM",
+		"insertCDRomOptions":  "InsertCDRomOptions when set indicates a media should be inserted into a CD-ROM.\nThe details within this field specify the CD-ROM and the media",
+	}
+}
+
+func (VirtualMachineInterfaceRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "+k8s:openapi-gen=true",
+		"addInterfaceOptions":    "AddInterfaceOptions when set indicates a network interface should be added.\nThe details within this field specify how to add the interface",
+		"removeInterfaceOptions": "RemoveInterfaceOptions when set indicates a network interface should be removed.\nThe details within this field specify how to remove the interface",
 	}
 }
 
@@ -493,9 +571,102 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (EjectCDRomOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "EjectCDRomOptions is provided when ejecting the media of a CD-ROM\n+k8s:openapi-gen=true",
+		"name": "Name represents the name of the CD-ROM disk, and of the volume of its media",
+	}
+}
+
+func (InsertCDRomOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "InsertCDRomOptions is provided when inserting a media into an empty CD-ROM\n+k8s:openapi-gen=true",
+		"name":         "Name represents the name of the CD-ROM disk. It is also the name of the\nvolume of the media",
+		"volumeSource": "VolumeSource represents the source of the media",
+	}
+}
+
+func (SetBlockIOTuneOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "SetBlockIOTuneOptions is provided when changing the I/O limits of a disk of a running VMI\n+k8s:openapi-gen=true",
+		"name":   "Name is the name of the disk",
+		"ioTune": "IOTune are the new I/O limits of the disk. They replace all the current limits,\nunset limits are removed.",
+	}
+}
+
+func (AddInterfaceOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                "AddInterfaceOptions is provided when dynamically hot plugging a network interface\n+k8s:openapi-gen=true",
+		"networkAttachmentDefinitionName": "NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition CRD object. Format:\n<networkAttachmentDefinitionName>, <namespace>/<networkAttachmentDefinitionName>. If namespace is not\nspecified, VMI namespace is assumed.",
+		"name":                            "Name indicates the logical name of the interface, which is also used as the name\nof the network it is connected to.",
+	}
+}
+
+func (RemoveInterfaceOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "RemoveInterfaceOptions is provided when dynamically hot unplugging a network interface\n+k8s:openapi-gen=true",
+		"name": "Name indicates the logical name of the interface, which maps to both the\ninterface and the network that should be removed",
+	}
+}
+
+func (VirtualMachineInstanceBackupOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceBackupOptions is provided when starting a backup of the disks with changed block tracking\n+k8s:openapi-gen=true",
+		"backupName":  "BackupName is the name of the VirtualMachineBackup",
+		"mode":        "Mode is either Push or Pull",
+		"checkpoint":  "Checkpoint is the name of the checkpoint created by the backup",
+		"checkpoints": "Checkpoints is the chain of checkpoints the backup is incremental to, oldest first.\nOnly the blocks which changed since the last one are backed up. A full backup is\ntaken without checkpoints.\n+optional\n+listType=atomic",
+		"target":      "Target is the claim a push backup is written to\n+optional",
+	}
+}
+
+func (BackupCheckpoint) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "BackupCheckpoint is a checkpoint of the disks with changed block tracking\n+k8s:openapi-gen=true",
+		"name":              "Name is the name of the checkpoint",
+		"creationTimestamp": "CreationTimestamp is the time the checkpoint was created",
+		"volumes":           "Volumes are the volumes whose changed blocks are tracked since the checkpoint\n+listType=atomic",
+	}
+}
+
+func (BackupTarget) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "BackupTarget is the PersistentVolumeClaim a push backup is written to\n+k8s:openapi-gen=true",
+		"claimName": "ClaimName is the name of the PersistentVolumeClaim",
+		"podUID":    "PodUID is the UID of the pod which mounts the claim on the node of the VirtualMachineInstance",
+	}
+}
+
+func (VirtualMachineInstanceAbortBackupOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineInstanceAbortBackupOptions is provided when aborting a backup.\nAborting a pull backup ends the export of its disks.\n+k8s:openapi-gen=true",
+		"backupName": "BackupName is the name of the VirtualMachineBackup",
+	}
+}
+
+func (VirtualMachineInstanceBackupState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceBackupState represents the state of a backup of the disks\n+k8s:openapi-gen=true",
+		"backupName":     "BackupName is the name of the VirtualMachineBackup",
+		"checkpoint":     "Checkpoint is the name of the checkpoint created by the backup\n+optional",
+		"startTimestamp": "The time the backup started\n+optional",
+		"endTimestamp":   "The time the backup ended\n+optional",
+		"completed":      "Indicates the backup ended\n+optional",
+		"failed":         "Indicates the backup failed\n+optional",
+		"message":        "Message describes why the backup failed\n+optional",
+	}
+}
+
+func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command\n+k8s:openapi-gen=true",
+	}
+}
+
 func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "KubeVirtConfiguration holds all kubevirt configurations\n+k8s:openapi-gen=true",
+		"":                  "KubeVirtConfiguration holds all kubevirt configurations\n+k8s:openapi-gen=true",
+		"obsoleteCPUModels": "ObsoleteCPUModels lists the CPU models which are not labelled on nodes.\nSet a model to false to stop treating it as obsolete.",
 	}
 }
 
@@ -546,6 +717,23 @@ func (MediatedHostDevice) SwaggerDoc() map[string]string {
 
 func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "NetworkConfiguration holds network options\n+k8s:openapi-gen=true",
+		"":                    "NetworkConfiguration holds network options\n+k8s:openapi-gen=true",
+		"binding":             "Binding registers the network binding plugins which interfaces may select by name.",
+		"secondaryNetworkDNS": "SecondaryNetworkDNS configures the DNS records published for the interfaces of VMIs on secondary networks.\nThe records are only published when the SecondaryNetworkDNS feature gate is enabled.\n+optional",
+	}
+}
+
+func (SecondaryNetworkDNS) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "SecondaryNetworkDNS configures the DNS records of the VMIs on secondary networks\n+k8s:openapi-gen=true",
+		"namePattern": "NamePattern is the name of the record published for each address of an interface.\nThe placeholders {interface}, {vmi}, {namespace} and {network} are replaced by the name of the interface,\nthe VMI, its namespace and the NetworkAttachmentDefinition of the network.\nDefaults to {interface}.{vmi}.{namespace}.{network}\n+optional",
+	}
+}
+
+func (InterfaceBindingPlugin) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "InterfaceBindingPlugin describes a network binding plugin\n+k8s:openapi-gen=true",
+		"sidecarImage":                "SidecarImage references a container image which runs in the virt-launcher pod.\nThe sidecar implements the hooks API and contributes the libvirt interface definition\nof the interfaces using the binding on OnDefineDomain.\n+optional",
+		"networkAttachmentDefinition": "NetworkAttachmentDefinition references a NetworkAttachmentDefinition which sets up\nthe pod network for the binding. It is invoked by Multus with the name of the\nnetwork of the interface in the \"logicNetworkName\" CNI argument.\nFormat: <name>, <namespace>/<name>. If namespace is not specified, the VMI namespace is assumed.\n+optional",
 	}
 }
@@ -0,0 +1,285 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+// VirtualMachineBackup backs up the disks with changed block tracking of a running VirtualMachine,
+// either fully or incrementally to a previous backup
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource
+type VirtualMachineBackupSpec struct {
+	// Source is the VirtualMachine of the kubevirt.io group which is backed up.
+	// Only the disks with changedBlockTracking are part of the backup.
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// Mode is either Push, the default, or Pull.
+	// A push backup is written by libvirt to the PersistentVolumeClaim PVCName,
+	// the disks of a pull backup are exported through an NBD server until TTLDuration passed.
+	// +optional
+	Mode v1.BackupMode `json:"mode,omitempty"`
+
+	// PVCName is the name of the filesystem PersistentVolumeClaim a push backup is written to.
+	// Every backup is written to a directory of its own, so a claim can hold many backups.
+	// +optional
+	PVCName *string `json:"pvcName,omitempty"`
+
+	// IncrementalFrom is the name of a succeeded VirtualMachineBackup of the same source.
+	// Only the blocks which changed since its checkpoint are backed up. A full backup is
+	// taken if it is not set.
+	// +optional
+	IncrementalFrom *string `json:"incrementalFrom,omitempty"`
+
+	// TTLDuration is how long the disks of a pull backup are exported. Defaults to 1h.
+	// +optional
+	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+}
+
+// DefaultPullBackupTTL is how long the disks of a pull backup are exported if no TTLDuration is set
+const DefaultPullBackupTTL = "1h"
+
+// BackupPhase is the phase of a VirtualMachineBackup or VirtualMachineBackupRestore
+type BackupPhase string
+
+const (
+	// Pending means the backup or restore did not start yet
+	Pending BackupPhase = "Pending"
+
+	// Running means the backup or restore is in progress.
+	// The disks of a running pull backup are exported.
+	Running BackupPhase = "Running"
+
+	// Succeeded means the backup or restore completed
+	Succeeded BackupPhase = "Succeeded"
+
+	// Failed means the backup or restore failed
+	Failed BackupPhase = "Failed"
+)
+
+// BackupType is the type of a VirtualMachineBackup
+type BackupType string
+
+const (
+	// Full backups contain the whole disks
+	Full BackupType = "Full"
+
+	// Incremental backups contain the blocks which changed since the previous backup
+	Incremental BackupType = "Incremental"
+)
+
+// VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource
+type VirtualMachineBackupStatus struct {
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+
+	// +optional
+	Type BackupType `json:"type,omitempty"`
+
+	// +optional
+	// +nullable
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	// +nullable
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Checkpoint is the name of the checkpoint created by the backup.
+	// Later backups can be incremental to it.
+	// +optional
+	Checkpoint *string `json:"checkpoint,omitempty"`
+
+	// CheckpointChain is the chain of checkpoints the state of the disks at this backup is
+	// restored from. It starts with the checkpoint of the full backup, and ends with the one
+	// of this backup.
+	// +optional
+	// +listType=atomic
+	CheckpointChain []VirtualMachineBackupCheckpoint `json:"checkpointChain,omitempty"`
+
+	// Volumes are the backed up volumes
+	// +optional
+	// +listType=map
+	// +listMapKey=volumeName
+	Volumes []VirtualMachineBackupVolume `json:"volumes,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineBackupCheckpoint is a checkpoint in the chain of a VirtualMachineBackup
+type VirtualMachineBackupCheckpoint struct {
+	// BackupName is the name of the VirtualMachineBackup which created the checkpoint
+	BackupName string `json:"backupName"`
+
+	// Name is the name of the checkpoint
+	Name string `json:"name"`
+
+	// CreationTimestamp is the time the checkpoint was created
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+
+	// PVCName is the claim the backup was pushed to. It is not set for pull backups.
+	// +optional
+	PVCName *string `json:"pvcName,omitempty"`
+
+	// Volumes are the volumes whose changed blocks are tracked since the checkpoint
+	// +listType=atomic
+	Volumes []string `json:"volumes"`
+}
+
+// VirtualMachineBackupVolume is a backed up volume
+type VirtualMachineBackupVolume struct {
+	// VolumeName is the name of the volume in the VirtualMachine
+	VolumeName string `json:"volumeName"`
+
+	// DiskTarget is the target of the disk of the volume in the domain, eg: vda
+	// +optional
+	DiskTarget string `json:"diskTarget,omitempty"`
+
+	// Path is the qcow2 image a push backup of the volume is written to,
+	// relative to the root of the PersistentVolumeClaim
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// ExportName is the name of the NBD export of the volume of a pull backup
+	// +optional
+	ExportName string `json:"exportName,omitempty"`
+}
+
+// ConditionType is the const type for Conditions
+type ConditionType string
+
+const (
+	// ConditionReady is the "ready" condition type.
+	// A backup is ready once it completed, or while the disks of a pull backup are exported.
+	ConditionReady ConditionType = "Ready"
+
+	// ConditionProgressing is the "progressing" condition type
+	ConditionProgressing ConditionType = "Progressing"
+
+	// ConditionFailure is the "failure" condition type
+	ConditionFailure ConditionType = "Failure"
+)
+
+// Condition defines conditions
+type Condition struct {
+	Type ConditionType `json:"type"`
+
+	Status corev1.ConditionStatus `json:"status"`
+
+	// +optional
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineBackupList is a list of VirtualMachineBackup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineBackup `json:"items"`
+}
+
+// VirtualMachineBackupRestore restores the volumes of a push VirtualMachineBackup to
+// PersistentVolumeClaims, by replaying the backups of its checkpoint chain onto the full backup
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupRestoreSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupRestoreStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupRestoreSpec is the spec for a VirtualMachineBackupRestore resource
+type VirtualMachineBackupRestoreSpec struct {
+	// BackupName is the name of the VirtualMachineBackup whose state of the disks is restored.
+	// The VirtualMachineBackups of its checkpoint chain do not have to exist anymore,
+	// but their files have to.
+	BackupName string `json:"backupName"`
+
+	// Volumes are the backed up volumes which are restored, and the claims they are restored to
+	// +listType=map
+	// +listMapKey=volumeName
+	Volumes []VirtualMachineBackupRestoreVolume `json:"volumes"`
+}
+
+// VirtualMachineBackupRestoreVolume maps a backed up volume to the claim it is restored to
+type VirtualMachineBackupRestoreVolume struct {
+	// VolumeName is the name of the backed up volume
+	VolumeName string `json:"volumeName"`
+
+	// PVCName is the name of the PersistentVolumeClaim the volume is restored to.
+	// Its content is overwritten with a raw disk image.
+	PVCName string `json:"pvcName"`
+}
+
+// VirtualMachineBackupRestoreStatus is the status for a VirtualMachineBackupRestore resource
+type VirtualMachineBackupRestoreStatus struct {
+	// +optional
+	Phase BackupPhase `json:"phase,omitempty"`
+
+	// +optional
+	// +nullable
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// +optional
+	// +nullable
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineBackupRestoreList is a list of VirtualMachineBackupRestore resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineBackupRestore `json:"items"`
+}
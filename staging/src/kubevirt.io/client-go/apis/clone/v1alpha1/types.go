@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineClone creates a new VirtualMachine from an existing VirtualMachine or VirtualMachineSnapshot
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineCloneSpec `json:"spec"`
+
+	// +optional
+	Status VirtualMachineCloneStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineCloneSpec is the spec for a VirtualMachineClone resource
+type VirtualMachineCloneSpec struct {
+	// Source is the object that is cloned. Supported kinds are
+	// VirtualMachine of the kubevirt.io group and
+	// VirtualMachineSnapshot of the snapshot.kubevirt.io group.
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// Target is the VirtualMachine that is created by the clone.
+	// If the target is not set, its name is generated from NameTemplate.
+	// +optional
+	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
+
+	// NameTemplate is a Go template which generates the name of the target
+	// when no target is given. The template may reference {{.SourceName}},
+	// {{.CloneName}} and {{.UniqueSuffix}}. Defaults to "{{.SourceName}}-clone-{{.UniqueSuffix}}".
+	// +optional
+	NameTemplate *string `json:"nameTemplate,omitempty"`
+
+	// AnnotationFilters select which annotations of the source are copied to the target.
+	// A filter is a key which may contain "*" wildcards, a filter prefixed
+	// with "!" excludes the matching keys. By default all annotations are copied.
+	// +optional
+	// +listType=atomic
+	AnnotationFilters []string `json:"annotationFilters,omitempty"`
+
+	// LabelFilters select which labels of the source are copied to the target.
+	// The syntax is the same as for AnnotationFilters.
+	// +optional
+	// +listType=atomic
+	LabelFilters []string `json:"labelFilters,omitempty"`
+
+	// Template holds the filters applied to the metadata of the VirtualMachineInstance template.
+	// +optional
+	Template VirtualMachineCloneTemplateFilters `json:"template,omitempty"`
+
+	// NewMacAddresses maps interface names to the MAC addresses they get in the target.
+	// Interfaces which are not listed get a new MAC address assigned.
+	// +optional
+	NewMacAddresses map[string]string `json:"newMacAddresses,omitempty"`
+
+	// NewSMBiosSerial is the SMBIOS serial of the target.
+	// If it is not set, the serial of the source is dropped.
+	// +optional
+	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
+}
+
+// VirtualMachineCloneTemplateFilters holds the label and annotation filters of the VirtualMachineInstance template
+type VirtualMachineCloneTemplateFilters struct {
+	// AnnotationFilters select which annotations of the source template are copied.
+	// +optional
+	// +listType=atomic
+	AnnotationFilters []string `json:"annotationFilters,omitempty"`
+
+	// LabelFilters select which labels of the source template are copied.
+	// +optional
+	// +listType=atomic
+	LabelFilters []string `json:"labelFilters,omitempty"`
+}
+
+// VirtualMachineClonePhase is the phase of a VirtualMachineClone
+type VirtualMachineClonePhase string
+
+const (
+	// PhaseUnset means the clone was not processed yet
+	PhaseUnset VirtualMachineClonePhase = ""
+
+	// SnapshotInProgress means the source VirtualMachine is being snapshotted
+	SnapshotInProgress VirtualMachineClonePhase = "SnapshotInProgress"
+
+	// CreatingTargetVM means the target VirtualMachine is being created
+	CreatingTargetVM VirtualMachineClonePhase = "CreatingTargetVM"
+
+	// RestoreInProgress means the volumes of the target are being restored
+	RestoreInProgress VirtualMachineClonePhase = "RestoreInProgress"
+
+	// Succeeded means the target VirtualMachine was created
+	Succeeded VirtualMachineClonePhase = "Succeeded"
+
+	// Failed means the clone failed and will not be retried
+	Failed VirtualMachineClonePhase = "Failed"
+)
+
+// VirtualMachineCloneStatus is the status for a VirtualMachineClone resource
+type VirtualMachineCloneStatus struct {
+	// +optional
+	Phase VirtualMachineClonePhase `json:"phase,omitempty"`
+
+	// SnapshotName is the VirtualMachineSnapshot the target is restored from
+	// +optional
+	SnapshotName *string `json:"snapshotName,omitempty"`
+
+	// TargetName is the name of the target VirtualMachine
+	// +optional
+	TargetName *string `json:"targetName,omitempty"`
+
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// ConditionType is the const type for Conditions
+type ConditionType string
+
+const (
+	// ConditionReady is the "ready" condition type
+	ConditionReady ConditionType = "Ready"
+
+	// ConditionProgressing is the "progressing" condition type
+	ConditionProgressing ConditionType = "Progressing"
+)
+
+// Condition defines conditions
+type Condition struct {
+	Type ConditionType `json:"type"`
+
+	Status corev1.ConditionStatus `json:"status"`
+
+	// +optional
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineCloneList is a list of VirtualMachineClone resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineCloneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineClone `json:"items"`
+}
@@ -0,0 +1,197 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineExport exports the volumes and the definition of a VirtualMachine,
+// a VirtualMachineSnapshot or a PersistentVolumeClaim over HTTPS
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineExportSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineExportStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineExportSpec is the spec for a VirtualMachineExport resource
+type VirtualMachineExportSpec struct {
+	// Source is the object that is exported. Supported kinds are
+	// VirtualMachine of the kubevirt.io group,
+	// VirtualMachineSnapshot of the snapshot.kubevirt.io group and
+	// PersistentVolumeClaim of the core group.
+	// A VirtualMachine is only exported while it is stopped.
+	Source corev1.TypedLocalObjectReference `json:"source"`
+
+	// TokenSecretRef is the name of the Secret holding the token in its "token" key.
+	// Clients have to present the token in the x-kubevirt-export-token header
+	// or query parameter.
+	TokenSecretRef string `json:"tokenSecretRef"`
+}
+
+// TokenKey is the key of the token in the Secret referenced by the TokenSecretRef
+const TokenKey = "token"
+
+// VirtualMachineExportPhase is the phase of a VirtualMachineExport
+type VirtualMachineExportPhase string
+
+const (
+	// Pending means the export server is not available yet
+	Pending VirtualMachineExportPhase = "Pending"
+
+	// Ready means the export server is serving the source
+	Ready VirtualMachineExportPhase = "Ready"
+)
+
+// VirtualMachineExportStatus is the status for a VirtualMachineExport resource
+type VirtualMachineExportStatus struct {
+	// +optional
+	Phase VirtualMachineExportPhase `json:"phase,omitempty"`
+
+	// ServiceName is the name of the Service of the export server
+	// +optional
+	ServiceName string `json:"serviceName,omitempty"`
+
+	// +optional
+	Links *VirtualMachineExportLinks `json:"links,omitempty"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineExportLinks contains the links to the exported data
+type VirtualMachineExportLinks struct {
+	// Internal links are reachable from within the cluster
+	// +optional
+	Internal *VirtualMachineExportLink `json:"internal,omitempty"`
+}
+
+// VirtualMachineExportLink contains the certificate and the links of the export server
+type VirtualMachineExportLink struct {
+	// Cert is the PEM encoded CA certificate of the export server
+	Cert string `json:"cert"`
+
+	// Volumes are the exported volumes
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Volumes []VirtualMachineExportVolume `json:"volumes,omitempty"`
+
+	// Manifests are the exported definitions of the source
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Manifests []VirtualMachineExportManifest `json:"manifests,omitempty"`
+}
+
+// VirtualMachineExportVolume contains the links of an exported volume
+type VirtualMachineExportVolume struct {
+	// Name is the name of the exported PersistentVolumeClaim
+	Name string `json:"name"`
+
+	// +optional
+	// +listType=map
+	// +listMapKey=format
+	Formats []VirtualMachineExportVolumeFormat `json:"formats,omitempty"`
+}
+
+// ExportVolumeFormat is the format an exported volume is served in
+type ExportVolumeFormat string
+
+const (
+	// FormatRaw is the raw disk image
+	FormatRaw ExportVolumeFormat = "raw"
+
+	// FormatGzip is the gzip compressed raw disk image
+	FormatGzip ExportVolumeFormat = "gzip"
+
+	// FormatQcow2 is the disk image converted to qcow2
+	FormatQcow2 ExportVolumeFormat = "qcow2"
+)
+
+// VirtualMachineExportVolumeFormat is the link to an exported volume in a format
+type VirtualMachineExportVolumeFormat struct {
+	Format ExportVolumeFormat `json:"format"`
+
+	URL string `json:"url"`
+}
+
+// ExportManifestType is the type of an exported definition
+type ExportManifestType string
+
+const (
+	// VirtualMachineManifest is the definition of the VirtualMachine in yaml
+	VirtualMachineManifest ExportManifestType = "vm"
+
+	// AllManifests is a gzip compressed tar archive with the definitions of the
+	// VirtualMachine and its PersistentVolumeClaims
+	AllManifests ExportManifestType = "all"
+)
+
+// VirtualMachineExportManifest is the link to an exported definition
+type VirtualMachineExportManifest struct {
+	Type ExportManifestType `json:"type"`
+
+	URL string `json:"url"`
+}
+
+// ConditionType is the const type for Conditions
+type ConditionType string
+
+const (
+	// ConditionReady is the "ready" condition type
+	ConditionReady ConditionType = "Ready"
+)
+
+// Condition defines conditions
+type Condition struct {
+	Type ConditionType `json:"type"`
+
+	Status corev1.ConditionStatus `json:"status"`
+
+	// +optional
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+
+	// +optional
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// VirtualMachineExportList is a list of VirtualMachineExport resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineExport `json:"items"`
+}
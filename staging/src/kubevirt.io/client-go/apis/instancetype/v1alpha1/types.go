@@ -0,0 +1,370 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+// VirtualMachineInstancetype resource contains quantitative and resource related VirtualMachine configuration
+// that can be used by multiple VirtualMachine resources.
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstancetype struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the instancetype
+	Spec VirtualMachineInstancetypeSpec `json:"spec"`
+}
+
+// VirtualMachineInstancetypeList is a list of VirtualMachineInstancetype resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstancetypeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineInstancetype `json:"items"`
+}
+
+// VirtualMachineClusterInstancetype is a cluster scoped version of VirtualMachineInstancetype resource.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterInstancetype struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the instancetype
+	Spec VirtualMachineInstancetypeSpec `json:"spec"`
+}
+
+// VirtualMachineClusterInstancetypeList is a list of VirtualMachineClusterInstancetype resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterInstancetypeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineClusterInstancetype `json:"items"`
+}
+
+// VirtualMachineInstancetypeSpec is a description of the VirtualMachineInstancetype or VirtualMachineClusterInstancetype.
+//
+// CPU and Memory are required attributes with both requiring that their Guest attribute is defined, ensuring a number of vCPUs and amount of RAM is always provided by each instancetype.
+type VirtualMachineInstancetypeSpec struct {
+	// Required CPU related attributes of the instancetype.
+	CPU CPUInstancetype `json:"cpu"`
+
+	// Required Memory related attributes of the instancetype.
+	Memory MemoryInstancetype `json:"memory"`
+
+	// Optionally defines any GPU devices associated with the instancetype.
+	//
+	// +optional
+	// +listType=atomic
+	GPUs []v1.GPU `json:"gpus,omitempty"`
+
+	// Optionally defines any HostDevices associated with the instancetype.
+	//
+	// +optional
+	// +listType=atomic
+	HostDevices []v1.HostDevice `json:"hostDevices,omitempty"`
+
+	// Optionally defines the IOThreadsPolicy to be used by the instancetype.
+	//
+	// +optional
+	IOThreadsPolicy *v1.IOThreadsPolicy `json:"ioThreadsPolicy,omitempty"`
+}
+
+// CPUInstancetype contains the CPU related configuration of a given VirtualMachineInstancetypeSpec.
+//
+// Guest is a required attribute and defines the number of vCPUs to be exposed to the guest by the instancetype.
+type CPUInstancetype struct {
+	// Required number of vCPUs to expose to the guest.
+	// The resulting CPU topology being derived from the optional PreferredCPUTopology attribute of CPUPreferences that itself defaults to PreferSockets.
+	Guest uint32 `json:"guest"`
+
+	// Model specifies the CPU model inside the VMI.
+	// List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
+	// It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
+	// and "host-model" to get CPU closest to the node one.
+	// Defaults to host-model.
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node
+	// with enough dedicated pCPUs and pin the vCPUs to it.
+	// +optional
+	DedicatedCPUPlacement bool `json:"dedicatedCPUPlacement,omitempty"`
+
+	// IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place
+	// the emulator thread on it.
+	// +optional
+	IsolateEmulatorThread bool `json:"isolateEmulatorThread,omitempty"`
+}
+
+// MemoryInstancetype contains the Memory related configuration of a given VirtualMachineInstancetypeSpec.
+//
+// Guest is a required attribute and defines the amount of RAM to be exposed to the guest by the instancetype.
+type MemoryInstancetype struct {
+	// Required amount of memory which is visible inside the guest OS.
+	Guest resource.Quantity `json:"guest"`
+
+	// Optionally enables the use of hugepages for the VirtualMachineInstance instead of regular memory.
+	// +optional
+	Hugepages *v1.Hugepages `json:"hugepages,omitempty"`
+}
+
+// VirtualMachinePreference resource contains optional preferences related to the VirtualMachine.
+//
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePreference struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the preferences
+	Spec VirtualMachinePreferenceSpec `json:"spec"`
+}
+
+// VirtualMachinePreferenceList is a list of VirtualMachinePreference resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePreferenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachinePreference `json:"items"`
+}
+
+// VirtualMachineClusterPreference is a cluster scoped version of the VirtualMachinePreference resource.
+//
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterPreference struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Required spec describing the preferences
+	Spec VirtualMachinePreferenceSpec `json:"spec"`
+}
+
+// VirtualMachineClusterPreferenceList is a list of VirtualMachineClusterPreference resources.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineClusterPreferenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineClusterPreference `json:"items"`
+}
+
+// VirtualMachinePreferenceSpec is a description of the VirtualMachinePreference or VirtualMachineClusterPreference.
+//
+// Preferences are only applied to the attributes the VirtualMachine does not define itself.
+type VirtualMachinePreferenceSpec struct {
+	// Clock optionally defines preferences associated with the Clock attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	Clock *ClockPreferences `json:"clock,omitempty"`
+
+	// CPU optionally defines preferences associated with the CPU attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	CPU *CPUPreferences `json:"cpu,omitempty"`
+
+	// Devices optionally defines preferences associated with the Devices attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	Devices *DevicePreferences `json:"devices,omitempty"`
+
+	// Features optionally defines preferences associated with the Features attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	Features *FeaturePreferences `json:"features,omitempty"`
+
+	// Firmware optionally defines preferences associated with the Firmware attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	Firmware *FirmwarePreferences `json:"firmware,omitempty"`
+
+	// Machine optionally defines preferences associated with the Machine attribute of a VirtualMachineInstance DomainSpec
+	//
+	// +optional
+	Machine *MachinePreferences `json:"machine,omitempty"`
+}
+
+// PreferredCPUTopology defines how the guest vCPUs of an instancetype are spread across the CPU topology
+type PreferredCPUTopology string
+
+const (
+	// PreferSockets exposes the guest vCPUs as sockets, the default
+	PreferSockets PreferredCPUTopology = "preferSockets"
+	// PreferCores exposes the guest vCPUs as cores
+	PreferCores PreferredCPUTopology = "preferCores"
+	// PreferThreads exposes the guest vCPUs as threads
+	PreferThreads PreferredCPUTopology = "preferThreads"
+)
+
+// CPUPreferences contains various optional CPU preferences.
+type CPUPreferences struct {
+	// PreferredCPUTopology optionally defines the preferred guest visible CPU topology, defaults to PreferSockets.
+	//
+	// +optional
+	PreferredCPUTopology PreferredCPUTopology `json:"preferredCPUTopology,omitempty"`
+}
+
+// DevicePreferences contains various optional Device preferences.
+type DevicePreferences struct {
+	// PreferredAutoattachGraphicsDevice optionally defines the preferred value of AutoattachGraphicsDevice
+	//
+	// +optional
+	PreferredAutoattachGraphicsDevice *bool `json:"preferredAutoattachGraphicsDevice,omitempty"`
+
+	// PreferredAutoattachMemBalloon optionally defines the preferred value of AutoattachMemBalloon
+	//
+	// +optional
+	PreferredAutoattachMemBalloon *bool `json:"preferredAutoattachMemBalloon,omitempty"`
+
+	// PreferredAutoattachPodInterface optionally defines the preferred value of AutoattachPodInterface
+	//
+	// +optional
+	PreferredAutoattachPodInterface *bool `json:"preferredAutoattachPodInterface,omitempty"`
+
+	// PreferredAutoattachSerialConsole optionally defines the preferred value of AutoattachSerialConsole
+	//
+	// +optional
+	PreferredAutoattachSerialConsole *bool `json:"preferredAutoattachSerialConsole,omitempty"`
+
+	// PreferredUseVirtioTransitional optionally defines the preferred value of UseVirtioTransitional
+	//
+	// +optional
+	PreferredUseVirtioTransitional *bool `json:"preferredUseVirtioTransitional,omitempty"`
+
+	// PreferredBlockMultiQueue optionally enables the vhost multiqueue feature for virtio disks.
+	//
+	// +optional
+	PreferredBlockMultiQueue *bool `json:"preferredBlockMultiQueue,omitempty"`
+
+	// PreferredNetworkInterfaceMultiQueue optionally enables the vhost multiqueue feature for virtio interfaces.
+	//
+	// +optional
+	PreferredNetworkInterfaceMultiQueue *bool `json:"preferredNetworkInterfaceMultiQueue,omitempty"`
+
+	// PreferredDiskBus optionally defines the preferred bus for Disk Disk devices.
+	//
+	// +optional
+	PreferredDiskBus string `json:"preferredDiskBus,omitempty"`
+
+	// PreferredCdromBus optionally defines the preferred bus for Cdrom Disk devices.
+	//
+	// +optional
+	PreferredCdromBus string `json:"preferredCdromBus,omitempty"`
+
+	// PreferredLunBus optionally defines the preferred bus for Lun Disk devices.
+	//
+	// +optional
+	PreferredLunBus string `json:"preferredLunBus,omitempty"`
+
+	// PreferredInterfaceModel optionally defines the preferred model to be used by Interface devices.
+	//
+	// +optional
+	PreferredInterfaceModel string `json:"preferredInterfaceModel,omitempty"`
+
+	// PreferredRng optionally defines the preferred rng device to be used.
+	//
+	// +optional
+	PreferredRng *v1.Rng `json:"preferredRng,omitempty"`
+}
+
+// FeaturePreferences contains various optional defaults for Features.
+type FeaturePreferences struct {
+	// PreferredAcpi optionally enables the ACPI feature
+	//
+	// +optional
+	PreferredAcpi *v1.FeatureState `json:"preferredAcpi,omitempty"`
+
+	// PreferredApic optionally enables and configures the APIC feature
+	//
+	// +optional
+	PreferredApic *v1.FeatureAPIC `json:"preferredApic,omitempty"`
+
+	// PreferredHyperv optionally enables and configures HyperV features
+	//
+	// +optional
+	PreferredHyperv *v1.FeatureHyperv `json:"preferredHyperv,omitempty"`
+
+	// PreferredSmm optionally enables the SMM feature
+	//
+	// +optional
+	PreferredSmm *v1.FeatureState `json:"preferredSmm,omitempty"`
+}
+
+// FirmwarePreferences contains various optional defaults for Firmware.
+type FirmwarePreferences struct {
+	// PreferredUseBios optionally enables BIOS
+	//
+	// +optional
+	PreferredUseBios *bool `json:"preferredUseBios,omitempty"`
+
+	// PreferredUseBiosSerial optionally transmits BIOS output over the serial.
+	//
+	// Requires PreferredUseBios to be enabled.
+	//
+	// +optional
+	PreferredUseBiosSerial *bool `json:"preferredUseBiosSerial,omitempty"`
+
+	// PreferredUseEfi optionally enables EFI
+	//
+	// +optional
+	PreferredUseEfi *bool `json:"preferredUseEfi,omitempty"`
+
+	// PreferredUseSecureBoot optionally enables SecureBoot and the OVMF roms will be swapped for SecureBoot-enabled ones.
+	//
+	// Requires PreferredUseEfi and PreferredSmm to be enabled.
+	//
+	// +optional
+	PreferredUseSecureBoot *bool `json:"preferredUseSecureBoot,omitempty"`
+}
+
+// MachinePreferences contains various optional defaults for Machine.
+type MachinePreferences struct {
+	// PreferredMachineType optionally defines the preferred machine type to use.
+	//
+	// +optional
+	PreferredMachineType string `json:"preferredMachineType,omitempty"`
+}
+
+// ClockPreferences contains various optional defaults for Clock.
+type ClockPreferences struct {
+	// ClockOffset allows specifying the UTC offset or the timezone of the guest clock.
+	//
+	// +optional
+	PreferredClockOffset *v1.ClockOffset `json:"preferredClockOffset,omitempty"`
+
+	// Timer specifies which timers are attached to the vmi.
+	//
+	// +optional
+	PreferredTimer *v1.Timer `json:"preferredTimer,omitempty"`
+}
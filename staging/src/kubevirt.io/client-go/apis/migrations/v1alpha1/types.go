@@ -0,0 +1,86 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MigrationPolicy overrides the cluster wide migration configuration for the
+// VirtualMachineInstances it selects
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MigrationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec MigrationPolicySpec `json:"spec"`
+
+	// +optional
+	Status MigrationPolicyStatus `json:"status,omitempty"`
+}
+
+// MigrationPolicySpec is the spec for a MigrationPolicy resource. Unset
+// fields keep the value of the cluster wide migration configuration.
+type MigrationPolicySpec struct {
+	// Selectors select the VirtualMachineInstances the policy applies to
+	Selectors *Selectors `json:"selectors"`
+
+	// +optional
+	AllowAutoConverge *bool `json:"allowAutoConverge,omitempty"`
+
+	// +optional
+	BandwidthPerMigration *resource.Quantity `json:"bandwidthPerMigration,omitempty"`
+
+	// +optional
+	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
+
+	// +optional
+	ProgressTimeout *int64 `json:"progressTimeout,omitempty"`
+
+	// +optional
+	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+}
+
+// Selectors select VirtualMachineInstances by their labels and the labels of
+// their namespace. A VirtualMachineInstance has to match both selectors, an
+// unset selector matches everything.
+type Selectors struct {
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// +optional
+	VirtualMachineInstanceSelector *metav1.LabelSelector `json:"virtualMachineInstanceSelector,omitempty"`
+}
+
+// MigrationPolicyStatus is the status for a MigrationPolicy resource
+type MigrationPolicyStatus struct {
+}
+
+// MigrationPolicyList is a list of MigrationPolicy resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MigrationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []MigrationPolicy `json:"items"`
+}
@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VirtualMachineNetworkPolicy restricts the traffic of the bridge and macvtap
+// interfaces of the VirtualMachineInstances it selects
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineNetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineNetworkPolicySpec `json:"spec"`
+	// +optional
+	Status VirtualMachineNetworkPolicyStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineNetworkPolicySpec is the spec for a VirtualMachineNetworkPolicy
+// resource. Like a NetworkPolicy, a VirtualMachineInstance selected by a policy
+// only accepts the traffic allowed by the rules of the policies selecting it,
+// in the directions listed in policyTypes.
+type VirtualMachineNetworkPolicySpec struct {
+	// VirtualMachineInstanceSelector selects the VirtualMachineInstances in the
+	// namespace of the policy. An empty selector selects all of them.
+	VirtualMachineInstanceSelector metav1.LabelSelector `json:"virtualMachineInstanceSelector"`
+
+	// Networks restricts the policy to the interfaces connected to the listed
+	// networks. All the bridge and macvtap interfaces are selected when empty.
+	// +optional
+	Networks []string `json:"networks,omitempty"`
+
+	// PolicyTypes lists the directions the policy isolates. It defaults to
+	// Ingress, and to Egress as well when egress rules are set.
+	// +optional
+	PolicyTypes []PolicyType `json:"policyTypes,omitempty"`
+
+	// Ingress lists the rules for the traffic received by the guest
+	// +optional
+	Ingress []VirtualMachineNetworkPolicyIngressRule `json:"ingress,omitempty"`
+
+	// Egress lists the rules for the traffic sent by the guest
+	// +optional
+	Egress []VirtualMachineNetworkPolicyEgressRule `json:"egress,omitempty"`
+}
+
+// PolicyType is a direction of the traffic isolated by a policy
+type PolicyType string
+
+const (
+	// PolicyTypeIngress isolates the traffic received by the guest
+	PolicyTypeIngress PolicyType = "Ingress"
+	// PolicyTypeEgress isolates the traffic sent by the guest
+	PolicyTypeEgress PolicyType = "Egress"
+)
+
+// VirtualMachineNetworkPolicyIngressRule allows traffic matching both its
+// ports and its peers to reach the guest
+type VirtualMachineNetworkPolicyIngressRule struct {
+	// Ports lists the ports of the guest the rule allows. All ports are
+	// allowed when empty.
+	// +optional
+	Ports []VirtualMachineNetworkPolicyPort `json:"ports,omitempty"`
+
+	// From lists the peers the rule allows. All peers are allowed when empty.
+	// +optional
+	From []VirtualMachineNetworkPolicyPeer `json:"from,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyEgressRule allows traffic matching both its
+// ports and its peers to leave the guest
+type VirtualMachineNetworkPolicyEgressRule struct {
+	// Ports lists the ports of the peers the rule allows. All ports are
+	// allowed when empty.
+	// +optional
+	Ports []VirtualMachineNetworkPolicyPort `json:"ports,omitempty"`
+
+	// To lists the peers the rule allows. All peers are allowed when empty.
+	// +optional
+	To []VirtualMachineNetworkPolicyPeer `json:"to,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyPort is a port, or a range of ports, of a protocol
+type VirtualMachineNetworkPolicyPort struct {
+	// Protocol is one of TCP, UDP or SCTP. Defaults to TCP.
+	// +optional
+	Protocol *k8sv1.Protocol `json:"protocol,omitempty"`
+
+	// Port is the port, or the first port of the range. All the ports of the
+	// protocol are allowed when unset.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// EndPort is the last port of the range starting at port
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyPeer is a set of addresses. Exactly one of its
+// fields has to be set.
+type VirtualMachineNetworkPolicyPeer struct {
+	// IPBlock selects addresses by CIDR
+	// +optional
+	IPBlock *IPBlock `json:"ipBlock,omitempty"`
+
+	// VirtualMachineInstanceSelector selects the addresses reported in the
+	// status of the VirtualMachineInstances in the namespace of the policy.
+	// +optional
+	VirtualMachineInstanceSelector *metav1.LabelSelector `json:"virtualMachineInstanceSelector,omitempty"`
+}
+
+// IPBlock is an IPv4 or IPv6 CIDR, without the CIDRs in except
+type IPBlock struct {
+	CIDR string `json:"cidr"`
+
+	// Except lists CIDRs within cidr which are not part of the block
+	// +optional
+	Except []string `json:"except,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyStatus is maintained by virt-controller, virt-handler
+// reads the addresses of the peers from it instead of watching all the
+// VirtualMachineInstances of the cluster.
+type VirtualMachineNetworkPolicyStatus struct {
+	// Peers lists the addresses of the VirtualMachineInstances selected by the
+	// peer selectors of the rules
+	// +optional
+	Peers []VirtualMachineNetworkPolicyPeerStatus `json:"peers,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyPeerStatus holds the addresses of the
+// VirtualMachineInstances matching a peer selector of the policy
+type VirtualMachineNetworkPolicyPeerStatus struct {
+	// VirtualMachineInstanceSelector is the peer selector of the rules
+	VirtualMachineInstanceSelector metav1.LabelSelector `json:"virtualMachineInstanceSelector"`
+
+	// Addresses lists the addresses reported by the selected
+	// VirtualMachineInstances as host CIDRs
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+}
+
+// VirtualMachineNetworkPolicyList is a list of VirtualMachineNetworkPolicy resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineNetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineNetworkPolicy `json:"items"`
+}
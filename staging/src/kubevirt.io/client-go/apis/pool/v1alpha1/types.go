@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2021 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	v1 "kubevirt.io/client-go/api/v1"
+)
+
+// VirtualMachinePool manages a set of VirtualMachines created from a common template.
+// Every VirtualMachine of the pool gets its own DataVolumes from the dataVolumeTemplates of the template.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachinePoolSpec `json:"spec"`
+
+	// +optional
+	Status VirtualMachinePoolStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineTemplateSpec describes the VirtualMachines created by a VirtualMachinePool
+type VirtualMachineTemplateSpec struct {
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +nullable
+	ObjectMeta metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// VirtualMachineSpec contains the VirtualMachine specification.
+	Spec v1.VirtualMachineSpec `json:"spec"`
+}
+
+// VirtualMachinePoolUpdateStrategyType defines how VirtualMachines are updated
+// when the template of the pool changes
+type VirtualMachinePoolUpdateStrategyType string
+
+const (
+	// RollingUpdateVirtualMachinePoolStrategyType updates the VirtualMachines
+	// and restarts their VirtualMachineInstances, respecting maxUnavailable
+	RollingUpdateVirtualMachinePoolStrategyType VirtualMachinePoolUpdateStrategyType = "RollingUpdate"
+
+	// OnDeleteVirtualMachinePoolStrategyType only applies the new template
+	// to VirtualMachines which are recreated after being deleted
+	OnDeleteVirtualMachinePoolStrategyType VirtualMachinePoolUpdateStrategyType = "OnDelete"
+)
+
+// VirtualMachinePoolUpdateStrategy defines the update strategy of a VirtualMachinePool
+type VirtualMachinePoolUpdateStrategy struct {
+	// Type of the update strategy. Defaults to RollingUpdate.
+	// +optional
+	Type VirtualMachinePoolUpdateStrategyType `json:"type,omitempty"`
+
+	// RollingUpdate configures the RollingUpdate strategy.
+	// +optional
+	RollingUpdate *RollingUpdateVirtualMachinePool `json:"rollingUpdate,omitempty"`
+}
+
+// RollingUpdateVirtualMachinePool configures the RollingUpdate strategy of a VirtualMachinePool
+type RollingUpdateVirtualMachinePool struct {
+	// The maximum number of VirtualMachines which may be restarted at the same time
+	// during an update. Value can be an absolute number or a percentage of the
+	// desired replicas. Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// VirtualMachinePoolVolumeClaimPolicy defines what happens to the DataVolumes and
+// PersistentVolumeClaims of a VirtualMachine when the pool is scaled down
+type VirtualMachinePoolVolumeClaimPolicy string
+
+const (
+	// VirtualMachinePoolVolumeClaimDelete deletes the DataVolumes together with the VirtualMachine
+	VirtualMachinePoolVolumeClaimDelete VirtualMachinePoolVolumeClaimPolicy = "Delete"
+
+	// VirtualMachinePoolVolumeClaimRetain keeps the DataVolumes, they are reused when
+	// the pool is scaled up again
+	VirtualMachinePoolVolumeClaimRetain VirtualMachinePoolVolumeClaimPolicy = "Retain"
+)
+
+// VirtualMachinePoolSpec is the spec for a VirtualMachinePool resource
+type VirtualMachinePoolSpec struct {
+	// Number of desired VirtualMachines. This is a pointer to distinguish between explicit
+	// zero and not specified. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Label selector for VirtualMachines. It has to match the labels of the template.
+	Selector *metav1.LabelSelector `json:"selector"`
+
+	// Template describes the VirtualMachines that will be created.
+	VirtualMachineTemplate *VirtualMachineTemplateSpec `json:"virtualMachineTemplate"`
+
+	// Indicates that the pool is paused.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// UpdateStrategy defines how existing VirtualMachines are updated when the template changes.
+	// +optional
+	UpdateStrategy *VirtualMachinePoolUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// ScaleDownVolumeClaimPolicy defines what happens to the DataVolumes of removed
+	// VirtualMachines when the pool is scaled down. Defaults to Delete.
+	// +optional
+	ScaleDownVolumeClaimPolicy *VirtualMachinePoolVolumeClaimPolicy `json:"scaleDownVolumeClaimPolicy,omitempty"`
+}
+
+// VirtualMachinePoolConditionType represents the type of a VirtualMachinePool condition
+type VirtualMachinePoolConditionType string
+
+const (
+	// VirtualMachinePoolReplicaFailure is added in a pool when one of its VirtualMachines
+	// fails to be created or deleted.
+	VirtualMachinePoolReplicaFailure VirtualMachinePoolConditionType = "ReplicaFailure"
+
+	// VirtualMachinePoolReplicaPaused is added in a pool when the pool got paused by the controller.
+	VirtualMachinePoolReplicaPaused VirtualMachinePoolConditionType = "ReplicaPaused"
+)
+
+// VirtualMachinePoolCondition represents the state of a VirtualMachinePool
+type VirtualMachinePoolCondition struct {
+	Type   VirtualMachinePoolConditionType `json:"type"`
+	Status corev1.ConditionStatus          `json:"status"`
+	// +nullable
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// +nullable
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	Reason             string      `json:"reason,omitempty"`
+	Message            string      `json:"message,omitempty"`
+}
+
+// VirtualMachinePoolStatus is the status for a VirtualMachinePool resource
+type VirtualMachinePoolStatus struct {
+	// Total number of VirtualMachines of the pool.
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+
+	// The number of VirtualMachines of the pool with a ready VirtualMachineInstance.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas,omitempty"`
+
+	// The number of VirtualMachines which run the latest template of the pool.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas,omitempty"`
+
+	// +optional
+	Conditions []VirtualMachinePoolCondition `json:"conditions,omitempty"`
+
+	// Canonical form of the label selector for HPA which consumes it through the scale subresource.
+	// +optional
+	LabelSelector string `json:"labelSelector,omitempty"`
+}
+
+// VirtualMachinePoolList is a list of VirtualMachinePool resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachinePool `json:"items"`
+}
@@ -60,6 +60,12 @@ type VirtualMachineSnapshotSpec struct {
 
 	// +optional
 	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// FreezeTimeout bounds how long the guest filesystems of a running VM stay frozen
+	// while its volumes are snapshotted, they are thawed automatically once it passed.
+	// Defaults to 5m.
+	// +optional
+	FreezeTimeout *metav1.Duration `json:"freezeTimeout,omitempty"`
 }
 
 // VirtualMachineSnapshotStatus is the status for a VirtualMachineSnapshot resource
@@ -82,8 +88,28 @@ type VirtualMachineSnapshotStatus struct {
 
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+
+	// +optional
+	// +listType=set
+	Indications []Indication `json:"indications,omitempty"`
 }
 
+// Indication is a way to indicate the state of the vm when taking the snapshot
+type Indication string
+
+const (
+	// VMSnapshotOnlineSnapshotIndication means the snapshot was taken while the VM was running
+	VMSnapshotOnlineSnapshotIndication Indication = "Online"
+
+	// VMSnapshotGuestAgentFrozenIndication means the guest filesystems were frozen
+	// by the qemu-guest-agent while the snapshot was taken
+	VMSnapshotGuestAgentFrozenIndication Indication = "GuestAgentFrozen"
+
+	// VMSnapshotCrashConsistentIndication means the snapshot was taken from a running VM
+	// without the guest agent, so it is only crash consistent
+	VMSnapshotCrashConsistentIndication Indication = "CrashConsistent"
+)
+
 // Error is the last error encountered during the snapshot/restore
 type Error struct {
 	// +optional
@@ -241,10 +267,18 @@ type VirtualMachineRestore struct {
 
 // VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource
 type VirtualMachineRestoreSpec struct {
-	// initially only VirtualMachine type supported
+	// initially only VirtualMachine type supported.
+	// If the target VirtualMachine does not exist, it is created from the snapshot
+	// with new MAC addresses and firmware UUID.
 	Target corev1.TypedLocalObjectReference `json:"target"`
 
 	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+
+	// VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot,
+	// defaults to the namespace of the restore. Restoring a snapshot of another namespace
+	// requires a VirtualMachineRestoreGrant in the namespace of the snapshot.
+	// +optional
+	VirtualMachineSnapshotNamespace *string `json:"virtualMachineSnapshotNamespace,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the spec for a VirtualMachineRestoreresource
@@ -285,3 +319,152 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// VirtualMachineRestoreGrant allows VirtualMachineRestores of other namespaces
+// to restore the VirtualMachineSnapshots of its namespace
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineRestoreGrant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineRestoreGrantSpec `json:"spec"`
+}
+
+// VirtualMachineRestoreGrantSpec is the spec for a VirtualMachineRestoreGrant resource
+type VirtualMachineRestoreGrantSpec struct {
+	// Namespaces whose VirtualMachineRestores may use the snapshots
+	// +listType=set
+	Namespaces []string `json:"namespaces"`
+
+	// VirtualMachineSnapshotNames limits the grant to the listed snapshots,
+	// all snapshots of the namespace are granted if it is empty
+	// +optional
+	// +listType=set
+	VirtualMachineSnapshotNames []string `json:"virtualMachineSnapshotNames,omitempty"`
+}
+
+// Allows returns true if the grant permits VirtualMachineRestores in namespace
+// to restore the VirtualMachineSnapshot snapshotName
+func (g *VirtualMachineRestoreGrant) Allows(namespace, snapshotName string) bool {
+	namespaceGranted := false
+	for _, ns := range g.Spec.Namespaces {
+		if ns == namespace {
+			namespaceGranted = true
+			break
+		}
+	}
+
+	if !namespaceGranted {
+		return false
+	}
+
+	if len(g.Spec.VirtualMachineSnapshotNames) == 0 {
+		return true
+	}
+
+	for _, name := range g.Spec.VirtualMachineSnapshotNames {
+		if name == snapshotName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// VirtualMachineRestoreGrantList is a list of VirtualMachineRestoreGrant resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineRestoreGrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineRestoreGrant `json:"items"`
+}
+
+// VirtualMachineSnapshotSchedule periodically creates VirtualMachineSnapshots
+// of the selected VirtualMachines
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Schedule in cron format, for example "0 2 * * *"
+	Schedule string `json:"schedule"`
+
+	// Selector selects the VirtualMachines in the namespace of the schedule
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Disabled suspends the creation of new VirtualMachineSnapshots,
+	// the retention policy is still applied
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// DeletionPolicy of the created VirtualMachineSnapshots
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// +optional
+	Retention *VirtualMachineSnapshotScheduleRetention `json:"retention,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleRetention defines which VirtualMachineSnapshots of a
+// schedule are kept per VirtualMachine. A snapshot is kept if any of Last, Daily or
+// Weekly selects it, or if none of them is set.
+type VirtualMachineSnapshotScheduleRetention struct {
+	// Last is the number of most recent snapshots to keep
+	// +optional
+	Last *int32 `json:"last,omitempty"`
+
+	// Daily is the number of days to keep the most recent snapshot of
+	// +optional
+	Daily *int32 `json:"daily,omitempty"`
+
+	// Weekly is the number of weeks to keep the most recent snapshot of
+	// +optional
+	Weekly *int32 `json:"weekly,omitempty"`
+
+	// Expires deletes snapshots older than the duration, even if they are
+	// selected by Last, Daily or Weekly
+	// +optional
+	Expires *metav1.Duration `json:"expires,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// LastRunTime is the time the VirtualMachineSnapshots were last scheduled for
+	// +optional
+	// +nullable
+	LastRunTime *metav1.Time `json:"lastRunTime,omitempty"`
+
+	// NextRunTime is the time the next VirtualMachineSnapshots are scheduled for
+	// +optional
+	// +nullable
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+
+	// LastSuccessTime is the time of the last run whose snapshots all became ready
+	// +optional
+	// +nullable
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// LastFailure is the time and reason of the last failed run
+	// +optional
+	LastFailure *Error `json:"lastFailure,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
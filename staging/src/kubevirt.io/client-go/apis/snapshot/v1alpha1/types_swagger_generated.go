@@ -13,6 +13,7 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy": "+optional",
+		"freezeTimeout":  "FreezeTimeout bounds how long the guest filesystems of a running VM stay frozen\nwhile its volumes are snapshotted, they are thawed automatically once it passed.\nDefaults to 5m.\n+optional",
 	}
 }
 
@@ -25,6 +26,7 @@ func (VirtualMachineSnapshotStatus) SwaggerDoc() map[string]string {
 		"readyToUse":                        "+optional",
 		"error":                             "+optional",
 		"conditions":                        "+optional",
+		"indications":                       "+optional\n+listType=set",
 	}
 }
 
@@ -120,8 +122,9 @@ func (VirtualMachineRestore) SwaggerDoc() map[string]string {
 
 func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":       "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
-		"target": "initially only VirtualMachine type supported",
+		"":                                "VirtualMachineRestoreSpec is the spec for a VirtualMachineRestoreresource",
+		"target":                          "initially only VirtualMachine type supported.\nIf the target VirtualMachine does not exist, it is created from the snapshot\nwith new MAC addresses and firmware UUID.",
+		"virtualMachineSnapshotNamespace": "VirtualMachineSnapshotNamespace is the namespace of the VirtualMachineSnapshot,\ndefaults to the namespace of the restore. Restoring a snapshot of another namespace\nrequires a VirtualMachineRestoreGrant in the namespace of the snapshot.\n+optional",
 	}
 }
 
@@ -148,3 +151,67 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineRestoreGrant) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineRestoreGrant allows VirtualMachineRestores of other namespaces\nto restore the VirtualMachineSnapshots of its namespace\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineRestoreGrantSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "VirtualMachineRestoreGrantSpec is the spec for a VirtualMachineRestoreGrant resource",
+		"namespaces":                  "Namespaces whose VirtualMachineRestores may use the snapshots\n+listType=set",
+		"virtualMachineSnapshotNames": "VirtualMachineSnapshotNames limits the grant to the listed snapshots,\nall snapshots of the namespace are granted if it is empty\n+optional\n+listType=set",
+	}
+}
+
+func (VirtualMachineRestoreGrantList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineRestoreGrantList is a list of VirtualMachineRestoreGrant resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule periodically creates VirtualMachineSnapshots\nof the selected VirtualMachines\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":       "Schedule in cron format, for example \"0 2 * * *\"",
+		"selector":       "Selector selects the VirtualMachines in the namespace of the schedule",
+		"disabled":       "Disabled suspends the creation of new VirtualMachineSnapshots,\nthe retention policy is still applied\n+optional",
+		"deletionPolicy": "DeletionPolicy of the created VirtualMachineSnapshots\n+optional",
+		"retention":      "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineSnapshotScheduleRetention defines which VirtualMachineSnapshots of a\nschedule are kept per VirtualMachine. A snapshot is kept if any of Last, Daily or\nWeekly selects it, or if none of them is set.",
+		"last":    "Last is the number of most recent snapshots to keep\n+optional",
+		"daily":   "Daily is the number of days to keep the most recent snapshot of\n+optional",
+		"weekly":  "Weekly is the number of weeks to keep the most recent snapshot of\n+optional",
+		"expires": "Expires deletes snapshots older than the duration, even if they are\nselected by Last, Daily or Weekly\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastRunTime":     "LastRunTime is the time the VirtualMachineSnapshots were last scheduled for\n+optional\n+nullable",
+		"nextRunTime":     "NextRunTime is the time the next VirtualMachineSnapshots are scheduled for\n+optional\n+nullable",
+		"lastSuccessTime": "LastSuccessTime is the time of the last run whose snapshots all became ready\n+optional\n+nullable",
+		"lastFailure":     "LastFailure is the time and reason of the last failed run\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
@@ -25,11 +25,25 @@ import (
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
 
+	backupv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/backup/v1alpha1"
+	clonev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/clone/v1alpha1"
+	exportv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
+	instancetypev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1"
+	migrationsv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
+	networkpolicyv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/networkpolicy/v1alpha1"
+	poolv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 )
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	BackupV1alpha1() backupv1alpha1.BackupV1alpha1Interface
+	CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface
+	ExportV1alpha1() exportv1alpha1.ExportV1alpha1Interface
+	InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface
+	MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface
+	NetworkpolicyV1alpha1() networkpolicyv1alpha1.NetworkpolicyV1alpha1Interface
+	PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface
 	SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface
 }
 
@@ -37,7 +51,49 @@ type Interface interface {
 // version included in a Clientset.
 type Clientset struct {
 	*discovery.DiscoveryClient
-	snapshotV1alpha1 *snapshotv1alpha1.SnapshotV1alpha1Client
+	backupV1alpha1        *backupv1alpha1.BackupV1alpha1Client
+	cloneV1alpha1         *clonev1alpha1.CloneV1alpha1Client
+	exportV1alpha1        *exportv1alpha1.ExportV1alpha1Client
+	instancetypeV1alpha1  *instancetypev1alpha1.InstancetypeV1alpha1Client
+	migrationsV1alpha1    *migrationsv1alpha1.MigrationsV1alpha1Client
+	networkpolicyV1alpha1 *networkpolicyv1alpha1.NetworkpolicyV1alpha1Client
+	poolV1alpha1          *poolv1alpha1.PoolV1alpha1Client
+	snapshotV1alpha1      *snapshotv1alpha1.SnapshotV1alpha1Client
+}
+
+// BackupV1alpha1 retrieves the BackupV1alpha1Client
+func (c *Clientset) BackupV1alpha1() backupv1alpha1.BackupV1alpha1Interface {
+	return c.backupV1alpha1
+}
+
+// CloneV1alpha1 retrieves the CloneV1alpha1Client
+func (c *Clientset) CloneV1alpha1() clonev1alpha1.CloneV1alpha1Interface {
+	return c.cloneV1alpha1
+}
+
+// ExportV1alpha1 retrieves the ExportV1alpha1Client
+func (c *Clientset) ExportV1alpha1() exportv1alpha1.ExportV1alpha1Interface {
+	return c.exportV1alpha1
+}
+
+// InstancetypeV1alpha1 retrieves the InstancetypeV1alpha1Client
+func (c *Clientset) InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface {
+	return c.instancetypeV1alpha1
+}
+
+// MigrationsV1alpha1 retrieves the MigrationsV1alpha1Client
+func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface {
+	return c.migrationsV1alpha1
+}
+
+// NetworkpolicyV1alpha1 retrieves the NetworkpolicyV1alpha1Client
+func (c *Clientset) NetworkpolicyV1alpha1() networkpolicyv1alpha1.NetworkpolicyV1alpha1Interface {
+	return c.networkpolicyV1alpha1
+}
+
+// PoolV1alpha1 retrieves the PoolV1alpha1Client
+func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
+	return c.poolV1alpha1
 }
 
 // SnapshotV1alpha1 retrieves the SnapshotV1alpha1Client
@@ -66,6 +122,34 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	}
 	var cs Clientset
 	var err error
+	cs.backupV1alpha1, err = backupv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.cloneV1alpha1, err = clonev1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.exportV1alpha1, err = exportv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.instancetypeV1alpha1, err = instancetypev1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.migrationsV1alpha1, err = migrationsv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.networkpolicyV1alpha1, err = networkpolicyv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.poolV1alpha1, err = poolv1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.snapshotV1alpha1, err = snapshotv1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
@@ -82,6 +166,13 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 // panics if there is an error in the config.
 func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
+	cs.backupV1alpha1 = backupv1alpha1.NewForConfigOrDie(c)
+	cs.cloneV1alpha1 = clonev1alpha1.NewForConfigOrDie(c)
+	cs.exportV1alpha1 = exportv1alpha1.NewForConfigOrDie(c)
+	cs.instancetypeV1alpha1 = instancetypev1alpha1.NewForConfigOrDie(c)
+	cs.migrationsV1alpha1 = migrationsv1alpha1.NewForConfigOrDie(c)
+	cs.networkpolicyV1alpha1 = networkpolicyv1alpha1.NewForConfigOrDie(c)
+	cs.poolV1alpha1 = poolv1alpha1.NewForConfigOrDie(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
@@ -91,6 +182,13 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.backupV1alpha1 = backupv1alpha1.New(c)
+	cs.cloneV1alpha1 = clonev1alpha1.New(c)
+	cs.exportV1alpha1 = exportv1alpha1.New(c)
+	cs.instancetypeV1alpha1 = instancetypev1alpha1.New(c)
+	cs.migrationsV1alpha1 = migrationsv1alpha1.New(c)
+	cs.networkpolicyV1alpha1 = networkpolicyv1alpha1.New(c)
+	cs.poolV1alpha1 = poolv1alpha1.New(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+
+	v1alpha1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+)
+
+// FakeVirtualMachineBackupRestores implements VirtualMachineBackupRestoreInterface
+type FakeVirtualMachineBackupRestores struct {
+	Fake *FakeBackupV1alpha1
+	ns   string
+}
+
+var virtualmachinebackuprestoresResource = schema.GroupVersionResource{Group: "backup.kubevirt.io", Version: "v1alpha1", Resource: "virtualmachinebackuprestores"}
+
+var virtualmachinebackuprestoresKind = schema.GroupVersionKind{Group: "backup.kubevirt.io", Version: "v1alpha1", Kind: "VirtualMachineBackupRestore"}
+
+// Get takes name of the virtualMachineBackupRestore, and returns the corresponding virtualMachineBackupRestore object, and an error if there is any.
+func (c *FakeVirtualMachineBackupRestores) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachinebackuprestoresResource, c.ns, name), &v1alpha1.VirtualMachineBackupRestore{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineBackupRestore), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineBackupRestores that match those selectors.
+func (c *FakeVirtualMachineBackupRestores) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineBackupRestoreList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachinebackuprestoresResource, virtualmachinebackuprestoresKind, c.ns, opts), &v1alpha1.VirtualMachineBackupRestoreList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.VirtualMachineBackupRestoreList{ListMeta: obj.(*v1alpha1.VirtualMachineBackupRestoreList).ListMeta}
+	for _, item := range obj.(*v1alpha1.VirtualMachineBackupRestoreList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineBackupRestores.
+func (c *FakeVirtualMachineBackupRestores) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachinebackuprestoresResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineBackupRestore and creates it.  Returns the server's representation of the virtualMachineBackupRestore, and an error, if there is any.
+func (c *FakeVirtualMachineBackupRestores) Create(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachinebackuprestoresResource, c.ns, virtualMachineBackupRestore), &v1alpha1.VirtualMachineBackupRestore{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineBackupRestore), err
+}
+
+// Update takes the representation of a virtualMachineBackupRestore and updates it. Returns the server's representation of the virtualMachineBackupRestore, and an error, if there is any.
+func (c *FakeVirtualMachineBackupRestores) Update(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachinebackuprestoresResource, c.ns, virtualMachineBackupRestore), &v1alpha1.VirtualMachineBackupRestore{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineBackupRestore), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineBackupRestores) UpdateStatus(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineBackupRestore, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(virtualmachinebackuprestoresResource, "status", c.ns, virtualMachineBackupRestore), &v1alpha1.VirtualMachineBackupRestore{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineBackupRestore), err
+}
+
+// Delete takes name of the virtualMachineBackupRestore and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineBackupRestores) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(virtualmachinebackuprestoresResource, c.ns, name), &v1alpha1.VirtualMachineBackupRestore{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineBackupRestores) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachinebackuprestoresResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.VirtualMachineBackupRestoreList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineBackupRestore.
+func (c *FakeVirtualMachineBackupRestores) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinebackuprestoresResource, c.ns, name, pt, data, subresources...), &v1alpha1.VirtualMachineBackupRestore{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineBackupRestore), err
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+
+	v1alpha1 "kubevirt.io/client-go/apis/backup/v1alpha1"
+	scheme "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/scheme"
+)
+
+// VirtualMachineBackupRestoresGetter has a method to return a VirtualMachineBackupRestoreInterface.
+// A group's client should implement this interface.
+type VirtualMachineBackupRestoresGetter interface {
+	VirtualMachineBackupRestores(namespace string) VirtualMachineBackupRestoreInterface
+}
+
+// VirtualMachineBackupRestoreInterface has methods to work with VirtualMachineBackupRestore resources.
+type VirtualMachineBackupRestoreInterface interface {
+	Create(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.CreateOptions) (*v1alpha1.VirtualMachineBackupRestore, error)
+	Update(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineBackupRestore, error)
+	UpdateStatus(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineBackupRestore, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.VirtualMachineBackupRestore, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineBackupRestoreList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineBackupRestore, err error)
+	VirtualMachineBackupRestoreExpansion
+}
+
+// virtualMachineBackupRestores implements VirtualMachineBackupRestoreInterface
+type virtualMachineBackupRestores struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineBackupRestores returns a VirtualMachineBackupRestores
+func newVirtualMachineBackupRestores(c *BackupV1alpha1Client, namespace string) *virtualMachineBackupRestores {
+	return &virtualMachineBackupRestores{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineBackupRestore, and returns the corresponding virtualMachineBackupRestore object, and an error if there is any.
+func (c *virtualMachineBackupRestores) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	result = &v1alpha1.VirtualMachineBackupRestore{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineBackupRestores that match those selectors.
+func (c *virtualMachineBackupRestores) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineBackupRestoreList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.VirtualMachineBackupRestoreList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineBackupRestores.
+func (c *virtualMachineBackupRestores) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineBackupRestore and creates it.  Returns the server's representation of the virtualMachineBackupRestore, and an error, if there is any.
+func (c *virtualMachineBackupRestores) Create(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	result = &v1alpha1.VirtualMachineBackupRestore{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineBackupRestore).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineBackupRestore and updates it. Returns the server's representation of the virtualMachineBackupRestore, and an error, if there is any.
+func (c *virtualMachineBackupRestores) Update(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	result = &v1alpha1.VirtualMachineBackupRestore{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		Name(virtualMachineBackupRestore.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineBackupRestore).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *virtualMachineBackupRestores) UpdateStatus(ctx context.Context, virtualMachineBackupRestore *v1alpha1.VirtualMachineBackupRestore, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	result = &v1alpha1.VirtualMachineBackupRestore{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		Name(virtualMachineBackupRestore.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineBackupRestore).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineBackupRestore and deletes it. Returns an error if one occurs.
+func (c *virtualMachineBackupRestores) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineBackupRestores) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineBackupRestore.
+func (c *virtualMachineBackupRestores) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineBackupRestore, err error) {
+	result = &v1alpha1.VirtualMachineBackupRestore{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachinebackuprestores").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+
+	v1alpha1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+)
+
+// FakeVirtualMachineNetworkPolicies implements VirtualMachineNetworkPolicyInterface
+type FakeVirtualMachineNetworkPolicies struct {
+	Fake *FakeNetworkpolicyV1alpha1
+	ns   string
+}
+
+var virtualmachinenetworkpoliciesResource = schema.GroupVersionResource{Group: "networkpolicy.kubevirt.io", Version: "v1alpha1", Resource: "virtualmachinenetworkpolicies"}
+
+var virtualmachinenetworkpoliciesKind = schema.GroupVersionKind{Group: "networkpolicy.kubevirt.io", Version: "v1alpha1", Kind: "VirtualMachineNetworkPolicy"}
+
+// Get takes name of the virtualMachineNetworkPolicy, and returns the corresponding virtualMachineNetworkPolicy object, and an error if there is any.
+func (c *FakeVirtualMachineNetworkPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachinenetworkpoliciesResource, c.ns, name), &v1alpha1.VirtualMachineNetworkPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineNetworkPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineNetworkPolicies that match those selectors.
+func (c *FakeVirtualMachineNetworkPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineNetworkPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachinenetworkpoliciesResource, virtualmachinenetworkpoliciesKind, c.ns, opts), &v1alpha1.VirtualMachineNetworkPolicyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.VirtualMachineNetworkPolicyList{ListMeta: obj.(*v1alpha1.VirtualMachineNetworkPolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.VirtualMachineNetworkPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineNetworkPolicies.
+func (c *FakeVirtualMachineNetworkPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachinenetworkpoliciesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineNetworkPolicy and creates it.  Returns the server's representation of the virtualMachineNetworkPolicy, and an error, if there is any.
+func (c *FakeVirtualMachineNetworkPolicies) Create(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachinenetworkpoliciesResource, c.ns, virtualMachineNetworkPolicy), &v1alpha1.VirtualMachineNetworkPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineNetworkPolicy), err
+}
+
+// Update takes the representation of a virtualMachineNetworkPolicy and updates it. Returns the server's representation of the virtualMachineNetworkPolicy, and an error, if there is any.
+func (c *FakeVirtualMachineNetworkPolicies) Update(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachinenetworkpoliciesResource, c.ns, virtualMachineNetworkPolicy), &v1alpha1.VirtualMachineNetworkPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineNetworkPolicy), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineNetworkPolicies) UpdateStatus(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineNetworkPolicy, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(virtualmachinenetworkpoliciesResource, "status", c.ns, virtualMachineNetworkPolicy), &v1alpha1.VirtualMachineNetworkPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineNetworkPolicy), err
+}
+
+// Delete takes name of the virtualMachineNetworkPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineNetworkPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(virtualmachinenetworkpoliciesResource, c.ns, name), &v1alpha1.VirtualMachineNetworkPolicy{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineNetworkPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachinenetworkpoliciesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.VirtualMachineNetworkPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineNetworkPolicy.
+func (c *FakeVirtualMachineNetworkPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinenetworkpoliciesResource, c.ns, name, pt, data, subresources...), &v1alpha1.VirtualMachineNetworkPolicy{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineNetworkPolicy), err
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2021 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+
+	v1alpha1 "kubevirt.io/client-go/apis/networkpolicy/v1alpha1"
+	scheme "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/scheme"
+)
+
+// VirtualMachineNetworkPoliciesGetter has a method to return a VirtualMachineNetworkPolicyInterface.
+// A group's client should implement this interface.
+type VirtualMachineNetworkPoliciesGetter interface {
+	VirtualMachineNetworkPolicies(namespace string) VirtualMachineNetworkPolicyInterface
+}
+
+// VirtualMachineNetworkPolicyInterface has methods to work with VirtualMachineNetworkPolicy resources.
+type VirtualMachineNetworkPolicyInterface interface {
+	Create(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.CreateOptions) (*v1alpha1.VirtualMachineNetworkPolicy, error)
+	Update(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineNetworkPolicy, error)
+	UpdateStatus(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineNetworkPolicy, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.VirtualMachineNetworkPolicy, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.VirtualMachineNetworkPolicyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineNetworkPolicy, err error)
+	VirtualMachineNetworkPolicyExpansion
+}
+
+// virtualMachineNetworkPolicies implements VirtualMachineNetworkPolicyInterface
+type virtualMachineNetworkPolicies struct {
+	client rest.Interface
+	ns     string
+}
+
+// newVirtualMachineNetworkPolicies returns a VirtualMachineNetworkPolicies
+func newVirtualMachineNetworkPolicies(c *NetworkpolicyV1alpha1Client, namespace string) *virtualMachineNetworkPolicies {
+	return &virtualMachineNetworkPolicies{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the virtualMachineNetworkPolicy, and returns the corresponding virtualMachineNetworkPolicy object, and an error if there is any.
+func (c *virtualMachineNetworkPolicies) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	result = &v1alpha1.VirtualMachineNetworkPolicy{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineNetworkPolicies that match those selectors.
+func (c *virtualMachineNetworkPolicies) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineNetworkPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.VirtualMachineNetworkPolicyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineNetworkPolicies.
+func (c *virtualMachineNetworkPolicies) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a virtualMachineNetworkPolicy and creates it.  Returns the server's representation of the virtualMachineNetworkPolicy, and an error, if there is any.
+func (c *virtualMachineNetworkPolicies) Create(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	result = &v1alpha1.VirtualMachineNetworkPolicy{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineNetworkPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a virtualMachineNetworkPolicy and updates it. Returns the server's representation of the virtualMachineNetworkPolicy, and an error, if there is any.
+func (c *virtualMachineNetworkPolicies) Update(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	result = &v1alpha1.VirtualMachineNetworkPolicy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		Name(virtualMachineNetworkPolicy.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineNetworkPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *virtualMachineNetworkPolicies) UpdateStatus(ctx context.Context, virtualMachineNetworkPolicy *v1alpha1.VirtualMachineNetworkPolicy, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	result = &v1alpha1.VirtualMachineNetworkPolicy{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		Name(virtualMachineNetworkPolicy.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(virtualMachineNetworkPolicy).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the virtualMachineNetworkPolicy and deletes it. Returns an error if one occurs.
+func (c *virtualMachineNetworkPolicies) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *virtualMachineNetworkPolicies) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched virtualMachineNetworkPolicy.
+func (c *virtualMachineNetworkPolicies) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineNetworkPolicy, err error) {
+	result = &v1alpha1.VirtualMachineNetworkPolicy{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("virtualmachinenetworkpolicies").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -33,6 +33,10 @@ func (c *FakeSnapshotV1alpha1) VirtualMachineRestores(namespace string) v1alpha1
 	return &FakeVirtualMachineRestores{c, namespace}
 }
 
+func (c *FakeSnapshotV1alpha1) VirtualMachineRestoreGrants(namespace string) v1alpha1.VirtualMachineRestoreGrantInterface {
+	return &FakeVirtualMachineRestoreGrants{c, namespace}
+}
+
 func (c *FakeSnapshotV1alpha1) VirtualMachineSnapshots(namespace string) v1alpha1.VirtualMachineSnapshotInterface {
 	return &FakeVirtualMachineSnapshots{c, namespace}
 }
@@ -41,6 +45,10 @@ func (c *FakeSnapshotV1alpha1) VirtualMachineSnapshotContents(namespace string)
 	return &FakeVirtualMachineSnapshotContents{c, namespace}
 }
 
+func (c *FakeSnapshotV1alpha1) VirtualMachineSnapshotSchedules(namespace string) v1alpha1.VirtualMachineSnapshotScheduleInterface {
+	return &FakeVirtualMachineSnapshotSchedules{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSnapshotV1alpha1) RESTClient() rest.Interface {
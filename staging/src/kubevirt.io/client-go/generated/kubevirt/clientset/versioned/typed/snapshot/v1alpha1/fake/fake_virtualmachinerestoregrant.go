@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+
+	v1alpha1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+)
+
+// FakeVirtualMachineRestoreGrants implements VirtualMachineRestoreGrantInterface
+type FakeVirtualMachineRestoreGrants struct {
+	Fake *FakeSnapshotV1alpha1
+	ns   string
+}
+
+var virtualmachinerestoregrantsResource = schema.GroupVersionResource{Group: "snapshot.kubevirt.io", Version: "v1alpha1", Resource: "virtualmachinerestoregrants"}
+
+var virtualmachinerestoregrantsKind = schema.GroupVersionKind{Group: "snapshot.kubevirt.io", Version: "v1alpha1", Kind: "VirtualMachineRestoreGrant"}
+
+// Get takes name of the virtualMachineRestoreGrant, and returns the corresponding virtualMachineRestoreGrant object, and an error if there is any.
+func (c *FakeVirtualMachineRestoreGrants) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineRestoreGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachinerestoregrantsResource, c.ns, name), &v1alpha1.VirtualMachineRestoreGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineRestoreGrant), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineRestoreGrants that match those selectors.
+func (c *FakeVirtualMachineRestoreGrants) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineRestoreGrantList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachinerestoregrantsResource, virtualmachinerestoregrantsKind, c.ns, opts), &v1alpha1.VirtualMachineRestoreGrantList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.VirtualMachineRestoreGrantList{ListMeta: obj.(*v1alpha1.VirtualMachineRestoreGrantList).ListMeta}
+	for _, item := range obj.(*v1alpha1.VirtualMachineRestoreGrantList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineRestoreGrants.
+func (c *FakeVirtualMachineRestoreGrants) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachinerestoregrantsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineRestoreGrant and creates it.  Returns the server's representation of the virtualMachineRestoreGrant, and an error, if there is any.
+func (c *FakeVirtualMachineRestoreGrants) Create(ctx context.Context, virtualMachineRestoreGrant *v1alpha1.VirtualMachineRestoreGrant, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineRestoreGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachinerestoregrantsResource, c.ns, virtualMachineRestoreGrant), &v1alpha1.VirtualMachineRestoreGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineRestoreGrant), err
+}
+
+// Update takes the representation of a virtualMachineRestoreGrant and updates it. Returns the server's representation of the virtualMachineRestoreGrant, and an error, if there is any.
+func (c *FakeVirtualMachineRestoreGrants) Update(ctx context.Context, virtualMachineRestoreGrant *v1alpha1.VirtualMachineRestoreGrant, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineRestoreGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachinerestoregrantsResource, c.ns, virtualMachineRestoreGrant), &v1alpha1.VirtualMachineRestoreGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineRestoreGrant), err
+}
+
+// Delete takes name of the virtualMachineRestoreGrant and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineRestoreGrants) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(virtualmachinerestoregrantsResource, c.ns, name), &v1alpha1.VirtualMachineRestoreGrant{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineRestoreGrants) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachinerestoregrantsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.VirtualMachineRestoreGrantList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineRestoreGrant.
+func (c *FakeVirtualMachineRestoreGrants) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineRestoreGrant, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinerestoregrantsResource, c.ns, name, pt, data, subresources...), &v1alpha1.VirtualMachineRestoreGrant{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineRestoreGrant), err
+}
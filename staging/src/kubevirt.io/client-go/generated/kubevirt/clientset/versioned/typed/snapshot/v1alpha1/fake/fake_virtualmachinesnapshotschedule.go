@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+
+	v1alpha1 "kubevirt.io/client-go/apis/snapshot/v1alpha1"
+)
+
+// FakeVirtualMachineSnapshotSchedules implements VirtualMachineSnapshotScheduleInterface
+type FakeVirtualMachineSnapshotSchedules struct {
+	Fake *FakeSnapshotV1alpha1
+	ns   string
+}
+
+var virtualmachinesnapshotschedulesResource = schema.GroupVersionResource{Group: "snapshot.kubevirt.io", Version: "v1alpha1", Resource: "virtualmachinesnapshotschedules"}
+
+var virtualmachinesnapshotschedulesKind = schema.GroupVersionKind{Group: "snapshot.kubevirt.io", Version: "v1alpha1", Kind: "VirtualMachineSnapshotSchedule"}
+
+// Get takes name of the virtualMachineSnapshotSchedule, and returns the corresponding virtualMachineSnapshotSchedule object, and an error if there is any.
+func (c *FakeVirtualMachineSnapshotSchedules) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(virtualmachinesnapshotschedulesResource, c.ns, name), &v1alpha1.VirtualMachineSnapshotSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineSnapshotSchedule), err
+}
+
+// List takes label and field selectors, and returns the list of VirtualMachineSnapshotSchedules that match those selectors.
+func (c *FakeVirtualMachineSnapshotSchedules) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.VirtualMachineSnapshotScheduleList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(virtualmachinesnapshotschedulesResource, virtualmachinesnapshotschedulesKind, c.ns, opts), &v1alpha1.VirtualMachineSnapshotScheduleList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.VirtualMachineSnapshotScheduleList{ListMeta: obj.(*v1alpha1.VirtualMachineSnapshotScheduleList).ListMeta}
+	for _, item := range obj.(*v1alpha1.VirtualMachineSnapshotScheduleList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested virtualMachineSnapshotSchedules.
+func (c *FakeVirtualMachineSnapshotSchedules) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(virtualmachinesnapshotschedulesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a virtualMachineSnapshotSchedule and creates it.  Returns the server's representation of the virtualMachineSnapshotSchedule, and an error, if there is any.
+func (c *FakeVirtualMachineSnapshotSchedules) Create(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.CreateOptions) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(virtualmachinesnapshotschedulesResource, c.ns, virtualMachineSnapshotSchedule), &v1alpha1.VirtualMachineSnapshotSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineSnapshotSchedule), err
+}
+
+// Update takes the representation of a virtualMachineSnapshotSchedule and updates it. Returns the server's representation of the virtualMachineSnapshotSchedule, and an error, if there is any.
+func (c *FakeVirtualMachineSnapshotSchedules) Update(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.UpdateOptions) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(virtualmachinesnapshotschedulesResource, c.ns, virtualMachineSnapshotSchedule), &v1alpha1.VirtualMachineSnapshotSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineSnapshotSchedule), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeVirtualMachineSnapshotSchedules) UpdateStatus(ctx context.Context, virtualMachineSnapshotSchedule *v1alpha1.VirtualMachineSnapshotSchedule, opts v1.UpdateOptions) (*v1alpha1.VirtualMachineSnapshotSchedule, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(virtualmachinesnapshotschedulesResource, "status", c.ns, virtualMachineSnapshotSchedule), &v1alpha1.VirtualMachineSnapshotSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineSnapshotSchedule), err
+}
+
+// Delete takes name of the virtualMachineSnapshotSchedule and deletes it. Returns an error if one occurs.
+func (c *FakeVirtualMachineSnapshotSchedules) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(virtualmachinesnapshotschedulesResource, c.ns, name), &v1alpha1.VirtualMachineSnapshotSchedule{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeVirtualMachineSnapshotSchedules) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(virtualmachinesnapshotschedulesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.VirtualMachineSnapshotScheduleList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched virtualMachineSnapshotSchedule.
+func (c *FakeVirtualMachineSnapshotSchedules) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.VirtualMachineSnapshotSchedule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(virtualmachinesnapshotschedulesResource, c.ns, name, pt, data, subresources...), &v1alpha1.VirtualMachineSnapshotSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.VirtualMachineSnapshotSchedule), err
+}
@@ -20,6 +20,10 @@ package v1alpha1
 
 type VirtualMachineRestoreExpansion interface{}
 
+type VirtualMachineRestoreGrantExpansion interface{}
+
 type VirtualMachineSnapshotExpansion interface{}
 
 type VirtualMachineSnapshotContentExpansion interface{}
+
+type VirtualMachineSnapshotScheduleExpansion interface{}
@@ -28,8 +28,10 @@ import (
 type SnapshotV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	VirtualMachineRestoresGetter
+	VirtualMachineRestoreGrantsGetter
 	VirtualMachineSnapshotsGetter
 	VirtualMachineSnapshotContentsGetter
+	VirtualMachineSnapshotSchedulesGetter
 }
 
 // SnapshotV1alpha1Client is used to interact with features provided by the snapshot.kubevirt.io group.
@@ -41,6 +43,10 @@ func (c *SnapshotV1alpha1Client) VirtualMachineRestores(namespace string) Virtua
 	return newVirtualMachineRestores(c, namespace)
 }
 
+func (c *SnapshotV1alpha1Client) VirtualMachineRestoreGrants(namespace string) VirtualMachineRestoreGrantInterface {
+	return newVirtualMachineRestoreGrants(c, namespace)
+}
+
 func (c *SnapshotV1alpha1Client) VirtualMachineSnapshots(namespace string) VirtualMachineSnapshotInterface {
 	return newVirtualMachineSnapshots(c, namespace)
 }
@@ -49,6 +55,10 @@ func (c *SnapshotV1alpha1Client) VirtualMachineSnapshotContents(namespace string
 	return newVirtualMachineSnapshotContents(c, namespace)
 }
 
+func (c *SnapshotV1alpha1Client) VirtualMachineSnapshotSchedules(namespace string) VirtualMachineSnapshotScheduleInterface {
+	return newVirtualMachineSnapshotSchedules(c, namespace)
+}
+
 // NewForConfig creates a new SnapshotV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*SnapshotV1alpha1Client, error) {
 	config := *c
@@ -4,6 +4,8 @@
 package kubecli
 
 import (
+	time "time"
+
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/openshift/client-go/security/clientset/versioned/typed/security/v1"
 	v10 "k8s.io/api/autoscaling/v1"
@@ -61,6 +63,13 @@ import (
 	versioned "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned"
 	versioned0 "kubevirt.io/client-go/generated/external-snapshotter/clientset/versioned"
 	versioned1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned"
+	v1alpha113 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/backup/v1alpha1"
+	v1alpha18 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/clone/v1alpha1"
+	v1alpha19 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
+	v1alpha111 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1"
+	v1alpha110 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
+	v1alpha112 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/networkpolicy/v1alpha1"
+	v1alpha17 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
 	v1alpha16 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 	versioned2 "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned"
 	versioned3 "kubevirt.io/client-go/generated/prometheus-operator/clientset/versioned"
@@ -177,6 +186,136 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestore(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestore", arg0)
 }
 
+func (_m *MockKubevirtClient) VirtualMachineRestoreGrant(namespace string) v1alpha16.VirtualMachineRestoreGrantInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineRestoreGrant", namespace)
+	ret0, _ := ret[0].(v1alpha16.VirtualMachineRestoreGrantInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineRestoreGrant(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineRestoreGrant", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1alpha16.VirtualMachineSnapshotScheduleInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1alpha16.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotSchedule(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotSchedule", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha17.VirtualMachinePoolInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachinePool", namespace)
+	ret0, _ := ret[0].(v1alpha17.VirtualMachinePoolInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachinePool(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachinePool", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineClone(namespace string) v1alpha18.VirtualMachineCloneInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineClone", namespace)
+	ret0, _ := ret[0].(v1alpha18.VirtualMachineCloneInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineClone(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClone", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineExport(namespace string) v1alpha19.VirtualMachineExportInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineExport", namespace)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachineExportInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineExport(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineExport", arg0)
+}
+
+func (_m *MockKubevirtClient) MigrationPolicy() v1alpha110.MigrationPolicyInterface {
+	ret := _m.ctrl.Call(_m, "MigrationPolicy")
+	ret0, _ := ret[0].(v1alpha110.MigrationPolicyInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) MigrationPolicy() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "MigrationPolicy")
+}
+
+func (_m *MockKubevirtClient) VirtualMachineInstancetype(namespace string) v1alpha111.VirtualMachineInstancetypeInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineInstancetype", namespace)
+	ret0, _ := ret[0].(v1alpha111.VirtualMachineInstancetypeInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineInstancetype(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineInstancetype", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineClusterInstancetype() v1alpha111.VirtualMachineClusterInstancetypeInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineClusterInstancetype")
+	ret0, _ := ret[0].(v1alpha111.VirtualMachineClusterInstancetypeInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineClusterInstancetype() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClusterInstancetype")
+}
+
+func (_m *MockKubevirtClient) VirtualMachinePreference(namespace string) v1alpha111.VirtualMachinePreferenceInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachinePreference", namespace)
+	ret0, _ := ret[0].(v1alpha111.VirtualMachinePreferenceInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachinePreference(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachinePreference", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineClusterPreference() v1alpha111.VirtualMachineClusterPreferenceInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineClusterPreference")
+	ret0, _ := ret[0].(v1alpha111.VirtualMachineClusterPreferenceInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineClusterPreference() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClusterPreference")
+}
+
+func (_m *MockKubevirtClient) VirtualMachineNetworkPolicy(namespace string) v1alpha112.VirtualMachineNetworkPolicyInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineNetworkPolicy", namespace)
+	ret0, _ := ret[0].(v1alpha112.VirtualMachineNetworkPolicyInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineNetworkPolicy(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineNetworkPolicy", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineBackup(namespace string) v1alpha113.VirtualMachineBackupInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineBackup", namespace)
+	ret0, _ := ret[0].(v1alpha113.VirtualMachineBackupInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineBackup(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineBackup", arg0)
+}
+
+func (_m *MockKubevirtClient) VirtualMachineBackupRestore(namespace string) v1alpha113.VirtualMachineBackupRestoreInterface {
+	ret := _m.ctrl.Call(_m, "VirtualMachineBackupRestore", namespace)
+	ret0, _ := ret[0].(v1alpha113.VirtualMachineBackupRestoreInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) VirtualMachineBackupRestore(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineBackupRestore", arg0)
+}
+
 func (_m *MockKubevirtClient) ServerVersion() *ServerVersion {
 	ret := _m.ctrl.Call(_m, "ServerVersion")
 	ret0, _ := ret[0].(*ServerVersion)
@@ -881,6 +1020,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VNC(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VNC", arg0)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) PortForward(name string, port int, protocol string) (StreamInterface, error) {
+	ret := _m.ctrl.Call(_m, "PortForward", name, port, protocol)
+	ret0, _ := ret[0].(StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) PortForward(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PortForward", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) Pause(name string) error {
 	ret := _m.ctrl.Call(_m, "Pause", name)
 	ret0, _ := ret[0].(error)
@@ -901,6 +1051,26 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Unpause(arg0 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Unpause", arg0)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) Freeze(name string, unfreezeTimeout time.Duration) error {
+	ret := _m.ctrl.Call(_m, "Freeze", name, unfreezeTimeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Freeze(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Freeze", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) Unfreeze(name string) error {
+	ret := _m.ctrl.Call(_m, "Unfreeze", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Unfreeze(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Unfreeze", arg0)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) GuestOsInfo(name string) (v117.VirtualMachineInstanceGuestAgentInfo, error) {
 	ret := _m.ctrl.Call(_m, "GuestOsInfo", name)
 	ret0, _ := ret[0].(v117.VirtualMachineInstanceGuestAgentInfo)
@@ -954,6 +1124,87 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveVolume(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) AddInterface(name string, addInterfaceOptions *v117.AddInterfaceOptions) error {
+	ret := _m.ctrl.Call(_m, "AddInterface", name, addInterfaceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AddInterface(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddInterface", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) RemoveInterface(name string, removeInterfaceOptions *v117.RemoveInterfaceOptions) error {
+	ret := _m.ctrl.Call(_m, "RemoveInterface", name, removeInterfaceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveInterface(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveInterface", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) EjectCDRom(name string, ejectCDRomOptions *v117.EjectCDRomOptions) error {
+	ret := _m.ctrl.Call(_m, "EjectCDRom", name, ejectCDRomOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) EjectCDRom(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EjectCDRom", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) InsertCDRom(name string, insertCDRomOptions *v117.InsertCDRomOptions) error {
+	ret := _m.ctrl.Call(_m, "InsertCDRom", name, insertCDRomOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) InsertCDRom(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "InsertCDRom", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) Backup(name string, backupOptions *v117.VirtualMachineInstanceBackupOptions) error {
+	ret := _m.ctrl.Call(_m, "Backup", name, backupOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Backup(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Backup", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) AbortBackup(name string, abortBackupOptions *v117.VirtualMachineInstanceAbortBackupOptions) error {
+	ret := _m.ctrl.Call(_m, "AbortBackup", name, abortBackupOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AbortBackup(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AbortBackup", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) SetBlockIOTune(name string, setBlockIOTuneOptions *v117.SetBlockIOTuneOptions) error {
+	ret := _m.ctrl.Call(_m, "SetBlockIOTune", name, setBlockIOTuneOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SetBlockIOTune(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetBlockIOTune", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) NBD(name string) (StreamInterface, error) {
+	ret := _m.ctrl.Call(_m, "NBD", name)
+	ret0, _ := ret[0].(StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) NBD(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NBD", arg0)
+}
+
 // Mock of ReplicaSetInterface interface
 type MockReplicaSetInterface struct {
 	ctrl     *gomock.Controller
@@ -1373,6 +1624,46 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveVolume(arg0, arg1 interfa
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInterface) AddInterface(name string, addInterfaceOptions *v117.AddInterfaceOptions) error {
+	ret := _m.ctrl.Call(_m, "AddInterface", name, addInterfaceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) AddInterface(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddInterface", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) RemoveInterface(name string, removeInterfaceOptions *v117.RemoveInterfaceOptions) error {
+	ret := _m.ctrl.Call(_m, "RemoveInterface", name, removeInterfaceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveInterface(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveInterface", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) EjectCDRom(name string, ejectCDRomOptions *v117.EjectCDRomOptions) error {
+	ret := _m.ctrl.Call(_m, "EjectCDRom", name, ejectCDRomOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) EjectCDRom(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EjectCDRom", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) InsertCDRom(name string, insertCDRomOptions *v117.InsertCDRomOptions) error {
+	ret := _m.ctrl.Call(_m, "InsertCDRom", name, insertCDRomOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) InsertCDRom(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "InsertCDRom", arg0, arg1)
+}
+
 // Mock of VirtualMachineInstanceMigrationInterface interface
 type MockVirtualMachineInstanceMigrationInterface struct {
 	ctrl     *gomock.Controller
@@ -27,6 +27,7 @@ package kubecli
 
 import (
 	"io"
+	"time"
 
 	secv1 "github.com/openshift/client-go/security/clientset/versioned/typed/security/v1"
 	autov1 "k8s.io/api/autoscaling/v1"
@@ -42,6 +43,13 @@ import (
 	cdiclient "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned"
 	k8ssnapshotclient "kubevirt.io/client-go/generated/external-snapshotter/clientset/versioned"
 	generatedclient "kubevirt.io/client-go/generated/kubevirt/clientset/versioned"
+	backupv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/backup/v1alpha1"
+	clonev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/clone/v1alpha1"
+	exportv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
+	instancetypev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1"
+	migrationsv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
+	networkpolicyv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/networkpolicy/v1alpha1"
+	poolv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
 	vmsnapshotv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 	networkclient "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned"
 	promclient "kubevirt.io/client-go/generated/prometheus-operator/clientset/versioned"
@@ -57,6 +65,19 @@ type KubevirtClient interface {
 	VirtualMachineSnapshot(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotContentInterface
 	VirtualMachineRestore(namespace string) vmsnapshotv1alpha1.VirtualMachineRestoreInterface
+	VirtualMachineRestoreGrant(namespace string) vmsnapshotv1alpha1.VirtualMachineRestoreGrantInterface
+	VirtualMachineSnapshotSchedule(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachinePool(namespace string) poolv1alpha1.VirtualMachinePoolInterface
+	VirtualMachineClone(namespace string) clonev1alpha1.VirtualMachineCloneInterface
+	VirtualMachineExport(namespace string) exportv1alpha1.VirtualMachineExportInterface
+	MigrationPolicy() migrationsv1alpha1.MigrationPolicyInterface
+	VirtualMachineInstancetype(namespace string) instancetypev1alpha1.VirtualMachineInstancetypeInterface
+	VirtualMachineClusterInstancetype() instancetypev1alpha1.VirtualMachineClusterInstancetypeInterface
+	VirtualMachinePreference(namespace string) instancetypev1alpha1.VirtualMachinePreferenceInterface
+	VirtualMachineClusterPreference() instancetypev1alpha1.VirtualMachineClusterPreferenceInterface
+	VirtualMachineNetworkPolicy(namespace string) networkpolicyv1alpha1.VirtualMachineNetworkPolicyInterface
+	VirtualMachineBackup(namespace string) backupv1alpha1.VirtualMachineBackupInterface
+	VirtualMachineBackupRestore(namespace string) backupv1alpha1.VirtualMachineBackupRestoreInterface
 	ServerVersion() *ServerVersion
 	RestClient() *rest.RESTClient
 	GeneratedKubeVirtClient() generatedclient.Interface
@@ -137,6 +158,58 @@ func (k kubevirt) VirtualMachineRestore(namespace string) vmsnapshotv1alpha1.Vir
 	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineRestores(namespace)
 }
 
+func (k kubevirt) VirtualMachineRestoreGrant(namespace string) vmsnapshotv1alpha1.VirtualMachineRestoreGrantInterface {
+	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineRestoreGrants(namespace)
+}
+
+func (k kubevirt) VirtualMachineSnapshotSchedule(namespace string) vmsnapshotv1alpha1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1alpha1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirt) VirtualMachinePool(namespace string) poolv1alpha1.VirtualMachinePoolInterface {
+	return k.generatedKubeVirtClient.PoolV1alpha1().VirtualMachinePools(namespace)
+}
+
+func (k kubevirt) VirtualMachineClone(namespace string) clonev1alpha1.VirtualMachineCloneInterface {
+	return k.generatedKubeVirtClient.CloneV1alpha1().VirtualMachineClones(namespace)
+}
+
+func (k kubevirt) VirtualMachineExport(namespace string) exportv1alpha1.VirtualMachineExportInterface {
+	return k.generatedKubeVirtClient.ExportV1alpha1().VirtualMachineExports(namespace)
+}
+
+func (k kubevirt) MigrationPolicy() migrationsv1alpha1.MigrationPolicyInterface {
+	return k.generatedKubeVirtClient.MigrationsV1alpha1().MigrationPolicies()
+}
+
+func (k kubevirt) VirtualMachineInstancetype(namespace string) instancetypev1alpha1.VirtualMachineInstancetypeInterface {
+	return k.generatedKubeVirtClient.InstancetypeV1alpha1().VirtualMachineInstancetypes(namespace)
+}
+
+func (k kubevirt) VirtualMachineClusterInstancetype() instancetypev1alpha1.VirtualMachineClusterInstancetypeInterface {
+	return k.generatedKubeVirtClient.InstancetypeV1alpha1().VirtualMachineClusterInstancetypes()
+}
+
+func (k kubevirt) VirtualMachinePreference(namespace string) instancetypev1alpha1.VirtualMachinePreferenceInterface {
+	return k.generatedKubeVirtClient.InstancetypeV1alpha1().VirtualMachinePreferences(namespace)
+}
+
+func (k kubevirt) VirtualMachineClusterPreference() instancetypev1alpha1.VirtualMachineClusterPreferenceInterface {
+	return k.generatedKubeVirtClient.InstancetypeV1alpha1().VirtualMachineClusterPreferences()
+}
+
+func (k kubevirt) VirtualMachineNetworkPolicy(namespace string) networkpolicyv1alpha1.VirtualMachineNetworkPolicyInterface {
+	return k.generatedKubeVirtClient.NetworkpolicyV1alpha1().VirtualMachineNetworkPolicies(namespace)
+}
+
+func (k kubevirt) VirtualMachineBackup(namespace string) backupv1alpha1.VirtualMachineBackupInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackups(namespace)
+}
+
+func (k kubevirt) VirtualMachineBackupRestore(namespace string) backupv1alpha1.VirtualMachineBackupRestoreInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupRestores(namespace)
+}
+
 func (k kubevirt) KubernetesSnapshotClient() k8ssnapshotclient.Interface {
 	return k.snapshotClient
 }
@@ -163,13 +236,24 @@ type VirtualMachineInstanceInterface interface {
 	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1.VirtualMachineInstance, err error)
 	SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error)
 	VNC(name string) (StreamInterface, error)
+	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	Pause(name string) error
 	Unpause(name string) error
+	Freeze(name string, unfreezeTimeout time.Duration) error
+	Unfreeze(name string) error
 	GuestOsInfo(name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(name string) (v1.VirtualMachineInstanceFileSystemList, error)
 	AddVolume(name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	AddInterface(name string, addInterfaceOptions *v1.AddInterfaceOptions) error
+	RemoveInterface(name string, removeInterfaceOptions *v1.RemoveInterfaceOptions) error
+	EjectCDRom(name string, ejectCDRomOptions *v1.EjectCDRomOptions) error
+	InsertCDRom(name string, insertCDRomOptions *v1.InsertCDRomOptions) error
+	Backup(name string, backupOptions *v1.VirtualMachineInstanceBackupOptions) error
+	AbortBackup(name string, abortBackupOptions *v1.VirtualMachineInstanceAbortBackupOptions) error
+	SetBlockIOTune(name string, setBlockIOTuneOptions *v1.SetBlockIOTuneOptions) error
+	NBD(name string) (StreamInterface, error)
 }
 
 type ReplicaSetInterface interface {
@@ -213,6 +297,10 @@ type VirtualMachineInterface interface {
 	Rename(name string, options *v1.RenameOptions) error
 	AddVolume(name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	AddInterface(name string, addInterfaceOptions *v1.AddInterfaceOptions) error
+	RemoveInterface(name string, removeInterfaceOptions *v1.RemoveInterfaceOptions) error
+	EjectCDRom(name string, ejectCDRomOptions *v1.EjectCDRomOptions) error
+	InsertCDRom(name string, insertCDRomOptions *v1.InsertCDRomOptions) error
 }
 
 type VirtualMachineInstanceMigrationInterface interface {
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/websocket"
 	. "github.com/onsi/ginkgo"
@@ -30,6 +31,7 @@ import (
 	"github.com/onsi/gomega/ghttp"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -219,6 +221,34 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Expect(bufOut).To(Equal(bufIn))
 	})
 
+	It("should connect to the port-forward subresource", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", subVMPath+"/portforward/22"),
+			func(w http.ResponseWriter, r *http.Request) {
+				_, err := upgrader.Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+			},
+		))
+		_, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).PortForward("testvm", 22, "")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should connect to the port-forward subresource with a protocol", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", subVMPath+"/portforward/53/udp"),
+			func(w http.ResponseWriter, r *http.Request) {
+				_, err := upgrader.Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+			},
+		))
+		_, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).PortForward("testvm", 53, "udp")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
 	It("should pause a VirtualMachineInstance", func() {
 		server.AppendHandlers(ghttp.CombineHandlers(
 			ghttp.VerifyRequest("PUT", subVMPath+"/pause"),
@@ -241,6 +271,87 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("should freeze a VirtualMachineInstance", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/freeze"),
+			ghttp.VerifyBody([]byte(`{"unfreezeTimeout":"1m0s"}`)),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).Freeze("testvm", time.Minute)
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should unfreeze a VirtualMachineInstance", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/unfreeze"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).Unfreeze("testvm")
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should start a backup of a VirtualMachineInstance", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/backup"),
+			ghttp.VerifyBody([]byte(`{"backupName":"mybackup","mode":"Pull","checkpoint":"mybackup-checkpoint"}`)),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).Backup("testvm", &v1.VirtualMachineInstanceBackupOptions{
+			BackupName: "mybackup",
+			Mode:       v1.BackupModePull,
+			Checkpoint: "mybackup-checkpoint",
+		})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should abort a backup of a VirtualMachineInstance", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/abortbackup"),
+			ghttp.VerifyBody([]byte(`{"backupName":"mybackup"}`)),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).AbortBackup("testvm", &v1.VirtualMachineInstanceAbortBackupOptions{BackupName: "mybackup"})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should set the I/O limits of a disk of a VirtualMachineInstance", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", subVMPath+"/setblockiotune"),
+			ghttp.VerifyBody([]byte(`{"name":"rootdisk","ioTune":{"totalBytesSec":"100Mi"}}`)),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+		))
+		totalBytes := resource.MustParse("100Mi")
+		err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).SetBlockIOTune("testvm", &v1.SetBlockIOTuneOptions{
+			Name:   "rootdisk",
+			IOTune: v1.DiskIOTune{TotalBytesSec: &totalBytes},
+		})
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should connect to the nbd subresource", func() {
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", subVMPath+"/nbd"),
+			func(w http.ResponseWriter, r *http.Request) {
+				_, err := upgrader.Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+			},
+		))
+		_, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).NBD("testvm")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
 	It("should fetch GuestOSInfo from VirtualMachineInstance via subresource", func() {
 		osInfo := v1.VirtualMachineInstanceGuestAgentInfo{
 			GAVersion: "4.1.1",
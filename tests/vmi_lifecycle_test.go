@@ -1123,7 +1123,7 @@ var _ = Describe("[rfe_id:273][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				addNodeAffinityToVMI(vmi, node.Name)
 
 				node, err = virtClient.CoreV1().Nodes().Patch(context.Background(), node.Name, types.StrategicMergePatchType,
-					[]byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "true"}}}`, services.NFD_CPU_FEATURE_PREFIX+"monitor")), metav1.PatchOptions{})
+					[]byte(fmt.Sprintf(`{"metadata": { "labels": {"%s": "true"}}}`, v1.CPUFeatureLabel+"monitor")), metav1.PatchOptions{})
 				Expect(err).ToNot(HaveOccurred(), "Should patch node successfully")
 
 				_, err = virtClient.VirtualMachineInstance(vmi.Namespace).Create(vmi)